@@ -10,7 +10,9 @@ import (
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/frontend"
 	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/identity/ldap"
 	"github.com/pomerium/pomerium/internal/identity/oauth"
+	"github.com/pomerium/pomerium/internal/identity/saml"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
@@ -33,10 +35,11 @@ func ValidateOptions(o *config.Options) error {
 	if o.Provider == "" {
 		return errors.New("authenticate: 'IDP_PROVIDER' is required")
 	}
-	if o.ClientID == "" {
+	// LDAP directories may allow anonymous searches, without a service account
+	if o.ClientID == "" && o.Provider != ldap.Name {
 		return errors.New("authenticate: 'IDP_CLIENT_ID' is required")
 	}
-	if o.ClientSecret == "" {
+	if o.ClientSecret == "" && o.Provider != saml.Name && o.ClientID != "" {
 		return errors.New("authenticate: 'IDP_CLIENT_SECRET' is required")
 	}
 	if o.AuthenticateCallbackPath == "" {
@@ -57,7 +60,20 @@ type Authenticate struct {
 
 	options  *config.AtomicOptions
 	provider *identity.AtomicAuthenticator
-	state    *atomicAuthenticateState
+	// providers are the additional identity providers, by id
+	providers *atomicIdentityProviders
+	state     *atomicAuthenticateState
+	// scim serves the SCIM endpoints when they're enabled
+	scim *atomicSCIMHandler
+
+	// kioskLimiter limits how often each client IP can request a kiosk device
+	kioskLimiter *keyedRateLimiter
+	// deviceLimiter limits how often each client IP can register a device
+	// authorization
+	deviceLimiter *keyedRateLimiter
+	// ldapLimiter limits how often each client IP and username can try to
+	// sign in with LDAP
+	ldapLimiter *keyedRateLimiter
 }
 
 // New validates and creates a new authenticate service from a set of Options.
@@ -76,6 +92,10 @@ func New(cfg *config.Config) (*Authenticate, error) {
 			ClientDNSRoundRobin:     cfg.Options.GRPCClientDNSRoundRobin,
 			WithInsecure:            cfg.Options.GRPCInsecure,
 			ServiceName:             cfg.Options.Services,
+			Compression:             cfg.Options.DataBrokerCompressionThreshold > 0,
+			Namespace:               cfg.Options.DataBrokerNamespace,
+			ClientID:                cfg.Options.DataBrokerClientID,
+			ClientSecret:            cfg.Options.GetDataBrokerClientSecret(),
 		})
 	if err != nil {
 		return nil, err
@@ -89,7 +109,12 @@ func New(cfg *config.Config) (*Authenticate, error) {
 		templates:        template.Must(frontend.NewTemplates()),
 		options:          config.NewAtomicOptions(),
 		provider:         identity.NewAtomicAuthenticator(),
+		providers:        newAtomicIdentityProviders(),
 		state:            newAtomicAuthenticateState(newAuthenticateState()),
+		scim:             newAtomicSCIMHandler(),
+		kioskLimiter:     newKioskRateLimiter(),
+		deviceLimiter:    newDeviceRateLimiter(),
+		ldapLimiter:      newLDAPRateLimiter(),
 	}
 
 	err = a.updateProvider(cfg)
@@ -102,6 +127,7 @@ func New(cfg *config.Config) (*Authenticate, error) {
 		return nil, err
 	}
 	a.state.Store(state)
+	a.updateSCIM(cfg.Options)
 
 	return a, nil
 }
@@ -122,6 +148,7 @@ func (a *Authenticate) OnConfigChange(cfg *config.Config) {
 	} else {
 		a.state.Store(state)
 	}
+	a.updateSCIM(cfg.Options)
 }
 
 func (a *Authenticate) updateProvider(cfg *config.Config) error {
@@ -139,11 +166,26 @@ func (a *Authenticate) updateProvider(cfg *config.Config) error {
 			Scopes:          cfg.Options.Scopes,
 			ServiceAccount:  cfg.Options.ServiceAccount,
 			AuthCodeOptions: cfg.Options.RequestParams,
+			ClockSkew:       cfg.Options.GetIdpClockSkew(),
+			SAMLAttributes:  cfg.Options.SAMLAttributes,
 		})
 	if err != nil {
 		return err
 	}
+	a.setSAMLReplayCache(provider)
+
+	providers := make(map[string]identity.Authenticator)
+	for i := range cfg.Options.IdentityProviders {
+		idp := &cfg.Options.IdentityProviders[i]
+		providers[idp.ID], err = identity.NewAuthenticator(cfg.Options.GetOauthOptionsForIdentityProvider(idp))
+		if err != nil {
+			return fmt.Errorf("identity provider %q: %w", idp.ID, err)
+		}
+		a.setSAMLReplayCache(providers[idp.ID])
+	}
+
 	a.provider.Store(provider)
+	a.providers.Store(providers)
 
 	return nil
 }
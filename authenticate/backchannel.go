@@ -0,0 +1,120 @@
+package authenticate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity/oidc"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// backChannelLogoutPath is the endpoint identity providers post logout
+// tokens to. It's called without a session and is exempt from CSRF
+// protection.
+const backChannelLogoutPath = "/.pomerium/backchannel_logout"
+
+// errBackChannelLogoutNotSupported is returned by the back-channel logout
+// endpoint when the identity provider doesn't use OpenID Connect.
+var errBackChannelLogoutNotSupported = errors.New("identity provider doesn't support back-channel logout")
+
+// BackChannelLogout is the OpenID Connect back-channel logout endpoint. The
+// identity provider posts a logout token to it when a user signs out or is
+// disabled, and the sessions the token identifies are deleted from the
+// databroker.
+//
+// Identity providers other than the default one are selected with the
+// identity provider id query parameter.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html
+func (a *Authenticate) BackChannelLogout(w http.ResponseWriter, r *http.Request) error {
+	ctx, span := trace.StartSpan(r.Context(), "authenticate.BackChannelLogout")
+	defer span.End()
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	idpID := r.FormValue(urlutil.QueryIdentityProviderID)
+	if idpID == "" {
+		idpID = config.DefaultIdentityProviderID
+	}
+	idp, err := a.getIdentityProvider(idpID)
+	if err != nil {
+		return err
+	}
+	provider, ok := idp.(interface {
+		VerifyLogoutToken(ctx context.Context, rawLogoutToken string) (*oidc.LogoutToken, error)
+	})
+	if !ok {
+		return httputil.NewError(http.StatusNotFound, errBackChannelLogoutNotSupported)
+	}
+
+	token, err := provider.VerifyLogoutToken(ctx, r.FormValue("logout_token"))
+	if err != nil {
+		log.FromRequest(r).Info().Err(err).Str("idp_id", idpID).Msg("authenticate: invalid logout token")
+		return writeOAuthError(w, "invalid_request")
+	}
+
+	sessions, err := a.getBackChannelLogoutSessions(ctx, idpID, idp.Name(), token)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	for _, s := range sessions {
+		if err := a.deleteSession(ctx, s.GetId()); err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+		log.FromRequest(r).Info().
+			Str("id", s.GetId()).
+			Str("user_id", s.GetUserId()).
+			Str("idp_id", idpID).
+			Msg("authenticate: session logged out by identity provider")
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// getBackChannelLogoutSessions returns the sessions of the identity provider
+// a logout token identifies. A token with a session id logs out that identity
+// provider session, otherwise all of the subject's sessions are logged out.
+func (a *Authenticate) getBackChannelLogoutSessions(
+	ctx context.Context,
+	idpID, providerName string,
+	token *oidc.LogoutToken,
+) ([]*session.Session, error) {
+	if a.dataBrokerClient == nil {
+		return nil, nil
+	}
+
+	var all []*session.Session
+	var err error
+	if token.SessionID != "" {
+		all, err = session.GetAllForIdentityProviderSession(ctx, a.dataBrokerClient, token.SessionID)
+	} else {
+		all, err = session.GetAllForUser(ctx, a.dataBrokerClient, databroker.GetUserID(providerName, token.Subject))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*session.Session
+	for _, s := range all {
+		sessionIDPID := s.GetIdpId()
+		if sessionIDPID == "" {
+			sessionIDPID = config.DefaultIdentityProviderID
+		}
+		if sessionIDPID != idpID {
+			continue
+		}
+		if token.Subject != "" && s.GetIdToken().GetSubject() != token.Subject {
+			continue
+		}
+		matched = append(matched, s)
+	}
+	return matched, nil
+}
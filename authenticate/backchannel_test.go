@@ -0,0 +1,117 @@
+package authenticate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/identity/oidc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+type mockLogoutTokenProvider struct {
+	identity.MockProvider
+	tokens map[string]*oidc.LogoutToken
+}
+
+func (p mockLogoutTokenProvider) VerifyLogoutToken(ctx context.Context, rawLogoutToken string) (*oidc.LogoutToken, error) {
+	token, ok := p.tokens[rawLogoutToken]
+	if !ok {
+		return nil, oidc.ErrInvalidLogoutToken
+	}
+	return token, nil
+}
+
+func TestAuthenticate_BackChannelLogout(t *testing.T) {
+	t.Parallel()
+
+	allSessions := []*session.Session{
+		{Id: "S1", UserId: "mock/SUBJECT", IdpSessionId: "SID1", IdToken: &session.IDToken{Subject: "SUBJECT"}},
+		{Id: "S2", UserId: "mock/SUBJECT", IdpSessionId: "SID2", IdToken: &session.IDToken{Subject: "SUBJECT"}},
+		{Id: "S3", UserId: "mock/SUBJECT", IdpSessionId: "SID1", IdpId: "contractors", IdToken: &session.IDToken{Subject: "SUBJECT"}},
+		{Id: "S4", UserId: "mock/OTHER", IdpSessionId: "SID1", IdToken: &session.IDToken{Subject: "OTHER"}},
+	}
+	tokens := map[string]*oidc.LogoutToken{
+		"SUBJECT":         {Subject: "SUBJECT"},
+		"SESSION":         {SessionID: "SID1"},
+		"SUBJECT_SESSION": {Subject: "SUBJECT", SessionID: "SID1"},
+	}
+
+	tests := []struct {
+		name        string
+		idpID       string
+		logoutToken string
+		wantCode    int
+		wantDeleted []string
+	}{
+		{"subject", "", "SUBJECT", http.StatusOK, []string{"S1", "S2"}},
+		{"session id", "", "SESSION", http.StatusOK, []string{"S1", "S4"}},
+		{"subject and session id", "", "SUBJECT_SESSION", http.StatusOK, []string{"S1"}},
+		{"other identity provider", "contractors", "SUBJECT", http.StatusOK, []string{"S3"}},
+		{"invalid token", "", "INVALID", http.StatusBadRequest, nil},
+		{"unknown identity provider", "missing", "SUBJECT", http.StatusBadRequest, nil},
+		{"not supported", "saml", "SUBJECT", http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			a := testAuthenticate()
+			a.provider = identity.NewAtomicAuthenticator()
+			a.provider.Store(mockLogoutTokenProvider{tokens: tokens})
+			a.providers = newAtomicIdentityProviders()
+			a.providers.Store(map[string]identity.Authenticator{
+				"contractors": mockLogoutTokenProvider{tokens: tokens},
+				"saml":        identity.MockProvider{},
+			})
+			a.dataBrokerClient = mockDataBrokerServiceClient{
+				getAllByIndex: func(ctx context.Context, in *databroker.GetAllByIndexRequest, opts ...grpc.CallOption) (*databroker.GetAllByIndexResponse, error) {
+					res := new(databroker.GetAllByIndexResponse)
+					for _, s := range allSessions {
+						if (in.GetIndex() == "user_id" && s.GetUserId() == in.GetValue()) ||
+							(in.GetIndex() == "idp_session_id" && s.GetIdpSessionId() == in.GetValue()) {
+							data, err := ptypes.MarshalAny(s)
+							if err != nil {
+								return nil, err
+							}
+							res.Records = append(res.Records, &databroker.Record{Id: s.GetId(), Data: data})
+						}
+					}
+					return res, nil
+				},
+				delete: func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+					deleted = append(deleted, in.GetId())
+					return new(emptypb.Empty), nil
+				},
+			}
+
+			u := url.URL{Path: backChannelLogoutPath}
+			if tt.idpID != "" {
+				u.RawQuery = url.Values{"pomerium_idp_id": {tt.idpID}}.Encode()
+			}
+			form := url.Values{"logout_token": {tt.logoutToken}}
+			r := httptest.NewRequest(http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			httputil.HandlerFunc(a.BackChannelLogout).ServeHTTP(w, r)
+
+			require.Equal(t, tt.wantCode, w.Code, w.Body.String())
+			sort.Strings(deleted)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		})
+	}
+}
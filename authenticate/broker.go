@@ -0,0 +1,107 @@
+package authenticate
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// Broker issues a short-lived access token to a page embedded in an allowed
+// origin. The token is delivered to the page that opened, or framed, the
+// broker using window.postMessage, so it can only be received by that origin.
+// The token can be used with API routes as an `Authorization: Pomerium`
+// header.
+func (a *Authenticate) Broker(w http.ResponseWriter, r *http.Request) error {
+	ctx, span := trace.StartSpan(r.Context(), "authenticate.Broker")
+	defer span.End()
+
+	options := a.options.Load()
+	state := a.state.Load()
+
+	origin, err := getBrokerOrigin(r.FormValue(urlutil.QueryBrokerOrigin), options.AuthenticateBrokerAllowedOrigins)
+	if err != nil {
+		return err
+	}
+
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	expiry := time.Now().Add(options.AuthenticateBrokerTokenTTL)
+	if s.Expiry != nil && s.Expiry.Time().Before(expiry) {
+		expiry = s.Expiry.Time()
+	}
+	token := sessions.NewSession(s, state.redirectURL.Host, []string{state.redirectURL.Host})
+	token.Expiry = jwt.NewNumericDate(expiry)
+	rawToken, err := state.sharedEncoder.Marshal(token)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	log.FromRequest(r).Info().
+		Str("session-id", s.ID).
+		Str("origin", origin).
+		Time("expires-at", expiry).
+		Msg("authenticate: issued broker access token")
+
+	nonce := base64.RawURLEncoding.EncodeToString(cryptutil.NewKey())
+	w.Header().Set("Content-Security-Policy", fmt.Sprintf(
+		"default-src 'none'; script-src 'nonce-%s'; frame-ancestors %s", nonce, origin))
+	w.Header().Set("Cache-Control", "no-store")
+	err = a.templates.ExecuteTemplate(w, "broker.html", map[string]interface{}{
+		"Nonce":       nonce,
+		"Origin":      origin,
+		"AccessToken": string(rawToken),
+		"ExpiresAt":   expiry.Unix(),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("authenticate: error rendering broker")
+	}
+	return nil
+}
+
+// getBrokerOrigin returns the normalized origin if it's one of the allowed
+// origins.
+func getBrokerOrigin(rawOrigin string, allowedOrigins []string) (string, error) {
+	if rawOrigin == "" {
+		return "", httputil.NewError(http.StatusBadRequest, errors.New("missing origin"))
+	}
+	origin, err := normalizeOrigin(rawOrigin)
+	if err != nil {
+		return "", httputil.NewError(http.StatusBadRequest, err)
+	}
+	for _, allowedOrigin := range allowedOrigins {
+		if o, err := normalizeOrigin(allowedOrigin); err == nil && o == origin {
+			return origin, nil
+		}
+	}
+	return "", httputil.NewError(http.StatusForbidden, fmt.Errorf("origin %s is not allowed", origin))
+}
+
+func normalizeOrigin(rawOrigin string) (string, error) {
+	u, err := url.Parse(rawOrigin)
+	if err != nil {
+		return "", err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid origin %s", rawOrigin)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("invalid origin %s", rawOrigin)
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: strings.ToLower(u.Host)}).String(), nil
+}
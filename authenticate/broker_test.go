@@ -0,0 +1,155 @@
+package authenticate
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/frontend"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+func TestAuthenticate_Broker(t *testing.T) {
+	t.Parallel()
+
+	signer, err := jws.NewHS256Signer(nil, "mock")
+	require.NoError(t, err)
+	redirectURL, _ := url.Parse("https://authenticate.example.com/oauth2/callback")
+
+	tests := []struct {
+		name     string
+		origin   string
+		expiry   time.Duration
+		wantCode int
+		wantTTL  time.Duration
+	}{
+		{"good", "https://app.example.com", time.Hour, http.StatusOK, 5 * time.Minute},
+		{"good normalized", "https://APP.example.com/", time.Hour, http.StatusOK, 5 * time.Minute},
+		{"session expires before token", "https://app.example.com", time.Minute, http.StatusOK, time.Minute},
+		{"missing origin", "", time.Hour, http.StatusBadRequest, 0},
+		{"invalid origin", "javascript:alert(1)", time.Hour, http.StatusBadRequest, 0},
+		{"origin with path", "https://app.example.com/path", time.Hour, http.StatusBadRequest, 0},
+		{"not allowed origin", "https://evil.example.com", time.Hour, http.StatusForbidden, 0},
+		{"not allowed scheme", "http://app.example.com", time.Hour, http.StatusForbidden, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Authenticate{
+				state: newAtomicAuthenticateState(&authenticateState{
+					redirectURL:   redirectURL,
+					sharedEncoder: signer,
+				}),
+				templates: template.Must(frontend.NewTemplates()),
+				options:   config.NewAtomicOptions(),
+			}
+			a.options.Store(&config.Options{
+				AuthenticateBrokerAllowedOrigins: []string{"https://app.example.com"},
+				AuthenticateBrokerTokenTTL:       5 * time.Minute,
+			})
+
+			rawSession, err := signer.Marshal(&sessions.State{
+				ID:     "SESSION_ID",
+				Expiry: jwt.NewNumericDate(time.Now().Add(tt.expiry)),
+			})
+			require.NoError(t, err)
+
+			u := &url.URL{Path: "/.pomerium/broker"}
+			if tt.origin != "" {
+				u.RawQuery = url.Values{urlutil.QueryBrokerOrigin: {tt.origin}}.Encode()
+			}
+			r := httptest.NewRequest(http.MethodGet, u.String(), nil)
+			r = r.WithContext(sessions.NewContext(r.Context(), string(rawSession), nil))
+			w := httptest.NewRecorder()
+			httputil.HandlerFunc(a.Broker).ServeHTTP(w, r)
+
+			require.Equal(t, tt.wantCode, w.Code, w.Body.String())
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+			csp := w.Header().Get("Content-Security-Policy")
+			assert.Contains(t, csp, "frame-ancestors https://app.example.com")
+			nonce := regexp.MustCompile(`'nonce-([^']+)'`).FindStringSubmatch(csp)
+			require.Len(t, nonce, 2)
+
+			body := w.Body.String()
+			assert.Contains(t, body, `nonce="`+nonce[1]+`"`)
+			assert.Contains(t, body, `"https://app.example.com"`)
+
+			m := regexp.MustCompile(`access_token: "([^"]+)"`).FindStringSubmatch(body)
+			require.Len(t, m, 2)
+			var token sessions.State
+			require.NoError(t, signer.Unmarshal([]byte(m[1]), &token))
+			assert.Equal(t, "SESSION_ID", token.ID)
+			assert.Equal(t, jwt.Audience{"authenticate.example.com"}, token.Audience)
+			assert.WithinDuration(t, time.Now().Add(tt.wantTTL), token.Expiry.Time(), 5*time.Second)
+		})
+	}
+}
+
+func TestAuthenticate_Broker_NoSession(t *testing.T) {
+	t.Parallel()
+
+	signer, err := jws.NewHS256Signer(nil, "mock")
+	require.NoError(t, err)
+	a := &Authenticate{
+		state: newAtomicAuthenticateState(&authenticateState{
+			sharedEncoder: signer,
+		}),
+		options: config.NewAtomicOptions(),
+	}
+	a.options.Store(&config.Options{
+		AuthenticateBrokerAllowedOrigins: []string{"https://app.example.com"},
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/.pomerium/broker?"+url.Values{
+		urlutil.QueryBrokerOrigin: {"https://app.example.com"},
+	}.Encode(), nil)
+	r = r.WithContext(sessions.NewContext(r.Context(), "", sessions.ErrNoSessionFound))
+	w := httptest.NewRecorder()
+	httputil.HandlerFunc(a.Broker).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestNormalizeOrigin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"https://app.example.com", "https://app.example.com", false},
+		{"https://App.Example.com:8443/", "https://app.example.com:8443", false},
+		{"http://localhost:3000", "http://localhost:3000", false},
+		{"https://app.example.com/path", "", true},
+		{"https://app.example.com?x=y", "", true},
+		{"https://user@app.example.com", "", true},
+		{"ftp://app.example.com", "", true},
+		{"app.example.com", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		got, err := normalizeOrigin(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got)
+	}
+}
@@ -0,0 +1,463 @@
+package authenticate
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pomerium/csrf"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// deviceCodeGrantType is the grant type of device access token requests.
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// devicePollInterval is the default minimum interval between polls of the
+// device token endpoint, and how much it's increased by when a client polls
+// too often. https://tools.ietf.org/html/rfc8628#section-3.5
+const devicePollInterval = 5 * time.Second
+
+// Device authorization grant endpoints. The client endpoints are called
+// without a session and are exempt from CSRF protection.
+const (
+	deviceAuthorizationPath = "/.pomerium/device/code"
+	deviceTokenPath         = "/.pomerium/device/token"
+	deviceVerificationPath  = "/.pomerium/device"
+)
+
+// deviceAuthorizationsPerMinute and deviceAuthorizationBurst are how many
+// device authorizations each client IP can register.
+const (
+	deviceAuthorizationsPerMinute = 5
+	deviceAuthorizationBurst      = 20
+)
+
+func newDeviceRateLimiter() *keyedRateLimiter {
+	return newKeyedRateLimiter(rate.Limit(deviceAuthorizationsPerMinute)/60, deviceAuthorizationBurst)
+}
+
+// errDeviceAuthorizationUnavailable is returned by the device authorization
+// grant endpoints when there's no databroker to store grants in.
+var errDeviceAuthorizationUnavailable = errors.New("device authorization requires the databroker")
+
+// Device access token errors. https://tools.ietf.org/html/rfc8628#section-3.5
+const (
+	deviceErrAuthorizationPending = "authorization_pending"
+	deviceErrSlowDown             = "slow_down"
+	deviceErrAccessDenied         = "access_denied"
+	deviceErrExpiredToken         = "expired_token"
+	deviceErrInvalidGrant         = "invalid_grant"
+	deviceErrInvalidRequest       = "invalid_request"
+	deviceErrUnsupportedGrantType = "unsupported_grant_type"
+)
+
+// DeviceAuthorization is the device authorization endpoint of the OAuth 2.0
+// device authorization grant. It returns a device code for the client to poll
+// the token endpoint with, and a user code for a signed in user to approve at
+// the verification URL. The codes are sealed in the device code, and nothing
+// is saved to the databroker until the client polls with it.
+//
+// https://tools.ietf.org/html/rfc8628#section-3.1
+func (a *Authenticate) DeviceAuthorization(w http.ResponseWriter, r *http.Request) error {
+	_, span := trace.StartSpan(r.Context(), "authenticate.DeviceAuthorization")
+	defer span.End()
+
+	if a.dataBrokerClient == nil {
+		return httputil.NewError(http.StatusNotFound, errDeviceAuthorizationUnavailable)
+	}
+	options := a.options.Load()
+	state := a.state.Load()
+
+	userCode, err := kiosk.NewUserCode()
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	now := time.Now()
+	dc := &deviceCode{
+		Key:         cryptutil.NewBase64Key(),
+		UserCode:    userCode,
+		ClientID:    r.FormValue("client_id"),
+		UserAgent:   r.UserAgent(),
+		IPAddress:   getClientIP(r),
+		RequestedAt: now.Unix(),
+		ExpiresAt:   now.Add(options.DeviceAuthorizationCodeTTL).Unix(),
+	}
+	bs, err := json.Marshal(dc)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	verificationURL := state.redirectURL.ResolveReference(&url.URL{Path: deviceVerificationPath})
+	verificationURLComplete := *verificationURL
+	verificationURLComplete.RawQuery = url.Values{urlutil.QueryDeviceUserCode: {userCode}}.Encode()
+	return writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"device_code":               base64.RawURLEncoding.EncodeToString(cryptutil.Encrypt(state.cookieCipher, bs, nil)),
+		"user_code":                 userCode,
+		"verification_uri":          verificationURL.String(),
+		"verification_uri_complete": verificationURLComplete.String(),
+		"expires_in":                int64(options.DeviceAuthorizationCodeTTL.Seconds()),
+		"interval":                  int64(devicePollInterval.Seconds()),
+	})
+}
+
+// DeviceToken is the token endpoint of the OAuth 2.0 device authorization
+// grant. Clients poll it with their device code until the user code is
+// approved, and are then issued a Pomerium session JWT as the access token.
+//
+// https://tools.ietf.org/html/rfc8628#section-3.4
+func (a *Authenticate) DeviceToken(w http.ResponseWriter, r *http.Request) error {
+	ctx, span := trace.StartSpan(r.Context(), "authenticate.DeviceToken")
+	defer span.End()
+
+	if a.dataBrokerClient == nil {
+		return httputil.NewError(http.StatusNotFound, errDeviceAuthorizationUnavailable)
+	}
+	if r.FormValue("grant_type") != deviceCodeGrantType {
+		return writeOAuthError(w, deviceErrUnsupportedGrantType)
+	}
+	rawDeviceCode := r.FormValue("device_code")
+	if rawDeviceCode == "" {
+		return writeOAuthError(w, deviceErrInvalidRequest)
+	}
+	dc, err := a.openDeviceCode(rawDeviceCode)
+	if err != nil {
+		return writeOAuthError(w, deviceErrInvalidGrant)
+	}
+
+	now := time.Now()
+	id := kiosk.DeviceID(rawDeviceCode)
+	authorization, version, err := device.GetWithVersion(ctx, a.dataBrokerClient, id)
+	if err != nil {
+		if !dc.isPending(now) {
+			return writeOAuthError(w, deviceErrExpiredToken)
+		}
+		// the session is saved under the authorization's id, so a code
+		// which was already exchanged isn't registered again
+		if _, err := session.Get(ctx, a.dataBrokerClient, id); err == nil {
+			return writeOAuthError(w, deviceErrInvalidGrant)
+		}
+		// the client kept its device code, so register it for approval
+		if err := a.registerDeviceAuthorization(ctx, r, dc, id); err != nil {
+			return err
+		}
+		return writeOAuthError(w, deviceErrAuthorizationPending)
+	}
+
+	switch {
+	case authorization.GetDeniedAt() != nil:
+		a.deleteDeviceAuthorization(ctx, authorization)
+		return writeOAuthError(w, deviceErrAccessDenied)
+	case authorization.IsExpired(now):
+		a.deleteDeviceAuthorization(ctx, authorization)
+		return writeOAuthError(w, deviceErrExpiredToken)
+	case authorization.GetApprovedAt() == nil:
+		errCode := deviceErrAuthorizationPending
+		interval := time.Duration(authorization.GetInterval()) * time.Second
+		if polledAt := authorization.GetPolledAt(); polledAt != nil && now.Sub(polledAt.AsTime()) < interval {
+			errCode = deviceErrSlowDown
+			authorization.Interval += int64(devicePollInterval.Seconds())
+		}
+		authorization.PolledAt = timestamppb.New(now)
+		// don't overwrite an approval or denial made since it was read
+		_, err := device.CompareAndSet(ctx, a.dataBrokerClient, authorization, version)
+		if err != nil && !errors.Is(err, device.ErrChanged) {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+		return writeOAuthError(w, errCode)
+	}
+
+	// the device code can only be exchanged once, so it's consumed before the
+	// session is issued, and only one of any concurrent polls succeeds
+	if err := device.CompareAndDelete(ctx, a.dataBrokerClient, authorization.GetId(), version); errors.Is(err, device.ErrChanged) {
+		return writeOAuthError(w, deviceErrInvalidGrant)
+	} else if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	pbSession, err := session.Get(ctx, a.dataBrokerClient, authorization.GetSessionId())
+	if err != nil {
+		return writeOAuthError(w, deviceErrExpiredToken)
+	}
+	accessToken, err := a.state.Load().sharedEncoder.Marshal(a.newDeviceSessionState(pbSession, authorization))
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	logDeviceAuthorization(authorization, "issued")
+
+	return writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"access_token": string(accessToken),
+		"token_type":   httputil.AuthorizationTypePomerium,
+		"expires_in":   int64(time.Until(pbSession.GetExpiresAt().AsTime()).Seconds()),
+	})
+}
+
+// DeviceVerification renders the verification page, where signed in users
+// enter the user code shown by a device and approve or deny its sign in.
+func (a *Authenticate) DeviceVerification(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	if a.dataBrokerClient == nil {
+		return httputil.NewError(http.StatusNotFound, errDeviceAuthorizationUnavailable)
+	}
+
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	u, err := a.getSessionUser(ctx, s.ID)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+
+	input := map[string]interface{}{
+		"Email":         u.GetEmail(),
+		"UserCodeParam": urlutil.QueryDeviceUserCode,
+		"csrfField":     csrf.TemplateField(r),
+	}
+	if r.FormValue(urlutil.QueryDeviceUserCode) != "" {
+		authorization, err := a.getPendingDeviceAuthorization(ctx, r)
+		if err != nil {
+			return err
+		}
+		input["Authorization"] = authorization
+	}
+	return a.renderDeviceVerification(w, input)
+}
+
+// ApproveDeviceAuthorization approves the pending device authorization with
+// the given user code, creating a session for the device which acts as the
+// signed in user.
+func (a *Authenticate) ApproveDeviceAuthorization(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	if a.dataBrokerClient == nil {
+		return httputil.NewError(http.StatusNotFound, errDeviceAuthorizationUnavailable)
+	}
+	options := a.options.Load()
+	state := a.state.Load()
+
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	approver, err := a.getDataBrokerSession(ctx, s)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	// kiosk devices are limited to their routes, so can't sign in other devices
+	if kiosk.IsUserID(approver.GetUserId()) {
+		return httputil.NewError(http.StatusForbidden, errors.New("kiosk devices can't approve device sign ins"))
+	}
+	authorization, err := a.getPendingDeviceAuthorization(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := timestamppb.New(now.Add(options.DeviceAuthorizationSessionTTL))
+	pbSession := &session.Session{
+		Id:        authorization.GetId(),
+		UserId:    approver.GetUserId(),
+		ExpiresAt: expiresAt,
+		IdToken: &session.IDToken{
+			Issuer:    state.redirectURL.Hostname(),
+			Subject:   approver.GetIdToken().GetSubject(),
+			ExpiresAt: expiresAt,
+			IssuedAt:  timestamppb.New(now),
+		},
+		Claims:       approver.GetClaims(),
+		IdpId:        approver.GetIdpId(),
+		IdpSessionId: approver.GetIdpSessionId(),
+	}
+	if _, err := session.Set(ctx, a.dataBrokerClient, pbSession); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	authorization.ApprovedBy = approver.GetUserId()
+	authorization.ApprovedAt = timestamppb.New(now)
+	authorization.SessionId = pbSession.GetId()
+	if _, err := device.Set(ctx, a.dataBrokerClient, authorization); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	logDeviceAuthorization(authorization, "approved")
+
+	return a.renderDeviceVerification(w, map[string]interface{}{
+		"Message": "The device is signed in. You can close this page and return to your device.",
+	})
+}
+
+// DenyDeviceAuthorization denies the pending device authorization with the
+// given user code.
+func (a *Authenticate) DenyDeviceAuthorization(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	authorization, err := a.getPendingDeviceAuthorization(ctx, r)
+	if err != nil {
+		return err
+	}
+	authorization.DeniedAt = timestamppb.Now()
+	if _, err := device.Set(ctx, a.dataBrokerClient, authorization); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	logDeviceAuthorization(authorization, "denied")
+
+	return a.renderDeviceVerification(w, map[string]interface{}{
+		"Message": "The device sign in was denied.",
+	})
+}
+
+func (a *Authenticate) getPendingDeviceAuthorization(ctx context.Context, r *http.Request) (*device.Authorization, error) {
+	if a.dataBrokerClient == nil {
+		return nil, httputil.NewError(http.StatusNotFound, errDeviceAuthorizationUnavailable)
+	}
+	userCode := kiosk.NormalizeUserCode(r.FormValue(urlutil.QueryDeviceUserCode))
+	authorization, err := device.GetByUserCode(ctx, a.dataBrokerClient, userCode, time.Now())
+	if err != nil {
+		return nil, httputil.NewError(http.StatusNotFound, err)
+	}
+	return authorization, nil
+}
+
+// registerDeviceAuthorization saves a pending authorization from its device
+// code so that it can be approved. Each client may only register a few
+// authorizations a minute.
+func (a *Authenticate) registerDeviceAuthorization(ctx context.Context, r *http.Request, dc *deviceCode, id string) error {
+	if !a.deviceLimiter.Allow(getClientIP(r)) {
+		return httputil.NewError(http.StatusTooManyRequests, errors.New("too many device authorizations requested"))
+	}
+	authorization := dc.newAuthorization(id, time.Now())
+	_, err := device.Create(ctx, a.dataBrokerClient, authorization)
+	if errors.Is(err, device.ErrExists) {
+		// a concurrent poll registered it first
+		return nil
+	} else if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	logDeviceAuthorization(authorization, "requested")
+	return nil
+}
+
+func (a *Authenticate) openDeviceCode(rawDeviceCode string) (*deviceCode, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(rawDeviceCode)
+	if err != nil {
+		return nil, err
+	}
+	bs, err = cryptutil.Decrypt(a.state.Load().cookieCipher, bs, nil)
+	if err != nil {
+		return nil, err
+	}
+	var dc deviceCode
+	if err := json.Unmarshal(bs, &dc); err != nil {
+		return nil, err
+	}
+	return &dc, nil
+}
+
+func (a *Authenticate) deleteDeviceAuthorization(ctx context.Context, authorization *device.Authorization) {
+	if err := device.Delete(ctx, a.dataBrokerClient, authorization.GetId()); err != nil {
+		log.Warn().Err(err).Str("id", authorization.GetId()).Msg("authenticate: failed to delete device authorization")
+	}
+}
+
+// newDeviceSessionState returns the session state of an approved device.
+func (a *Authenticate) newDeviceSessionState(pbSession *session.Session, authorization *device.Authorization) *sessions.State {
+	state := a.state.Load()
+	s := &sessions.State{
+		ID:                 pbSession.GetId(),
+		Subject:            pbSession.GetIdToken().GetSubject(),
+		IdentityProviderID: pbSession.GetIdpId(),
+		Programmatic:       true,
+	}
+	ns := sessions.NewSession(s, state.redirectURL.Hostname(), []string{state.redirectURL.Hostname()})
+	ns.Expiry = jwt.NewNumericDate(pbSession.GetExpiresAt().AsTime())
+	ns.AuthTime = jwt.NewNumericDate(authorization.GetApprovedAt().AsTime())
+	return &ns
+}
+
+func (a *Authenticate) renderDeviceVerification(w http.ResponseWriter, input map[string]interface{}) error {
+	w.Header().Set("Cache-Control", "no-store")
+	err := a.templates.ExecuteTemplate(w, "device.html", input)
+	if err != nil {
+		log.Warn().Err(err).Msg("authenticate: error rendering device verification")
+	}
+	return nil
+}
+
+// deviceCode is what's sealed in the device code given to a client.
+type deviceCode struct {
+	Key         string `json:"key"`
+	UserCode    string `json:"user_code"`
+	ClientID    string `json:"client_id,omitempty"`
+	UserAgent   string `json:"user_agent,omitempty"`
+	IPAddress   string `json:"ip_address,omitempty"`
+	RequestedAt int64  `json:"requested_at"`
+	ExpiresAt   int64  `json:"expires_at"`
+}
+
+// isPending reports whether the user code can still be approved.
+func (dc *deviceCode) isPending(now time.Time) bool {
+	return now.Before(time.Unix(dc.ExpiresAt, 0))
+}
+
+func (dc *deviceCode) newAuthorization(id string, now time.Time) *device.Authorization {
+	return &device.Authorization{
+		Id:          id,
+		UserCode:    dc.UserCode,
+		ClientId:    dc.ClientID,
+		UserAgent:   dc.UserAgent,
+		IpAddress:   dc.IPAddress,
+		RequestedAt: timestamppb.New(time.Unix(dc.RequestedAt, 0)),
+		ExpiresAt:   timestamppb.New(time.Unix(dc.ExpiresAt, 0)),
+		Interval:    int64(devicePollInterval.Seconds()),
+		PolledAt:    timestamppb.New(now),
+	}
+}
+
+// writeOAuthError writes an OAuth 2.0 error response.
+// https://tools.ietf.org/html/rfc6749#section-5.2
+func writeOAuthError(w http.ResponseWriter, errCode string) error {
+	return writeJSONResponse(w, http.StatusBadRequest, map[string]interface{}{
+		"error": errCode,
+	})
+}
+
+func writeJSONResponse(w http.ResponseWriter, status int, v interface{}) error {
+	jBytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "%s", jBytes)
+	return nil
+}
+
+func logDeviceAuthorization(authorization *device.Authorization, action string) {
+	log.Info().
+		Str("service", "authenticate").
+		Str("id", authorization.GetId()).
+		Str("user-code", authorization.GetUserCode()).
+		Str("client-id", authorization.GetClientId()).
+		Str("ip-address", authorization.GetIpAddress()).
+		Str("approved-by", authorization.GetApprovedBy()).
+		Str("session-id", authorization.GetSessionId()).
+		Msgf("device authorization %s", action)
+}
@@ -0,0 +1,228 @@
+package authenticate
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+var csrfFieldRE = regexp.MustCompile(`name="(gorilla\.csrf\.Token)" value="([^"]+)"`)
+
+func TestAuthenticate_DeviceAuthorizationGrant(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	db := newMockDataBroker(0)
+	h := newStatelessTestInstance(t, cfg, db.client())
+
+	// sign in the user approving the device
+	_, res := signInAcrossInstances(t, h, h)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	userCookies := res.Cookies()
+
+	requestCode := func(t *testing.T) (deviceCode, userCode, verificationURL string) {
+		res := servePostForm(h, "https://auth.example.com/.pomerium/device/code", url.Values{"client_id": {"kubectl"}}, nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		var body struct {
+			DeviceCode              string `json:"device_code"`
+			UserCode                string `json:"user_code"`
+			VerificationURI         string `json:"verification_uri"`
+			VerificationURIComplete string `json:"verification_uri_complete"`
+			ExpiresIn               int64  `json:"expires_in"`
+			Interval                int64  `json:"interval"`
+		}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		assert.Equal(t, "https://auth.example.com/.pomerium/device", body.VerificationURI)
+		assert.Equal(t, int64(600), body.ExpiresIn)
+		assert.Equal(t, int64(5), body.Interval)
+		return body.DeviceCode, body.UserCode, body.VerificationURIComplete
+	}
+	pollToken := func(t *testing.T, deviceCode string) (int, map[string]interface{}) {
+		res := servePostForm(h, "https://auth.example.com/.pomerium/device/token", url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {deviceCode},
+		}, nil)
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		return res.StatusCode, body
+	}
+	// decide approves or denies a user code from the verification page
+	decide := func(t *testing.T, verificationURL, action string) {
+		res := serveStateless(h, verificationURL, userCookies)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		page, _ := ioutil.ReadAll(res.Body)
+		assert.Contains(t, string(page), "kubectl")
+		m := csrfFieldRE.FindStringSubmatch(string(page))
+		require.Len(t, m, 3, "should render a csrf field")
+
+		form := mustParseURL(t, verificationURL).Query()
+		form.Set(m[1], m[2])
+		res = servePostForm(h, "https://auth.example.com/.pomerium/device/"+action, form, append(userCookies, res.Cookies()...))
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	t.Run("approved", func(t *testing.T) {
+		deviceCode, userCode, verificationURL := requestCode(t)
+		assert.Equal(t, url.Values{urlutil.QueryDeviceUserCode: {userCode}}, mustParseURL(t, verificationURL).Query())
+		_, err := device.Get(context.Background(), db.client(), kiosk.DeviceID(deviceCode))
+		assert.Error(t, err, "nothing should be saved until the client polls")
+
+		status, body := pollToken(t, deviceCode)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "authorization_pending", body["error"])
+		status, body = pollToken(t, deviceCode)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "slow_down", body["error"], "polling too often should slow the client down")
+		authorization, err := device.Get(context.Background(), db.client(), kiosk.DeviceID(deviceCode))
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), authorization.GetInterval())
+
+		decide(t, verificationURL, "approve")
+
+		status, body = pollToken(t, deviceCode)
+		require.Equal(t, http.StatusOK, status)
+		assert.Equal(t, httputil.AuthorizationTypePomerium, body["token_type"])
+		assert.InDelta(t, cfg.Options.DeviceAuthorizationSessionTTL.Seconds(), body["expires_in"], 5)
+
+		var s struct {
+			ID string `json:"jti"`
+		}
+		state, err := newAuthenticateStateFromConfig(cfg)
+		require.NoError(t, err)
+		require.NoError(t, state.sharedEncoder.Unmarshal([]byte(body["access_token"].(string)), &s))
+		pbSession, err := session.Get(context.Background(), db.client(), s.ID)
+		require.NoError(t, err, "should create a session for the device")
+		assert.NotEmpty(t, pbSession.GetUserId())
+		assert.Nil(t, pbSession.GetOauthToken())
+
+		status, body = pollToken(t, deviceCode)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalid_grant", body["error"], "device codes can only be exchanged once")
+	})
+	t.Run("denied", func(t *testing.T) {
+		deviceCode, _, verificationURL := requestCode(t)
+		status, _ := pollToken(t, deviceCode)
+		require.Equal(t, http.StatusBadRequest, status)
+		decide(t, verificationURL, "deny")
+
+		status, body := pollToken(t, deviceCode)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "access_denied", body["error"])
+	})
+	t.Run("concurrent exchange", func(t *testing.T) {
+		deviceCode, _, verificationURL := requestCode(t)
+		status, _ := pollToken(t, deviceCode)
+		require.Equal(t, http.StatusBadRequest, status)
+		decide(t, verificationURL, "approve")
+
+		var wg sync.WaitGroup
+		statuses := make([]int, 5)
+		for i := range statuses {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				res := servePostForm(h, "https://auth.example.com/.pomerium/device/token", url.Values{
+					"grant_type":  {deviceCodeGrantType},
+					"device_code": {deviceCode},
+				}, nil)
+				statuses[i] = res.StatusCode
+			}(i)
+		}
+		wg.Wait()
+		var issued int
+		for _, status := range statuses {
+			if status == http.StatusOK {
+				issued++
+			}
+		}
+		assert.Equal(t, 1, issued, "only one poll should be issued a session")
+	})
+	t.Run("invalid device code", func(t *testing.T) {
+		status, body := pollToken(t, "not-a-device-code")
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalid_grant", body["error"])
+	})
+	t.Run("expired", func(t *testing.T) {
+		deviceCode, _, _ := requestCode(t)
+		status, _ := pollToken(t, deviceCode)
+		require.Equal(t, http.StatusBadRequest, status)
+		authorization, err := device.Get(context.Background(), db.client(), kiosk.DeviceID(deviceCode))
+		require.NoError(t, err)
+		authorization.ExpiresAt = timestamppb.New(time.Now().Add(-time.Second))
+		_, err = device.Set(context.Background(), db.client(), authorization)
+		require.NoError(t, err)
+
+		status, body := pollToken(t, deviceCode)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "expired_token", body["error"])
+	})
+	t.Run("unsupported grant type", func(t *testing.T) {
+		res := servePostForm(h, "https://auth.example.com/.pomerium/device/token", url.Values{
+			"grant_type": {"authorization_code"},
+			"code":       {"CODE"},
+		}, nil)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+	t.Run("unknown user code", func(t *testing.T) {
+		res := serveStateless(h, "https://auth.example.com/.pomerium/device?"+url.Values{
+			urlutil.QueryDeviceUserCode: {"XXXX-XXXX"},
+		}.Encode(), userCookies)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestAuthenticate_DeviceAuthorizationRateLimit(t *testing.T) {
+	t.Parallel()
+
+	db := newMockDataBroker(0)
+	h := newStatelessTestInstance(t, newStatelessTestConfig(t), db.client())
+
+	register := func() int {
+		res := servePostForm(h, "https://auth.example.com/.pomerium/device/code", nil, nil)
+		require.Equal(t, http.StatusOK, res.StatusCode, "requesting codes should never be limited")
+		var body struct {
+			DeviceCode string `json:"device_code"`
+		}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		res = servePostForm(h, "https://auth.example.com/.pomerium/device/token", url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {body.DeviceCode},
+		}, nil)
+		return res.StatusCode
+	}
+	for i := 0; i < deviceAuthorizationBurst; i++ {
+		require.Equal(t, http.StatusBadRequest, register(), "should be pending")
+	}
+	assert.Equal(t, http.StatusTooManyRequests, register())
+}
+
+func servePostForm(h http.Handler, rawURL string, form url.Values, cookies []*http.Cookie) *http.Response {
+	u, _ := url.Parse(rawURL)
+	r := httptest.NewRequest(http.MethodPost, u.RequestURI(), strings.NewReader(form.Encode()))
+	r.Host = u.Host
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Result()
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,22 +18,40 @@ import (
 	"github.com/pomerium/csrf"
 	"github.com/rs/cors"
 	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/square/go-jose.v2/jwt"
 
+	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/identity/ldap"
 	"github.com/pomerium/pomerium/internal/identity/manager"
 	"github.com/pomerium/pomerium/internal/identity/oidc"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/middleware"
 	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/impersonation"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 )
 
+// errSessionTooOld is returned when a route requires a more recent sign in
+// than the session's.
+var errSessionTooOld = errors.New("session is too old")
+
+// errSessionIdle is returned when a session hasn't been used within the
+// cookie idle timeout.
+var errSessionIdle = errors.New("session is idle")
+
+// errSAMLNotConfigured is returned for the SAML metadata when the identity
+// provider doesn't use SAML.
+var errSAMLNotConfigured = errors.New("identity provider doesn't use SAML")
+
 // Handler returns the authenticate service's handler chain.
 func (a *Authenticate) Handler() http.Handler {
 	r := httputil.NewRouter()
@@ -44,6 +63,10 @@ func (a *Authenticate) Handler() http.Handler {
 func (a *Authenticate) Mount(r *mux.Router) {
 	r.StrictSlash(true)
 	r.Use(middleware.SetHeaders(httputil.HeadersContentSecurityPolicy))
+	// SAML responses are re-posted before the CSRF check
+	r.Use(a.relaySAMLResponse)
+	// endpoints called by CLIs and identity providers rather than browsers
+	r.Use(skipCSRFCheck(deviceAuthorizationPath, deviceTokenPath, backChannelLogoutPath, scimPath+"/", programmaticRefreshPath))
 	r.Use(func(h http.Handler) http.Handler {
 		options := a.options.Load()
 		state := a.state.Load()
@@ -60,7 +83,20 @@ func (a *Authenticate) Mount(r *mux.Router) {
 
 	r.Path("/robots.txt").HandlerFunc(a.RobotsTxt).Methods(http.MethodGet)
 	// Identity Provider (IdP) endpoints
-	r.Path("/oauth2/callback").Handler(httputil.HandlerFunc(a.OAuthCallback)).Methods(http.MethodGet)
+	r.Path("/oauth2/callback").Handler(httputil.HandlerFunc(a.OAuthCallback)).Methods(http.MethodGet, http.MethodPost)
+
+	// kiosk devices sign in without a session
+	r.Path("/.pomerium/kiosk").Handler(httputil.HandlerFunc(a.Kiosk)).Methods(http.MethodGet)
+
+	// device authorization grant clients sign in without a session
+	r.Path(deviceAuthorizationPath).Handler(httputil.HandlerFunc(a.DeviceAuthorization)).Methods(http.MethodPost)
+	r.Path(deviceTokenPath).Handler(httputil.HandlerFunc(a.DeviceToken)).Methods(http.MethodPost)
+
+	// identity providers log users out without a session
+	r.Path(backChannelLogoutPath).Handler(httputil.HandlerFunc(a.BackChannelLogout)).Methods(http.MethodPost)
+
+	// identity providers push user and group changes without a session
+	r.PathPrefix(scimPath + "/").Handler(httputil.HandlerFunc(a.SCIM))
 
 	// Proxy service endpoints
 	v := r.PathPrefix("/.pomerium").Subrouter()
@@ -84,10 +120,20 @@ func (a *Authenticate) Mount(r *mux.Router) {
 	v.Path("/").Handler(httputil.HandlerFunc(a.Dashboard))
 	v.Path("/sign_in").Handler(httputil.HandlerFunc(a.SignIn))
 	v.Path("/sign_out").Handler(httputil.HandlerFunc(a.SignOut))
+	v.Path("/broker").Handler(httputil.HandlerFunc(a.Broker)).Methods(http.MethodGet)
 	v.Path("/admin/impersonate").Handler(httputil.HandlerFunc(a.Impersonate)).Methods(http.MethodPost)
+	v.Path("/admin/impersonate/approve").Handler(httputil.HandlerFunc(a.ApproveImpersonation)).Methods(http.MethodPost)
+	v.Path("/admin/kiosk/approve").Handler(httputil.HandlerFunc(a.ApproveKioskDevice)).Methods(http.MethodPost)
+	v.Path("/admin/kiosk/revoke").Handler(httputil.HandlerFunc(a.RevokeKioskDevice)).Methods(http.MethodPost)
+	v.Path("/roles/assume").Handler(httputil.HandlerFunc(a.AssumeRole)).Methods(http.MethodPost)
+	v.Path("/roles/drop").Handler(httputil.HandlerFunc(a.DropRole)).Methods(http.MethodPost)
+	v.Path("/device").Handler(httputil.HandlerFunc(a.DeviceVerification)).Methods(http.MethodGet)
+	v.Path("/device/approve").Handler(httputil.HandlerFunc(a.ApproveDeviceAuthorization)).Methods(http.MethodPost)
+	v.Path("/device/deny").Handler(httputil.HandlerFunc(a.DenyDeviceAuthorization)).Methods(http.MethodPost)
 
 	wk := r.PathPrefix("/.well-known/pomerium").Subrouter()
 	wk.Path("/jwks.json").Handler(httputil.HandlerFunc(a.jwks)).Methods(http.MethodGet)
+	wk.Path("/saml/metadata").Handler(httputil.HandlerFunc(a.samlMetadata)).Methods(http.MethodGet)
 	wk.Path("/").Handler(httputil.HandlerFunc(a.wellKnown)).Methods(http.MethodGet)
 
 	// programmatic access api endpoint
@@ -95,6 +141,23 @@ func (a *Authenticate) Mount(r *mux.Router) {
 	api.Use(func(h http.Handler) http.Handler {
 		return sessions.RetrieveSession(a.state.Load().sessionLoaders...)(h)
 	})
+	api.Path("/v1/refresh").Handler(httputil.HandlerFunc(a.ProgrammaticRefresh)).Methods(http.MethodPost)
+}
+
+// skipCSRFCheck exempts the endpoints with the given paths from CSRF
+// protection. Paths ending with a slash exempt every endpoint under them.
+func skipCSRFCheck(paths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, p := range paths {
+				if r.URL.Path == p || strings.HasSuffix(p, "/") && strings.HasPrefix(r.URL.Path, p) {
+					r = csrf.UnsafeSkipCheck(r)
+					break
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 // Well-Known Uniform Resource Identifiers (URIs)
@@ -108,10 +171,15 @@ func (a *Authenticate) wellKnown(w http.ResponseWriter, r *http.Request) error {
 		JSONWebKeySetURL       string `json:"jwks_uri"`
 		OAuth2Callback         string `json:"authentication_callback_endpoint"`
 		ProgrammaticRefreshAPI string `json:"api_refresh_endpoint"`
+		// RFC8628 device authorization grant endpoints
+		DeviceAuthorization string `json:"device_authorization_endpoint"`
+		DeviceToken         string `json:"device_token_endpoint"`
 	}{
 		state.redirectURL.ResolveReference(&url.URL{Path: "/.well-known/pomerium/jwks.json"}).String(),
 		state.redirectURL.ResolveReference(&url.URL{Path: "/oauth2/callback"}).String(),
-		state.redirectURL.ResolveReference(&url.URL{Path: "/api/v1/refresh"}).String(),
+		state.redirectURL.ResolveReference(&url.URL{Path: programmaticRefreshPath}).String(),
+		state.redirectURL.ResolveReference(&url.URL{Path: deviceAuthorizationPath}).String(),
+		state.redirectURL.ResolveReference(&url.URL{Path: deviceTokenPath}).String(),
 	}
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("X-Content-Type-Options", "nosniff")
@@ -145,17 +213,55 @@ func (a *Authenticate) VerifySession(next http.Handler) http.Handler {
 		ctx, span := trace.StartSpan(r.Context(), "authenticate.VerifySession")
 		defer span.End()
 		sessionState, err := a.getSessionFromCtx(ctx)
+		if err != nil {
+			// approved kiosk devices are signed in again without the identity provider
+			if raw, kioskErr := a.restoreKioskSession(w, r); kioskErr == nil {
+				ctx = sessions.NewContext(ctx, raw, nil)
+				sessionState, err = a.getSessionFromCtx(ctx)
+			}
+		}
 		if err != nil {
 			log.FromRequest(r).Info().Err(err).Msg("authenticate: session load error")
 			return a.reauthenticateOrFail(w, r, err)
 		}
 
+		// routes with a maximum session age require a recent sign in
+		if maxAge, ok := getSessionMaxAge(r); ok && !sessionState.AuthenticatedWithin(maxAge) {
+			log.FromRequest(r).Info().Str("id", sessionState.ID).Dur("max-age", maxAge).Msg("authenticate: session is too old")
+			return a.reauthenticateOrFail(w, r, errSessionTooOld)
+		}
+
+		// routes which require a step-up authentication require a session
+		// which signed in with it
+		if acrValues, amrValues, ok := getStepUpRequirement(r); ok && !sessionState.SatisfiesStepUp(acrValues, amrValues) {
+			if isSignedInSinceRedirect(sessionState, r) {
+				log.FromRequest(r).Info().Str("id", sessionState.ID).Str("acr", sessionState.ACR).Strs("amr", sessionState.AMR).Msg("authenticate: step-up authentication not performed")
+				return httputil.NewError(http.StatusForbidden, errStepUpNotPerformed)
+			}
+			log.FromRequest(r).Info().Str("id", sessionState.ID).Strs("acr_values", acrValues).Strs("amr_values", amrValues).Msg("authenticate: step-up authentication required")
+			return a.reauthenticateOrFail(w, r, errStepUpRequired)
+		}
+
+		// routes which require an identity provider require a session from it
+		if idpID := r.FormValue(urlutil.QueryIdentityProviderID); idpID != "" && !isSessionFromIdentityProvider(sessionState, idpID) {
+			log.FromRequest(r).Info().Str("id", sessionState.ID).Str("idp_id", idpID).Msg("authenticate: session is from a different identity provider")
+			return a.reauthenticateOrFail(w, r, errIdentityProviderMismatch)
+		}
+
 		if a.dataBrokerClient != nil {
-			_, err = session.Get(ctx, a.dataBrokerClient, sessionState.ID)
+			s, err := a.getDataBrokerSession(ctx, sessionState)
 			if err != nil {
 				log.FromRequest(r).Info().Err(err).Str("id", sessionState.ID).Msg("authenticate: session not found in databroker")
 				return a.reauthenticateOrFail(w, r, err)
 			}
+			// idle sessions are revoked, so they can't be used to sign in again
+			if s.IsIdle(time.Now(), a.options.Load().CookieIdleTimeout) {
+				log.FromRequest(r).Info().Str("id", sessionState.ID).Msg("authenticate: session is idle")
+				if err := session.Delete(ctx, a.dataBrokerClient, s.GetId()); err != nil {
+					log.FromRequest(r).Warn().Err(err).Str("id", sessionState.ID).Msg("authenticate: failed to delete idle session")
+				}
+				return a.reauthenticateOrFail(w, r, errSessionIdle)
+			}
 		}
 
 		next.ServeHTTP(w, r.WithContext(ctx))
@@ -163,6 +269,16 @@ func (a *Authenticate) VerifySession(next http.Handler) http.Handler {
 	})
 }
 
+// getSessionMaxAge returns the maximum session age required by the route the
+// user is signing in to, if any.
+func getSessionMaxAge(r *http.Request) (time.Duration, bool) {
+	secs, err := strconv.ParseInt(r.FormValue(urlutil.QuerySessionMaxAge), 10, 64)
+	if err != nil || secs <= 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
 // RobotsTxt handles the /robots.txt route.
 func (a *Authenticate) RobotsTxt(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
@@ -219,6 +335,9 @@ func (a *Authenticate) SignIn(w http.ResponseWriter, r *http.Request) error {
 	// user impersonation
 	if impersonate := r.FormValue(urlutil.QueryImpersonateAction); impersonate != "" {
 		s.SetImpersonation(r.FormValue(urlutil.QueryImpersonateEmail), r.FormValue(urlutil.QueryImpersonateGroups))
+		if err := a.saveImpersonationGrant(ctx, s); err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
 	}
 	newSession := sessions.NewSession(s, state.redirectURL.Host, jwtAudience)
 
@@ -232,16 +351,13 @@ func (a *Authenticate) SignIn(w http.ResponseWriter, r *http.Request) error {
 	if r.FormValue(urlutil.QueryIsProgrammatic) == "true" {
 		newSession.Programmatic = true
 
-		pbSession, err := session.Get(ctx, a.dataBrokerClient, s.ID)
+		// the refresh token is the encrypted route session, which the
+		// refresh api exchanges for new JWTs for as long as the session lasts
+		refreshToken, err := state.encryptedEncoder.Marshal(newSession)
 		if err != nil {
 			return httputil.NewError(http.StatusBadRequest, err)
 		}
-
-		encSession, err := state.encryptedEncoder.Marshal(pbSession.GetOauthToken())
-		if err != nil {
-			return httputil.NewError(http.StatusBadRequest, err)
-		}
-		callbackParams.Set(urlutil.QueryRefreshToken, string(encSession))
+		callbackParams.Set(urlutil.QueryRefreshToken, string(refreshToken))
 		callbackParams.Set(urlutil.QueryIsProgrammatic, "true")
 	}
 
@@ -275,11 +391,16 @@ func (a *Authenticate) SignOut(w http.ResponseWriter, r *http.Request) error {
 	defer span.End()
 
 	state := a.state.Load()
+	options := a.options.Load()
 
+	// the ID token is sent as a hint of the session to end, so the identity
+	// provider doesn't need to ask the user to confirm signing out
+	var idTokenHint string
 	sessionState, err := a.getSessionFromCtx(ctx)
 	if err == nil {
 		if s, _ := session.Get(ctx, a.dataBrokerClient, sessionState.ID); s != nil && s.OauthToken != nil {
-			if err := a.provider.Load().Revoke(ctx, manager.FromOAuthToken(s.OauthToken)); err != nil {
+			idTokenHint = s.GetOauthToken().GetIdToken()
+			if err := a.getSessionIdentityProvider(sessionState).Revoke(ctx, manager.FromOAuthToken(s.OauthToken)); err != nil {
 				log.Warn().Err(err).Msg("failed to revoke access token")
 			}
 		}
@@ -287,19 +408,19 @@ func (a *Authenticate) SignOut(w http.ResponseWriter, r *http.Request) error {
 		if err != nil {
 			log.Warn().Err(err).Msg("failed to delete session from session store")
 		}
+		metrics.RecordLogout(ctx, "authenticate", options.Provider)
 	}
 
 	// no matter what happens, we want to clear the session store
 	state.sessionStore.ClearSession(w, r)
 	redirectString := r.FormValue(urlutil.QueryRedirectURI)
-	endSessionURL, err := a.provider.Load().LogOut()
-	if err == nil {
-		params := url.Values{}
-		params.Add("post_logout_redirect_uri", redirectString)
-		endSessionURL.RawQuery = params.Encode()
-		redirectString = endSessionURL.String()
-	} else if !errors.Is(err, oidc.ErrSignoutNotImplemented) {
-		log.Warn().Err(err).Msg("authenticate.SignOut: failed getting session")
+	if !skipEndSession(options, sessionState) {
+		endSessionURL, err := getEndSessionURL(a.getSessionIdentityProvider(sessionState), redirectString, idTokenHint)
+		if err == nil {
+			redirectString = endSessionURL.String()
+		} else if !errors.Is(err, oidc.ErrSignoutNotImplemented) {
+			log.Warn().Err(err).Msg("authenticate.SignOut: failed getting session")
+		}
 	}
 
 	httputil.Redirect(w, r, redirectString, http.StatusFound)
@@ -307,6 +428,43 @@ func (a *Authenticate) SignOut(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+// skipEndSession returns true if the identity provider of a session is
+// configured to only sign users out of Pomerium.
+func skipEndSession(options *config.Options, s *sessions.State) bool {
+	var idpID string
+	if s != nil {
+		idpID = s.IdentityProviderID
+	}
+	idp, ok := options.GetIdentityProvider(idpID)
+	return ok && idp.SkipEndSession
+}
+
+// getEndSessionURL returns the URL which ends the user's session with the
+// identity provider, and then redirects them to redirectURI. The ID token
+// hint identifies the session to end, if it's known. Providers with
+// non-standard end session parameters, like Cognito, build the URL themselves.
+//
+// https://openid.net/specs/openid-connect-rpinitiated-1_0.html#RPLogout
+func getEndSessionURL(provider identity.Authenticator, redirectURI, idTokenHint string) (*url.URL, error) {
+	if p, ok := provider.(interface {
+		LogOutURL(redirectURI string) (*url.URL, error)
+	}); ok {
+		return p.LogOutURL(redirectURI)
+	}
+
+	endSessionURL, err := provider.LogOut()
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Add("post_logout_redirect_uri", redirectURI)
+	if idTokenHint != "" {
+		params.Add("id_token_hint", idTokenHint)
+	}
+	endSessionURL.RawQuery = params.Encode()
+	return endSessionURL, nil
+}
+
 // Impersonate takes the result of a form and adds user impersonation details
 // to the user's current user sessions state if the user is currently an
 // administrative user. Requests are redirected back to the user dashboard.
@@ -332,6 +490,106 @@ func (a *Authenticate) Impersonate(w http.ResponseWriter, r *http.Request) error
 	return nil
 }
 
+// ApproveImpersonation approves a pending impersonation grant. The grant is
+// valid for the configured impersonation grant TTL. Administrators cannot
+// approve their own requests. Requests are redirected back to the user
+// dashboard.
+func (a *Authenticate) ApproveImpersonation(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	options := a.options.Load()
+
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	approver, err := a.getSessionUser(ctx, s.ID)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	if !a.isAdmin(approver.GetEmail()) {
+		return httputil.NewError(http.StatusForbidden, errors.New("only administrators can approve impersonation"))
+	}
+
+	grant, err := impersonation.Get(ctx, a.dataBrokerClient, r.FormValue(urlutil.QueryImpersonateGrant))
+	if err != nil {
+		return httputil.NewError(http.StatusNotFound, err)
+	}
+	if grant.GetUserId() == approver.GetId() {
+		return httputil.NewError(http.StatusForbidden, errors.New("administrators cannot approve their own impersonation"))
+	}
+
+	if !grant.IsApproved() {
+		now := time.Now()
+		grant.ApprovedBy = approver.GetEmail()
+		grant.ApprovedAt = timestamppb.New(now)
+		grant.ExpiresAt = timestamppb.New(now.Add(options.ImpersonationGrantTTL))
+		if _, err := impersonation.Set(ctx, a.dataBrokerClient, grant); err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+		logImpersonationGrant(grant, "approved")
+	}
+
+	httputil.Redirect(w, r, a.getDashboardRedirectURL(r).String(), http.StatusFound)
+	return nil
+}
+
+// saveImpersonationGrant stores the impersonation set on the session as a
+// grant in the databroker, or deletes the grant if impersonation was cleared.
+// Unless approval is required, the grant is approved by the requesting
+// administrator and takes effect immediately.
+func (a *Authenticate) saveImpersonationGrant(ctx context.Context, s *sessions.State) error {
+	if !s.Impersonating() {
+		return impersonation.Delete(ctx, a.dataBrokerClient, s.ID)
+	}
+
+	options := a.options.Load()
+	requester, err := a.getSessionUser(ctx, s.ID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	grant := &impersonation.Grant{
+		Id:                s.ID,
+		UserId:            requester.GetId(),
+		Email:             requester.GetEmail(),
+		ImpersonateEmail:  s.ImpersonateEmail,
+		ImpersonateGroups: s.ImpersonateGroups,
+		RequestedAt:       timestamppb.New(now),
+	}
+	if !options.ImpersonationApprovalRequired {
+		grant.ApprovedBy = grant.Email
+		grant.ApprovedAt = grant.RequestedAt
+		grant.ExpiresAt = timestamppb.New(now.Add(options.ImpersonationGrantTTL))
+	}
+	if _, err := impersonation.Set(ctx, a.dataBrokerClient, grant); err != nil {
+		return err
+	}
+	logImpersonationGrant(grant, "requested")
+	return nil
+}
+
+// getSessionUser gets the user signed in to a session from the databroker.
+func (a *Authenticate) getSessionUser(ctx context.Context, sessionID string) (*user.User, error) {
+	pbSession, err := session.Get(ctx, a.dataBrokerClient, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return user.Get(ctx, a.dataBrokerClient, pbSession.GetUserId())
+}
+
+func logImpersonationGrant(grant *impersonation.Grant, action string) {
+	log.Info().
+		Str("service", "authenticate").
+		Str("session-id", grant.GetId()).
+		Str("user-id", grant.GetUserId()).
+		Str("email", grant.GetEmail()).
+		Str("impersonate-email", grant.GetImpersonateEmail()).
+		Strs("impersonate-groups", grant.GetImpersonateGroups()).
+		Str("approved-by", grant.GetApprovedBy()).
+		Msgf("impersonation %s", action)
+}
+
 // reauthenticateOrFail starts the authenticate process by redirecting the
 // user to their respective identity provider. This function also builds the
 // 'state' parameter which is encrypted and includes authenticating data
@@ -339,6 +597,9 @@ func (a *Authenticate) Impersonate(w http.ResponseWriter, r *http.Request) error
 // If the request is a `xhr/ajax` request (e.g the `X-Requested-With` header)
 // is set do not redirect but instead return 401 unauthorized.
 //
+// The identity provider is selected by the sign in URL. If it doesn't select
+// one and there are several, the user chooses one first.
+//
 // https://openid.net/specs/openid-connect-core-1_0-final.html#AuthRequest
 // https://tools.ietf.org/html/rfc6749#section-4.2.1
 // https://developer.mozilla.org/en-US/docs/Web/API/XMLHttpRequest
@@ -350,6 +611,16 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 		return httputil.NewError(http.StatusUnauthorized, err)
 	}
 	state.sessionStore.ClearSession(w, r)
+
+	idpID := r.FormValue(urlutil.QueryIdentityProviderID)
+	if idpID == "" && len(a.providers.Load()) > 0 {
+		return a.renderIdentityProviderPicker(w, r)
+	}
+	provider, err := a.getIdentityProvider(idpID)
+	if err != nil {
+		return err
+	}
+
 	redirectURL := state.redirectURL.ResolveReference(r.URL)
 	nonce := csrf.Token(r)
 	now := time.Now().Unix()
@@ -357,7 +628,7 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 	enc := cryptutil.Encrypt(state.cookieCipher, []byte(redirectURL.String()), b)
 	b = append(b, enc...)
 	encodedState := base64.URLEncoding.EncodeToString(b)
-	httputil.Redirect(w, r, a.provider.Load().GetSignInURL(encodedState), http.StatusFound)
+	httputil.Redirect(w, r, getSignInURL(provider, r, encodedState), http.StatusFound)
 	return nil
 }
 
@@ -366,7 +637,17 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 // https://openid.net/specs/openid-connect-core-1_0.html#CodeFlowSteps
 // https://openid.net/specs/openid-connect-core-1_0.html#AuthResponse
 func (a *Authenticate) OAuthCallback(w http.ResponseWriter, r *http.Request) error {
-	redirect, err := a.getOAuthCallback(w, r)
+	idpID, provider, err := a.getCallbackIdentityProvider(r)
+	if err != nil {
+		return fmt.Errorf("authenticate.OAuthCallback: %w", err)
+	}
+
+	// users of LDAP directories sign in on a form of the callback
+	if ldapProvider, ok := provider.(*ldap.Provider); ok {
+		return a.ldapCallback(w, r, idpID, ldapProvider)
+	}
+
+	redirect, err := a.getOAuthCallback(w, r, idpID, provider)
 	if err != nil {
 		return fmt.Errorf("authenticate.OAuthCallback: %w", err)
 	}
@@ -383,12 +664,10 @@ func (a *Authenticate) statusForErrorCode(errorCode string) int {
 	}
 }
 
-func (a *Authenticate) getOAuthCallback(w http.ResponseWriter, r *http.Request) (*url.URL, error) {
+func (a *Authenticate) getOAuthCallback(w http.ResponseWriter, r *http.Request, idpID string, provider identity.Authenticator) (*url.URL, error) {
 	ctx, span := trace.StartSpan(r.Context(), "authenticate.getOAuthCallback")
 	defer span.End()
 
-	state := a.state.Load()
-
 	// Error Authentication Response: rfc6749#section-4.1.2.1 & OIDC#3.1.2.6
 	//
 	// first, check if the identity provider returned an error
@@ -401,27 +680,32 @@ func (a *Authenticate) getOAuthCallback(w http.ResponseWriter, r *http.Request)
 		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("identity provider returned empty code"))
 	}
 
+	redirectURL, err := a.getCallbackRedirectURL(r.FormValue("state"))
+	if err != nil {
+		return nil, err
+	}
+
 	// Successful Authentication Response: rfc6749#section-4.1.2 & OIDC#3.1.2.5
 	//
 	// Exchange the supplied Authorization Code for a valid user session.
-	s := sessions.State{ID: uuid.New().String()}
-	accessToken, err := a.provider.Load().Authenticate(ctx, code, &s)
+	s := sessions.State{ID: uuid.New().String(), IdentityProviderID: idpID}
+	accessToken, err := provider.Authenticate(ctx, code, &s)
 	if err != nil {
 		return nil, fmt.Errorf("error redeeming authenticate code: %w", err)
 	}
-
-	err = a.saveSessionToDataBroker(r.Context(), &s, accessToken)
-	if err != nil {
-		return nil, httputil.NewError(http.StatusInternalServerError, err)
+	if err := a.saveCallbackSession(ctx, w, r, &s, accessToken); err != nil {
+		return nil, err
 	}
+	return redirectURL, nil
+}
 
-	newState := sessions.NewSession(
-		&s,
-		state.redirectURL.Hostname(),
-		[]string{state.redirectURL.Hostname()})
+// getCallbackRedirectURL returns the URL to redirect to after signing in, from
+// the state of a callback.
+func (a *Authenticate) getCallbackRedirectURL(rawState string) (*url.URL, error) {
+	state := a.state.Load()
 
 	// state includes a csrf nonce (validated by middleware) and redirect uri
-	bytes, err := base64.URLEncoding.DecodeString(r.FormValue("state"))
+	bytes, err := base64.URLEncoding.DecodeString(rawState)
 	if err != nil {
 		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("bad bytes: %w", err))
 	}
@@ -451,12 +735,30 @@ func (a *Authenticate) getOAuthCallback(w http.ResponseWriter, r *http.Request)
 	if err != nil {
 		return nil, httputil.NewError(http.StatusBadRequest, err)
 	}
+	return redirectURL, nil
+}
+
+// saveCallbackSession saves the session of a user who just signed in.
+func (a *Authenticate) saveCallbackSession(ctx context.Context, w http.ResponseWriter, r *http.Request, s *sessions.State, accessToken *oauth2.Token) error {
+	state := a.state.Load()
+	s.AuthTime = jwt.NewNumericDate(time.Now())
+
+	err := a.saveSessionToDataBroker(r.Context(), s, accessToken)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	newState := sessions.NewSession(
+		s,
+		state.redirectURL.Hostname(),
+		[]string{state.redirectURL.Hostname()})
 
 	// ...  and the user state to local storage.
 	if err := state.sessionStore.SaveSession(w, r, &newState); err != nil {
-		return nil, fmt.Errorf("failed saving new session: %w", err)
+		return fmt.Errorf("failed saving new session: %w", err)
 	}
-	return redirectURL, nil
+	metrics.RecordLogin(ctx, "authenticate", a.options.Load().Provider)
+	return nil
 }
 
 func (a *Authenticate) getSessionFromCtx(ctx context.Context) (*sessions.State, error) {
@@ -525,6 +827,7 @@ func (a *Authenticate) Dashboard(w http.ResponseWriter, r *http.Request) error {
 		groups = append(groups, pbDirectoryGroup)
 	}
 
+	isAdmin := a.isAdmin(pbUser.Email)
 	input := map[string]interface{}{
 		"State":             s,
 		"Session":           pbSession,
@@ -535,8 +838,31 @@ func (a *Authenticate) Dashboard(w http.ResponseWriter, r *http.Request) error {
 		"ImpersonateAction": urlutil.QueryImpersonateAction,
 		"ImpersonateEmail":  urlutil.QueryImpersonateEmail,
 		"ImpersonateGroups": urlutil.QueryImpersonateGroups,
+		"ImpersonateGrant":  urlutil.QueryImpersonateGrant,
 		"RedirectURL":       r.URL.Query().Get(urlutil.QueryRedirectURI),
-		"IsAdmin":           a.isAdmin(pbUser.Email),
+		"IsAdmin":           isAdmin,
+	}
+
+	if s.Impersonating() {
+		if grant, err := impersonation.Get(r.Context(), a.dataBrokerClient, s.ID); err == nil {
+			input["ImpersonationGrant"] = grant
+		}
+	} else {
+		a.addRolesDashboardInput(r.Context(), a.options.Load(), pbSession, pbUser, input)
+	}
+	if isAdmin {
+		var pending []*impersonation.Grant
+		if grants, err := impersonation.GetAll(r.Context(), a.dataBrokerClient); err == nil {
+			for _, grant := range grants {
+				if !grant.IsApproved() && grant.GetUserId() != pbUser.GetId() {
+					pending = append(pending, grant)
+				}
+			}
+		} else {
+			log.Warn().Err(err).Msg("authenticate: failed to get impersonation grants")
+		}
+		input["PendingImpersonationGrants"] = pending
+		a.addKioskDashboardInput(r.Context(), input)
 	}
 
 	if redirectURL, err := url.Parse(r.URL.Query().Get(urlutil.QueryRedirectURI)); err == nil {
@@ -566,9 +892,14 @@ func (a *Authenticate) saveSessionToDataBroker(ctx context.Context, sessionState
 	sessionState.Expiry = jwt.NewNumericDate(sessionExpiry.AsTime())
 	idTokenIssuedAt, _ := ptypes.TimestampProto(sessionState.IssuedAt.Time())
 
+	provider, err := a.getIdentityProvider(sessionState.IdentityProviderID)
+	if err != nil {
+		return err
+	}
+
 	s := &session.Session{
 		Id:        sessionState.ID,
-		UserId:    sessionState.UserID(a.provider.Load().Name()),
+		UserId:    sessionState.UserID(provider.Name()),
 		ExpiresAt: sessionExpiry,
 		IdToken: &session.IDToken{
 			Issuer:    sessionState.Issuer,
@@ -576,7 +907,10 @@ func (a *Authenticate) saveSessionToDataBroker(ctx context.Context, sessionState
 			ExpiresAt: sessionExpiry,
 			IssuedAt:  idTokenIssuedAt,
 		},
-		OauthToken: manager.ToOAuthToken(accessToken),
+		OauthToken:   manager.ToOAuthToken(accessToken),
+		IdpId:        sessionState.IdentityProviderID,
+		IdpSessionId: sessionState.IdentityProviderSessionID,
+		LastActiveAt: ptypes.TimestampNow(),
 	}
 
 	// if no user exists yet, create a new one
@@ -587,7 +921,11 @@ func (a *Authenticate) saveSessionToDataBroker(ctx context.Context, sessionState
 				Id: s.GetUserId(),
 			},
 		}
-		err := a.provider.Load().UpdateUserInfo(ctx, accessToken, &mu)
+		var mapClaims func(map[string]interface{})
+		if len(options.ClaimsMapping) > 0 {
+			mapClaims = options.ClaimsMapping.Apply
+		}
+		err := manager.UpdateUserInfo(ctx, provider, accessToken, &mu, mapClaims)
 		if err != nil {
 			return fmt.Errorf("authenticate: error retrieving user info: %w", err)
 		}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -29,12 +30,16 @@ import (
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/impersonation"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/chacha20poly1305"
 	"golang.org/x/oauth2"
 	"gopkg.in/square/go-jose.v2/jwt"
@@ -52,6 +57,7 @@ func testAuthenticate() *Authenticate {
 	auth.options.Store(&config.Options{
 		SharedKey: cryptutil.NewBase64Key(),
 	})
+	auth.ldapLimiter = newLDAPRateLimiter()
 	return &auth
 }
 
@@ -139,6 +145,7 @@ func TestAuthenticate_SignIn(t *testing.T) {
 		{"good programmatic request", "https", "corp.example.example", map[string]string{urlutil.QueryIsProgrammatic: "true", urlutil.QueryRedirectURI: "https://dst.some.example/"}, &mstore.Store{Session: &sessions.State{}}, identity.MockProvider{}, &mock.Encoder{}, http.StatusFound},
 		{"good additional audience", "https", "corp.example.example", map[string]string{urlutil.QueryForwardAuth: "x.y.z", urlutil.QueryRedirectURI: "https://dst.some.example/"}, &mstore.Store{Session: &sessions.State{}}, identity.MockProvider{}, &mock.Encoder{}, http.StatusFound},
 		{"good user impersonate", "https", "corp.example.example", map[string]string{urlutil.QueryImpersonateAction: "set", urlutil.QueryRedirectURI: "https://dst.some.example/"}, &mstore.Store{Session: &sessions.State{}}, identity.MockProvider{}, &mock.Encoder{}, http.StatusFound},
+		{"good user impersonate email", "https", "corp.example.example", map[string]string{urlutil.QueryImpersonateAction: "set", urlutil.QueryImpersonateEmail: "user@example.com", urlutil.QueryRedirectURI: "https://dst.some.example/"}, &mstore.Store{Session: &sessions.State{ID: "SESSION_ID"}}, identity.MockProvider{}, &mock.Encoder{}, http.StatusFound},
 		{"bad user impersonate save failure", "https", "corp.example.example", map[string]string{urlutil.QueryImpersonateAction: "set", urlutil.QueryRedirectURI: "https://dst.some.example/"}, &mstore.Store{SaveError: errors.New("err"), Session: &sessions.State{}}, identity.MockProvider{}, &mock.Encoder{}, http.StatusBadRequest},
 	}
 	for _, tt := range tests {
@@ -154,10 +161,18 @@ func TestAuthenticate_SignIn(t *testing.T) {
 					encryptedEncoder: tt.encoder,
 				}),
 				dataBrokerClient: mockDataBrokerServiceClient{
+					delete: func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+						return new(emptypb.Empty), nil
+					},
 					get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
-						data, err := ptypes.MarshalAny(&session.Session{
-							Id: "SESSION_ID",
-						})
+						var msg proto.Message = &session.Session{
+							Id:     "SESSION_ID",
+							UserId: "USER_ID",
+						}
+						if in.GetId() == "USER_ID" {
+							msg = &user.User{Id: "USER_ID", Email: "admin@example.com"}
+						}
+						data, err := ptypes.MarshalAny(msg)
 						if err != nil {
 							return nil, err
 						}
@@ -166,11 +181,14 @@ func TestAuthenticate_SignIn(t *testing.T) {
 							Record: &databroker.Record{
 								Version: "0001",
 								Type:    data.GetTypeUrl(),
-								Id:      "SESSION_ID",
+								Id:      in.GetId(),
 								Data:    data,
 							},
 						}, nil
 					},
+					set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+						return &databroker.SetResponse{Record: &databroker.Record{Id: in.GetId(), Data: in.GetData()}}, nil
+					},
 				},
 				options:  config.NewAtomicOptions(),
 				provider: identity.NewAtomicAuthenticator(),
@@ -295,6 +313,88 @@ func TestAuthenticate_SignOut(t *testing.T) {
 	}
 }
 
+type logOutURLProvider struct {
+	identity.MockProvider
+}
+
+func (logOutURLProvider) LogOutURL(redirectURI string) (*url.URL, error) {
+	return url.Parse("https://idp.example.com/logout?client_id=CLIENT_ID&logout_uri=" + url.QueryEscape(redirectURI))
+}
+
+func TestGetEndSessionURL(t *testing.T) {
+	u, err := getEndSessionURL(identity.MockProvider{LogOutResponse: *uriParseHelper("https://idp.example.com/end_session")}, "https://app.example.com/", "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/end_session?post_logout_redirect_uri=https%3A%2F%2Fapp.example.com%2F", u.String())
+
+	u, err = getEndSessionURL(identity.MockProvider{LogOutResponse: *uriParseHelper("https://idp.example.com/end_session")}, "https://app.example.com/", "ID_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/end_session?id_token_hint=ID_TOKEN&post_logout_redirect_uri=https%3A%2F%2Fapp.example.com%2F", u.String())
+
+	u, err = getEndSessionURL(logOutURLProvider{}, "https://app.example.com/", "ID_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/logout?client_id=CLIENT_ID&logout_uri=https%3A%2F%2Fapp.example.com%2F", u.String())
+
+	_, err = getEndSessionURL(identity.MockProvider{LogOutError: oidc.ErrSignoutNotImplemented}, "https://app.example.com/", "")
+	assert.True(t, errors.Is(err, oidc.ErrSignoutNotImplemented))
+}
+
+func TestAuthenticate_SignOut_endSession(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		skipEndSession bool
+		wantLocation   string
+	}{
+		{"end session", false, "https://idp.example.com/end_session?id_token_hint=ID_TOKEN&post_logout_redirect_uri=https%3A%2F%2Fapp.example.com%2F"},
+		{"skip end session", true, "https://app.example.com/"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := jws.NewHS256Signer(nil, "mock")
+			require.NoError(t, err)
+			sessionStore := &mstore.Store{Encrypted: true, Session: &sessions.State{ID: "SESSION_ID"}}
+			a := &Authenticate{
+				state: newAtomicAuthenticateState(&authenticateState{
+					sessionStore:  sessionStore,
+					sharedEncoder: signer,
+				}),
+				dataBrokerClient: mockDataBrokerServiceClient{
+					delete: func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+						return new(emptypb.Empty), nil
+					},
+					get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+						data, err := ptypes.MarshalAny(&session.Session{
+							Id:         "SESSION_ID",
+							OauthToken: &session.OAuthToken{AccessToken: "ACCESS_TOKEN", IdToken: "ID_TOKEN"},
+						})
+						if err != nil {
+							return nil, err
+						}
+						return &databroker.GetResponse{Record: &databroker.Record{Id: in.GetId(), Data: data}}, nil
+					},
+				},
+				options:  config.NewAtomicOptions(),
+				provider: identity.NewAtomicAuthenticator(),
+			}
+			a.options.Store(&config.Options{SkipEndSession: tt.skipEndSession})
+			a.provider.Store(identity.MockProvider{LogOutResponse: *uriParseHelper("https://idp.example.com/end_session")})
+
+			u := url.URL{Path: "/.pomerium/sign_out", RawQuery: url.Values{urlutil.QueryRedirectURI: {"https://app.example.com/"}}.Encode()}
+			r := httptest.NewRequest(http.MethodPost, u.String(), nil)
+			state, err := sessionStore.LoadSession(r)
+			require.NoError(t, err)
+			r = r.WithContext(sessions.NewContext(r.Context(), state, nil))
+
+			w := httptest.NewRecorder()
+			httputil.HandlerFunc(a.SignOut).ServeHTTP(w, r)
+			assert.Equal(t, http.StatusFound, w.Code)
+			assert.Equal(t, tt.wantLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
 func TestAuthenticate_OAuthCallback(t *testing.T) {
 	t.Parallel()
 
@@ -527,6 +627,141 @@ func TestAuthenticate_SessionValidatorMiddleware(t *testing.T) {
 	}
 }
 
+func TestAuthenticate_VerifySessionMaxAge(t *testing.T) {
+	t.Parallel()
+	fn := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		authTime   *jwt.NumericDate
+		maxAge     string
+		wantStatus int
+	}{
+		{"no max age", nil, "", http.StatusOK},
+		{"invalid max age", nil, "soon", http.StatusOK},
+		{"recent sign in", jwt.NewNumericDate(time.Now().Add(-time.Minute)), "300", http.StatusOK},
+		{"old sign in", jwt.NewNumericDate(time.Now().Add(-time.Hour)), "300", http.StatusFound},
+		{"unknown sign in", nil, "300", http.StatusFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			aead, err := chacha20poly1305.NewX(cryptutil.NewKey())
+			require.NoError(t, err)
+			signer, err := jws.NewHS256Signer(nil, "mock")
+			require.NoError(t, err)
+			sessionStore := &mstore.Store{Session: &sessions.State{
+				Version:  "v1",
+				ID:       "xyz",
+				Expiry:   jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+				AuthTime: tt.authTime,
+			}}
+			a := Authenticate{
+				state: newAtomicAuthenticateState(&authenticateState{
+					cookieSecret:     cryptutil.NewKey(),
+					redirectURL:      uriParseHelper("https://authenticate.corp.beyondperimeter.com"),
+					sessionStore:     sessionStore,
+					cookieCipher:     aead,
+					encryptedEncoder: signer,
+					sharedEncoder:    signer,
+				}),
+				options:  config.NewAtomicOptions(),
+				provider: identity.NewAtomicAuthenticator(),
+			}
+			a.provider.Store(identity.MockProvider{})
+			r := httptest.NewRequest("GET", "/?"+url.Values{urlutil.QuerySessionMaxAge: {tt.maxAge}}.Encode(), nil)
+			state, err := sessionStore.LoadSession(r)
+			require.NoError(t, err)
+			r = r.WithContext(sessions.NewContext(r.Context(), state, nil))
+			w := httptest.NewRecorder()
+
+			a.VerifySession(fn).ServeHTTP(w, r)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+type stepUpMockProvider struct {
+	identity.MockProvider
+}
+
+func (stepUpMockProvider) GetStepUpSignInURL(state string, acrValues []string) string {
+	return "https://idp.example.com/step-up?" + url.Values{"acr_values": {strings.Join(acrValues, " ")}}.Encode()
+}
+
+func TestAuthenticate_VerifySessionStepUp(t *testing.T) {
+	t.Parallel()
+	fn := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	redirectedAt := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name         string
+		acr          string
+		amr          sessions.AuthMethods
+		authTime     *jwt.NumericDate
+		acrValues    string
+		amrValues    string
+		wantStatus   int
+		wantLocation string
+	}{
+		{"no requirement", "", nil, nil, "", "", http.StatusOK, ""},
+		{"acr", "urn:example:mfa", nil, nil, "urn:example:mfa", "", http.StatusOK, ""},
+		{"amr", "", sessions.AuthMethods{"pwd", "otp"}, nil, "", "mfa otp", http.StatusOK, ""},
+		{"missing acr", "urn:example:pwd", nil, jwt.NewNumericDate(redirectedAt.Add(-time.Hour)), "urn:example:mfa", "", http.StatusFound, "https://idp.example.com/step-up?acr_values=urn%3Aexample%3Amfa"},
+		{"missing amr", "", sessions.AuthMethods{"pwd"}, jwt.NewNumericDate(redirectedAt.Add(-time.Hour)), "", "mfa", http.StatusFound, "https://idp.example.com/step-up?acr_values="},
+		{"not performed", "", sessions.AuthMethods{"pwd"}, jwt.NewNumericDate(redirectedAt.Add(time.Second)), "", "mfa", http.StatusForbidden, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			aead, err := chacha20poly1305.NewX(cryptutil.NewKey())
+			require.NoError(t, err)
+			signer, err := jws.NewHS256Signer(nil, "mock")
+			require.NoError(t, err)
+			sessionStore := &mstore.Store{Session: &sessions.State{
+				Version:  "v1",
+				ID:       "xyz",
+				Expiry:   jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+				AuthTime: tt.authTime,
+				ACR:      tt.acr,
+				AMR:      tt.amr,
+			}}
+			a := Authenticate{
+				state: newAtomicAuthenticateState(&authenticateState{
+					cookieSecret:     cryptutil.NewKey(),
+					redirectURL:      uriParseHelper("https://authenticate.corp.beyondperimeter.com"),
+					sessionStore:     sessionStore,
+					cookieCipher:     aead,
+					encryptedEncoder: signer,
+					sharedEncoder:    signer,
+				}),
+				options:  config.NewAtomicOptions(),
+				provider: identity.NewAtomicAuthenticator(),
+			}
+			a.provider.Store(stepUpMockProvider{})
+			r := httptest.NewRequest("GET", "/?"+url.Values{
+				urlutil.QueryStepUpACRValues: {tt.acrValues},
+				urlutil.QueryStepUpAMRValues: {tt.amrValues},
+				urlutil.QueryHmacIssued:      {strconv.FormatInt(redirectedAt.Unix(), 10)},
+			}.Encode(), nil)
+			state, err := sessionStore.LoadSession(r)
+			require.NoError(t, err)
+			r = r.WithContext(sessions.NewContext(r.Context(), state, nil))
+			w := httptest.NewRecorder()
+
+			a.VerifySession(fn).ServeHTTP(w, r)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
 func TestWellKnownEndpoint(t *testing.T) {
 	auth := testAuthenticate()
 
@@ -539,7 +774,7 @@ func TestWellKnownEndpoint(t *testing.T) {
 	rr := httptest.NewRecorder()
 	h.ServeHTTP(rr, req)
 	body := rr.Body.String()
-	expected := `{"jwks_uri":"https://auth.example.com/.well-known/pomerium/jwks.json","authentication_callback_endpoint":"https://auth.example.com/oauth2/callback","api_refresh_endpoint":"https://auth.example.com/api/v1/refresh"}`
+	expected := `{"jwks_uri":"https://auth.example.com/.well-known/pomerium/jwks.json","authentication_callback_endpoint":"https://auth.example.com/oauth2/callback","api_refresh_endpoint":"https://auth.example.com/api/v1/refresh","device_authorization_endpoint":"https://auth.example.com/.pomerium/device/code","device_token_endpoint":"https://auth.example.com/.pomerium/device/token"}`
 	assert.Equal(t, body, expected)
 }
 
@@ -595,6 +830,7 @@ func TestAuthenticate_Dashboard(t *testing.T) {
 					sharedEncoder:    signer,
 				}),
 				templates: template.Must(frontend.NewTemplates()),
+				options:   config.NewAtomicOptions(),
 				dataBrokerClient: mockDataBrokerServiceClient{
 					get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
 						data, err := ptypes.MarshalAny(&session.Session{
@@ -644,8 +880,11 @@ func TestAuthenticate_Dashboard(t *testing.T) {
 type mockDataBrokerServiceClient struct {
 	databroker.DataBrokerServiceClient
 
-	delete func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	get    func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error)
+	delete        func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	get           func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error)
+	getAll        func(ctx context.Context, in *databroker.GetAllRequest, opts ...grpc.CallOption) (*databroker.GetAllResponse, error)
+	getAllByIndex func(ctx context.Context, in *databroker.GetAllByIndexRequest, opts ...grpc.CallOption) (*databroker.GetAllByIndexResponse, error)
+	set           func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error)
 }
 
 func (m mockDataBrokerServiceClient) Delete(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
@@ -655,3 +894,104 @@ func (m mockDataBrokerServiceClient) Delete(ctx context.Context, in *databroker.
 func (m mockDataBrokerServiceClient) Get(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
 	return m.get(ctx, in, opts...)
 }
+
+func (m mockDataBrokerServiceClient) GetAll(ctx context.Context, in *databroker.GetAllRequest, opts ...grpc.CallOption) (*databroker.GetAllResponse, error) {
+	return m.getAll(ctx, in, opts...)
+}
+
+func (m mockDataBrokerServiceClient) GetAllByIndex(ctx context.Context, in *databroker.GetAllByIndexRequest, opts ...grpc.CallOption) (*databroker.GetAllByIndexResponse, error) {
+	return m.getAllByIndex(ctx, in, opts...)
+}
+
+func (m mockDataBrokerServiceClient) Set(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+	return m.set(ctx, in, opts...)
+}
+
+func TestAuthenticate_ApproveImpersonation(t *testing.T) {
+	t.Parallel()
+
+	pending := &impersonation.Grant{
+		Id:               "REQUESTER_SESSION_ID",
+		UserId:           "REQUESTER_ID",
+		Email:            "requester@example.com",
+		ImpersonateEmail: "user@example.com",
+	}
+	tests := []struct {
+		name       string
+		approverID string
+		admin      bool
+		wantCode   int
+	}{
+		{"good", "APPROVER_ID", true, http.StatusFound},
+		{"not admin", "APPROVER_ID", false, http.StatusForbidden},
+		{"own request", "REQUESTER_ID", true, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *impersonation.Grant
+			records := map[string]proto.Message{
+				"APPROVER_SESSION_ID":  &session.Session{Id: "APPROVER_SESSION_ID", UserId: tt.approverID},
+				tt.approverID:          &user.User{Id: tt.approverID, Email: "approver@example.com"},
+				"REQUESTER_SESSION_ID": pending,
+			}
+			administrators := map[string]struct{}{}
+			if tt.admin {
+				administrators["approver@example.com"] = struct{}{}
+			}
+			signer, err := jws.NewHS256Signer(nil, "mock")
+			if err != nil {
+				t.Fatal(err)
+			}
+			sessionStore := &mstore.Store{Encrypted: true, Session: &sessions.State{ID: "APPROVER_SESSION_ID"}}
+			a := &Authenticate{
+				state: newAtomicAuthenticateState(&authenticateState{
+					sessionStore:   sessionStore,
+					sharedEncoder:  signer,
+					administrators: administrators,
+				}),
+				dataBrokerClient: mockDataBrokerServiceClient{
+					get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+						data, err := ptypes.MarshalAny(records[in.GetId()])
+						if err != nil {
+							return nil, err
+						}
+						return &databroker.GetResponse{Record: &databroker.Record{Id: in.GetId(), Data: data}}, nil
+					},
+					set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+						saved = new(impersonation.Grant)
+						if err := ptypes.UnmarshalAny(in.GetData(), saved); err != nil {
+							return nil, err
+						}
+						return &databroker.SetResponse{Record: &databroker.Record{Id: in.GetId(), Data: in.GetData()}}, nil
+					},
+				},
+				options: config.NewAtomicOptions(),
+			}
+			a.options.Store(&config.Options{ImpersonationGrantTTL: time.Hour})
+
+			form := url.Values{urlutil.QueryImpersonateGrant: {"REQUESTER_SESSION_ID"}}
+			r := httptest.NewRequest(http.MethodPost, "/.pomerium/admin/impersonate/approve", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			state, err := sessionStore.LoadSession(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = r.WithContext(sessions.NewContext(r.Context(), state, nil))
+
+			w := httptest.NewRecorder()
+			httputil.HandlerFunc(a.ApproveImpersonation).ServeHTTP(w, r)
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusFound {
+				assert.Nil(t, saved)
+				return
+			}
+			if assert.NotNil(t, saved) {
+				assert.True(t, saved.IsApproved())
+				assert.Equal(t, "approver@example.com", saved.GetApprovedBy())
+				assert.NoError(t, saved.Validate(time.Now(), "user@example.com", nil))
+				assert.Equal(t, impersonation.ErrExpired, saved.Validate(time.Now().Add(2*time.Hour), "user@example.com", nil))
+			}
+		})
+	}
+}
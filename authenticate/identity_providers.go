@@ -0,0 +1,123 @@
+package authenticate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+// errIdentityProviderMismatch is returned when a route requires users to sign
+// in with a different identity provider than their session's.
+var errIdentityProviderMismatch = errors.New("session is from a different identity provider")
+
+// atomicIdentityProviders stores the authenticators of the additional
+// identity providers, by id.
+type atomicIdentityProviders struct {
+	value atomic.Value
+}
+
+func newAtomicIdentityProviders() *atomicIdentityProviders {
+	p := new(atomicIdentityProviders)
+	p.Store(nil)
+	return p
+}
+
+// Load returns the authenticators. It's safe to call on nil.
+func (p *atomicIdentityProviders) Load() map[string]identity.Authenticator {
+	if p == nil {
+		return nil
+	}
+	return p.value.Load().(map[string]identity.Authenticator)
+}
+
+func (p *atomicIdentityProviders) Store(authenticators map[string]identity.Authenticator) {
+	p.value.Store(authenticators)
+}
+
+// getIdentityProvider returns the authenticator of the identity provider with
+// the given id. An empty id is the default identity provider.
+func (a *Authenticate) getIdentityProvider(idpID string) (identity.Authenticator, error) {
+	if idpID == "" || idpID == config.DefaultIdentityProviderID {
+		return a.provider.Load(), nil
+	}
+	if provider, ok := a.providers.Load()[idpID]; ok {
+		return provider, nil
+	}
+	return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("unknown identity provider %q", idpID))
+}
+
+// getSessionIdentityProvider returns the authenticator of the identity
+// provider a session signed in with, or the default one if it's no longer
+// configured.
+func (a *Authenticate) getSessionIdentityProvider(s *sessions.State) identity.Authenticator {
+	if s != nil {
+		if provider, err := a.getIdentityProvider(s.IdentityProviderID); err == nil {
+			return provider
+		}
+	}
+	return a.provider.Load()
+}
+
+// getCallbackIdentityProvider returns the id and authenticator of the
+// identity provider a callback is from. The identity provider is selected by
+// the sign in URL, which is the redirect URL in the callback's state.
+func (a *Authenticate) getCallbackIdentityProvider(r *http.Request) (string, identity.Authenticator, error) {
+	if len(a.providers.Load()) == 0 {
+		return config.DefaultIdentityProviderID, a.provider.Load(), nil
+	}
+	redirectURL, err := a.getCallbackRedirectURL(r.FormValue("state"))
+	if err != nil {
+		return "", nil, err
+	}
+	idpID := redirectURL.Query().Get(urlutil.QueryIdentityProviderID)
+	if idpID == "" {
+		idpID = config.DefaultIdentityProviderID
+	}
+	provider, err := a.getIdentityProvider(idpID)
+	if err != nil {
+		return "", nil, err
+	}
+	return idpID, provider, nil
+}
+
+// isSessionFromIdentityProvider returns true if the session signed in with
+// the identity provider with the given id.
+func isSessionFromIdentityProvider(s *sessions.State, idpID string) bool {
+	sessionIDPID := s.IdentityProviderID
+	if sessionIDPID == "" {
+		sessionIDPID = config.DefaultIdentityProviderID
+	}
+	return sessionIDPID == idpID
+}
+
+// renderIdentityProviderPicker shows the identity providers users can sign in
+// with. Choosing one signs in again with its id added to the sign in URL.
+func (a *Authenticate) renderIdentityProviderPicker(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query()
+	query.Del(urlutil.QueryIdentityProviderID)
+
+	var idps []map[string]string
+	for _, idp := range a.options.Load().GetIdentityProviders() {
+		idps = append(idps, map[string]string{
+			"ID":   idp.ID,
+			"Name": idp.GetName(),
+		})
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	return a.templates.ExecuteTemplate(w, "idp_picker.html", map[string]interface{}{
+		"Action":            r.URL.Path,
+		"Query":             query,
+		"QueryParam":        urlutil.QueryIdentityProviderID,
+		"IdentityProviders": idps,
+	})
+}
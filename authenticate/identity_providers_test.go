@@ -0,0 +1,115 @@
+package authenticate
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/sessions"
+	mstore "github.com/pomerium/pomerium/internal/sessions/mock"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func testAuthenticateWithIdentityProviders(t *testing.T) *Authenticate {
+	a := testAuthenticate()
+	aead, err := chacha20poly1305.NewX(cryptutil.NewKey())
+	require.NoError(t, err)
+	a.state.Load().cookieCipher = aead
+	a.state.Load().sessionStore = &mstore.Store{}
+	a.options.Store(&config.Options{
+		SharedKey: cryptutil.NewBase64Key(),
+		Provider:  "okta",
+		IdentityProviders: []config.IdentityProvider{
+			{ID: "contractors", Name: "Contractors", Provider: "google"},
+		},
+	})
+	a.provider = identity.NewAtomicAuthenticator()
+	a.provider.Store(identity.MockProvider{GetSignInURLResponse: "https://okta.example.com/authorize"})
+	a.providers = newAtomicIdentityProviders()
+	a.providers.Store(map[string]identity.Authenticator{
+		"contractors": identity.MockProvider{GetSignInURLResponse: "https://google.example.com/authorize"},
+	})
+	return a
+}
+
+func TestAuthenticate_reauthenticateOrFail_identityProviders(t *testing.T) {
+	a := testAuthenticateWithIdentityProviders(t)
+	reauthenticate := func(rawURL string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, rawURL, nil)
+		w := httptest.NewRecorder()
+		httputil.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			return a.reauthenticateOrFail(w, r, errors.New("no session"))
+		}).ServeHTTP(w, r)
+		return w
+	}
+
+	// without an identity provider, the user chooses one
+	w := reauthenticate("https://auth.example.com/.pomerium/sign_in?pomerium_redirect_uri=https%3A%2F%2Fapp.example.com")
+	assert.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `action="/.pomerium/sign_in"`)
+	assert.Contains(t, body, `name="pomerium_redirect_uri" value="https://app.example.com"`)
+	assert.Contains(t, body, `name="pomerium_idp_id"`)
+	assert.Contains(t, body, `value="default"`)
+	assert.Contains(t, body, `value="contractors"`)
+	assert.Contains(t, body, "Contractors")
+
+	w = reauthenticate("https://auth.example.com/.pomerium/sign_in?pomerium_idp_id=contractors")
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "https://google.example.com/authorize", w.Header().Get("Location"))
+
+	w = reauthenticate("https://auth.example.com/.pomerium/sign_in?pomerium_idp_id=default")
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "https://okta.example.com/authorize", w.Header().Get("Location"))
+
+	w = reauthenticate("https://auth.example.com/.pomerium/sign_in?pomerium_idp_id=missing")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAuthenticate_getCallbackIdentityProvider(t *testing.T) {
+	a := testAuthenticateWithIdentityProviders(t)
+	newState := func(redirectURL string) string {
+		b := []byte(fmt.Sprintf("%s|%d|", cryptutil.NewBase64Key(), time.Now().Unix()))
+		b = append(b, cryptutil.Encrypt(a.state.Load().cookieCipher, []byte(redirectURL), b)...)
+		return base64.URLEncoding.EncodeToString(b)
+	}
+	getCallbackIdentityProvider := func(state string) (string, identity.Authenticator, error) {
+		r := httptest.NewRequest(http.MethodGet, "https://auth.example.com/oauth/callback?state="+state, nil)
+		return a.getCallbackIdentityProvider(r)
+	}
+
+	idpID, provider, err := getCallbackIdentityProvider(newState("https://auth.example.com/.pomerium/sign_in?" + urlutil.QueryIdentityProviderID + "=contractors"))
+	require.NoError(t, err)
+	assert.Equal(t, "contractors", idpID)
+	assert.Equal(t, "https://google.example.com/authorize", provider.GetSignInURL(""))
+
+	idpID, provider, err = getCallbackIdentityProvider(newState("https://auth.example.com/.pomerium/sign_in"))
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultIdentityProviderID, idpID)
+	assert.Equal(t, "https://okta.example.com/authorize", provider.GetSignInURL(""))
+
+	_, _, err = getCallbackIdentityProvider(newState("https://auth.example.com/.pomerium/sign_in?" + urlutil.QueryIdentityProviderID + "=missing"))
+	assert.Error(t, err)
+
+	_, _, err = getCallbackIdentityProvider("bad")
+	assert.Error(t, err)
+}
+
+func TestIsSessionFromIdentityProvider(t *testing.T) {
+	assert.True(t, isSessionFromIdentityProvider(&sessions.State{}, config.DefaultIdentityProviderID),
+		"sessions without an identity provider should be from the default one")
+	assert.True(t, isSessionFromIdentityProvider(&sessions.State{IdentityProviderID: "contractors"}, "contractors"))
+	assert.False(t, isSessionFromIdentityProvider(&sessions.State{IdentityProviderID: "contractors"}, config.DefaultIdentityProviderID))
+}
@@ -0,0 +1,478 @@
+package authenticate
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+// kioskRefreshInterval is how often the kiosk page checks whether the device
+// has been approved.
+const kioskRefreshInterval = 5 * time.Second
+
+// kioskDevicesPerMinute and kioskDeviceBurst are how many kiosk devices each
+// client IP can request.
+const (
+	kioskDevicesPerMinute = 2
+	kioskDeviceBurst      = 10
+)
+
+func newKioskRateLimiter() *keyedRateLimiter {
+	return newKeyedRateLimiter(rate.Limit(kioskDevicesPerMinute)/60, kioskDeviceBurst)
+}
+
+// Kiosk signs in a kiosk-style device. The device is shown a short user code
+// which an administrator approves from the dashboard. The device keeps a
+// long-lived device code in a cookie, and once approved, is signed in with a
+// session which may only access the routes chosen by the administrator.
+func (a *Authenticate) Kiosk(w http.ResponseWriter, r *http.Request) error {
+	ctx, span := trace.StartSpan(r.Context(), "authenticate.Kiosk")
+	defer span.End()
+
+	if a.dataBrokerClient == nil {
+		return httputil.NewError(http.StatusNotFound, errors.New("kiosk devices require the databroker"))
+	}
+
+	now := time.Now()
+	var device *kiosk.Device
+	kc, err := a.getKioskCookie(r)
+	if err == nil {
+		device, _ = kiosk.Get(ctx, a.dataBrokerClient, kiosk.DeviceID(kc.DeviceCode))
+	}
+
+	switch {
+	case device != nil && device.IsActive(now):
+		if err := a.saveKioskSession(w, r, device); err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+		httputil.Redirect(w, r, a.getKioskRedirectURL(r, device).String(), http.StatusFound)
+		return nil
+	case device != nil && device.IsPending(now):
+	case kc != nil && kc.isPending(now):
+		// the device kept its cookie, so register its code for approval
+		device, err = a.registerKioskDevice(ctx, r, kc)
+		if err != nil {
+			return err
+		}
+	default:
+		device, err = a.newKioskDevice(w, r)
+		if err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Refresh", fmt.Sprint(int(kioskRefreshInterval.Seconds())))
+	err = a.templates.ExecuteTemplate(w, "kiosk.html", map[string]interface{}{
+		"Device":       device,
+		"DashboardURL": urlutil.GetAbsoluteURL(r).ResolveReference(&url.URL{Path: "/.pomerium/"}).String(),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("authenticate: error rendering kiosk")
+	}
+	return nil
+}
+
+// ApproveKioskDevice approves the pending kiosk device with the given user
+// code, allowing it to access the chosen routes. Requests are redirected back
+// to the user dashboard.
+func (a *Authenticate) ApproveKioskDevice(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	options := a.options.Load()
+
+	approver, err := a.getKioskAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return httputil.NewError(http.StatusBadRequest, err)
+	}
+	userCode := kiosk.NormalizeUserCode(r.FormValue(urlutil.QueryKioskUserCode))
+	routes := r.Form[urlutil.QueryKioskRoutes]
+	if len(routes) == 0 {
+		return httputil.NewError(http.StatusBadRequest, errors.New("kiosk devices must be allowed at least one route"))
+	}
+
+	now := time.Now()
+	devices, err := kiosk.GetAll(ctx, a.dataBrokerClient)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	var device *kiosk.Device
+	for _, d := range devices {
+		if d.GetUserCode() == userCode && d.IsPending(now) {
+			device = d
+			break
+		}
+	}
+	if device == nil {
+		return httputil.NewError(http.StatusNotFound, fmt.Errorf("no pending kiosk device with code %s", userCode))
+	}
+
+	device.Name = r.FormValue(urlutil.QueryKioskDeviceName)
+	device.Routes = routes
+	device.ApprovedBy = approver.GetEmail()
+	device.ApprovedAt = timestamppb.New(now)
+	device.ExpiresAt = timestamppb.New(now.Add(options.KioskSessionTTL))
+	if err := a.saveKioskDeviceSession(ctx, device); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	if _, err := kiosk.Set(ctx, a.dataBrokerClient, device); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	logKioskDevice(device, "approved")
+
+	httputil.Redirect(w, r, a.getDashboardRedirectURL(r).String(), http.StatusFound)
+	return nil
+}
+
+// RevokeKioskDevice deletes a kiosk device and its session, signing it out.
+func (a *Authenticate) RevokeKioskDevice(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	revoker, err := a.getKioskAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	device, err := kiosk.Get(ctx, a.dataBrokerClient, r.FormValue(urlutil.QueryKioskDevice))
+	if err != nil {
+		return httputil.NewError(http.StatusNotFound, err)
+	}
+	if err := a.deleteSession(ctx, device.GetId()); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	if err := kiosk.Delete(ctx, a.dataBrokerClient, device.GetId()); err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+	log.Info().
+		Str("service", "authenticate").
+		Str("device-id", device.GetId()).
+		Str("name", device.GetName()).
+		Str("revoked-by", revoker.GetEmail()).
+		Msg("kiosk device revoked")
+
+	httputil.Redirect(w, r, a.getDashboardRedirectURL(r).String(), http.StatusFound)
+	return nil
+}
+
+// restoreKioskSession signs in an approved kiosk device whose session cookie
+// has expired, using its device code cookie. It returns the raw session JWT.
+func (a *Authenticate) restoreKioskSession(w http.ResponseWriter, r *http.Request) (string, error) {
+	if a.dataBrokerClient == nil {
+		return "", sessions.ErrNoSessionFound
+	}
+	kc, err := a.getKioskCookie(r)
+	if err != nil {
+		return "", err
+	}
+	device, err := kiosk.Get(r.Context(), a.dataBrokerClient, kiosk.DeviceID(kc.DeviceCode))
+	if err != nil {
+		return "", err
+	}
+	if !device.IsActive(time.Now()) {
+		return "", sessions.ErrExpired
+	}
+	s := a.newKioskSessionState(device)
+	raw, err := a.state.Load().sharedEncoder.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	if err := a.state.Load().sessionStore.SaveSession(w, r, s); err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// newKioskDevice creates a new pending device and stores its codes in a
+// cookie. Nothing is saved to the databroker until the device comes back with
+// the cookie.
+func (a *Authenticate) newKioskDevice(w http.ResponseWriter, r *http.Request) (*kiosk.Device, error) {
+	options := a.options.Load()
+	state := a.state.Load()
+
+	userCode, err := kiosk.NewUserCode()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	kc := &kioskCookie{
+		DeviceCode:    cryptutil.NewBase64Key(),
+		UserCode:      userCode,
+		CodeExpiresAt: now.Add(options.KioskCodeTTL).Unix(),
+	}
+	bs, err := json.Marshal(kc)
+	if err != nil {
+		return nil, err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     getKioskCookieName(options.CookieName),
+		Value:    base64.RawURLEncoding.EncodeToString(cryptutil.Encrypt(state.cookieCipher, bs, nil)),
+		Path:     "/",
+		MaxAge:   int((options.KioskCodeTTL + options.KioskSessionTTL).Seconds()),
+		Secure:   options.CookieSecure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return kc.newDevice(r, now), nil
+}
+
+// registerKioskDevice saves a pending device from its cookie so that it can be
+// approved. Each client may only register a few devices a minute.
+func (a *Authenticate) registerKioskDevice(ctx context.Context, r *http.Request, kc *kioskCookie) (*kiosk.Device, error) {
+	if !a.kioskLimiter.Allow(getClientIP(r)) {
+		return nil, httputil.NewError(http.StatusTooManyRequests, errors.New("too many kiosk devices requested"))
+	}
+	device := kc.newDevice(r, time.Now())
+	if _, err := kiosk.Set(ctx, a.dataBrokerClient, device); err != nil {
+		return nil, httputil.NewError(http.StatusInternalServerError, err)
+	}
+	logKioskDevice(device, "requested")
+	return device, nil
+}
+
+// saveKioskDeviceSession saves the user and session a device signs in as.
+func (a *Authenticate) saveKioskDeviceSession(ctx context.Context, device *kiosk.Device) error {
+	state := a.state.Load()
+
+	userID := kiosk.UserID(device.GetId())
+	if _, err := user.Set(ctx, a.dataBrokerClient, &user.User{
+		Id:   userID,
+		Name: device.GetName(),
+	}); err != nil {
+		return fmt.Errorf("authenticate: error saving kiosk user: %w", err)
+	}
+	_, err := session.Set(ctx, a.dataBrokerClient, &session.Session{
+		Id:        device.GetId(),
+		UserId:    userID,
+		ExpiresAt: device.GetExpiresAt(),
+		IdToken: &session.IDToken{
+			Issuer:    state.redirectURL.Hostname(),
+			Subject:   userID,
+			ExpiresAt: device.GetExpiresAt(),
+			IssuedAt:  device.GetApprovedAt(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("authenticate: error saving kiosk session: %w", err)
+	}
+	return nil
+}
+
+// saveKioskSession signs the device in to the authenticate service.
+func (a *Authenticate) saveKioskSession(w http.ResponseWriter, r *http.Request, device *kiosk.Device) error {
+	return a.state.Load().sessionStore.SaveSession(w, r, a.newKioskSessionState(device))
+}
+
+func (a *Authenticate) newKioskSessionState(device *kiosk.Device) *sessions.State {
+	state := a.state.Load()
+	s := &sessions.State{
+		ID:      device.GetId(),
+		Subject: kiosk.UserID(device.GetId()),
+	}
+	ns := sessions.NewSession(s, state.redirectURL.Hostname(), []string{state.redirectURL.Hostname()})
+	ns.Expiry = jwt.NewNumericDate(device.GetExpiresAt().AsTime())
+	ns.AuthTime = jwt.NewNumericDate(device.GetApprovedAt().AsTime())
+	return &ns
+}
+
+// kioskCookie is the cookie a kiosk device keeps its codes in.
+type kioskCookie struct {
+	DeviceCode    string `json:"device_code"`
+	UserCode      string `json:"user_code,omitempty"`
+	CodeExpiresAt int64  `json:"code_expires_at,omitempty"`
+}
+
+// isPending reports whether the user code in the cookie can still be approved.
+func (kc *kioskCookie) isPending(now time.Time) bool {
+	return kc.UserCode != "" && now.Before(time.Unix(kc.CodeExpiresAt, 0))
+}
+
+func (kc *kioskCookie) newDevice(r *http.Request, now time.Time) *kiosk.Device {
+	return &kiosk.Device{
+		Id:            kiosk.DeviceID(kc.DeviceCode),
+		UserCode:      kc.UserCode,
+		Name:          r.FormValue(urlutil.QueryKioskDeviceName),
+		UserAgent:     r.UserAgent(),
+		IpAddress:     getClientIP(r),
+		RequestedAt:   timestamppb.New(now),
+		CodeExpiresAt: timestamppb.New(time.Unix(kc.CodeExpiresAt, 0)),
+	}
+}
+
+func (a *Authenticate) getKioskCookie(r *http.Request) (*kioskCookie, error) {
+	cookie, err := r.Cookie(getKioskCookieName(a.options.Load().CookieName))
+	if err != nil {
+		return nil, err
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+	bs, err = cryptutil.Decrypt(a.state.Load().cookieCipher, bs, nil)
+	if err != nil {
+		return nil, err
+	}
+	var kc kioskCookie
+	if err := json.Unmarshal(bs, &kc); err != nil {
+		// devices approved before codes were kept in the cookie only have
+		// their device code
+		return &kioskCookie{DeviceCode: string(bs)}, nil
+	}
+	return &kc, nil
+}
+
+// getKioskAdmin returns the signed in user if they're an administrator.
+func (a *Authenticate) getKioskAdmin(ctx context.Context) (*user.User, error) {
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return nil, httputil.NewError(http.StatusUnauthorized, err)
+	}
+	admin, err := a.getSessionUser(ctx, s.ID)
+	if err != nil {
+		return nil, httputil.NewError(http.StatusUnauthorized, err)
+	}
+	if !a.isAdmin(admin.GetEmail()) {
+		return nil, httputil.NewError(http.StatusForbidden, errors.New("only administrators can manage kiosk devices"))
+	}
+	return admin, nil
+}
+
+// addKioskDashboardInput adds the kiosk devices, and the routes they can be
+// allowed to access, to the administrator's dashboard.
+func (a *Authenticate) addKioskDashboardInput(ctx context.Context, input map[string]interface{}) {
+	if a.dataBrokerClient == nil {
+		return
+	}
+	devices, err := kiosk.GetAll(ctx, a.dataBrokerClient)
+	if err != nil {
+		log.Warn().Err(err).Msg("authenticate: failed to get kiosk devices")
+		return
+	}
+	now := time.Now()
+	var pending, active []*kiosk.Device
+	for _, device := range devices {
+		switch {
+		case device.IsPending(now):
+			pending = append(pending, device)
+		case device.IsActive(now):
+			active = append(active, device)
+		}
+	}
+	input["PendingKioskDevices"] = pending
+	input["KioskDevices"] = active
+
+	var routes []string
+	seen := map[string]struct{}{}
+	for _, p := range a.options.Load().Policies {
+		if p.Source == nil || p.AllowPublicUnauthenticatedAccess {
+			continue
+		}
+		if _, ok := seen[p.Source.String()]; !ok {
+			seen[p.Source.String()] = struct{}{}
+			routes = append(routes, p.Source.String())
+		}
+	}
+	input["KioskRoutes"] = routes
+}
+
+func getKioskCookieName(cookieName string) string {
+	return fmt.Sprintf("%s_kiosk", cookieName)
+}
+
+// getKioskRedirectURL returns the redirect url requested by the device if it's
+// one of the device's routes, or the first of its routes otherwise.
+func (a *Authenticate) getKioskRedirectURL(r *http.Request, device *kiosk.Device) *url.URL {
+	var routeURLs []*url.URL
+	for _, route := range device.GetRoutes() {
+		if u, err := url.Parse(route); err == nil {
+			routeURLs = append(routeURLs, u)
+		}
+	}
+	if u, err := urlutil.ParseAndValidateURL(r.FormValue(urlutil.QueryRedirectURI)); err == nil {
+		for _, routeURL := range routeURLs {
+			if strings.EqualFold(u.Host, routeURL.Host) {
+				return u
+			}
+		}
+	}
+	if len(routeURLs) > 0 {
+		return routeURLs[0]
+	}
+	return a.getDashboardRedirectURL(r)
+}
+
+// getDashboardRedirectURL returns the redirect url of the request if it's on
+// the authenticate service or one of the routes, and the dashboard otherwise,
+// so that the handlers which use it can't be used as an open redirect.
+func (a *Authenticate) getDashboardRedirectURL(r *http.Request) *url.URL {
+	redirectURL := urlutil.GetAbsoluteURL(r).ResolveReference(&url.URL{
+		Path: "/.pomerium",
+	})
+	if u, err := urlutil.ParseAndValidateURL(r.FormValue(urlutil.QueryRedirectURI)); err == nil && a.isAllowedRedirectHost(u.Host) {
+		redirectURL = u
+	}
+	return redirectURL
+}
+
+// isAllowedRedirectHost returns true if host is the authenticate service's or
+// one of the routes'.
+func (a *Authenticate) isAllowedRedirectHost(host string) bool {
+	if u := a.state.Load().redirectURL; u != nil && strings.EqualFold(host, u.Host) {
+		return true
+	}
+	for _, p := range a.options.Load().Policies {
+		if p.Source != nil && strings.EqualFold(host, p.Source.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// getClientIP returns the IP address of the client. Envoy appends the address
+// it received the request from to X-Forwarded-For, so the last entry is the
+// only one the client can't choose.
+func getClientIP(r *http.Request) string {
+	if forwardedFor := r.Header.Get(httputil.HeaderForwardedFor); forwardedFor != "" {
+		addrs := strings.Split(forwardedFor, ",")
+		return strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+func logKioskDevice(device *kiosk.Device, action string) {
+	log.Info().
+		Str("service", "authenticate").
+		Str("device-id", device.GetId()).
+		Str("user-code", device.GetUserCode()).
+		Str("name", device.GetName()).
+		Str("ip-address", device.GetIpAddress()).
+		Strs("routes", device.GetRoutes()).
+		Str("approved-by", device.GetApprovedBy()).
+		Msgf("kiosk device %s", action)
+}
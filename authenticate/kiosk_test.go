@@ -0,0 +1,262 @@
+package authenticate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	mstore "github.com/pomerium/pomerium/internal/sessions/mock"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestAuthenticate_Kiosk(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	db := newMockDataBroker(0)
+	h := newStatelessTestInstance(t, cfg, db.client())
+	kioskURL := "https://auth.example.com/.pomerium/kiosk?" + url.Values{
+		urlutil.QueryRedirectURI: {"https://app.example.com/status"},
+	}.Encode()
+
+	// a new device is shown a user code, which isn't saved yet
+	res := serveStateless(h, kioskURL, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "no-store", res.Header.Get("Cache-Control"))
+	assert.Empty(t, db.records)
+	cookies := res.Cookies()
+	assert.NotNil(t, findCookie(cookies, getKioskCookieName(cfg.Options.CookieName)), "should set the device cookie")
+
+	// a device which comes back with its cookie is saved for approval
+	res = serveStateless(h, kioskURL, cookies)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Len(t, db.records, 1)
+	var device kiosk.Device
+	for _, record := range db.records {
+		require.NoError(t, ptypes.UnmarshalAny(record.GetData(), &device))
+	}
+	assert.True(t, device.IsPending(time.Now()))
+	assert.Len(t, device.GetUserCode(), 9)
+
+	// a pending device keeps its user code
+	res = serveStateless(h, kioskURL, cookies)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Len(t, db.records, 1)
+
+	// an approved device is signed in and redirected to its route
+	device.ApprovedAt = timestamppb.Now()
+	device.ExpiresAt = timestamppb.New(time.Now().Add(time.Hour))
+	device.Routes = []string{"https://app.example.com"}
+	_, err := kiosk.Set(context.Background(), db.client(), &device)
+	require.NoError(t, err)
+
+	res = serveStateless(h, kioskURL, cookies)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, "https://app.example.com/status", res.Header.Get("Location"))
+	assert.NotNil(t, findCookie(res.Cookies(), cfg.Options.CookieName), "should set the session cookie")
+
+	// an approved device can't be redirected to other routes
+	res = serveStateless(h, "https://auth.example.com/.pomerium/kiosk?"+url.Values{
+		urlutil.QueryRedirectURI: {"https://other.example.com"},
+	}.Encode(), cookies)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, "https://app.example.com", res.Header.Get("Location"))
+}
+
+func TestAuthenticate_Kiosk_rateLimit(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	db := newMockDataBroker(0)
+	h := newStatelessTestInstance(t, cfg, db.client())
+	kioskURL := "https://auth.example.com/.pomerium/kiosk"
+
+	for i := 0; i < kioskDeviceBurst; i++ {
+		res := serveStateless(h, kioskURL, nil)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		res = serveStateless(h, kioskURL, res.Cookies())
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Len(t, db.records, kioskDeviceBurst)
+
+	// new devices are still shown a code, but aren't saved
+	res := serveStateless(h, kioskURL, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	res = serveStateless(h, kioskURL, res.Cookies())
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Len(t, db.records, kioskDeviceBurst)
+}
+
+func TestAuthenticate_getDashboardRedirectURL(t *testing.T) {
+	t.Parallel()
+
+	a := &Authenticate{
+		state:   newAtomicAuthenticateState(&authenticateState{redirectURL: mustParseURL(t, "https://auth.example.com")}),
+		options: config.NewAtomicOptions(),
+	}
+	a.options.Store(&config.Options{Policies: []config.Policy{
+		{Source: &config.StringURL{URL: mustParseURL(t, "https://app.example.com")}},
+	}})
+
+	tests := []struct {
+		name        string
+		redirectURI string
+		want        string
+	}{
+		{"empty", "", "https://auth.example.com/.pomerium"},
+		{"authenticate", "https://auth.example.com/.pomerium/roles", "https://auth.example.com/.pomerium/roles"},
+		{"route", "https://APP.example.com/status", "https://APP.example.com/status"},
+		{"other host", "https://evil.example.com/", "https://auth.example.com/.pomerium"},
+		{"scheme relative", "//evil.example.com/", "https://auth.example.com/.pomerium"},
+		{"relative", "/status", "https://auth.example.com/.pomerium"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "https://auth.example.com/.pomerium/roles/assume?"+url.Values{
+				urlutil.QueryRedirectURI: {tt.redirectURI},
+			}.Encode(), nil)
+			assert.Equal(t, tt.want, a.getDashboardRedirectURL(r).String())
+		})
+	}
+}
+
+func findCookie(cookies []*http.Cookie, name string) *http.Cookie {
+	for _, c := range cookies {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestAuthenticate_ApproveKioskDevice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		admin    bool
+		userCode string
+		routes   []string
+		wantCode int
+	}{
+		{"good", true, "bcdf-ghjk", []string{"https://app.example.com"}, http.StatusFound},
+		{"not admin", false, "BCDF-GHJK", []string{"https://app.example.com"}, http.StatusForbidden},
+		{"no routes", true, "BCDF-GHJK", nil, http.StatusBadRequest},
+		{"unknown code", true, "XXXX-XXXX", []string{"https://app.example.com"}, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			pending := &kiosk.Device{
+				Id:            kiosk.DeviceID("DEVICE_CODE"),
+				UserCode:      "BCDF-GHJK",
+				RequestedAt:   timestamppb.Now(),
+				CodeExpiresAt: timestamppb.New(time.Now().Add(time.Minute)),
+			}
+			records := map[string]proto.Message{
+				"ADMIN_SESSION_ID": &session.Session{Id: "ADMIN_SESSION_ID", UserId: "ADMIN_ID"},
+				"ADMIN_ID":         &user.User{Id: "ADMIN_ID", Email: "admin@example.com"},
+			}
+			saved := map[string]proto.Message{}
+			administrators := map[string]struct{}{}
+			if tt.admin {
+				administrators["admin@example.com"] = struct{}{}
+			}
+			signer, err := jws.NewHS256Signer(nil, "mock")
+			require.NoError(t, err)
+			sessionStore := &mstore.Store{Encrypted: true, Session: &sessions.State{ID: "ADMIN_SESSION_ID"}}
+			a := &Authenticate{
+				state: newAtomicAuthenticateState(&authenticateState{
+					redirectURL:    mustParseURL(t, "https://auth.example.com/oauth2/callback"),
+					sessionStore:   sessionStore,
+					sharedEncoder:  signer,
+					administrators: administrators,
+				}),
+				dataBrokerClient: mockDataBrokerServiceClient{
+					get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+						data, err := ptypes.MarshalAny(records[in.GetId()])
+						if err != nil {
+							return nil, err
+						}
+						return &databroker.GetResponse{Record: &databroker.Record{Id: in.GetId(), Data: data}}, nil
+					},
+					getAll: func(ctx context.Context, in *databroker.GetAllRequest, opts ...grpc.CallOption) (*databroker.GetAllResponse, error) {
+						data, err := ptypes.MarshalAny(pending)
+						if err != nil {
+							return nil, err
+						}
+						return &databroker.GetAllResponse{Records: []*databroker.Record{{Id: pending.GetId(), Data: data}}}, nil
+					},
+					set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+						msg, err := ptypes.Empty(in.GetData())
+						if err != nil {
+							return nil, err
+						}
+						if err := ptypes.UnmarshalAny(in.GetData(), msg); err != nil {
+							return nil, err
+						}
+						saved[in.GetType()+"/"+in.GetId()] = msg
+						return &databroker.SetResponse{Record: &databroker.Record{Id: in.GetId(), Data: in.GetData()}}, nil
+					},
+				},
+				options: config.NewAtomicOptions(),
+			}
+			a.options.Store(&config.Options{KioskSessionTTL: time.Hour})
+
+			form := url.Values{
+				urlutil.QueryKioskUserCode:   {tt.userCode},
+				urlutil.QueryKioskDeviceName: {"Lobby TV"},
+				urlutil.QueryKioskRoutes:     tt.routes,
+			}
+			r := httptest.NewRequest(http.MethodPost, "/.pomerium/admin/kiosk/approve", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			state, err := sessionStore.LoadSession(r)
+			require.NoError(t, err)
+			r = r.WithContext(sessions.NewContext(r.Context(), state, nil))
+
+			w := httptest.NewRecorder()
+			httputil.HandlerFunc(a.ApproveKioskDevice).ServeHTTP(w, r)
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusFound {
+				assert.Empty(t, saved)
+				return
+			}
+
+			device, ok := saved["type.googleapis.com/kiosk.Device/"+pending.GetId()].(*kiosk.Device)
+			require.True(t, ok, "should save the device")
+			assert.True(t, device.IsActive(time.Now()))
+			assert.False(t, device.IsActive(time.Now().Add(2*time.Hour)))
+			assert.Equal(t, "Lobby TV", device.GetName())
+			assert.Equal(t, "admin@example.com", device.GetApprovedBy())
+			assert.Equal(t, tt.routes, device.GetRoutes())
+
+			s, ok := saved["type.googleapis.com/session.Session/"+pending.GetId()].(*session.Session)
+			require.True(t, ok, "should save the device session")
+			assert.Equal(t, kiosk.UserID(pending.GetId()), s.GetUserId())
+			assert.Equal(t, device.GetExpiresAt().AsTime(), s.GetExpiresAt().AsTime())
+			u, ok := saved["type.googleapis.com/user.User/"+kiosk.UserID(pending.GetId())].(*user.User)
+			require.True(t, ok, "should save the device user")
+			assert.Equal(t, "Lobby TV", u.GetName())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity/ldap"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+)
+
+// ldapSignInsPerMinute and ldapSignInBurst are how many times each client IP,
+// and each username, can try to sign in with a password.
+const (
+	ldapSignInsPerMinute = 5
+	ldapSignInBurst      = 10
+)
+
+func newLDAPRateLimiter() *keyedRateLimiter {
+	return newKeyedRateLimiter(rate.Limit(ldapSignInsPerMinute)/60, ldapSignInBurst)
+}
+
+// ldapCallback shows the sign in form of the LDAP identity provider, and signs
+// in users with the username and password posted to it. The form is on the
+// callback, so the CSRF middleware checks its state like the state of an
+// OAuth2 callback.
+func (a *Authenticate) ldapCallback(w http.ResponseWriter, r *http.Request, idpID string, provider *ldap.Provider) error {
+	ctx, span := trace.StartSpan(r.Context(), "authenticate.ldapCallback")
+	defer span.End()
+
+	if r.Method != http.MethodPost {
+		return a.renderLDAPSignIn(w, r, http.StatusOK, "")
+	}
+
+	redirectURL, err := a.getCallbackRedirectURL(r.FormValue("state"))
+	if err != nil {
+		return err
+	}
+
+	// passwords are guessed from many IPs against one user, or from one IP
+	// against many users, so both are limited
+	username := r.FormValue("username")
+	if !a.ldapLimiter.Allow("ip/"+getClientIP(r)) || !a.ldapLimiter.Allow("username/"+strings.ToLower(username)) {
+		log.FromRequest(r).Warn().Str("username", username).Msg("authenticate: too many ldap sign in attempts")
+		return a.renderLDAPSignIn(w, r, http.StatusTooManyRequests, "Too many sign in attempts. Try again later.")
+	}
+
+	s := sessions.State{ID: uuid.New().String(), IdentityProviderID: idpID}
+	accessToken, err := provider.Authenticate(ctx, ldap.Code(username, r.FormValue("password")), &s)
+	if errors.Is(err, ldap.ErrInvalidCredentials) {
+		log.FromRequest(r).Info().Str("username", username).Msg("authenticate: invalid ldap credentials")
+		return a.renderLDAPSignIn(w, r, http.StatusUnauthorized, "Invalid username or password.")
+	} else if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	if err := a.saveCallbackSession(ctx, w, r, &s, accessToken); err != nil {
+		return err
+	}
+	httputil.Redirect(w, r, redirectURL.String(), http.StatusFound)
+	return nil
+}
+
+func (a *Authenticate) renderLDAPSignIn(w http.ResponseWriter, r *http.Request, status int, message string) error {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	return a.templates.ExecuteTemplate(w, "sign_in.html", map[string]interface{}{
+		"Action":   a.state.Load().redirectURL.Path,
+		"State":    r.FormValue("state"),
+		"Username": r.FormValue("username"),
+		"Error":    message,
+	})
+}
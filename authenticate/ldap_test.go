@@ -0,0 +1,88 @@
+package authenticate
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/identity/ldap"
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func TestAuthenticate_ldapCallback(t *testing.T) {
+	a := testAuthenticate()
+	aead, err := chacha20poly1305.NewX(cryptutil.NewKey())
+	require.NoError(t, err)
+	a.state.Load().cookieCipher = aead
+
+	provider, err := ldap.New(context.Background(), &oauth.Options{
+		ProviderURL: "ldaps://ldap.example.com/dc=example,dc=com",
+		RedirectURL: a.state.Load().redirectURL,
+	})
+	require.NoError(t, err)
+	a.provider = identity.NewAtomicAuthenticator()
+	a.provider.Store(provider)
+
+	nonce := cryptutil.NewBase64Key()
+	b := []byte(fmt.Sprintf("%s|%d|", nonce, time.Now().Unix()))
+	b = append(b, cryptutil.Encrypt(aead, []byte("https://app.example.com"), b)...)
+	state := base64.URLEncoding.EncodeToString(b)
+
+	// the provider's sign in URL shows the form
+	r := httptest.NewRequest(http.MethodGet, provider.GetSignInURL(state), nil)
+	w := httptest.NewRecorder()
+	httputil.HandlerFunc(a.OAuthCallback).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	body := w.Body.String()
+	assert.Contains(t, body, `action="/oauth/callback"`)
+	assert.Contains(t, body, `value="`+state+`" name="state"`)
+	assert.Contains(t, body, `name="password"`)
+
+	post := func(form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "https://auth.example.com/oauth/callback", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		httputil.HandlerFunc(a.OAuthCallback).ServeHTTP(w, r)
+		return w
+	}
+
+	w = post(url.Values{"state": {state}, "username": {"alice"}, "password": {""}})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid username or password.")
+	assert.Contains(t, w.Body.String(), `value="alice"`, "the username should be kept")
+
+	w = post(url.Values{"state": {"bad"}, "username": {"alice"}, "password": {"password"}})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	t.Run("rate limit", func(t *testing.T) {
+		a.ldapLimiter = newLDAPRateLimiter()
+		for i := 0; i < ldapSignInBurst; i++ {
+			w := post(url.Values{"state": {state}, "username": {"bob"}, "password": {""}})
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		}
+		w := post(url.Values{"state": {state}, "username": {"bob"}, "password": {""}})
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Contains(t, w.Body.String(), "Too many sign in attempts.")
+
+		a.ldapLimiter = newLDAPRateLimiter()
+		for i := 0; i < ldapSignInBurst; i++ {
+			a.ldapLimiter.Allow("username/carol")
+		}
+		w = post(url.Values{"state": {state}, "username": {"Carol"}, "password": {""}})
+		assert.Equal(t, http.StatusTooManyRequests, w.Code, "usernames should be limited from any ip")
+	})
+}
@@ -0,0 +1,61 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+)
+
+// programmaticRefreshPath is the path of the refresh api, which is called by
+// CLIs without a session and is exempt from CSRF protection.
+const programmaticRefreshPath = "/api/v1/refresh"
+
+// errInvalidRefreshToken is returned by the refresh api when the refresh token
+// is malformed, or its session has been signed out or has expired.
+var errInvalidRefreshToken = errors.New("invalid refresh token")
+
+// ProgrammaticRefresh exchanges the refresh token returned by a programmatic
+// login, sent as "Authorization: Pomerium <refresh token>", for a new route
+// session JWT. Refresh tokens are valid for as long as the user's session.
+func (a *Authenticate) ProgrammaticRefresh(w http.ResponseWriter, r *http.Request) error {
+	ctx, span := trace.StartSpan(r.Context(), "authenticate.ProgrammaticRefresh")
+	defer span.End()
+
+	state := a.state.Load()
+
+	refreshToken, err := sessions.FromContext(ctx)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	var s sessions.State
+	if err := state.encryptedEncoder.Unmarshal([]byte(refreshToken), &s); err != nil || !s.Programmatic {
+		return httputil.NewError(http.StatusUnauthorized, errInvalidRefreshToken)
+	}
+
+	pbSession, err := a.getDataBrokerSession(ctx, &s)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, errInvalidRefreshToken)
+	}
+	expiresAt := pbSession.GetExpiresAt().AsTime()
+	if !expiresAt.After(time.Now()) || pbSession.IsIdle(time.Now(), a.options.Load().CookieIdleTimeout) {
+		return httputil.NewError(http.StatusUnauthorized, errInvalidRefreshToken)
+	}
+
+	newSession := sessions.NewSession(&s, state.redirectURL.Host, s.Audience)
+	newSession.Expiry = jwt.NewNumericDate(expiresAt)
+	signedJWT, err := state.sharedEncoder.Marshal(newSession)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	return writeJSONResponse(w, http.StatusOK, map[string]interface{}{
+		"jwt":        string(signedJWT),
+		"expires_in": int64(time.Until(expiresAt).Seconds()),
+	})
+}
@@ -0,0 +1,72 @@
+package authenticate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestAuthenticate_ProgrammaticRefresh(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	db := newMockDataBroker(0)
+	h := newStatelessTestInstance(t, cfg, db.client())
+	state, err := newAuthenticateStateFromConfig(cfg)
+	require.NoError(t, err)
+
+	_, res := signInAcrossInstances(t, h, h)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	cookies := res.Cookies()
+
+	res = serveStateless(h, "https://auth.example.com/.pomerium/sign_in?"+url.Values{
+		urlutil.QueryRedirectURI:    {"http://127.0.0.1:8000/"},
+		urlutil.QueryCallbackURI:    {"https://app.example.com/.pomerium/callback/"},
+		urlutil.QueryIsProgrammatic: {"true"},
+	}.Encode(), cookies)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	callbackURL := mustParseURL(t, res.Header.Get("Location"))
+	assert.Equal(t, "app.example.com", callbackURL.Host)
+	refreshToken := callbackURL.Query().Get(urlutil.QueryRefreshToken)
+	require.NotEmpty(t, refreshToken)
+
+	refresh := func(token string) (int, map[string]interface{}) {
+		r := httptest.NewRequest(http.MethodPost, programmaticRefreshPath, nil)
+		r.Host = "auth.example.com"
+		r.Header.Set("Authorization", httputil.AuthorizationTypePomerium+" "+token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		var body map[string]interface{}
+		_ = json.NewDecoder(w.Body).Decode(&body)
+		return w.Code, body
+	}
+
+	status, body := refresh(refreshToken)
+	require.Equal(t, http.StatusOK, status)
+	var s sessions.State
+	require.NoError(t, state.sharedEncoder.Unmarshal([]byte(body["jwt"].(string)), &s))
+	assert.True(t, s.Programmatic)
+	assert.Contains(t, s.Audience, "app.example.com")
+	assert.Greater(t, body["expires_in"], float64(0))
+
+	t.Run("route session", func(t *testing.T) {
+		status, _ := refresh(body["jwt"].(string))
+		assert.Equal(t, http.StatusUnauthorized, status, "route session JWTs aren't refresh tokens")
+	})
+	t.Run("signed out", func(t *testing.T) {
+		require.NoError(t, session.Delete(context.Background(), db.client(), s.ID))
+		status, _ := refresh(refreshToken)
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+}
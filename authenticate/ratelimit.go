@@ -0,0 +1,71 @@
+package authenticate
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// maxRateLimiterKeys is the most keys a keyedRateLimiter tracks before it
+// forgets idle keys.
+const maxRateLimiterKeys = 10000
+
+// A keyedRateLimiter limits how often an action may be taken for each key,
+// such as a client IP address.
+type keyedRateLimiter struct {
+	limit rate.Limit
+	burst int
+	// idle is how long it takes an unused key's limiter to refill, after
+	// which it's the same as a new one and may be forgotten.
+	idle time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*keyedRateLimiterEntry
+}
+
+type keyedRateLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newKeyedRateLimiter creates a new keyedRateLimiter which allows burst
+// actions per key, refilled at limit.
+func newKeyedRateLimiter(limit rate.Limit, burst int) *keyedRateLimiter {
+	return &keyedRateLimiter{
+		limit:    limit,
+		burst:    burst,
+		idle:     time.Duration(float64(burst) / float64(limit) * float64(time.Second)),
+		limiters: make(map[string]*keyedRateLimiterEntry),
+	}
+}
+
+// Allow reports whether an action may be taken for the given key now.
+func (l *keyedRateLimiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	entry, ok := l.limiters[key]
+	if !ok {
+		if len(l.limiters) >= maxRateLimiterKeys {
+			l.forgetIdle(now)
+		}
+		entry = &keyedRateLimiterEntry{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = entry
+	}
+	entry.lastSeen = now
+	return entry.limiter.AllowN(now, 1)
+}
+
+func (l *keyedRateLimiter) forgetIdle(now time.Time) {
+	for key, entry := range l.limiters {
+		if now.Sub(entry.lastSeen) >= l.idle {
+			delete(l.limiters, key)
+		}
+	}
+	// every key is busy, so start over rather than grow without bound
+	if len(l.limiters) >= maxRateLimiterKeys {
+		l.limiters = make(map[string]*keyedRateLimiterEntry)
+	}
+}
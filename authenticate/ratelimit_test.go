@@ -0,0 +1,23 @@
+package authenticate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestKeyedRateLimiter(t *testing.T) {
+	l := newKeyedRateLimiter(rate.Every(time.Hour), 2)
+	assert.True(t, l.Allow("a"))
+	assert.True(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"), "should limit each key")
+	assert.True(t, l.Allow("b"), "should not limit other keys")
+
+	for i := 0; i < maxRateLimiterKeys; i++ {
+		l.Allow(fmt.Sprint(i))
+	}
+	assert.LessOrEqual(t, len(l.limiters), maxRateLimiterKeys, "should not track unlimited keys")
+}
@@ -0,0 +1,206 @@
+package authenticate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+// maxRoleUpdateAttempts is how many times a role change is retried when the
+// session is changed concurrently, e.g. by a token refresh.
+const maxRoleUpdateAttempts = 3
+
+// AssumeRole assumes an elevated role the user is entitled to for a limited
+// time. The reason is required and recorded in the session for auditing.
+func (a *Authenticate) AssumeRole(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	options := a.options.Load()
+
+	if err := r.ParseForm(); err != nil {
+		return httputil.NewError(http.StatusBadRequest, err)
+	}
+	role, ok := options.GetRole(r.FormValue(urlutil.QueryRole))
+	if !ok {
+		return httputil.NewError(http.StatusNotFound, fmt.Errorf("unknown role %q", r.FormValue(urlutil.QueryRole)))
+	}
+	reason := strings.TrimSpace(r.FormValue(urlutil.QueryRoleReason))
+	if reason == "" {
+		return httputil.NewError(http.StatusBadRequest, errors.New("a reason is required to assume a role"))
+	}
+	duration := role.GetMaxDuration()
+	if v := r.FormValue(urlutil.QueryRoleDuration); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid role duration %q", v))
+		}
+		if d < duration {
+			duration = d
+		}
+	}
+
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	if s.Impersonating() {
+		return httputil.NewError(http.StatusForbidden, errors.New("roles cannot be assumed while impersonating"))
+	}
+	u, err := a.getSessionUser(ctx, s.ID)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	if !role.IsEntitled(u.GetEmail(), a.getUserGroups(ctx, u.GetId())) {
+		return httputil.NewError(http.StatusForbidden, fmt.Errorf("%s is not entitled to role %q", u.GetEmail(), role.Name))
+	}
+
+	var assumed *session.AssumedRole
+	err = a.updateSessionRoles(ctx, s.ID, func(pbSession *session.Session) {
+		assumed = pbSession.AssumeRole(role.Name, reason, time.Now(), duration)
+	})
+	if err != nil {
+		return err
+	}
+	logAssumedRole(s.ID, u, assumed, "assumed")
+
+	httputil.Redirect(w, r, a.getDashboardRedirectURL(r).String(), http.StatusFound)
+	return nil
+}
+
+// DropRole drops a role assumed in the session before it expires.
+func (a *Authenticate) DropRole(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	name := r.FormValue(urlutil.QueryRole)
+	s, err := a.getSessionFromCtx(ctx)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	u, err := a.getSessionUser(ctx, s.ID)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+
+	var dropped *session.AssumedRole
+	err = a.updateSessionRoles(ctx, s.ID, func(pbSession *session.Session) {
+		for _, r := range pbSession.GetAssumedRoles() {
+			if r.GetName() == name {
+				dropped = r
+			}
+		}
+		pbSession.DropRole(name, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+	if dropped != nil {
+		logAssumedRole(s.ID, u, dropped, "dropped")
+	}
+
+	httputil.Redirect(w, r, a.getDashboardRedirectURL(r).String(), http.StatusFound)
+	return nil
+}
+
+// updateSessionRoles applies update to the session in the databroker,
+// retrying when the session is changed concurrently.
+func (a *Authenticate) updateSessionRoles(ctx context.Context, sessionID string, update func(*session.Session)) error {
+	any, _ := ptypes.MarshalAny(new(session.Session))
+	for i := 0; i < maxRoleUpdateAttempts; i++ {
+		res, err := a.dataBrokerClient.Get(ctx, &databroker.GetRequest{
+			Type: any.GetTypeUrl(),
+			Id:   sessionID,
+		})
+		if err != nil {
+			return httputil.NewError(http.StatusUnauthorized, err)
+		}
+		var pbSession session.Session
+		if err := ptypes.UnmarshalAny(res.GetRecord().GetData(), &pbSession); err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+
+		update(&pbSession)
+		_, err = session.CompareAndSet(ctx, a.dataBrokerClient, &pbSession, res.GetRecord().GetVersion())
+		if errors.Is(err, session.ErrChanged) {
+			continue
+		} else if err != nil {
+			return httputil.NewError(http.StatusInternalServerError, err)
+		}
+		return nil
+	}
+	return httputil.NewError(http.StatusConflict, session.ErrChanged)
+}
+
+// getUserGroups returns the ids, names and emails of the user's directory
+// groups, as matched by policies.
+func (a *Authenticate) getUserGroups(ctx context.Context, userID string) []string {
+	du, err := directory.GetUser(ctx, a.dataBrokerClient, userID)
+	if err != nil || du.GetInactive() {
+		return nil
+	}
+	var groups []string
+	for _, groupID := range du.GetGroupIds() {
+		if dg, err := directory.GetGroup(ctx, a.dataBrokerClient, groupID); err == nil {
+			if dg.GetName() != "" {
+				groups = append(groups, dg.GetName())
+			}
+			if dg.GetEmail() != "" {
+				groups = append(groups, dg.GetEmail())
+			}
+		}
+	}
+	return append(groups, du.GetGroupIds()...)
+}
+
+// addRolesDashboardInput adds the roles the user is entitled to, and the
+// roles assumed in the session, to the dashboard.
+func (a *Authenticate) addRolesDashboardInput(ctx context.Context, options *config.Options,
+	pbSession *session.Session, pbUser *user.User, input map[string]interface{},
+) {
+	if len(options.Roles) == 0 {
+		return
+	}
+	groups := a.getUserGroups(ctx, pbUser.GetId())
+	var roles []config.Role
+	for _, role := range options.Roles {
+		if role.IsEntitled(pbUser.GetEmail(), groups) {
+			roles = append(roles, role)
+		}
+	}
+	now := time.Now()
+	var assumed []*session.AssumedRole
+	for _, r := range pbSession.GetAssumedRoles() {
+		if r.IsActive(now) {
+			assumed = append(assumed, r)
+		}
+	}
+	input["Roles"] = roles
+	input["AssumedRoles"] = assumed
+	input["Role"] = urlutil.QueryRole
+	input["RoleReason"] = urlutil.QueryRoleReason
+	input["RoleDuration"] = urlutil.QueryRoleDuration
+}
+
+func logAssumedRole(sessionID string, u *user.User, role *session.AssumedRole, action string) {
+	log.Info().
+		Str("service", "authenticate").
+		Str("session-id", sessionID).
+		Str("user-id", u.GetId()).
+		Str("email", u.GetEmail()).
+		Str("role", role.GetName()).
+		Str("reason", role.GetReason()).
+		Time("expires-at", role.GetExpiresAt().AsTime()).
+		Msgf("role %s", action)
+}
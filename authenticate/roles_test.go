@@ -0,0 +1,174 @@
+package authenticate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	mstore "github.com/pomerium/pomerium/internal/sessions/mock"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestAuthenticate_AssumeRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		role         string
+		reason       string
+		duration     string
+		impersonate  bool
+		wantCode     int
+		wantDuration time.Duration
+	}{
+		{"good", "prod-admin", "INC-1234", "", false, http.StatusFound, time.Hour},
+		{"shorter duration", "prod-admin", "INC-1234", "10m", false, http.StatusFound, 10 * time.Minute},
+		{"longer duration", "prod-admin", "INC-1234", "10h", false, http.StatusFound, time.Hour},
+		{"by group", "db-admin", "INC-1234", "", false, http.StatusFound, 15 * time.Minute},
+		{"not entitled", "billing-admin", "INC-1234", "", false, http.StatusForbidden, 0},
+		{"unknown role", "root", "INC-1234", "", false, http.StatusNotFound, 0},
+		{"no reason", "prod-admin", " ", "", false, http.StatusBadRequest, 0},
+		{"bad duration", "prod-admin", "INC-1234", "-1m", false, http.StatusBadRequest, 0},
+		{"impersonating", "prod-admin", "INC-1234", "", true, http.StatusForbidden, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			a, saved := newTestRolesAuthenticate(t, &session.Session{Id: "SESSION_ID", UserId: "USER_ID"})
+
+			form := url.Values{
+				urlutil.QueryRole:         {tt.role},
+				urlutil.QueryRoleReason:   {tt.reason},
+				urlutil.QueryRoleDuration: {tt.duration},
+			}
+			state := &sessions.State{ID: "SESSION_ID"}
+			if tt.impersonate {
+				state.ImpersonateEmail = "user@example.com"
+			}
+			w := serveRolesRequest(t, a, state, a.AssumeRole, form)
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusFound {
+				assert.Empty(t, saved)
+				return
+			}
+
+			s, ok := saved["SESSION_ID"]
+			require.True(t, ok, "should save the session")
+			require.Len(t, s.GetAssumedRoles(), 1)
+			r := s.GetAssumedRoles()[0]
+			assert.Equal(t, tt.role, r.GetName())
+			assert.Equal(t, tt.reason, r.GetReason())
+			assert.Equal(t, tt.wantDuration, r.GetExpiresAt().AsTime().Sub(r.GetAssumedAt().AsTime()))
+			assert.Equal(t, []string{tt.role}, s.GetActiveRoles(time.Now()))
+		})
+	}
+}
+
+func TestAuthenticate_DropRole(t *testing.T) {
+	t.Parallel()
+
+	pbSession := &session.Session{Id: "SESSION_ID", UserId: "USER_ID"}
+	pbSession.AssumeRole("prod-admin", "INC-1234", time.Now(), time.Hour)
+	pbSession.AssumeRole("db-admin", "INC-1234", time.Now(), time.Hour)
+	a, saved := newTestRolesAuthenticate(t, pbSession)
+
+	w := serveRolesRequest(t, a, &sessions.State{ID: "SESSION_ID"}, a.DropRole, url.Values{
+		urlutil.QueryRole: {"prod-admin"},
+	})
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, []string{"db-admin"}, saved["SESSION_ID"].GetActiveRoles(time.Now()))
+}
+
+// newTestRolesAuthenticate returns an authenticate service whose databroker
+// contains the given session and its user, and which records the sessions it
+// saves. The first save fails as if the session was changed concurrently.
+func newTestRolesAuthenticate(t *testing.T, pbSession *session.Session) (*Authenticate, map[string]*session.Session) {
+	records := map[string]proto.Message{
+		"type.googleapis.com/session.Session/SESSION_ID": pbSession,
+		"type.googleapis.com/user.User/USER_ID":          &user.User{Id: "USER_ID", Email: "user@example.com"},
+		"type.googleapis.com/directory.User/USER_ID":     &directory.User{Id: "USER_ID", GroupIds: []string{"GROUP_ID"}},
+		"type.googleapis.com/directory.Group/GROUP_ID":   &directory.Group{Id: "GROUP_ID", Name: "dba"},
+	}
+	version := 1
+	saved := map[string]*session.Session{}
+	a := &Authenticate{
+		state: newAtomicAuthenticateState(&authenticateState{}),
+		dataBrokerClient: mockDataBrokerServiceClient{
+			get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+				msg, ok := records[in.GetType()+"/"+in.GetId()]
+				if !ok {
+					return nil, status.Error(codes.NotFound, "not found")
+				}
+				data, err := ptypes.MarshalAny(msg)
+				if err != nil {
+					return nil, err
+				}
+				return &databroker.GetResponse{Record: &databroker.Record{
+					Id:      in.GetId(),
+					Data:    data,
+					Version: strconv.Itoa(version),
+				}}, nil
+			},
+			set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+				if in.GetExpectedVersion() != strconv.Itoa(version) || version == 1 {
+					version++
+					return nil, status.Error(codes.Aborted, "version mismatch")
+				}
+				var s session.Session
+				if err := ptypes.UnmarshalAny(in.GetData(), &s); err != nil {
+					return nil, err
+				}
+				saved[in.GetId()] = &s
+				return &databroker.SetResponse{Record: &databroker.Record{Id: in.GetId(), Data: in.GetData()}}, nil
+			},
+		},
+		options: config.NewAtomicOptions(),
+	}
+	a.options.Store(&config.Options{Roles: []config.Role{
+		{Name: "prod-admin", AllowedUsers: []string{"user@example.com"}},
+		{Name: "db-admin", AllowedGroups: []string{"dba"}, MaxDuration: 15 * time.Minute},
+		{Name: "billing-admin", AllowedGroups: []string{"billing"}},
+	}})
+	return a, saved
+}
+
+func serveRolesRequest(t *testing.T, a *Authenticate, state *sessions.State,
+	handler func(http.ResponseWriter, *http.Request) error, form url.Values,
+) *httptest.ResponseRecorder {
+	signer, err := jws.NewHS256Signer(nil, "mock")
+	require.NoError(t, err)
+	sessionStore := &mstore.Store{Encrypted: true, Session: state}
+	a.state.Load().sessionStore = sessionStore
+	a.state.Load().sharedEncoder = signer
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	loaded, err := sessionStore.LoadSession(r)
+	require.NoError(t, err)
+	r = r.WithContext(sessions.NewContext(r.Context(), loaded, nil))
+
+	w := httptest.NewRecorder()
+	httputil.HandlerFunc(handler).ServeHTTP(w, r)
+	return w
+}
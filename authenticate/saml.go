@@ -0,0 +1,124 @@
+package authenticate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/identity/saml"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/samlassertion"
+)
+
+// samlRelayScript submits the relay form as soon as the page is loaded.
+const samlRelayScript = "document.forms[0].submit();"
+
+var samlRelayTemplate = template.Must(template.New("saml-relay").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><title>Signing in</title></head>
+<body>
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="code" value="{{.Code}}">
+<input type="hidden" name="state" value="{{.State}}">
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+<script>` + samlRelayScript + `</script>
+</body>
+</html>
+`))
+
+// samlRelayCSP only allows the relay page to run its own script and submit
+// its form.
+var samlRelayCSP = func() string {
+	h := sha256.Sum256([]byte(samlRelayScript))
+	return "default-src 'none'; script-src 'sha256-" + base64.StdEncoding.EncodeToString(h[:]) + "'; form-action 'self';"
+}()
+
+// relaySAMLResponse re-posts the SAML responses identity providers post to
+// the callback, as the code and state of a post from the authenticate
+// service itself.
+//
+// The identity provider's post is a cross site request, so browsers don't
+// send the CSRF cookie with it, and the state can't be checked. The re-post
+// is a same site request, so the CSRF middleware checks it like the state of
+// an OAuth2 callback.
+func (a *Authenticate) relaySAMLResponse(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callbackPath := a.state.Load().redirectURL.Path
+		if r.Method != http.MethodPost || r.URL.Path != callbackPath || r.PostFormValue("SAMLResponse") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Security-Policy", samlRelayCSP)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = samlRelayTemplate.Execute(w, map[string]string{
+			"Action": callbackPath,
+			"Code":   r.PostFormValue("SAMLResponse"),
+			"State":  r.PostFormValue("RelayState"),
+		})
+	})
+}
+
+// samlMetadata returns the metadata of the authenticate service as a SAML
+// service provider, if the identity provider uses SAML. Additional identity
+// providers are selected by their id in the query.
+func (a *Authenticate) samlMetadata(w http.ResponseWriter, r *http.Request) error {
+	idp, err := a.getIdentityProvider(r.FormValue(urlutil.QueryIdentityProviderID))
+	if err != nil {
+		return err
+	}
+	provider, ok := idp.(interface{ Metadata() ([]byte, error) })
+	if !ok {
+		return httputil.NewError(http.StatusNotFound, errSAMLNotConfigured)
+	}
+	md, err := provider.Metadata()
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	_, _ = w.Write(md)
+	return nil
+}
+
+// samlReplayCache records the SAML assertions which have been used to sign in
+// in the databroker, so that each can only be used once with any replica of
+// the authenticate service.
+type samlReplayCache struct {
+	client databroker.DataBrokerServiceClient
+}
+
+func (c samlReplayCache) Add(ctx context.Context, issuer, id string, expiry time.Time) (bool, error) {
+	ok, err := samlassertion.Add(ctx, c.client, &samlassertion.Assertion{
+		Id:        samlassertion.ID(issuer, id),
+		Issuer:    issuer,
+		ExpiresAt: timestamppb.New(expiry),
+	})
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	if err := samlassertion.DeleteExpired(ctx, c.client, time.Now()); err != nil {
+		log.Warn().Err(err).Msg("authenticate: failed to delete expired saml assertions")
+	}
+	return true, nil
+}
+
+// setSAMLReplayCache makes a SAML provider record its used assertions in the
+// databroker.
+func (a *Authenticate) setSAMLReplayCache(provider identity.Authenticator) {
+	if p, ok := provider.(interface{ SetReplayCache(saml.ReplayCache) }); ok {
+		p.SetReplayCache(samlReplayCache{client: a.dataBrokerClient})
+	}
+}
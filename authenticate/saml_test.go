@@ -0,0 +1,129 @@
+package authenticate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/samlassertion"
+)
+
+type samlMockProvider struct {
+	identity.MockProvider
+}
+
+func (samlMockProvider) Metadata() ([]byte, error) {
+	return []byte("<EntityDescriptor/>"), nil
+}
+
+func TestAuthenticate_relaySAMLResponse(t *testing.T) {
+	a := testAuthenticate()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	h := a.relaySAMLResponse(next)
+
+	form := url.Values{"SAMLResponse": {`PHNhbWxwOlJlc3BvbnNlLz4="><script>`}, "RelayState": {"STATE"}}
+	r := httptest.NewRequest(http.MethodPost, "https://auth.example.com/oauth/callback", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, samlRelayCSP, w.Header().Get("Content-Security-Policy"))
+	body := w.Body.String()
+	assert.Contains(t, body, `action="/oauth/callback"`)
+	assert.Contains(t, body, `name="code" value="PHNhbWxwOlJlc3BvbnNlLz4=&#34;&gt;&lt;script&gt;"`)
+	assert.Contains(t, body, `name="state" value="STATE"`)
+
+	for name, r := range map[string]*http.Request{
+		"get":              httptest.NewRequest(http.MethodGet, "https://auth.example.com/oauth/callback?SAMLResponse=x", nil),
+		"other path":       httptest.NewRequest(http.MethodPost, "https://auth.example.com/other?SAMLResponse=x", nil),
+		"no saml response": httptest.NewRequest(http.MethodPost, "https://auth.example.com/oauth/callback?code=x", nil),
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusTeapot, w.Code, name)
+	}
+}
+
+func TestAuthenticate_samlMetadata(t *testing.T) {
+	a := testAuthenticate()
+	a.provider = identity.NewAtomicAuthenticator()
+	a.provider.Store(identity.MockProvider{})
+
+	w := httptest.NewRecorder()
+	err := a.samlMetadata(w, httptest.NewRequest(http.MethodGet, "/.well-known/pomerium/saml/metadata", nil))
+	require.Error(t, err)
+	var httpErr *httputil.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusNotFound, httpErr.Status)
+
+	a.provider.Store(samlMockProvider{})
+	w = httptest.NewRecorder()
+	err = a.samlMetadata(w, httptest.NewRequest(http.MethodGet, "/.well-known/pomerium/saml/metadata", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "application/samlmetadata+xml", w.Header().Get("Content-Type"))
+	assert.Equal(t, "<EntityDescriptor/>", w.Body.String())
+}
+
+func TestSAMLReplayCache(t *testing.T) {
+	ctx := context.Background()
+	records := map[string]*databroker.Record{}
+	expired := &samlassertion.Assertion{Id: "EXPIRED", ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute))}
+	data, err := ptypes.MarshalAny(expired)
+	require.NoError(t, err)
+	records[expired.Id] = &databroker.Record{Id: expired.Id, Data: data}
+
+	c := samlReplayCache{client: mockDataBrokerServiceClient{
+		set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+			assert.True(t, in.GetCreateOnly())
+			if _, ok := records[in.GetId()]; ok {
+				return nil, status.Error(codes.AlreadyExists, "already exists")
+			}
+			records[in.GetId()] = &databroker.Record{Id: in.GetId(), Data: in.GetData()}
+			return &databroker.SetResponse{Record: records[in.GetId()]}, nil
+		},
+		getAll: func(ctx context.Context, in *databroker.GetAllRequest, opts ...grpc.CallOption) (*databroker.GetAllResponse, error) {
+			var res databroker.GetAllResponse
+			for _, record := range records {
+				res.Records = append(res.Records, record)
+			}
+			return &res, nil
+		},
+		delete: func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			delete(records, in.GetId())
+			return new(emptypb.Empty), nil
+		},
+	}}
+
+	ok, err := c.Add(ctx, "https://idp.example.com", "ASSERTION_ID", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Len(t, records, 1, "expired assertions should be deleted")
+	assert.Contains(t, records, samlassertion.ID("https://idp.example.com", "ASSERTION_ID"))
+
+	ok, err = c.Add(ctx, "https://idp.example.com", "ASSERTION_ID", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, ok, "assertions should only be added once")
+
+	ok, err = c.Add(ctx, "https://other.example.com", "ASSERTION_ID", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, ok, "assertion ids should be scoped to their issuer")
+}
@@ -0,0 +1,65 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/scim"
+)
+
+// scimPath is the prefix of the SCIM endpoints identity providers push user
+// and group changes to. They're called without a session and are exempt from
+// CSRF protection.
+const scimPath = "/scim/v2"
+
+// errSCIMDisabled is returned by the SCIM endpoints when no SCIM bearer token
+// is configured.
+var errSCIMDisabled = errors.New("scim is disabled")
+
+// atomicSCIMHandler stores the SCIM handler, which is nil when SCIM is
+// disabled.
+type atomicSCIMHandler struct {
+	value atomic.Value
+}
+
+func newAtomicSCIMHandler() *atomicSCIMHandler {
+	h := new(atomicSCIMHandler)
+	h.Store(nil)
+	return h
+}
+
+// Load returns the SCIM handler. It's safe to call on nil.
+func (h *atomicSCIMHandler) Load() *scim.Handler {
+	if h == nil {
+		return nil
+	}
+	return h.value.Load().(*scim.Handler)
+}
+
+func (h *atomicSCIMHandler) Store(handler *scim.Handler) {
+	h.value.Store(handler)
+}
+
+// SCIM serves the SCIM endpoints of the default identity provider, which
+// update the directory users and groups in the databroker.
+func (a *Authenticate) SCIM(w http.ResponseWriter, r *http.Request) error {
+	h := a.scim.Load()
+	if h == nil {
+		return httputil.NewError(http.StatusNotFound, errSCIMDisabled)
+	}
+	h.ServeHTTP(w, r)
+	return nil
+}
+
+// updateSCIM builds the SCIM handler for the options, so that it isn't built
+// for every request.
+func (a *Authenticate) updateSCIM(options *config.Options) {
+	if options.SCIMBearerToken == "" || a.dataBrokerClient == nil {
+		a.scim.Store(nil)
+		return
+	}
+	a.scim.Store(scim.New(scimPath, a.dataBrokerClient, options.Provider, options.SCIMBearerToken))
+}
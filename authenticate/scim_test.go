@@ -0,0 +1,38 @@
+package authenticate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestAuthenticate_SCIM(t *testing.T) {
+	t.Parallel()
+
+	a := &Authenticate{
+		dataBrokerClient: mockDataBrokerServiceClient{},
+		scim:             newAtomicSCIMHandler(),
+	}
+	serve := func() int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, scimPath+"/Users", nil)
+		_ = a.SCIM(w, r)
+		return w.Code
+	}
+
+	err := a.SCIM(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, scimPath+"/Users", nil))
+	assert.Error(t, err, "scim should be disabled without a bearer token")
+
+	a.updateSCIM(&config.Options{SCIMBearerToken: "TOKEN"})
+	h := a.scim.Load()
+	assert.NotNil(t, h)
+	assert.Equal(t, http.StatusUnauthorized, serve())
+	assert.Same(t, h, a.scim.Load(), "the handler should be reused across requests")
+
+	a.updateSCIM(&config.Options{})
+	assert.Nil(t, a.scim.Load())
+}
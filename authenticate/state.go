@@ -12,7 +12,7 @@ import (
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/encoding"
 	"github.com/pomerium/pomerium/internal/encoding/ecjson"
-	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/encoding/sessiontoken"
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/internal/sessions/cookie"
@@ -64,7 +64,7 @@ func newAuthenticateStateFromConfig(cfg *config.Config) (*authenticateState, err
 
 	// shared state encoder setup
 	var err error
-	state.sharedEncoder, err = jws.NewHS256Signer([]byte(cfg.Options.SharedKey), cfg.Options.GetAuthenticateURL().Host)
+	state.sharedEncoder, err = sessiontoken.New(cfg.Options, "authenticate")
 	if err != nil {
 		return nil, err
 	}
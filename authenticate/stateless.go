@@ -0,0 +1,71 @@
+package authenticate
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// Settings used when a session isn't found in the databroker. The callback
+// that created the session may have been handled by another authenticate
+// instance, and the write may not have reached the databroker used by this
+// instance yet.
+var (
+	sessionReplicationGracePeriod           = 10 * time.Second
+	sessionReplicationMaxRetries     uint64 = 5
+	sessionReplicationInitialBackoff        = 50 * time.Millisecond
+	sessionReplicationMaxBackoff            = time.Second
+)
+
+// getDataBrokerSession gets the session for the given session state from the
+// databroker. If the session was issued within the replication grace period,
+// NotFound errors are retried with exponential backoff.
+func (a *Authenticate) getDataBrokerSession(ctx context.Context, sessionState *sessions.State) (*session.Session, error) {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = sessionReplicationInitialBackoff
+	bo.MaxInterval = sessionReplicationMaxBackoff
+	bo.MaxElapsedTime = sessionReplicationGracePeriod
+
+	var s *session.Session
+	err := backoff.RetryNotify(func() error {
+		var err error
+		s, err = session.Get(ctx, a.dataBrokerClient, sessionState.ID)
+		if err != nil && !(isNotFound(err) && isRecentlyIssued(sessionState)) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, backoff.WithContext(backoff.WithMaxRetries(bo, sessionReplicationMaxRetries), ctx), func(err error, next time.Duration) {
+		log.Debug().Err(err).
+			Str("id", sessionState.ID).
+			Dur("next", next).
+			Msg("authenticate: retrying databroker session get")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// isRecentlyIssued returns true if the session state was issued within the
+// replication grace period.
+func isRecentlyIssued(sessionState *sessions.State) bool {
+	if sessionState.IssuedAt == nil {
+		return false
+	}
+	return time.Since(sessionState.IssuedAt.Time()) < sessionReplicationGracePeriod
+}
+
+// isNotFound returns true if the error, or an error it wraps, is a NotFound
+// gRPC status.
+func isNotFound(err error) bool {
+	var se interface{ GRPCStatus() *status.Status }
+	return errors.As(err, &se) && se.GRPCStatus().Code() == codes.NotFound
+}
@@ -0,0 +1,306 @@
+package authenticate
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/frontend"
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// stateProvider is a mock provider which includes the state in the sign in url.
+type stateProvider struct {
+	identity.MockProvider
+}
+
+func (p stateProvider) GetSignInURL(state string) string {
+	return "https://idp.example.com/authorize?" + url.Values{"state": {state}}.Encode()
+}
+
+// mockDataBroker is an in-memory databroker shared by authenticate instances.
+// The first `lag` gets for a record that was set return NotFound, to simulate
+// a write which hasn't been replicated yet.
+type mockDataBroker struct {
+	mu      sync.Mutex
+	lag     int
+	records map[string]*databroker.Record
+	misses  map[string]int
+	version uint64
+}
+
+func newMockDataBroker(lag int) *mockDataBroker {
+	return &mockDataBroker{
+		lag:     lag,
+		records: map[string]*databroker.Record{},
+		misses:  map[string]int{},
+	}
+}
+
+func (db *mockDataBroker) client() databroker.DataBrokerServiceClient {
+	return mockDataBrokerServiceClient{
+		get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+			db.mu.Lock()
+			defer db.mu.Unlock()
+			key := in.GetType() + "/" + in.GetId()
+			record, ok := db.records[key]
+			if !ok || db.misses[key] < db.lag {
+				db.misses[key]++
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return &databroker.GetResponse{Record: record}, nil
+		},
+		set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+			db.mu.Lock()
+			defer db.mu.Unlock()
+			key := in.GetType() + "/" + in.GetId()
+			current, ok := db.records[key]
+			if in.GetExpectedVersion() != "" && (!ok || current.GetVersion() != in.GetExpectedVersion()) {
+				return nil, status.Error(codes.Aborted, "changed")
+			}
+			if in.GetCreateOnly() && ok {
+				return nil, status.Error(codes.AlreadyExists, "exists")
+			}
+			db.version++
+			record := &databroker.Record{
+				Version: fmt.Sprint(db.version),
+				Type:    in.GetType(),
+				Id:      in.GetId(),
+				Data:    in.GetData(),
+			}
+			db.records[key] = record
+			return &databroker.SetResponse{Record: record, ServerVersion: "1"}, nil
+		},
+		delete: func(ctx context.Context, in *databroker.DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			db.mu.Lock()
+			defer db.mu.Unlock()
+			key := in.GetType() + "/" + in.GetId()
+			if current, ok := db.records[key]; in.GetExpectedVersion() != "" && (!ok || current.GetVersion() != in.GetExpectedVersion()) {
+				return nil, status.Error(codes.Aborted, "changed")
+			}
+			delete(db.records, key)
+			return new(emptypb.Empty), nil
+		},
+		getAll: func(ctx context.Context, in *databroker.GetAllRequest, opts ...grpc.CallOption) (*databroker.GetAllResponse, error) {
+			db.mu.Lock()
+			defer db.mu.Unlock()
+			var records []*databroker.Record
+			for _, record := range db.records {
+				if record.GetType() == in.GetType() {
+					records = append(records, record)
+				}
+			}
+			return &databroker.GetAllResponse{Records: records, ServerVersion: "1"}, nil
+		},
+	}
+}
+
+func newStatelessTestConfig(t *testing.T) *config.Config {
+	opts := config.NewDefaultOptions()
+	opts.AuthenticateURL = mustParseURL(t, "https://auth.example.com")
+	opts.SharedKey = cryptutil.NewBase64Key()
+	opts.CookieSecret = cryptutil.NewBase64Key()
+	return &config.Config{Options: opts}
+}
+
+func newStatelessTestInstance(t *testing.T, cfg *config.Config, client databroker.DataBrokerServiceClient) http.Handler {
+	state, err := newAuthenticateStateFromConfig(cfg)
+	require.NoError(t, err)
+	a := &Authenticate{
+		dataBrokerClient: client,
+		templates:        template.Must(frontend.NewTemplates()),
+		options:          config.NewAtomicOptions(),
+		provider:         identity.NewAtomicAuthenticator(),
+		state:            newAtomicAuthenticateState(state),
+		kioskLimiter:     newKioskRateLimiter(),
+		deviceLimiter:    newDeviceRateLimiter(),
+		ldapLimiter:      newLDAPRateLimiter(),
+	}
+	a.options.Store(cfg.Options)
+	a.provider.Store(stateProvider{})
+	r := mux.NewRouter()
+	a.Mount(r)
+	return r
+}
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return u
+}
+
+func serveStateless(h http.Handler, rawURL string, cookies []*http.Cookie) *http.Response {
+	u, _ := url.Parse(rawURL)
+	r := httptest.NewRequest(http.MethodGet, u.RequestURI(), nil)
+	r.Host = u.Host
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Result()
+}
+
+// signInAcrossInstances starts a sign in on the first handler, completes the
+// identity provider callback on the second, and returns the callback response.
+func signInAcrossInstances(t *testing.T, first, second http.Handler) (signInURL string, res *http.Response) {
+	signInURL = "https://auth.example.com/.pomerium/sign_in?" + url.Values{
+		urlutil.QueryRedirectURI: {"https://app.example.com/"},
+	}.Encode()
+
+	res = serveStateless(first, signInURL, nil)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	idpURL := mustParseURL(t, res.Header.Get("Location"))
+	require.Equal(t, "idp.example.com", idpURL.Host)
+
+	callbackURL := "https://auth.example.com/oauth2/callback?" + url.Values{
+		"code":  {"CODE"},
+		"state": {idpURL.Query().Get("state")},
+	}.Encode()
+	return signInURL, serveStateless(second, callbackURL, res.Cookies())
+}
+
+func TestStateless_CrossInstanceCallback(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	db := newMockDataBroker(0)
+	instanceA := newStatelessTestInstance(t, cfg, db.client())
+	instanceB := newStatelessTestInstance(t, cfg, db.client())
+
+	signInURL, res := signInAcrossInstances(t, instanceA, instanceB)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, signInURL, res.Header.Get("Location"))
+
+	// the original sign in request is retried on the first instance
+	res = serveStateless(instanceA, res.Header.Get("Location"), res.Cookies())
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, "app.example.com", mustParseURL(t, res.Header.Get("Location")).Host)
+}
+
+func TestStateless_CrossInstanceCallbackReplicationLag(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	primary := newMockDataBroker(0)
+	replica := newMockDataBroker(2)
+	replica.records = primary.records
+	instanceA := newStatelessTestInstance(t, cfg, replica.client())
+	instanceB := newStatelessTestInstance(t, cfg, primary.client())
+
+	_, res := signInAcrossInstances(t, instanceA, instanceB)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	res = serveStateless(instanceA, res.Header.Get("Location"), res.Cookies())
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, "app.example.com", mustParseURL(t, res.Header.Get("Location")).Host)
+}
+
+func TestStateless_CrossInstanceCallbackDifferentSecret(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	other := newStatelessTestConfig(t)
+	db := newMockDataBroker(0)
+	instanceA := newStatelessTestInstance(t, cfg, db.client())
+	instanceB := newStatelessTestInstance(t, other, db.client())
+
+	_, res := signInAcrossInstances(t, instanceA, instanceB)
+	assert.NotEqual(t, http.StatusFound, res.StatusCode)
+	assert.Empty(t, db.records, "no session should be created")
+}
+
+func TestAuthenticate_getDataBrokerSession(t *testing.T) {
+	t.Parallel()
+
+	any, _ := ptypes.MarshalAny(&session.Session{Id: "SESSION_ID"})
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		wantErr  bool
+	}{
+		{"recently issued", time.Now(), false},
+		{"issued before grace period", time.Now().Add(-time.Hour), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			db := newMockDataBroker(2)
+			db.records[any.GetTypeUrl()+"/SESSION_ID"] = &databroker.Record{Id: "SESSION_ID", Data: any}
+			a := &Authenticate{dataBrokerClient: db.client()}
+			s, err := a.getDataBrokerSession(context.Background(), &sessions.State{
+				ID:       "SESSION_ID",
+				IssuedAt: jwt.NewNumericDate(tt.issuedAt),
+			})
+			if tt.wantErr {
+				assert.True(t, isNotFound(err))
+				assert.Equal(t, 1, db.misses[any.GetTypeUrl()+"/SESSION_ID"])
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "SESSION_ID", s.GetId())
+		})
+	}
+}
+
+func TestStateless_IdleSession(t *testing.T) {
+	t.Parallel()
+
+	cfg := newStatelessTestConfig(t)
+	cfg.Options.CookieIdleTimeout = 30 * time.Minute
+	db := newMockDataBroker(0)
+	h := newStatelessTestInstance(t, cfg, db.client())
+
+	signInURL, res := signInAcrossInstances(t, h, h)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	cookies := res.Cookies()
+
+	res = serveStateless(h, signInURL, cookies)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, "app.example.com", mustParseURL(t, res.Header.Get("Location")).Host)
+
+	// the session was last used longer ago than the idle timeout
+	db.mu.Lock()
+	require.Len(t, db.records, 2)
+	var sessionKey string
+	for key, record := range db.records {
+		var s session.Session
+		if ptypes.UnmarshalAny(record.GetData(), &s) != nil {
+			continue
+		}
+		require.NotNil(t, s.GetLastActiveAt(), "new sessions should be active")
+		s.LastActiveAt = timestamppb.New(time.Now().Add(-time.Hour))
+		record.Data, _ = ptypes.MarshalAny(&s)
+		sessionKey = key
+	}
+	db.mu.Unlock()
+	require.NotEmpty(t, sessionKey)
+
+	res = serveStateless(h, signInURL, cookies)
+	require.Equal(t, http.StatusFound, res.StatusCode)
+	assert.Equal(t, "idp.example.com", mustParseURL(t, res.Header.Get("Location")).Host)
+	assert.NotContains(t, db.records, sessionKey, "idle sessions should be revoked")
+}
@@ -0,0 +1,54 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pomerium/pomerium/internal/identity"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+var (
+	// errStepUpRequired is returned when a route requires a step-up
+	// authentication the session didn't sign in with.
+	errStepUpRequired = errors.New("step-up authentication required")
+	// errStepUpNotPerformed is returned when the user signed in again for a
+	// route which requires a step-up authentication, but the identity
+	// provider didn't perform it.
+	errStepUpNotPerformed = errors.New("the identity provider did not perform the required step-up authentication")
+)
+
+// getStepUpRequirement returns the authentication context classes and the
+// authentication methods required by the route the user is signing in to,
+// if any.
+func getStepUpRequirement(r *http.Request) (acrValues, amrValues []string, ok bool) {
+	acrValues = strings.Fields(r.FormValue(urlutil.QueryStepUpACRValues))
+	amrValues = strings.Fields(r.FormValue(urlutil.QueryStepUpAMRValues))
+	return acrValues, amrValues, len(acrValues) > 0 || len(amrValues) > 0
+}
+
+// isSignedInSinceRedirect returns true if the user signed in with the
+// identity provider after the authorize service redirected them to sign in,
+// so asking them to sign in again would loop.
+func isSignedInSinceRedirect(s *sessions.State, r *http.Request) bool {
+	issued, err := strconv.ParseInt(r.FormValue(urlutil.QueryHmacIssued), 10, 64)
+	if err != nil || s.AuthTime == nil {
+		return false
+	}
+	return s.AuthTime.Time().Unix() >= issued
+}
+
+// getSignInURL returns the URL of the identity provider's sign in page. If
+// the route requires a step-up authentication, the identity provider is
+// asked to perform it, when it supports it.
+func getSignInURL(provider identity.Authenticator, r *http.Request, state string) string {
+	if acrValues, _, ok := getStepUpRequirement(r); ok {
+		if stepUpProvider, ok := provider.(identity.StepUpAuthenticator); ok {
+			return stepUpProvider.GetStepUpSignInURL(state, acrValues)
+		}
+	}
+	return provider.GetSignInURL(state)
+}
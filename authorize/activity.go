@@ -0,0 +1,126 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// errSessionIdle is returned when a session hasn't been used within the idle
+// timeout.
+var errSessionIdle = errors.New("session is idle")
+
+// sessionActivityUpdateTimeout limits how long recording the activity of a
+// session in the databroker may take.
+var sessionActivityUpdateTimeout = 10 * time.Second
+
+// getSessionActivityInterval returns how often the activity of a session is
+// recorded for the idle timeout. Activity is recorded at most once a minute,
+// so sessions may be considered idle up to a minute early.
+func getSessionActivityInterval(idleTimeout time.Duration) time.Duration {
+	if interval := idleTimeout / 4; interval < time.Minute {
+		return interval
+	}
+	return time.Minute
+}
+
+// sessionActivity tracks when the activity of sessions was last recorded,
+// so the authorize service doesn't record it again until the databroker
+// sync catches up.
+type sessionActivity struct {
+	mu       sync.Mutex
+	recorded map[string]time.Time
+	pruneAt  time.Time
+}
+
+func newSessionActivity() *sessionActivity {
+	return &sessionActivity{recorded: make(map[string]time.Time)}
+}
+
+// shouldRecord returns true if the activity of the session should be
+// recorded at the given time, and marks it as recorded.
+func (sa *sessionActivity) shouldRecord(s *session.Session, now time.Time, interval time.Duration) bool {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+
+	lastActiveAt := s.GetLastActiveAt().AsTime()
+	if t, ok := sa.recorded[s.GetId()]; ok && t.After(lastActiveAt) {
+		lastActiveAt = t
+	}
+	if now.Sub(lastActiveAt) < interval {
+		return false
+	}
+
+	// forget sessions whose activity the databroker sync has caught up with
+	if now.After(sa.pruneAt) {
+		for id, t := range sa.recorded {
+			if now.Sub(t) >= interval {
+				delete(sa.recorded, id)
+			}
+		}
+		sa.pruneAt = now.Add(interval)
+	}
+	sa.recorded[s.GetId()] = now
+	return true
+}
+
+// checkSessionActivity returns errSessionIdle if the session hasn't been used
+// within the idle timeout. Otherwise the activity of the session is recorded
+// in the background, if it's due.
+func (a *Authorize) checkSessionActivity(s *session.Session) error {
+	idleTimeout := a.currentOptions.Load().CookieIdleTimeout
+	if idleTimeout <= 0 {
+		return nil
+	}
+
+	now := timeNow()
+	if s.IsIdle(now, idleTimeout) {
+		return errSessionIdle
+	}
+	if a.dataBrokerClient != nil && a.sessionActivity.shouldRecord(s, now, getSessionActivityInterval(idleTimeout)) {
+		go a.recordSessionActivity(s.GetId(), now)
+	}
+	return nil
+}
+
+// recordSessionActivity sets the last activity time of a session in the
+// databroker. The session is read again so that concurrent updates, e.g.
+// by the identity manager, aren't overwritten.
+func (a *Authorize) recordSessionActivity(sessionID string, now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), sessionActivityUpdateTimeout)
+	defer cancel()
+
+	res, err := a.dataBrokerClient.Get(ctx, &databroker.GetRequest{
+		Type: sessionTypeURL,
+		Id:   sessionID,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("session_id", sessionID).Msg("authorize: failed to get session to record activity")
+		return
+	}
+	var s session.Session
+	if err := ptypes.UnmarshalAny(res.GetRecord().GetData(), &s); err != nil {
+		log.Warn().Err(err).Str("session_id", sessionID).Msg("authorize: failed to unmarshal session to record activity")
+		return
+	}
+	if s.GetLastActiveAt() != nil && !s.GetLastActiveAt().AsTime().Before(now) {
+		return
+	}
+
+	s.LastActiveAt = timestamppb.New(now)
+	_, err = session.CompareAndSet(ctx, a.dataBrokerClient, &s, res.GetRecord().GetVersion())
+	if errors.Is(err, session.ErrChanged) {
+		// the activity is recorded again after the interval
+		log.Debug().Str("session_id", sessionID).Msg("authorize: session changed while recording activity")
+	} else if err != nil {
+		log.Warn().Err(err).Str("session_id", sessionID).Msg("authorize: failed to record session activity")
+	}
+}
@@ -0,0 +1,104 @@
+package authorize
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+type mockSetDataBrokerServiceClient struct {
+	mockDataBrokerServiceClient
+
+	set func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error)
+}
+
+func (m mockSetDataBrokerServiceClient) Set(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+	return m.set(ctx, in, opts...)
+}
+
+func TestGetSessionActivityInterval(t *testing.T) {
+	assert.Equal(t, 15*time.Second, getSessionActivityInterval(time.Minute))
+	assert.Equal(t, time.Minute, getSessionActivityInterval(time.Hour))
+}
+
+func TestSessionActivity_shouldRecord(t *testing.T) {
+	now := time.Now()
+	sa := newSessionActivity()
+	s := &session.Session{Id: "SESSION_ID", LastActiveAt: timestamppb.New(now.Add(-2 * time.Minute))}
+
+	assert.True(t, sa.shouldRecord(s, now, time.Minute))
+	assert.False(t, sa.shouldRecord(s, now.Add(time.Second), time.Minute), "activity should be recorded once per interval")
+	assert.True(t, sa.shouldRecord(s, now.Add(time.Minute), time.Minute))
+
+	s.LastActiveAt = timestamppb.New(now.Add(2 * time.Minute))
+	assert.False(t, sa.shouldRecord(s, now.Add(2*time.Minute), time.Minute))
+	assert.True(t, sa.shouldRecord(&session.Session{Id: "OTHER_ID"}, now, time.Minute), "activity should be recorded for new sessions")
+}
+
+func TestAuthorize_checkSessionActivity(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	sets := make(chan *databroker.SetRequest, 1)
+	newAuthorize := func(s *session.Session) *Authorize {
+		a := &Authorize{currentOptions: config.NewAtomicOptions(), sessionActivity: newSessionActivity()}
+		a.currentOptions.Store(&config.Options{CookieIdleTimeout: 30 * time.Minute})
+		a.dataBrokerClient = mockSetDataBrokerServiceClient{
+			mockDataBrokerServiceClient: mockDataBrokerServiceClient{
+				get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+					data, _ := ptypes.MarshalAny(s)
+					return &databroker.GetResponse{Record: &databroker.Record{
+						Version: "0001",
+						Type:    data.GetTypeUrl(),
+						Id:      s.GetId(),
+						Data:    data,
+					}}, nil
+				},
+			},
+			set: func(ctx context.Context, in *databroker.SetRequest, opts ...grpc.CallOption) (*databroker.SetResponse, error) {
+				sets <- in
+				return &databroker.SetResponse{}, nil
+			},
+		}
+		return a
+	}
+
+	t.Run("idle", func(t *testing.T) {
+		s := &session.Session{Id: "SESSION_ID", LastActiveAt: timestamppb.New(now.Add(-time.Hour))}
+		assert.Equal(t, errSessionIdle, newAuthorize(s).checkSessionActivity(s))
+	})
+	t.Run("active", func(t *testing.T) {
+		s := &session.Session{Id: "SESSION_ID", LastActiveAt: timestamppb.New(now.Add(-10 * time.Minute))}
+		require.NoError(t, newAuthorize(s).checkSessionActivity(s))
+
+		select {
+		case in := <-sets:
+			assert.Equal(t, "0001", in.GetExpectedVersion())
+			var got session.Session
+			require.NoError(t, ptypes.UnmarshalAny(in.GetData(), &got))
+			assert.True(t, now.Equal(got.GetLastActiveAt().AsTime()))
+		case <-time.After(5 * time.Second):
+			t.Fatal("session activity wasn't recorded")
+		}
+	})
+	t.Run("recently active", func(t *testing.T) {
+		s := &session.Session{Id: "SESSION_ID", LastActiveAt: timestamppb.New(now.Add(-time.Second))}
+		require.NoError(t, newAuthorize(s).checkSessionActivity(s))
+		select {
+		case <-sets:
+			t.Fatal("session activity shouldn't be recorded more than once per interval")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
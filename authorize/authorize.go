@@ -8,11 +8,12 @@ import (
 	"html/template"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/encoding"
-	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/encoding/sessiontoken"
 	"github.com/pomerium/pomerium/internal/frontend"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry/metrics"
@@ -21,6 +22,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
 )
 
 type atomicMarshalUnmarshaler struct {
@@ -35,19 +37,54 @@ func (a *atomicMarshalUnmarshaler) Store(encoder encoding.MarshalUnmarshaler) {
 	a.value.Store(encoder)
 }
 
+// atomicEvaluator holds the current policy evaluator, so that a new one can be
+// swapped in while checks are still being evaluated by the old one.
+type atomicEvaluator struct {
+	value atomic.Value
+}
+
+func (a *atomicEvaluator) Load() *evaluator.Evaluator {
+	pe, _ := a.value.Load().(*evaluator.Evaluator)
+	return pe
+}
+
+func (a *atomicEvaluator) Store(pe *evaluator.Evaluator) {
+	a.value.Store(pe)
+}
+
 // Authorize struct holds
 type Authorize struct {
-	pe    *evaluator.Evaluator
+	// dataBrokerDataVersion is incremented every time the databroker data
+	// changes. It must be the first field to guarantee 64-bit alignment.
+	dataBrokerDataVersion uint64
+
+	pe    atomicEvaluator
 	store *evaluator.Store
 
 	currentOptions *config.AtomicOptions
 	currentEncoder atomicMarshalUnmarshaler
 	templates      *template.Template
 
-	dataBrokerClient databroker.DataBrokerServiceClient
+	dataBrokerClient  databroker.DataBrokerServiceClient
+	dataBrokerBreaker *circuitBreaker
+
+	upstreamTokenClient upstreamtoken.UpstreamTokenServiceClient
+	upstreamTokens      *upstreamTokenCache
 
 	dataBrokerDataLock sync.RWMutex
 	dataBrokerData     evaluator.DataBrokerData
+	tombstones         tombstones
+	dataBudget         *dataBudget
+	notFound           notFound
+	lazySessionLoading bool
+	sessionActivity    *sessionActivity
+
+	decisionCache atomicDecisionCache
+	policyData    *policyDataWatcher
+	geoIP         *geoIPLookup
+	interop       *interopVerifier
+	decisionLog   *decisionLogger
+	standby       *standbyState
 }
 
 // New validates and creates a new Authorize service from a set of config options.
@@ -56,38 +93,55 @@ func New(opts *config.Options) (*Authorize, error) {
 		return nil, fmt.Errorf("authorize: bad options: %w", err)
 	}
 
-	dataBrokerConn, err := grpc.NewGRPCClientConn(
-		&grpc.Options{
-			Addr:                    opts.DataBrokerURL,
-			OverrideCertificateName: opts.OverrideCertificateName,
-			CA:                      opts.CA,
-			CAFile:                  opts.CAFile,
-			RequestTimeout:          opts.GRPCClientTimeout,
-			ClientDNSRoundRobin:     opts.GRPCClientDNSRoundRobin,
-			WithInsecure:            opts.GRPCInsecure,
-			ServiceName:             opts.Services,
-		})
-	if err != nil {
-		return nil, fmt.Errorf("authorize: error creating cache connection: %w", err)
-	}
-
 	a := Authorize{
-		currentOptions:   config.NewAtomicOptions(),
-		store:            evaluator.NewStore(),
-		templates:        template.Must(frontend.NewTemplates()),
-		dataBrokerClient: databroker.NewDataBrokerServiceClient(dataBrokerConn),
-		dataBrokerData:   make(evaluator.DataBrokerData),
+		currentOptions:     config.NewAtomicOptions(),
+		store:              evaluator.NewStore(),
+		templates:          template.Must(frontend.NewTemplates()),
+		dataBrokerBreaker:  newDataBrokerCircuitBreaker(),
+		dataBrokerData:     make(evaluator.DataBrokerData),
+		tombstones:         make(tombstones),
+		dataBudget:         newDataBudget(opts.AuthorizeDataBudget),
+		notFound:           make(notFound),
+		sessionActivity:    newSessionActivity(),
+		lazySessionLoading: opts.AuthorizeLazySessionLoading,
+		upstreamTokens:     newUpstreamTokenCache(),
+		standby:            newStandbyState(opts.AuthorizeStandby),
 	}
 
-	var host string
-	if opts.AuthenticateURL != nil {
-		host = opts.AuthenticateURL.Host
+	if hasDataBroker(opts) {
+		dataBrokerConn, err := grpc.NewGRPCClientConn(
+			&grpc.Options{
+				Addr:                    opts.DataBrokerURL,
+				OverrideCertificateName: opts.OverrideCertificateName,
+				CA:                      opts.CA,
+				CAFile:                  opts.CAFile,
+				RequestTimeout:          opts.GRPCClientTimeout,
+				ClientDNSRoundRobin:     opts.GRPCClientDNSRoundRobin,
+				WithInsecure:            opts.GRPCInsecure,
+				ServiceName:             opts.Services,
+				Compression:             opts.DataBrokerCompressionThreshold > 0,
+				Namespace:               opts.DataBrokerNamespace,
+				ClientID:                opts.DataBrokerClientID,
+				ClientSecret:            opts.GetDataBrokerClientSecret(),
+			})
+		if err != nil {
+			return nil, fmt.Errorf("authorize: error creating cache connection: %w", err)
+		}
+		a.dataBrokerClient = databroker.NewDataBrokerServiceClient(dataBrokerConn)
+		a.upstreamTokenClient = upstreamtoken.NewUpstreamTokenServiceClient(dataBrokerConn)
 	}
-	encoder, err := jws.NewHS256Signer([]byte(opts.SharedKey), host)
+
+	encoder, err := sessiontoken.New(opts, "authorize")
 	if err != nil {
 		return nil, err
 	}
 	a.currentEncoder.Store(encoder)
+	a.policyData = newPolicyDataWatcher(a.store)
+	a.geoIP = newGeoIPLookup()
+	a.interop = newInteropVerifier()
+	a.interop.Update(opts)
+	a.decisionLog = newDecisionLogger()
+	a.decisionCache.Store(newDecisionCache(opts.AuthorizeDecisionCacheSize, opts.AuthorizeDecisionCacheTTL))
 	return &a, nil
 }
 
@@ -98,12 +152,22 @@ func validateOptions(o *config.Options) error {
 	if err := urlutil.ValidateURL(o.AuthenticateURL); err != nil {
 		return fmt.Errorf("authorize: invalid 'AUTHENTICATE_SERVICE_URL': %w", err)
 	}
+	if !hasDataBroker(o) {
+		return nil
+	}
 	if err := urlutil.ValidateURL(o.DataBrokerURL); err != nil {
 		return fmt.Errorf("authorize: invalid 'DATABROKER_SERVICE_URL': %w", err)
 	}
 	return nil
 }
 
+// hasDataBroker returns true if a databroker is used to look up sessions. It's
+// optional in sidecar mode, and without one there are no sessions, so only
+// routes which don't need a user, such as public routes, can be accessed.
+func hasDataBroker(o *config.Options) bool {
+	return o.DataBrokerURL != nil || !config.IsSidecar(o.Services)
+}
+
 // newPolicyEvaluator returns an policy evaluator.
 func newPolicyEvaluator(opts *config.Options, store *evaluator.Store) (*evaluator.Evaluator, error) {
 	metrics.AddPolicyCountCallback("pomerium-authorize", func() int64 {
@@ -112,17 +176,30 @@ func newPolicyEvaluator(opts *config.Options, store *evaluator.Store) (*evaluato
 	ctx := context.Background()
 	_, span := trace.StartSpan(ctx, "authorize.newPolicyEvaluator")
 	defer span.End()
-	return evaluator.New(opts, store)
+
+	start := time.Now()
+	pe, err := evaluator.New(opts, store)
+	metrics.RecordPolicyEvaluatorRebuild(ctx, "authorize", err, time.Since(start))
+	return pe, err
 }
 
 // OnConfigChange updates internal structures based on config.Options
 func (a *Authorize) OnConfigChange(cfg *config.Config) {
 	log.Info().Str("checksum", fmt.Sprintf("%x", cfg.Options.Checksum())).Msg("authorize: updating options")
-	a.currentOptions.Store(cfg.Options)
+	a.policyData.Update(cfg.Options.PolicyDataFiles)
+	a.geoIP.Update(cfg.Options.GeoIPCountryDatabaseFile, cfg.Options.GeoIPASNDatabaseFile)
+	a.interop.Update(cfg.Options)
+	a.decisionLog.Update(cfg.Options.DecisionLogURL, cfg.Options.DecisionLogBatchSize, cfg.Options.DecisionLogFlushInterval)
+	a.dataBudget.setMaxBytes(cfg.Options.AuthorizeDataBudget)
+
+	// checks keep using the current evaluator while the new one is built, and
+	// the options are only stored once it's ready so both are swapped together
 	pe, err := newPolicyEvaluator(cfg.Options, a.store)
 	if err != nil {
 		log.Error().Err(err).Msg("authorize: failed to update policy with options")
 		return
 	}
-	a.pe = pe
+	a.currentOptions.Store(cfg.Options)
+	a.pe.Store(pe)
+	a.decisionCache.Store(newDecisionCache(cfg.Options.AuthorizeDecisionCacheSize, cfg.Options.AuthorizeDecisionCacheTTL))
 }
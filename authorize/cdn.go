@@ -0,0 +1,63 @@
+package authorize
+
+import (
+	"net/http"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+// cdnResponse redirects an authorized request to a signed URL for the same
+// path on the route's CDN, so the CDN serves it without asking Pomerium. If
+// the route has a cookie domain, signed cookies for every URL on the CDN are
+// also set, so pages can link to other assets on the CDN directly.
+func (a *Authorize) cdnResponse(in *envoy_service_auth_v3.CheckRequest, c *config.CDN) *envoy_service_auth_v3.CheckResponse {
+	reqURL := getCheckRequestURL(in)
+	expires := timeNow().Add(c.GetTTL())
+
+	signedURL, err := c.Signer().SignURL(c.GetURL(reqURL.Path, reqURL.RawQuery), expires)
+	if err != nil {
+		log.Error().Err(err).Msg("authorize: error signing cdn url")
+		return a.deniedResponse(in, http.StatusInternalServerError, "internal error", "", nil)
+	}
+
+	envoyHeaders := []*envoy_config_core_v3.HeaderValueOption{
+		mkHeader("Cache-Control", "no-store", false),
+		mkHeader("Location", signedURL.String(), false),
+	}
+
+	if c.CookieDomain != "" {
+		baseURL := c.GetBaseURL()
+		cookies, err := c.Signer().SignCookies(baseURL, expires)
+		if err != nil {
+			log.Error().Err(err).Msg("authorize: error signing cdn cookies")
+			return a.deniedResponse(in, http.StatusInternalServerError, "internal error", "", nil)
+		}
+		for _, cookie := range cookies {
+			cookie.Domain = c.CookieDomain
+			cookie.Path = baseURL.Path
+			cookie.Expires = expires
+			cookie.Secure = baseURL.Scheme == "https"
+			cookie.HttpOnly = true
+			envoyHeaders = append(envoyHeaders, mkHeader("Set-Cookie", cookie.String(), true))
+		}
+	}
+
+	return &envoy_service_auth_v3.CheckResponse{
+		Status: &status.Status{Code: int32(codes.PermissionDenied), Message: "Redirect to CDN"},
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+			DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+				Status: &envoy_type_v3.HttpStatus{
+					Code: envoy_type_v3.StatusCode_Found,
+				},
+				Headers: envoyHeaders,
+			},
+		},
+	}
+}
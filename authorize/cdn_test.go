@@ -0,0 +1,86 @@
+package authorize
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestAuthorize_cdnResponse(t *testing.T) {
+	timeNow = func() time.Time { return time.Unix(1549751401, 0).Add(-time.Hour) }
+	defer func() { timeNow = time.Now }()
+
+	in := &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+					Host: "assets.example.com",
+					Path: "/foo",
+				},
+			},
+		},
+	}
+	getHeaders := func(res *envoy_service_auth_v3.CheckResponse) http.Header {
+		hdrs := make(http.Header)
+		for _, h := range res.GetDeniedResponse().GetHeaders() {
+			hdrs.Add(h.GetHeader().GetKey(), h.GetHeader().GetValue())
+		}
+		return hdrs
+	}
+	a := &Authorize{currentOptions: config.NewAtomicOptions()}
+
+	t.Run("signed url", func(t *testing.T) {
+		c := &config.CDN{Provider: "cloud_cdn", URL: "https://example.com", KeyID: "my-key", Key: "nZtRohdNF9m3cKM24IcK4w=="}
+		require.NoError(t, c.Validate())
+
+		res := a.cdnResponse(in, c)
+		assert.Equal(t, http.StatusFound, int(res.GetDeniedResponse().GetStatus().GetCode()))
+		hdrs := getHeaders(res)
+		assert.Equal(t, "https://example.com/foo?Expires=1549751401&KeyName=my-key&Signature=LRJM5A48WJzlGV5eKACS07XLgW4=", hdrs.Get("Location"))
+		assert.Equal(t, "no-store", hdrs.Get("Cache-Control"))
+		assert.Empty(t, hdrs.Values("Set-Cookie"))
+	})
+	t.Run("signed cookies", func(t *testing.T) {
+		c := &config.CDN{Provider: "cloud_cdn", URL: "https://example.com", KeyID: "my-key", Key: "nZtRohdNF9m3cKM24IcK4w==", CookieDomain: "example.com"}
+		require.NoError(t, c.Validate())
+
+		res := a.cdnResponse(in, c)
+		hdrs := getHeaders(res)
+		assert.Equal(t, []string{
+			"Cloud-CDN-Cookie=URLPrefix=aHR0cHM6Ly9leGFtcGxlLmNvbS8=:Expires=1549751401:KeyName=my-key:Signature=QwwnreuT1Ypm3COIEbZwQDD7le4=; " +
+				"Path=/; Domain=example.com; Expires=Sat, 09 Feb 2019 22:30:01 GMT; HttpOnly; Secure",
+		}, hdrs.Values("Set-Cookie"))
+		for _, h := range res.GetDeniedResponse().GetHeaders() {
+			if h.GetHeader().GetKey() == "Set-Cookie" {
+				assert.True(t, h.GetAppend().GetValue(), "cookies should be appended")
+			}
+		}
+	})
+	t.Run("escaped path", func(t *testing.T) {
+		c := &config.CDN{Provider: "cloud_cdn", URL: "https://cdn.example.com/assets", KeyID: "my-key", Key: "nZtRohdNF9m3cKM24IcK4w=="}
+		require.NoError(t, c.Validate())
+
+		in := &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Host: "assets.example.com",
+						Path: "/img/logo%20big.png?v=1",
+					},
+				},
+			},
+		}
+		u, err := url.Parse(getHeaders(a.cdnResponse(in, c)).Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "/assets/img/logo%20big.png", u.EscapedPath())
+		assert.Equal(t, "1", u.Query().Get("v"))
+		assert.Equal(t, "my-key", u.Query().Get("KeyName"))
+	})
+}
@@ -4,22 +4,26 @@ import (
 	"bytes"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
-	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
-	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/urlutil"
 )
 
-func (a *Authorize) okResponse(reply *evaluator.Result) *envoy_service_auth_v2.CheckResponse {
+func (a *Authorize) okResponse(reply *evaluator.Result) *envoy_service_auth_v3.CheckResponse {
 	requestHeaders, err := a.getEnvoyRequestHeaders(reply.SignedJWT)
 	if err != nil {
 		log.Warn().Err(err).Msg("authorize: error generating new request headers")
@@ -36,10 +40,16 @@ func (a *Authorize) okResponse(reply *evaluator.Result) *envoy_service_auth_v2.C
 		log.Warn().Err(err).Msg("error getting google cloud serverless authentication headers")
 	}
 
-	return &envoy_service_auth_v2.CheckResponse{
+	if hdrs, err := a.getUpstreamOAuth2Headers(reply); err == nil {
+		requestHeaders = append(requestHeaders, hdrs...)
+	} else {
+		log.Warn().Err(err).Msg("error getting upstream oauth2 headers")
+	}
+
+	return &envoy_service_auth_v3.CheckResponse{
 		Status: &status.Status{Code: int32(codes.OK), Message: reply.Message},
-		HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{
-			OkResponse: &envoy_service_auth_v2.OkHttpResponse{
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+			OkResponse: &envoy_service_auth_v3.OkHttpResponse{
 				Headers: requestHeaders,
 			},
 		},
@@ -47,10 +57,28 @@ func (a *Authorize) okResponse(reply *evaluator.Result) *envoy_service_auth_v2.C
 }
 
 func (a *Authorize) deniedResponse(
-	in *envoy_service_auth_v2.CheckRequest,
-	code int32, reason string, headers map[string]string,
-) *envoy_service_auth_v2.CheckResponse {
+	in *envoy_service_auth_v3.CheckRequest,
+	code int32, reason string, denyReason evaluator.DenyReason, headers map[string]string,
+) *envoy_service_auth_v3.CheckResponse {
+	if denyReason != "" {
+		hdrs := make(map[string]string, len(headers)+1)
+		for k, v := range headers {
+			hdrs[k] = v
+		}
+		hdrs[httputil.HeaderPomeriumDenyReason] = string(denyReason)
+		headers = hdrs
+	}
 
+	if dr := a.getDenyResponse(in); dr != nil {
+		return a.customDeniedResponse(in, dr, code, reason, denyReason, headers)
+	}
+	return a.defaultDeniedResponse(in, code, reason, denyReason, headers)
+}
+
+func (a *Authorize) defaultDeniedResponse(
+	in *envoy_service_auth_v3.CheckRequest,
+	code int32, reason string, denyReason evaluator.DenyReason, headers map[string]string,
+) *envoy_service_auth_v3.CheckResponse {
 	returnHTMLError := true
 	inHeaders := in.GetAttributes().GetRequest().GetHttp().GetHeaders()
 	if inHeaders != nil {
@@ -58,17 +86,21 @@ func (a *Authorize) deniedResponse(
 	}
 
 	if returnHTMLError {
-		return a.htmlDeniedResponse(code, reason, headers)
+		return a.htmlDeniedResponse(code, reason, denyReason, headers)
 	}
 	return a.plainTextDeniedResponse(code, reason, headers)
 }
 
-func (a *Authorize) htmlDeniedResponse(code int32, reason string, headers map[string]string) *envoy_service_auth_v2.CheckResponse {
+func (a *Authorize) htmlDeniedResponse(
+	code int32, reason string, denyReason evaluator.DenyReason, headers map[string]string,
+) *envoy_service_auth_v3.CheckResponse {
 	var details string
-	switch code {
-	case httputil.StatusInvalidClientCertificate:
+	switch {
+	case code == httputil.StatusInvalidClientCertificate:
 		details = "a valid client certificate is required to access this page"
-	case http.StatusForbidden:
+	case denyReason != "":
+		details = getDenyReasonDetails(denyReason, reason)
+	case code == http.StatusForbidden:
 		details = "access to this page is forbidden"
 	default:
 		details = reason
@@ -84,25 +116,24 @@ func (a *Authorize) htmlDeniedResponse(code int32, reason string, headers map[st
 		"StatusText": reason,
 		"CanDebug":   code/100 == 4,
 		"Error":      details,
+		"DenyReason": string(denyReason),
 	})
 	if err != nil {
 		buf.WriteString(reason)
 		log.Error().Err(err).Msg("error executing error template")
 	}
 
-	envoyHeaders := []*envoy_api_v2_core.HeaderValueOption{
+	envoyHeaders := []*envoy_config_core_v3.HeaderValueOption{
 		mkHeader("Content-Type", "text/html", false),
 	}
-	for k, v := range headers {
-		envoyHeaders = append(envoyHeaders, mkHeader(k, v, false))
-	}
+	envoyHeaders = appendSortedHeaders(envoyHeaders, headers)
 
-	return &envoy_service_auth_v2.CheckResponse{
+	return &envoy_service_auth_v3.CheckResponse{
 		Status: &status.Status{Code: int32(codes.PermissionDenied), Message: "Access Denied"},
-		HttpResponse: &envoy_service_auth_v2.CheckResponse_DeniedResponse{
-			DeniedResponse: &envoy_service_auth_v2.DeniedHttpResponse{
-				Status: &envoy_type.HttpStatus{
-					Code: envoy_type.StatusCode(code),
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+			DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+				Status: &envoy_type_v3.HttpStatus{
+					Code: envoy_type_v3.StatusCode(code),
 				},
 				Headers: envoyHeaders,
 				Body:    buf.String(),
@@ -111,29 +142,138 @@ func (a *Authorize) htmlDeniedResponse(code int32, reason string, headers map[st
 	}
 }
 
-func (a *Authorize) plainTextDeniedResponse(code int32, reason string, headers map[string]string) *envoy_service_auth_v2.CheckResponse {
-	envoyHeaders := []*envoy_api_v2_core.HeaderValueOption{
+func (a *Authorize) plainTextDeniedResponse(code int32, reason string, headers map[string]string) *envoy_service_auth_v3.CheckResponse {
+	envoyHeaders := []*envoy_config_core_v3.HeaderValueOption{
 		mkHeader("Content-Type", "text/plain", false),
 	}
+	envoyHeaders = appendSortedHeaders(envoyHeaders, headers)
+
+	return &envoy_service_auth_v3.CheckResponse{
+		Status: &status.Status{Code: int32(codes.PermissionDenied), Message: "Access Denied"},
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+			DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+				Status: &envoy_type_v3.HttpStatus{
+					Code: envoy_type_v3.StatusCode(code),
+				},
+				Headers: envoyHeaders,
+				Body:    reason,
+			},
+		},
+	}
+}
+
+// getDenyReasonDetails returns the explanation shown on the error page for a
+// deny reason.
+func getDenyReasonDetails(denyReason evaluator.DenyReason, reason string) string {
+	switch denyReason {
+	case evaluator.DenyReasonUnauthenticated:
+		return "you must sign in to access this page"
+	case evaluator.DenyReasonExpiredSession:
+		return "your session has expired, sign in again to access this page"
+	case evaluator.DenyReasonSessionTooOld:
+		return "this page requires a recent sign in, sign in again to access it"
+	case evaluator.DenyReasonStepUpRequired:
+		return "this page requires a stronger sign in, sign in again to access it"
+	case evaluator.DenyReasonIdentityProviderMismatch:
+		return "this page requires signing in with a different identity provider"
+	case evaluator.DenyReasonGroupMismatch:
+		return "your account is not allowed to access this page"
+	case evaluator.DenyReasonIPBlocked:
+		return "access to this page is not allowed from your network"
+	case evaluator.DenyReasonCustomRego:
+		if reason != "" && reason != "forbidden" {
+			return reason
+		}
+		return "access to this page was denied by policy"
+	case evaluator.DenyReasonInternalError:
+		return "an error occurred while authorizing your request, try again later"
+	case evaluator.DenyReasonInvalidClientCertificate:
+		return "a valid client certificate is required to access this page"
+	}
+	return "access to this page is forbidden"
+}
+
+// appendSortedHeaders appends headers to the envoy headers sorted by key, so
+// that responses are deterministic.
+func appendSortedHeaders(
+	envoyHeaders []*envoy_config_core_v3.HeaderValueOption,
+	headers map[string]string,
+) []*envoy_config_core_v3.HeaderValueOption {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		envoyHeaders = append(envoyHeaders, mkHeader(k, headers[k], false))
+	}
+	return envoyHeaders
+}
+
+// deadlineExceededResponse is returned when a Check request couldn't be
+// completed in time, so that the client gets a clear, retryable error instead
+// of envoy timing out the authorization request.
+func (a *Authorize) deadlineExceededResponse(in *envoy_service_auth_v3.CheckRequest) *envoy_service_auth_v3.CheckResponse {
+	return a.deniedResponse(in, http.StatusServiceUnavailable, "authorization timed out", evaluator.DenyReasonInternalError, map[string]string{
+		"Retry-After": "1",
+	})
+}
+
+// customDeniedResponse returns the deny response configured for the route
+// instead of the default error page.
+func (a *Authorize) customDeniedResponse(
+	in *envoy_service_auth_v3.CheckRequest,
+	dr *config.DenyResponse,
+	code int32, reason string, denyReason evaluator.DenyReason, headers map[string]string,
+) *envoy_service_auth_v3.CheckResponse {
+	statusCode, customHeaders, body, err := dr.Render(int(code), reason, string(denyReason), getCheckRequestURL(in).String())
+	if err != nil {
+		log.Error().Err(err).Msg("authorize: error rendering deny response")
+		return a.defaultDeniedResponse(in, code, reason, denyReason, headers)
+	}
+
+	var envoyHeaders []*envoy_config_core_v3.HeaderValueOption
+	for k, v := range customHeaders {
+		envoyHeaders = append(envoyHeaders, mkHeader(k, v, false))
+	}
 	for k, v := range headers {
 		envoyHeaders = append(envoyHeaders, mkHeader(k, v, false))
 	}
+	sort.Slice(envoyHeaders, func(i, j int) bool {
+		return envoyHeaders[i].GetHeader().GetKey() < envoyHeaders[j].GetHeader().GetKey()
+	})
 
-	return &envoy_service_auth_v2.CheckResponse{
+	return &envoy_service_auth_v3.CheckResponse{
 		Status: &status.Status{Code: int32(codes.PermissionDenied), Message: "Access Denied"},
-		HttpResponse: &envoy_service_auth_v2.CheckResponse_DeniedResponse{
-			DeniedResponse: &envoy_service_auth_v2.DeniedHttpResponse{
-				Status: &envoy_type.HttpStatus{
-					Code: envoy_type.StatusCode(code),
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+			DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+				Status: &envoy_type_v3.HttpStatus{
+					Code: envoy_type_v3.StatusCode(statusCode),
 				},
 				Headers: envoyHeaders,
-				Body:    reason,
+				Body:    body,
 			},
 		},
 	}
 }
 
-func (a *Authorize) redirectResponse(in *envoy_service_auth_v2.CheckRequest) *envoy_service_auth_v2.CheckResponse {
+// getDenyResponse returns the deny response configured for the route
+// matching the request, or nil if there isn't one.
+func (a *Authorize) getDenyResponse(in *envoy_service_auth_v3.CheckRequest) *config.DenyResponse {
+	p := a.getMatchingPolicy(getCheckRequestURL(in))
+	if p == nil {
+		return nil
+	}
+	return p.DenyResponse
+}
+
+// redirectResponse redirects the user to sign in, with the requirements the
+// route has of the sign in. If the session is too old, or the step-up
+// authentication is too old, the user must sign in again with the identity
+// provider unless they did so within the route's max age.
+func (a *Authorize) redirectResponse(
+	in *envoy_service_auth_v3.CheckRequest, p *config.Policy, denyReason evaluator.DenyReason,
+) *envoy_service_auth_v3.CheckResponse {
 	opts := a.currentOptions.Load()
 
 	signinURL := opts.GetAuthenticateURL().ResolveReference(&url.URL{Path: "/.pomerium/sign_in"})
@@ -144,16 +284,39 @@ func (a *Authorize) redirectResponse(in *envoy_service_auth_v2.CheckRequest) *en
 	url.Scheme = "https"
 
 	q.Set(urlutil.QueryRedirectURI, url.String())
+	if p != nil {
+		var maxAge time.Duration
+		switch denyReason {
+		case evaluator.DenyReasonSessionTooOld:
+			maxAge = p.AllowedSessionMaxAge
+		case evaluator.DenyReasonStepUpRequired:
+			maxAge = p.StepUpMaxAge
+		}
+		if maxAge > 0 {
+			q.Set(urlutil.QuerySessionMaxAge, strconv.FormatInt(int64(maxAge.Seconds()), 10))
+		}
+		if p.IdentityProviderID != "" {
+			q.Set(urlutil.QueryIdentityProviderID, p.IdentityProviderID)
+		}
+		// ask for the step-up authentication up front, so users without a
+		// session don't have to sign in twice
+		if len(p.RequiredACRValues) > 0 {
+			q.Set(urlutil.QueryStepUpACRValues, strings.Join(p.RequiredACRValues, " "))
+		}
+		if len(p.RequiredAMRValues) > 0 {
+			q.Set(urlutil.QueryStepUpAMRValues, strings.Join(p.RequiredAMRValues, " "))
+		}
+	}
 	signinURL.RawQuery = q.Encode()
 	redirectTo := urlutil.NewSignedURL(opts.SharedKey, signinURL).String()
 
-	return a.deniedResponse(in, http.StatusFound, "Login", map[string]string{
+	return a.defaultDeniedResponse(in, http.StatusFound, "Login", "", map[string]string{
 		"Location": redirectTo,
 	})
 }
 
-func getKubernetesHeaders(reply *evaluator.Result) []*envoy_api_v2_core.HeaderValueOption {
-	var requestHeaders []*envoy_api_v2_core.HeaderValueOption
+func getKubernetesHeaders(reply *evaluator.Result) []*envoy_config_core_v3.HeaderValueOption {
+	var requestHeaders []*envoy_config_core_v3.HeaderValueOption
 	if reply.MatchingPolicy != nil && reply.MatchingPolicy.KubernetesServiceAccountToken != "" {
 		requestHeaders = append(requestHeaders,
 			mkHeader("Authorization", "Bearer "+reply.MatchingPolicy.KubernetesServiceAccountToken, false))
@@ -168,9 +331,9 @@ func getKubernetesHeaders(reply *evaluator.Result) []*envoy_api_v2_core.HeaderVa
 	return requestHeaders
 }
 
-func mkHeader(k, v string, shouldAppend bool) *envoy_api_v2_core.HeaderValueOption {
-	return &envoy_api_v2_core.HeaderValueOption{
-		Header: &envoy_api_v2_core.HeaderValue{
+func mkHeader(k, v string, shouldAppend bool) *envoy_config_core_v3.HeaderValueOption {
+	return &envoy_config_core_v3.HeaderValueOption{
+		Header: &envoy_config_core_v3.HeaderValue{
 			Key:   k,
 			Value: v,
 		},
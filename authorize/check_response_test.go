@@ -8,9 +8,9 @@ import (
 	"testing"
 	"time"
 
-	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
-	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/status"
@@ -20,6 +20,8 @@ import (
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/encoding/jws"
 	"github.com/pomerium/pomerium/internal/frontend"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 )
@@ -42,8 +44,8 @@ func TestAuthorize_okResponse(t *testing.T) {
 	a.store = evaluator.NewStore()
 	pe, err := newPolicyEvaluator(opt, a.store)
 	require.NoError(t, err)
-	a.pe = pe
-	validJWT, _ := a.pe.SignedJWT(a.pe.JWTPayload(&evaluator.Request{
+	a.pe.Store(pe)
+	validJWT, _ := pe.SignedJWT(pe.JWTPayload(&evaluator.Request{
 		DataBrokerData: evaluator.DataBrokerData{
 			"type.googleapis.com/session.Session": map[string]interface{}{
 				"SESSION_ID": &session.Session{
@@ -84,16 +86,16 @@ func TestAuthorize_okResponse(t *testing.T) {
 	tests := []struct {
 		name  string
 		reply *evaluator.Result
-		want  *envoy_service_auth_v2.CheckResponse
+		want  *envoy_service_auth_v3.CheckResponse
 	}{
 		{
 			"ok reply",
 			&evaluator.Result{Status: 0, Message: "ok", SignedJWT: "valid-signed-jwt"},
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: 0, Message: "ok"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{
-					OkResponse: &envoy_service_auth_v2.OkHttpResponse{
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+					OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("x-pomerium-jwt-assertion", "valid-signed-jwt", false),
 						},
 					},
@@ -110,11 +112,11 @@ func TestAuthorize_okResponse(t *testing.T) {
 					KubernetesServiceAccountToken: "k8s-svc-account",
 				},
 			},
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: 0, Message: "ok"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{
-					OkResponse: &envoy_service_auth_v2.OkHttpResponse{
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+					OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("x-pomerium-jwt-assertion", "valid-signed-jwt", false),
 							mkHeader("Authorization", "Bearer k8s-svc-account", false),
 						},
@@ -134,11 +136,11 @@ func TestAuthorize_okResponse(t *testing.T) {
 				UserEmail:  "foo@example.com",
 				UserGroups: []string{"admin", "test"},
 			},
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: 0, Message: "ok"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{
-					OkResponse: &envoy_service_auth_v2.OkHttpResponse{
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+					OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("x-pomerium-jwt-assertion", "valid-signed-jwt", false),
 							mkHeader("Authorization", "Bearer k8s-svc-account", false),
 							mkHeader("Impersonate-User", "foo@example.com", false),
@@ -160,11 +162,11 @@ func TestAuthorize_okResponse(t *testing.T) {
 					Destination: mustParseURL("https://example.com"),
 				},
 			},
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: 0, Message: "ok"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{
-					OkResponse: &envoy_service_auth_v2.OkHttpResponse{
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+					OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("x-pomerium-jwt-assertion", "valid-signed-jwt", false),
 							mkHeader("Authorization", "Bearer 2020-01-01T01:00:00Z", false),
 						},
@@ -179,11 +181,11 @@ func TestAuthorize_okResponse(t *testing.T) {
 				Message:   "ok",
 				SignedJWT: validJWT,
 			},
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: 0, Message: "ok"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{
-					OkResponse: &envoy_service_auth_v2.OkHttpResponse{
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+					OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("x-pomerium-claim-email", "foo@example.com", false),
 							mkHeader("x-pomerium-jwt-assertion", validJWT, false),
 						},
@@ -219,11 +221,11 @@ func TestAuthorize_deniedResponse(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		in      *envoy_service_auth_v2.CheckRequest
+		in      *envoy_service_auth_v3.CheckRequest
 		code    int32
 		reason  string
 		headers map[string]string
-		want    *envoy_service_auth_v2.CheckResponse
+		want    *envoy_service_auth_v3.CheckResponse
 	}{
 		{
 			"html denied",
@@ -231,14 +233,14 @@ func TestAuthorize_deniedResponse(t *testing.T) {
 			http.StatusBadRequest,
 			"Access Denied",
 			nil,
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: int32(codes.PermissionDenied), Message: "Access Denied"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_DeniedResponse{
-					DeniedResponse: &envoy_service_auth_v2.DeniedHttpResponse{
-						Status: &envoy_type.HttpStatus{
-							Code: envoy_type.StatusCode(codes.InvalidArgument),
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+					DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+						Status: &envoy_type_v3.HttpStatus{
+							Code: envoy_type_v3.StatusCode(codes.InvalidArgument),
 						},
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("Content-Type", "text/html", false),
 						},
 						Body: "Access Denied",
@@ -248,10 +250,10 @@ func TestAuthorize_deniedResponse(t *testing.T) {
 		},
 		{
 			"plain text denied",
-			&envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+			&envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Headers: map[string]string{},
 						},
 					},
@@ -260,14 +262,14 @@ func TestAuthorize_deniedResponse(t *testing.T) {
 			http.StatusBadRequest,
 			"Access Denied",
 			map[string]string{},
-			&envoy_service_auth_v2.CheckResponse{
+			&envoy_service_auth_v3.CheckResponse{
 				Status: &status.Status{Code: int32(codes.PermissionDenied), Message: "Access Denied"},
-				HttpResponse: &envoy_service_auth_v2.CheckResponse_DeniedResponse{
-					DeniedResponse: &envoy_service_auth_v2.DeniedHttpResponse{
-						Status: &envoy_type.HttpStatus{
-							Code: envoy_type.StatusCode(codes.InvalidArgument),
+				HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+					DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+						Status: &envoy_type_v3.HttpStatus{
+							Code: envoy_type_v3.StatusCode(codes.InvalidArgument),
 						},
-						Headers: []*envoy_api_v2_core.HeaderValueOption{
+						Headers: []*envoy_config_core_v3.HeaderValueOption{
 							mkHeader("Content-Type", "text/plain", false),
 						},
 						Body: "Access Denied",
@@ -280,10 +282,157 @@ func TestAuthorize_deniedResponse(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			got := a.deniedResponse(tc.in, tc.code, tc.reason, tc.headers)
+			got := a.deniedResponse(tc.in, tc.code, tc.reason, "", tc.headers)
 			assert.Equal(t, tc.want.Status.Code, got.Status.Code)
 			assert.Equal(t, tc.want.Status.Message, got.Status.Message)
 			assert.Equal(t, tc.want.GetDeniedResponse().GetHeaders(), got.GetDeniedResponse().GetHeaders())
 		})
 	}
 }
+
+func TestAuthorize_customDeniedResponse(t *testing.T) {
+	dr := &config.DenyResponse{
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    `{"status": {{.Status}}, "error": {{json .Reason}}, "url": {{json .URL}}}`,
+	}
+	require.NoError(t, dr.Validate())
+
+	a := &Authorize{currentOptions: config.NewAtomicOptions()}
+	a.currentOptions.Store(&config.Options{
+		Policies: []config.Policy{{
+			Source:       &config.StringURL{URL: &url.URL{Scheme: "https", Host: "api.example.com"}},
+			DenyResponse: dr,
+		}},
+	})
+	a.templates = template.Must(frontend.NewTemplates())
+
+	newCheckRequest := func(host string) *envoy_service_auth_v3.CheckRequest {
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Scheme:  "https",
+						Host:    host,
+						Path:    "/v1/items",
+						Headers: map[string]string{"accept": "text/html"},
+					},
+				},
+			},
+		}
+	}
+
+	got := a.deniedResponse(newCheckRequest("api.example.com"), http.StatusForbidden, `user "x" is not allowed`, "", nil)
+	assert.Equal(t, int32(codes.PermissionDenied), got.GetStatus().GetCode())
+	assert.Equal(t, envoy_type_v3.StatusCode_Forbidden, got.GetDeniedResponse().GetStatus().GetCode())
+	assert.Equal(t, []*envoy_config_core_v3.HeaderValueOption{
+		mkHeader("Content-Type", "application/json", false),
+	}, got.GetDeniedResponse().GetHeaders())
+	assert.JSONEq(t, `{"status": 403, "error": "user \"x\" is not allowed", "url": "https://api.example.com/v1/items"}`,
+		got.GetDeniedResponse().GetBody())
+
+	t.Run("status code", func(t *testing.T) {
+		dr.StatusCode = http.StatusNotFound
+		defer func() { dr.StatusCode = 0 }()
+
+		got := a.deniedResponse(newCheckRequest("api.example.com"), http.StatusForbidden, "forbidden", "", nil)
+		assert.Equal(t, envoy_type_v3.StatusCode_NotFound, got.GetDeniedResponse().GetStatus().GetCode())
+		assert.Contains(t, got.GetDeniedResponse().GetBody(), `"status": 404`)
+	})
+	t.Run("other route", func(t *testing.T) {
+		got := a.deniedResponse(newCheckRequest("www.example.com"), http.StatusForbidden, "forbidden", "", nil)
+		assert.Equal(t, []*envoy_config_core_v3.HeaderValueOption{
+			mkHeader("Content-Type", "text/html", false),
+		}, got.GetDeniedResponse().GetHeaders())
+	})
+}
+
+func TestAuthorize_deniedResponseDenyReason(t *testing.T) {
+	a := &Authorize{currentOptions: config.NewAtomicOptions()}
+	a.currentOptions.Store(&config.Options{})
+	a.templates = template.Must(frontend.NewTemplates())
+
+	newCheckRequest := func(accept string) *envoy_service_auth_v3.CheckRequest {
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Scheme:  "https",
+						Host:    "www.example.com",
+						Headers: map[string]string{"accept": accept},
+					},
+				},
+			},
+		}
+	}
+
+	got := a.deniedResponse(newCheckRequest("application/json"), http.StatusForbidden, "forbidden",
+		evaluator.DenyReasonGroupMismatch, map[string]string{"Retry-After": "1"})
+	assert.Equal(t, []*envoy_config_core_v3.HeaderValueOption{
+		mkHeader("Content-Type", "text/plain", false),
+		mkHeader("Retry-After", "1", false),
+		mkHeader(httputil.HeaderPomeriumDenyReason, "group-mismatch", false),
+	}, got.GetDeniedResponse().GetHeaders())
+
+	got = a.deniedResponse(newCheckRequest("text/html"), http.StatusForbidden, "forbidden",
+		evaluator.DenyReasonGroupMismatch, nil)
+	assert.Contains(t, got.GetDeniedResponse().GetBody(), "your account is not allowed to access this page")
+	assert.Contains(t, got.GetDeniedResponse().GetBody(), "group-mismatch")
+
+	got = a.deniedResponse(newCheckRequest("text/html"), http.StatusUnauthorized, "Unauthenticated",
+		evaluator.DenyReasonExpiredSession, nil)
+	assert.Contains(t, got.GetDeniedResponse().GetBody(), "your session has expired")
+}
+
+func TestAuthorize_redirectResponse(t *testing.T) {
+	a := &Authorize{currentOptions: config.NewAtomicOptions()}
+	a.currentOptions.Store(&config.Options{
+		AuthenticateURL: mustParseURL("https://authenticate.example.com"),
+		SharedKey:       "UYgnt8bxxK5G2sFaNzyqi5Z+OgF8m2akNc0xdQx718w=",
+	})
+	a.templates = template.Must(frontend.NewTemplates())
+	in := &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+					Host: "example.com",
+					Path: "/admin",
+				},
+			},
+		},
+	}
+	getLocation := func(res *envoy_service_auth_v3.CheckResponse) *url.URL {
+		for _, h := range res.GetDeniedResponse().GetHeaders() {
+			if h.GetHeader().GetKey() == "Location" {
+				u, err := url.Parse(h.GetHeader().GetValue())
+				require.NoError(t, err)
+				return u
+			}
+		}
+		t.Fatal("missing Location header")
+		return nil
+	}
+
+	u := getLocation(a.redirectResponse(in, nil, evaluator.DenyReasonUnauthenticated))
+	assert.Equal(t, "https://example.com/admin", u.Query().Get(urlutil.QueryRedirectURI))
+	assert.Empty(t, u.Query().Get(urlutil.QuerySessionMaxAge))
+	assert.Empty(t, u.Query().Get(urlutil.QueryIdentityProviderID))
+
+	p := &config.Policy{AllowedSessionMaxAge: 5 * time.Minute}
+	u = getLocation(a.redirectResponse(in, p, evaluator.DenyReasonSessionTooOld))
+	assert.Equal(t, "300", u.Query().Get(urlutil.QuerySessionMaxAge))
+	u = getLocation(a.redirectResponse(in, p, evaluator.DenyReasonUnauthenticated))
+	assert.Empty(t, u.Query().Get(urlutil.QuerySessionMaxAge))
+
+	u = getLocation(a.redirectResponse(in, &config.Policy{IdentityProviderID: "contractors"}, evaluator.DenyReasonUnauthenticated))
+	assert.Equal(t, "contractors", u.Query().Get(urlutil.QueryIdentityProviderID))
+
+	p = &config.Policy{
+		RequiredACRValues: []string{"urn:example:mfa"},
+		RequiredAMRValues: []string{"mfa", "otp"},
+		StepUpMaxAge:      10 * time.Minute,
+	}
+	u = getLocation(a.redirectResponse(in, p, evaluator.DenyReasonStepUpRequired))
+	assert.Equal(t, "urn:example:mfa", u.Query().Get(urlutil.QueryStepUpACRValues))
+	assert.Equal(t, "mfa otp", u.Query().Get(urlutil.QueryStepUpAMRValues))
+	assert.Equal(t, "600", u.Query().Get(urlutil.QuerySessionMaxAge))
+}
@@ -0,0 +1,154 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+)
+
+// Settings used by the circuit breaker between authorize and the databroker.
+var (
+	dataBrokerCircuitBreakerWindow         = 10 * time.Second
+	dataBrokerCircuitBreakerMinRequests    = 10
+	dataBrokerCircuitBreakerErrorRate      = 0.5
+	dataBrokerCircuitBreakerOpenDuration   = 5 * time.Second
+	dataBrokerCircuitBreakerHalfOpenProbes = 3
+)
+
+var errCircuitBreakerOpen = errors.New("circuit breaker is open")
+
+type circuitBreakerState int
+
+const (
+	circuitBreakerClosed circuitBreakerState = iota
+	circuitBreakerHalfOpen
+	circuitBreakerOpen
+)
+
+func (s circuitBreakerState) String() string {
+	switch s {
+	case circuitBreakerHalfOpen:
+		return metrics.CircuitBreakerHalfOpen
+	case circuitBreakerOpen:
+		return metrics.CircuitBreakerOpen
+	}
+	return metrics.CircuitBreakerClosed
+}
+
+// A circuitBreaker stops calls to a failing dependency so that requests fail
+// fast instead of waiting for a timeout. The breaker opens when the error
+// rate within a window exceeds a threshold. After the open duration, a
+// limited number of probe calls are allowed through: if they all succeed the
+// breaker closes, and if any fail it opens again.
+type circuitBreaker struct {
+	name         string
+	window       time.Duration
+	minRequests  int
+	errorRate    float64
+	openDuration time.Duration
+	probes       int
+	now          func() time.Time
+
+	mu          sync.Mutex
+	state       circuitBreakerState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	inFlight    int
+	successes   int
+}
+
+func newDataBrokerCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{
+		name:         "databroker",
+		window:       dataBrokerCircuitBreakerWindow,
+		minRequests:  dataBrokerCircuitBreakerMinRequests,
+		errorRate:    dataBrokerCircuitBreakerErrorRate,
+		openDuration: dataBrokerCircuitBreakerOpenDuration,
+		probes:       dataBrokerCircuitBreakerHalfOpenProbes,
+		now:          time.Now,
+	}
+}
+
+// Allow returns errCircuitBreakerOpen if a call should not be made. If nil is
+// returned, the result of the call must be reported with Done.
+func (cb *circuitBreaker) Allow(ctx context.Context) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := cb.now()
+	if cb.state == circuitBreakerOpen && now.Sub(cb.openedAt) >= cb.openDuration {
+		cb.setState(ctx, circuitBreakerHalfOpen)
+	}
+
+	switch cb.state {
+	case circuitBreakerOpen:
+		metrics.RecordCircuitBreakerRejection(ctx, "authorize", cb.name)
+		return errCircuitBreakerOpen
+	case circuitBreakerHalfOpen:
+		if cb.inFlight+cb.successes >= cb.probes {
+			metrics.RecordCircuitBreakerRejection(ctx, "authorize", cb.name)
+			return errCircuitBreakerOpen
+		}
+	}
+	cb.inFlight++
+	return nil
+}
+
+// Done reports the result of a call allowed by Allow.
+func (cb *circuitBreaker) Done(ctx context.Context, failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.inFlight > 0 {
+		cb.inFlight--
+	}
+
+	switch cb.state {
+	case circuitBreakerHalfOpen:
+		if failed {
+			cb.setState(ctx, circuitBreakerOpen)
+			return
+		}
+		cb.successes++
+		if cb.successes >= cb.probes {
+			cb.setState(ctx, circuitBreakerClosed)
+		}
+	case circuitBreakerClosed:
+		now := cb.now()
+		if now.Sub(cb.windowStart) >= cb.window {
+			cb.windowStart = now
+			cb.requests, cb.failures = 0, 0
+		}
+		cb.requests++
+		if failed {
+			cb.failures++
+		}
+		if cb.requests >= cb.minRequests && float64(cb.failures)/float64(cb.requests) >= cb.errorRate {
+			cb.setState(ctx, circuitBreakerOpen)
+		}
+	}
+}
+
+func (cb *circuitBreaker) setState(ctx context.Context, state circuitBreakerState) {
+	if cb.state == state {
+		return
+	}
+	cb.state = state
+	cb.successes = 0
+	switch state {
+	case circuitBreakerOpen:
+		cb.openedAt = cb.now()
+		log.Warn().Str("circuit_breaker", cb.name).Msg("authorize: circuit breaker opened, using the last synced data")
+	case circuitBreakerClosed:
+		cb.windowStart = cb.now()
+		cb.requests, cb.failures = 0, 0
+		log.Info().Str("circuit_breaker", cb.name).Msg("authorize: circuit breaker closed")
+	}
+	metrics.RecordCircuitBreakerTransition(ctx, "authorize", cb.name, state.String())
+}
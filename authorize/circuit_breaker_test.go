@@ -0,0 +1,97 @@
+package authorize
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cb := newDataBrokerCircuitBreaker()
+	cb.now = func() time.Time { return now }
+
+	call := func(failed bool) error {
+		if err := cb.Allow(ctx); err != nil {
+			return err
+		}
+		cb.Done(ctx, failed)
+		return nil
+	}
+
+	for i := 0; i < cb.minRequests-1; i++ {
+		assert.NoError(t, call(true))
+	}
+	assert.Equal(t, circuitBreakerClosed, cb.state, "should not open before the minimum number of requests")
+	assert.NoError(t, call(true))
+	assert.Equal(t, circuitBreakerOpen, cb.state)
+	assert.Equal(t, errCircuitBreakerOpen, call(false), "should reject calls while open")
+
+	now = now.Add(cb.openDuration)
+	assert.NoError(t, cb.Allow(ctx), "should allow a probe after the open duration")
+	assert.Equal(t, circuitBreakerHalfOpen, cb.state)
+	cb.Done(ctx, true)
+	assert.Equal(t, circuitBreakerOpen, cb.state, "a failed probe should re-open the breaker")
+
+	now = now.Add(cb.openDuration)
+	for i := 0; i < cb.probes; i++ {
+		assert.NoError(t, cb.Allow(ctx))
+	}
+	assert.Equal(t, errCircuitBreakerOpen, cb.Allow(ctx), "should limit the number of probes")
+	for i := 0; i < cb.probes; i++ {
+		cb.Done(ctx, false)
+	}
+	assert.Equal(t, circuitBreakerClosed, cb.state, "successful probes should close the breaker")
+
+	t.Run("error rate", func(t *testing.T) {
+		for i := 0; i < 2*cb.minRequests; i++ {
+			assert.NoError(t, call(i%3 == 0))
+		}
+		assert.Equal(t, circuitBreakerClosed, cb.state, "should stay closed below the error rate")
+
+		now = now.Add(cb.window)
+		for i := 0; i < cb.minRequests; i++ {
+			_ = call(i%2 == 0)
+		}
+		assert.Equal(t, circuitBreakerOpen, cb.state)
+	})
+}
+
+func TestAuthorize_getDataBrokerRecordCircuitBreaker(t *testing.T) {
+	defer func(initial, max time.Duration) {
+		dataBrokerGetInitialBackoff, dataBrokerGetMaxBackoff = initial, max
+	}(dataBrokerGetInitialBackoff, dataBrokerGetMaxBackoff)
+	dataBrokerGetInitialBackoff, dataBrokerGetMaxBackoff = time.Millisecond, time.Millisecond
+
+	var calls int32
+	a := &Authorize{
+		dataBrokerClient: mockDataBrokerServiceClient{
+			get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+				atomic.AddInt32(&calls, 1)
+				return nil, status.Error(codes.Unavailable, "unavailable")
+			},
+		},
+		dataBrokerBreaker: newDataBrokerCircuitBreaker(),
+	}
+
+	for i := 0; i < a.dataBrokerBreaker.minRequests; i++ {
+		_, _ = a.getDataBrokerRecord(context.Background(), sessionTypeURL, "session1")
+	}
+	assert.Equal(t, int32(a.dataBrokerBreaker.minRequests), atomic.LoadInt32(&calls),
+		"should stop retrying once the breaker opens")
+
+	_, err := a.getDataBrokerRecord(context.Background(), sessionTypeURL, "session1")
+	assert.Equal(t, errCircuitBreakerOpen, err)
+	assert.Equal(t, int32(a.dataBrokerBreaker.minRequests), atomic.LoadInt32(&calls),
+		"should not call the databroker while the breaker is open")
+	assert.Nil(t, a.forceSyncSession(context.Background(), "session1"))
+}
@@ -0,0 +1,127 @@
+package authorize
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// isForceSyncedType returns true if the records of a type are fetched from the
+// databroker on demand when they're missing, by a force sync. They're the ones
+// which count towards the data budget, and which can be loaded lazily.
+func isForceSyncedType(typeURL string) bool {
+	return typeURL == sessionTypeURL || typeURL == userTypeURL
+}
+
+type dataBudgetKey struct {
+	typeURL, id string
+}
+
+type dataBudgetEntry struct {
+	key  dataBudgetKey
+	size int64
+}
+
+// A dataBudget tracks the approximate memory used by the session and user
+// records of the dataBrokerData, and decides which ones to evict, from the
+// least to the most recently used, to stay within the budget.
+//
+// Records are used under the read lock of the dataBrokerDataLock, so the
+// dataBudget has its own lock.
+type dataBudget struct {
+	mu         sync.Mutex
+	maxBytes   int64
+	totalBytes int64
+	lru        *list.List
+	byKey      map[dataBudgetKey]*list.Element
+}
+
+func newDataBudget(maxBytes int64) *dataBudget {
+	return &dataBudget{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		byKey:    make(map[dataBudgetKey]*list.Element),
+	}
+}
+
+// setMaxBytes changes the budget. If zero, the budget is unlimited. Records
+// are evicted to fit a smaller budget on the next update.
+func (b *dataBudget) setMaxBytes(maxBytes int64) {
+	b.mu.Lock()
+	b.maxBytes = maxBytes
+	b.mu.Unlock()
+}
+
+// touch marks a record as the most recently used one.
+func (b *dataBudget) touch(typeURL, id string) {
+	b.mu.Lock()
+	if e, ok := b.byKey[dataBudgetKey{typeURL: typeURL, id: id}]; ok {
+		b.lru.MoveToFront(e)
+	}
+	b.mu.Unlock()
+}
+
+// update tracks a record added to or deleted from the dataBrokerData, and
+// returns the records to evict to stay within the budget. The record itself
+// is never evicted.
+func (b *dataBudget) update(record *databroker.Record) []dataBudgetKey {
+	if !isForceSyncedType(record.GetType()) {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := dataBudgetKey{typeURL: record.GetType(), id: record.GetId()}
+	b.remove(key)
+	if record.GetDeletedAt() != nil {
+		return nil
+	}
+
+	entry := &dataBudgetEntry{key: key, size: int64(len(record.GetData().GetValue()))}
+	b.byKey[key] = b.lru.PushFront(entry)
+	b.totalBytes += entry.size
+
+	var evicted []dataBudgetKey
+	for b.maxBytes > 0 && b.totalBytes > b.maxBytes && b.lru.Len() > 1 {
+		key := b.lru.Back().Value.(*dataBudgetEntry).key
+		b.remove(key)
+		evicted = append(evicted, key)
+	}
+	return evicted
+}
+
+// clear forgets the records of a type.
+func (b *dataBudget) clear(typeURL string) {
+	b.mu.Lock()
+	for key := range b.byKey {
+		if key.typeURL == typeURL {
+			b.remove(key)
+		}
+	}
+	b.mu.Unlock()
+}
+
+// remove must be called with mu held.
+func (b *dataBudget) remove(key dataBudgetKey) {
+	e, ok := b.byKey[key]
+	if !ok {
+		return
+	}
+	b.totalBytes -= e.Value.(*dataBudgetEntry).size
+	b.lru.Remove(e)
+	delete(b.byKey, key)
+}
+
+// updateDataBudget tracks a record added to or deleted from the
+// dataBrokerData, and evicts the least recently used records to stay within
+// the budget. Evicted records are fetched again by a force sync when they're
+// needed, so the data version isn't changed, and cached decisions stay valid.
+// It must be called with the dataBrokerDataLock held.
+func (a *Authorize) updateDataBudget(record *databroker.Record) {
+	for _, key := range a.dataBudget.update(record) {
+		a.dataBrokerData.Delete(key.typeURL, key.id)
+		a.store.DeleteRecord(key.typeURL, key.id)
+	}
+}
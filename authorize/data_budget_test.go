@@ -0,0 +1,61 @@
+package authorize
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestDataBudget(t *testing.T) {
+	newRecord := func(id string) *databroker.Record {
+		data, _ := anypb.New(&session.Session{Id: id, UserId: "user1"})
+		return &databroker.Record{Version: "0001", Type: sessionTypeURL, Id: id, Data: data}
+	}
+	recordSize := int64(len(newRecord("session1").GetData().GetValue()))
+
+	var gets int32
+	a, err := New(&config.Options{
+		AuthenticateURL:     mustParseURL("https://authN.example.com"),
+		DataBrokerURL:       mustParseURL("https://cache.example.com"),
+		SharedKey:           "gXK6ggrlIW2HyKyUF9rUO4azrDgxhDPWqw9y+lJU7B8=",
+		AuthorizeDataBudget: 2 * recordSize,
+	})
+	require.NoError(t, err)
+	a.dataBrokerClient = mockDataBrokerServiceClient{
+		get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+			atomic.AddInt32(&gets, 1)
+			return &databroker.GetResponse{Record: newRecord(in.GetId())}, nil
+		},
+	}
+	hasSession := func(id string) bool {
+		return a.dataBrokerData.Get(sessionTypeURL, id) != nil
+	}
+
+	a.updateRecord(newRecord("session1"))
+	a.updateRecord(newRecord("session2"))
+	assert.NotNil(t, a.forceSyncSession(context.Background(), "session1"))
+	a.updateRecord(newRecord("session3"))
+	assert.True(t, hasSession("session1"), "recently used records should be kept")
+	assert.False(t, hasSession("session2"), "the least recently used record should be evicted")
+	assert.True(t, hasSession("session3"))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&gets))
+
+	assert.NotNil(t, a.forceSyncSession(context.Background(), "session2"),
+		"evicted records should be fetched from the databroker")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets))
+	assert.True(t, hasSession("session2"))
+	assert.False(t, hasSession("session1"))
+
+	a.clearRecords(sessionTypeURL)
+	assert.Zero(t, a.dataBudget.totalBytes)
+	assert.Zero(t, a.dataBudget.lru.Len())
+}
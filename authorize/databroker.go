@@ -0,0 +1,120 @@
+package authorize
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// Settings used when getting records from the databroker during a Check.
+var (
+	dataBrokerGetMaxRetries     uint64 = 3
+	dataBrokerGetInitialBackoff        = 25 * time.Millisecond
+	dataBrokerGetMaxBackoff            = 250 * time.Millisecond
+	dataBrokerGetHedgeDelay            = 100 * time.Millisecond
+)
+
+// getDataBrokerRecord gets a record from the databroker. Transient errors are
+// retried with exponential backoff, and if a request doesn't complete within
+// the hedge delay, a second request is sent and the first response is used.
+// NotFound errors are returned immediately, and are always returned if there's
+// no databroker. While the databroker circuit breaker is open,
+// errCircuitBreakerOpen is returned without making a request so that the last
+// synced data is used instead.
+func (a *Authorize) getDataBrokerRecord(ctx context.Context, typeURL, id string) (*databroker.Record, error) {
+	if a.dataBrokerClient == nil {
+		return nil, status.Error(codes.NotFound, "no databroker is configured")
+	}
+
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = dataBrokerGetInitialBackoff
+	bo.MaxInterval = dataBrokerGetMaxBackoff
+	bo.MaxElapsedTime = 0
+
+	var res *databroker.GetResponse
+	err := backoff.RetryNotify(func() error {
+		if a.dataBrokerBreaker != nil {
+			if err := a.dataBrokerBreaker.Allow(ctx); err != nil {
+				return backoff.Permanent(err)
+			}
+		}
+		var err error
+		res, err = hedgeDataBrokerGet(ctx, dataBrokerGetHedgeDelay, func(ctx context.Context) (*databroker.GetResponse, error) {
+			return a.dataBrokerClient.Get(ctx, &databroker.GetRequest{
+				Type: typeURL,
+				Id:   id,
+			})
+		})
+		if a.dataBrokerBreaker != nil {
+			a.dataBrokerBreaker.Done(ctx, err != nil && isTransientDataBrokerError(err))
+		}
+		if err != nil && !isTransientDataBrokerError(err) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, backoff.WithContext(backoff.WithMaxRetries(bo, dataBrokerGetMaxRetries), ctx), func(err error, next time.Duration) {
+		log.Debug().Err(err).
+			Str("type", typeURL).
+			Str("id", id).
+			Dur("next", next).
+			Msg("authorize: retrying databroker get")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetRecord(), nil
+}
+
+// hedgeDataBrokerGet calls get, and calls it a second time if the first call
+// hasn't completed after delay. The first successful or non-transient result
+// is returned.
+func hedgeDataBrokerGet(
+	ctx context.Context,
+	delay time.Duration,
+	get func(context.Context) (*databroker.GetResponse, error),
+) (*databroker.GetResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		res *databroker.GetResponse
+		err error
+	}
+	results := make(chan result, 2)
+	call := func() {
+		res, err := get(ctx)
+		results <- result{res: res, err: err}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	go call()
+	pending := 1
+	for {
+		select {
+		case <-timer.C:
+			pending++
+			go call()
+		case r := <-results:
+			pending--
+			if r.err == nil || !isTransientDataBrokerError(r.err) || pending == 0 {
+				return r.res, r.err
+			}
+		}
+	}
+}
+
+func isTransientDataBrokerError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Unknown:
+		return true
+	}
+	return false
+}
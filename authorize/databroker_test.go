@@ -0,0 +1,95 @@
+package authorize
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestAuthorize_getDataBrokerRecord(t *testing.T) {
+	defer func(initial, max time.Duration) {
+		dataBrokerGetInitialBackoff, dataBrokerGetMaxBackoff = initial, max
+	}(dataBrokerGetInitialBackoff, dataBrokerGetMaxBackoff)
+	dataBrokerGetInitialBackoff, dataBrokerGetMaxBackoff = time.Millisecond, time.Millisecond
+
+	newAuthorize := func(get func(calls int32) (*databroker.GetResponse, error)) (*Authorize, *int32) {
+		var calls int32
+		return &Authorize{
+			dataBrokerClient: mockDataBrokerServiceClient{
+				get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+					return get(atomic.AddInt32(&calls, 1))
+				},
+			},
+		}, &calls
+	}
+
+	t.Run("retry transient errors", func(t *testing.T) {
+		a, calls := newAuthorize(func(calls int32) (*databroker.GetResponse, error) {
+			if calls < 3 {
+				return nil, status.Error(codes.Unavailable, "unavailable")
+			}
+			return &databroker.GetResponse{Record: &databroker.Record{Id: "session1"}}, nil
+		})
+		record, err := a.getDataBrokerRecord(context.Background(), sessionTypeURL, "session1")
+		assert.NoError(t, err)
+		assert.Equal(t, "session1", record.GetId())
+		assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+	})
+	t.Run("give up", func(t *testing.T) {
+		a, calls := newAuthorize(func(calls int32) (*databroker.GetResponse, error) {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		})
+		_, err := a.getDataBrokerRecord(context.Background(), sessionTypeURL, "session1")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(dataBrokerGetMaxRetries+1), atomic.LoadInt32(calls))
+	})
+	t.Run("not found", func(t *testing.T) {
+		a, calls := newAuthorize(func(calls int32) (*databroker.GetResponse, error) {
+			return nil, status.Error(codes.NotFound, "record not found")
+		})
+		_, err := a.getDataBrokerRecord(context.Background(), sessionTypeURL, "session1")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls), "should not retry not found errors")
+	})
+}
+
+func TestAuthorize_getDataBrokerRecordWithoutDataBroker(t *testing.T) {
+	a := &Authorize{}
+	_, err := a.getDataBrokerRecord(context.Background(), sessionTypeURL, "SESSION_ID")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Nil(t, a.forceSyncSession(context.Background(), "SESSION_ID"))
+}
+
+func Test_hedgeDataBrokerGet(t *testing.T) {
+	t.Run("hedged", func(t *testing.T) {
+		var calls int32
+		res, err := hedgeDataBrokerGet(context.Background(), time.Millisecond, func(ctx context.Context) (*databroker.GetResponse, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				// the first request hangs until it's canceled
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return &databroker.GetResponse{Record: &databroker.Record{Id: "hedged"}}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "hedged", res.GetRecord().GetId())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+	t.Run("fast", func(t *testing.T) {
+		var calls int32
+		_, err := hedgeDataBrokerGet(context.Background(), time.Hour, func(ctx context.Context) (*databroker.GetResponse, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls), "should not hedge requests that complete quickly")
+	})
+}
@@ -0,0 +1,46 @@
+package authorize
+
+import (
+	"context"
+	"time"
+)
+
+// Settings used to derive the deadline of a Check request.
+var (
+	// checkDeadlineMargin is subtracted from the time envoy will wait for a
+	// Check response, so that authorize can return a deny of its own before
+	// envoy gives up on the request.
+	checkDeadlineMargin = 100 * time.Millisecond
+	// defaultCheckTimeout matches the ext_authz timeout configured for envoy
+	// when no gRPC client timeout is set.
+	defaultCheckTimeout = 30 * time.Second
+)
+
+// withCheckDeadline returns a context whose deadline is slightly before the
+// time envoy will stop waiting for the Check response. Envoy sends its ext_authz
+// timeout as the gRPC deadline. If there isn't one, the configured gRPC client
+// timeout, which envoy also uses for ext_authz, is used instead.
+func (a *Authorize) withCheckDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	now := time.Now()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		timeout := defaultCheckTimeout
+		if options := a.currentOptions.Load(); options != nil && options.GRPCClientTimeout > 0 {
+			timeout = options.GRPCClientTimeout
+		}
+		deadline = now.Add(timeout)
+	}
+
+	// for very short timeouts, keep half of the remaining time for the margin
+	margin := checkDeadlineMargin
+	if remaining := deadline.Sub(now); remaining < 2*margin {
+		margin = remaining / 2
+	}
+	return context.WithDeadline(ctx, deadline.Add(-margin))
+}
+
+// isCheckDeadlineExceeded returns true if the Check deadline derived by
+// withCheckDeadline has passed.
+func isCheckDeadlineExceeded(ctx context.Context) bool {
+	return ctx.Err() == context.DeadlineExceeded
+}
@@ -0,0 +1,108 @@
+package authorize
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"testing"
+	"time"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/frontend"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestAuthorize_withCheckDeadline(t *testing.T) {
+	a := &Authorize{currentOptions: config.NewAtomicOptions()}
+	a.currentOptions.Store(&config.Options{GRPCClientTimeout: 5 * time.Second})
+
+	t.Run("envoy deadline", func(t *testing.T) {
+		parent, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		parentDeadline, _ := parent.Deadline()
+
+		ctx, cancel := a.withCheckDeadline(parent)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.Equal(t, parentDeadline.Add(-checkDeadlineMargin), deadline)
+	})
+	t.Run("short envoy deadline", func(t *testing.T) {
+		parent, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		parentDeadline, _ := parent.Deadline()
+
+		ctx, cancel := a.withCheckDeadline(parent)
+		defer cancel()
+		deadline, _ := ctx.Deadline()
+		assert.True(t, deadline.Before(parentDeadline))
+		assert.True(t, deadline.After(time.Now()), "should keep some of the remaining time for the request")
+	})
+	t.Run("grpc client timeout", func(t *testing.T) {
+		ctx, cancel := a.withCheckDeadline(context.Background())
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(5*time.Second-checkDeadlineMargin), deadline, time.Second)
+	})
+}
+
+func TestAuthorize_CheckDeadlineExceeded(t *testing.T) {
+	opts := config.NewDefaultOptions()
+	opts.AuthenticateURL = mustParseURL("https://authenticate.example.com")
+	opts.Policies = []config.Policy{{From: "https://from.example.com", To: "https://to.example.com", AllowedUsers: []string{"user@example.com"}}}
+	require.NoError(t, opts.Policies[0].Validate())
+
+	encoder, err := jws.NewHS256Signer([]byte{0, 0, 0, 0}, "")
+	require.NoError(t, err)
+	rawJWT, err := encoder.Marshal(&sessions.State{ID: "session1"})
+	require.NoError(t, err)
+
+	a := &Authorize{
+		currentOptions: config.NewAtomicOptions(),
+		store:          evaluator.NewStore(),
+		templates:      template.Must(frontend.NewTemplates()),
+		dataBrokerData: make(evaluator.DataBrokerData),
+		dataBrokerClient: mockDataBrokerServiceClient{
+			get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		},
+	}
+	a.currentOptions.Store(opts)
+	a.currentEncoder.Store(encoder)
+	pe, err := newPolicyEvaluator(opts, a.store)
+	require.NoError(t, err)
+	a.pe.Store(pe)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	res, err := a.Check(ctx, &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+					Method: http.MethodGet,
+					Scheme: "https",
+					Host:   "from.example.com",
+					Path:   "/",
+					Headers: map[string]string{
+						"authorization": "Pomerium " + string(rawJWT),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.NoError(t, ctx.Err(), "should respond before the envoy deadline")
+	assert.Equal(t, envoy_type_v3.StatusCode_ServiceUnavailable, res.GetDeniedResponse().GetStatus().GetCode())
+}
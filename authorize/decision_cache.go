@@ -0,0 +1,159 @@
+package authorize
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+)
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
+// decisionCacheKey identifies an authorization decision. Any change to the
+// databroker data bumps dataVersion, so stale decisions are never returned
+// after a record update.
+type decisionCacheKey struct {
+	sessionID         string
+	impersonateEmail  string
+	impersonateGroups string
+	clientCertificate string
+	authContext       string
+	dataVersion       uint64
+	routeID           uint64
+	method            string
+	grpcMethod        string
+	openAPIOperation  string
+	graphQLOperation  string
+}
+
+type decisionCacheEntry struct {
+	result *evaluator.Result
+	expiry time.Time
+}
+
+// A decisionCache is an LRU cache of evaluator results with a TTL.
+type decisionCache struct {
+	ttl time.Duration
+	lru *lru.Cache
+}
+
+// newDecisionCache creates a new decisionCache. If size is not positive,
+// caching is disabled and nil is returned.
+func newDecisionCache(size int, ttl time.Duration) *decisionCache {
+	if size <= 0 || ttl <= 0 {
+		return nil
+	}
+	c, err := lru.New(size)
+	if err != nil {
+		return nil
+	}
+	return &decisionCache{ttl: ttl, lru: c}
+}
+
+// Get returns the cached result for the given key if it exists and has not
+// expired.
+func (c *decisionCache) Get(key decisionCacheKey) (*evaluator.Result, bool) {
+	if c == nil {
+		return nil, false
+	}
+	value, ok := c.lru.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := value.(decisionCacheEntry)
+	if timeNow().After(entry.expiry) {
+		c.lru.Remove(key)
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// Add adds a result to the cache.
+func (c *decisionCache) Add(key decisionCacheKey, result *evaluator.Result) {
+	if c == nil {
+		return
+	}
+	c.lru.Add(key, decisionCacheEntry{
+		result: result,
+		expiry: timeNow().Add(c.ttl),
+	})
+}
+
+// wrap the decisionCache to support a nil value in an atomic.Value.
+type decisionCacheValue struct {
+	*decisionCache
+}
+
+type atomicDecisionCache struct {
+	value atomic.Value
+}
+
+func (a *atomicDecisionCache) Load() *decisionCache {
+	v, _ := a.value.Load().(decisionCacheValue)
+	return v.decisionCache
+}
+
+func (a *atomicDecisionCache) Store(c *decisionCache) {
+	a.value.Store(decisionCacheValue{c})
+}
+
+// getDecisionCacheKey returns the cache key for an evaluator request. If the
+// decision depends on more than the session and the matched route, false is
+// returned and the request must always be evaluated.
+func (a *Authorize) getDecisionCacheKey(req *evaluator.Request, policy *config.Policy) (decisionCacheKey, bool) {
+	switch {
+	case policy == nil,
+		policy.AllowedSessionMaxAge > 0,
+		// assumed roles expire without a change to the session record
+		len(policy.AllowedRoles) > 0,
+		policy.StepUpMaxAge > 0,
+		len(policy.AllowedIPLists) > 0,
+		policy.JWTAssertionTTL > 0,
+		len(req.CustomPolicies) > 0,
+		req.Interop != nil,
+		req.HTTP.Method == http.MethodOptions,
+		strings.Contains(req.HTTP.URL, "/.pomerium/"):
+		return decisionCacheKey{}, false
+	}
+
+	return decisionCacheKey{
+		sessionID:         req.Session.ID,
+		impersonateEmail:  req.Session.ImpersonateEmail,
+		impersonateGroups: strings.Join(req.Session.ImpersonateGroups, ","),
+		clientCertificate: req.HTTP.ClientCertificate,
+		authContext:       req.Session.ACR + " " + strings.Join(req.Session.AMR, ","),
+		dataVersion:       atomic.LoadUint64(&a.dataBrokerDataVersion),
+		routeID:           policy.RouteID(),
+		method:            req.HTTP.Method,
+		grpcMethod:        getGRPCMethodName(req.GRPC),
+		openAPIOperation:  getOpenAPIOperationID(req.OpenAPI),
+		graphQLOperation:  getGraphQLOperation(req.GraphQL),
+	}, true
+}
+
+func getGraphQLOperation(graphQL *evaluator.RequestGraphQL) string {
+	if graphQL == nil {
+		return ""
+	}
+	return graphQL.OperationType + " " + graphQL.OperationName + " " + strings.Join(graphQL.Fields, ",")
+}
+
+func getOpenAPIOperationID(openAPI *evaluator.RequestOpenAPI) string {
+	if openAPI == nil {
+		return ""
+	}
+	return openAPI.OperationID
+}
+
+func getGRPCMethodName(grpc *evaluator.RequestGRPC) string {
+	if grpc == nil {
+		return ""
+	}
+	return grpc.Service + "/" + grpc.Method
+}
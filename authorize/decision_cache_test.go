@@ -0,0 +1,136 @@
+package authorize
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestDecisionCache(t *testing.T) {
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, newDecisionCache(0, time.Second))
+		assert.Nil(t, newDecisionCache(10, 0))
+
+		var c *decisionCache
+		c.Add(decisionCacheKey{sessionID: "s1"}, &evaluator.Result{Status: 200})
+		_, ok := c.Get(decisionCacheKey{sessionID: "s1"})
+		assert.False(t, ok)
+	})
+	t.Run("get", func(t *testing.T) {
+		c := newDecisionCache(10, time.Second)
+		k1 := decisionCacheKey{sessionID: "s1", routeID: 1}
+		k2 := decisionCacheKey{sessionID: "s1", routeID: 1, dataVersion: 1}
+
+		c.Add(k1, &evaluator.Result{Status: 200})
+		res, ok := c.Get(k1)
+		if assert.True(t, ok) {
+			assert.Equal(t, 200, res.Status)
+		}
+		_, ok = c.Get(k2)
+		assert.False(t, ok, "should not return results for a different data version")
+	})
+	t.Run("expiry", func(t *testing.T) {
+		c := newDecisionCache(10, time.Second)
+		k := decisionCacheKey{sessionID: "s1", routeID: 1}
+		c.Add(k, &evaluator.Result{Status: 200})
+
+		now = now.Add(2 * time.Second)
+		_, ok := c.Get(k)
+		assert.False(t, ok)
+	})
+}
+
+func TestAuthorize_getDecisionCacheKey(t *testing.T) {
+	a := &Authorize{}
+	policy := &config.Policy{
+		Source: &config.StringURL{URL: &url.URL{Scheme: "https", Host: "example.com"}},
+	}
+	newRequest := func(method, rawURL string) *evaluator.Request {
+		return &evaluator.Request{
+			HTTP: evaluator.RequestHTTP{
+				Method: method,
+				URL:    rawURL,
+			},
+			Session: evaluator.RequestSession{
+				ID: "session-1",
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		req           *evaluator.Request
+		policy        *config.Policy
+		wantCacheable bool
+	}{
+		{"ok", newRequest("GET", "https://example.com/"), policy, true},
+		{"no policy", newRequest("GET", "https://example.com/"), nil, false},
+		{"options", newRequest("OPTIONS", "https://example.com/"), policy, false},
+		{"pomerium path", newRequest("GET", "https://example.com/.pomerium/jwt"), policy, false},
+		{"custom policies", &evaluator.Request{
+			HTTP:           evaluator.RequestHTTP{Method: "GET", URL: "https://example.com/"},
+			CustomPolicies: []string{"allow = true"},
+		}, policy, false},
+		{"session max age", newRequest("GET", "https://example.com/"), &config.Policy{
+			Source:               policy.Source,
+			AllowedSessionMaxAge: time.Minute,
+		}, false},
+		{"allowed roles", newRequest("GET", "https://example.com/"), &config.Policy{
+			Source:       policy.Source,
+			AllowedRoles: []string{"break-glass"},
+		}, false},
+		{"step-up max age", newRequest("GET", "https://example.com/"), &config.Policy{
+			Source:            policy.Source,
+			RequiredAMRValues: []string{"mfa"},
+			StepUpMaxAge:      time.Minute,
+		}, false},
+		{"ip lists", newRequest("GET", "https://example.com/"), &config.Policy{
+			Source:         policy.Source,
+			AllowedIPLists: []string{"partner-acme"},
+		}, false},
+		{"jwt assertion ttl", newRequest("GET", "https://example.com/"), &config.Policy{
+			Source:          policy.Source,
+			JWTAssertionTTL: time.Minute,
+		}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, cacheable := a.getDecisionCacheKey(tt.req, tt.policy)
+			assert.Equal(t, tt.wantCacheable, cacheable)
+		})
+	}
+
+	t.Run("data version", func(t *testing.T) {
+		k1, _ := a.getDecisionCacheKey(newRequest("GET", "https://example.com/"), policy)
+		a.dataBrokerDataVersion++
+		k2, _ := a.getDecisionCacheKey(newRequest("GET", "https://example.com/"), policy)
+		assert.NotEqual(t, k1, k2)
+	})
+	t.Run("auth context", func(t *testing.T) {
+		r1 := newRequest("GET", "https://example.com/")
+		r2 := newRequest("GET", "https://example.com/")
+		r2.Session.AMR = []string{"pwd", "mfa"}
+		k1, _ := a.getDecisionCacheKey(r1, policy)
+		k2, _ := a.getDecisionCacheKey(r2, policy)
+		assert.NotEqual(t, k1, k2)
+	})
+	t.Run("grpc method", func(t *testing.T) {
+		r1 := newRequest("POST", "https://example.com/pkg.Service/Get")
+		r1.GRPC = &evaluator.RequestGRPC{Service: "pkg.Service", Method: "Get"}
+		r2 := newRequest("POST", "https://example.com/pkg.Service/Delete")
+		r2.GRPC = &evaluator.RequestGRPC{Service: "pkg.Service", Method: "Delete"}
+		k1, _ := a.getDecisionCacheKey(r1, policy)
+		k2, _ := a.getDecisionCacheKey(r2, policy)
+		assert.NotEqual(t, k1, k2)
+	})
+}
@@ -0,0 +1,394 @@
+package authorize
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/kafka"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+// Settings used when exporting decision logs.
+var (
+	// decisionLogPath is the path of the rego query which makes the decision,
+	// as it would be reported by OPA.
+	decisionLogPath = "pomerium/authz"
+	// decisionLogMaxBuffered is the maximum number of decisions kept while
+	// the endpoint is unavailable. Older decisions are dropped first.
+	decisionLogMaxBuffered = 10000
+	// decisionLogDefaultBatchSize and decisionLogDefaultFlushInterval are used
+	// until the logger is updated with the configured values.
+	decisionLogDefaultBatchSize     = 100
+	decisionLogDefaultFlushInterval = 10 * time.Second
+	// decisionLogUploadTimeout is the timeout of a single upload.
+	decisionLogUploadTimeout = 30 * time.Second
+)
+
+// A decisionLogEvent is an authorization decision in the OPA decision log
+// format.
+type decisionLogEvent struct {
+	Labels      map[string]string `json:"labels"`
+	DecisionID  string            `json:"decision_id"`
+	Path        string            `json:"path"`
+	Input       decisionLogInput  `json:"input"`
+	Result      decisionLogResult `json:"result"`
+	RequestedBy string            `json:"requested_by,omitempty"`
+	Timestamp   time.Time         `json:"timestamp"`
+
+	// user and route identify the user and route of the decision, which
+	// sinks may partition decisions by
+	user  string
+	route string
+}
+
+type decisionLogInput struct {
+	HTTP    evaluator.RequestHTTP     `json:"http"`
+	GRPC    *evaluator.RequestGRPC    `json:"grpc,omitempty"`
+	OpenAPI *evaluator.RequestOpenAPI `json:"openapi,omitempty"`
+	GraphQL *evaluator.RequestGraphQL `json:"graphql,omitempty"`
+	Session evaluator.RequestSession  `json:"session"`
+}
+
+type decisionLogResult struct {
+	Allow      bool                 `json:"allow"`
+	Status     int                  `json:"status"`
+	Message    string               `json:"message,omitempty"`
+	DenyReason evaluator.DenyReason `json:"deny_reason,omitempty"`
+	Email      string               `json:"email,omitempty"`
+	Groups     []string             `json:"groups,omitempty"`
+}
+
+// A decisionLogger exports authorization decisions in batches to a sink.
+type decisionLogger struct {
+	instanceID string
+	flush      chan struct{}
+
+	mu            sync.Mutex
+	endpoint      string
+	sink          decisionLogSink
+	batchSize     int
+	flushInterval time.Duration
+	buffered      []*decisionLogEvent
+	dropped       int
+}
+
+func newDecisionLogger() *decisionLogger {
+	return &decisionLogger{
+		instanceID: uuid.New().String(),
+		flush:      make(chan struct{}, 1),
+
+		batchSize:     decisionLogDefaultBatchSize,
+		flushInterval: decisionLogDefaultFlushInterval,
+	}
+}
+
+// Update sets the endpoint decisions are exported to. If the endpoint is
+// empty, decisions aren't exported.
+func (l *decisionLogger) Update(endpoint string, batchSize int, flushInterval time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if endpoint != l.endpoint {
+		if l.sink != nil {
+			_ = l.sink.Close()
+			l.sink = nil
+		}
+		if endpoint != "" {
+			sink, err := newDecisionLogSink(endpoint)
+			if err != nil {
+				log.Error().Err(err).Msg("authorize: failed to create decision log sink")
+			}
+			l.sink = sink
+		}
+		l.endpoint = endpoint
+	}
+	if l.sink == nil {
+		l.buffered = nil
+	}
+	if batchSize > 0 {
+		l.batchSize = batchSize
+	}
+	if flushInterval > 0 {
+		l.flushInterval = flushInterval
+	}
+}
+
+// Log adds a decision to the next batch.
+func (l *decisionLogger) Log(decisionID string, req *evaluator.Request, reply *evaluator.Result) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.sink == nil {
+		return
+	}
+	if decisionID == "" {
+		decisionID = uuid.New().String()
+	}
+
+	evt := &decisionLogEvent{
+		Labels: map[string]string{
+			"id":      l.instanceID,
+			"version": version.FullVersion(),
+		},
+		DecisionID: decisionID,
+		Path:       decisionLogPath,
+		Input: decisionLogInput{
+			HTTP:    req.HTTP,
+			GRPC:    req.GRPC,
+			OpenAPI: req.OpenAPI,
+			GraphQL: req.GraphQL,
+			Session: req.Session,
+		},
+		Result: decisionLogResult{
+			Allow:      reply.Status == http.StatusOK,
+			Status:     reply.Status,
+			Message:    reply.Message,
+			DenyReason: reply.DenyReason,
+			Email:      reply.UserEmail,
+			Groups:     reply.UserGroups,
+		},
+		RequestedBy: req.HTTP.ClientIP,
+		Timestamp:   time.Now().UTC(),
+
+		user: reply.UserEmail,
+	}
+	if evt.user == "" {
+		evt.user = req.Session.ID
+	}
+	if reply.MatchingPolicy != nil {
+		evt.route = reply.MatchingPolicy.From
+	} else if u, err := url.Parse(req.HTTP.URL); err == nil {
+		evt.route = u.Host
+	}
+	evt.Input.HTTP.URL = log.RedactURL(req.HTTP.URL)
+	evt.Input.HTTP.Headers = redactDecisionLogHeaders(req.HTTP.Headers)
+
+	l.buffered = append(l.buffered, evt)
+	l.trim()
+	if len(l.buffered) >= l.batchSize {
+		select {
+		case l.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run exports batches of decisions until the context is canceled.
+func (l *decisionLogger) Run(ctx context.Context) error {
+	timer := time.NewTimer(l.getFlushInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// use a new context so the last batch can still be sent
+			l.Flush(context.Background())
+			return ctx.Err()
+		case <-timer.C:
+		case <-l.flush:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+
+		l.Flush(ctx)
+		timer.Reset(l.getFlushInterval())
+	}
+}
+
+// Flush exports all the buffered decisions. Batches which fail to upload are
+// kept to be retried by the next flush.
+func (l *decisionLogger) Flush(ctx context.Context) {
+	for {
+		l.mu.Lock()
+		sink, batch := l.sink, l.buffered
+		if len(batch) > l.batchSize {
+			batch = batch[:l.batchSize]
+		}
+		l.buffered = l.buffered[len(batch):]
+		dropped := l.dropped
+		l.dropped = 0
+		l.mu.Unlock()
+
+		if dropped > 0 {
+			log.Warn().Int("dropped", dropped).Msg("authorize: dropped decision logs")
+		}
+		if sink == nil || len(batch) == 0 {
+			return
+		}
+
+		err := sink.Export(ctx, batch)
+		if err != nil {
+			log.Warn().Err(err).Str("endpoint", sink.String()).Int("decisions", len(batch)).
+				Msg("authorize: failed to export decision logs")
+
+			l.mu.Lock()
+			if l.sink == sink {
+				l.buffered = append(append([]*decisionLogEvent{}, batch...), l.buffered...)
+				l.trim()
+			}
+			l.mu.Unlock()
+			return
+		}
+	}
+}
+
+// trim drops the oldest decisions when too many are buffered. The lock must
+// be held.
+func (l *decisionLogger) trim() {
+	if n := len(l.buffered) - decisionLogMaxBuffered; n > 0 {
+		l.buffered = l.buffered[n:]
+		l.dropped += n
+	}
+}
+
+func (l *decisionLogger) getFlushInterval() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.flushInterval
+}
+
+// A decisionLogSink exports batches of decisions.
+type decisionLogSink interface {
+	Export(ctx context.Context, batch []*decisionLogEvent) error
+	// String returns the endpoint decisions are exported to, without any
+	// credentials.
+	String() string
+	io.Closer
+}
+
+// newDecisionLogSink creates a sink for a decision log URL. Kafka URLs
+// produce decisions to a Kafka topic, and other URLs are HTTP endpoints.
+func newDecisionLogSink(endpoint string) (decisionLogSink, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decision log url: %w", err)
+	}
+	switch u.Scheme {
+	case "kafka", "kafkas":
+		options, err := kafka.ParseURL(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		producer, err := kafka.NewProducer(*options)
+		if err != nil {
+			return nil, err
+		}
+		u.User = nil
+		return &kafkaDecisionLogSink{
+			producer:     producer,
+			partitionKey: u.Query().Get("partition_key"),
+			endpoint:     u.String(),
+		}, nil
+	}
+	return &httpDecisionLogSink{
+		client:   &http.Client{Timeout: decisionLogUploadTimeout},
+		endpoint: endpoint,
+	}, nil
+}
+
+// An httpDecisionLogSink exports decisions to an HTTP endpoint as gzip
+// compressed, newline delimited JSON batches, so that they can be consumed by
+// OPA management planes.
+type httpDecisionLogSink struct {
+	client   *http.Client
+	endpoint string
+}
+
+func (s *httpDecisionLogSink) String() string { return s.endpoint }
+
+func (s *httpDecisionLogSink) Close() error { return nil }
+
+func (s *httpDecisionLogSink) Export(ctx context.Context, batch []*decisionLogEvent) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for _, evt := range batch {
+		if err := enc.Encode(evt); err != nil {
+			return fmt.Errorf("error encoding decision log: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("error compressing decision logs: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, &buf)
+	if err != nil {
+		return fmt.Errorf("error creating decision log request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("User-Agent", version.UserAgent())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// A kafkaDecisionLogSink produces decisions to a Kafka topic as JSON
+// messages, keyed by the user or route of the decision if partitionKey is set.
+type kafkaDecisionLogSink struct {
+	producer     *kafka.Producer
+	partitionKey string
+	endpoint     string
+}
+
+func (s *kafkaDecisionLogSink) String() string { return s.endpoint }
+
+func (s *kafkaDecisionLogSink) Close() error { return s.producer.Close() }
+
+func (s *kafkaDecisionLogSink) Export(ctx context.Context, batch []*decisionLogEvent) error {
+	msgs := make([]kafka.Message, 0, len(batch))
+	for _, evt := range batch {
+		value, err := json.Marshal(evt)
+		if err != nil {
+			return fmt.Errorf("error encoding decision log: %w", err)
+		}
+		var key string
+		switch s.partitionKey {
+		case "user":
+			key = evt.user
+		case "route":
+			key = evt.route
+		}
+		msg := kafka.Message{Value: value, Timestamp: evt.Timestamp}
+		if key != "" {
+			msg.Key = []byte(key)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, decisionLogUploadTimeout)
+	defer cancel()
+	return s.producer.Produce(ctx, msgs...)
+}
+
+// redactDecisionLogHeaders removes the sensitive headers, rather than only
+// redacting their values, so they aren't exported at all.
+func redactDecisionLogHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if !log.IsRedactedHeader(k) {
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,163 @@
+package evaluator
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/protoutil"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+// claimCacheSize is the number of decoded claims kept by each generation of
+// the claim cache.
+const claimCacheSize = 10000
+
+// decodedClaims caches the flattened values of claims, so that the claims of
+// a session aren't decoded again on every request.
+var decodedClaims = newClaimCache(claimCacheSize)
+
+// getIDPClaims returns the identity provider claims of a user and their
+// session, keyed by claim name. Every claim is flattened to a list of values
+// so that policies can match single and multi-valued claims the same way.
+// Session claims are added after user claims.
+func getIDPClaims(claimSets ...map[string]*anypb.Any) map[string][]interface{} {
+	var claims map[string][]interface{}
+	for _, claimSet := range claimSets {
+		for name, any := range claimSet {
+			values := decodedClaims.get(any)
+			if len(values) == 0 {
+				continue
+			}
+			if claims == nil {
+				claims = make(map[string][]interface{})
+			}
+			claims[name] = append(claims[name], values...)
+		}
+	}
+	return claims
+}
+
+// getMappedClaims returns the claims set by the claims mapping, which is
+// applied to the identity provider claims of a user and their session.
+func getMappedClaims(mapping config.ClaimsMapping, u *user.User, s *session.Session) map[string]interface{} {
+	claims := make(map[string]interface{})
+	for _, claimSet := range []map[string]*anypb.Any{u.GetClaims(), s.GetClaims()} {
+		for name, any := range claimSet {
+			claims[name] = toNativeClaim(protoutil.AnyToInterface(any))
+		}
+	}
+	if u.GetEmail() != "" {
+		claims["email"] = u.GetEmail()
+	}
+	if u.GetName() != "" {
+		claims["name"] = u.GetName()
+	}
+	mapping.Apply(claims)
+
+	mapped := make(map[string]interface{})
+	for _, name := range mapping.Claims() {
+		if value, ok := claims[name]; ok && value != nil {
+			mapped[name] = value
+		}
+	}
+	return mapped
+}
+
+func toNativeClaim(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *structpb.ListValue:
+		return v.AsSlice()
+	case *structpb.Struct:
+		return v.AsMap()
+	case *structpb.Value:
+		return v.AsInterface()
+	}
+	return value
+}
+
+func flattenClaim(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case *structpb.ListValue:
+		var values []interface{}
+		for _, lv := range v.AsSlice() {
+			values = append(values, flattenClaim(lv)...)
+		}
+		return values
+	case *structpb.Value:
+		return flattenClaim(v.AsInterface())
+	case []interface{}:
+		var values []interface{}
+		for _, lv := range v {
+			values = append(values, flattenClaim(lv)...)
+		}
+		return values
+	case map[string]interface{}, *structpb.Struct:
+		// nested objects can't be matched by value
+		return nil
+	}
+	return []interface{}{value}
+}
+
+// A claimCache caches the flattened values of claims by their Any. Records
+// are replaced rather than modified when they change, so an Any always
+// decodes to the same values.
+//
+// The cache has two generations: when the current generation is full it
+// becomes the previous one, and claims found in the previous generation are
+// moved back to the current one. This bounds the cache without tracking the
+// use of every claim.
+type claimCache struct {
+	size int
+
+	mu       sync.Mutex
+	current  map[*anypb.Any][]interface{}
+	previous map[*anypb.Any][]interface{}
+}
+
+func newClaimCache(size int) *claimCache {
+	return &claimCache{
+		size:    size,
+		current: make(map[*anypb.Any][]interface{}),
+	}
+}
+
+// get returns the flattened values of a claim. The values are shared, so
+// they must not be modified.
+func (c *claimCache) get(any *anypb.Any) []interface{} {
+	if any == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	values, ok := c.current[any]
+	if !ok {
+		values, ok = c.previous[any]
+		if ok {
+			c.add(any, values)
+		}
+	}
+	c.mu.Unlock()
+	if ok {
+		return values
+	}
+
+	values = flattenClaim(protoutil.AnyToInterface(any))
+
+	c.mu.Lock()
+	c.add(any, values)
+	c.mu.Unlock()
+	return values
+}
+
+func (c *claimCache) add(any *anypb.Any, values []interface{}) {
+	if len(c.current) >= c.size {
+		c.previous, c.current = c.current, make(map[*anypb.Any][]interface{}, c.size)
+	}
+	c.current[any] = values
+}
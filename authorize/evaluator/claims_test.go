@@ -0,0 +1,117 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestGetIDPClaims(t *testing.T) {
+	mustAny := func(msg interface{}) *anypb.Any {
+		var any *anypb.Any
+		switch v := msg.(type) {
+		case string:
+			any, _ = ptypes.MarshalAny(wrapperspb.String(v))
+		case bool:
+			any, _ = ptypes.MarshalAny(wrapperspb.Bool(v))
+		case []interface{}:
+			lst, _ := structpb.NewList(v)
+			any, _ = ptypes.MarshalAny(lst)
+		case map[string]interface{}:
+			obj, _ := structpb.NewStruct(v)
+			any, _ = ptypes.MarshalAny(obj)
+		}
+		return any
+	}
+
+	userClaims := map[string]*anypb.Any{
+		"department":     mustAny("engineering"),
+		"groups":         mustAny([]interface{}{"admins", []interface{}{"devs"}}),
+		"email_verified": mustAny(true),
+		"address":        mustAny(map[string]interface{}{"country": "US"}),
+	}
+	sessionClaims := map[string]*anypb.Any{
+		"groups": mustAny([]interface{}{"on-call"}),
+		"amr":    mustAny([]interface{}{"pwd", "mfa"}),
+	}
+
+	assert.Equal(t, map[string][]interface{}{
+		"department":     {"engineering"},
+		"groups":         {"admins", "devs", "on-call"},
+		"email_verified": {true},
+		"amr":            {"pwd", "mfa"},
+	}, getIDPClaims(userClaims, sessionClaims))
+	assert.Nil(t, getIDPClaims(nil, nil))
+}
+
+func TestGetMappedClaims(t *testing.T) {
+	mapping := config.ClaimsMapping{
+		{Claim: "upn", From: "preferred_username", Rename: true},
+		{Claim: "roles", From: "https://example.com/roles"},
+		{Claim: "display_name", Template: "{{.name}} <{{.email}}>"},
+		{Claim: "country", From: "address.country"},
+	}
+	mustAny := func(msg proto.Message) *anypb.Any {
+		any, _ := ptypes.MarshalAny(msg)
+		return any
+	}
+	roles, _ := structpb.NewList([]interface{}{"admin"})
+	u := &user.User{
+		Name:   "Joe",
+		Email:  "joe@test.com",
+		Claims: map[string]*anypb.Any{"country": mustAny(wrapperspb.String("US"))},
+	}
+	s := &session.Session{Claims: map[string]*anypb.Any{
+		"preferred_username":        mustAny(wrapperspb.String("joe")),
+		"https://example.com/roles": mustAny(roles),
+	}}
+
+	assert.Equal(t, map[string]interface{}{
+		"upn":          "joe",
+		"roles":        []interface{}{"admin"},
+		"display_name": "Joe <joe@test.com>",
+		"country":      "US",
+	}, getMappedClaims(mapping, u, s))
+	assert.Equal(t, map[string]interface{}{}, getMappedClaims(mapping, nil, nil))
+}
+
+func TestClaimCache(t *testing.T) {
+	c := newClaimCache(2)
+	claims := make([]*anypb.Any, 3)
+	for i := range claims {
+		claims[i], _ = ptypes.MarshalAny(wrapperspb.Int64(int64(i)))
+	}
+
+	assert.Equal(t, []interface{}{int64(0)}, c.get(claims[0]))
+	assert.Equal(t, []interface{}{int64(1)}, c.get(claims[1]))
+	assert.Equal(t, []interface{}{int64(2)}, c.get(claims[2]))
+	assert.Len(t, c.current, 1, "a full generation should become the previous one")
+	assert.Len(t, c.previous, 2)
+
+	assert.Equal(t, []interface{}{int64(0)}, c.get(claims[0]))
+	assert.Contains(t, c.current, claims[0], "claims in the previous generation should be moved back")
+	assert.Nil(t, c.get(nil))
+}
+
+func BenchmarkGetIDPClaims(b *testing.B) {
+	groups, _ := structpb.NewList([]interface{}{"admins", "devs", "on-call"})
+	claims := map[string]*anypb.Any{}
+	claims["groups"], _ = ptypes.MarshalAny(groups)
+	claims["department"], _ = ptypes.MarshalAny(wrapperspb.String("engineering"))
+	claims["email_verified"], _ = ptypes.MarshalAny(wrapperspb.Bool(true))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		getIDPClaims(claims, nil)
+	}
+}
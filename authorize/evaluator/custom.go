@@ -13,8 +13,11 @@ import (
 // A CustomEvaluatorRequest is the data needed to evaluate a custom rego policy.
 type CustomEvaluatorRequest struct {
 	RegoPolicy string
-	HTTP       RequestHTTP    `json:"http"`
-	Session    RequestSession `json:"session"`
+	HTTP       RequestHTTP     `json:"http"`
+	GRPC       *RequestGRPC    `json:"grpc,omitempty"`
+	OpenAPI    *RequestOpenAPI `json:"openapi,omitempty"`
+	GraphQL    *RequestGraphQL `json:"graphql,omitempty"`
+	Session    RequestSession  `json:"session"`
 }
 
 // A CustomEvaluatorResponse is the response from the evaluation of a custom rego policy.
@@ -22,6 +25,9 @@ type CustomEvaluatorResponse struct {
 	Allowed bool
 	Denied  bool
 	Reason  string
+	// DenyReason is set from an optional `deny_reason` string in the policy,
+	// e.g. `deny_reason = "ip-blocked"`.
+	DenyReason DenyReason
 }
 
 // A CustomEvaluator evaluates custom rego policies.
@@ -48,9 +54,12 @@ func (ce *CustomEvaluator) Evaluate(ctx context.Context, req *CustomEvaluatorReq
 	}
 
 	resultSet, err := q.Eval(ctx, rego.EvalInput(struct {
-		HTTP    RequestHTTP    `json:"http"`
-		Session RequestSession `json:"session"`
-	}{HTTP: req.HTTP, Session: req.Session}))
+		HTTP    RequestHTTP     `json:"http"`
+		GRPC    *RequestGRPC    `json:"grpc,omitempty"`
+		OpenAPI *RequestOpenAPI `json:"openapi,omitempty"`
+		GraphQL *RequestGraphQL `json:"graphql,omitempty"`
+		Session RequestSession  `json:"session"`
+	}{HTTP: req.HTTP, GRPC: req.GRPC, OpenAPI: req.OpenAPI, GraphQL: req.GraphQL, Session: req.Session}))
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +71,9 @@ func (ce *CustomEvaluator) Evaluate(ctx context.Context, req *CustomEvaluatorReq
 
 	res := &CustomEvaluatorResponse{}
 	res.Allowed, _ = vars["allow"].(bool)
+	if v, ok := vars["deny_reason"].(string); ok {
+		res.DenyReason = DenyReason(v)
+	}
 	if v, ok := vars["deny"]; ok {
 		// support `deny = true`
 		if b, ok := v.(bool); ok {
@@ -0,0 +1,58 @@
+package evaluator
+
+// A DenyReason is a code describing why a request was denied. It is returned
+// to the proxy and logged so that denials can be told apart.
+type DenyReason string
+
+// Deny reasons.
+const (
+	// DenyReasonUnauthenticated is used when the request has no session.
+	DenyReasonUnauthenticated DenyReason = "unauthenticated"
+	// DenyReasonExpiredSession is used when the request has a session which is
+	// no longer valid.
+	DenyReasonExpiredSession DenyReason = "expired-session"
+	// DenyReasonSessionTooOld is used when the route requires the user to
+	// have signed in more recently.
+	DenyReasonSessionTooOld DenyReason = "session-too-old"
+	// DenyReasonStepUpRequired is used when the route requires the user to
+	// have signed in with a stronger authentication, e.g. with a second
+	// factor.
+	DenyReasonStepUpRequired DenyReason = "step-up-required"
+	// DenyReasonIdentityProviderMismatch is used when the route requires the
+	// user to sign in with a different identity provider.
+	DenyReasonIdentityProviderMismatch DenyReason = "idp-mismatch"
+	// DenyReasonGroupMismatch is used when the user, or one of their groups or
+	// domains, isn't allowed by the route, or is explicitly denied.
+	DenyReasonGroupMismatch DenyReason = "group-mismatch"
+	// DenyReasonIPBlocked is used when the client address isn't allowed.
+	DenyReasonIPBlocked DenyReason = "ip-blocked"
+	// DenyReasonCustomRego is used when a custom rego policy denies the request.
+	DenyReasonCustomRego DenyReason = "custom-rego"
+	// DenyReasonInternalError is used when the request couldn't be authorized.
+	DenyReasonInternalError DenyReason = "internal-error"
+	// DenyReasonInvalidClientCertificate is used when a valid client
+	// certificate is required but wasn't provided.
+	DenyReasonInvalidClientCertificate DenyReason = "invalid-client-certificate"
+	// DenyReasonForbidden is used for any other denial.
+	DenyReasonForbidden DenyReason = "forbidden"
+)
+
+var denyReasons = map[DenyReason]struct{}{
+	DenyReasonUnauthenticated:          {},
+	DenyReasonExpiredSession:           {},
+	DenyReasonSessionTooOld:            {},
+	DenyReasonStepUpRequired:           {},
+	DenyReasonIdentityProviderMismatch: {},
+	DenyReasonGroupMismatch:            {},
+	DenyReasonIPBlocked:                {},
+	DenyReasonCustomRego:               {},
+	DenyReasonInternalError:            {},
+	DenyReasonInvalidClientCertificate: {},
+	DenyReasonForbidden:                {},
+}
+
+// IsValid returns true if the deny reason is one of the known deny reasons.
+func (r DenyReason) IsValid() bool {
+	_, ok := denyReasons[r]
+	return ok
+}
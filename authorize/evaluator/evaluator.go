@@ -9,13 +9,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
 	"github.com/open-policy-agent/opa/rego"
+	protov2 "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/anypb"
 	"gopkg.in/square/go-jose.v2"
@@ -25,6 +30,9 @@ import (
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	"github.com/pomerium/pomerium/pkg/grpc/serviceaccount"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 )
@@ -34,6 +42,9 @@ const (
 	userTypeURL           = "type.googleapis.com/user.User"
 	directoryUserTypeURL  = "type.googleapis.com/directory.User"
 	directoryGroupTypeURL = "type.googleapis.com/directory.Group"
+	kioskDeviceTypeURL    = "type.googleapis.com/kiosk.Device"
+	ipListTypeURL         = "type.googleapis.com/iplist.IPList"
+	serviceAccountTypeURL = "type.googleapis.com/serviceaccount.ServiceAccount"
 )
 
 // Evaluator specifies the interface for a policy engine.
@@ -42,11 +53,43 @@ type Evaluator struct {
 	rego     *rego.Rego
 	query    rego.PreparedEvalQuery
 	policies []config.Policy
+	routes   *routeIndex
 
 	clientCA         string
 	authenticateHost string
-	jwk              interface{}
-	kid              string
+	// policyGroups are the groups the policies reference, if only they are
+	// included in JWTs.
+	policyGroups map[string]struct{}
+	groupsLimit  int
+	signingKey   *signingKey
+	// routeSigningKeys are the signing keys of the routes which have their
+	// own, by route policy index.
+	routeSigningKeys map[int]*signingKey
+	// claimsMapping maps the identity provider claims added to JWTs.
+	claimsMapping config.ClaimsMapping
+}
+
+// A signingKey signs the JWT assertions sent to upstreams.
+type signingKey struct {
+	jwk *ecdsa.PrivateKey
+	kid string
+}
+
+// newSigningKey decodes a base64 encoded PEM ECDSA private key.
+func newSigningKey(raw string) (*signingKey, error) {
+	decodedCert, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate cert %v: %w", decodedCert, err)
+	}
+	key, err := cryptutil.DecodePrivateKey(decodedCert)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate signing key: %w", err)
+	}
+	jwk, err := cryptutil.PublicJWKFromBytes(decodedCert, jose.ES256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert jwk: %w", err)
+	}
+	return &signingKey{jwk: key, kid: jwk.KeyID}, nil
 }
 
 // New creates a new Evaluator.
@@ -55,6 +98,12 @@ func New(options *config.Options, store *Store) (*Evaluator, error) {
 		custom:           NewCustomEvaluator(store.opaStore),
 		authenticateHost: options.AuthenticateURL.Host,
 		policies:         options.Policies,
+		routes:           newRouteIndex(options.Policies),
+		groupsLimit:      options.JWTGroupsLimit,
+		claimsMapping:    options.ClaimsMapping,
+	}
+	if options.JWTGroupsFilter {
+		e.policyGroups = getPolicyGroups(options.Policies)
 	}
 	if options.ClientCA != "" {
 		e.clientCA = options.ClientCA
@@ -71,27 +120,30 @@ func New(options *config.Options, store *Store) (*Evaluator, error) {
 		if err != nil {
 			return nil, fmt.Errorf("authorize: couldn't generate signing key: %w", err)
 		}
-		e.jwk = key
+		e.signingKey = &signingKey{jwk: key}
 		pubKeyBytes, err := cryptutil.EncodePublicKey(&key.PublicKey)
 		if err != nil {
 			return nil, fmt.Errorf("authorize: encode public key: %w", err)
 		}
 		log.Info().Interface("PublicKey", pubKeyBytes).Msg("authorize: ecdsa public key")
 	} else {
-		decodedCert, err := base64.StdEncoding.DecodeString(options.SigningKey)
+		key, err := newSigningKey(options.SigningKey)
 		if err != nil {
-			return nil, fmt.Errorf("authorize: failed to decode certificate cert %v: %w", decodedCert, err)
+			return nil, fmt.Errorf("authorize: %w", err)
 		}
-		key, err := cryptutil.DecodePrivateKey(decodedCert)
-		if err != nil {
-			return nil, fmt.Errorf("authorize: couldn't generate signing key: %w", err)
+		e.signingKey = key
+	}
+	e.routeSigningKeys = make(map[int]*signingKey)
+	for i := range options.Policies {
+		policy := &options.Policies[i]
+		if policy.SigningKey == "" {
+			continue
 		}
-		e.jwk = key
-		jwk, err := cryptutil.PublicJWKFromBytes(decodedCert, jose.ES256)
+		key, err := newSigningKey(policy.SigningKey)
 		if err != nil {
-			return nil, fmt.Errorf("authorize: failed to convert jwk: %w", err)
+			return nil, fmt.Errorf("authorize: route %s: %w", policy.String(), err)
 		}
-		e.kid = jwk.KeyID
+		e.routeSigningKeys[i] = key
 	}
 
 	authzPolicy, err := readPolicy("/authz.rego")
@@ -99,9 +151,6 @@ func New(options *config.Options, store *Store) (*Evaluator, error) {
 		return nil, fmt.Errorf("error loading rego policy: %w", err)
 	}
 
-	store.UpdateAdmins(options.Administrators)
-	store.UpdateRoutePolicies(options.Policies)
-
 	e.rego = rego.New(
 		rego.Store(store.opaStore),
 		rego.Module("pomerium.authz", string(authzPolicy)),
@@ -113,6 +162,12 @@ func New(options *config.Options, store *Store) (*Evaluator, error) {
 		return nil, fmt.Errorf("error preparing rego query: %w", err)
 	}
 
+	// the store is shared with the current evaluator, so it's only updated
+	// once the new one is ready to replace it. The route policies aren't
+	// stored, they're part of each evaluator's input, so they always match
+	// its route index.
+	store.UpdateAdmins(options.Administrators)
+
 	return e, nil
 }
 
@@ -123,7 +178,9 @@ func (e *Evaluator) Evaluate(ctx context.Context, req *Request) (*Result, error)
 		return nil, fmt.Errorf("error validating client certificate: %w", err)
 	}
 
-	res, err := e.query.Eval(ctx, rego.EvalInput(e.newInput(req, isValid)))
+	in := e.newInput(req, isValid)
+	defer putInput(in)
+	res, err := e.query.Eval(ctx, rego.EvalInput(in))
 	if err != nil {
 		return nil, fmt.Errorf("error evaluating rego policy: %w", err)
 	}
@@ -134,8 +191,19 @@ func (e *Evaluator) Evaluate(ctx context.Context, req *Request) (*Result, error)
 	}
 
 	payload := e.JWTPayload(req)
+	if in.RoutePolicyIdx >= 0 && in.RoutePolicyIdx < len(e.policies) {
+		limitJWTPayload(payload, e.policies[in.RoutePolicyIdx].JWTAssertionTTL)
+	}
+	// the user's groups are kept for Kubernetes impersonation and tracing,
+	// before the JWT's groups are limited
+	userGroups, _ := payload["groups"].([]string)
+	limitJWTGroups(payload, e.policyGroups, e.groupsLimit)
 
-	signedJWT, err := e.SignedJWT(payload)
+	key := e.signingKey
+	if routeKey, ok := e.routeSigningKeys[in.RoutePolicyIdx]; ok {
+		key = routeKey
+	}
+	signedJWT, err := signJWT(key, payload)
 	if err != nil {
 		return nil, fmt.Errorf("error signing JWT: %w", err)
 	}
@@ -147,23 +215,31 @@ func (e *Evaluator) Evaluate(ctx context.Context, req *Request) (*Result, error)
 	if e, ok := payload["email"].(string); ok {
 		evalResult.UserEmail = e
 	}
-	if gs, ok := payload["groups"].([]string); ok {
-		evalResult.UserGroups = gs
-	}
+	evalResult.UserGroups = userGroups
 
 	allow := allowed(res[0].Bindings.WithoutWildcards())
+	denyReason := DenyReasonGroupMismatch
 	// evaluate any custom policies
 	if allow {
 		for _, src := range req.CustomPolicies {
 			cres, err := e.custom.Evaluate(ctx, &CustomEvaluatorRequest{
 				RegoPolicy: src,
 				HTTP:       req.HTTP,
+				GRPC:       req.GRPC,
+				OpenAPI:    req.OpenAPI,
+				GraphQL:    req.GraphQL,
 				Session:    req.Session,
 			})
 			if err != nil {
 				return nil, err
 			}
-			allow = allow && (cres.Allowed && !cres.Denied)
+			if allow && (!cres.Allowed || cres.Denied) {
+				allow = false
+				denyReason = DenyReasonCustomRego
+				if cres.DenyReason.IsValid() {
+					denyReason = cres.DenyReason
+				}
+			}
 			if cres.Reason != "" {
 				evalResult.Message = cres.Reason
 			}
@@ -178,23 +254,36 @@ func (e *Evaluator) Evaluate(ctx context.Context, req *Request) (*Result, error)
 	if req.Session.ID == "" {
 		evalResult.Status = http.StatusUnauthorized
 		evalResult.Message = "login required"
+		evalResult.DenyReason = DenyReasonUnauthenticated
 		return evalResult, nil
 	}
 
 	evalResult.Status = http.StatusForbidden
+	evalResult.DenyReason = denyReason
 	if evalResult.Message == "" {
 		evalResult.Message = "forbidden"
 	}
 	return evalResult, nil
 }
 
-// ParseSignedJWT parses the input signature and return its payload.
+// ParseSignedJWT parses the input signature and return its payload. The
+// signature is verified with the global signing key, or with the route
+// signing key matching its key id.
 func (e *Evaluator) ParseSignedJWT(signature string) ([]byte, error) {
 	object, err := jose.ParseSigned(signature)
 	if err != nil {
 		return nil, err
 	}
-	return object.Verify(&(e.jwk.(*ecdsa.PrivateKey).PublicKey))
+	key := e.signingKey
+	if len(object.Signatures) > 0 {
+		for _, routeKey := range e.routeSigningKeys {
+			if routeKey.kid == object.Signatures[0].Header.KeyID {
+				key = routeKey
+				break
+			}
+		}
+	}
+	return object.Verify(&key.jwk.PublicKey)
 }
 
 // JWTPayload returns the JWT payload for a request.
@@ -205,6 +294,30 @@ func (e *Evaluator) JWTPayload(req *Request) map[string]interface{} {
 	if u, err := url.Parse(req.HTTP.URL); err == nil {
 		payload["aud"] = u.Hostname()
 	}
+	if sa := getServiceAccount(req); sa != nil {
+		payload["sub"] = serviceaccount.UserID(sa.GetId())
+		payload["user"] = serviceaccount.UserID(sa.GetId())
+		payload["email"] = sa.GetEmail()
+		if sa.GetExpiresAt() != nil {
+			payload["exp"] = sa.GetExpiresAt().AsTime().Unix()
+		}
+		if len(sa.GetGroups()) > 0 {
+			payload["groups"] = append([]string(nil), sa.GetGroups()...)
+		}
+		return payload
+	}
+	if ri := req.Interop; ri != nil {
+		payload["sub"] = ri.UserID
+		payload["user"] = ri.UserID
+		payload["email"] = ri.Email
+		if ri.ExpiresAt != 0 {
+			payload["exp"] = ri.ExpiresAt
+		}
+		if len(ri.Groups) > 0 {
+			payload["groups"] = append([]string(nil), ri.Groups...)
+		}
+		return payload
+	}
 	if s, ok := req.DataBrokerData.Get("type.googleapis.com/session.Session", req.Session.ID).(*session.Session); ok {
 		if tm, err := ptypes.Timestamp(s.GetIdToken().GetExpiresAt()); err == nil {
 			payload["exp"] = tm.Unix()
@@ -212,12 +325,18 @@ func (e *Evaluator) JWTPayload(req *Request) map[string]interface{} {
 		if tm, err := ptypes.Timestamp(s.GetIdToken().GetIssuedAt()); err == nil {
 			payload["iat"] = tm.Unix()
 		}
-		if u, ok := req.DataBrokerData.Get("type.googleapis.com/user.User", s.GetUserId()).(*user.User); ok {
+		u, ok := req.DataBrokerData.Get("type.googleapis.com/user.User", s.GetUserId()).(*user.User)
+		if ok {
 			payload["sub"] = u.GetId()
 			payload["user"] = u.GetId()
 			payload["email"] = u.GetEmail()
 		}
-		if du, ok := req.DataBrokerData.Get("type.googleapis.com/directory.User", s.GetUserId()).(*directory.User); ok {
+		if len(e.claimsMapping) > 0 {
+			for name, value := range getMappedClaims(e.claimsMapping, u, s) {
+				payload[name] = value
+			}
+		}
+		if du, ok := req.DataBrokerData.Get("type.googleapis.com/directory.User", s.GetUserId()).(*directory.User); ok && !du.GetInactive() {
 			var groupNames []string
 			for _, groupID := range du.GetGroupIds() {
 				if dg, ok := req.DataBrokerData.Get("type.googleapis.com/directory.Group", groupID).(*directory.Group); ok {
@@ -233,13 +352,34 @@ func (e *Evaluator) JWTPayload(req *Request) map[string]interface{} {
 	return payload
 }
 
-// SignedJWT returns the signature of given request.
+// limitJWTPayload limits the lifetime of a JWT payload to the ttl, and adds a
+// unique jti claim to it so it can't be replayed. Nothing is done if the ttl
+// is zero.
+func limitJWTPayload(payload map[string]interface{}, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	now := time.Now()
+	exp := now.Add(ttl).Unix()
+	if sessionExp, ok := payload["exp"].(int64); !ok || sessionExp > exp {
+		payload["exp"] = exp
+	}
+	payload["nbf"] = now.Unix()
+	payload["jti"] = uuid.New().String()
+}
+
+// SignedJWT returns the signature of given request, signed with the global
+// signing key.
 func (e *Evaluator) SignedJWT(payload map[string]interface{}) (string, error) {
+	return signJWT(e.signingKey, payload)
+}
+
+func signJWT(key *signingKey, payload map[string]interface{}) (string, error) {
 	signerOpt := &jose.SignerOptions{}
 	signer, err := jose.NewSigner(jose.SigningKey{
 		Algorithm: jose.ES256,
-		Key:       e.jwk,
-	}, signerOpt.WithHeader("kid", e.kid))
+		Key:       key.jwk,
+	}, signerOpt.WithHeader("kid", key.kid))
 	if err != nil {
 		return "", err
 	}
@@ -258,26 +398,92 @@ func (e *Evaluator) SignedJWT(payload map[string]interface{}) (string, error) {
 }
 
 type input struct {
-	DataBrokerData           dataBrokerDataInput `json:"databroker_data"`
-	HTTP                     RequestHTTP         `json:"http"`
-	Session                  RequestSession      `json:"session"`
-	IsValidClientCertificate bool                `json:"is_valid_client_certificate"`
+	DataBrokerData           dataBrokerDataInput    `json:"databroker_data"`
+	HTTP                     RequestHTTP            `json:"http"`
+	GRPC                     *RequestGRPC           `json:"grpc,omitempty"`
+	OpenAPI                  *RequestOpenAPI        `json:"openapi,omitempty"`
+	GraphQL                  *RequestGraphQL        `json:"graphql,omitempty"`
+	Session                  RequestSession         `json:"session"`
+	IsValidClientCertificate bool                   `json:"is_valid_client_certificate"`
+	ClientCertificate        *clientCertificateInfo `json:"client_certificate,omitempty"`
+	// RoutePolicyIdx is the index of the route policy matching the request,
+	// or -1 if there isn't one. Looking it up in Go avoids checking every
+	// route in rego.
+	RoutePolicyIdx int `json:"route_policy_idx"`
+	// RoutePolicy is the route policy matching the request, if there is one.
+	RoutePolicy *config.Policy `json:"route_policy,omitempty"`
+	// IsAllowedIP is true if the client address is in one of the IP lists
+	// allowed by the route policy.
+	IsAllowedIP bool `json:"is_allowed_ip"`
 }
 
 type dataBrokerDataInput struct {
 	Session interface{} `json:"session,omitempty"`
 	User    interface{} `json:"user,omitempty"`
 	Groups  interface{} `json:"groups,omitempty"`
+	// Claims are the identity provider claims of the user and session.
+	Claims map[string][]interface{} `json:"claims,omitempty"`
+	// Device is the kiosk device signed in to the session, if it's active.
+	Device interface{} `json:"device,omitempty"`
+	// AssumedRoles are the names of the roles actively assumed in the
+	// session.
+	AssumedRoles []string `json:"assumed_roles,omitempty"`
+}
+
+// inputPool reuses the inputs of evaluations. Rego converts the input to its
+// own values, so an input can be reused once the evaluation is done.
+var inputPool = sync.Pool{
+	New: func() interface{} { return new(input) },
+}
+
+// putInput resets an input and returns it to the pool.
+func putInput(i *input) {
+	*i = input{}
+	inputPool.Put(i)
 }
 
 func (e *Evaluator) newInput(req *Request, isValidClientCertificate bool) *input {
-	i := new(input)
+	i := inputPool.Get().(*input)
+	i.RoutePolicyIdx = e.routes.Lookup(req.HTTP.URL)
+	if i.RoutePolicyIdx >= 0 && i.RoutePolicyIdx < len(e.policies) {
+		i.RoutePolicy = &e.policies[i.RoutePolicyIdx]
+	}
 	i.DataBrokerData.Session = req.DataBrokerData.Get(sessionTypeURL, req.Session.ID)
-	if obj, ok := i.DataBrokerData.Session.(interface{ GetUserId() string }); ok {
+	if sa := getServiceAccount(req); sa != nil {
+		// service accounts act as a user with the service account's email
+		// and groups
+		i.DataBrokerData.Session = sa
+		i.DataBrokerData.User = &user.User{
+			Id:    serviceaccount.UserID(sa.GetId()),
+			Name:  sa.GetName(),
+			Email: sa.GetEmail(),
+		}
+		i.DataBrokerData.Groups = sa.GetGroups()
+	} else if ri := req.Interop; ri != nil {
+		// upstream identities act as a user with the asserted email and
+		// groups
+		i.DataBrokerData.Session = &session.Session{Id: req.Session.ID, UserId: ri.UserID}
+		i.DataBrokerData.User = &user.User{Id: ri.UserID, Email: ri.Email}
+		i.DataBrokerData.Groups = ri.Groups
+	} else if obj, ok := i.DataBrokerData.Session.(interface{ GetUserId() string }); ok {
 		i.DataBrokerData.User = req.DataBrokerData.Get(userTypeURL, obj.GetUserId())
+		i.DataBrokerData.Claims = getIDPClaims(
+			getClaims(i.DataBrokerData.User),
+			getClaims(i.DataBrokerData.Session),
+		)
+		if s, ok := i.DataBrokerData.Session.(*session.Session); ok {
+			i.DataBrokerData.AssumedRoles = s.GetActiveRoles(time.Now())
+		}
+		if kiosk.IsUserID(obj.GetUserId()) {
+			device, ok := req.DataBrokerData.Get(kioskDeviceTypeURL, req.Session.ID).(*kiosk.Device)
+			if ok && device.IsActive(time.Now()) {
+				i.DataBrokerData.Device = device
+			}
+		}
 
+		// deactivated users don't get the access their groups allow
 		user, ok := req.DataBrokerData.Get(directoryUserTypeURL, obj.GetUserId()).(*directory.User)
-		if ok {
+		if ok && !user.GetInactive() {
 			var groups []string
 			for _, groupID := range user.GetGroupIds() {
 				if dg, ok := req.DataBrokerData.Get(directoryGroupTypeURL, groupID).(*directory.Group); ok {
@@ -294,17 +500,78 @@ func (e *Evaluator) newInput(req *Request, isValidClientCertificate bool) *input
 		}
 	}
 	i.HTTP = req.HTTP
+	i.GRPC = req.GRPC
+	i.OpenAPI = req.OpenAPI
+	i.GraphQL = req.GraphQL
 	i.Session = req.Session
 	i.IsValidClientCertificate = isValidClientCertificate
+	i.ClientCertificate = getClientCertificateInfo(req.HTTP.ClientCertificate)
+	if i.RoutePolicyIdx >= 0 && i.RoutePolicyIdx < len(e.policies) {
+		i.IsAllowedIP = isAllowedIP(req.DataBrokerData, e.policies[i.RoutePolicyIdx].AllowedIPLists, req.HTTP.ClientIP)
+	}
 	return i
 }
 
+// getServiceAccount returns the service account signed in with the request's
+// session id, if there's no session with that id and the service account is
+// active.
+func getServiceAccount(req *Request) *serviceaccount.ServiceAccount {
+	if req.Session.ID == "" || req.DataBrokerData.Get(sessionTypeURL, req.Session.ID) != nil {
+		return nil
+	}
+	sa, ok := req.DataBrokerData.Get(serviceAccountTypeURL, req.Session.ID).(*serviceaccount.ServiceAccount)
+	if !ok || !sa.IsActive(time.Now()) {
+		return nil
+	}
+	return sa
+}
+
+// isAllowedIP returns true if the client IP is in one of the named IP lists.
+// Lists which don't exist in the databroker don't match any address.
+func isAllowedIP(dbd DataBrokerData, names []string, clientIP string) bool {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, name := range names {
+		if l, ok := dbd.Get(ipListTypeURL, name).(*iplist.IPList); ok && l.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRequestOpenAPI returns the OpenAPI field of a request to a route, or nil
+// if the route doesn't have an OpenAPI spec or the request doesn't match one
+// of its operations.
+func GetRequestOpenAPI(policy *config.Policy, method string, requestURL *url.URL) *RequestOpenAPI {
+	if policy == nil || policy.OpenAPISpec == nil {
+		return nil
+	}
+	op := policy.OpenAPISpec.Match(method, requestURL.EscapedPath())
+	if op == nil {
+		return nil
+	}
+	return &RequestOpenAPI{OperationID: op.ID, Scopes: op.Scopes}
+}
+
+func getClaims(record interface{}) map[string]*anypb.Any {
+	if obj, ok := record.(interface{ GetClaims() map[string]*anypb.Any }); ok {
+		return obj.GetClaims()
+	}
+	return nil
+}
+
 type (
 	// Request is the request data used for the evaluator.
 	Request struct {
-		DataBrokerData DataBrokerData `json:"databroker_data"`
-		HTTP           RequestHTTP    `json:"http"`
-		Session        RequestSession `json:"session"`
+		DataBrokerData DataBrokerData  `json:"databroker_data"`
+		HTTP           RequestHTTP     `json:"http"`
+		GRPC           *RequestGRPC    `json:"grpc,omitempty"`
+		OpenAPI        *RequestOpenAPI `json:"openapi,omitempty"`
+		GraphQL        *RequestGraphQL `json:"graphql,omitempty"`
+		Session        RequestSession  `json:"session"`
+		Interop        *RequestInterop `json:"interop,omitempty"`
 		CustomPolicies []string
 	}
 
@@ -314,6 +581,42 @@ type (
 		URL               string            `json:"url"`
 		Headers           map[string]string `json:"headers"`
 		ClientCertificate string            `json:"client_certificate"`
+		// ClientIP is the IP address of the downstream client.
+		ClientIP string `json:"client_ip,omitempty"`
+		// Country is the ISO 3166-1 country code of the client IP, if a geoip
+		// country database is configured.
+		Country string `json:"country,omitempty"`
+		// ASN and ASOrganization are the autonomous system of the client IP, if
+		// a geoip ASN database is configured.
+		ASN            uint   `json:"asn,omitempty"`
+		ASOrganization string `json:"as_organization,omitempty"`
+	}
+
+	// RequestGRPC is the gRPC field in the request. It is only set for gRPC
+	// requests.
+	RequestGRPC struct {
+		// Service is the fully-qualified service name, e.g. "pkg.Service".
+		Service string `json:"service"`
+		Method  string `json:"method"`
+	}
+
+	// RequestOpenAPI is the OpenAPI field in the request. It is only set for
+	// requests matching an operation of the route's OpenAPI spec.
+	RequestOpenAPI struct {
+		OperationID string `json:"operation_id"`
+		// Scopes are the scopes required by the operation's security
+		// requirements.
+		Scopes []string `json:"scopes"`
+	}
+
+	// RequestGraphQL is the GraphQL field in the request. It is only set for
+	// requests to GraphQL routes which are a valid GraphQL operation.
+	RequestGraphQL struct {
+		// OperationType is query, mutation or subscription.
+		OperationType string `json:"operation_type"`
+		OperationName string `json:"operation_name,omitempty"`
+		// Fields are the names of the operation's top-level fields.
+		Fields []string `json:"fields"`
 	}
 
 	// RequestSession is the session field in the request.
@@ -321,6 +624,27 @@ type (
 		ID                string   `json:"id"`
 		ImpersonateEmail  string   `json:"impersonate_email"`
 		ImpersonateGroups []string `json:"impersonate_groups"`
+		// AuthTime is when the user signed in with the identity provider, as
+		// a unix timestamp.
+		AuthTime int64 `json:"auth_time,omitempty"`
+		// ACR and AMR are the authentication context class and the methods
+		// the user signed in with.
+		ACR string   `json:"acr,omitempty"`
+		AMR []string `json:"amr,omitempty"`
+		// IdentityProviderID is the id of the identity provider the user
+		// signed in with.
+		IdentityProviderID string `json:"idp_id,omitempty"`
+	}
+
+	// RequestInterop is the identity asserted by an upstream zero-trust
+	// provider. It is only set for requests without a session, which are
+	// evaluated as the asserted user.
+	RequestInterop struct {
+		UserID string   `json:"user_id"`
+		Email  string   `json:"email"`
+		Groups []string `json:"groups,omitempty"`
+		// ExpiresAt is when the assertion expires, as a unix timestamp.
+		ExpiresAt int64 `json:"expires_at,omitempty"`
 	}
 )
 
@@ -328,6 +652,7 @@ type (
 type Result struct {
 	Status         int
 	Message        string
+	DenyReason     DenyReason
 	SignedJWT      string
 	MatchingPolicy *config.Policy
 
@@ -346,7 +671,7 @@ func getMatchingPolicy(vars rego.Vars, policies []config.Policy) *config.Policy
 		return nil
 	}
 
-	if idx >= len(policies) {
+	if idx < 0 || idx >= len(policies) {
 		return nil
 	}
 
@@ -380,7 +705,7 @@ func getDenyVar(vars rego.Vars) []Result {
 	results := make([]Result, 0, len(denials))
 	for _, denial := range denials {
 		denial, ok := denial.([]interface{})
-		if !ok || len(denial) != 2 {
+		if !ok || len(denial) < 2 || len(denial) > 3 {
 			continue
 		}
 
@@ -391,9 +716,17 @@ func getDenyVar(vars rego.Vars) []Result {
 		}
 		msg := fmt.Sprint(denial[1])
 
+		denyReason := DenyReasonForbidden
+		if len(denial) == 3 {
+			if r := DenyReason(fmt.Sprint(denial[2])); r.IsValid() {
+				denyReason = r
+			}
+		}
+
 		results = append(results, Result{
-			Status:  status,
-			Message: msg,
+			Status:     status,
+			Message:    msg,
+			DenyReason: denyReason,
 		})
 	}
 	return results
@@ -416,6 +749,11 @@ func (dbd DataBrokerData) Get(typeURL, id string) interface{} {
 	return m[id]
 }
 
+// Delete removes a record from the DataBrokerData.
+func (dbd DataBrokerData) Delete(typeURL, id string) {
+	delete(dbd[typeURL], id)
+}
+
 // Update updates a record in the DataBrokerData.
 func (dbd DataBrokerData) Update(record *databroker.Record) {
 	db, ok := dbd[record.GetType()]
@@ -436,7 +774,23 @@ func (dbd DataBrokerData) Update(record *databroker.Record) {
 	}
 }
 
+// recordTypes are constructors for the record types used by the evaluator,
+// by type URL. Decoding them doesn't need a lookup in the protobuf registry.
+var recordTypes = map[string]func() protov2.Message{
+	sessionTypeURL:        func() protov2.Message { return new(session.Session) },
+	userTypeURL:           func() protov2.Message { return new(user.User) },
+	directoryUserTypeURL:  func() protov2.Message { return new(directory.User) },
+	directoryGroupTypeURL: func() protov2.Message { return new(directory.Group) },
+	kioskDeviceTypeURL:    func() protov2.Message { return new(kiosk.Device) },
+	ipListTypeURL:         func() protov2.Message { return new(iplist.IPList) },
+}
+
 func unmarshalAny(any *anypb.Any) (proto.Message, error) {
+	if newMessage, ok := recordTypes[any.GetTypeUrl()]; ok {
+		msg := newMessage()
+		return proto.MessageV1(msg), protov2.Unmarshal(any.GetValue(), msg)
+	}
+
 	messageType, err := protoregistry.GlobalTypes.FindMessageByURL(any.GetTypeUrl())
 	if err != nil {
 		return nil, err
@@ -1,7 +1,9 @@
 package evaluator
 
 import (
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
@@ -56,6 +58,52 @@ func isValidClientCertificate(ca, cert string) (bool, error) {
 	return valid, nil
 }
 
+var clientCertificateInfoCache, _ = lru.New2Q(100)
+
+// clientCertificateInfo is the client certificate data made available to
+// policies.
+type clientCertificateInfo struct {
+	// Fingerprint is the hex-encoded SHA-256 hash of the certificate.
+	Fingerprint string `json:"fingerprint"`
+	// SANs are the DNS, email, IP and URI subject alternative names.
+	SANs []string `json:"sans"`
+}
+
+// getClientCertificateInfo returns the fingerprint and subject alternative
+// names of a PEM-encoded client certificate, or nil if no valid certificate was
+// supplied.
+func getClientCertificateInfo(cert string) *clientCertificateInfo {
+	if cert == "" {
+		return nil
+	}
+
+	value, ok := clientCertificateInfoCache.Get(cert)
+	if ok {
+		return value.(*clientCertificateInfo)
+	}
+
+	var info *clientCertificateInfo
+	xcert, err := parseCertificate(cert)
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to parse client certificate")
+	} else {
+		fingerprint := sha256.Sum256(xcert.Raw)
+		info = &clientCertificateInfo{Fingerprint: hex.EncodeToString(fingerprint[:])}
+		info.SANs = append(info.SANs, xcert.DNSNames...)
+		info.SANs = append(info.SANs, xcert.EmailAddresses...)
+		for _, ip := range xcert.IPAddresses {
+			info.SANs = append(info.SANs, ip.String())
+		}
+		for _, u := range xcert.URIs {
+			info.SANs = append(info.SANs, u.String())
+		}
+	}
+
+	clientCertificateInfoCache.Add(cert, info)
+
+	return info
+}
+
 func parseCertificate(pemStr string) (*x509.Certificate, error) {
 	block, _ := pem.Decode([]byte(pemStr))
 	if block == nil {
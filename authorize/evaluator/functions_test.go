@@ -120,3 +120,18 @@ func Test_isValidClientCertificate(t *testing.T) {
 		assert.False(t, valid, "should return false")
 	})
 }
+
+func Test_getClientCertificateInfo(t *testing.T) {
+	t.Run("no cert", func(t *testing.T) {
+		assert.Nil(t, getClientCertificateInfo(""))
+	})
+	t.Run("valid cert", func(t *testing.T) {
+		assert.Equal(t, &clientCertificateInfo{
+			Fingerprint: "b88b1e6dfe21f2ce582a8b9e337eaf664d6d86f50f6b9340ce6dd9de7ed7e199",
+			SANs:        []string{"example-subject"},
+		}, getClientCertificateInfo(testValidCert))
+	})
+	t.Run("not a cert", func(t *testing.T) {
+		assert.Nil(t, getClientCertificateInfo("WHATEVER!"))
+	})
+}
@@ -0,0 +1,58 @@
+package evaluator
+
+import (
+	"github.com/pomerium/pomerium/config"
+)
+
+// groupsOverflowClaim is set in JWTs whose groups claim was truncated to the
+// groups limit.
+const groupsOverflowClaim = "groups_overflow"
+
+// getPolicyGroups returns the groups referenced by the policies, including
+// their sub-policies.
+func getPolicyGroups(policies []config.Policy) map[string]struct{} {
+	groups := make(map[string]struct{})
+	for i := range policies {
+		p := &policies[i]
+		for _, group := range p.AllowedGroups {
+			groups[group] = struct{}{}
+		}
+		for _, group := range p.DeniedGroups {
+			groups[group] = struct{}{}
+		}
+		for _, sp := range p.SubPolicies {
+			for _, group := range sp.AllowedGroups {
+				groups[group] = struct{}{}
+			}
+		}
+	}
+	return groups
+}
+
+// limitJWTGroups limits the groups claim of a JWT payload, so it fits in the
+// headers upstreams accept. If policyGroups isn't nil, the groups it doesn't
+// contain are removed. Then at most limit groups are kept, and the groups
+// overflow claim is set if any more were removed. The groups aren't limited
+// if the limit is zero.
+func limitJWTGroups(payload map[string]interface{}, policyGroups map[string]struct{}, limit int) {
+	groups, ok := payload["groups"].([]string)
+	if !ok {
+		return
+	}
+
+	if policyGroups != nil {
+		filtered := make([]string, 0, len(groups))
+		for _, group := range groups {
+			if _, ok := policyGroups[group]; ok {
+				filtered = append(filtered, group)
+			}
+		}
+		groups = filtered
+	}
+
+	if limit > 0 && len(groups) > limit {
+		groups = groups[:limit]
+		payload[groupsOverflowClaim] = true
+	}
+	payload["groups"] = groups
+}
@@ -0,0 +1,75 @@
+package evaluator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestGetPolicyGroups(t *testing.T) {
+	groups := getPolicyGroups([]config.Policy{
+		{AllowedGroups: []string{"admins", "devs"}},
+		{DeniedGroups: []string{"contractors"}, SubPolicies: []config.SubPolicy{{AllowedGroups: []string{"on-call"}}}},
+		{AllowedUsers: []string{"user@example.com"}},
+	})
+	assert.Equal(t, map[string]struct{}{
+		"admins":      {},
+		"devs":        {},
+		"contractors": {},
+		"on-call":     {},
+	}, groups)
+}
+
+func TestLimitJWTGroups(t *testing.T) {
+	makeGroups := func(n int) []string {
+		groups := make([]string, n)
+		for i := range groups {
+			groups[i] = fmt.Sprintf("group%d", i)
+		}
+		return groups
+	}
+
+	tests := []struct {
+		name         string
+		groups       []string
+		policyGroups map[string]struct{}
+		limit        int
+		want         map[string]interface{}
+	}{
+		{"no groups", nil, nil, 2, map[string]interface{}{}},
+		{"no limit", makeGroups(500), nil, 0, map[string]interface{}{"groups": makeGroups(500)}},
+		{"under limit", makeGroups(99), nil, 100, map[string]interface{}{"groups": makeGroups(99)}},
+		{"at limit", makeGroups(100), nil, 100, map[string]interface{}{"groups": makeGroups(100)}},
+		{"over limit", makeGroups(101), nil, 100, map[string]interface{}{"groups": makeGroups(100), "groups_overflow": true}},
+		{
+			"filtered", makeGroups(500), map[string]struct{}{"group1": {}, "group3": {}, "other": {}}, 0,
+			map[string]interface{}{"groups": []string{"group1", "group3"}},
+		},
+		{
+			"filtered to limit", makeGroups(500), map[string]struct{}{"group1": {}, "group3": {}}, 2,
+			map[string]interface{}{"groups": []string{"group1", "group3"}},
+		},
+		{
+			"filtered over limit", makeGroups(500), map[string]struct{}{"group1": {}, "group3": {}, "group5": {}}, 2,
+			map[string]interface{}{"groups": []string{"group1", "group3"}, "groups_overflow": true},
+		},
+		{
+			"filtered to none", makeGroups(500), map[string]struct{}{}, 0,
+			map[string]interface{}{"groups": []string{}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			payload := map[string]interface{}{}
+			if tt.groups != nil {
+				payload["groups"] = tt.groups
+			}
+			limitJWTGroups(payload, tt.policyGroups, tt.limit)
+			assert.Equal(t, tt.want, payload)
+		})
+	}
+}
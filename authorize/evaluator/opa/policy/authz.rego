@@ -3,15 +3,30 @@ package pomerium.authz
 default allow = false
 
 
-route_policy_idx := first_allowed_route_policy_idx(input.http.url)
-route_policy := data.route_policies[route_policy_idx]
+# the evaluator looks up the matching route before evaluating the policy, but
+# fall back to checking every route when it isn't part of the input
+route_policy_idx = idx {
+	idx := input.route_policy_idx
+} else = idx {
+	idx := first_allowed_route_policy_idx(input.http.url)
+}
+
+# the evaluator includes the matching route policy, so it always comes from the
+# same routes as its index
+route_policy = policy {
+	policy := input.route_policy
+} else = policy {
+	policy := data.route_policies[route_policy_idx]
+}
 session := input.databroker_data.session
 user := input.databroker_data.user
 groups := input.databroker_data.groups
+idp_claims := object.get(input.databroker_data, "claims", {})
 
 all_allowed_domains := get_allowed_domains(route_policy)
 all_allowed_groups := get_allowed_groups(route_policy)
 all_allowed_users := get_allowed_users(route_policy)
+all_allowed_idp_claims := get_allowed_idp_claims(route_policy)
 
 # allow public
 allow {
@@ -65,6 +80,28 @@ allow {
 	email_in_domain(input.session.impersonate_email, all_allowed_domains[domain])
 }
 
+# allow by idp claims, if every claim of the route's or of a sub policy's
+# allowed idp claims has an accepted value
+allow {
+	idp_claims_allowed(all_allowed_idp_claims[_])
+	input.session.impersonate_email == ""
+	object.get(input.session, "impersonate_groups", null) == null
+}
+
+# allow by actively assumed role
+allow {
+	some role
+	object.get(input.databroker_data, "assumed_roles", [])[_] = role
+	object.get(route_policy, "allowed_roles", [])[_] = role
+	input.session.impersonate_email == ""
+	object.get(input.session, "impersonate_groups", null) == null
+}
+
+# allow kiosk devices on the routes they were approved for
+allow {
+	object.get(input.databroker_data, "device", {}).routes[_] == route_policy.source
+}
+
 # allow pomerium urls
 allow {
 	contains(input.http.url, "/.pomerium/")
@@ -73,23 +110,173 @@ allow {
 
 # allow user is admin
 allow {
-	element_in_list(data.admins, input.user.email)
+	element_in_list(data.admins, user.email)
 	contains(input.http.url, ".pomerium/admin")
 }
 
 # deny non-admin users from accesing admin routes
 deny[reason] {
-	reason = [403, "user is not admin"]
+	reason = [403, "user is not admin", "group-mismatch"]
 	not element_in_list(data.admins, user.email)
 	contains(input.http.url,".pomerium/admin")
 }
 
+# deny by email
+deny[reason] {
+	reason = [403, "user is denied", "group-mismatch"]
+	element_in_list(object.get(route_policy, "denied_users", []), user.email)
+}
+
+# deny by impersonate email
+deny[reason] {
+	reason = [403, "user is denied", "group-mismatch"]
+	element_in_list(object.get(route_policy, "denied_users", []), input.session.impersonate_email)
+}
+
+# deny by group
+deny[reason] {
+	reason = [403, "user is denied", "group-mismatch"]
+	some group
+	groups[_] = group
+	element_in_list(object.get(route_policy, "denied_groups", []), group)
+}
+
+# deny by impersonate group
+deny[reason] {
+	reason = [403, "user is denied", "group-mismatch"]
+	some group
+	input.session.impersonate_groups[_] = group
+	element_in_list(object.get(route_policy, "denied_groups", []), group)
+}
+
+# deny by domain
 deny[reason] {
-	reason = [495, "invalid client certificate"]
+	reason = [403, "user is denied", "group-mismatch"]
+	some domain
+	email_in_domain(user.email, object.get(route_policy, "denied_domains", [])[domain])
+}
+
+# deny by impersonate domain
+deny[reason] {
+	reason = [403, "user is denied", "group-mismatch"]
+	some domain
+	email_in_domain(input.session.impersonate_email, object.get(route_policy, "denied_domains", [])[domain])
+}
+
+# deny grpc methods which are not allowed, including requests which aren't a
+# well-formed grpc call
+deny[reason] {
+	reason = [403, "grpc method is not allowed", "forbidden"]
+	count(object.get(route_policy, "allowed_grpc_methods", [])) > 0
+	not grpc_method_allowed(route_policy.allowed_grpc_methods)
+}
+
+# deny openapi operations which are not allowed, including requests which
+# don't match an operation of the route's spec
+deny[reason] {
+	reason = [403, "openapi operation is not allowed", "forbidden"]
+	count(object.get(route_policy, "allowed_openapi_operations", [])) > 0
+	not openapi_operation_allowed(route_policy.allowed_openapi_operations)
+}
+
+# deny graphql operations with fields which are not allowed, including
+# requests which aren't a valid graphql operation
+deny[reason] {
+	reason = [403, "graphql operation is not allowed", "forbidden"]
+	count(object.get(route_policy, "allowed_graphql_fields", [])) > 0
+	not graphql_fields_allowed(route_policy.allowed_graphql_fields)
+}
+
+deny[reason] {
+	reason = [495, "invalid client certificate", "invalid-client-certificate"]
 	is_boolean(input.is_valid_client_certificate)
 	not input.is_valid_client_certificate
 }
 
+# deny client certificates which don't match the allowed fingerprints
+deny[reason] {
+	reason = [495, "client certificate is not allowed", "invalid-client-certificate"]
+	count(object.get(route_policy, "allowed_client_certificate_fingerprints", [])) > 0
+	not client_certificate_fingerprint_allowed(route_policy.allowed_client_certificate_fingerprints)
+}
+
+# deny client certificates which don't have one of the allowed sans
+deny[reason] {
+	reason = [495, "client certificate is not allowed", "invalid-client-certificate"]
+	count(object.get(route_policy, "allowed_client_certificate_sans", [])) > 0
+	not client_certificate_san_allowed(route_policy.allowed_client_certificate_sans)
+}
+
+# deny client addresses which aren't in one of the route's ip lists
+deny[reason] {
+	reason = [403, "ip address is not allowed", "ip-blocked"]
+	count(object.get(route_policy, "allowed_ip_lists", [])) > 0
+	not input.is_allowed_ip
+}
+
+# deny sessions which signed in longer ago than the route allows
+deny[reason] {
+	reason = [401, "session is too old", "session-too-old"]
+	object.get(route_policy, "allowed_session_max_age", 0) > 0
+	input.session.id != ""
+	time.now_ns() - (object.get(input.session, "auth_time", 0) * 1000000000) > route_policy.allowed_session_max_age
+}
+
+# deny sessions which haven't signed in with the step-up authentication the
+# route requires
+deny[reason] {
+	reason = [401, "step-up authentication required", "step-up-required"]
+	step_up_required
+	input.session.id != ""
+	not step_up_satisfied
+}
+
+step_up_required {
+	count(object.get(route_policy, "required_acr_values", [])) > 0
+}
+
+step_up_required {
+	count(object.get(route_policy, "required_amr_values", [])) > 0
+}
+
+step_up_satisfied {
+	step_up_acr_satisfied
+	step_up_amr_satisfied
+	step_up_max_age_satisfied
+}
+
+step_up_acr_satisfied {
+	count(object.get(route_policy, "required_acr_values", [])) == 0
+}
+
+step_up_acr_satisfied {
+	route_policy.required_acr_values[_] == object.get(input.session, "acr", "")
+}
+
+step_up_amr_satisfied {
+	count(object.get(route_policy, "required_amr_values", [])) == 0
+}
+
+step_up_amr_satisfied {
+	route_policy.required_amr_values[_] == object.get(input.session, "amr", [])[_]
+}
+
+step_up_max_age_satisfied {
+	object.get(route_policy, "step_up_max_age", 0) == 0
+}
+
+step_up_max_age_satisfied {
+	time.now_ns() - (object.get(input.session, "auth_time", 0) * 1000000000) <= route_policy.step_up_max_age
+}
+
+# deny sessions from a different identity provider than the route requires
+deny[reason] {
+	reason = [401, "identity provider mismatch", "idp-mismatch"]
+	object.get(route_policy, "idp_id", "") != ""
+	input.session.id != ""
+	object.get(input.session, "idp_id", "") != route_policy.idp_id
+}
+
 # returns the first matching route
 first_allowed_route_policy_idx(input_url) = first_policy_idx {
 	first_policy_idx := [idx | some idx, policy; policy = data.route_policies[idx]; allowed_route(input.http.url, policy)][0]
@@ -164,20 +351,105 @@ get_allowed_users(policy) = v {
     sub_allowed_users = [sp.allowed_users | sp := policy.sub_policies[_]]
     v := { x | x = array.concat(
         policy.allowed_users,
-        [u | u := policy.sub_policies[_].allowed_users[_]]
+        [u | sp := policy.sub_policies[_]; sub_policy_applies(sp); u := sp.allowed_users[_]]
     )[_] }
 }
 
 get_allowed_domains(policy) = v {
     v := { x | x = array.concat(
         policy.allowed_domains,
-        [u | u := policy.sub_policies[_].allowed_domains[_]]
+        [u | sp := policy.sub_policies[_]; sub_policy_applies(sp); u := sp.allowed_domains[_]]
     )[_] }
 }
 
 get_allowed_groups(policy) = v {
     v := { x | x = array.concat(
         policy.allowed_groups,
-        [u | u := policy.sub_policies[_].allowed_groups[_]]
+        [u | sp := policy.sub_policies[_]; sub_policy_applies(sp); u := sp.allowed_groups[_]]
     )[_] }
 }
+
+get_allowed_idp_claims(policy) = v {
+	v := array.concat(
+		[object.get(policy, "allowed_idp_claims", {})],
+		[c | sp := policy.sub_policies[_]; sub_policy_applies(sp); c := sp.allowed_idp_claims]
+	)
+}
+
+idp_claims_allowed(allowed) {
+	count(allowed) > 0
+	not idp_claim_denied(allowed)
+}
+
+idp_claim_denied(allowed) {
+	some claim
+	allowed[claim]
+	not idp_claim_matches(allowed[claim], claim)
+}
+
+idp_claim_matches(values, claim) {
+	values[_] == idp_claims[claim][_]
+}
+
+client_certificate_fingerprint_allowed(fingerprints) {
+	input.client_certificate.fingerprint == fingerprints[_]
+}
+
+client_certificate_san_allowed(sans) {
+	input.client_certificate.sans[_] == sans[_]
+}
+
+grpc_method := concat("/", [input.grpc.service, input.grpc.method])
+
+grpc_method_allowed(patterns) {
+	glob.match(patterns[_], ["/"], grpc_method)
+}
+
+openapi_operation_allowed(patterns) {
+	glob.match(patterns[_], ["/"], input.openapi.operation_id)
+}
+
+# every top-level field of the graphql operation matches one of the patterns,
+# as "type.field"
+graphql_fields_allowed(patterns) {
+	input.graphql
+	not graphql_field_denied(patterns)
+}
+
+graphql_field_denied(patterns) {
+	field := input.graphql.fields[_]
+	not graphql_field_matches(patterns, concat(".", [input.graphql.operation_type, field]))
+}
+
+graphql_field_matches(patterns, field) {
+	glob.match(patterns[_], ["."], field)
+}
+
+# sub policies with allowed grpc methods, openapi operations or graphql fields
+# only apply to those methods, operations and fields
+sub_policy_applies(sp) {
+	sub_policy_grpc_applies(sp)
+	sub_policy_openapi_applies(sp)
+	sub_policy_graphql_applies(sp)
+}
+
+sub_policy_grpc_applies(sp) {
+	count(object.get(sp, "allowed_grpc_methods", [])) == 0
+}
+sub_policy_grpc_applies(sp) {
+	grpc_method_allowed(sp.allowed_grpc_methods)
+}
+
+sub_policy_openapi_applies(sp) {
+	count(object.get(sp, "allowed_openapi_operations", [])) == 0
+}
+sub_policy_openapi_applies(sp) {
+	openapi_operation_allowed(sp.allowed_openapi_operations)
+}
+
+sub_policy_graphql_applies(sp) {
+	count(object.get(sp, "allowed_graphql_fields", [])) == 0
+}
+sub_policy_graphql_applies(sp) {
+	graphql_fields_allowed(sp.allowed_graphql_fields)
+}
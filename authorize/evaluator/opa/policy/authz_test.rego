@@ -18,6 +18,29 @@ test_email_allowed {
 		input.session as { "id": "session1", "impersonate_email": "" }
 }
 
+test_input_route_policy_used {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@example.com"]
+		}] with
+		input.route_policy_idx as 0 with
+		input.route_policy as {
+			"source": "example.com",
+			"allowed_users": ["y@example.com"]
+		} with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com"
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
 test_impersonate_email_not_allowed {
 	not allow with
 		data.route_policies as [{
@@ -169,6 +192,240 @@ test_impersonate_domain_allowed {
 		input.session as { "id": "session1", "impersonate_email": "y@example1.com" }
 }
 
+test_idp_claim_allowed {
+	allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_idp_claims": { "department": ["engineering"], "level": [3] }
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com"
+			},
+			"claims": {
+				"department": ["sales", "engineering"],
+				"level": [3]
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_idp_claim_number_allowed {
+	allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_idp_claims": { "level": [3] }
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"claims": {
+				"level": [3.0]
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_idp_claim_not_allowed {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_idp_claims": { "department": ["engineering"] }
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"claims": {
+				"department": ["sales"],
+				"team": ["engineering"]
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_idp_claims_all_required {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_idp_claims": { "department": ["engineering"], "level": [3, 4] }
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"claims": {
+				"department": ["engineering"],
+				"level": [2]
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_idp_claims_sub_policy_allowed {
+	allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_idp_claims": { "department": ["engineering"], "level": [3, 4] },
+			"sub_policies": [
+				{ "allowed_idp_claims": { "department": ["sales"] } }
+			]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"claims": {
+				"department": ["sales"],
+				"level": [2]
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_impersonate_idp_claim_not_allowed {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_idp_claims": { "department": ["engineering"] }
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"claims": {
+				"department": ["engineering"]
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "y@example.com" }
+}
+
+test_denied_users {
+	deny[[403, "user is denied", "group-mismatch"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_domains": ["example.com"],
+			"denied_users": ["x@example.com"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com"
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_denied_users_not_matched {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_domains": ["example.com"],
+			"denied_users": ["y@example.com"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com"
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_impersonate_denied_users {
+	deny[[403, "user is denied", "group-mismatch"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_domains": ["example.com"],
+			"denied_users": ["y@example.com"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com"
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "y@example.com" }
+}
+
+test_denied_groups {
+	deny[[403, "user is denied", "group-mismatch"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_groups": ["1"],
+			"denied_groups": ["2"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com",
+			},
+			"groups": ["1", "2"]
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_groups": null }
+}
+
+test_impersonate_denied_groups {
+	deny[[403, "user is denied", "group-mismatch"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_groups": ["1"],
+			"denied_groups": ["2"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com",
+			},
+			"groups": ["1"]
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_groups": ["2"] }
+}
+
+test_denied_domains {
+	deny[[403, "user is denied", "group-mismatch"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@contractor.example.com"],
+			"denied_domains": ["contractor.example.com"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@contractor.example.com"
+			}
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
 test_example {
 	not allow with
 		data.route_policies as [
@@ -243,6 +500,24 @@ test_pomerium_denied {
 		}
 }
 
+test_pomerium_admin_allowed {
+	allow with
+		data.admins as ["bob@example.com"] with
+		data.route_policies as [] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "bob@example.com"
+			}
+		} with
+		input.http as {
+			"url": "http://example.com/.pomerium/admin/analytics",
+			"host": "example.com"
+		}
+}
+
 test_cors_preflight_allowed {
 	allow with
 		data.route_policies as [{
@@ -343,4 +618,401 @@ test_sub_policy {
         ]
     })
 	z == {"g1", "g2", "g3", "g4"}
+
+
+	c := get_allowed_idp_claims({
+        "source": "example.com",
+        "allowed_idp_claims": { "c1": ["v1"] },
+        "sub_policies": [
+            { "allowed_idp_claims": { "c2": ["v2"] } },
+            { "allowed_users": ["u1"] }
+        ]
+    })
+	c == [{ "c1": ["v1"] }, { "c2": ["v2"] }]
+}
+
+test_grpc_method_allowed {
+	allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@example.com"],
+			"allowed_grpc_methods": ["inventory.Inventory/Get*"]
+		}] with
+		input.databroker_data as {
+			"session": {
+				"user_id": "user1"
+			},
+			"user": {
+				"email": "x@example.com"
+			}
+		} with
+		input.http as { "url": "http://example.com/inventory.Inventory/GetItem" } with
+		input.grpc as { "service": "inventory.Inventory", "method": "GetItem" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_grpc_method_denied {
+	deny[[403, "grpc method is not allowed", "forbidden"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@example.com"],
+			"allowed_grpc_methods": ["inventory.Inventory/Get*"]
+		}] with
+		input.http as { "url": "http://example.com/inventory.Inventory/DeleteItem" } with
+		input.grpc as { "service": "inventory.Inventory", "method": "DeleteItem" }
+}
+
+test_grpc_malformed_request_denied {
+	deny[[403, "grpc method is not allowed", "forbidden"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@example.com"],
+			"allowed_grpc_methods": ["inventory.Inventory/Get*"]
+		}] with
+		input.http as { "url": "http://example.com/inventory.Inventory/GetItem/../DeleteItem" }
+}
+
+test_grpc_method_sub_policy {
+	x := get_allowed_users({
+		"source": "example.com",
+		"allowed_users": ["u1"],
+		"sub_policies": [
+			{ "allowed_users": ["u2"], "allowed_grpc_methods": ["inventory.Inventory/Get*", "inventory.Inventory/List*"] },
+			{ "allowed_users": ["u3"] }
+		]
+	}) with input.grpc as { "service": "inventory.Inventory", "method": "ListItems" }
+	x == {"u1", "u2", "u3"}
+
+	y := get_allowed_users({
+		"source": "example.com",
+		"allowed_users": ["u1"],
+		"sub_policies": [
+			{ "allowed_users": ["u2"], "allowed_grpc_methods": ["inventory.Inventory/Get*", "inventory.Inventory/List*"] },
+			{ "allowed_users": ["u3"] }
+		]
+	}) with input.grpc as { "service": "inventory.Inventory", "method": "DeleteItem" }
+	y == {"u1", "u3"}
+}
+
+test_openapi_operation_allowed {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_openapi_operations": ["list*", "invoices.*"]
+		}] with
+		input.http as { "url": "http://example.com/invoices/1" } with
+		input.openapi as { "operation_id": "invoices.get", "scopes": [] }
+}
+
+test_openapi_operation_denied {
+	deny[[403, "openapi operation is not allowed", "forbidden"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_openapi_operations": ["list*", "invoices.*"]
+		}] with
+		input.http as { "url": "http://example.com/invoices" } with
+		input.openapi as { "operation_id": "createInvoice", "scopes": [] }
+}
+
+test_openapi_operation_unmatched {
+	deny[[403, "openapi operation is not allowed", "forbidden"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_openapi_operations": ["list*"]
+		}] with
+		input.http as { "url": "http://example.com/unknown" }
+}
+
+test_openapi_operation_sub_policy {
+	x := get_allowed_groups({
+		"source": "example.com",
+		"allowed_groups": ["admin"],
+		"sub_policies": [
+			{ "allowed_groups": ["finance"], "allowed_openapi_operations": ["createInvoice"] },
+			{ "allowed_groups": ["support"], "allowed_openapi_operations": ["createInvoice"], "allowed_grpc_methods": ["inventory.Inventory/*"] }
+		]
+	}) with input.openapi as { "operation_id": "createInvoice", "scopes": ["invoices.write"] }
+	x == {"admin", "finance"}
+
+	y := get_allowed_groups({
+		"source": "example.com",
+		"allowed_groups": ["admin"],
+		"sub_policies": [
+			{ "allowed_groups": ["finance"], "allowed_openapi_operations": ["createInvoice"] }
+		]
+	}) with input.openapi as { "operation_id": "listInvoices", "scopes": [] }
+	y == {"admin"}
+}
+
+test_graphql_fields_allowed {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_graphql_fields": ["query.*", "mutation.update*"]
+		}] with
+		input.http as { "url": "http://example.com/graphql" } with
+		input.graphql as { "operation_type": "mutation", "fields": ["updateUser", "updateGroup"] }
+}
+
+test_graphql_fields_denied {
+	deny[[403, "graphql operation is not allowed", "forbidden"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_graphql_fields": ["query.*", "mutation.update*"]
+		}] with
+		input.http as { "url": "http://example.com/graphql" } with
+		input.graphql as { "operation_type": "mutation", "fields": ["updateUser", "deleteUser"] }
+}
+
+test_graphql_fields_invalid_operation {
+	deny[[403, "graphql operation is not allowed", "forbidden"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_graphql_fields": ["query.*"]
+		}] with
+		input.http as { "url": "http://example.com/graphql" }
+}
+
+test_graphql_fields_sub_policy {
+	x := get_allowed_groups({
+		"source": "example.com",
+		"allowed_domains": [],
+		"allowed_groups": [],
+		"sub_policies": [
+			{ "allowed_domains": ["example.com"], "allowed_graphql_fields": ["query.*"] },
+			{ "allowed_groups": ["admin"] }
+		]
+	}) with input.graphql as { "operation_type": "mutation", "fields": ["me", "deleteUser"] }
+	x == {"admin"}
+
+	y := get_allowed_domains({
+		"source": "example.com",
+		"allowed_domains": [],
+		"allowed_groups": [],
+		"sub_policies": [
+			{ "allowed_domains": ["example.com"], "allowed_graphql_fields": ["query.*"] },
+			{ "allowed_groups": ["admin"] }
+		]
+	}) with input.graphql as { "operation_type": "query", "fields": ["me"] }
+	y == {"example.com"}
+}
+
+test_client_certificate_fingerprint_allowed {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_client_certificate_fingerprints": ["aaaa", "bbbb"]
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.client_certificate as { "fingerprint": "bbbb", "sans": [] }
+}
+
+test_client_certificate_fingerprint_denied {
+	deny[[495, "client certificate is not allowed", "invalid-client-certificate"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_client_certificate_fingerprints": ["aaaa"]
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.client_certificate as { "fingerprint": "bbbb", "sans": [] }
+}
+
+test_client_certificate_missing_denied {
+	deny[[495, "client certificate is not allowed", "invalid-client-certificate"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_client_certificate_sans": ["device-1.example.com"]
+		}] with
+		input.http as { "url": "http://example.com" }
+}
+
+test_client_certificate_san_allowed {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_client_certificate_sans": ["device-1.example.com"]
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.client_certificate as { "fingerprint": "bbbb", "sans": ["10.0.0.1", "device-1.example.com"] }
+}
+
+test_client_certificate_san_denied {
+	deny[[495, "client certificate is not allowed", "invalid-client-certificate"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_client_certificate_sans": ["device-1.example.com"]
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.client_certificate as { "fingerprint": "bbbb", "sans": ["device-2.example.com"] }
+}
+
+test_session_max_age_too_old {
+	deny[[401, "session is too old", "session-too-old"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_session_max_age": 300000000000
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "auth_time": (time.now_ns() / 1000000000) - 600 }
+}
+
+test_session_max_age_missing_auth_time {
+	deny[[401, "session is too old", "session-too-old"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_session_max_age": 300000000000
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1" }
+}
+
+test_step_up_acr_required {
+	deny[[401, "step-up authentication required", "step-up-required"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"required_acr_values": ["urn:example:mfa"]
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "acr": "urn:example:pwd" }
+}
+
+test_step_up_amr_required {
+	deny[[401, "step-up authentication required", "step-up-required"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"required_amr_values": ["mfa", "otp"]
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "amr": ["pwd"] }
+}
+
+test_step_up_too_old {
+	deny[[401, "step-up authentication required", "step-up-required"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"required_amr_values": ["mfa"],
+			"step_up_max_age": 300000000000
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "amr": ["pwd", "mfa"], "auth_time": (time.now_ns() / 1000000000) - 600 }
+}
+
+test_step_up_satisfied {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"required_acr_values": ["urn:example:mfa"],
+			"required_amr_values": ["mfa", "otp"],
+			"step_up_max_age": 300000000000
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "acr": "urn:example:mfa", "amr": ["pwd", "otp"], "auth_time": (time.now_ns() / 1000000000) - 60 }
+}
+
+test_idp_mismatch {
+	deny[[401, "identity provider mismatch", "idp-mismatch"]] with
+		data.route_policies as [{
+			"source": "example.com",
+			"idp_id": "contractors"
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "idp_id": "default" }
+}
+
+test_idp_match {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"idp_id": "contractors"
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "idp_id": "contractors" }
+}
+
+test_session_max_age_fresh {
+	count(deny) == 0 with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_session_max_age": 300000000000
+		}] with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "auth_time": (time.now_ns() / 1000000000) - 60 }
+}
+
+test_kiosk_device_allowed {
+	allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@example.com"]
+		}] with
+		input.databroker_data as {
+			"session": { "user_id": "kiosk/device1" },
+			"user": { "id": "kiosk/device1" },
+			"device": { "routes": ["example.com"] }
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "device1" }
+}
+
+test_kiosk_device_other_route_denied {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_users": ["x@example.com"]
+		}, {
+			"source": "other.example.com",
+			"allowed_users": ["x@example.com"]
+		}] with
+		input.databroker_data as {
+			"session": { "user_id": "kiosk/device1" },
+			"user": { "id": "kiosk/device1" },
+			"device": { "routes": ["example.com"] }
+		} with
+		input.http as { "url": "http://other.example.com" } with
+		input.session as { "id": "device1" }
+}
+
+test_assumed_role_allowed {
+	allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_roles": ["admin"]
+		}] with
+		input.databroker_data as {
+			"session": { "user_id": "user1" },
+			"user": { "id": "user1", "email": "x@example.com" },
+			"assumed_roles": ["admin"]
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_assumed_role_not_assumed {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_roles": ["admin"]
+		}] with
+		input.databroker_data as {
+			"session": { "user_id": "user1" },
+			"user": { "id": "user1", "email": "x@example.com" },
+			"assumed_roles": ["auditor"]
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "" }
+}
+
+test_assumed_role_impersonating {
+	not allow with
+		data.route_policies as [{
+			"source": "example.com",
+			"allowed_roles": ["admin"]
+		}] with
+		input.databroker_data as {
+			"session": { "user_id": "user1" },
+			"user": { "id": "user1", "email": "x@example.com" },
+			"assumed_roles": ["admin"]
+		} with
+		input.http as { "url": "http://example.com" } with
+		input.session as { "id": "session1", "impersonate_email": "y@example.com" }
 }
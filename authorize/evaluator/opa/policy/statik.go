@@ -9,6 +9,6 @@ import (
 const Rego = "rego" // static asset namespace
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xd5\x86\xf6P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00authz.regoUT\x05\x00\x01Ro\x18_\xacWO\x97\x9b6\x10?\xc3\xa7\x98\x90\x8biY6\xe9\x9fC\x9dG\xb7y9\xf5\xd0n^\xd2\x9ex\x84\xc8 \x1b% QI$\xbb\xd9\xe4\xbb\xf7\x8d$l\xc0\x86u6\xd9\xc3\xda\x1e\xfd\xe6\xcfof\x18\x0d-)\xde\x93\x1d\x85V4T\xb2\xae\x89I\xa7\xabO\xbe_\xd2-\xe9j\x0d\xa4\xae\xc5GH`KjE}\xdf\x97\xa2\xd34oE\xcd\x8a\xdb\x9c\x957\xb0N`\xcb\xa4\xd2\xb9A\xd22\x9f\"V\x8c\xb7\x9d\x8e+\xad\xdb\xb8\x93u82\x81\xea%\xd1$\x1e\x08\x19U\xe9\xd4H\xe6+\xaa\x14\x13\x1c\x15\xacAT\xdbH\xf1\x9e\xca\xdcXp\x00\xbfST\xce\xa3\xf0\xd4\xdfI\xd1\xb5j\x1ed\xcf}\x9f\xd4\xf5\x9eV)\x1a\xc2\xb8Q\xdaQ=\x15\xaf\x86\x01\x87#\xc5\x83\xb3\xa1\x9e\x95.\xa8a\xa0GZF8Q\xf2\x1f\xbb\"\xb5\xdd\xa6f\x85o\x7f\xdc\xf9\xde\x10\x16?G\xe9K\x83\xf8\x97c\x8d)\xd7\xac \x9a\x96\xcf\x8b\x82*\x05I\x02Zv\xd4\xffr0X\x08\xa9\xa0\x95t[\xb3]\xa5g\x0c\xbf\xb8~\xf5\xda\x1a\xef\x81{S\xde\xa0\xf2\x0d\xd5\x95(\xf1(\xb8~\xf9\xcf\x9f\xd7\x7f\xbf\x0e|\xaf\x10\x1d\xd7+\xb1yG\x0b\x1d\xef\xa8\x1e\xb6JEII\xa5\x8a \xb0\x01^\xbc\x10\\KQ_\xbc\xa2\xffuT\xe9\x8b\xbf\x8c\xc5 \x824\x0bC\xf8\x1d\x9e\x9ck\xefZ\xb2\x1d\xe3C\xc5\x01\xe7\xcd-\xd0\x86\xb0\xfa\xc0\x16s\x1e\x1b\x19F\x7fT\xa24\xcfz\xa2\xae\x03c\xd6\xb4T*\xc1\x89\xa6\xf9^1\x08\x86nL\xf9\x0f>\x94h\xa8\x93y\xb63\xd2<\x83\xa4\x17\x1d\xb7\xd3\xe8x\xde\xbb\xeb\xbd$\x01\xde\xd5\xf5\x84\xe7\x008\xe5|\x8a%\xf4O\xcb,\xcd\x05\xfb\x0b|\xef\x8b\xfe+21\xf6o\x1f\xcd\x89S'\xf4L\xc09\xe3\xee\x01^\x1d\xaa\x1c\xc1\x89\xc7>\xb5\x9fY\xf8\x80ZOR\xf1Ua\xdd\xe3\xec\x9eX\x07A\xf4\xf3\x1d:Y\xab\x83\xf3Bpm\xe6\xd7xHG\x10\\\xc6\xbd\xcae\x10\xfa\x1e\x17\x1a\xce\x02\x93\xb2a<\x18\xf96\x13\x99)0G\x07\xdf\xb4\xa6\x0d\xe5\x1a\xd9\xd6L\xe9\x95\x19\xbd\x06\xa3\"\xd7j\x87\xaa\x84K\xb1\xcex/)\xbf\x05.\xf8\x85\x91\x82\x9d\xa8[)\x1a 8R\x18\xdf\xd9\x90\xc0\x0c4\xe5#>\x95\x94(\xc133\xe7\xccWH \xfd\xe5\xc9\xcf\x11\x04=\x0f\xcc\x85u\x94\xd9\xc4,29\x8b\xc3i\n\x0b\x01\xfd\xf6k\x04\x01\xe3\x1fH\xcdJ(jF\xb9\x86\x82J\xcd\xb6f\xaacdL\xe5\x1b!jJ\xfa6b*7\xf8\xdc\xe2\xf3\x01\xdeU\xf8^\x9cM\xac\xa4\xba\x93\\\x81\xae\xa8\xbd\xfc\xa1!\xba\xa80\xa1&\x95\xfe9\x1bA\x8e\xcb\x00\xf4\xdb\xc3`\xa3\xb8\xf3\xbd#\xd9:\x81\x14??\x83y\\Xy\x13\x81=~\xe6>\xe1\xf4\"\x81\xbb\xc33\x18Er\xd4?\xee&\xcd\xd2'\x19\xf2;\x01\xce\x87\xb8;7\x03P\x98\x8b\xcd;\x0c\xae%RQ\x14\x0c\xb8\x99i5H\x80\x12\x9d,\x06\x06Qwot\n\xc6k\x97\xdd\x9c\x0b&\xba:\x13*\xe9\x8e\xce\x9a\x9d\x92_\x0e\x19\x0b5\xb8k\xad4\x82\xc0*\x05\x11\x04A\xb8\x9f\x86\xdf\xdb\xee#c\xd7\xb3\xb2\xd3\x95p\x0b\x8a\x85\x84\x93\xa2\xc5\x95PfS\x19[0\xe2\xe3<,Vc.^\xab\xb4\x98\x87o\xb7\xdb\xe7A\x13\xa9\xd5G6\xed\x83\x18[\xa3\xb7\x18[\xcd\x13u^h\xa0\xd9(\x88\xae\x96\xb9}\x93M\xc7\xab\x0f\x9c\xe8\n\xdd\x1cs;\xe6\xb2\xd4\xe1s\x8e\x8d\xce\"\x9bo\xb5\xea\xf8H\x9a\x9bQ\xd97\xa7\x81D'x\x99\"\x1dzYi\x89\xb3\xf2\x0e\x02UT\xb4\xa1\xc1\x1a\xec\x97\x08\x02l\xd9`\x0d\xf8\xd1\xe7p\x0d&c_0\xb24\x8f\xf6X\x8b\x91\xe4#\x1e\xe3\xded\xfc\xc7[\xc6K\x9c\xd6\xb9\xd2\x92\xf1]\xae\xba\x8d\x892\xe7+\xdf\xf3\xde\xae\xae\xd6+\x9c\x96\xa9\xca\xae\xc2\xf5\xe5ex\xb5J\xdf\\f?\x86\xab\xf4\xcd\xd5\xe3\xec\x87\xf0m\xe4{\x9e\xd22\x82\xa7!\x0eQ\xcf\xd6\x0b\xb8\x90\x0d\xa9\xd9'\xfbx\x99\x86p\xbe\x0d\xbd\x13\xc7\x8egp\x19\x98\xd5H\xcb}9\xe6\xc1\x88r\xe0G\xfd\xee5\xdd\xa3\xdc\xb6d\x7f\x99\x82\x99;E\xb55\xd3\xfda\xf0G\x10\xf6\xef\x107\xa6\x0f~\xf2\xbd\x9b\xf4i\x86_\xdd\x82\x86\xa6'\x97=\xfe\x8b\xcc\n\x80v\x01\xf0\xb7]JQ\x86\x1a\xc7op}\xe3$\xf0\xc1\xe8\x00\xa8n3y\xf5K Um<\x96}\x06\xd5\x9a!\xe7F[\xb79\xdcty\x96\x19K\x1f\x10p\x07xW\xde@\x02DJr\x1b\x17\x82\x17D\xaf\x0c\x00\xff\x9c\x81\x91\xf5h\x7f\x9av\xf0\x19\xbayGc\xbd\xbd\xe7\x10i\x7f\x992\xee\xdf\x90\x8f9?$Rg\xed\x01\xb1\xf6\xdb\xf1r\xb4\xee\xbd\xfc\xfb\x04k\x8d= \xd6\xfd\xab\xcd8\xd4\xff\x03\x00\x00\xff\xffPK\x07\x08O\xed+'\xcb\x04\x00\x00\xad\x11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00E\x86\xf6P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00authz_test.regoUT\x05\x00\x01Bn\x18_\xecXMo\xe36\x10=K\xbf\x82\xe0i\xb7pl8\xed\xc9@\xd0,\x16E\xd1C\x9b\xc5\xee\xf6\x14\x18\x02-M%v%QKRX;\x86\xff\xfb\x82\x1f\xfa\xb2%\x9b\x11b'\x07\xe7\x12\x9b\x9ay3\xf3\xde\x0cE\xba \xe17\x12\x03*X\x06\x9c\x96\xd9\x94\x942y\xf2}	B\x06\x90\x11\x9a\x06$M\xd9\x0f\x88\xd0\xd6\xf7\xf4G\xf4\x83\xca\xc4\xf7\xbc\x88H2\xe5\xac\x94\x10\x14,\xa5!\x05\x81\x88@\x8f[\xdf\xf3<,X\xc9C\xc0\x0b\x84aM\xb2\"\x85i\xc82<\xd1\xcf,bP\n\xe0\x02/\xd0#^\xdf\xb7\xad\x96\xbe\xe7\xed\x96U\x1c\x9a\x17\xa5\x9c\xaah+\xce\xbe\x01\x0f\xd4G\x15\xc9\x06\x02!(\xcb\xf1\xc2|\xf7\xb0B\x0dh\xa4B\xab\x8fs\xac\x96w&\xb2Zh,u}\xca\xae\x1b^\xdb\xab\x14\xba\x19$R\x16:,\xc2%\xd7nje1\x9b\xb5}\xd1\x9e\x93\xcd\xce\xfa\x99\xac\xec\xda\x1cO\x10\xa6Y\x01\\\xb0\x9cH\x08\xeat0\xda\xf9;\xab\xc1\x81A\x903\xd9\xd6$g\x12]u\xb9\x88.\x9b\xfb.\xe4\x11\x91.14\x9b\xab8.\xe2\xc4\x9c\x95\xc5\x19\x05\xd1\xf8F\x91\xf9k\xab0i9\x1c\xe6uai\xea\x04\xf22M\x07\xa6\xc5\xd8\\`O{K*\xb5\xec#\xca!\x94\x8co\x82\xb6'B\x08\x1d\xea\xf7*\xf3\xd5\xca\xe2\x16/\x8f\xabx\x91\x19\xbb\xbd\xaa\xf7\x02\xeaE,#4?\xa3b&\x80	}}M\x9d>\xdbYA\xce\xbf\x11^\x859q~\x98\x1f;\xdd\xbd\xc6\xdc\xcc\xaf\xfa\x1c\xd5\xc7\xae?k^T9\xb6\xe0F\x95\x9e\xd4'\xc6\xa6 2Q\x163R\xad\xb8\x8c\x93%xL\x9c\xd5~\x9c\xe6\xd8\x9f3\x96\xc3}}a\xef\x06[>_\x9e\xd9\xea@ \x15\xac\xa3\x8e\x96\xa5\x96\xe2\x7f\x06C\x87ms\xfb\x89 \xa7g\xdb\xc0\x1a&Vlu\xf2\n\xe4\xc2\xc0\x0b\xd6_\x94\xab\x94\x86g\xd8\x1f>(\x98O\x1a\xfd\xdf\x9c\x942\x81\\\xd2\x90H\x88>\x84!\x08E\x88\xe4%\x8cg\xc0\xdfu*\x18!ao\xaf\xf759\x87\xff\xe8\xda\xb4\xf9\xe6F\xef>\x83\xcd\xde'\xf1\xd0X\xf5\x84rgMonC\xcc\x99mrx\x80l\x15\x8a\xfc\xa6\x13\xec\x80\x9e\xa1\x17\xdch\x1a\xd3\x07\xb3i\x95\xf6\xac\xdd\x12U)#\x9a\xe2\xec\xd5\x9c\xd0\xa6)\x88D\x19\xcdm\xcc\x84	\xb9\x9fMG\xbd\x90q\x11\xa8FMi\x9c\xc8\xd7\xd1\xd0\x18~|\xf8\xfc\xc5\xb4q\x95\x8d\xc3\xa8k\xcf\x0cd\xc2\xf4\xf6\xf5\xf0\xe9\xeb_\x0f\xff|\xb1\xa1\x87\xc9\xaa\xd8\x01\x12\x99\xac\xec\x8c=p\x1a\xd3\\g)X\x06\xcc|]VC\xa6G\xe9\xe6#\xcb%g\xe9\xcdg\xf8^\x82\x907\x7fW\xe1\x1f\xf1\x9f\x7f|m\xdd\x81\xaa\xb6\xda\xe3\xf8\xcd6\xd7\x1b\xe6\xd1\xce'\xe1\x02\x82\x92\xa7*\x8e\xfa\xb7\xb8C\xf5\xda\xbb\xbe\x04U\xf4\x99:l\xfc\xfe]\xe0\xf7\xdai*\xc2\x042@ww\xa6$lV\xd5\xa4\xe8\xb5\xee\xa8\xa8G\xca_?j\xe0p\x9dSE\xbcQ\xcdHT\x8fO\xb5\xde\x97\x1b\x9e\xa0\xed\x80\xa4\xbb\xf7\xcf\xf7\xef1\x18\x0b#\xc6\xe0\xcc^\n\xe84\xce\xcc\x0d\xc8!#\x83T\x8f\xe1 \x1a\xe3\xf1q\xb9\xfa\xbb\xc1\x1c\x00\xdc\xbb\xa1u`p,Q\xef\xf5\xba-uW\xee\x81\x987\x81[\x89=9\xd4\xee\x03\xd5\xa9\xb1p\xaf\xad:\xf1;V\xd6\xe3\xe4TD/%\xf5\xadf\x0c!\x07\xce\xfdtp\x88\xe1\x19Zks\x85;\xfd\xc5\x91\x91\x9e\xc2j\x10\xfb\xd0b\xb9\x17W\x03<\xae7O\xcbvq\xa2\\\x99w\xd0F\xd5\xb4V[m\x0cM\xcd\xfa=\xf3n\xeb#\xfb7\xf8r\xaa\x0d\x0e\xdfP\xa5\xbem\x94\xb7\xea\xc5\xd0\xe0Tq)h\xab\xfa\x89\xfa\xdb\x1e\x81\xf9\x15/\xd1n\xe2`~\xab\xcd\x7f\xd3?\x13V\x96K\xfdIq\xb7V;\xfd\xb6\xc9\xcd@\x1b\x8f\x9d\xef\xfb\xdef\x9f\n{3\x1eEF\xfbV\x1d\xe9\x90\xd18:z\x80\x8e\x13\xd2q\xd0eFC\x94l\x0c%u~\x06\xdcxhJ\x9e\xf6)1?\xc7\x8eb\xa4\xf5Kn\xac\x03\xc6\xe3\x089\xc49\xceG\xdb^\x97\x18\x0f\xd1\xf1d\xe8\xa8\xb33\xd8\xc6C\x9dU~\x06\x00\x00\xff\xffPK\x07\x08tC\x13e,\x04\x00\x00k \x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd5\x86\xf6PO\xed+'\xcb\x04\x00\x00\xad\x11\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00authz.regoUT\x05\x00\x01Ro\x18_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00E\x86\xf6PtC\x13e,\x04\x00\x00k \x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x0c\x05\x00\x00authz_test.regoUT\x05\x00\x01Bn\x18_PK\x05\x06\x00\x00\x00\x00\x02\x00\x02\x00\x87\x00\x00\x00~	\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00N\x0eQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00authz.regoUT\x05\x00\x01e\xd4\xd2j\xccZK\x93\xe3\xb6\x11>\x8b\xbf\xa2\xcd9Xt(\xcdl\x1e\x87\xccF\xd9\xb8|\xca!Y\x97\x9d\x9cT2\x0d\x91\x90\x04\x0f\x05\xd0\x008\x0f\xaf\xe7\xbf\xa7\x1a\x00I\xf0\xad\xf1\xcc\xa6\xec\x835\x0bt\x7f\xfd@\xa3\x01t\xb3 \xe9\x1d9R(\xc4\x99JV\x9e\xd7\xa4\xd4\xa7_\x82 \xa3\x07R\xe6\x1aH\x9e\x8b\x07\xd8\xc0\x81\xe4\x8a\x06Ap\x05\xfaD\x81\xde\x93\xbc$ZH\xc8\x85\xb8SP\x16f\xf8Ltzb\xfc\x08R\x94\x9a\xc2\x9e\x1e\x84\xac\x89q\x1c\x89\n\x91\xb3\xf4)\x86}\xa9\x83+\xc4\xcdaO\xd2;\xd0\x02\xd2\x13M\xef\x90\x8e\xdeS\xf9\xe4P\x1eN\x94\x03\xd3\xc0\x14\xffRCA\xa4\x06q0H\x8c\x17\xa5\x0e\x0cUbQ\x13\x96=\xc2\x06\xf0\xff\x9f\x82\x05\xfe\xdcn,\xd9\xbaK\x16<\x03\xcd\x15\xedQ\x1f\x98T:1f\xd3,\xe9r--\xd8I\xebb]\xca<\n\x9e\xfb.a<\xcd\xcb\x8c\xaa!\x97T\xc6+\x81&\x91\xfc\x81<)H\xc5\x99*8HqF\x96\xe0\n\x149Sk\xbd\x02\xa2\x80i\x05\x8cg\xf4\xb1e+l\x1c\x1a*\xef\xfe\x1a\xb4\xb6\xb1t\x88>#\x9a\xf8\xe4\x8c\xaa\xad\xf7O\xe3\xd2]\xf0\x1c(\xaa\x14\x13\xbc\x11\x81\x8c{)\xee\xa8L\xf0\xcf\xb5#\x08JE\xe58\x15\xce\x06G)\xcaB\x8d\x13\xd9\xf9\x80eE\x92\xe6\x84\x9d\x0d\xa9\xd8\xffDS\xbd>R\xbd\x1cT \x86\xd0\x12\x871|z\x8e\x82\x80\xe4y\xbd\x8e\x998\x13\xc6\x0d\xce\x91\xea\xee\xf0\xd278j16\xaa\xfa|vt\x82\x0d\xcd\xecq\x99\xc1	\xa6\xb6\xbd>g3\xd3a\x0f\xae\xdc\x0e-\xca}\xceRT]<\xe0\x02\xfbd\xeb\xaf\xd1\x98o\x0d\xc5\x7f9)\xf5\x89r\xcdR\xa2i\xf6u\x9aR\xa5`\xb3\x01-Kj\xa3\xd9b\xa4B*($=\xe4\xecx\xd2#\xc0\xdf|\xfc\xee{\x0b^\x11\xd6P\x0bo\xa7\x9c\xa9>\x89\x0c\xa7\xc2\x8f\xdf\xfe\xe7\x9f\x1f\xff\xfd}\x18,RQr\xbd\xec\xad\xaaa8Q\x92Q\xa9b\x08\xad\x82\xabo\x04\xd7R\xe4\xab\xef\xe8\xcf%Uz\xf5/\x83\x18\xc6\xb0\xddE\x11\xfc\x1dn.\xc5\xfb(\xd9\x91q\x9f\xd1\xb3y\xff\x04\xf4LX\xdeX\x8bK\xb66c\xa8\xbd\x1f\x188\xa3\xb6\xc9\xae2\xd4\x85\xff\x9a\x9d\x0b*\x95\xe0D\xd3\xa4f\x0cC_\x8c\x89\x9eF\x86\x12g\xea\xc6\x16\xe6\x07aaS\x0d\xf5\xa3\xb15=.\xdd\x85\xeef\x03\xbc\xcc\xf3\x8e\x9d\x1ea\xd7\xe6!+a\x033fN\xe0O\xd8;\xa7\xfd\x0b<\xd1\x96owvG\xa8\x1b\\\x18\x83\x13\xc6\xdd\xfe_6\xab\x1c\xc3@\xd6\xd8\xda\xdf]\xf4\x1b\xd6\xba\xe3\x8a\x17\xa95#lF\xd7\xcezd\x05\xd8\xf4\x18\x03;\xb8\x03\xd6\x0cT\xa7\xa9\xc9\x18_*\x10\x12G\x08\xa8r\xef\x0e\x8c/U\x85D3\x0f\x08ND\x01\xe1@\xd2\x94\x16\x9af\x80' m\\\xde$\xadJ\xc9\xa5\xafp3\xbdM.w\xed\xa2\xb7\xc1\xddb\xc4\x10\xf6\xc3'\x8cM\xecG#\x9b\x80\xa4\x9a\xdd\xd3\xfc	\x88R\xe5\x99f E\xee\x19`\x02\xd5\x0c\xf5\xa5vN\xbf\x18B\x87\x91 \x03\n\xde\xee\"\x1b\xbd=\x04?7#\xa3s\xc8\x08\xe3L\x14\xbc\xb9_\xee\x98Pw\x90\xd1{\x96R\x05\x827\xc1an4O\xf0@%\x05R\x14R\xdc\xd3\x0c\x0eB6\x1e\xbb\xc0M\x16\xd8\x9e\xd1\xf6\xde\x81\xeb\x8fV\xb4\x8e,%J\x99\xb6\x0e\xa4\xea\x86\n\xa5\xccU#2\x15\\\x13\xc6U\xe7f\x16Cx\xbd\xaeX\xae\xc3(Xp\xa1\xe1\"b\x92\x9d\x19\x0f#_6&\x08`\n\xccT#\x9b\xe6\xf4L\xb9N\x18Or\xa6\xf4\x12\x83bmhT\x0cMR\x89\xa6\xb4\x1c\x91\x9bQ\xfe\x04\\\xf0\x95\x813`\xee\x8a\x88\xdbM\xe1%\xd9\xce\x18\xaf\xa9\x00\xe9\xb7\x92\x12%\xf8\x0eU\xb3\x7f\xc2\x06\xb6\x7f\xbe\xf9S\x0cae\x01z\xc10\x861\x84f\x93\xac\xceL\x99\x8b{\xb8\xb3Nz\xbdU\x93F\xd5\x87\xec\xa5*g\x943\x9a\x0d\xeb\xdb\xd5\xd5\x0b\xc0\xce.\xb3(\xf6\xd8\xb6\xbb\xb3\xbdD-\x05\xbd\x1d\xf3\xbbQv&\x0ft\\l\xa4\xbf\x89\x8bg\xae'/^\x81:\x03\x19\xab\xcc\xbf:\xba\xfb\xde\xff<v\xbc\xe8\xda\xf1\x19,t\xd7\x807[\x9eK.6\xb3[\xc3\xdd \xdc	T\xdfyF\x97\xe6\xffe\xc4L\xe0\xbf\x85eGY\xa4`_)\n\x1eN,=\x01\x91\xd4&K{:\xc7\xeeY\x8f\x89W\xdaG\x88G\x89\x95	\x12\\\xc1\x03\xcd\xf3\xd5AH\xbcK\x18\xcc\x94\xe4\xf3\xb9\xc3\x93^\xa7h+\x15\x93\xdeA\xc8=\xcb2\xca\xc3\xdd\xc0#\xa7\xb3\x94\x8e/A\xc8\xc4\x19\xe4?v\x16h\x927Y\xdf\xcc|\x9c\xf5\x10\x8a\xef.QPN\n\x86\xbf\x92h&\xf8\x8b\x9d\x86\x8e\x17\xe85\x13\x02x\x8b\xac\xb1\xba\xf7QU\xd0t\xd6\x85=\x8d\xde\xca\x91\x0e8\xa9\x81\xfb\xee\xec\x91L;\xb5\x8f\xd8\x8eDR\x9c~\xce[\xaee\xfa\x04\x07F\xf3\x0bb3\xb8\x1a\x8bN\xbc\x9d\xb3\xac\x8f\x7fAtv4z\xbb\x185\xc0\x895\xad\xe7\xd6\xf6\xf4\\\xa0\xfa\xb4\xc6\x9f\x13f\xfd\xf5/\xf8X\xe0\xd6!i\xce(\xd7\x90R\xa9\xd9\xc1\x14E\xc2fvegW\xfe,\xbe\xf8U\xb2\x17\"\xa7\xa4JNL%\x06-\xb1\xf4\x89G\xefn\x9e\xb3t^\x0c\xf4U\xaa\x16\xd3\xdf3x-wN\x81\x03\xe3G*\x0b\xc9\xb8\x9e\xbc\n\x1a\xcb\xfb\xf0\x03+:\xed\x80K\x97\xb8\xef\x8e\xc4W\xb5\xb7\xe6\xd3\xf4\xd310#\xcb\xdfds\x0e>\x91{\n\x82\xd3*\x159\xb9\xa0\x08\xff\xbd\xbb\x17U\xbc\xc4\xad\x8a\xcc\xa4\xa9a\x9e!7\x92,\x93T)\xda9\x0f\x19\xf7]XesV\x00>\x95&\xddh\xee>\xac\xa8\x80\x87\xa2\xb3X\xeds\x91\xde\xd1\xec%\xd1\xc8\n\xf3L\xeb\xfb\xa7\xde\x9c\x95\xf1\xcc\x95\x93\xccvt\x05\x86\xca<\xc5\x8e\x1c\xeb \x1cr\x81\xe1\x05\xe4(@\x9f\x88\xf7P\xb6\x013m\xe3\xbb\x18B\x87\x8c\x06j!@\xe4Y\xd8\x8c\xae\xb4\x10+\x1c\xda\x05\x8b\xf9\x9d\xe6\xa0\x923yL\xc8\x11s\xd8\x8d\xab\x8bv\xaeO\x19|a\x0b\x06\x9a\x9d\xe9\x9a\x8b\x87\x84\xabe\x04+\xf0\xb7s\x8b\x07=X\xeaS\x82\x0c\x16\xf7+xwS\xfd\x87R\x06/\x0f\x1d\x8d\xc6\x1d\x8a\xbb\x0d\xaf\x02\x8dc\xcd\x89\x87\xf9MiZ\xac\xca\x02\xbc\x9a5\x1e@\xb6Kb\xa4\x9a\xe3\x8e\xc9\x99\x17\xb0q\xf60\x96\xe3\xb7\x9e\xb7$\xabz\x0c\xaf\xa6\x9a\x16IY$\xd5\xd8\xb8C1\xcdW\xd4\x8ah\xa6\x0e\x8cfhv\x17\x02>\xcdo\xf1\x8a6!\xa9\xc4\xb3\xa5\xacjJ\xe8\xef\x9b\xd7\xa3\x9egPk\x03P\xd9j\x10ui,k\x86\xcf\x83\xc3n\xe1\xbd)\x0f\xbf\x05\xf5J\x87l6p3\x89\xdd\x8a\xcf\x01\xcf\x9a\x07\xed@\x87\xc9\xad1n\x80Tb\x80\xd8\xea\xcc\xa0\xdd\xaft\x7f\xcf\x06\xdf\xa7\x136\x9c_`\xc3Y\xba\xb7]\xb2\xf3\xcd\xe8\xad\x13\x8a\x1b\x8f\xcc\x0e\x9b\xcd\x08]\xf5\x071\xdf,\xe3\xfc\xad[,l\xab4\x94jLw\x95@\xc6\x0e\x07*\xf1\xf0g\x196\xc1\xf4\x13`\x1d\x93eTvs\xf8\xc5\x89\xa5\x8fT\xbf\xcf\xf1&\x99\xb5+V\xe3\x8e\xc5\x8283y(\x8c\xaa$=\x96k&<\xd7\x85iy\xcaNZ\x07I\xaaK\xc9MQ\xd7v\xbd;\xfd\xfb\xe0\x92Vx\x82]p\xfc>\xc0\xd06\xb3\xe8\xa8\xde\xd8\xed\x06\xb6\xd8m\xff\x15\xcc\x83\x9fe\x8f\xb1k1\xbcw\xbf0\xdc\x90f\xd9\xe3\xee}u\x7f\xb2M\xf9^%\xd5\x02D\xbb\xed\x8d\x89\xee\x01b\xd4\xb5\x12\x18}r\x89\x1c\x07\x13\xb1\xff	\x95+\x88T\x14\x07\x96\xf5T\x14,\x9a\xfa<\xead\x0b\xd3\x0d\x01\xf2\xd6\xa0]b\xec\x9f\xb2\xc7K\x89\x89>]H*\xe9\x91\x8e\xc2v\x8d\x9fV\xb9\xb3\xd9\x9bmn\xect\xd1X\xb5\xb5\xde\x1a\xd7E\xb3\x955\xbc\x12\xd5\x167$U\x9b\xa8\"]\x9f\x842-\xe76\x82\x19\xee\xfbar5\xc6\xfc`\x99&\xfd\xf0z\xdc\xca\x0f\x9aH\xad\xf0\xf6\xd3v\xea\x1aC\xa3B\\[q\x03\xeb<\x11@\xa3Z\x10}\x9a\xb6\xedU\x98\xce\xaeJq\xa2O(\xa6o[\xdf\x96\xa9\x08\x1f\x13lx&\xady-\xaa\xb3G\xd2\xc4\xa4J'zm`\xe3\x01\xbb\xcc\"5YEi\x89\xb9\xf2\x13\x84*=\xd13\x0do\xc1\xfe\x11C\x88!\x1b\xde\x02\xfeT>\xbc\x05\xfc\x81g\xb4w\x9b\xc45\xad\xa5\x91\xe4\x01\xa7\xb1\x01n\xe4\xaf\x0f\x8c\x9bz^\xa2\xb4d\xfc\x98\xa8ro\xb4L\xf82X,~\\~\xb8]b\x87f\xabv\x1f\xa2\xdb\xeb\xeb\xe8\xc3r\xfb\xc3\xf5\xee\x0f\xd1r\xfb\xc3\x87\xab\xddW\xd1\x8fq\xb0X(-cx\x17a\x12] <l\x80\x0by&9\xfb\xc5nP\x1c\\:\xd9\xc6\xbc\x81iggx\x1d\xa2\xeaJ\xcb:\x81\x8c\x13#\x95#\xfe\xc2\x11\x07\xddr\xb6\xab\xf7\xda\x7f\x99\x053g\x8a*r\xa6\xab\xc9\xf0\x1f\xd8\xec\xb3\x17\xe1G\xd3\xe0\xfcc\xb0x\xdc\xbe3-FWb~\x0e\x82nQ\x1f\x1f\x86\xb1i}!.\x98G\xaa\xb9\x16\x9a1\xe4\xe8\x7f\xc9S\xed\xad\x0d\xdc\x1b\x1e\xc0\x9ey}^\x1a\x1a|\xeb\xa9\xa2.\xa8\xda\xb1_A\x15\xa8\xb7\x8b\x1ed\xaaO\xbad\xb73H\xf7H\xf0	\x1e\xe1W\xc0/\xda\x88\x94\xe4i\x9d\n\x9e\x12\xbd4\x04\xf8\x9f\x03h\xa1\xc7\xf5\xec\xb6\x9c\x91\xf4\x1ej\xd1O	)\x8a\x9cQ\xb5TE\xf4\x1eJ\x94\xde\xd5\xbb\xd6\xcd\xf4\xb5\x9f\xbb>qE\xf6\x01\xaf\xfc\x16[\x1c\xdag\xb1\xc6a\xcf\xd8\xe3\xbe\xf1z\x1bs,\xd8g\xb1\xa6\xeeXM\x19\xd3|l\xd11ha\xaci\x87\xd7b\xb1\x1dJ\x84},\xdb\xc5\xdfa\xde\xd8\xa6\xbf9\xd8\xd2N\xb05\xf8\xbb`aRL3R\xed\xae\xa5\xfb\x8d\x9agW=R\x97\xbdj\xb6\xc46\xe6j\x92\x16fw\xb2\xfeD\xc9\xd8X\xdf\xbc\xb6\xe6\x9f\xbb.\xb2\xc9\xb0T-\xdbT\xb1e\xee\x08\xaah\xeds\xbe\xa2Ay\xad'\\\xcd\xa0\x9cL\xf7^\x9b.\xa6\xd6\x9e\xf1\x0b\xac\x06\xdc\x1cK\xeb>\xf7\xda\xa3D\xb9>\xe3\xb8H\xbfp\x88\xc5\xc6i\x11H\xe1^\xa6\xeeO\x84\xf5\xfaJ\xb8\xf6.\xad\x85\xd7\xf8<\xb5\xda\"\xc5ZQ\x89\x1f\xa1\xb8\x83\xd5\x8e\xd9\x9e\xd6.j\x81\xd4\xb6\x17Dk*\x9dR\xc7\\\xec\xd7\xee\x9cv\xe3\xdbd\x17\xc36\xbc\x0ew\xb1\xdf\x053\xeb4\xde\xc6y	\xaaU\xdfa\xad\x1b,f\x85\\\xb9/\xaf\xb4(V9\xbd\xa7\xb9m\xecT\x85\xe6^w\x06\\\xcc\xf8\xa5\xd4J\x9d\x18?OQ\x10\xea\xa7\x02\xd7\x92\xe6Y\x18\x8c4MZ\x16T\xde4-\x93\x81^K\xb5!j&\xf4\xce4\x05\xc2ZC\xeaoz\x1d\x83U\x0c\x1d?$\xa9\xda\x11\x95\xa8\xb8\x8e\x85\xb5\x1f\x0bFS\xcf\x99hql=\xb7\x8b\x06\xd4\xeb\xc3\x1a\xda\x99\xf5[cTXB\x84\xbcj\xbe\x80cX\xdb\xc6\x9a\xa4\x8b\xb3V\xbf8\x1ej\x87\nYY\xea:w\xc1\x15\x08\x8e_\x9a\x15E\xfe\x84\xdf\xba\xeb\x93P\xb4\x85Q\xb5R	\xcf*\xa6\xe1\xf3\x19\xcd\xf0fP\x19\xff\xf8nM:\xddF\xe7\x9d\x96\xady\xac\x0d\x8d\xc3\x0f\x16\xbaT\xe1\x1d\x10\xde\xce\xaa\x8b\x96\xae@4\x83\xebq\xd6\xb1\xdb:\xea\x1a\xe0\xae\x9a\x03\x86\xcekZ1\xd5\xa15\xa1\xef\x88\x80\x1e\xc4\x90\xe2=\xa2\x9e\xfa\x03\xebp\x89\xa3\xbd\xcd4\xe9\xeaA\xf0\x91d\xd1r\xb8O\x11\x05\xcf\xc1\xff\x06\x00PK\x07\x08M?e\x00T\x0b\x00\x0002\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00f\x0eQ]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00authz_test.regoUT\x05\x00\x01\x90\xd4\xd2j\xec\\ko\xdb8\xd6\xfel\xff\nB\x9f\x9a\xc2\x978\x99\xf7\x056@\xb1\x1d\xcc.\x8a\x02\xbb\xdb\xc1\\>\x05\x86 K\x8c\xcd\xad$\xaa$\x95\xc4\x0d\xf2\xdf\x17\x87\xa4$R7\xcb\xaa-;\x93\xa4@\x13K\xe4\xe1\xe1\xf3\x9c\x0by(9\xf1\xfc\xaf\xde\x1a\xa3\x84F\x98\x914\x9ay\xa9\xd8|\x1f\x8f\x05\xe6\xc2\xc5\x91GB\xd7\x0bC\xfa\x80\x03\xf44\x1e\xc9?\xd1\x03\x11\x9b\xf1h\x14x\xc2\x9b1\x9a\n\xec&4$>\xc1\x1cy\x1c\xdd>\x8dG\xa3\x91\xc3i\xca|\xec\xdc \x07?zQ\x12\xe2\x99O#g\"\xefi\x89n\xca1\xe3\xce\x0d\xbau\x1e?\x9a\xad\x96\xe3\xd1\xe8y\x99\x8dC\xe2$\x153\x18m\xc5\xe8W\xcc\\\xf8\x13F\xd2\x03a\xce	\x8d\x9d\x1b\xf5y\xe4\x80T\x97\x0404\xfc\xb9p\xa0\xd9\xb3\x1a\x19.\x14-\xe5\xfc\xa0\x9d=<\xb4|\x06\x15l\x0d6B$rX\xe4\xa4Lv\x83+7\xf3\xb9\xd9\x17\x95:i\xedt?\xa5\x95\xbe\xb6p&\xc8!Q\x82\x19\xa7\xb1'\xb0\x9b\xab\xe3\xa0\xe7\xf1\xb3\xe6@\x8aq\x0d\x98\xb7\x00\x9bd#\xa6\x02\x9d\x90\x11K'\x12<\xc2\x1c/\x9b\x9b\x18\x8c\xed\xa5\xc8\xd6\xe2fY\xe5\xe5\xd5ZF\xd9t\xdc\x98\n\xd3[Ol\x1f\xaf\x89\x17\xdbH[I2\x08:\x9a\xf3n\xdf\xc2\xa9\x11N\x1b\xc9Y3\x9a&G$D\xcaW\xee\xb28uR\x9b\x18\x1d\xaaz\x0dLM\xae@\x9c\x86a\x83\xb7\xa86\x03\xc4\xb4*\x1a'^zH\xaa\x9c\x800\xec\x0b\xca\xb6\xae\xb9hA\x08\xa1*\x7f'\xf1/C\x8b+g\xd9\xce\xa2\xc1\xe0\xf1\xd8\xbb:\x8b\x85\xe3Kg/\xa0\x91G\xe2#2\xa6\x06P\x94\x99\xad\xce\x81\xbc\x13\xb8Q\xaeN\xd3\xb2A\x13r\xfc@\xf8FL=1\xf9\xfaa\xa1e\xb6\xd14\xa8\xdf,\xde\xd6w\xe6\xfa\xae\xcaO\x90\xb8~\xe8\x91\xe8\x88\xb4\xe4c\x003O\xc8	p\xe21\x11\xe1X\xa8\x08\x17\xafI\x8c1#\xf1\xdaYN\x90\x13\xe2{\x0ch\xdc^C\xd0=\xbdc)G,&\x00\x1fG\xe5Ip/\xc4\x1c\xfc\xc3\x9e\x8djk\xcc\xe8\xe4K\xfdz\xf2\xe34Za6\xa0\x0d\x0cCr\x99\xb4b\xd4\xd9\xe5\xd9Rq\xf4,\xb6\x8f?\x0eHN\xadGe.$\xb0\x17U\xb5;O\n9\xf8\x91\xcb\xf0\xb7\x94\xb0\xb3 \xd1\n\xaa\x13\xf4\xd3	Ym\x0d\x8fWgK(OWY\x1dw\xb8\x18\xd9\n]\x95Re\x1f\xb9\xa6\x04\x83\x98[\xb88zB]\xedF{\x1dz\x96&2:\xde\xc2i\x87\x9d\xd8\xde\x7f\xb6\x16b\x98P\xee\x90\x03\xecC\xf6q\xfe3\xf1\xf5\x93\xf3\xd6X\xe3\x0cpL\xb2\xc2=\xcc$\xc0\xf1\xf6\xf6\xf6\xa7\xcb\xeb\x89\x9a2\"\x1c\xa96\x105dmb\x1a\x11\x1ey\xc2\xdf8\xcb\xe5\xe1Bz\xe3\xeeR\x17L\x0c=\xdf\x8e\x04[\x8f\x04M\xa8\xa4;J\xb2T.\xf6i\x1a\x8bw@\xf2\x05\xfa\xf0\x01]\x9e\x8e?\xdb\"_\xfb\x1e\xb5)\xae\xbeX\xf7|\xa3\xd7\xa4\xd7F\xc3\xe0Z\xf3+\xe3\xeai	6\n\xbe\x8bR\xd0\xb5k\xc1\xa7\xf5\xd4\xc6s\xaa	\xca\n\xfc\x03\x93\xdc\xe5\xb0\xea\x8d\xe6C\xd1|R\x86+g!\n8\x1d\x1bO\xea\xbe\xe6\x93\x17>\x8d\x05\xf3\xe0ppfB`;\xb5\x19\xd0\x9b:\x0co\x03\x0d\x9a\x9c\xd5\xfaJ+\xb6Wi\x03&\xa0-\xbe`\xb3FY]\xf0I<\xb1\x81\x16s/\xbb\x92\xed`M\xdaL\x84\x96\x85\x87\xf5\x19gU\x1e\xa7\xb0\xa7\x98\xd2\x18\x7f\xcc\x1f\x00\xb4\x07[\xeeO\xc8|U\xa1\x04\x06\xb3\xf8\x90.\x98\xaf\xd5\xfeKqS\xfe\x94mtx=R\xad\xa9@bEW\x1fw\xf9G/\x93\xec?\xff$]\x85\xc4?BY\xe6g\x00\xf1W)\xfd\xcf\x18\x1e\xfa\xc4\xb1 \xbe'p\xf0\xb3\xefc\x0e\x06(X\x8a\xfb#0~\xb6f\xd0\x83\xc2Z\x9f\xaa\xccd\xe4$\x0c\xdf\x91GPd\xbe\xdaN\x01\xebfc\xaf\xa3\xb8\xc9\xadj\x86\xea\x8e\x9a\x0cgM\xb6\x03\xf7\x9b\xc1\xcbg\x01\xe0\xe7+\xc9\xccA\x8fX\xa2;\xbc'\xccg\x99\xdas\xd3$\xf4\xb5>F\xd1'c\xee\xe5\xd7;\xb8)&\xe4\x05\x11\x89\xf5\x98\x1b\xcaE\xd9d\x1a\xd8\x83^\xed\x1c\xca&\xca\x05\xaa\xaa\xef\xc2g\xc8=vY\xb9]Y|?h\xe7^\xec\x85[A|\xde\x15d\x9f2\xeeB4\x08\xc9zc\x9d3\x0d\x972\x94\xaa\xbf|\xf9\xedw\x15+2m:\xc4S\xd93\xc2bC%\x0b_~\xfd\xe3\xf3\x97\xff\xfc\xeeLv\xc0\xa6\x1bl\xb0\x17(\xad4M_\x18Y\x13(\xa2\xdc:\x9cF\x98\xaa\x8f\xd9\x91\x93\x8a\xf2\xd3_`=F\xc3\xe9o\xf8[\x8a\xb9\x98\xfe;\x1b\xfe\xd6\xf9\xf4\xcf?\xf4\xaaC[r\x1d\xc6g\xeb\xc1g\x8c\xa3\x0e\x82\x1e\xe3\xd8MY\x08\xe3\xc0\xaf\x9b\x0f(\xbf\xf6\xae\x8eh`q\x0e+\xc7\xbf\x7f\xe3\xce\x85\xec4\xe3\xfe\x06G\x18J}\xb2\x87\xa3\xaeB8\x92\xd7\x8c\xee\xfa\x16\xf4\x97\xb7\nqN\xaeSf\xdf\xca9\x14Ey\x8c\xca\xae\xd7\xe9\xe6L\xd0S\x03\xa5\xcf\x17\xfb\xf7\xafi\xd0W\x0c\xef#g~(A\xbb\xe5\xcc\x0f\xa6\x91\x92\x94'\xd2F\xb5([\x97\xd42\x84\x80\x8czk\x80\xb8J\x1e\xbb[\x83\xb1*\xeb8E\x99\xf7\xa4YJ\xab,	\x91w;N\xb1F\x87\xbc{\xc3\xec\xc0-\xba\xcf-\xdbV\xedC\x9e\xdd\xa9\xd3$j!\xc9\xc4\xf4\x02\xa4\xd2\xb9\x1e\x0e\x86\xd7x\x0f\xaees\x90;{\xdf\x9f\xeb\\\x88\xbe9{\xdf\x1d([\xc0\xed\xe3\xf6\xfb\xd2\xe4\xba8\xe0\x869=B\xa8]\xe3|\x81\xa0\xd2\xf9\xbb\xa71\xd2?\x0d\x91lR4\xb0z\xca\x14\x9b\xca-mz\x05	6oV9\xae\xd67\xe4\xcfS\x8b\x98k(CM:4\xbf\x92\xa3\xfe\x04\xcd\xf3\xd6K\xf9\x17`\xf7\x08\x91\xfe\xa9\xd0\x0d\xda^\xeb\x1e\xcf\xe3\xf1x\xb4-C\xa1\xcb\x0f\xbd\xc00K\x17\x81\x9cG\xd0\x0f\x8e\x1aA\xed\x80X\x1d$$A\x13$[\x05I\xae\x1f\xfc\x7f\xad{HH\xbe\x97!Qe\xd3^\x88\x18\x85\xc5\xb5\x1cp\xdd\x0f\x90\xaa\x9cv<\xcc\xf6\x12\x8eu\x13\x1c\xdf\x15\x1c\xb9v\xf0\xff\xb5\xee!\xe1\xf0\xcbp\x14\xa7\xf3\xbd )\x1f\xee\xfb\x0b\xa9\xe6\xfd\xc2\x9ePg\xd7\xa9\xc8\xbbR\xf2\xe4+	\xdd|h\xd1\x80\x8d\x0f\xd8\xdcVt\xac\x8c\xb2\xcc\x83\xe8\x9a%\xbe\xabV\x9eYp\xc9\x83\xe81v\x1f\xa53r{\x93b(\xa3Z\x93\xf8\x1e\xc7\xf0F\xc9\xecs\xf6\xd7\xfc\x13\x16\xef_\xf0\xe1\xec\xbcaN\x9f\x05\xaeV\xe1\x00\x10-\x90cvO\x14\xce5\x12\xc0\xfe\x8b\xfdC\x938]\x10\xeft\xc2\x90\x97:\xcd\xb3A\xd3Z\x8a}\x94yr\x04-\x90\xd2\x04\x0e\x90\x8cT\x08\x03\xdcQ\xb6\"A\x80\xe3\x83>\xa71\x88u\xe5{\xde\xb6:r\x9d\xc4\x7f\xe0\x10\x0b|Hz-\x89%O\xf6\xc2;\xca\"X\xa1\xa9m\xdd\x1bM\x1di\xd2>3\x9f\xcd\xe6m\xf8J\xf3\xe9\xba:k\xb5\xdf\x1a\xf3M\xf5\xf9g5\x95\xd8\xcf\x0c\x16\x06/Wo\xa8\x87\x89Oj\xedl\xfe/\xc2\xc1\xfe\x91\xae/\xd7&\x1f\x99\xc8\xc7\xf2Y\xc4\xe7\x0b\xe9$\xe8\x87\xa2\x15\x8c	`s\x884\x0d\x0b\xc0\xe7\xba\x95\xdf\x1b\xcc\xfb\xc0l[u\xb6\xaa\xd48_;\x85\xa1\xd3\x04\xc7^B\xe07\xf3\x04\xa1V\xed\xf7xOkU\x86U\xd0\x86\xd2\"\x95\xb9R\xe2c>\xfb1\xc7\x972\xe6\x8bJ\xaa\xd5\xc3k\x11\xc5\xdc\xd5il\xd6q\xb6\xc6\x02\x94\xe1>M\x94\xcd\x98\xaf\x91V\xa6\xd0\x10}\xb3\xb1\xf2v\x83\xa5\xca!A\xde\x13b\x9faO\xe0\xcfJ\x81}0Nc\xe3i\xc2\x17\x00so\xebM\xe3\xaf1}\x88\xcd\x05Y\x15\x8d\x1d\x85\x83|k\xd8-h\x1a\xdb2Y\xe2\xe8\x96\x9f\x8c^w$\xf6b\x1f[Y\xaa\x01\x1d\xdb\x00jr\x90!\x96\xa7IB\x99\xe8!\xd6\xe8\xd0i\xbb\xf1\xbe)\xdb\xb5\x07\x8c\x16k.\x82\xc9\x03#B\xce4O{\xfa\x84\x0e\xe5\xb8\xd5&\xbe\x97D\xe2\xfe\xd0A\xc4\xd7\x02x5\x0ed\x89K\x97\x03\xf3\xb8\xb0f^\xb2\xf9\x16\xbaw\x04\x87\x01\x1f&e\xd9c\xca\xf9\x7fK1\xdb\xced,\x8dR!\x81\x99\xa5I\xe0	\xfc\x03\x8e\xaf\xc7\xa9\x04T}\xbd\x92\xb3\xc46\x91k\x82L\x03\xd0\xc6\xd0Q\xe9\xf3\xa7|\xea\x00\xe9O\x9f\x80m\xeb\xad\xfa\x12\xa0\xf5\x99,\xd3`\xf8\x10\xfb\x97\xc0>\x90k2\xf9\xa9\x05z\x12\xdf{!	\x8a\xc0V~\xac/\xd3\xe5\xacX8\x00\xe2M\xce}\xd8\x1cg\x94b\x97\xd6\x8d\"\x02v\xcazfI\xd7\x1c\xccN9\xcdx\xb5&<\x9d}\xeb\xe3iO[\x8cp\x8d\x0d\xda\x99\xa86\xfd\xe8\x89v\xdey\xfd\xf5\x01\x96Q\xbf\x14e#\xec\x98\xf9\xca\xb4\x88\xc2\xb0\xfd\x90\xe0X\xb8>f\x82\xdc\xc9\xc7\xb2\xdc;\x12\xaf1K\x18\x89\xc50Y\xac]\x07m~\x9e\xe7\xc1\xfcV\xab\xd5\xaa\xb7gW\xf2Wud\x1d\x8e\x0d\x0c@w9*\xac\x04<mEFhh\xd7\xbe\x9a\xb6\xfe\xf6\x7f\x13\xe4\xa8N\xc8\x80\xbdfk\xa0\xc3\xeeT5\x9e\x1a\x8d\x0fZ\x16k\x9f\x80\x01\xff\xb9\xc3\x1e\x11\xceI\xbc~\x99\x90g\xa6\xe5\x04\x18**\xd3\x85\xfd\x9cz\x7f\xe8\xdb\x0c\x95{\x03\xd5V\x86\x9c\xb0\x8dRu\xe4n.\xee,.g\xf0O\x96\xa7\xea9\xd9\x8d\xed\x9b%\x9a\x96x b4\x19W\x8dd\xe8S&7\xf2\x1e]o\x8d]A\xa9KCk\xeb\xb0\x98\xe4\x07O\x10x\x05\xa5\x88\x86\x81S\\\x9d\nJ\xa7p\xe9\x90`\x97\x14sn\xd0\xf5e\xf1s(`w\x1d\xb2\xc1\x93\xeb\xae \x11\x8c\xff\x0e~\xcfb\xfa\xe0\xc6\xfc\xdd\x05\x9a\xa3E\xae\xce\x05\x9a\xa2\xff\xbf\xbcl\xc15\x8b\xb7\xb9\xc0W\x8e\xb0B\xd8\x04L\xe0\xc4\x85\xaf\xcb\xf4\x99\xf5\xed\x1f\x96\x15\n\x9cL\xd3\x04\x19\xef\x13\x80Qf\xcd\xa5I\xaa&\xd3\xfc\xda!l2\x13&\x95\xbb\xf7\xc2\x14\xeb\xbd9\x8bot\xeb\x9b\xe8\xee\x80I_\xd3^\x0f\x1a\xecP|x'\xd9\x1a?y\x08j\xe1\x8c\xce\x19\xce\xc8\x82\x13 \x84J\xb0H\x06D2\x02$o\x1d\x80oYc\x8eM\xf1\xf0\xe4\x96X\x85N?\x9f\x91i~\xe2\xc0i\xe0\n%\x1e\xa9\xdf\x8f\xc4S=+\xee	\xc2\xef\xc8\xb1\xce\xb7:{zwK>+V\xaaqC;]\x89/\xe9\x83\xfb\xf2e\xb8\x0f<6\x95\xbd\x7f\\\xcet$\x80w\xc1\xc4\x16%\x8c\xde\x93\x003\x94\xbf\xa9\x0c\xbb\x8a\xe0\xc0o\xb8\x82*\xfa\xd0*\x7f9\x94;C\xc5\x97b\xf4\x00\xdfyi(\xcc \x0d7\xe5\xc4\x8fc\xcc\xe72s\x13wc\xf6\x1a\xa6,P\xb9w\x0c\xf3#!\xf1\x82\x17\x95\x06`_	\xe5_]\xb5\xd30\xb7\xa2G{-\xa6\xf4\xe8O\xcf\xefXA\xe6#|r\x0es5\x078\xd7\xb7\xdf\xe7FN[+\xf5Y\x89\x943\xac\xf9:\x11t\xcc\xafa)\xd4\xae\xe7\x84\x8a\x0df\xfa\xed\x96b;\x9boV\x0fg\xc7\x8d\x0f\xd1\xc1\xe4'\xa8\xcc\xb5\xd4k\xf6\xca\x19\x97\x8cW\x91\xe8\xc9\xbb\xc7y*\x9f\xdc\xa3\xe11}\x11\xc4\xeb\xaa\xa2,\xf1\x1f\x84\x11\xc0\xbe\x99	u\xd7|\x7f\xde\x0e\x03Y?\x13\x81\x8a\x8a\x1d\xe9\xb0\xe5\xee&b\xaf\xe7_-\x8ab\x9a_8\xd2{\xfe/\x8b\xaa4 \x82\xb23%\xab\xf8\xf2\x14\x12\xaf_9]\x8a\xaeB\xc5S\x91U\xf3\x15T\xff\x1b\x00PK\x07\x08\"|X\xc9@\x0b\x00\x00\xddk\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00N\x0eQ]M?e\x00T\x0b\x00\x0002\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00authz.regoUT\x05\x00\x01e\xd4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00f\x0eQ]\"|X\xc9@\x0b\x00\x00\xddk\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x95\x0b\x00\x00authz_test.regoUT\x05\x00\x01\x90\xd4\xd2jPK\x05\x06\x00\x00\x00\x00\x02\x00\x02\x00\x87\x00\x00\x00\x1b\x17\x00\x00\x00\x00"
 	fs.RegisterWithNamespace("rego", data)
 }
@@ -0,0 +1,114 @@
+package evaluator
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// routeURLRE splits a URL into its scheme, host and path the same way the
+// parse_url function in authz.rego does, so routes match the same requests.
+var routeURLRE = regexp.MustCompile(`(?:(http[s]?)://)?([^/]+)([^?#]*)`)
+
+// A routeIndex finds the first route policy matching a request. Policies are
+// indexed by host, so only the routes for the request's host are checked
+// instead of every route.
+type routeIndex struct {
+	byHost  map[string][]indexedRoute
+	anyHost []indexedRoute
+}
+
+type indexedRoute struct {
+	idx    int
+	prefix string
+	path   string
+	regex  *regexp.Regexp
+
+	// invalid is set when the policy's regex doesn't compile, in which case
+	// the policy never matches
+	invalid bool
+}
+
+func newRouteIndex(policies []config.Policy) *routeIndex {
+	ri := &routeIndex{
+		byHost: make(map[string][]indexedRoute),
+	}
+	for i := range policies {
+		p := &policies[i]
+		r := indexedRoute{
+			idx:    i,
+			prefix: p.Prefix,
+			path:   p.Path,
+		}
+		if p.Regex != "" {
+			var err error
+			r.regex, err = regexp.Compile(p.Regex)
+			r.invalid = err != nil
+		}
+
+		// policies without a source match every host
+		if p.Source == nil || p.Source.String() == "" {
+			ri.anyHost = append(ri.anyHost, r)
+			continue
+		}
+		host, _, ok := parseRouteURL(p.Source.String())
+		if !ok {
+			continue
+		}
+		ri.byHost[host] = append(ri.byHost[host], r)
+	}
+	return ri
+}
+
+// Lookup returns the index of the first policy matching the URL, or -1 if
+// there isn't one.
+func (ri *routeIndex) Lookup(rawURL string) int {
+	if ri == nil {
+		return -1
+	}
+	host, path, ok := parseRouteURL(rawURL)
+	if !ok {
+		return -1
+	}
+	idx := firstMatchingRoute(ri.byHost[host], path)
+	if anyIdx := firstMatchingRoute(ri.anyHost, path); anyIdx >= 0 && (idx < 0 || anyIdx < idx) {
+		idx = anyIdx
+	}
+	return idx
+}
+
+func firstMatchingRoute(routes []indexedRoute, path string) int {
+	for _, r := range routes {
+		if r.matches(path) {
+			return r.idx
+		}
+	}
+	return -1
+}
+
+func (r *indexedRoute) matches(path string) bool {
+	switch {
+	case r.invalid:
+		return false
+	case r.prefix != "" && !strings.HasPrefix(path, r.prefix):
+		return false
+	case r.path != "" && r.path != path:
+		return false
+	case r.regex != nil && !r.regex.MatchString(path):
+		return false
+	}
+	return true
+}
+
+func parseRouteURL(rawURL string) (host, path string, ok bool) {
+	m := routeURLRE.FindStringSubmatch(rawURL)
+	if m == nil {
+		return "", "", false
+	}
+	host, path = m[2], m[3]
+	if path == "" {
+		path = "/"
+	}
+	return host, path, true
+}
@@ -0,0 +1,60 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestRouteIndex_Lookup(t *testing.T) {
+	policies := []config.Policy{
+		{From: "https://a.example.com", To: "https://to.example.com", Prefix: "/admin"},
+		{From: "https://a.example.com", To: "https://to.example.com", Path: "/exact"},
+		{From: "https://a.example.com", To: "https://to.example.com", Regex: `^/items/\d+$`},
+		{From: "https://a.example.com", To: "https://to.example.com", Regex: `(`},
+		{From: "https://a.example.com", To: "https://to.example.com"},
+		{From: "https://b.example.com", To: "https://to.example.com"},
+		{From: "https://b.example.com", To: "https://to.example.com", Prefix: "/never"},
+	}
+	for i := range policies {
+		require.NoError(t, policies[i].Validate())
+	}
+	ri := newRouteIndex(policies)
+
+	tests := []struct {
+		url  string
+		want int
+	}{
+		{"https://a.example.com/admin/users", 0},
+		{"https://a.example.com/exact", 1},
+		{"https://a.example.com/exact/more", 4},
+		{"https://a.example.com/items/123", 2},
+		{"https://a.example.com/items/abc", 4},
+		{"https://a.example.com", 4},
+		{"https://a.example.com/admin?x=1", 0},
+		{"https://b.example.com/never", 5},
+		{"https://c.example.com", -1},
+		{"", -1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ri.Lookup(tt.url), tt.url)
+	}
+
+	t.Run("any host", func(t *testing.T) {
+		ri := newRouteIndex([]config.Policy{
+			policies[5],
+			{Prefix: "/public"},
+		})
+		assert.Equal(t, 0, ri.Lookup("https://b.example.com/public"))
+		assert.Equal(t, 1, ri.Lookup("https://c.example.com/public"))
+		assert.Equal(t, -1, ri.Lookup("https://c.example.com/private"))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var ri *routeIndex
+		assert.Equal(t, -1, ri.Lookup("https://a.example.com"))
+	})
+}
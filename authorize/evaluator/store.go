@@ -7,7 +7,6 @@ import (
 	"github.com/open-policy-agent/opa/storage"
 	"github.com/open-policy-agent/opa/storage/inmem"
 
-	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
@@ -35,9 +34,14 @@ func (s *Store) UpdateAdmins(admins []string) {
 	s.write("/admins", admins)
 }
 
-// UpdateRoutePolicies updates the route policies in the store.
-func (s *Store) UpdateRoutePolicies(routePolicies []config.Policy) {
-	s.write("/route_policies", routePolicies)
+// UpdatePolicyData updates an external policy data document in the store.
+func (s *Store) UpdatePolicyData(name string, value interface{}) {
+	s.write(fmt.Sprintf("/policy_data/%s", name), value)
+}
+
+// ClearPolicyData removes all the external policy data documents from the store.
+func (s *Store) ClearPolicyData() {
+	s.delete("/policy_data")
 }
 
 // UpdateRecord updates a record in the store.
@@ -60,6 +64,12 @@ func (s *Store) UpdateRecord(record *databroker.Record) {
 	s.write(rawPath, msg)
 }
 
+// DeleteRecord removes a record from the store.
+func (s *Store) DeleteRecord(typeURL, id string) {
+	rawPath := fmt.Sprintf("/databroker_data/%s/%s", typeURL, id)
+	s.delete(rawPath)
+}
+
 func (s *Store) delete(rawPath string) {
 	p, ok := storage.ParsePath(rawPath)
 	if !ok {
@@ -30,6 +30,16 @@ func TestStore(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, []interface{}{"admin3"}, v)
 	})
+	t.Run("policy data", func(t *testing.T) {
+		s.UpdatePolicyData("teams", map[string]interface{}{"eng": []interface{}{"u1"}})
+		v, err := storage.ReadOne(ctx, s.opaStore, storage.MustParsePath("/policy_data/teams/eng"))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"u1"}, v)
+
+		s.ClearPolicyData()
+		_, err = storage.ReadOne(ctx, s.opaStore, storage.MustParsePath("/policy_data"))
+		assert.True(t, storage.IsNotFound(err))
+	})
 	t.Run("records", func(t *testing.T) {
 		u := &user.User{
 			Version: "v1",
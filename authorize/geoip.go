@@ -0,0 +1,98 @@
+package authorize
+
+import (
+	"net"
+	"sync"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+// A geoIPLookup adds the country and autonomous system of the client IP to
+// authorization requests using MaxMind GeoLite2 or GeoIP2 databases.
+type geoIPLookup struct {
+	mu          sync.RWMutex
+	countryFile string
+	asnFile     string
+	country     *maxminddb.Reader
+	asn         *maxminddb.Reader
+}
+
+func newGeoIPLookup() *geoIPLookup {
+	return new(geoIPLookup)
+}
+
+type geoIPCountryRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+}
+
+type geoIPASNRecord struct {
+	Number       uint   `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
+// Update sets the database files to use. Files which are unchanged are not
+// reopened.
+func (g *geoIPLookup) Update(countryFile, asnFile string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if countryFile != g.countryFile {
+		g.countryFile = countryFile
+		g.country = openGeoIPDatabase(g.country, countryFile)
+	}
+	if asnFile != g.asnFile {
+		g.asnFile = asnFile
+		g.asn = openGeoIPDatabase(g.asn, asnFile)
+	}
+}
+
+func openGeoIPDatabase(previous *maxminddb.Reader, file string) *maxminddb.Reader {
+	if previous != nil {
+		_ = previous.Close()
+	}
+	if file == "" {
+		return nil
+	}
+	r, err := maxminddb.Open(file)
+	if err != nil {
+		log.Error().Err(err).Str("file", file).Msg("authorize: failed to open geoip database")
+		return nil
+	}
+	log.Info().Str("file", file).Str("type", r.Metadata.DatabaseType).Msg("authorize: loaded geoip database")
+	return r
+}
+
+// Enrich sets the country and autonomous system fields of the request based
+// on its client IP. Fields which can't be determined are left empty.
+func (g *geoIPLookup) Enrich(req *evaluator.RequestHTTP) {
+	ip := net.ParseIP(req.ClientIP)
+	if ip == nil {
+		return
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if g.country != nil {
+		var record geoIPCountryRecord
+		if err := g.country.Lookup(ip, &record); err != nil {
+			log.Debug().Err(err).Str("ip", req.ClientIP).Msg("authorize: geoip country lookup failed")
+		} else {
+			req.Country = record.Country.ISOCode
+		}
+	}
+	if g.asn != nil {
+		var record geoIPASNRecord
+		if err := g.asn.Lookup(ip, &record); err != nil {
+			log.Debug().Err(err).Str("ip", req.ClientIP).Msg("authorize: geoip asn lookup failed")
+		} else {
+			req.ASN = record.Number
+			req.ASOrganization = record.Organization
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package authorize
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestGeoIPLookup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pomerium-geoip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	countryFile := filepath.Join(dir, "country.mmdb")
+	writeTestGeoIPDatabase(t, countryFile, map[string]interface{}{
+		"country": map[string]interface{}{"iso_code": "KP"},
+	})
+	asnFile := filepath.Join(dir, "asn.mmdb")
+	writeTestGeoIPDatabase(t, asnFile, map[string]interface{}{
+		"autonomous_system_number":       uint32(64496),
+		"autonomous_system_organization": "Example Networks",
+	})
+
+	g := newGeoIPLookup()
+	defer g.Update("", "")
+
+	req := evaluator.RequestHTTP{ClientIP: "192.0.2.1"}
+	g.Enrich(&req)
+	assert.Equal(t, evaluator.RequestHTTP{ClientIP: "192.0.2.1"}, req, "should not change the request without databases")
+
+	g.Update(countryFile, asnFile)
+	g.Enrich(&req)
+	assert.Equal(t, evaluator.RequestHTTP{
+		ClientIP:       "192.0.2.1",
+		Country:        "KP",
+		ASN:            64496,
+		ASOrganization: "Example Networks",
+	}, req)
+
+	req = evaluator.RequestHTTP{ClientIP: "not-an-ip"}
+	g.Enrich(&req)
+	assert.Equal(t, evaluator.RequestHTTP{ClientIP: "not-an-ip"}, req)
+
+	t.Run("policy", func(t *testing.T) {
+		policy := config.Policy{
+			From:                             "https://from.example.com",
+			To:                               "https://to.example.com",
+			AllowPublicUnauthenticatedAccess: true,
+		}
+		require.NoError(t, policy.Validate())
+		e, err := evaluator.New(&config.Options{
+			AuthenticateURL: mustParseURL("https://authn.example.com"),
+			Policies:        []config.Policy{policy},
+		}, evaluator.NewStore())
+		require.NoError(t, err)
+
+		evaluate := func(clientIP string) int {
+			req := &evaluator.Request{
+				DataBrokerData: make(evaluator.DataBrokerData),
+				HTTP:           evaluator.RequestHTTP{Method: "GET", URL: "https://from.example.com/", ClientIP: clientIP},
+				CustomPolicies: []string{`
+				embargoed_countries := {"CU", "IR", "KP", "SY"}
+				embargoed { embargoed_countries[input.http.country] }
+				allow { not embargoed }
+			`},
+			}
+			g.Enrich(&req.HTTP)
+			res, err := e.Evaluate(context.Background(), req)
+			require.NoError(t, err)
+			return res.Status
+		}
+		assert.Equal(t, http.StatusUnauthorized, evaluate("192.0.2.1"))
+		assert.Equal(t, http.StatusOK, evaluate(""))
+	})
+}
+
+func Test_getCheckRequestClientIP(t *testing.T) {
+	newCheckRequest := func(headers map[string]string, address string) *envoy_service_auth_v3.CheckRequest {
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Source: &envoy_service_auth_v3.AttributeContext_Peer{
+					Address: &envoy_config_core_v3.Address{
+						Address: &envoy_config_core_v3.Address_SocketAddress{
+							SocketAddress: &envoy_config_core_v3.SocketAddress{Address: address},
+						},
+					},
+				},
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{Headers: headers},
+				},
+			},
+		}
+	}
+
+	assert.Equal(t, "", getCheckRequestClientIP(&envoy_service_auth_v3.CheckRequest{}))
+	assert.Equal(t, "10.0.0.1", getCheckRequestClientIP(newCheckRequest(nil, "10.0.0.1")))
+	assert.Equal(t, "192.0.2.1", getCheckRequestClientIP(newCheckRequest(map[string]string{
+		"x-envoy-external-address": "192.0.2.1",
+	}, "10.0.0.1")))
+}
+
+// writeTestGeoIPDatabase writes an IPv4 MaxMind DB file which returns record
+// for every address.
+func writeTestGeoIPDatabase(t *testing.T, file string, record map[string]interface{}) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	// a single node whose left and right records both point to the start of
+	// the data section: node count + 16 byte separator + offset 0
+	buf.Write([]byte{0, 0, 17, 0, 0, 17})
+	buf.Write(make([]byte, 16))
+	writeTestMMDBValue(&buf, record)
+	buf.WriteString("\xab\xcd\xefMaxMind.com")
+	writeTestMMDBValue(&buf, map[string]interface{}{
+		"binary_format_major_version": uint32(2),
+		"database_type":               "Test",
+		"ip_version":                  uint32(4),
+		"node_count":                  uint32(1),
+		"record_size":                 uint32(24),
+	})
+	require.NoError(t, ioutil.WriteFile(file, buf.Bytes(), 0600))
+}
+
+func writeTestMMDBValue(buf *bytes.Buffer, value interface{}) {
+	const (
+		typeString = 2
+		typeUint32 = 6
+		typeMap    = 7
+	)
+	switch v := value.(type) {
+	case string:
+		if len(v) < 29 {
+			buf.WriteByte(typeString<<5 | byte(len(v)))
+		} else {
+			buf.Write([]byte{typeString<<5 | 29, byte(len(v) - 29)})
+		}
+		buf.WriteString(v)
+	case uint32:
+		buf.WriteByte(typeUint32<<5 | 4)
+		_ = binary.Write(buf, binary.BigEndian, v)
+	case map[string]interface{}:
+		buf.WriteByte(typeMap<<5 | byte(len(v)))
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			writeTestMMDBValue(buf, k)
+			writeTestMMDBValue(buf, v[k])
+		}
+	}
+}
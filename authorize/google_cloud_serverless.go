@@ -12,7 +12,7 @@ import (
 	"sync"
 	"time"
 
-	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"golang.org/x/oauth2"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/api/idtoken"
@@ -129,7 +129,7 @@ func getGoogleCloudServerlessTokenSource(serviceAccount, audience string) (oauth
 	return src, nil
 }
 
-func (a *Authorize) getGoogleCloudServerlessAuthenticationHeaders(reply *evaluator.Result) ([]*envoy_api_v2_core.HeaderValueOption, error) {
+func (a *Authorize) getGoogleCloudServerlessAuthenticationHeaders(reply *evaluator.Result) ([]*envoy_config_core_v3.HeaderValueOption, error) {
 	if reply.MatchingPolicy == nil || !reply.MatchingPolicy.EnableGoogleCloudServerlessAuthentication {
 		return nil, nil
 	}
@@ -147,7 +147,7 @@ func (a *Authorize) getGoogleCloudServerlessAuthenticationHeaders(reply *evaluat
 		return nil, err
 	}
 
-	return []*envoy_api_v2_core.HeaderValueOption{
+	return []*envoy_config_core_v3.HeaderValueOption{
 		mkHeader("Authorization", "Bearer "+tok.AccessToken, false),
 	}, nil
 }
@@ -6,30 +6,38 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/graphql"
 	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/interop"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/internal/telemetry/requestid"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/internal/urlutil"
-	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/impersonation"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 
-	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 )
 
 var sessionTypeURL, userTypeURL string
 
+var errPolicyEvaluatorNotReady = errors.New("authorize: policy evaluator is not ready")
+
 func init() {
 	any, _ := ptypes.MarshalAny(new(session.Session))
 	sessionTypeURL = any.GetTypeUrl()
@@ -39,42 +47,143 @@ func init() {
 }
 
 // Check implements the envoy auth server gRPC endpoint.
-func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v2.CheckRequest) (*envoy_service_auth_v2.CheckResponse, error) {
+func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRequest) (*envoy_service_auth_v3.CheckResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "authorize.grpc.Check")
 	defer span.End()
 
+	ctx, cancel := a.withCheckDeadline(ctx)
+	defer cancel()
+
 	// maybe rewrite http request for forward auth
 	isForwardAuth := a.handleForwardAuth(in)
-	hreq := getHTTPRequestFromCheckRequest(in)
-	rawJWT, _ := loadRawSession(hreq, a.currentOptions.Load(), a.currentEncoder.Load())
-	sessionState, _ := loadSession(a.currentEncoder.Load(), rawJWT)
+	hdrs := newCheckRequestHeaders(in)
+	hreq := getHTTPRequestFromCheckRequest(in, hdrs)
+	opts := a.currentOptions.Load()
+	rawJWT, _ := loadRawSession(hreq, opts, a.currentEncoder.Load())
+	sessionState, _ := loadSession(a.currentEncoder.Load(), rawJWT, opts.ClockSkew)
 
-	if err := a.forceSync(ctx, sessionState); err != nil {
+	if err := a.forceSync(ctx, sessionState); isCheckDeadlineExceeded(ctx) {
+		log.Warn().Msg("authorize: deadline exceeded while syncing session")
+		return a.deadlineExceededResponse(in), nil
+	} else if err != nil {
 		log.Warn().Err(err).Msg("clearing session due to force sync failed")
 		sessionState = nil
 	}
+	// the request has a session which is no longer valid
+	expiredSession := len(rawJWT) > 0 && sessionState == nil
+
+	// requests without a session may have passed through an upstream
+	// zero-trust provider which already authenticated the user
+	var interopID *interop.Identity
+	if sessionState == nil && a.interop != nil {
+		interopID = a.interop.Verify(ctx, hreq.Header)
+	}
 
 	a.dataBrokerDataLock.RLock()
 	defer a.dataBrokerDataLock.RUnlock()
 
-	req := a.getEvaluatorRequestFromCheckRequest(in, sessionState)
-	reply, err := a.pe.Evaluate(ctx, req)
-	if err != nil {
+	// impersonation is only honored while the session has a valid grant
+	evaluatorSession := sessionState
+	var grant *impersonation.Grant
+	var grantErr error
+	if sessionState != nil && sessionState.Impersonating() {
+		grant, grantErr = a.getImpersonationGrant(sessionState)
+		if grantErr != nil {
+			evaluatorSession = withoutImpersonation(sessionState)
+		}
+	}
+
+	req := a.getEvaluatorRequestFromCheckRequest(in, hdrs, evaluatorSession)
+	if interopID != nil {
+		withInteropIdentity(req, interopID)
+	}
+	reply, err := a.evaluate(ctx, in, req)
+	if err != nil && isCheckDeadlineExceeded(ctx) {
+		log.Warn().Err(err).Msg("authorize: deadline exceeded during OPA evaluation")
+		return a.deadlineExceededResponse(in), nil
+	} else if err != nil {
 		log.Error().Err(err).Msg("error during OPA evaluation")
-		return nil, err
+		return a.deniedResponse(in, http.StatusInternalServerError, "internal error", evaluator.DenyReasonInternalError, nil), nil
+	}
+	if reply.DenyReason == evaluator.DenyReasonUnauthenticated && expiredSession {
+		reply = withDenyReason(reply, evaluator.DenyReasonExpiredSession)
+	}
+	reply = a.denyForcedTrace(hdrs, reply)
+	logAuthorizeCheck(ctx, in, hdrs, reply)
+	if a.decisionLog != nil {
+		a.decisionLog.Log(hdrs.Get("X-Request-Id"), req, reply)
+	}
+	if sessionState != nil && sessionState.Impersonating() {
+		logImpersonatedCheck(ctx, in, sessionState, grant, grantErr, reply)
 	}
-	logAuthorizeCheck(ctx, in, reply)
 
 	switch {
 	case reply.Status == http.StatusOK:
-		return a.okResponse(reply), nil
+		if p := reply.MatchingPolicy; p != nil && p.CDN != nil && !isForwardAuth {
+			return a.cdnResponse(in, p.CDN), nil
+		}
+		res := a.okResponse(reply)
+		if timeout, ok := a.getStreamTimeout(in, req, reply.MatchingPolicy); ok {
+			okResponse := res.GetOkResponse()
+			okResponse.Headers = append(okResponse.Headers,
+				mkHeader(headerEnvoyUpstreamRequestTimeout, strconv.FormatInt(timeout.Milliseconds(), 10), false))
+		}
+		if hdr, ok := getPriorityHeader(reply); ok {
+			okResponse := res.GetOkResponse()
+			okResponse.Headers = append(okResponse.Headers, hdr)
+		}
+		return res, nil
 	case reply.Status == http.StatusUnauthorized:
-		if isForwardAuth {
-			return a.deniedResponse(in, http.StatusUnauthorized, "Unauthenticated", nil), nil
+		// API clients get the route's deny response instead of a login redirect
+		if isForwardAuth || (a.getDenyResponse(in) != nil && !isBrowserRequest(in)) {
+			return a.deniedResponse(in, http.StatusUnauthorized, "Unauthenticated", reply.DenyReason, nil), nil
 		}
-		return a.redirectResponse(in), nil
+		return a.redirectResponse(in, a.getMatchingPolicy(getCheckRequestURL(in)), reply.DenyReason), nil
+	}
+	return a.deniedResponse(in, int32(reply.Status), reply.Message, reply.DenyReason, nil), nil
+}
+
+// withDenyReason returns a copy of the result with a different deny reason,
+// so that results shared by the decision cache aren't modified.
+func withDenyReason(reply *evaluator.Result, denyReason evaluator.DenyReason) *evaluator.Result {
+	cp := *reply
+	cp.DenyReason = denyReason
+	return &cp
+}
+
+// evaluate evaluates the request, using the decision cache when possible.
+func (a *Authorize) evaluate(
+	ctx context.Context,
+	in *envoy_service_auth_v3.CheckRequest,
+	req *evaluator.Request,
+) (*evaluator.Result, error) {
+	// load the evaluator once, so the whole request is evaluated by the same
+	// one even if the config changes
+	pe := a.pe.Load()
+	if pe == nil {
+		return nil, errPolicyEvaluatorNotReady
 	}
-	return a.deniedResponse(in, int32(reply.Status), reply.Message, nil), nil
+
+	cache := a.decisionCache.Load()
+	if cache == nil {
+		return pe.Evaluate(ctx, req)
+	}
+
+	key, cacheable := a.getDecisionCacheKey(req, a.getMatchingPolicy(getCheckRequestURL(in)))
+	if !cacheable {
+		return pe.Evaluate(ctx, req)
+	}
+
+	if reply, ok := cache.Get(key); ok {
+		return reply, nil
+	}
+
+	reply, err := pe.Evaluate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	cache.Add(key, reply)
+	return reply, nil
 }
 
 func (a *Authorize) forceSync(ctx context.Context, ss *sessions.State) error {
@@ -85,9 +194,22 @@ func (a *Authorize) forceSync(ctx context.Context, ss *sessions.State) error {
 	}
 	s := a.forceSyncSession(ctx, ss.ID)
 	if s == nil {
+		// service accounts sign in with their id as the session id
+		if sa := a.forceSyncServiceAccount(ctx, ss.ID); sa != nil && sa.IsActive(time.Now()) {
+			return nil
+		}
 		return errors.New("session not found")
 	}
+	if err := a.checkSessionActivity(s); err != nil {
+		return err
+	}
 	a.forceSyncUser(ctx, s.GetUserId())
+	if ss.Impersonating() {
+		a.forceSyncImpersonationGrant(ctx, ss.ID)
+	}
+	if kiosk.IsUserID(s.GetUserId()) {
+		a.forceSyncKioskDevice(ctx, ss.ID)
+	}
 	return nil
 }
 
@@ -97,24 +219,31 @@ func (a *Authorize) forceSyncSession(ctx context.Context, sessionID string) *ses
 
 	a.dataBrokerDataLock.RLock()
 	s, ok := a.dataBrokerData.Get(sessionTypeURL, sessionID).(*session.Session)
+	notFound := a.notFound.has(sessionTypeURL, sessionID)
 	a.dataBrokerDataLock.RUnlock()
 	if ok {
+		a.dataBudget.touch(sessionTypeURL, sessionID)
 		return s
+	} else if notFound {
+		return nil
 	}
 
-	res, err := a.dataBrokerClient.Get(ctx, &databroker.GetRequest{
-		Type: sessionTypeURL,
-		Id:   sessionID,
-	})
-	if err != nil {
+	record, err := a.getDataBrokerRecord(ctx, sessionTypeURL, sessionID)
+	if status.Code(err) == codes.NotFound {
+		a.dataBrokerDataLock.Lock()
+		a.notFound.add(sessionTypeURL, sessionID)
+		a.dataBrokerDataLock.Unlock()
+		return nil
+	} else if errors.Is(err, errCircuitBreakerOpen) {
+		log.Debug().Err(err).Msg("skipped getting session from databroker")
+		return nil
+	} else if err != nil {
 		log.Warn().Err(err).Msg("failed to get session from databroker")
 		return nil
 	}
 
 	a.dataBrokerDataLock.Lock()
-	if current := a.dataBrokerData.Get(sessionTypeURL, sessionID); current == nil {
-		a.dataBrokerData.Update(res.GetRecord())
-	}
+	a.addForceSyncedRecord(record)
 	s, _ = a.dataBrokerData.Get(sessionTypeURL, sessionID).(*session.Session)
 	a.dataBrokerDataLock.Unlock()
 
@@ -127,32 +256,39 @@ func (a *Authorize) forceSyncUser(ctx context.Context, userID string) *user.User
 
 	a.dataBrokerDataLock.RLock()
 	u, ok := a.dataBrokerData.Get(userTypeURL, userID).(*user.User)
+	notFound := a.notFound.has(userTypeURL, userID)
 	a.dataBrokerDataLock.RUnlock()
 	if ok {
+		a.dataBudget.touch(userTypeURL, userID)
 		return u
+	} else if notFound {
+		return nil
 	}
 
-	res, err := a.dataBrokerClient.Get(ctx, &databroker.GetRequest{
-		Type: userTypeURL,
-		Id:   userID,
-	})
-	if err != nil {
+	record, err := a.getDataBrokerRecord(ctx, userTypeURL, userID)
+	if status.Code(err) == codes.NotFound {
+		a.dataBrokerDataLock.Lock()
+		a.notFound.add(userTypeURL, userID)
+		a.dataBrokerDataLock.Unlock()
+		return nil
+	} else if errors.Is(err, errCircuitBreakerOpen) {
+		log.Debug().Err(err).Msg("skipped getting user from databroker")
+		return nil
+	} else if err != nil {
 		log.Warn().Err(err).Msg("failed to get user from databroker")
 		return nil
 	}
 
 	a.dataBrokerDataLock.Lock()
-	if current := a.dataBrokerData.Get(userTypeURL, userID); current == nil {
-		a.dataBrokerData.Update(res.GetRecord())
-	}
+	a.addForceSyncedRecord(record)
 	u, _ = a.dataBrokerData.Get(userTypeURL, userID).(*user.User)
 	a.dataBrokerDataLock.Unlock()
 
 	return u
 }
 
-func (a *Authorize) getEnvoyRequestHeaders(signedJWT string) ([]*envoy_api_v2_core.HeaderValueOption, error) {
-	var hvos []*envoy_api_v2_core.HeaderValueOption
+func (a *Authorize) getEnvoyRequestHeaders(signedJWT string) ([]*envoy_config_core_v3.HeaderValueOption, error) {
+	var hvos []*envoy_config_core_v3.HeaderValueOption
 
 	hdrs, err := a.getJWTClaimHeaders(a.currentOptions.Load(), signedJWT)
 	if err != nil {
@@ -165,7 +301,7 @@ func (a *Authorize) getEnvoyRequestHeaders(signedJWT string) ([]*envoy_api_v2_co
 	return hvos, nil
 }
 
-func (a *Authorize) handleForwardAuth(req *envoy_service_auth_v2.CheckRequest) bool {
+func (a *Authorize) handleForwardAuth(req *envoy_service_auth_v3.CheckRequest) bool {
 	opts := a.currentOptions.Load()
 
 	if opts.ForwardAuthURL == nil {
@@ -206,26 +342,50 @@ func (a *Authorize) handleForwardAuth(req *envoy_service_auth_v2.CheckRequest) b
 	return true
 }
 
-func (a *Authorize) getEvaluatorRequestFromCheckRequest(in *envoy_service_auth_v2.CheckRequest, sessionState *sessions.State) *evaluator.Request {
+func (a *Authorize) getEvaluatorRequestFromCheckRequest(
+	in *envoy_service_auth_v3.CheckRequest,
+	hdrs *checkRequestHeaders,
+	sessionState *sessions.State,
+) *evaluator.Request {
 	requestURL := getCheckRequestURL(in)
 	req := &evaluator.Request{
 		DataBrokerData: a.dataBrokerData,
 		HTTP: evaluator.RequestHTTP{
 			Method:            in.GetAttributes().GetRequest().GetHttp().GetMethod(),
 			URL:               requestURL.String(),
-			Headers:           getCheckRequestHeaders(in),
+			Headers:           hdrs.Map(),
 			ClientCertificate: getPeerCertificate(in),
+			ClientIP:          getCheckRequestClientIP(in),
 		},
+		GRPC: getCheckRequestGRPC(in),
+	}
+	if a.geoIP != nil {
+		a.geoIP.Enrich(&req.HTTP)
 	}
 	if sessionState != nil {
 		req.Session = evaluator.RequestSession{
-			ID:                sessionState.ID,
-			ImpersonateEmail:  sessionState.ImpersonateEmail,
-			ImpersonateGroups: sessionState.ImpersonateGroups,
+			ID:                 sessionState.ID,
+			ImpersonateEmail:   sessionState.ImpersonateEmail,
+			ImpersonateGroups:  sessionState.ImpersonateGroups,
+			IdentityProviderID: sessionState.IdentityProviderID,
+			ACR:                sessionState.ACR,
+			AMR:                sessionState.AMR,
+		}
+		// sessions created before identity providers had ids are from the
+		// default identity provider
+		if req.Session.IdentityProviderID == "" {
+			req.Session.IdentityProviderID = config.DefaultIdentityProviderID
+		}
+		if sessionState.AuthTime != nil {
+			req.Session.AuthTime = sessionState.AuthTime.Time().Unix()
 		}
 	}
 	p := a.getMatchingPolicy(requestURL)
 	if p != nil {
+		req.OpenAPI = evaluator.GetRequestOpenAPI(p, req.HTTP.Method, requestURL)
+		if p.GraphQL {
+			req.GraphQL = getCheckRequestGraphQL(in, requestURL)
+		}
 		for _, sp := range p.SubPolicies {
 			req.CustomPolicies = append(req.CustomPolicies, sp.Rego...)
 		}
@@ -237,65 +397,28 @@ func (a *Authorize) getMatchingPolicy(requestURL *url.URL) *config.Policy {
 	options := a.currentOptions.Load()
 
 	for _, p := range options.Policies {
-		if p.Source == nil {
-			continue
-		}
-
-		if p.Source.Host != requestURL.Host {
-			continue
-		}
-
-		if p.Prefix != "" {
-			if !strings.HasPrefix(requestURL.Path, p.Prefix) {
-				continue
-			}
-		}
-
-		if p.Path != "" {
-			if requestURL.Path != p.Path {
-				continue
-			}
-		}
-
-		if p.Regex != "" {
-			re, err := regexp.Compile(p.Regex)
-			if err == nil && !re.MatchString(requestURL.String()) {
-				continue
-			}
+		if p.Matches(requestURL) {
+			return &p
 		}
-
-		return &p
 	}
 
 	return nil
 }
 
-func getHTTPRequestFromCheckRequest(req *envoy_service_auth_v2.CheckRequest) *http.Request {
+func getHTTPRequestFromCheckRequest(req *envoy_service_auth_v3.CheckRequest, hdrs *checkRequestHeaders) *http.Request {
 	hattrs := req.GetAttributes().GetRequest().GetHttp()
 	hreq := &http.Request{
 		Method:     hattrs.GetMethod(),
 		URL:        getCheckRequestURL(req),
-		Header:     make(http.Header),
+		Header:     hdrs.HTTPHeader(),
 		Body:       ioutil.NopCloser(strings.NewReader(hattrs.GetBody())),
 		Host:       hattrs.GetHost(),
 		RequestURI: hattrs.GetPath(),
 	}
-	for k, v := range getCheckRequestHeaders(req) {
-		hreq.Header.Set(k, v)
-	}
 	return hreq
 }
 
-func getCheckRequestHeaders(req *envoy_service_auth_v2.CheckRequest) map[string]string {
-	hdrs := make(map[string]string)
-	ch := req.GetAttributes().GetRequest().GetHttp().GetHeaders()
-	for k, v := range ch {
-		hdrs[http.CanonicalHeaderKey(k)] = v
-	}
-	return hdrs
-}
-
-func getCheckRequestURL(req *envoy_service_auth_v2.CheckRequest) *url.URL {
+func getCheckRequestURL(req *envoy_service_auth_v3.CheckRequest) *url.URL {
 	h := req.GetAttributes().GetRequest().GetHttp()
 	u := &url.URL{
 		Scheme: h.GetScheme(),
@@ -319,37 +442,94 @@ func getCheckRequestURL(req *envoy_service_auth_v2.CheckRequest) *url.URL {
 }
 
 // getPeerCertificate gets the PEM-encoded peer certificate from the check request
-func getPeerCertificate(in *envoy_service_auth_v2.CheckRequest) string {
+func getPeerCertificate(in *envoy_service_auth_v3.CheckRequest) string {
 	// ignore the error as we will just return the empty string in that case
 	cert, _ := url.QueryUnescape(in.GetAttributes().GetSource().GetCertificate())
 	return cert
 }
 
+// getCheckRequestGRPC returns the service and method of a gRPC request, which
+// are encoded in the path as /<service>/<method>. Nil is returned for other
+// requests, including gRPC requests with a malformed path, which routes with
+// allowed gRPC methods deny.
+func getCheckRequestGRPC(in *envoy_service_auth_v3.CheckRequest) *evaluator.RequestGRPC {
+	hattrs := in.GetAttributes().GetRequest().GetHttp()
+	if !strings.HasPrefix(strings.ToLower(hattrs.GetHeaders()["content-type"]), "application/grpc") {
+		return nil
+	}
+	path := hattrs.GetPath()
+	if !strings.HasPrefix(path, "/") || strings.ContainsAny(path, "?#") {
+		return nil
+	}
+	parts := strings.Split(path[1:], "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil
+	}
+	return &evaluator.RequestGRPC{Service: parts[0], Method: parts[1]}
+}
+
+// getCheckRequestGraphQL returns the operation of a GraphQL request, or nil if
+// the request isn't a valid GraphQL operation. Envoy only sends the start of
+// large bodies, which are treated as invalid.
+func getCheckRequestGraphQL(in *envoy_service_auth_v3.CheckRequest, requestURL *url.URL) *evaluator.RequestGraphQL {
+	hattrs := in.GetAttributes().GetRequest().GetHttp()
+	if hattrs.GetHeaders()["x-envoy-auth-partial-body"] == "true" {
+		return nil
+	}
+	op, err := graphql.ParseRequest(hattrs.GetMethod(), hattrs.GetHeaders()["content-type"], requestURL.Query(), []byte(hattrs.GetBody()))
+	if err != nil {
+		return nil
+	}
+	return &evaluator.RequestGraphQL{
+		OperationType: op.Type,
+		OperationName: op.Name,
+		Fields:        op.Fields,
+	}
+}
+
+// isBrowserRequest returns true if the request accepts an HTML response.
+func isBrowserRequest(in *envoy_service_auth_v3.CheckRequest) bool {
+	return strings.Contains(in.GetAttributes().GetRequest().GetHttp().GetHeaders()["accept"], "text/html")
+}
+
+// getCheckRequestClientIP returns the IP address of the downstream client.
+// Envoy sets the external address header based on the trusted hops in
+// x-forwarded-for, otherwise the address of the connection is used.
+func getCheckRequestClientIP(in *envoy_service_auth_v3.CheckRequest) string {
+	if ip := in.GetAttributes().GetRequest().GetHttp().GetHeaders()[httputil.HeaderEnvoyExternalAddress]; ip != "" {
+		return ip
+	}
+	return in.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress()
+}
+
 func logAuthorizeCheck(
 	ctx context.Context,
-	in *envoy_service_auth_v2.CheckRequest,
+	in *envoy_service_auth_v3.CheckRequest,
+	hdrs *checkRequestHeaders,
 	reply *evaluator.Result,
 ) {
-	hdrs := getCheckRequestHeaders(in)
 	hattrs := in.GetAttributes().GetRequest().GetHttp()
 	evt := log.Info().Str("service", "authorize")
 	// request
-	evt = evt.Str("request-id", requestid.FromContext(ctx))
-	evt = evt.Str("check-request-id", hdrs["X-Request-Id"])
+	evt = log.Str(evt, "request_id", requestid.FromContext(ctx))
+	evt = log.Str(evt, "check_request_id", hdrs.Get("X-Request-Id"))
 	evt = evt.Str("method", hattrs.GetMethod())
-	evt = evt.Str("path", hattrs.GetPath())
+	evt = evt.Str("path", log.RedactURL(hattrs.GetPath()))
 	evt = evt.Str("host", hattrs.GetHost())
-	evt = evt.Str("query", hattrs.GetQuery())
+	evt = evt.Str("query", strings.TrimPrefix(log.RedactURL("?"+hattrs.GetQuery()), "?"))
 	// reply
 	if reply != nil {
 		evt = evt.Bool("allow", reply.Status == http.StatusOK)
 		evt = evt.Int("status", reply.Status)
 		evt = evt.Str("message", reply.Message)
+		if reply.DenyReason != "" {
+			evt = log.Str(evt, "deny_reason", string(reply.DenyReason))
+		}
 	}
 
 	// potentially sensitive, only log if debug mode
 	if zerolog.GlobalLevel() <= zerolog.DebugLevel {
-		evt = evt.Interface("headers", hdrs)
+		evt = evt.Interface("headers", log.RedactHeaders(hdrs.Map()))
 	}
 
 	evt.Msg("authorize check")
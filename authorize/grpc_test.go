@@ -5,12 +5,16 @@ import (
 	"errors"
 	"net/url"
 	"testing"
+	"time"
 
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
 	"github.com/pomerium/pomerium/config"
@@ -18,6 +22,7 @@ import (
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/serviceaccount"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 )
@@ -59,28 +64,30 @@ func Test_getEvaluatorRequest(t *testing.T) {
 		}},
 	})
 
-	actual := a.getEvaluatorRequestFromCheckRequest(
-		&envoy_service_auth_v2.CheckRequest{
-			Attributes: &envoy_service_auth_v2.AttributeContext{
-				Source: &envoy_service_auth_v2.AttributeContext_Peer{
-					Certificate: url.QueryEscape(certPEM),
-				},
-				Request: &envoy_service_auth_v2.AttributeContext_Request{
-					Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
-						Id:     "id-1234",
-						Method: "GET",
-						Headers: map[string]string{
-							"accept":            "text/html",
-							"x-forwarded-proto": "https",
-						},
-						Path:   "/some/path?qs=1",
-						Host:   "example.com",
-						Scheme: "http",
-						Body:   "BODY",
+	in := &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Source: &envoy_service_auth_v3.AttributeContext_Peer{
+				Certificate: url.QueryEscape(certPEM),
+			},
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+					Id:     "id-1234",
+					Method: "GET",
+					Headers: map[string]string{
+						"accept":            "text/html",
+						"x-forwarded-proto": "https",
 					},
+					Path:   "/some/path?qs=1",
+					Host:   "example.com",
+					Scheme: "http",
+					Body:   "BODY",
 				},
 			},
 		},
+	}
+	actual := a.getEvaluatorRequestFromCheckRequest(
+		in,
+		newCheckRequestHeaders(in),
 		&sessions.State{
 			ID:                "SESSION_ID",
 			ImpersonateEmail:  "foo@example.com",
@@ -89,9 +96,10 @@ func Test_getEvaluatorRequest(t *testing.T) {
 	)
 	expect := &evaluator.Request{
 		Session: evaluator.RequestSession{
-			ID:                "SESSION_ID",
-			ImpersonateEmail:  "foo@example.com",
-			ImpersonateGroups: []string{"admin", "test"},
+			ID:                 "SESSION_ID",
+			ImpersonateEmail:   "foo@example.com",
+			ImpersonateGroups:  []string{"admin", "test"},
+			IdentityProviderID: "default",
 		},
 		HTTP: evaluator.RequestHTTP{
 			Method: "GET",
@@ -110,20 +118,20 @@ func Test_getEvaluatorRequest(t *testing.T) {
 func Test_handleForwardAuth(t *testing.T) {
 	tests := []struct {
 		name           string
-		checkReq       *envoy_service_auth_v2.CheckRequest
-		attrCtxHTTPReq *envoy_service_auth_v2.AttributeContext_HttpRequest
+		checkReq       *envoy_service_auth_v3.CheckRequest
+		attrCtxHTTPReq *envoy_service_auth_v3.AttributeContext_HttpRequest
 		forwardAuthURL string
 		isForwardAuth  bool
 	}{
 		{
 			name: "enabled",
-			checkReq: &envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Source: &envoy_service_auth_v2.AttributeContext_Peer{
+			checkReq: &envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Source: &envoy_service_auth_v3.AttributeContext_Peer{
 						Certificate: url.QueryEscape(certPEM),
 					},
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Method: "GET",
 							Path:   "/verify?uri=" + url.QueryEscape("https://example.com/some/path?qs=1"),
 							Host:   "forward-auth.example.com",
@@ -132,7 +140,7 @@ func Test_handleForwardAuth(t *testing.T) {
 					},
 				},
 			},
-			attrCtxHTTPReq: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+			attrCtxHTTPReq: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 				Method: "GET",
 				Path:   "/some/path?qs=1",
 				Host:   "example.com",
@@ -150,13 +158,13 @@ func Test_handleForwardAuth(t *testing.T) {
 		},
 		{
 			name: "honor x-forwarded-uri set",
-			checkReq: &envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Source: &envoy_service_auth_v2.AttributeContext_Peer{
+			checkReq: &envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Source: &envoy_service_auth_v3.AttributeContext_Peer{
 						Certificate: url.QueryEscape(certPEM),
 					},
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Method: "GET",
 							Path:   "/",
 							Host:   "forward-auth.example.com",
@@ -170,7 +178,7 @@ func Test_handleForwardAuth(t *testing.T) {
 					},
 				},
 			},
-			attrCtxHTTPReq: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+			attrCtxHTTPReq: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 				Method: "GET",
 				Path:   "/foo/bar",
 				Host:   "example.com",
@@ -186,13 +194,13 @@ func Test_handleForwardAuth(t *testing.T) {
 		},
 		{
 			name: "request with invalid forward auth url",
-			checkReq: &envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Source: &envoy_service_auth_v2.AttributeContext_Peer{
+			checkReq: &envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Source: &envoy_service_auth_v3.AttributeContext_Peer{
 						Certificate: url.QueryEscape(certPEM),
 					},
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Method: "GET",
 							Path:   "/verify?uri=" + url.QueryEscape("https://example.com?q=foo"),
 							Host:   "fake-forward-auth.example.com",
@@ -207,13 +215,13 @@ func Test_handleForwardAuth(t *testing.T) {
 		},
 		{
 			name: "request with invalid path",
-			checkReq: &envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Source: &envoy_service_auth_v2.AttributeContext_Peer{
+			checkReq: &envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Source: &envoy_service_auth_v3.AttributeContext_Peer{
 						Certificate: url.QueryEscape(certPEM),
 					},
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Method: "GET",
 							Path:   "/foo?uri=" + url.QueryEscape("https://example.com?q=foo"),
 							Host:   "forward-auth.example.com",
@@ -228,13 +236,13 @@ func Test_handleForwardAuth(t *testing.T) {
 		},
 		{
 			name: "request with empty uri",
-			checkReq: &envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Source: &envoy_service_auth_v2.AttributeContext_Peer{
+			checkReq: &envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Source: &envoy_service_auth_v3.AttributeContext_Peer{
 						Certificate: url.QueryEscape(certPEM),
 					},
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Method: "GET",
 							Path:   "/verify?uri=",
 							Host:   "forward-auth.example.com",
@@ -249,13 +257,13 @@ func Test_handleForwardAuth(t *testing.T) {
 		},
 		{
 			name: "request with invalid uri",
-			checkReq: &envoy_service_auth_v2.CheckRequest{
-				Attributes: &envoy_service_auth_v2.AttributeContext{
-					Source: &envoy_service_auth_v2.AttributeContext_Peer{
+			checkReq: &envoy_service_auth_v3.CheckRequest{
+				Attributes: &envoy_service_auth_v3.AttributeContext{
+					Source: &envoy_service_auth_v3.AttributeContext_Peer{
 						Certificate: url.QueryEscape(certPEM),
 					},
-					Request: &envoy_service_auth_v2.AttributeContext_Request{
-						Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+					Request: &envoy_service_auth_v3.AttributeContext_Request{
+						Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 							Method: "GET",
 							Path:   "/verify?uri= http://example.com/foo",
 							Host:   "forward-auth.example.com",
@@ -300,13 +308,13 @@ func Test_getEvaluatorRequestWithPortInHostHeader(t *testing.T) {
 		}},
 	})
 
-	actual := a.getEvaluatorRequestFromCheckRequest(&envoy_service_auth_v2.CheckRequest{
-		Attributes: &envoy_service_auth_v2.AttributeContext{
-			Source: &envoy_service_auth_v2.AttributeContext_Peer{
+	in := &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Source: &envoy_service_auth_v3.AttributeContext_Peer{
 				Certificate: url.QueryEscape(certPEM),
 			},
-			Request: &envoy_service_auth_v2.AttributeContext_Request{
-				Http: &envoy_service_auth_v2.AttributeContext_HttpRequest{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
 					Id:     "id-1234",
 					Method: "GET",
 					Headers: map[string]string{
@@ -320,7 +328,8 @@ func Test_getEvaluatorRequestWithPortInHostHeader(t *testing.T) {
 				},
 			},
 		},
-	}, nil)
+	}
+	actual := a.getEvaluatorRequestFromCheckRequest(in, newCheckRequestHeaders(in), nil)
 	expect := &evaluator.Request{
 		Session: evaluator.RequestSession{},
 		HTTP: evaluator.RequestHTTP{
@@ -380,6 +389,11 @@ func TestSync(t *testing.T) {
 			return f(ctx, in, opts...)
 		},
 	}
+	notFoundClient := mockDataBrokerServiceClient{
+		get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		},
+	}
 	o := &config.Options{
 		AuthenticateURL: mustParseURL("https://authN.example.com"),
 		DataBrokerURL:   mustParseURL("https://cache.example.com"),
@@ -424,6 +438,8 @@ func TestSync(t *testing.T) {
 		{"good", &sessions.State{ID: "SESSION_ID"}, dbdClient, false},
 		{"nil session state", nil, dbdClient, false},
 		{"not found session state", &sessions.State{ID: "not-existed-id"}, dbdClient, true},
+		{"service account", &sessions.State{ID: "dbd_service_account_id"}, notFoundClient, false},
+		{"expired service account", &sessions.State{ID: "dbd_expired_service_account_id"}, notFoundClient, true},
 		{
 			"user not found",
 			&sessions.State{ID: "session_with_not_found_user"},
@@ -463,6 +479,13 @@ func TestSync(t *testing.T) {
 				"type.googleapis.com/user.User": map[string]interface{}{
 					"dbd_user1": &user.User{Id: "dbd_user1"},
 				},
+				"type.googleapis.com/serviceaccount.ServiceAccount": map[string]interface{}{
+					"dbd_service_account_id": &serviceaccount.ServiceAccount{Id: "dbd_service_account_id"},
+					"dbd_expired_service_account_id": &serviceaccount.ServiceAccount{
+						Id:        "dbd_expired_service_account_id",
+						ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+					},
+				},
 			}
 			a.dataBrokerClient = tc.databrokerClient
 			assert.True(t, (a.forceSync(ctx, tc.sessionState) != nil) == tc.wantErr)
@@ -486,3 +509,60 @@ type mockDataBrokerServiceClient struct {
 func (m mockDataBrokerServiceClient) Get(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
 	return m.get(ctx, in, opts...)
 }
+
+func Test_getCheckRequestGRPC(t *testing.T) {
+	newCheckRequest := func(contentType, path string) *envoy_service_auth_v3.CheckRequest {
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Path:    path,
+						Headers: map[string]string{"content-type": contentType},
+					},
+				},
+			},
+		}
+	}
+
+	assert.Equal(t, &evaluator.RequestGRPC{Service: "inventory.v1.Inventory", Method: "GetItem"},
+		getCheckRequestGRPC(newCheckRequest("application/grpc", "/inventory.v1.Inventory/GetItem")))
+	assert.Equal(t, &evaluator.RequestGRPC{Service: "inventory.v1.Inventory", Method: "GetItem"},
+		getCheckRequestGRPC(newCheckRequest("application/grpc+proto", "/inventory.v1.Inventory/GetItem")))
+	assert.Nil(t, getCheckRequestGRPC(newCheckRequest("application/json", "/inventory.v1.Inventory/GetItem")))
+	assert.Nil(t, getCheckRequestGRPC(newCheckRequest("application/grpc", "/")))
+	assert.Nil(t, getCheckRequestGRPC(newCheckRequest("application/grpc", "/a/b/c")))
+	assert.Equal(t, &evaluator.RequestGRPC{Service: "inventory.v1.Inventory", Method: "GetItem"},
+		getCheckRequestGRPC(newCheckRequest("Application/GRPC", "/inventory.v1.Inventory/GetItem")))
+	assert.Nil(t, getCheckRequestGRPC(newCheckRequest("application/grpc", "inventory.v1.Inventory/GetItem")))
+	assert.Nil(t, getCheckRequestGRPC(newCheckRequest("application/grpc", "/inventory.v1.Inventory/GetItem?x=/DeleteItem")))
+	assert.Nil(t, getCheckRequestGRPC(newCheckRequest("application/grpc", "//inventory.v1.Inventory/GetItem")))
+}
+
+func Test_getCheckRequestGraphQL(t *testing.T) {
+	newCheckRequest := func(method, contentType, body string, partial bool) *envoy_service_auth_v3.CheckRequest {
+		headers := map[string]string{"content-type": contentType}
+		if partial {
+			headers["x-envoy-auth-partial-body"] = "true"
+		}
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Method:  method,
+						Headers: headers,
+						Body:    body,
+					},
+				},
+			},
+		}
+	}
+	u := mustParseURL("https://example.com/graphql")
+
+	assert.Equal(t, &evaluator.RequestGraphQL{OperationType: "mutation", OperationName: "Delete", Fields: []string{"deleteUser"}},
+		getCheckRequestGraphQL(newCheckRequest("POST", "application/json", `{"query": "mutation Delete { deleteUser(id: 1) }"}`, false), u))
+	assert.Equal(t, &evaluator.RequestGraphQL{OperationType: "query", Fields: []string{"me"}},
+		getCheckRequestGraphQL(newCheckRequest("GET", "", "", false), mustParseURL("https://example.com/graphql?query=%7Bme%7D")))
+	assert.Nil(t, getCheckRequestGraphQL(newCheckRequest("POST", "application/json", `{"query": "mutation { deleteUser`, true), u))
+	assert.Nil(t, getCheckRequestGraphQL(newCheckRequest("POST", "application/json", `not json`, false), u))
+	assert.Nil(t, getCheckRequestGraphQL(newCheckRequest("GET", "", "", false), u))
+}
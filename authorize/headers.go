@@ -0,0 +1,79 @@
+package authorize
+
+import (
+	"net/http"
+	"strings"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+)
+
+// checkRequestHeaders are the headers of a check request. A check builds
+// them once and shares them between the evaluator input, the logs and the
+// responses, rather than each copying the headers with canonical names.
+//
+// Envoy sends lowercase header names, so a header can usually be looked up
+// without canonicalizing every name. The canonical names are only built when
+// the whole map is needed.
+type checkRequestHeaders struct {
+	raw map[string]string
+	// lowercase is true if every raw header name is lowercase.
+	lowercase bool
+	canonical map[string]string
+}
+
+func newCheckRequestHeaders(in *envoy_service_auth_v3.CheckRequest) *checkRequestHeaders {
+	h := &checkRequestHeaders{
+		raw:       in.GetAttributes().GetRequest().GetHttp().GetHeaders(),
+		lowercase: true,
+	}
+	for k := range h.raw {
+		if strings.ToLower(k) != k {
+			h.lowercase = false
+			break
+		}
+	}
+	return h
+}
+
+// Lookup returns the value of a header, and whether the request has it.
+func (h *checkRequestHeaders) Lookup(name string) (string, bool) {
+	if h.lowercase {
+		v, ok := h.raw[strings.ToLower(name)]
+		return v, ok
+	}
+	v, ok := h.Map()[http.CanonicalHeaderKey(name)]
+	return v, ok
+}
+
+// Get returns the value of a header, or "" if the request doesn't have it.
+func (h *checkRequestHeaders) Get(name string) string {
+	v, _ := h.Lookup(name)
+	return v
+}
+
+// Map returns the headers by canonical header name. The map is shared, so it
+// must not be modified.
+func (h *checkRequestHeaders) Map() map[string]string {
+	if h.canonical == nil {
+		h.canonical = make(map[string]string, len(h.raw))
+		for k, v := range h.raw {
+			h.canonical[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+	return h.canonical
+}
+
+// HTTPHeader returns the headers as an http.Header.
+func (h *checkRequestHeaders) HTTPHeader() http.Header {
+	m := h.Map()
+	hdr := make(http.Header, len(m))
+	// share one backing array between the values, like net/textproto does
+	values := make([]string, len(m))
+	i := 0
+	for k, v := range m {
+		values[i] = v
+		hdr[k] = values[i : i+1 : i+1]
+		i++
+	}
+	return hdr
+}
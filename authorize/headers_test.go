@@ -0,0 +1,75 @@
+package authorize
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRequestHeaders(t *testing.T) {
+	newHeaders := func(headers map[string]string) *checkRequestHeaders {
+		return newCheckRequestHeaders(&envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Headers: headers,
+					},
+				},
+			},
+		})
+	}
+
+	t.Run("lowercase", func(t *testing.T) {
+		hdrs := newHeaders(map[string]string{"x-request-id": "1234", "accept": "text/html"})
+		assert.Equal(t, "1234", hdrs.Get("X-Request-Id"))
+		_, ok := hdrs.Lookup("X-Pomerium-Trace")
+		assert.False(t, ok)
+		assert.Nil(t, hdrs.canonical, "lookups shouldn't canonicalize the headers")
+
+		assert.Equal(t, map[string]string{"X-Request-Id": "1234", "Accept": "text/html"}, hdrs.Map())
+		assert.Equal(t, http.Header{"X-Request-Id": {"1234"}, "Accept": {"text/html"}}, hdrs.HTTPHeader())
+	})
+	t.Run("mixed case", func(t *testing.T) {
+		hdrs := newHeaders(map[string]string{"X-Request-ID": "1234"})
+		assert.Equal(t, "1234", hdrs.Get("x-request-id"))
+		assert.Equal(t, map[string]string{"X-Request-Id": "1234"}, hdrs.Map())
+	})
+	t.Run("empty", func(t *testing.T) {
+		hdrs := newHeaders(nil)
+		assert.Equal(t, "", hdrs.Get("X-Request-Id"))
+		assert.NotNil(t, hdrs.Map())
+		assert.Empty(t, hdrs.HTTPHeader())
+	})
+}
+
+func BenchmarkCheckRequestHeaders(b *testing.B) {
+	headers := make(map[string]string)
+	for i := 0; i < 50; i++ {
+		headers[fmt.Sprintf("x-custom-header-%d", i)] = "value"
+	}
+	headers["x-request-id"] = "1234"
+	in := &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+					Headers: headers,
+				},
+			},
+		},
+	}
+
+	// the headers used by a check: the session lookup, the evaluator input,
+	// the tracing check, the logs and the decision log
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		hdrs := newCheckRequestHeaders(in)
+		_ = getHTTPRequestFromCheckRequest(in, hdrs)
+		_ = hdrs.Map()
+		_, _ = hdrs.Lookup("X-Pomerium-Trace")
+		_ = hdrs.Get("X-Request-Id")
+		_ = hdrs.Get("X-Request-Id")
+	}
+}
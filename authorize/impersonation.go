@@ -0,0 +1,128 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/audit"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/telemetry/requestid"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/impersonation"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+)
+
+var errImpersonationGrantNotFound = errors.New("impersonation grant not found")
+
+var impersonationGrantTypeURL string
+
+func init() {
+	any, _ := ptypes.MarshalAny(new(impersonation.Grant))
+	impersonationGrantTypeURL = any.GetTypeUrl()
+}
+
+func (a *Authorize) forceSyncImpersonationGrant(ctx context.Context, sessionID string) *impersonation.Grant {
+	ctx, span := trace.StartSpan(ctx, "authorize.forceSyncImpersonationGrant")
+	defer span.End()
+
+	a.dataBrokerDataLock.RLock()
+	g, ok := a.dataBrokerData.Get(impersonationGrantTypeURL, sessionID).(*impersonation.Grant)
+	a.dataBrokerDataLock.RUnlock()
+	if ok {
+		return g
+	}
+
+	record, err := a.getDataBrokerRecord(ctx, impersonationGrantTypeURL, sessionID)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if errors.Is(err, errCircuitBreakerOpen) {
+		log.Debug().Err(err).Msg("skipped getting impersonation grant from databroker")
+		return nil
+	} else if err != nil {
+		log.Warn().Err(err).Msg("failed to get impersonation grant from databroker")
+		return nil
+	}
+
+	a.dataBrokerDataLock.Lock()
+	a.addForceSyncedRecord(record)
+	g, _ = a.dataBrokerData.Get(impersonationGrantTypeURL, sessionID).(*impersonation.Grant)
+	a.dataBrokerDataLock.Unlock()
+
+	return g
+}
+
+// getImpersonationGrant returns the grant for the impersonation set on a
+// session, and an error if the grant doesn't allow it. The data broker data
+// lock must be held.
+func (a *Authorize) getImpersonationGrant(ss *sessions.State) (*impersonation.Grant, error) {
+	grant, ok := a.dataBrokerData.Get(impersonationGrantTypeURL, ss.ID).(*impersonation.Grant)
+	if !ok {
+		return nil, errImpersonationGrantNotFound
+	}
+	return grant, grant.Validate(timeNow(), ss.ImpersonateEmail, ss.ImpersonateGroups)
+}
+
+// withoutImpersonation returns a copy of the session state with impersonation
+// cleared, so that the request is authorized as the administrator instead.
+func withoutImpersonation(ss *sessions.State) *sessions.State {
+	cp := *ss
+	cp.SetImpersonation("", "")
+	return &cp
+}
+
+// logImpersonatedCheck logs an audit event for a request made by a session
+// which is impersonating another user or set of groups.
+func logImpersonatedCheck(
+	ctx context.Context,
+	in *envoy_service_auth_v3.CheckRequest,
+	ss *sessions.State,
+	grant *impersonation.Grant,
+	grantErr error,
+	reply *evaluator.Result,
+) {
+	hattrs := in.GetAttributes().GetRequest().GetHttp()
+	fields := map[string]interface{}{
+		"service":            "authorize",
+		"audit":              true,
+		"request_id":         requestid.FromContext(ctx),
+		"session_id":         ss.ID,
+		"impersonate_email":  ss.ImpersonateEmail,
+		"impersonate_groups": ss.ImpersonateGroups,
+		"method":             hattrs.GetMethod(),
+		"path":               hattrs.GetPath(),
+		"host":               hattrs.GetHost(),
+	}
+	if grant != nil {
+		fields["user_id"] = grant.GetUserId()
+		fields["email"] = grant.GetEmail()
+		fields["approved_by"] = grant.GetApprovedBy()
+		if grant.GetExpiresAt() != nil {
+			fields["grant_expires_at"] = grant.GetExpiresAt().AsTime()
+		}
+	}
+	if grantErr != nil {
+		fields["impersonated"] = false
+		fields["impersonation_error"] = grantErr.Error()
+	} else {
+		fields["impersonated"] = true
+	}
+	var tenant string
+	if reply != nil {
+		fields["allow"] = reply.Status == http.StatusOK
+		fields["status"] = reply.Status
+		if reply.MatchingPolicy != nil {
+			tenant = reply.MatchingPolicy.Tenant
+		}
+	}
+	log.WithLegacyFields(fields)
+	log.Info().Fields(fields).Msg("authorize impersonated check")
+	audit.LogForTenant(tenant, "authorize impersonated check", fields)
+}
@@ -0,0 +1,65 @@
+package authorize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/impersonation"
+)
+
+func TestAuthorize_getImpersonationGrant(t *testing.T) {
+	now := time.Now()
+	a := &Authorize{dataBrokerData: make(evaluator.DataBrokerData)}
+	setGrant := func(grant *impersonation.Grant) {
+		data, err := anypb.New(grant)
+		require.NoError(t, err)
+		a.dataBrokerData.Update(&databroker.Record{Type: data.GetTypeUrl(), Id: grant.GetId(), Data: data})
+	}
+	setGrant(&impersonation.Grant{
+		Id:               "approved",
+		ImpersonateEmail: "user@example.com",
+		ApprovedAt:       timestamppb.New(now),
+		ExpiresAt:        timestamppb.New(now.Add(time.Hour)),
+	})
+	setGrant(&impersonation.Grant{
+		Id:               "pending",
+		ImpersonateEmail: "user@example.com",
+	})
+	setGrant(&impersonation.Grant{
+		Id:               "expired",
+		ImpersonateEmail: "user@example.com",
+		ApprovedAt:       timestamppb.New(now.Add(-2 * time.Hour)),
+		ExpiresAt:        timestamppb.New(now.Add(-time.Hour)),
+	})
+
+	for _, tc := range []struct {
+		name      string
+		sessionID string
+		email     string
+		expect    error
+	}{
+		{"approved", "approved", "user@example.com", nil},
+		{"different email", "approved", "other@example.com", impersonation.ErrMismatch},
+		{"pending", "pending", "user@example.com", impersonation.ErrPending},
+		{"expired", "expired", "user@example.com", impersonation.ErrExpired},
+		{"missing", "missing", "user@example.com", errImpersonationGrantNotFound},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := a.getImpersonationGrant(&sessions.State{ID: tc.sessionID, ImpersonateEmail: tc.email})
+			assert.Equal(t, tc.expect, err)
+		})
+	}
+
+	ss := &sessions.State{ID: "expired", ImpersonateEmail: "user@example.com", ImpersonateGroups: []string{"admins"}}
+	assert.False(t, withoutImpersonation(ss).Impersonating())
+	assert.True(t, ss.Impersonating(), "should not modify the original session")
+}
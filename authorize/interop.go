@@ -0,0 +1,90 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/interop"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+// An interopVerifier verifies the identity assertions of the configured
+// upstream zero-trust provider, so requests which passed through it don't
+// need a Pomerium session.
+type interopVerifier struct {
+	mu       sync.RWMutex
+	options  [5]string
+	verifier interop.Verifier
+}
+
+func newInteropVerifier() *interopVerifier {
+	return new(interopVerifier)
+}
+
+// Update sets the upstream provider. The verifier, and the keys it has
+// cached, are kept if the provider is unchanged.
+func (v *interopVerifier) Update(options *config.Options) {
+	key := [5]string{
+		options.InteropProvider,
+		options.InteropCloudflareTeamDomain,
+		options.InteropCloudflareAudience,
+		options.InteropAWSALBRegion,
+		options.InteropAWSALBARN,
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key == v.options {
+		return
+	}
+	v.options = key
+	verifier, err := interop.New(options)
+	if err != nil {
+		log.Error().Err(err).Msg("authorize: failed to create interop verifier")
+	}
+	v.verifier = verifier
+}
+
+// Verify returns the identity asserted by the request headers, or nil if
+// there's no provider or valid assertion.
+func (v *interopVerifier) Verify(ctx context.Context, headers http.Header) *interop.Identity {
+	v.mu.RLock()
+	verifier := v.verifier
+	v.mu.RUnlock()
+	if verifier == nil {
+		return nil
+	}
+
+	id, err := verifier.Verify(ctx, headers)
+	if errors.Is(err, interop.ErrNoAssertion) {
+		return nil
+	} else if err != nil {
+		log.Warn().Err(err).Msg("authorize: invalid interop assertion")
+		return nil
+	}
+	return id
+}
+
+// withInteropIdentity sets the session of the request to the upstream
+// identity.
+func withInteropIdentity(req *evaluator.Request, id *interop.Identity) {
+	req.Session = evaluator.RequestSession{
+		ID: id.UserID(),
+	}
+	if !id.IssuedAt.IsZero() {
+		req.Session.AuthTime = id.IssuedAt.Unix()
+	}
+	req.Interop = &evaluator.RequestInterop{
+		UserID: id.UserID(),
+		Email:  id.Email,
+		Groups: id.Groups,
+	}
+	if !id.ExpiresAt.IsZero() {
+		req.Interop.ExpiresAt = id.ExpiresAt.Unix()
+	}
+}
@@ -0,0 +1,75 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/interop"
+)
+
+type mockInteropVerifier struct {
+	id  *interop.Identity
+	err error
+}
+
+func (m mockInteropVerifier) Verify(context.Context, http.Header) (*interop.Identity, error) {
+	return m.id, m.err
+}
+
+func TestInteropVerifier(t *testing.T) {
+	v := newInteropVerifier()
+	assert.Nil(t, v.Verify(context.Background(), http.Header{}), "no provider")
+
+	options := &config.Options{
+		InteropProvider:             config.InteropProviderCloudflareAccess,
+		InteropCloudflareTeamDomain: "example.cloudflareaccess.com",
+		InteropCloudflareAudience:   "AUD",
+	}
+	v.Update(options)
+	verifier := v.verifier
+	assert.NotNil(t, verifier)
+	v.Update(options)
+	assert.True(t, verifier == v.verifier, "verifier should be kept if the provider is unchanged")
+	v.Update(&config.Options{})
+	assert.Nil(t, v.verifier)
+
+	id := &interop.Identity{Provider: config.InteropProviderCloudflareAccess, Subject: "SUBJECT"}
+	for _, tc := range []struct {
+		name   string
+		mock   mockInteropVerifier
+		expect *interop.Identity
+	}{
+		{"valid", mockInteropVerifier{id: id}, id},
+		{"no assertion", mockInteropVerifier{err: interop.ErrNoAssertion}, nil},
+		{"invalid", mockInteropVerifier{err: errors.New("invalid")}, nil},
+	} {
+		v.verifier = tc.mock
+		assert.Equal(t, tc.expect, v.Verify(context.Background(), http.Header{}), tc.name)
+	}
+}
+
+func TestWithInteropIdentity(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	req := &evaluator.Request{}
+	withInteropIdentity(req, &interop.Identity{
+		Provider:  config.InteropProviderAWSALB,
+		Subject:   "SUBJECT",
+		Email:     "user@example.com",
+		Groups:    []string{"engineering"},
+		ExpiresAt: now.Add(time.Minute),
+	})
+	assert.Equal(t, evaluator.RequestSession{ID: "interop/aws_alb/SUBJECT"}, req.Session)
+	assert.Equal(t, &evaluator.RequestInterop{
+		UserID:    "interop/aws_alb/SUBJECT",
+		Email:     "user@example.com",
+		Groups:    []string{"engineering"},
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	}, req.Interop)
+}
@@ -0,0 +1,51 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+)
+
+var kioskDeviceTypeURL string
+
+func init() {
+	any, _ := ptypes.MarshalAny(new(kiosk.Device))
+	kioskDeviceTypeURL = any.GetTypeUrl()
+}
+
+func (a *Authorize) forceSyncKioskDevice(ctx context.Context, deviceID string) *kiosk.Device {
+	ctx, span := trace.StartSpan(ctx, "authorize.forceSyncKioskDevice")
+	defer span.End()
+
+	a.dataBrokerDataLock.RLock()
+	d, ok := a.dataBrokerData.Get(kioskDeviceTypeURL, deviceID).(*kiosk.Device)
+	a.dataBrokerDataLock.RUnlock()
+	if ok {
+		return d
+	}
+
+	record, err := a.getDataBrokerRecord(ctx, kioskDeviceTypeURL, deviceID)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if errors.Is(err, errCircuitBreakerOpen) {
+		log.Debug().Err(err).Msg("skipped getting kiosk device from databroker")
+		return nil
+	} else if err != nil {
+		log.Warn().Err(err).Msg("failed to get kiosk device from databroker")
+		return nil
+	}
+
+	a.dataBrokerDataLock.Lock()
+	a.addForceSyncedRecord(record)
+	d, _ = a.dataBrokerData.Get(kioskDeviceTypeURL, deviceID).(*kiosk.Device)
+	a.dataBrokerDataLock.Unlock()
+
+	return d
+}
@@ -0,0 +1,93 @@
+package authorize
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// notFoundTTL is how long a record which wasn't found in the databroker is
+// remembered, so that requests with an unknown session don't each make a
+// databroker request.
+const notFoundTTL = 10 * time.Second
+
+// notFound remembers the records which weren't found in the databroker, by
+// type and id, until they expire or the record is synced.
+//
+// notFound is guarded by the dataBrokerDataLock.
+type notFound map[string]map[string]time.Time
+
+// add remembers that a record wasn't found.
+func (n *notFound) add(typeURL, id string) {
+	if *n == nil {
+		*n = make(notFound)
+	}
+
+	now := timeNow()
+	for recordType, byID := range *n {
+		for id, expiry := range byID {
+			if now.After(expiry) {
+				delete(byID, id)
+			}
+		}
+		if len(byID) == 0 {
+			delete(*n, recordType)
+		}
+	}
+
+	byID, ok := (*n)[typeURL]
+	if !ok {
+		byID = make(map[string]time.Time)
+		(*n)[typeURL] = byID
+	}
+	byID[id] = now.Add(notFoundTTL)
+}
+
+// remove forgets that a record wasn't found.
+func (n notFound) remove(typeURL, id string) {
+	delete(n[typeURL], id)
+}
+
+// clear forgets the records of a type which weren't found.
+func (n notFound) clear(typeURL string) {
+	delete(n, typeURL)
+}
+
+// has returns true if a record wasn't found recently.
+func (n notFound) has(typeURL, id string) bool {
+	expiry, ok := n[typeURL][id]
+	return ok && !timeNow().After(expiry)
+}
+
+// isLazyType returns true if the records of a type are loaded lazily.
+func (a *Authorize) isLazyType(typeURL string) bool {
+	return a.lazySessionLoading && isForceSyncedType(typeURL)
+}
+
+// updateLoadedRecord updates a record of a lazily loaded type. Only the
+// records which were already loaded by a force sync, or which were recently
+// not found, are kept, and deletions are always applied so that revoked
+// sessions are never allowed.
+//
+// A record updated while it's being loaded may be loaded at the version
+// before the update, which is kept until the record is updated again.
+func (a *Authorize) updateLoadedRecord(record *databroker.Record) {
+	if record.GetDeletedAt() != nil {
+		a.updateRecord(record)
+		return
+	}
+
+	a.dataBrokerDataLock.Lock()
+	defer a.dataBrokerDataLock.Unlock()
+
+	if a.dataBrokerData.Get(record.GetType(), record.GetId()) == nil &&
+		!a.notFound.has(record.GetType(), record.GetId()) {
+		return
+	}
+	a.dataBrokerData.Update(record)
+	a.updateDataBudget(record)
+	a.tombstones.remove(record)
+	a.notFound.remove(record.GetType(), record.GetId())
+	atomic.AddUint64(&a.dataBrokerDataVersion, 1)
+}
@@ -0,0 +1,85 @@
+package authorize
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestLazySessionLoading(t *testing.T) {
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	newRecord := func(id, version, userID string) *databroker.Record {
+		data, _ := anypb.New(&session.Session{Id: id, UserId: userID})
+		return &databroker.Record{Version: version, Type: sessionTypeURL, Id: id, Data: data}
+	}
+
+	var gets int32
+	a, err := New(&config.Options{
+		AuthenticateURL:             mustParseURL("https://authN.example.com"),
+		DataBrokerURL:               mustParseURL("https://cache.example.com"),
+		SharedKey:                   "gXK6ggrlIW2HyKyUF9rUO4azrDgxhDPWqw9y+lJU7B8=",
+		AuthorizeLazySessionLoading: true,
+	})
+	require.NoError(t, err)
+	a.dataBrokerClient = mockDataBrokerServiceClient{
+		get: func(ctx context.Context, in *databroker.GetRequest, opts ...grpc.CallOption) (*databroker.GetResponse, error) {
+			atomic.AddInt32(&gets, 1)
+			if in.GetId() != "session1" {
+				return nil, status.Error(codes.NotFound, "not found")
+			}
+			return &databroker.GetResponse{Record: newRecord("session1", "0001", "user1")}, nil
+		},
+	}
+	assert.True(t, a.isLazyType(sessionTypeURL))
+	assert.False(t, a.isLazyType("type.googleapis.com/directory.User"))
+
+	a.updateLoadedRecord(newRecord("session2", "0001", "user2"))
+	assert.Nil(t, a.dataBrokerData.Get(sessionTypeURL, "session2"), "records which weren't loaded should be ignored")
+
+	t.Run("not found", func(t *testing.T) {
+		assert.Nil(t, a.forceSyncSession(context.Background(), "session2"))
+		assert.Nil(t, a.forceSyncSession(context.Background(), "session2"))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&gets), "records which weren't found should be remembered")
+
+		now = now.Add(2 * notFoundTTL)
+		assert.Nil(t, a.forceSyncSession(context.Background(), "session2"))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&gets), "records which weren't found should be forgotten")
+
+		a.updateLoadedRecord(newRecord("session2", "0002", "user2"))
+		assert.NotNil(t, a.forceSyncSession(context.Background(), "session2"),
+			"records which weren't found should be loaded when they're synced")
+		assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+	})
+	t.Run("loaded", func(t *testing.T) {
+		s := a.forceSyncSession(context.Background(), "session1")
+		require.NotNil(t, s)
+		assert.Equal(t, "user1", s.GetUserId())
+
+		a.updateLoadedRecord(newRecord("session1", "0003", "user3"))
+		s = a.forceSyncSession(context.Background(), "session1")
+		require.NotNil(t, s)
+		assert.Equal(t, "user3", s.GetUserId(), "loaded records should be synced")
+
+		deleted := newRecord("session1", "0004", "user3")
+		deleted.DeletedAt = timestamppb.New(now)
+		a.updateLoadedRecord(deleted)
+		assert.Nil(t, a.dataBrokerData.Get(sessionTypeURL, "session1"), "deleted records should be removed")
+		assert.True(t, a.tombstones.isDeleted(newRecord("session1", "0001", "user1")))
+	})
+}
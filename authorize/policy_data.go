@@ -0,0 +1,127 @@
+package authorize
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/open-policy-agent/opa/util"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+// A policyDataWatcher loads external JSON or YAML documents into the rego
+// store as `data.policy_data.<name>`, where name is the file name without its
+// extension, and reloads them whenever they change on disk.
+type policyDataWatcher struct {
+	store *evaluator.Store
+
+	mu      sync.Mutex
+	files   []string
+	watcher *fsnotify.Watcher
+}
+
+func newPolicyDataWatcher(store *evaluator.Store) *policyDataWatcher {
+	return &policyDataWatcher{store: store}
+}
+
+// Update sets the files to load and watch.
+func (w *policyDataWatcher) Update(files []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if reflect.DeepEqual(files, w.files) {
+		return
+	}
+	w.files = files
+
+	if w.watcher != nil {
+		_ = w.watcher.Close()
+		w.watcher = nil
+	}
+	w.store.ClearPolicyData()
+
+	if len(files) == 0 {
+		return
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Error().Err(err).Msg("authorize: failed to create policy data file watcher")
+	} else {
+		w.watcher = watcher
+		// watch the directories instead of the files so that files replaced by
+		// editors or kubernetes config map updates are picked up
+		dirs := map[string]struct{}{}
+		for _, file := range files {
+			dirs[filepath.Dir(file)] = struct{}{}
+		}
+		for dir := range dirs {
+			if err := watcher.Add(dir); err != nil {
+				log.Error().Err(err).Str("dir", dir).Msg("authorize: failed to watch policy data directory")
+			}
+		}
+		go w.watch(watcher, files)
+	}
+
+	for _, file := range files {
+		w.load(file)
+	}
+}
+
+func (w *policyDataWatcher) watch(watcher *fsnotify.Watcher, files []string) {
+	for {
+		select {
+		case evt, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			for _, file := range files {
+				if filepath.Clean(evt.Name) == filepath.Clean(file) {
+					w.mu.Lock()
+					if w.watcher == watcher {
+						w.load(file)
+					}
+					w.mu.Unlock()
+				}
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Error().Err(err).Msg("authorize: policy data file watcher error")
+		}
+	}
+}
+
+func (w *policyDataWatcher) load(file string) {
+	value, err := readPolicyDataFile(file)
+	if err != nil {
+		log.Error().Err(err).Str("file", file).Msg("authorize: failed to load policy data file")
+		return
+	}
+	log.Info().Str("file", file).Msg("authorize: loaded policy data file")
+	w.store.UpdatePolicyData(getPolicyDataName(file), value)
+}
+
+func readPolicyDataFile(file string) (interface{}, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := util.Unmarshal(bs, &value); err != nil {
+		return nil, fmt.Errorf("invalid policy data: %w", err)
+	}
+	return value, nil
+}
+
+func getPolicyDataName(file string) string {
+	name := filepath.Base(file)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
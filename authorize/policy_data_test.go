@@ -0,0 +1,69 @@
+package authorize
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestPolicyDataWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pomerium-policy-data")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "settings.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("blocked: false\n"), 0600))
+
+	store := evaluator.NewStore()
+	w := newPolicyDataWatcher(store)
+	w.Update([]string{file})
+	defer w.Update(nil)
+
+	policy := config.Policy{
+		From:                             "https://from.example.com",
+		To:                               "https://to.example.com",
+		AllowPublicUnauthenticatedAccess: true,
+	}
+	require.NoError(t, policy.Validate())
+	e, err := evaluator.New(&config.Options{
+		AuthenticateURL: mustParseURL("https://authn.example.com"),
+		Policies:        []config.Policy{policy},
+	}, store)
+	require.NoError(t, err)
+
+	evaluate := func() int {
+		res, err := e.Evaluate(context.Background(), &evaluator.Request{
+			DataBrokerData: make(evaluator.DataBrokerData),
+			HTTP:           evaluator.RequestHTTP{Method: "GET", URL: "https://from.example.com/"},
+			CustomPolicies: []string{"allow { not data.policy_data.settings.blocked }"},
+		})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	assert.Equal(t, http.StatusOK, evaluate())
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("blocked: true\n"), 0600))
+	assert.Eventually(t, func() bool {
+		return evaluate() != http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond, "policy data should be reloaded")
+
+	w.Update(nil)
+	assert.Equal(t, http.StatusOK, evaluate(), "policy data should be removed")
+}
+
+func Test_getPolicyDataName(t *testing.T) {
+	assert.Equal(t, "org_chart", getPolicyDataName("/etc/pomerium/org_chart.yaml"))
+	assert.Equal(t, "schedule", getPolicyDataName("schedule.json"))
+	assert.Equal(t, "entitlements", getPolicyDataName("data/entitlements"))
+}
@@ -0,0 +1,28 @@
+package authorize
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+// getPriorityHeader returns the x-pomerium-priority header of an allowed
+// request to a route with priority groups. Envoy routes requests with the
+// header set to "high" to the upstream with a high priority, so the header is
+// always overwritten to stop clients from setting it themselves.
+func getPriorityHeader(reply *evaluator.Result) (*envoy_config_core_v3.HeaderValueOption, bool) {
+	policy := reply.MatchingPolicy
+	if policy == nil || len(policy.PriorityGroups) == 0 {
+		return nil, false
+	}
+
+	for _, group := range reply.UserGroups {
+		for _, priorityGroup := range policy.PriorityGroups {
+			if group == priorityGroup {
+				return mkHeader(httputil.HeaderPomeriumPriority, "high", false), true
+			}
+		}
+	}
+	return mkHeader(httputil.HeaderPomeriumPriority, "default", false), true
+}
@@ -0,0 +1,36 @@
+package authorize
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestGetPriorityHeader(t *testing.T) {
+	policy := &config.Policy{PriorityGroups: []string{"oncall"}}
+
+	_, ok := getPriorityHeader(&evaluator.Result{Status: http.StatusOK, MatchingPolicy: &config.Policy{}})
+	assert.False(t, ok, "routes without priority groups should be unaffected")
+
+	hdr, ok := getPriorityHeader(&evaluator.Result{
+		Status:         http.StatusOK,
+		MatchingPolicy: policy,
+		UserGroups:     []string{"users", "oncall"},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "x-pomerium-priority", hdr.GetHeader().GetKey())
+	assert.Equal(t, "high", hdr.GetHeader().GetValue())
+	assert.False(t, hdr.GetAppend().GetValue(), "the header should be overwritten")
+
+	hdr, ok = getPriorityHeader(&evaluator.Result{
+		Status:         http.StatusOK,
+		MatchingPolicy: policy,
+		UserGroups:     []string{"users"},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "default", hdr.GetHeader().GetValue())
+}
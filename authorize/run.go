@@ -3,6 +3,7 @@ package authorize
 import (
 	"context"
 	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
@@ -19,13 +20,19 @@ import (
 func (a *Authorize) Run(ctx context.Context) error {
 	eg, ctx := errgroup.WithContext(ctx)
 
-	updateTypes := make(chan []string)
-	eg.Go(func() error {
-		return a.runTypesSyncer(ctx, updateTypes)
-	})
+	if a.dataBrokerClient != nil {
+		updateTypes := make(chan []string)
+		eg.Go(func() error {
+			return a.runTypesSyncer(ctx, updateTypes)
+		})
+
+		eg.Go(func() error {
+			return a.runDataSyncer(ctx, updateTypes)
+		})
+	}
 
 	eg.Go(func() error {
-		return a.runDataSyncer(ctx, updateTypes)
+		return a.decisionLog.Run(ctx)
 	})
 
 	return eg.Wait()
@@ -87,33 +94,14 @@ func (a *Authorize) runDataSyncer(ctx context.Context, updateTypes <-chan []stri
 func (a *Authorize) runDataTypeSyncer(ctx context.Context, typeURL string) error {
 	var serverVersion, recordVersion string
 
-	log.Info().Str("type_url", typeURL).Msg("starting data initial load")
-	ctx, span := trace.StartSpan(ctx, "authorize.dataBrokerClient.GetAll")
-	backoff := backoff.NewExponentialBackOff()
-	for {
-		res, err := a.dataBrokerClient.GetAll(ctx, &databroker.GetAllRequest{
-			Type: typeURL,
-		})
-		if err != nil {
-			log.Warn().Err(err).Str("type_url", typeURL).Msg("error getting data")
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(backoff.NextBackOff()):
-			}
-			continue
-		}
-
-		serverVersion = res.GetServerVersion()
-		recordVersion = res.GetRecordVersion()
-
-		for _, record := range res.GetRecords() {
-			a.updateRecord(record)
-		}
-
-		break
+	// lazily loaded records aren't loaded up front, and only the changes to
+	// the ones which were loaded are synced
+	updateRecord := a.updateRecord
+	if a.isLazyType(typeURL) {
+		updateRecord = a.updateLoadedRecord
+	} else if err := a.loadDataType(ctx, typeURL, &serverVersion, &recordVersion); err != nil {
+		return err
 	}
-	span.End()
 
 	log.Info().Str("type_url", typeURL).Msg("starting data syncer")
 	return tryForever(ctx, func(backoff interface{ Reset() }) error {
@@ -154,16 +142,52 @@ func (a *Authorize) runDataTypeSyncer(ctx context.Context, typeURL string) error
 			}
 
 			for _, record := range res.GetRecords() {
-				a.updateRecord(record)
+				updateRecord(record)
 			}
 		}
 	})
 }
 
+// loadDataType loads all the records of a type.
+func (a *Authorize) loadDataType(ctx context.Context, typeURL string, serverVersion, recordVersion *string) error {
+	log.Info().Str("type_url", typeURL).Msg("starting data initial load")
+	ctx, span := trace.StartSpan(ctx, "authorize.dataBrokerClient.GetAll")
+	backoff := backoff.NewExponentialBackOff()
+	for {
+		res, err := a.dataBrokerClient.GetAll(ctx, &databroker.GetAllRequest{
+			Type: typeURL,
+		})
+		if err != nil {
+			log.Warn().Err(err).Str("type_url", typeURL).Msg("error getting data")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff.NextBackOff()):
+			}
+			continue
+		}
+
+		*serverVersion = res.GetServerVersion()
+		*recordVersion = res.GetRecordVersion()
+
+		for _, record := range res.GetRecords() {
+			a.updateRecord(record)
+		}
+
+		break
+	}
+	span.End()
+	return nil
+}
+
 func (a *Authorize) clearRecords(typeURL string) {
 	a.store.ClearRecords(typeURL)
 	a.dataBrokerDataLock.Lock()
 	a.dataBrokerData.Clear(typeURL)
+	a.tombstones.clear(typeURL)
+	a.dataBudget.clear(typeURL)
+	a.notFound.clear(typeURL)
+	atomic.AddUint64(&a.dataBrokerDataVersion, 1)
 	a.dataBrokerDataLock.Unlock()
 }
 
@@ -171,6 +195,14 @@ func (a *Authorize) updateRecord(record *databroker.Record) {
 	a.store.UpdateRecord(record)
 	a.dataBrokerDataLock.Lock()
 	a.dataBrokerData.Update(record)
+	a.updateDataBudget(record)
+	if record.GetDeletedAt() != nil {
+		a.tombstones.add(record)
+	} else {
+		a.tombstones.remove(record)
+		a.notFound.remove(record.GetType(), record.GetId())
+	}
+	atomic.AddUint64(&a.dataBrokerDataVersion, 1)
 	a.dataBrokerDataLock.Unlock()
 }
 
@@ -0,0 +1,61 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/serviceaccount"
+)
+
+var serviceAccountTypeURL string
+
+func init() {
+	any, _ := ptypes.MarshalAny(new(serviceaccount.ServiceAccount))
+	serviceAccountTypeURL = any.GetTypeUrl()
+}
+
+// forceSyncServiceAccount gets the service account signed in with a session
+// id which doesn't match a session. Deleting a service account revokes its
+// token, so a service account which isn't found is remembered like a session
+// which isn't found.
+func (a *Authorize) forceSyncServiceAccount(ctx context.Context, serviceAccountID string) *serviceaccount.ServiceAccount {
+	ctx, span := trace.StartSpan(ctx, "authorize.forceSyncServiceAccount")
+	defer span.End()
+
+	a.dataBrokerDataLock.RLock()
+	sa, ok := a.dataBrokerData.Get(serviceAccountTypeURL, serviceAccountID).(*serviceaccount.ServiceAccount)
+	notFound := a.notFound.has(serviceAccountTypeURL, serviceAccountID)
+	a.dataBrokerDataLock.RUnlock()
+	if ok {
+		return sa
+	} else if notFound {
+		return nil
+	}
+
+	record, err := a.getDataBrokerRecord(ctx, serviceAccountTypeURL, serviceAccountID)
+	if status.Code(err) == codes.NotFound {
+		a.dataBrokerDataLock.Lock()
+		a.notFound.add(serviceAccountTypeURL, serviceAccountID)
+		a.dataBrokerDataLock.Unlock()
+		return nil
+	} else if errors.Is(err, errCircuitBreakerOpen) {
+		log.Debug().Err(err).Msg("skipped getting service account from databroker")
+		return nil
+	} else if err != nil {
+		log.Warn().Err(err).Msg("failed to get service account from databroker")
+		return nil
+	}
+
+	a.dataBrokerDataLock.Lock()
+	a.addForceSyncedRecord(record)
+	sa, _ = a.dataBrokerData.Get(serviceAccountTypeURL, serviceAccountID).(*serviceaccount.ServiceAccount)
+	a.dataBrokerDataLock.Unlock()
+
+	return sa
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/encoding"
@@ -42,12 +44,15 @@ func loadRawSession(req *http.Request, options *config.Options, encoder encoding
 	return nil, sessions.ErrNoSessionFound
 }
 
-func loadSession(encoder encoding.MarshalUnmarshaler, rawJWT []byte) (*sessions.State, error) {
+func loadSession(encoder encoding.MarshalUnmarshaler, rawJWT []byte, clockSkew time.Duration) (*sessions.State, error) {
 	var s sessions.State
 	err := encoder.Unmarshal(rawJWT, &s)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.Validate(clockSkew); err != nil {
+		return nil, err
+	}
 	return &s, nil
 }
 
@@ -91,8 +96,13 @@ func (a *Authorize) getJWTClaimHeaders(options *config.Options, signedJWT string
 		return make(map[string]string), nil
 	}
 
+	pe := a.pe.Load()
+	if pe == nil {
+		return nil, errPolicyEvaluatorNotReady
+	}
+
 	var claims map[string]interface{}
-	payload, err := a.pe.ParseSignedJWT(signedJWT)
+	payload, err := pe.ParseSignedJWT(signedJWT)
 	if err != nil {
 		return nil, err
 	}
@@ -105,15 +115,43 @@ func (a *Authorize) getJWTClaimHeaders(options *config.Options, signedJWT string
 		if claim, ok := claims[name]; ok {
 			switch value := claim.(type) {
 			case string:
-				hdrs["x-pomerium-claim-"+name] = value
+				if options.JWTClaimHeaderMaxSize == 0 || len(value) <= options.JWTClaimHeaderMaxSize {
+					hdrs["x-pomerium-claim-"+name] = value
+				}
+			case bool:
+				hdrs["x-pomerium-claim-"+name] = strconv.FormatBool(value)
 			case []interface{}:
-				hdrs["x-pomerium-claim-"+name] = strings.Join(toSliceStrings(value), ",")
+				hdrs["x-pomerium-claim-"+name] = joinClaimValues(toSliceStrings(value), options.JWTClaimHeaderMaxSize)
 			}
 		}
 	}
 	return hdrs, nil
 }
 
+// joinClaimValues joins the values of a list claim with commas, keeping as
+// many of the leading values as fit in maxSize bytes. Values are never
+// truncated. There's no limit if maxSize is zero.
+func joinClaimValues(values []string, maxSize int) string {
+	if maxSize == 0 {
+		return strings.Join(values, ",")
+	}
+	var b strings.Builder
+	for _, value := range values {
+		size := len(value)
+		if b.Len() > 0 {
+			size++
+		}
+		if b.Len()+size > maxSize {
+			break
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(value)
+	}
+	return b.String()
+}
+
 func toSliceStrings(sliceIfaces []interface{}) []string {
 	sliceStrings := make([]string, 0, len(sliceIfaces))
 	for _, e := range sliceIfaces {
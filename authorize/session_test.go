@@ -4,10 +4,12 @@ import (
 	"net/url"
 	"regexp"
 	"testing"
+	"time"
 
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2/jwt"
 
 	"github.com/pomerium/pomerium/authorize/evaluator"
 	"github.com/pomerium/pomerium/config"
@@ -30,14 +32,15 @@ func TestLoadSession(t *testing.T) {
 		return
 	}
 
-	load := func(t *testing.T, hattrs *envoy_service_auth_v2.AttributeContext_HttpRequest) (*sessions.State, error) {
-		req := getHTTPRequestFromCheckRequest(&envoy_service_auth_v2.CheckRequest{
-			Attributes: &envoy_service_auth_v2.AttributeContext{
-				Request: &envoy_service_auth_v2.AttributeContext_Request{
+	load := func(t *testing.T, hattrs *envoy_service_auth_v3.AttributeContext_HttpRequest) (*sessions.State, error) {
+		in := &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
 					Http: hattrs,
 				},
 			},
-		})
+		}
+		req := getHTTPRequestFromCheckRequest(in, newCheckRequestHeaders(in))
 		raw, err := loadRawSession(req, opts, encoder)
 		if err != nil {
 			return nil, err
@@ -61,7 +64,7 @@ func TestLoadSession(t *testing.T) {
 		}
 		cookie := regexp.MustCompile(`^([^;]+)(;.*)?$`).ReplaceAllString(hdrs["Set-Cookie"], "$1")
 
-		hattrs := &envoy_service_auth_v2.AttributeContext_HttpRequest{
+		hattrs := &envoy_service_auth_v3.AttributeContext_HttpRequest{
 			Id:     "req-1",
 			Method: "GET",
 			Headers: map[string]string{
@@ -76,7 +79,7 @@ func TestLoadSession(t *testing.T) {
 		assert.NotNil(t, sess)
 	})
 	t.Run("header", func(t *testing.T) {
-		hattrs := &envoy_service_auth_v2.AttributeContext_HttpRequest{
+		hattrs := &envoy_service_auth_v3.AttributeContext_HttpRequest{
 			Id:     "req-1",
 			Method: "GET",
 			Headers: map[string]string{
@@ -91,7 +94,7 @@ func TestLoadSession(t *testing.T) {
 		assert.NotNil(t, sess)
 	})
 	t.Run("query param", func(t *testing.T) {
-		hattrs := &envoy_service_auth_v2.AttributeContext_HttpRequest{
+		hattrs := &envoy_service_auth_v3.AttributeContext_HttpRequest{
 			Id:     "req-1",
 			Method: "GET",
 			Path: "/hello/world?" + url.Values{
@@ -123,8 +126,8 @@ func TestAuthorize_getJWTClaimHeaders(t *testing.T) {
 	a.store = evaluator.NewStore()
 	pe, err := newPolicyEvaluator(opt, a.store)
 	require.NoError(t, err)
-	a.pe = pe
-	signedJWT, _ := a.pe.SignedJWT(a.pe.JWTPayload(&evaluator.Request{
+	a.pe.Store(pe)
+	signedJWT, _ := pe.SignedJWT(pe.JWTPayload(&evaluator.Request{
 		DataBrokerData: evaluator.DataBrokerData{
 			"type.googleapis.com/session.Session": map[string]interface{}{
 				"SESSION_ID": &session.Session{
@@ -165,19 +168,65 @@ func TestAuthorize_getJWTClaimHeaders(t *testing.T) {
 		name            string
 		signedJWT       string
 		jwtHeaders      []string
+		maxSize         int
 		expectedHeaders map[string]string
 	}{
-		{"good with email", signedJWT, []string{"email"}, map[string]string{"x-pomerium-claim-email": "foo@example.com"}},
-		{"good with groups", signedJWT, []string{"groups"}, map[string]string{"x-pomerium-claim-groups": "admin_id,test_id,admin,test"}},
-		{"empty signed JWT", "", nil, make(map[string]string)},
+		{"good with email", signedJWT, []string{"email"}, 0, map[string]string{"x-pomerium-claim-email": "foo@example.com"}},
+		{"good with groups", signedJWT, []string{"groups"}, 0, map[string]string{"x-pomerium-claim-groups": "admin_id,test_id,admin,test"}},
+		{"empty signed JWT", "", nil, 0, make(map[string]string)},
+		{"email at max size", signedJWT, []string{"email"}, 15, map[string]string{"x-pomerium-claim-email": "foo@example.com"}},
+		{"email over max size", signedJWT, []string{"email"}, 14, map[string]string{}},
+		{"groups at max size", signedJWT, []string{"groups"}, 27, map[string]string{"x-pomerium-claim-groups": "admin_id,test_id,admin,test"}},
+		{"groups over max size", signedJWT, []string{"groups"}, 26, map[string]string{"x-pomerium-claim-groups": "admin_id,test_id,admin"}},
+		{"groups under first value size", signedJWT, []string{"groups"}, 7, map[string]string{"x-pomerium-claim-groups": ""}},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			opt.JWTClaimsHeaders = tc.jwtHeaders
+			opt.JWTClaimHeaderMaxSize = tc.maxSize
 			gotHeaders, err := a.getJWTClaimHeaders(opt, tc.signedJWT)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expectedHeaders, gotHeaders)
 		})
 	}
 }
+
+func TestLoadSession_Expired(t *testing.T) {
+	encoder, err := jws.NewHS256Signer(nil, "example.com")
+	require.NoError(t, err)
+
+	rawjwt, err := encoder.Marshal(&sessions.State{
+		ID:     "xyz",
+		Expiry: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	})
+	require.NoError(t, err)
+	_, err = loadSession(encoder, rawjwt, 0)
+	assert.Equal(t, sessions.ErrExpired, err)
+	_, err = loadSession(encoder, rawjwt, 2*time.Minute)
+	assert.NoError(t, err, "the session should be valid within the clock skew")
+
+	rawjwt, err = encoder.Marshal(&sessions.State{
+		ID:     "xyz",
+		Expiry: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	})
+	require.NoError(t, err)
+	s, err := loadSession(encoder, rawjwt, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "xyz", s.ID)
+}
+
+func TestLoadSession_NotValidYet(t *testing.T) {
+	encoder, err := jws.NewHS256Signer(nil, "example.com")
+	require.NoError(t, err)
+
+	rawjwt, err := encoder.Marshal(&sessions.State{
+		ID:        "xyz",
+		NotBefore: jwt.NewNumericDate(time.Now().Add(30 * time.Second)),
+	})
+	require.NoError(t, err)
+	_, err = loadSession(encoder, rawjwt, 0)
+	assert.Equal(t, sessions.ErrNotValidYet, err)
+	_, err = loadSession(encoder, rawjwt, time.Minute)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,114 @@
+package authorize
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/standby"
+)
+
+// standbyState is whether the authorize service is on standby. On standby, it
+// keeps syncing the databroker data and building policies like an active
+// instance, but reports that it isn't ready, so load balancers don't send it
+// checks until it's activated.
+type standbyState struct {
+	mu        sync.Mutex
+	standby   bool
+	changedAt time.Time
+	onChange  []func(ready bool)
+}
+
+func newStandbyState(onStandby bool) *standbyState {
+	return &standbyState{standby: onStandby, changedAt: timeNow()}
+}
+
+// set switches modes, and calls the change callbacks if the mode changed.
+func (s *standbyState) set(onStandby bool) *standby.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.standby != onStandby {
+		s.standby = onStandby
+		s.changedAt = timeNow()
+		log.Info().Bool("standby", onStandby).Msg("authorize: switched modes")
+		for _, fn := range s.onChange {
+			fn(!onStandby)
+		}
+	}
+	return s.statusLocked()
+}
+
+func (s *standbyState) status() *standby.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.statusLocked()
+}
+
+func (s *standbyState) statusLocked() *standby.Status {
+	changedAt, _ := ptypes.TimestampProto(s.changedAt)
+	return &standby.Status{Standby: s.standby, ChangedAt: changedAt}
+}
+
+// Ready returns false if the authorize service is on standby.
+func (a *Authorize) Ready() bool {
+	return !a.standby.status().GetStandby()
+}
+
+// OnReadyChange calls fn with whether the authorize service is ready, now and
+// whenever it's activated or put on standby.
+func (a *Authorize) OnReadyChange(fn func(ready bool)) {
+	a.standby.mu.Lock()
+	defer a.standby.mu.Unlock()
+
+	a.standby.onChange = append(a.standby.onChange, fn)
+	fn(!a.standby.standby)
+}
+
+// StandbyServer returns the service which activates the authorize service or
+// puts it on standby. Requests must have a databroker admin token signed with
+// the shared secret.
+func (a *Authorize) StandbyServer() standby.StandbyServiceServer {
+	return standbyServer{a}
+}
+
+type standbyServer struct {
+	a *Authorize
+}
+
+func (srv standbyServer) authorize(ctx context.Context) error {
+	opts := srv.a.currentOptions.Load()
+	secret, _ := base64.StdEncoding.DecodeString(opts.SharedKey)
+	return internal_databroker.AuthorizeAdmin(ctx, secret, opts.ClockSkew)
+}
+
+// GetStatus returns whether the authorize service is on standby.
+func (srv standbyServer) GetStatus(ctx context.Context, _ *emptypb.Empty) (*standby.Status, error) {
+	if err := srv.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return srv.a.standby.status(), nil
+}
+
+// Activate makes the authorize service report that it's ready.
+func (srv standbyServer) Activate(ctx context.Context, _ *emptypb.Empty) (*standby.Status, error) {
+	if err := srv.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return srv.a.standby.set(false), nil
+}
+
+// Standby makes the authorize service report that it isn't ready.
+func (srv standbyServer) Standby(ctx context.Context, _ *emptypb.Empty) (*standby.Status, error) {
+	if err := srv.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return srv.a.standby.set(true), nil
+}
@@ -0,0 +1,74 @@
+package authorize
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/config"
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestStandby(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	opts := &config.Options{
+		AuthenticateURL:  mustParseURL("https://authN.example.com"),
+		DataBrokerURL:    mustParseURL("https://cache.example.com"),
+		SharedKey:        "gXK6ggrlIW2HyKyUF9rUO4azrDgxhDPWqw9y+lJU7B8=",
+		ClockSkew:        time.Minute,
+		AuthorizeStandby: true,
+	}
+	a, err := New(opts)
+	require.NoError(t, err)
+	a.OnConfigChange(&config.Config{Options: opts})
+
+	var readiness []bool
+	a.OnReadyChange(func(ready bool) { readiness = append(readiness, ready) })
+	assert.False(t, a.Ready())
+
+	secret, _ := base64.StdEncoding.DecodeString(opts.SharedKey)
+	token, err := internal_databroker.NewAdminToken(secret, time.Minute)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutil.JWTMetadataKey, token))
+	srv := a.StandbyServer()
+
+	_, err = srv.Activate(context.Background(), new(emptypb.Empty))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, a.Ready())
+
+	res, err := srv.GetStatus(ctx, new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.True(t, res.GetStandby())
+
+	now = now.Add(time.Hour)
+	res, err = srv.Activate(ctx, new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.False(t, res.GetStandby())
+	assert.Equal(t, now, res.GetChangedAt().AsTime())
+	assert.True(t, a.Ready())
+
+	// activating an active instance doesn't change anything
+	now = now.Add(time.Hour)
+	res, err = srv.Activate(ctx, new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-time.Hour), res.GetChangedAt().AsTime())
+
+	res, err = srv.Standby(ctx, new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.True(t, res.GetStandby())
+	assert.False(t, a.Ready())
+
+	assert.Equal(t, []bool{false, true, false}, readiness)
+}
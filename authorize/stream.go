@@ -0,0 +1,60 @@
+package authorize
+
+import (
+	"strings"
+	"time"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// headerEnvoyUpstreamRequestTimeout overrides the route timeout in envoy. The
+// header is only stripped from external requests, so headers added by the
+// authorize service are respected.
+const headerEnvoyUpstreamRequestTimeout = "x-envoy-upstream-rq-timeout-ms"
+
+// getStreamTimeout returns how long a streaming request, such as a websocket
+// or gRPC stream, may stay open before envoy terminates it. Once terminated,
+// the client has to reconnect, which re-evaluates the policy and denies
+// revoked or expired sessions. The timeout is the smaller of the stream
+// reauthorization interval, the time until the session expires and the
+// route's max stream duration.
+//
+// The data broker data lock must be held when calling this method.
+func (a *Authorize) getStreamTimeout(in *envoy_service_auth_v3.CheckRequest, req *evaluator.Request, policy *config.Policy) (time.Duration, bool) {
+	interval := a.currentOptions.Load().AuthorizeStreamReauthorizationInterval
+	if interval <= 0 || !isStreamingRequest(in) {
+		return 0, false
+	}
+
+	timeout := interval
+	if policy != nil && policy.MaxStreamDuration > 0 && policy.MaxStreamDuration < timeout {
+		timeout = policy.MaxStreamDuration
+	}
+	if s, ok := a.dataBrokerData.Get(sessionTypeURL, req.Session.ID).(*session.Session); ok {
+		if expiresAt, err := ptypes.Timestamp(s.GetExpiresAt()); err == nil {
+			if untilExpiry := expiresAt.Sub(timeNow()); untilExpiry < timeout {
+				timeout = untilExpiry
+			}
+		}
+	}
+	// a zero timeout disables the timeout in envoy
+	if timeout < time.Millisecond {
+		timeout = time.Millisecond
+	}
+	return timeout, true
+}
+
+// isStreamingRequest returns true if the request is a protocol upgrade, such
+// as a websocket, or a gRPC request, which may be a long-lived stream.
+func isStreamingRequest(in *envoy_service_auth_v3.CheckRequest) bool {
+	headers := in.GetAttributes().GetRequest().GetHttp().GetHeaders()
+	if headers["upgrade"] != "" {
+		return true
+	}
+	return strings.HasPrefix(headers["content-type"], "application/grpc")
+}
@@ -0,0 +1,64 @@
+package authorize
+
+import (
+	"testing"
+	"time"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestAuthorize_getStreamTimeout(t *testing.T) {
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	a := &Authorize{currentOptions: config.NewAtomicOptions(), dataBrokerData: make(evaluator.DataBrokerData)}
+	newCheckRequest := func(headers map[string]string) *envoy_service_auth_v3.CheckRequest {
+		return &envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Headers: headers,
+					},
+				},
+			},
+		}
+	}
+	websocket := newCheckRequest(map[string]string{"upgrade": "websocket"})
+	req := &evaluator.Request{Session: evaluator.RequestSession{ID: "session1"}}
+
+	_, ok := a.getStreamTimeout(websocket, req, nil)
+	assert.False(t, ok, "should be disabled by default")
+
+	a.currentOptions.Store(&config.Options{AuthorizeStreamReauthorizationInterval: 5 * time.Minute})
+
+	_, ok = a.getStreamTimeout(newCheckRequest(map[string]string{"content-type": "text/html"}), req, nil)
+	assert.False(t, ok, "should ignore non-streaming requests")
+
+	timeout, ok := a.getStreamTimeout(newCheckRequest(map[string]string{"content-type": "application/grpc+proto"}), req, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	timeout, ok = a.getStreamTimeout(websocket, req, &config.Policy{MaxStreamDuration: 2 * time.Minute})
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, timeout, "should use the route's max stream duration")
+
+	expiresAt, _ := ptypes.TimestampProto(now.Add(time.Minute))
+	a.dataBrokerData[sessionTypeURL] = map[string]interface{}{
+		"session1": &session.Session{Id: "session1", ExpiresAt: expiresAt},
+	}
+	timeout, ok = a.getStreamTimeout(websocket, req, nil)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, timeout, "should use the session expiry")
+
+	now = now.Add(2 * time.Minute)
+	timeout, ok = a.getStreamTimeout(websocket, req, nil)
+	assert.True(t, ok)
+	assert.Equal(t, time.Millisecond, timeout, "should terminate expired sessions immediately")
+}
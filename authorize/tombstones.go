@@ -0,0 +1,77 @@
+package authorize
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// tombstoneTTL is how long the deletion of a record is remembered. Records
+// fetched by a force sync are only ever stale by the duration of a
+// databroker request, so this only has to outlast those.
+const tombstoneTTL = 5 * time.Minute
+
+type tombstone struct {
+	version string
+	expiry  time.Time
+}
+
+// tombstones remember the versions of the records deleted by the databroker,
+// by type and id. A record fetched by a force sync before it was deleted may
+// arrive after the deletion was synced, and must not be added back, or a
+// revoked session would keep being allowed until it's synced again.
+//
+// tombstones are guarded by the dataBrokerDataLock.
+type tombstones map[string]map[string]tombstone
+
+// add remembers the deletion of a record.
+func (t tombstones) add(record *databroker.Record) {
+	now := timeNow()
+	for recordType, byID := range t {
+		for id, ts := range byID {
+			if now.After(ts.expiry) {
+				delete(byID, id)
+			}
+		}
+		if len(byID) == 0 {
+			delete(t, recordType)
+		}
+	}
+
+	byID, ok := t[record.GetType()]
+	if !ok {
+		byID = make(map[string]tombstone)
+		t[record.GetType()] = byID
+	}
+	byID[record.GetId()] = tombstone{version: record.GetVersion(), expiry: now.Add(tombstoneTTL)}
+}
+
+// remove forgets the deletion of a record, once a newer version is synced.
+func (t tombstones) remove(record *databroker.Record) {
+	delete(t[record.GetType()], record.GetId())
+}
+
+// clear forgets the deletions of the records of a type.
+func (t tombstones) clear(recordType string) {
+	delete(t, recordType)
+}
+
+// isDeleted returns true if the record was deleted at the same or a later
+// version.
+func (t tombstones) isDeleted(record *databroker.Record) bool {
+	ts, ok := t[record.GetType()][record.GetId()]
+	return ok && !timeNow().After(ts.expiry) && ts.version >= record.GetVersion()
+}
+
+// addForceSyncedRecord adds a record fetched from the databroker by a force
+// sync, unless a version of it was synced since, or it was deleted since. It
+// must be called with the dataBrokerDataLock held.
+func (a *Authorize) addForceSyncedRecord(record *databroker.Record) {
+	if a.dataBrokerData.Get(record.GetType(), record.GetId()) != nil || a.tombstones.isDeleted(record) {
+		return
+	}
+	a.dataBrokerData.Update(record)
+	a.updateDataBudget(record)
+	atomic.AddUint64(&a.dataBrokerDataVersion, 1)
+}
@@ -0,0 +1,61 @@
+package authorize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestTombstones(t *testing.T) {
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	a := &Authorize{
+		store:          evaluator.NewStore(),
+		dataBrokerData: make(evaluator.DataBrokerData),
+		tombstones:     make(tombstones),
+		dataBudget:     newDataBudget(0),
+	}
+	newRecord := func(version string, deleted bool) *databroker.Record {
+		data, _ := anypb.New(&session.Session{Id: "session1"})
+		record := &databroker.Record{Version: version, Type: sessionTypeURL, Id: "session1", Data: data}
+		if deleted {
+			record.DeletedAt = timestamppb.New(now)
+		}
+		return record
+	}
+	hasSession := func() bool {
+		return a.dataBrokerData.Get(sessionTypeURL, "session1") != nil
+	}
+
+	// a session fetched before it was revoked, which arrives after the
+	// revocation was synced
+	fetched := newRecord("0001", false)
+	a.updateRecord(newRecord("0002", true))
+	a.addForceSyncedRecord(fetched)
+	assert.False(t, hasSession(), "revoked sessions should not be added back")
+
+	a.addForceSyncedRecord(newRecord("0003", false))
+	assert.True(t, hasSession(), "newer versions should be added")
+
+	a.updateRecord(newRecord("0004", true))
+	a.updateRecord(newRecord("0005", false))
+	assert.Empty(t, a.tombstones[sessionTypeURL], "tombstones should be removed by newer versions")
+
+	a.updateRecord(newRecord("0006", true))
+	a.clearRecords(sessionTypeURL)
+	assert.Empty(t, a.tombstones, "tombstones should be cleared with their type")
+
+	a.updateRecord(newRecord("0007", true))
+	now = now.Add(2 * tombstoneTTL)
+	a.addForceSyncedRecord(newRecord("0001", false))
+	assert.True(t, hasSession(), "tombstones should expire")
+}
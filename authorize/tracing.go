@@ -0,0 +1,36 @@
+package authorize
+
+import (
+	"net/http"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+// denyForcedTrace denies an allowed request which forces tracing with the
+// x-pomerium-trace header, unless the user is in one of the tracing debug
+// groups. Envoy has already decided to trace the request by then, but a
+// denied request never reaches the upstream, so clients can't use the header
+// to flood the tracing backend.
+func (a *Authorize) denyForcedTrace(hdrs *checkRequestHeaders, reply *evaluator.Result) *evaluator.Result {
+	debugGroups := a.currentOptions.Load().TracingDebugGroups
+	if reply.Status != http.StatusOK || len(debugGroups) == 0 {
+		return reply
+	}
+	if _, ok := hdrs.Lookup(httputil.HeaderPomeriumTrace); !ok {
+		return reply
+	}
+	for _, group := range reply.UserGroups {
+		for _, debugGroup := range debugGroups {
+			if group == debugGroup {
+				return reply
+			}
+		}
+	}
+
+	cp := *reply
+	cp.Status = http.StatusForbidden
+	cp.Message = "tracing not allowed"
+	cp.DenyReason = evaluator.DenyReasonGroupMismatch
+	return &cp
+}
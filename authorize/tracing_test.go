@@ -0,0 +1,48 @@
+package authorize
+
+import (
+	"net/http"
+	"testing"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestAuthorize_denyForcedTrace(t *testing.T) {
+	a := &Authorize{currentOptions: config.NewAtomicOptions()}
+	a.currentOptions.Store(&config.Options{TracingDebugGroups: []string{"admins"}})
+
+	checkRequest := func(headers map[string]string) *checkRequestHeaders {
+		return newCheckRequestHeaders(&envoy_service_auth_v3.CheckRequest{
+			Attributes: &envoy_service_auth_v3.AttributeContext{
+				Request: &envoy_service_auth_v3.AttributeContext_Request{
+					Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+						Headers: headers,
+					},
+				},
+			},
+		})
+	}
+	traced := checkRequest(map[string]string{"x-pomerium-trace": "1"})
+	allowed := &evaluator.Result{Status: http.StatusOK, UserGroups: []string{"users"}}
+
+	assert.Equal(t, allowed, a.denyForcedTrace(checkRequest(nil), allowed), "requests without the header should be unaffected")
+	assert.Equal(t, http.StatusOK, a.denyForcedTrace(traced, &evaluator.Result{
+		Status:     http.StatusOK,
+		UserGroups: []string{"users", "admins"},
+	}).Status)
+
+	reply := a.denyForcedTrace(traced, allowed)
+	assert.Equal(t, http.StatusForbidden, reply.Status)
+	assert.Equal(t, evaluator.DenyReasonGroupMismatch, reply.DenyReason)
+	assert.Equal(t, http.StatusOK, allowed.Status, "the original result should not be modified")
+
+	denied := &evaluator.Result{Status: http.StatusUnauthorized}
+	assert.Equal(t, denied, a.denyForcedTrace(traced, denied))
+
+	a.currentOptions.Store(&config.Options{})
+	assert.Equal(t, allowed, a.denyForcedTrace(traced, allowed), "the header should be ignored without debug groups")
+}
@@ -0,0 +1,99 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	internal_upstreamtoken "github.com/pomerium/pomerium/internal/upstreamtoken"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+)
+
+var (
+	// upstreamTokenRefreshAhead is how long before its expiry a locally cached
+	// token is requested again. It's shorter than the databroker's, so by then
+	// the databroker returns a fresh token.
+	upstreamTokenRefreshAhead = internal_upstreamtoken.RefreshAhead / 2
+	upstreamTokenNow          = time.Now
+)
+
+// upstreamTokenCache caches the upstream tokens returned by the databroker,
+// so they're only requested again when they're about to expire.
+type upstreamTokenCache struct {
+	singleflight singleflight.Group
+
+	mu     sync.Mutex
+	tokens map[string]*upstreamtoken.Token
+}
+
+func newUpstreamTokenCache() *upstreamTokenCache {
+	return &upstreamTokenCache{tokens: make(map[string]*upstreamtoken.Token)}
+}
+
+func (c *upstreamTokenCache) get(
+	ctx context.Context,
+	client upstreamtoken.UpstreamTokenServiceClient,
+	req *upstreamtoken.GetTokenRequest,
+) (*upstreamtoken.Token, error) {
+	id := upstreamtoken.TokenID(req)
+
+	c.mu.Lock()
+	tok, ok := c.tokens[id]
+	c.mu.Unlock()
+	if ok && tok.ValidFor(upstreamTokenNow(), upstreamTokenRefreshAhead) {
+		return tok, nil
+	}
+
+	res, err, _ := c.singleflight.Do(id, func() (interface{}, error) {
+		tok, err := client.GetToken(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// drop the expired tokens of routes which have since been removed
+		now := upstreamTokenNow()
+		for id, tok := range c.tokens {
+			if !tok.ValidFor(now, 0) {
+				delete(c.tokens, id)
+			}
+		}
+		c.tokens[id] = tok
+		return tok, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*upstreamtoken.Token), nil
+}
+
+func (a *Authorize) getUpstreamOAuth2Headers(reply *evaluator.Result) ([]*envoy_config_core_v3.HeaderValueOption, error) {
+	if reply.MatchingPolicy == nil || reply.MatchingPolicy.UpstreamOAuth2 == nil {
+		return nil, nil
+	}
+	if a.upstreamTokenClient == nil {
+		return nil, errors.New("upstream oauth2 requires a databroker")
+	}
+
+	uo := reply.MatchingPolicy.UpstreamOAuth2
+	tok, err := a.upstreamTokens.get(context.Background(), a.upstreamTokenClient, &upstreamtoken.GetTokenRequest{
+		TokenUrl:     uo.TokenURL,
+		ClientId:     uo.ClientID,
+		ClientSecret: uo.ClientSecret,
+		Scopes:       uo.Scopes,
+		Audience:     uo.Audience,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*envoy_config_core_v3.HeaderValueOption{
+		mkHeader("Authorization", "Bearer "+tok.GetAccessToken(), false),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package authorize
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+)
+
+type mockUpstreamTokenClient struct {
+	calls    int
+	getToken func(req *upstreamtoken.GetTokenRequest) (*upstreamtoken.Token, error)
+}
+
+func (m *mockUpstreamTokenClient) GetToken(_ context.Context, req *upstreamtoken.GetTokenRequest, _ ...grpc.CallOption) (*upstreamtoken.Token, error) {
+	m.calls++
+	return m.getToken(req)
+}
+
+func TestAuthorize_getUpstreamOAuth2Headers(t *testing.T) {
+	now := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	upstreamTokenNow = func() time.Time { return now }
+	defer func() { upstreamTokenNow = time.Now }()
+
+	expiresAt, _ := ptypes.TimestampProto(now.Add(5 * time.Minute))
+	client := &mockUpstreamTokenClient{
+		getToken: func(req *upstreamtoken.GetTokenRequest) (*upstreamtoken.Token, error) {
+			assert.Equal(t, "CLIENT_ID", req.GetClientId())
+			assert.Equal(t, "https://api.example.com", req.GetAudience())
+			return &upstreamtoken.Token{AccessToken: "ACCESS_TOKEN", ExpiresAt: expiresAt}, nil
+		},
+	}
+	a := &Authorize{upstreamTokenClient: client, upstreamTokens: newUpstreamTokenCache()}
+	reply := &evaluator.Result{MatchingPolicy: &config.Policy{
+		UpstreamOAuth2: &config.UpstreamOAuth2{
+			ClientID:     "CLIENT_ID",
+			ClientSecret: "CLIENT_SECRET",
+			TokenURL:     "https://idp.example.com/oauth2/token",
+			Audience:     "https://api.example.com",
+		},
+	}}
+
+	hdrs, err := a.getUpstreamOAuth2Headers(reply)
+	require.NoError(t, err)
+	assert.Equal(t, []*envoy_config_core_v3.HeaderValueOption{
+		mkHeader("Authorization", "Bearer ACCESS_TOKEN", false),
+	}, hdrs)
+
+	// the token is cached until it's about to expire
+	now = now.Add(4 * time.Minute)
+	_, err = a.getUpstreamOAuth2Headers(reply)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.calls)
+
+	now = now.Add(45 * time.Second)
+	_, err = a.getUpstreamOAuth2Headers(reply)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.calls)
+
+	hdrs, err = a.getUpstreamOAuth2Headers(&evaluator.Result{MatchingPolicy: &config.Policy{}})
+	assert.NoError(t, err)
+	assert.Empty(t, hdrs)
+}
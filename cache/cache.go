@@ -5,6 +5,7 @@ package cache
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net"
 
@@ -15,17 +16,24 @@ import (
 	"github.com/pomerium/pomerium/internal/directory"
 	"github.com/pomerium/pomerium/internal/identity"
 	"github.com/pomerium/pomerium/internal/identity/manager"
+	internal_iplist "github.com/pomerium/pomerium/internal/iplist"
 	"github.com/pomerium/pomerium/internal/telemetry"
+	internal_upstreamtoken "github.com/pomerium/pomerium/internal/upstreamtoken"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
 )
 
 // Cache represents the cache service. The cache service is a simple interface
 // for storing keyed blobs (bytes) of unstructured data.
 type Cache struct {
-	dataBrokerServer *DataBrokerServer
-	manager          *manager.Manager
+	dataBrokerServer    *DataBrokerServer
+	dataBrokerACLServer databroker.DataBrokerServiceServer
+	upstreamTokenServer *internal_upstreamtoken.Server
+	ipListServer        *internal_iplist.Server
+	manager             *manager.Manager
 
 	localListener                net.Listener
 	localGRPCServer              *grpc.Server
@@ -45,7 +53,21 @@ func New(opts config.Options) (*Cache, error) {
 		return nil, fmt.Errorf("cache: failed to create authenticator: %w", err)
 	}
 
-	directoryProvider := directory.GetProvider(&opts)
+	authenticators := make(map[string]manager.Authenticator)
+	for i := range opts.IdentityProviders {
+		idp := &opts.IdentityProviders[i]
+		a, err := identity.NewAuthenticator(opts.GetOauthOptionsForIdentityProvider(idp))
+		if err != nil {
+			return nil, fmt.Errorf("cache: failed to create authenticator for identity provider %q: %w", idp.ID, err)
+		}
+		authenticators[idp.ID] = a
+	}
+
+	// the identity provider pushes directory changes with SCIM instead
+	var directoryProvider directory.Provider
+	if opts.SCIMBearerToken == "" {
+		directoryProvider = directory.GetProvider(&opts)
+	}
 
 	localListener, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
@@ -58,6 +80,9 @@ func New(opts config.Options) (*Cache, error) {
 
 	clientStatsHandler := telemetry.NewGRPCClientStatsHandler(opts.Services)
 	clientDialOptions := clientStatsHandler.DialOptions(grpc.WithInsecure())
+	if len(opts.DataBrokerClients) > 0 {
+		clientDialOptions = append(clientDialOptions, adminTokenDialOptions(opts)...)
+	}
 
 	localGRPCConnection, err := grpc.DialContext(
 		context.Background(),
@@ -73,18 +98,30 @@ func New(opts config.Options) (*Cache, error) {
 		return nil, err
 	}
 	dataBrokerClient := databroker.NewDataBrokerServiceClient(localGRPCConnection)
+	sharedKey, _ := base64.StdEncoding.DecodeString(opts.SharedKey)
 
+	managerOptions := []manager.Option{
+		manager.WithGroupRefreshInterval(opts.RefreshDirectoryInterval),
+		manager.WithGroupRefreshTimeout(opts.RefreshDirectoryTimeout),
+		manager.WithProviderName(opts.Provider),
+		manager.WithIdentityProviderAuthenticators(authenticators),
+	}
+	if len(opts.ClaimsMapping) > 0 {
+		managerOptions = append(managerOptions, manager.WithClaimsMapping(opts.ClaimsMapping.Apply))
+	}
 	manager := manager.New(
 		authenticator,
 		directoryProvider,
 		dataBrokerClient,
-		manager.WithGroupRefreshInterval(opts.RefreshDirectoryInterval),
-		manager.WithGroupRefreshTimeout(opts.RefreshDirectoryTimeout),
+		managerOptions...,
 	)
 
 	return &Cache{
-		dataBrokerServer: dataBrokerServer,
-		manager:          manager,
+		dataBrokerServer:    dataBrokerServer,
+		dataBrokerACLServer: newDataBrokerACLServer(dataBrokerServer, opts),
+		upstreamTokenServer: internal_upstreamtoken.New(dataBrokerClient),
+		ipListServer:        internal_iplist.New(dataBrokerClient, sharedKey, opts.ClockSkew),
+		manager:             manager,
 
 		localListener:                localListener,
 		localGRPCServer:              localGRPCServer,
@@ -96,13 +133,19 @@ func New(opts config.Options) (*Cache, error) {
 
 // Register registers all the gRPC services with the given server.
 func (c *Cache) Register(grpcServer *grpc.Server) {
-	databroker.RegisterDataBrokerServiceServer(grpcServer, c.dataBrokerServer)
+	databroker.RegisterDataBrokerServiceServer(grpcServer, c.dataBrokerACLServer)
+	upstreamtoken.RegisterUpstreamTokenServiceServer(grpcServer, c.upstreamTokenServer)
+	iplist.RegisterIPListServiceServer(grpcServer, c.ipListServer)
 }
 
 // Run runs the cache components.
 func (c *Cache) Run(ctx context.Context) error {
 	t, ctx := tomb.WithContext(ctx)
-	if c.dataBrokerStorageType == config.StorageInMemoryName {
+	if elector := c.dataBrokerServer.elector; elector != nil {
+		t.Go(func() error {
+			return elector.Run(ctx)
+		})
+	} else if c.dataBrokerStorageType == config.StorageInMemoryName {
 		t.Go(func() error {
 			return c.runMemberList(ctx)
 		})
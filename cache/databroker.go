@@ -1,24 +1,36 @@
 package cache
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/pomerium/pomerium/config"
 	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/election"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 )
 
+// adminTokenTTL is how long the admin tokens sent with the requests of the
+// identity manager are valid for.
+const adminTokenTTL = 5 * time.Minute
+
 // A DataBrokerServer implements the data broker service interface.
 type DataBrokerServer struct {
 	databroker.DataBrokerServiceServer
+
+	// elector elects the leader requests are forwarded to, if leader
+	// election is enabled.
+	elector *election.Elector
 }
 
 // NewDataBrokerServer creates a new databroker service server.
@@ -27,6 +39,10 @@ func NewDataBrokerServer(grpcServer *grpc.Server, opts config.Options) (*DataBro
 	if err != nil || len(key) != cryptutil.DefaultKeySize {
 		return nil, fmt.Errorf("shared key is required and must be %d bytes long", cryptutil.DefaultKeySize)
 	}
+	encryptionKeys, err := opts.GetDataBrokerEncryptionKeys()
+	if err != nil {
+		return nil, err
+	}
 
 	caCertPool := x509.NewCertPool()
 	if caCert, err := ioutil.ReadFile(opts.DataBrokerStorageCAFile); err == nil {
@@ -45,11 +61,80 @@ func NewDataBrokerServer(grpcServer *grpc.Server, opts config.Options) (*DataBro
 
 	internalSrv := internal_databroker.New(
 		internal_databroker.WithSecret(key),
+		internal_databroker.WithEncryptionKeys(encryptionKeys...),
 		internal_databroker.WithStorageType(opts.DataBrokerStorageType),
 		internal_databroker.WithStorageConnectionString(opts.DataBrokerStorageConnectionString),
 		internal_databroker.WithStorageTLSConfig(tlsConfig),
+		internal_databroker.WithRecordTTLs(opts.GetDataBrokerRecordTTLs()),
+		internal_databroker.WithClockSkew(opts.ClockSkew),
+		internal_databroker.WithHistorySize(opts.DataBrokerHistorySize),
+		internal_databroker.WithHistoryRetention(opts.DataBrokerHistoryRetention),
+		internal_databroker.WithCompressionThreshold(opts.DataBrokerCompressionThreshold),
+		internal_databroker.WithStorageMaxRecords(opts.DataBrokerStorageMaxRecords),
+		internal_databroker.WithStorageMaxBytes(opts.DataBrokerStorageMaxBytes),
+		internal_databroker.WithRecordValidation(opts.DataBrokerRecordValidation),
 	)
-	srv := &DataBrokerServer{DataBrokerServiceServer: internalSrv}
+	local := internal_databroker.NewNamespacedServer(internalSrv, opts.DataBrokerNamespace)
+	srv := &DataBrokerServer{DataBrokerServiceServer: local}
+	srv.elector, err = newElector(opts, tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create databroker leader elector: %w", err)
+	}
+	if srv.elector != nil {
+		srv.DataBrokerServiceServer = internal_databroker.NewForwarder(local, srv.elector, dialLeader(opts))
+	}
 	databroker.RegisterDataBrokerServiceServer(grpcServer, srv)
 	return srv, nil
 }
+
+// newDataBrokerACLServer returns the databroker server the other services
+// connect to. If there are databroker clients, it only allows them, and only
+// to the namespaces and record types of their ACLs. The identity manager
+// connects to the databroker directly, so it isn't subject to them.
+func newDataBrokerACLServer(srv databroker.DataBrokerServiceServer, opts config.Options) databroker.DataBrokerServiceServer {
+	if len(opts.DataBrokerClients) == 0 {
+		return srv
+	}
+	clients := make(map[string]*internal_databroker.ClientACL, len(opts.DataBrokerClients))
+	for _, c := range opts.DataBrokerClients {
+		secret, _ := base64.StdEncoding.DecodeString(c.Secret)
+		clients[c.ID] = &internal_databroker.ClientACL{
+			Secret:     secret,
+			Namespaces: c.Namespaces,
+			ReadTypes:  c.ReadTypes,
+			WriteTypes: c.WriteTypes,
+		}
+	}
+	sharedKey, _ := base64.StdEncoding.DecodeString(opts.SharedKey)
+	return internal_databroker.NewACLServer(srv, clients, opts.DataBrokerNamespace, sharedKey, opts.ClockSkew)
+}
+
+// adminTokenDialOptions returns dial options which send an admin token with
+// every request of the identity manager, so that they're allowed by the ACLs
+// of the databroker leader when they're forwarded to it.
+func adminTokenDialOptions(opts config.Options) []grpc.DialOption {
+	sharedKey, _ := base64.StdEncoding.DecodeString(opts.SharedKey)
+	withAdminToken := func(ctx context.Context) (context.Context, error) {
+		rawJWT, err := internal_databroker.NewAdminToken(sharedKey, adminTokenTTL)
+		if err != nil {
+			return nil, err
+		}
+		return grpcutil.WithOutgoingJWT(ctx, rawJWT), nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			ctx, err := withAdminToken(ctx)
+			if err != nil {
+				return err
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			ctx, err := withAdminToken(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return streamer(ctx, desc, cc, method, opts...)
+		}),
+	}
+}
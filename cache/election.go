@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/election"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// newElector creates the elector of the databroker leader, or returns nil if
+// leader election is disabled.
+func newElector(opts config.Options, storageTLSConfig *tls.Config) (*election.Elector, error) {
+	var lock election.Lock
+	switch opts.DataBrokerLeaderElection {
+	case "":
+		return nil, nil
+	case config.LeaderElectionKubernetes:
+		var err error
+		lock, err = election.NewKubernetesLock(opts.DataBrokerLeaderLeaseName)
+		if err != nil {
+			return nil, err
+		}
+	case config.LeaderElectionStorage:
+		lock = election.NewRedisLock(opts.DataBrokerStorageConnectionString, opts.DataBrokerLeaderLeaseName, storageTLSConfig)
+	default:
+		return nil, fmt.Errorf("unknown leader election %q", opts.DataBrokerLeaderElection)
+	}
+	return election.New(lock, opts.DataBrokerAdvertiseURL, opts.DataBrokerLeaderLeaseTTL), nil
+}
+
+// dialLeader returns a databroker client for the leader with the given
+// advertised URL.
+func dialLeader(opts config.Options) func(leader string) (databroker.DataBrokerServiceClient, error) {
+	return func(leader string) (databroker.DataBrokerServiceClient, error) {
+		u, err := urlutil.ParseAndValidateURL(leader)
+		if err != nil {
+			return nil, err
+		}
+		cc, err := grpc.GetGRPCClientConn("databroker-leader", &grpc.Options{
+			Addr:                    u,
+			OverrideCertificateName: opts.OverrideCertificateName,
+			CA:                      opts.CA,
+			CAFile:                  opts.CAFile,
+			RequestTimeout:          opts.GRPCClientTimeout,
+			WithInsecure:            opts.GRPCInsecure,
+			ServiceName:             opts.Services,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return databroker.NewDataBrokerServiceClient(cc), nil
+	}
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"os"
 
 	"github.com/pomerium/pomerium/internal/cmd/pomerium"
 	"github.com/pomerium/pomerium/internal/log"
@@ -25,5 +27,121 @@ func run(ctx context.Context) error {
 		fmt.Println(version.FullVersion())
 		return nil
 	}
+	if flag.Arg(0) == "policy" {
+		return runPolicy(ctx, flag.Args()[1:])
+	}
+	if flag.Arg(0) == "audit" {
+		return runAudit(flag.Args()[1:])
+	}
+	if flag.Arg(0) == "sidecar" {
+		return runSidecar(ctx, flag.Args()[1:])
+	}
+	if flag.Arg(0) == "databroker" {
+		return runDataBroker(ctx, flag.Args()[1:])
+	}
+	if flag.Arg(0) == "service-account" {
+		return runServiceAccount(ctx, flag.Args()[1:])
+	}
+	if flag.Arg(0) == "bundle" {
+		return runBundle(flag.Args()[1:])
+	}
 	return pomerium.Run(ctx, *configFile)
 }
+
+func runPolicy(ctx context.Context, args []string) error {
+	if len(args) == 0 || args[0] != "test" {
+		return errors.New("usage: pomerium policy test [-config <config file>] <fixtures file>")
+	}
+
+	fs := flag.NewFlagSet("policy test", flag.ExitOnError)
+	policyConfigFile := fs.String("config", *configFile, "Specify configuration file location")
+	_ = fs.Parse(args[1:])
+	if fs.NArg() != 1 {
+		return errors.New("usage: pomerium policy test [-config <config file>] <fixtures file>")
+	}
+
+	return pomerium.RunPolicyTest(ctx, *policyConfigFile, fs.Arg(0), os.Stdout)
+}
+
+func runAudit(args []string) error {
+	const usage = "usage: pomerium audit verify [-config <config file>] [-public-key <public key file>] <audit log file>"
+	if len(args) == 0 || args[0] != "verify" {
+		return errors.New(usage)
+	}
+
+	fs := flag.NewFlagSet("audit verify", flag.ExitOnError)
+	auditConfigFile := fs.String("config", *configFile, "Specify configuration file location")
+	publicKeyFile := fs.String("public-key", "", "Specify the PEM-encoded public key of the audit log signing key")
+	_ = fs.Parse(args[1:])
+	if fs.NArg() != 1 {
+		return errors.New(usage)
+	}
+
+	return pomerium.RunAuditVerify(*auditConfigFile, *publicKeyFile, fs.Arg(0), os.Stdout)
+}
+
+func runSidecar(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("sidecar", flag.ExitOnError)
+	sidecarConfigFile := fs.String("config", *configFile, "Specify configuration file location")
+	route := fs.String("route", "", "Specify the route to protect as <from>=<to>")
+	_ = fs.Parse(args)
+	if fs.NArg() != 0 {
+		return errors.New("usage: pomerium sidecar [-config <config file>] [-route <from>=<to>]")
+	}
+
+	return pomerium.RunSidecar(ctx, *sidecarConfigFile, *route)
+}
+
+func runDataBroker(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("databroker", flag.ExitOnError)
+	dataBrokerConfigFile := fs.String("config", *configFile, "Specify configuration file location")
+	dataBrokerURL := fs.String("databroker-url", "", "Specify the databroker url, instead of the one in the config file")
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		return errors.New(pomerium.DataBrokerUsage)
+	}
+
+	client, secret, err := pomerium.NewDataBrokerClient(*dataBrokerConfigFile, *dataBrokerURL)
+	if err != nil {
+		return err
+	}
+	return pomerium.RunDataBroker(ctx, client, secret, fs.Args(), os.Stdout)
+}
+
+func runServiceAccount(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("service-account", flag.ExitOnError)
+	serviceAccountConfigFile := fs.String("config", *configFile, "Specify configuration file location")
+	dataBrokerURL := fs.String("databroker-url", "", "Specify the databroker url, instead of the one in the config file")
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		return errors.New(pomerium.ServiceAccountUsage)
+	}
+
+	client, secret, err := pomerium.NewDataBrokerClient(*serviceAccountConfigFile, *dataBrokerURL)
+	if err != nil {
+		return err
+	}
+	signer, err := pomerium.NewServiceAccountSigner(*serviceAccountConfigFile)
+	if err != nil {
+		return err
+	}
+	return pomerium.RunServiceAccount(ctx, client, secret, signer, fs.Args(), os.Stdout)
+}
+
+func runBundle(args []string) error {
+	const usage = "usage: pomerium bundle build -version <version> -signing-key <private key file> [-out <bundle file>] <policy file>"
+	if len(args) == 0 || args[0] != "build" {
+		return errors.New(usage)
+	}
+
+	fs := flag.NewFlagSet("bundle build", flag.ExitOnError)
+	version := fs.String("version", "", "Specify the bundle version, e.g. a git commit or tag")
+	signingKeyFile := fs.String("signing-key", "", "Specify the PEM-encoded private key used to sign the bundle")
+	bundleFile := fs.String("out", "policy-bundle.tar", "Specify the bundle file location")
+	_ = fs.Parse(args[1:])
+	if fs.NArg() != 1 || *version == "" || *signingKeyFile == "" {
+		return errors.New(usage)
+	}
+
+	return pomerium.RunBundleBuild(fs.Arg(0), *signingKeyFile, *version, *bundleFile, os.Stdout)
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"reflect"
+	"sync"
+
+	"github.com/pomerium/pomerium/internal/audit"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// The AuditLogManager configures audit logging based on options.
+type AuditLogManager struct {
+	mu          sync.Mutex
+	file        string
+	signingKey  string
+	tenantFiles map[string]string
+}
+
+// NewAuditLogManager creates a new AuditLogManager.
+func NewAuditLogManager(src Source) *AuditLogManager {
+	mgr := &AuditLogManager{}
+	src.OnConfigChange(mgr.OnConfigChange)
+	mgr.OnConfigChange(src.GetConfig())
+	return mgr
+}
+
+// Close closes the audit log manager.
+func (mgr *AuditLogManager) Close() error {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	closeTenantWriters(audit.SetTenantWriters(nil))
+	if prev := audit.SetWriter(nil); prev != nil {
+		return prev.Close()
+	}
+	return nil
+}
+
+// OnConfigChange is called whenever configuration changes.
+func (mgr *AuditLogManager) OnConfigChange(cfg *Config) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	tenantFiles := make(map[string]string)
+	for _, tenant := range cfg.Options.Tenants {
+		if tenant.AuditLogFile != "" {
+			tenantFiles[tenant.Name] = tenant.AuditLogFile
+		}
+	}
+	if cfg.Options.AuditLogFile == mgr.file &&
+		cfg.Options.AuditLogSigningKey == mgr.signingKey &&
+		reflect.DeepEqual(tenantFiles, mgr.tenantFiles) {
+		return
+	}
+	mgr.file = cfg.Options.AuditLogFile
+	mgr.signingKey = cfg.Options.AuditLogSigningKey
+	mgr.tenantFiles = tenantFiles
+
+	var key *ecdsa.PrivateKey
+	if mgr.file != "" || len(mgr.tenantFiles) > 0 {
+		var err error
+		key, err = cfg.Options.GetAuditLogSigningKey()
+		if err != nil {
+			log.Error().Err(err).Msg("config: invalid audit log signing key")
+			return
+		}
+	}
+
+	var w *audit.Writer
+	if mgr.file != "" {
+		var err error
+		w, err = audit.NewWriter(mgr.file, key)
+		if err != nil {
+			log.Error().Err(err).Str("file", mgr.file).Msg("config: failed to open audit log")
+			return
+		}
+		pubKey, _ := cryptutil.EncodePublicKey(&key.PublicKey)
+		log.Info().Str("file", mgr.file).Str("public-key", string(pubKey)).Msg("config: writing audit log")
+	}
+
+	tenantWriters := make(map[string]*audit.Writer, len(mgr.tenantFiles))
+	for tenant, file := range mgr.tenantFiles {
+		tw, err := audit.NewWriter(file, key)
+		if err != nil {
+			log.Error().Err(err).Str("tenant", tenant).Str("file", file).Msg("config: failed to open tenant audit log")
+			continue
+		}
+		tenantWriters[tenant] = tw
+		log.Info().Str("tenant", tenant).Str("file", file).Msg("config: writing tenant audit log")
+	}
+
+	closeTenantWriters(audit.SetTenantWriters(tenantWriters))
+	if prev := audit.SetWriter(w); prev != nil {
+		if err := prev.Close(); err != nil {
+			log.Error().Err(err).Msg("config: failed to close audit log")
+		}
+	}
+}
+
+func closeTenantWriters(ws map[string]*audit.Writer) {
+	for tenant, w := range ws {
+		if err := w.Close(); err != nil {
+			log.Error().Err(err).Str("tenant", tenant).Msg("config: failed to close tenant audit log")
+		}
+	}
+}
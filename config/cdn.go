@@ -0,0 +1,132 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/cdn"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+// defaultCDNTTL is how long signed CDN URLs and cookies are valid by default.
+const defaultCDNTTL = time.Hour
+
+// CDN serves a route's assets from a content delivery network. Authorized
+// requests are redirected to the same path on the CDN, with a signed URL so
+// the CDN serves them without asking Pomerium, and optionally signed cookies
+// for every asset on the CDN.
+type CDN struct {
+	// Provider is the type of signed requests, either "cloudfront" or
+	// "cloud_cdn".
+	Provider string `mapstructure:"provider" yaml:"provider" json:"provider"`
+	// URL is the base URL of the assets on the CDN.
+	URL string `mapstructure:"url" yaml:"url" json:"url"`
+	// KeyID is the CloudFront public key ID, or the Cloud CDN key name.
+	KeyID string `mapstructure:"key_id" yaml:"key_id" json:"key_id"`
+	// Key is the base64 encoded PEM RSA private key for CloudFront, or the
+	// base64url encoded key for Cloud CDN.
+	Key string `mapstructure:"key" yaml:"key,omitempty" json:"-"`
+	// KeyFile is a file containing the PEM RSA private key for CloudFront,
+	// or the base64url encoded key for Cloud CDN.
+	KeyFile string `mapstructure:"key_file" yaml:"key_file,omitempty" json:"key_file,omitempty"`
+	// TTL is how long signed URLs and cookies are valid.
+	TTL time.Duration `mapstructure:"ttl" yaml:"ttl,omitempty" json:"ttl,omitempty"`
+	// CookieDomain, if set, is the domain of signed cookies which are set for
+	// every URL on the CDN. It must be a parent domain of both the route and
+	// the CDN.
+	CookieDomain string `mapstructure:"cookie_domain" yaml:"cookie_domain,omitempty" json:"cookie_domain,omitempty"`
+
+	baseURL *url.URL
+	signer  cdn.Signer
+}
+
+// Validate checks the validity of the CDN settings, and loads the signing
+// key.
+func (c *CDN) Validate() error {
+	var err error
+	c.baseURL, err = urlutil.ParseAndValidateURL(c.URL)
+	if err != nil {
+		return fmt.Errorf("config: cdn bad `url`: %w", err)
+	}
+	if c.KeyID == "" {
+		return fmt.Errorf("config: cdn `key_id` is required")
+	}
+	if c.TTL < 0 {
+		return fmt.Errorf("config: cdn `ttl` must not be negative")
+	}
+
+	var key []byte
+	switch {
+	case c.Key != "" && c.KeyFile != "":
+		return fmt.Errorf("config: specified both `key` and `key_file` for cdn")
+	case c.KeyFile != "":
+		key, err = ioutil.ReadFile(c.KeyFile)
+		if err != nil {
+			return fmt.Errorf("config: failed to load cdn key file: %w", err)
+		}
+	case c.Key != "":
+		key = []byte(c.Key)
+	default:
+		return fmt.Errorf("config: cdn `key` or `key_file` is required")
+	}
+
+	switch c.Provider {
+	case cdn.CloudFront:
+		if c.KeyFile == "" {
+			key, err = base64.StdEncoding.DecodeString(c.Key)
+			if err != nil {
+				return fmt.Errorf("config: couldn't decode cdn key: %w", err)
+			}
+		}
+		c.signer, err = cdn.NewCloudFrontSigner(c.KeyID, key)
+	case cdn.CloudCDN:
+		c.signer, err = cdn.NewCloudCDNSigner(c.KeyID, string(key))
+	default:
+		return fmt.Errorf("config: unknown cdn provider %q, must be %q or %q", c.Provider, cdn.CloudFront, cdn.CloudCDN)
+	}
+	if err != nil {
+		return fmt.Errorf("config: invalid cdn key: %w", err)
+	}
+	return nil
+}
+
+// GetURL returns the URL of the escaped path on the CDN, keeping the query
+// string. The path stays escaped the way the client sent it, since the CDN
+// checks signatures against the URL it's requested with.
+func (c *CDN) GetURL(escapedPath, rawQuery string) *url.URL {
+	u := *c.baseURL
+	rawPath := strings.TrimSuffix(u.EscapedPath(), "/") + "/" + strings.TrimPrefix(escapedPath, "/")
+	if p, err := url.PathUnescape(rawPath); err == nil {
+		u.Path, u.RawPath = p, rawPath
+	} else {
+		u.Path, u.RawPath = rawPath, ""
+	}
+	u.RawQuery = rawQuery
+	return &u
+}
+
+// GetBaseURL returns the base URL of the assets on the CDN.
+func (c *CDN) GetBaseURL() *url.URL {
+	u := *c.baseURL
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return &u
+}
+
+// GetTTL returns how long signed URLs and cookies are valid.
+func (c *CDN) GetTTL() time.Duration {
+	if c.TTL == 0 {
+		return defaultCDNTTL
+	}
+	return c.TTL
+}
+
+// Signer returns the signer for the CDN.
+func (c *CDN) Signer() cdn.Signer {
+	return c.signer
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCDN(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	f, err := ioutil.TempFile("", "cdn-key")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.Remove(f.Name()) })
+	_, err = f.Write(pemKey)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	t.Run("cloudfront key", func(t *testing.T) {
+		c := &CDN{Provider: "cloudfront", URL: "https://d111111abcdef8.cloudfront.net", KeyID: "K2JCJMDEHXQW5F", Key: base64.StdEncoding.EncodeToString(pemKey)}
+		require.NoError(t, c.Validate())
+		assert.NotNil(t, c.Signer())
+	})
+	t.Run("cloudfront key file", func(t *testing.T) {
+		c := &CDN{Provider: "cloudfront", URL: "https://d111111abcdef8.cloudfront.net", KeyID: "K2JCJMDEHXQW5F", KeyFile: f.Name()}
+		require.NoError(t, c.Validate())
+		assert.NotNil(t, c.Signer())
+	})
+	t.Run("urls", func(t *testing.T) {
+		c := &CDN{Provider: "cloud_cdn", URL: "https://cdn.corp.example/assets/", KeyID: "my-key", Key: "nZtRohdNF9m3cKM24IcK4w=="}
+		require.NoError(t, c.Validate())
+		assert.Equal(t, "https://cdn.corp.example/assets/", c.GetBaseURL().String())
+		assert.Equal(t, "https://cdn.corp.example/assets/img/logo%20big.png?v=1", c.GetURL("/img/logo%20big.png", "v=1").String())
+		assert.Equal(t, "https://cdn.corp.example/assets/a%2Fb", c.GetURL("/a%2Fb", "").String())
+		assert.Equal(t, "https://cdn.corp.example/assets/", c.GetURL("/", "").String())
+
+		c = &CDN{Provider: "cloud_cdn", URL: "https://cdn.corp.example", KeyID: "my-key", Key: "nZtRohdNF9m3cKM24IcK4w=="}
+		require.NoError(t, c.Validate())
+		assert.Equal(t, "https://cdn.corp.example/", c.GetBaseURL().String())
+		assert.Equal(t, "https://cdn.corp.example/img/logo.png", c.GetURL("/img/logo.png", "").String())
+	})
+	t.Run("ttl", func(t *testing.T) {
+		assert.Equal(t, time.Hour, (&CDN{}).GetTTL())
+		assert.Equal(t, time.Minute, (&CDN{TTL: time.Minute}).GetTTL())
+	})
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// reservedClaims are the claims set by pomerium, which can't be mapped.
+var reservedClaims = map[string]bool{
+	"iss":             true,
+	"aud":             true,
+	"sub":             true,
+	"exp":             true,
+	"iat":             true,
+	"nbf":             true,
+	"jti":             true,
+	"user":            true,
+	"email":           true,
+	"name":            true,
+	"groups":          true,
+	"groups_overflow": true,
+}
+
+// A ClaimMapping sets a claim to the value of another claim, or to a
+// template rendered with the claims.
+type ClaimMapping struct {
+	// Claim is the name of the claim which is set.
+	Claim string `mapstructure:"claim" yaml:"claim"`
+	// From is the name of the claim the value is copied from. If there's no
+	// claim with that name, the keys of nested objects are separated by
+	// dots, e.g. "address.country".
+	From string `mapstructure:"from" yaml:"from,omitempty"`
+	// Rename removes the claim the value is copied from.
+	Rename bool `mapstructure:"rename" yaml:"rename,omitempty"`
+	// Template is a go template rendered with the claims, e.g.
+	// "{{.given_name}} {{.family_name}}".
+	Template string `mapstructure:"template" yaml:"template,omitempty"`
+
+	tmpl *template.Template
+}
+
+// ClaimsMapping maps the identity provider claims of users, so upstreams
+// which rely on specific claim names don't need to be changed.
+type ClaimsMapping []ClaimMapping
+
+// Apply maps the claims in place. Mappings are applied in order, and
+// mappings whose claim or template values are missing are skipped, so
+// applying them again to mapped claims doesn't change them.
+func (m ClaimsMapping) Apply(claims map[string]interface{}) {
+	for i := range m {
+		value, ok := m[i].getValue(claims)
+		if !ok {
+			continue
+		}
+		if m[i].Rename {
+			deleteClaim(claims, m[i].From)
+		}
+		claims[m[i].Claim] = value
+	}
+}
+
+// Claims returns the names of the claims set by the mapping.
+func (m ClaimsMapping) Claims() []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, cm := range m {
+		if !seen[cm.Claim] {
+			seen[cm.Claim] = true
+			names = append(names, cm.Claim)
+		}
+	}
+	return names
+}
+
+func (m ClaimsMapping) validate() error {
+	for i := range m {
+		cm := &m[i]
+		if cm.Claim == "" {
+			return errors.New("config: claims mapping `claim` is required")
+		}
+		if reservedClaims[cm.Claim] {
+			return fmt.Errorf("config: claims mapping can't set the reserved claim %q", cm.Claim)
+		}
+		if (cm.From == "") == (cm.Template == "") {
+			return fmt.Errorf("config: claims mapping of %q requires one of `from` or `template`", cm.Claim)
+		}
+		if cm.Rename && (cm.From == "" || reservedClaims[strings.SplitN(cm.From, ".", 2)[0]]) {
+			return fmt.Errorf("config: claims mapping of %q can't rename %q", cm.Claim, cm.From)
+		}
+		if cm.Template != "" {
+			tmpl, err := parseClaimTemplate(cm.Template)
+			if err != nil {
+				return fmt.Errorf("config: invalid claims mapping template of %q: %w", cm.Claim, err)
+			}
+			cm.tmpl = tmpl
+		}
+	}
+	return nil
+}
+
+func (cm *ClaimMapping) getValue(claims map[string]interface{}) (interface{}, bool) {
+	if cm.From != "" {
+		return lookupClaim(claims, cm.From)
+	}
+
+	tmpl := cm.tmpl
+	if tmpl == nil {
+		var err error
+		if tmpl, err = parseClaimTemplate(cm.Template); err != nil {
+			return nil, false
+		}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, claims); err != nil {
+		return nil, false
+	}
+	return buf.String(), true
+}
+
+func parseClaimTemplate(text string) (*template.Template, error) {
+	return template.New("claim").Option("missingkey=error").Parse(text)
+}
+
+// lookupClaim returns the claim with the given name, or the value at the
+// dot separated path of nested objects.
+func lookupClaim(claims map[string]interface{}, path string) (interface{}, bool) {
+	if value, ok := claims[path]; ok {
+		return value, value != nil
+	}
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		obj, ok := claims[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		claims = obj
+	}
+	value, ok := claims[keys[len(keys)-1]]
+	return value, ok && value != nil
+}
+
+func deleteClaim(claims map[string]interface{}, path string) {
+	if _, ok := claims[path]; ok {
+		delete(claims, path)
+		return
+	}
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		obj, ok := claims[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		claims = obj
+	}
+	delete(claims, keys[len(keys)-1])
+}
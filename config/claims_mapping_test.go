@@ -0,0 +1,73 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimsMapping_Apply(t *testing.T) {
+	t.Parallel()
+
+	m := ClaimsMapping{
+		{Claim: "upn", From: "preferred_username", Rename: true},
+		{Claim: "country", From: "address.country"},
+		{Claim: "roles", From: "https://example.com/roles"},
+		{Claim: "display_name", Template: "{{.given_name}} {{.family_name}}"},
+		{Claim: "nickname", Template: "{{.nickname}}"},
+	}
+	assert.NoError(t, m.validate())
+
+	claims := map[string]interface{}{
+		"preferred_username":        "jdoe",
+		"address":                   map[string]interface{}{"country": "US"},
+		"https://example.com/roles": []interface{}{"admin"},
+		"given_name":                "Jane",
+		"family_name":               "Doe",
+	}
+	m.Apply(claims)
+	expect := map[string]interface{}{
+		"upn":                       "jdoe",
+		"address":                   map[string]interface{}{"country": "US"},
+		"country":                   "US",
+		"https://example.com/roles": []interface{}{"admin"},
+		"roles":                     []interface{}{"admin"},
+		"given_name":                "Jane",
+		"family_name":               "Doe",
+		"display_name":              "Jane Doe",
+	}
+	assert.Equal(t, expect, claims, "mappings with missing values should be skipped")
+
+	delete(claims, "address")
+	m.Apply(claims)
+	assert.Equal(t, "US", claims["country"], "mapped claims should be kept when applied again")
+	assert.Equal(t, "jdoe", claims["upn"])
+
+	assert.Equal(t, []string{"upn", "country", "roles", "display_name", "nickname"}, m.Claims())
+}
+
+func TestClaimsMapping_validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		mapping   ClaimsMapping
+		expectErr bool
+	}{
+		{"good", ClaimsMapping{{Claim: "upn", From: "preferred_username", Rename: true}, {Claim: "display_name", Template: "{{.name}}"}}, false},
+		{"missing claim", ClaimsMapping{{From: "preferred_username"}}, true},
+		{"reserved claim", ClaimsMapping{{Claim: "email", From: "mail"}}, true},
+		{"missing source", ClaimsMapping{{Claim: "upn"}}, true},
+		{"from and template", ClaimsMapping{{Claim: "upn", From: "preferred_username", Template: "{{.name}}"}}, true},
+		{"rename template", ClaimsMapping{{Claim: "upn", Template: "{{.name}}", Rename: true}}, true},
+		{"rename reserved claim", ClaimsMapping{{Claim: "mail", From: "email", Rename: true}}, true},
+		{"invalid template", ClaimsMapping{{Claim: "upn", Template: "{{.name"}}, true},
+	} {
+		err := tc.mapping.validate()
+		if tc.expectErr {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+		}
+	}
+}
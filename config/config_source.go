@@ -88,6 +88,8 @@ type FileOrEnvironmentSource struct {
 	mu     sync.RWMutex
 	config *Config
 
+	policyBundleWatcher *policyBundleWatcher
+
 	ChangeDispatcher
 }
 
@@ -105,6 +107,9 @@ func NewFileOrEnvironmentSource(configFile string) (*FileOrEnvironmentSource, er
 	options.viper.OnConfigChange(src.onConfigChange)
 	go options.viper.WatchConfig()
 
+	src.policyBundleWatcher = newPolicyBundleWatcher(src.onConfigChange)
+	src.policyBundleWatcher.Update(options.PolicyBundleFile)
+
 	return src, nil
 }
 
@@ -115,6 +120,7 @@ func (src *FileOrEnvironmentSource) onConfigChange(evt fsnotify.Event) {
 	src.config = cfg
 	src.mu.Unlock()
 
+	src.policyBundleWatcher.Update(newOptions.PolicyBundleFile)
 	src.Trigger(cfg)
 }
 
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"text/template"
+)
+
+// A DenyResponse customizes the response returned when a request to a route
+// is denied, for example to return machine-readable JSON errors for API routes
+// or a branded error page for browser routes.
+type DenyResponse struct {
+	// StatusCode overrides the status code of the response. If unset, the
+	// status code of the denial is used.
+	StatusCode int `mapstructure:"status_code" yaml:"status_code,omitempty" json:"status_code,omitempty"`
+	// Headers are added to the response. If no Content-Type header is set,
+	// "text/plain" is used.
+	Headers map[string]string `mapstructure:"headers" yaml:"headers,omitempty" json:"headers,omitempty"`
+	// Body is a template used to render the response body. See
+	// DenyResponseData for the available fields. HTML bodies are rendered
+	// with html/template, so the request's URL and the denial's reason are
+	// escaped, and any other body with text/template.
+	Body string `mapstructure:"body" yaml:"body,omitempty" json:"body,omitempty"`
+	// BodyFile is a file containing the body template.
+	BodyFile string `mapstructure:"body_file" yaml:"body_file,omitempty" json:"body_file,omitempty"`
+
+	tmpl denyResponseTemplate
+}
+
+// denyResponseTemplate is a text/template or html/template template.
+type denyResponseTemplate interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// DenyResponseData is the data available to a deny response body template.
+type DenyResponseData struct {
+	// Status is the status code of the response.
+	Status int
+	// StatusText is the text for the status code, e.g. "Forbidden".
+	StatusText string
+	// Reason is the reason the request was denied.
+	Reason string
+	// DenyReason is the code describing why the request was denied, e.g.
+	// "group-mismatch".
+	DenyReason string
+	// URL is the URL of the denied request.
+	URL string
+}
+
+var denyResponseTemplateFuncs = template.FuncMap{
+	// json encodes a value as JSON so that it can be safely embedded in a
+	// JSON body, e.g. {"error": {{json .Reason}}}
+	"json": func(v interface{}) (string, error) {
+		bs, err := json.Marshal(v)
+		return string(bs), err
+	},
+}
+
+// Validate checks the validity of the deny response, loading the body file
+// if one is set.
+func (dr *DenyResponse) Validate() error {
+	if dr.StatusCode != 0 && (dr.StatusCode < 300 || dr.StatusCode > 599) {
+		return fmt.Errorf("config: deny response status code must be between 300 and 599: %d", dr.StatusCode)
+	}
+
+	if dr.BodyFile != "" {
+		if dr.Body != "" {
+			return fmt.Errorf("config: specified both `body` and `body_file` for deny response")
+		}
+		bs, err := ioutil.ReadFile(dr.BodyFile)
+		if err != nil {
+			return fmt.Errorf("config: failed to load deny response body file: %w", err)
+		}
+		dr.Body = string(bs)
+	}
+
+	tmpl, err := dr.parse()
+	if err != nil {
+		return fmt.Errorf("config: invalid deny response body template: %w", err)
+	}
+	dr.tmpl = tmpl
+	return nil
+}
+
+// Render returns the status code, headers and body of the deny response for
+// a denial with the given status code, reason, deny reason code and request
+// URL.
+func (dr *DenyResponse) Render(status int, reason, denyReason, url string) (int, map[string]string, string, error) {
+	if dr.StatusCode != 0 {
+		status = dr.StatusCode
+	}
+
+	headers := make(map[string]string, len(dr.Headers)+1)
+	headers["Content-Type"] = "text/plain"
+	for k, v := range dr.Headers {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+
+	tmpl := dr.tmpl
+	if tmpl == nil {
+		var err error
+		tmpl, err = dr.parse()
+		if err != nil {
+			return status, headers, "", err
+		}
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, &DenyResponseData{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Reason:     reason,
+		DenyReason: denyReason,
+		URL:        url,
+	})
+	if err != nil {
+		return status, headers, "", err
+	}
+	return status, headers, buf.String(), nil
+}
+
+func (dr *DenyResponse) parse() (denyResponseTemplate, error) {
+	if dr.isHTML() {
+		return htmltemplate.New("deny_response").Funcs(htmltemplate.FuncMap(denyResponseTemplateFuncs)).Parse(dr.Body)
+	}
+	return template.New("deny_response").Funcs(denyResponseTemplateFuncs).Parse(dr.Body)
+}
+
+// isHTML returns true if the deny response's Content-Type header is HTML.
+func (dr *DenyResponse) isHTML() bool {
+	for k, v := range dr.Headers {
+		if !strings.EqualFold(k, "Content-Type") {
+			continue
+		}
+		mediaType, _, err := mime.ParseMediaType(v)
+		if err != nil {
+			// an unparseable content type may still be sniffed as HTML
+			return true
+		}
+		return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+	}
+	return false
+}
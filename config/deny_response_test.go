@@ -0,0 +1,40 @@
+package config
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenyResponse_Render(t *testing.T) {
+	const (
+		reason = `<script>alert("x")</script>`
+		url    = `https://example.com/?q="><script>alert(1)</script>`
+	)
+	tests := []struct {
+		name    string
+		headers map[string]string
+		body    string
+		want    string
+	}{
+		{"plain text", nil, `{{.Reason}}`, reason},
+		{"json", map[string]string{"Content-Type": "application/json"}, `{"error": {{json .Reason}}}`, `{"error": "\u003cscript\u003ealert(\"x\")\u003c/script\u003e"}`},
+		{"html", map[string]string{"content-type": "text/html; charset=utf-8"}, `<p>{{.Reason}}</p><a href="{{.URL}}">retry</a>`,
+			`<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</p><a href="https://example.com/?q=%22%3e%3cscript%3ealert%281%29%3c/script%3e">retry</a>`},
+		{"html script", map[string]string{"Content-Type": "text/html"}, `<script>var reason = {{.Reason}};</script>`,
+			`<script>var reason = "\u003cscript\u003ealert(\"x\")\u003c/script\u003e";</script>`},
+		{"invalid content type", map[string]string{"Content-Type": "text/html;;"}, `{{.Reason}}`, `&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := &DenyResponse{Headers: tt.headers, Body: tt.body}
+			require.NoError(t, dr.Validate())
+			status, _, body, err := dr.Render(http.StatusForbidden, reason, "group-mismatch", url)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, status)
+			assert.Equal(t, tt.want, body)
+		})
+	}
+}
@@ -11,10 +11,31 @@ const (
 	ServiceAuthenticate = "authenticate"
 	// ServiceCache represents running the cache service component
 	ServiceCache = "cache"
+	// ServiceSidecar represents running the proxy and authorize services for
+	// a single route, e.g. as a sidecar in the same pod as the upstream
+	ServiceSidecar = "sidecar"
 	// StorageRedisName is the name of the redis storage backend
 	StorageRedisName = "redis"
 	// StorageInMemoryName is the name of the in-memory storage backend
 	StorageInMemoryName = "memory"
+	// StorageEtcdName is the name of the etcd storage backend
+	StorageEtcdName = "etcd"
+	// LeaderElectionKubernetes elects the databroker leader with a kubernetes
+	// lease
+	LeaderElectionKubernetes = "kubernetes"
+	// LeaderElectionStorage elects the databroker leader with a lock in the
+	// storage backend
+	LeaderElectionStorage = "storage"
+	// SessionTokenFormatJWT issues session tokens as JWTs
+	SessionTokenFormatJWT = "jwt"
+	// SessionTokenFormatCompact issues session tokens in the smaller compact
+	// format
+	SessionTokenFormatCompact = "compact"
+	// InteropProviderCloudflareAccess accepts Cloudflare Access JWTs
+	InteropProviderCloudflareAccess = "cloudflare_access"
+	// InteropProviderAWSALB accepts AWS application load balancer OIDC
+	// headers
+	InteropProviderAWSALB = "aws_alb"
 )
 
 // IsValidService checks to see if a service is a valid service mode
@@ -25,7 +46,8 @@ func IsValidService(s string) bool {
 		ServiceAuthenticate,
 		ServiceAuthorize,
 		ServiceCache,
-		ServiceProxy:
+		ServiceProxy,
+		ServiceSidecar:
 		return true
 	}
 	return false
@@ -47,7 +69,8 @@ func IsAuthorize(s string) bool {
 	switch s {
 	case
 		ServiceAll,
-		ServiceAuthorize:
+		ServiceAuthorize,
+		ServiceSidecar:
 		return true
 	}
 	return false
@@ -58,7 +81,8 @@ func IsProxy(s string) bool {
 	switch s {
 	case
 		ServiceAll,
-		ServiceProxy:
+		ServiceProxy,
+		ServiceSidecar:
 		return true
 	}
 	return false
@@ -79,3 +103,8 @@ func IsCache(s string) bool {
 func IsAll(s string) bool {
 	return s == ServiceAll
 }
+
+// IsSidecar checks to see if we should be running in single route sidecar mode
+func IsSidecar(s string) bool {
+	return s == ServiceSidecar
+}
@@ -17,6 +17,7 @@ func Test_isValidService(t *testing.T) {
 		{"authorize implemented", "authorize", true},
 		{"jiberish", "xd23", false},
 		{"cache", "cache", true},
+		{"sidecar", "sidecar", true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -61,6 +62,7 @@ func Test_isAuthorize(t *testing.T) {
 		{"proxy", "proxy", false},
 		{"all", "all", true},
 		{"authorize", "authorize", true},
+		{"sidecar", "sidecar", true},
 		{"authorize bad case", "AuThorize", false},
 		{"authenticate implemented", "authenticate", false},
 		{"jiberish", "xd23", false},
@@ -83,6 +85,7 @@ func Test_IsProxy(t *testing.T) {
 		{"proxy", "proxy", true},
 		{"all", "all", true},
 		{"authorize", "authorize", false},
+		{"sidecar", "sidecar", true},
 		{"proxy bad case", "PrOxY", false},
 		{"jiberish", "xd23", false},
 	}
@@ -109,6 +112,7 @@ func Test_IsCache(t *testing.T) {
 		{"proxy bad case", "PrOxY", false},
 		{"jiberish", "xd23", false},
 		{"cache", "cache", true},
+		{"sidecar", "sidecar", false},
 	}
 	for _, tt := range tests {
 
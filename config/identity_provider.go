@@ -0,0 +1,118 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+)
+
+// DefaultIdentityProviderID is the id of the identity provider configured by
+// the idp_* options.
+const DefaultIdentityProviderID = "default"
+
+// An IdentityProvider is an identity provider users can sign in with, in
+// addition to the one configured by the idp_* options. Routes select the
+// identity provider their users sign in with by its id.
+type IdentityProvider struct {
+	// ID identifies the identity provider in routes and sessions.
+	ID string `mapstructure:"id" yaml:"id"`
+	// Name is shown to users choosing an identity provider to sign in with.
+	// It defaults to the id.
+	Name           string            `mapstructure:"name" yaml:"name,omitempty"`
+	Provider       string            `mapstructure:"provider" yaml:"provider"`
+	ProviderURL    string            `mapstructure:"provider_url" yaml:"provider_url,omitempty"`
+	ClientID       string            `mapstructure:"client_id" yaml:"client_id,omitempty"`
+	ClientSecret   string            `mapstructure:"client_secret" yaml:"client_secret,omitempty"`
+	Scopes         []string          `mapstructure:"scopes" yaml:"scopes,omitempty"`
+	ServiceAccount string            `mapstructure:"service_account" yaml:"service_account,omitempty"`
+	RequestParams  map[string]string `mapstructure:"request_params" yaml:"request_params,omitempty"`
+	SAMLAttributes map[string]string `mapstructure:"saml_attributes" yaml:"saml_attributes,omitempty"`
+	SkipEndSession bool              `mapstructure:"skip_end_session" yaml:"skip_end_session,omitempty"`
+}
+
+// GetName returns the name of the identity provider shown to users.
+func (idp *IdentityProvider) GetName() string {
+	if idp.Name != "" {
+		return idp.Name
+	}
+	return idp.ID
+}
+
+// GetIdentityProviders returns every identity provider users can sign in
+// with, starting with the one configured by the idp_* options.
+func (o *Options) GetIdentityProviders() []IdentityProvider {
+	idps := []IdentityProvider{{
+		ID:             DefaultIdentityProviderID,
+		Name:           o.Provider,
+		Provider:       o.Provider,
+		ProviderURL:    o.ProviderURL,
+		ClientID:       o.ClientID,
+		ClientSecret:   o.ClientSecret,
+		Scopes:         o.Scopes,
+		ServiceAccount: o.ServiceAccount,
+		RequestParams:  o.RequestParams,
+		SAMLAttributes: o.SAMLAttributes,
+		SkipEndSession: o.SkipEndSession,
+	}}
+	return append(idps, o.IdentityProviders...)
+}
+
+// GetIdentityProvider returns the identity provider with the given id. An
+// empty id is the default identity provider, so sessions created before
+// identity providers had ids are still valid.
+func (o *Options) GetIdentityProvider(id string) (*IdentityProvider, bool) {
+	if id == "" {
+		id = DefaultIdentityProviderID
+	}
+	for _, idp := range o.GetIdentityProviders() {
+		if idp.ID == id {
+			idp := idp
+			return &idp, true
+		}
+	}
+	return nil, false
+}
+
+// GetOauthOptionsForIdentityProvider gets the oauth.Options of an identity
+// provider.
+func (o *Options) GetOauthOptionsForIdentityProvider(idp *IdentityProvider) oauth.Options {
+	redirectURL := o.GetAuthenticateURL()
+	redirectURL.Path = o.AuthenticateCallbackPath
+	return oauth.Options{
+		RedirectURL:     redirectURL,
+		ProviderName:    idp.Provider,
+		ProviderURL:     idp.ProviderURL,
+		ClientID:        idp.ClientID,
+		ClientSecret:    idp.ClientSecret,
+		Scopes:          idp.Scopes,
+		ServiceAccount:  idp.ServiceAccount,
+		AuthCodeOptions: idp.RequestParams,
+		ClockSkew:       o.GetIdpClockSkew(),
+		SAMLAttributes:  idp.SAMLAttributes,
+	}
+}
+
+// validateIdentityProviders checks the additional identity providers, and
+// that the identity providers selected by routes exist.
+func (o *Options) validateIdentityProviders() error {
+	seen := map[string]bool{DefaultIdentityProviderID: true}
+	for _, idp := range o.IdentityProviders {
+		if idp.ID == "" {
+			return errors.New("config: identity provider `id` is required")
+		}
+		if seen[idp.ID] {
+			return fmt.Errorf("config: duplicate identity provider id %q", idp.ID)
+		}
+		seen[idp.ID] = true
+		if idp.Provider == "" {
+			return fmt.Errorf("config: identity provider %q `provider` is required", idp.ID)
+		}
+	}
+	for _, p := range o.Policies {
+		if p.IdentityProviderID != "" && !seen[p.IdentityProviderID] {
+			return fmt.Errorf("config: route %s has unknown `idp_id` %q", p.From, p.IdentityProviderID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_IdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	f, err := ioutil.TempFile(t.TempDir(), "*.yaml")
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+insecure_server: true
+idp_provider: okta
+idp_client_id: employees
+identity_providers:
+  - id: contractors
+    name: Contractors
+    provider: google
+    client_id: contractors
+    client_secret: secret
+    request_params:
+      hd: contractors.example.com
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	o, err := optionsFromViper(f.Name())
+	require.NoError(t, err)
+
+	idp, ok := o.GetIdentityProvider("")
+	require.True(t, ok)
+	assert.Equal(t, DefaultIdentityProviderID, idp.ID)
+	assert.Equal(t, "okta", idp.GetName())
+	assert.Equal(t, "employees", idp.ClientID)
+
+	idp, ok = o.GetIdentityProvider("contractors")
+	require.True(t, ok)
+	assert.Equal(t, "Contractors", idp.GetName())
+	oauthOptions := o.GetOauthOptionsForIdentityProvider(idp)
+	assert.Equal(t, "google", oauthOptions.ProviderName)
+	assert.Equal(t, map[string]string{"hd": "contractors.example.com"}, oauthOptions.AuthCodeOptions)
+
+	_, ok = o.GetIdentityProvider("missing")
+	assert.False(t, ok)
+}
+
+func TestOptions_validateIdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		idps      []IdentityProvider
+		policies  []Policy
+		expectErr bool
+	}{
+		{"good", []IdentityProvider{{ID: "contractors", Provider: "google"}}, []Policy{{IdentityProviderID: "contractors"}, {IdentityProviderID: DefaultIdentityProviderID}}, false},
+		{"missing id", []IdentityProvider{{Provider: "google"}}, nil, true},
+		{"duplicate id", []IdentityProvider{{ID: "contractors", Provider: "google"}, {ID: "contractors", Provider: "okta"}}, nil, true},
+		{"default id", []IdentityProvider{{ID: DefaultIdentityProviderID, Provider: "google"}}, nil, true},
+		{"missing provider", []IdentityProvider{{ID: "contractors"}}, nil, true},
+		{"unknown route idp", nil, []Policy{{IdentityProviderID: "contractors"}}, true},
+	} {
+		o := &Options{IdentityProviders: tc.idps, Policies: tc.policies}
+		err := o.validateIdentityProviders()
+		if tc.expectErr {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+		}
+	}
+}
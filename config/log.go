@@ -38,4 +38,7 @@ func (mgr *LogManager) OnConfigChange(cfg *Config) {
 	if cfg.Options.LogLevel != "" {
 		log.SetLevel(cfg.Options.LogLevel)
 	}
+
+	log.SetRedaction(cfg.Options.LogRedactQueryParams, cfg.Options.LogRedactHeaders)
+	log.SetLegacyFieldNames(cfg.Options.LogLegacyFieldNames)
 }
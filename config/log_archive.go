@@ -0,0 +1,89 @@
+package config
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/logarchive"
+)
+
+// The LogArchiveManager configures log archiving based on options.
+type LogArchiveManager struct {
+	mu      sync.Mutex
+	options logarchive.Options
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewLogArchiveManager creates a new LogArchiveManager.
+func NewLogArchiveManager(src Source) *LogArchiveManager {
+	mgr := &LogArchiveManager{}
+	src.OnConfigChange(mgr.OnConfigChange)
+	mgr.OnConfigChange(src.GetConfig())
+	return mgr
+}
+
+// Close stops archiving logs. Logs which haven't been uploaded yet are kept
+// in the spool directory and uploaded the next time pomerium starts.
+func (mgr *LogArchiveManager) Close() error {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	logarchive.SetArchiver(nil)
+	mgr.stop()
+	return nil
+}
+
+// OnConfigChange is called whenever configuration changes.
+func (mgr *LogArchiveManager) OnConfigChange(cfg *Config) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	options := logarchive.Options{
+		URL: cfg.Options.LogArchiveURL,
+		Credentials: logarchive.Credentials{
+			AccessKeyID:     cfg.Options.LogArchiveAccessKeyID,
+			SecretAccessKey: cfg.Options.LogArchiveSecretAccessKey,
+		},
+		SpoolDir:      cfg.Options.LogArchiveSpoolDir,
+		MaxRecords:    cfg.Options.LogArchiveBatchSize,
+		FlushInterval: cfg.Options.LogArchiveFlushInterval,
+	}
+	if options == mgr.options {
+		return
+	}
+	mgr.options = options
+
+	var a *logarchive.Archiver
+	if options.URL != "" {
+		var err error
+		a, err = logarchive.New(options)
+		if err != nil {
+			log.Error().Err(err).Msg("config: failed to create log archiver")
+		}
+	}
+
+	logarchive.SetArchiver(a)
+	mgr.stop()
+	if a != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = a.Run(ctx)
+		}()
+		mgr.cancel, mgr.done = cancel, done
+		log.Info().Str("spool", options.SpoolDir).Msg("config: archiving logs")
+	}
+}
+
+// stop stops the current archiver. The lock must be held.
+func (mgr *LogArchiveManager) stop() {
+	if mgr.cancel == nil {
+		return
+	}
+	mgr.cancel()
+	<-mgr.done
+	mgr.cancel, mgr.done = nil, nil
+}
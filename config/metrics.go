@@ -1,8 +1,10 @@
 package config
 
 import (
+	"crypto/subtle"
 	"net/http"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/log"
@@ -16,6 +18,8 @@ type MetricsManager struct {
 	serviceName string
 	addr        string
 	srv         *http.Server
+
+	tenantTokens atomic.Value // map[string]string
 }
 
 // NewMetricsManager creates a new MetricsManager.
@@ -46,6 +50,7 @@ func (mgr *MetricsManager) OnConfigChange(cfg *Config) {
 	defer mgr.mu.Unlock()
 
 	mgr.updateInfo(cfg)
+	mgr.updateTenants(cfg)
 	mgr.updateServer(cfg)
 }
 
@@ -59,6 +64,23 @@ func (mgr *MetricsManager) updateInfo(cfg *Config) {
 	mgr.serviceName = serviceName
 }
 
+func (mgr *MetricsManager) updateTenants(cfg *Config) {
+	tokens := make(map[string]string)
+	for _, tenant := range cfg.Options.Tenants {
+		if tenant.MetricsToken != "" {
+			tokens[tenant.Name] = tenant.MetricsToken
+		}
+	}
+	mgr.tenantTokens.Store(tokens)
+}
+
+// authorizeTenant returns true if token is the metrics token of the tenant.
+func (mgr *MetricsManager) authorizeTenant(tenant, token string) bool {
+	tokens, _ := mgr.tenantTokens.Load().(map[string]string)
+	want, ok := tokens[tenant]
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(want)) == 1
+}
+
 func (mgr *MetricsManager) updateServer(cfg *Config) {
 	if cfg.Options.MetricsAddr == mgr.addr {
 		return
@@ -85,12 +107,20 @@ func (mgr *MetricsManager) updateServer(cfg *Config) {
 		log.Error().Err(err).Msg("metrics: failed to create prometheus handler")
 		return
 	}
+	tenantHandler, err := metrics.TenantPrometheusHandler(mgr.authorizeTenant)
+	if err != nil {
+		log.Error().Err(err).Msg("metrics: failed to create tenant prometheus handler")
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/", handler)
+	mux.Handle("/metrics/tenants/", tenantHandler)
 
 	mgr.srv, err = httputil.NewServer(&httputil.ServerOptions{
 		Addr:     mgr.addr,
 		Insecure: true,
 		Service:  "metrics",
-	}, handler, new(sync.WaitGroup))
+	}, mux, new(sync.WaitGroup))
 	if err != nil {
 		log.Error().Err(err).Msg("metrics: failed to create metrics http server")
 		return
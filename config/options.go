@@ -2,14 +2,18 @@ package config
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/tls"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -20,6 +24,7 @@ import (
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v2"
 
+	"github.com/pomerium/pomerium/internal/directory/auth0"
 	"github.com/pomerium/pomerium/internal/directory/azure"
 	"github.com/pomerium/pomerium/internal/directory/github"
 	"github.com/pomerium/pomerium/internal/directory/gitlab"
@@ -27,7 +32,9 @@ import (
 	"github.com/pomerium/pomerium/internal/directory/okta"
 	"github.com/pomerium/pomerium/internal/directory/onelogin"
 	"github.com/pomerium/pomerium/internal/identity/oauth"
+	"github.com/pomerium/pomerium/internal/kafka"
 	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/policybundle"
 	"github.com/pomerium/pomerium/internal/telemetry"
 	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/internal/urlutil"
@@ -48,6 +55,14 @@ const (
 // gRPC server, or is used for healthchecks (authorize only service)
 const DefaultAlternativeAddr = ":5443"
 
+// maxClockSkew is the largest clock skew which may be tolerated, since a larger
+// one would noticeably extend the lifetime of tokens.
+const maxClockSkew = 10 * time.Minute
+
+// minSCIMBearerTokenLength is the length of the shortest SCIM bearer token
+// allowed, since the token can change every user's groups.
+const minSCIMBearerTokenLength = 32
+
 // EnvoyAdminURL indicates where the envoy control plane is listening
 var EnvoyAdminURL = &url.URL{Host: "127.0.0.1:9901", Scheme: "http"}
 
@@ -61,10 +76,24 @@ type Options struct {
 	// Possible options are "info","warn","debug" and "error". Defaults to "info".
 	LogLevel string `mapstructure:"log_level" yaml:"log_level,omitempty"`
 
+	// LogRedactQueryParams and LogRedactHeaders are patterns of the query
+	// parameters and headers which are redacted in logs, audit events and
+	// traces, in addition to the defaults.
+	LogRedactQueryParams []string `mapstructure:"log_redact_query_params" yaml:"log_redact_query_params,omitempty"`
+	LogRedactHeaders     []string `mapstructure:"log_redact_headers" yaml:"log_redact_headers,omitempty"`
+
+	// LogLegacyFieldNames also logs renamed fields with their names in the
+	// previous log schema version. It will be removed in the next release.
+	LogLegacyFieldNames bool `mapstructure:"log_legacy_field_names" yaml:"log_legacy_field_names,omitempty"`
+
 	// ProxyLogLevel sets the log level for the proxy service.
 	// Possible options are "info","warn", and "error". Defaults to the value of `LogLevel`.
 	ProxyLogLevel string `mapstructure:"proxy_log_level" yaml:"proxy_log_level,omitempty"`
 
+	// EnvoyBinaryPath is the path of an envoy binary to run instead of the
+	// one embedded in pomerium. Its version is checked when pomerium starts.
+	EnvoyBinaryPath string `mapstructure:"envoy_binary_path" yaml:"envoy_binary_path,omitempty"`
+
 	// SharedKey is the shared secret authorization key used to mutually authenticate
 	// requests between services.
 	SharedKey string `mapstructure:"shared_secret" yaml:"shared_secret,omitempty"`
@@ -108,6 +137,18 @@ type Options struct {
 	PolicyEnv  string   `yaml:",omitempty"`
 	PolicyFile string   `mapstructure:"policy_file" yaml:"policy_file,omitempty"`
 
+	// PolicyBundleFile is a signed policy bundle built by `pomerium bundle
+	// build`. Its routes are used instead of the policy option, once its
+	// signature is verified with one of the PolicyBundlePublicKeys.
+	PolicyBundleFile string `mapstructure:"policy_bundle_file" yaml:"policy_bundle_file,omitempty"`
+	// PolicyBundlePublicKeys are the base64-encoded PEM public keys trusted to
+	// sign policy bundles.
+	PolicyBundlePublicKeys []string `mapstructure:"policy_bundle_public_keys" yaml:"policy_bundle_public_keys,omitempty"`
+	// PolicyBundleVersion is the version of the loaded policy bundle.
+	PolicyBundleVersion string `yaml:"-"`
+	// PolicyBundleCreatedAt is when the loaded policy bundle was built.
+	PolicyBundleCreatedAt time.Time `yaml:"-"`
+
 	// AuthenticateURL represents the externally accessible http endpoints
 	// used for authentication requests and callbacks
 	AuthenticateURLString string   `mapstructure:"authenticate_service_url" yaml:"authenticate_service_url,omitempty"`
@@ -127,6 +168,36 @@ type Options struct {
 	CookieSecure   bool          `mapstructure:"cookie_secure" yaml:"cookie_secure,omitempty"`
 	CookieHTTPOnly bool          `mapstructure:"cookie_http_only" yaml:"cookie_http_only,omitempty"`
 	CookieExpire   time.Duration `mapstructure:"cookie_expire" yaml:"cookie_expire,omitempty"`
+	// CookieIdleTimeout invalidates sessions which haven't been used within
+	// the duration, even if they haven't expired. Zero disables it.
+	CookieIdleTimeout time.Duration `mapstructure:"cookie_idle_timeout" yaml:"cookie_idle_timeout,omitempty"`
+
+	// SessionTokenFormat is the format of the session tokens issued by the
+	// authenticate service: "jwt", the default, or "compact". Tokens of
+	// either format are accepted.
+	SessionTokenFormat string `mapstructure:"session_token_format" yaml:"session_token_format,omitempty"`
+	// SessionTokenLegacyMaxAge is how long after they were issued JWT session
+	// tokens are still accepted once the format is compact. If zero, they're
+	// always accepted.
+	SessionTokenLegacyMaxAge time.Duration `mapstructure:"session_token_legacy_max_age" yaml:"session_token_legacy_max_age,omitempty"`
+
+	// ClockSkew is the clock skew tolerated when validating the expiry and
+	// not before times of ID tokens, session JWTs and assertions.
+	ClockSkew time.Duration `mapstructure:"clock_skew" yaml:"clock_skew,omitempty"`
+
+	// InteropProvider is an upstream zero-trust provider, "cloudflare_access"
+	// or "aws_alb", whose identity assertions are accepted for requests
+	// without a session, so both can run in series during a migration.
+	InteropProvider string `mapstructure:"interop_provider" yaml:"interop_provider,omitempty"`
+	// InteropCloudflareTeamDomain is the Cloudflare Access team domain, e.g.
+	// "example.cloudflareaccess.com", and InteropCloudflareAudience is the
+	// application audience (AUD) tag.
+	InteropCloudflareTeamDomain string `mapstructure:"interop_cloudflare_team_domain" yaml:"interop_cloudflare_team_domain,omitempty"`
+	InteropCloudflareAudience   string `mapstructure:"interop_cloudflare_audience" yaml:"interop_cloudflare_audience,omitempty"`
+	// InteropAWSALBRegion is the region of the AWS application load balancer,
+	// and InteropAWSALBARN is its ARN, which assertions must be signed by.
+	InteropAWSALBRegion string `mapstructure:"interop_aws_alb_region" yaml:"interop_aws_alb_region,omitempty"`
+	InteropAWSALBARN    string `mapstructure:"interop_aws_alb_arn" yaml:"interop_aws_alb_arn,omitempty"`
 
 	// Identity provider configuration variables as specified by RFC6749
 	// https://openid.net/specs/openid-connect-basic-1_0.html#RFC6749
@@ -136,11 +207,20 @@ type Options struct {
 	ProviderURL    string   `mapstructure:"idp_provider_url" yaml:"idp_provider_url,omitempty"`
 	Scopes         []string `mapstructure:"idp_scopes" yaml:"idp_scopes,omitempty"`
 	ServiceAccount string   `mapstructure:"idp_service_account" yaml:"idp_service_account,omitempty"`
+	// IdpClockSkew overrides the clock skew tolerated when validating the
+	// identity provider's ID tokens.
+	IdpClockSkew time.Duration `mapstructure:"idp_clock_skew" yaml:"idp_clock_skew,omitempty"`
 	// Identity provider refresh directory interval/timeout settings.
 	RefreshDirectoryTimeout  time.Duration `mapstructure:"idp_refresh_directory_timeout" yaml:"idp_refresh_directory_timeout,omitempty"`
 	RefreshDirectoryInterval time.Duration `mapstructure:"idp_refresh_directory_interval" yaml:"idp_refresh_directory_interval,omitempty"`
 	QPS                      float64       `mapstructure:"idp_qps" yaml:"idp_qps"`
 
+	// SCIMBearerToken enables the SCIM endpoint of the authenticate service,
+	// which the identity provider pushes user and group changes to, and is
+	// the token it authenticates with. The periodic directory sync is
+	// disabled while it's set.
+	SCIMBearerToken string `mapstructure:"scim_bearer_token" yaml:"scim_bearer_token,omitempty"`
+
 	// RequestParams are custom request params added to the signin request as
 	// part of an Oauth2 code flow.
 	//
@@ -148,10 +228,53 @@ type Options struct {
 	// https://openid.net/specs/openid-connect-basic-1_0.html#RequestParameters
 	RequestParams map[string]string `mapstructure:"idp_request_params" yaml:"idp_request_params,omitempty"`
 
+	// SAMLAttributes maps claims to the SAML attributes they're read from, when
+	// the identity provider uses SAML.
+	SAMLAttributes map[string]string `mapstructure:"idp_saml_attributes" yaml:"idp_saml_attributes,omitempty"`
+
+	// SkipEndSession signs users out of Pomerium only, rather than also
+	// ending their session with the identity provider.
+	SkipEndSession bool `mapstructure:"idp_skip_end_session" yaml:"idp_skip_end_session,omitempty"`
+
+	// IdentityProviders are identity providers users can sign in with, in
+	// addition to the one configured by the idp_* options.
+	IdentityProviders []IdentityProvider `mapstructure:"identity_providers" yaml:"identity_providers,omitempty"`
+
 	// Administrators contains a set of emails with users who have super user
 	// (sudo) access including the ability to impersonate other users' access
 	Administrators []string `mapstructure:"administrators" yaml:"administrators,omitempty"`
 
+	// ImpersonationApprovalRequired requires impersonation requests to be
+	// approved by another administrator before they take effect.
+	ImpersonationApprovalRequired bool `mapstructure:"impersonation_approval_required" yaml:"impersonation_approval_required,omitempty"`
+	// ImpersonationGrantTTL is how long an impersonation grant is valid for
+	// once it has been approved.
+	ImpersonationGrantTTL time.Duration `mapstructure:"impersonation_grant_ttl" yaml:"impersonation_grant_ttl,omitempty"`
+
+	// Roles are elevated roles which entitled users can assume for a limited
+	// time, so routes can require an actively assumed role.
+	Roles []Role `mapstructure:"roles" yaml:"roles,omitempty"`
+
+	// KioskCodeTTL is how long a kiosk device's user code can be approved for.
+	KioskCodeTTL time.Duration `mapstructure:"kiosk_code_ttl" yaml:"kiosk_code_ttl,omitempty"`
+	// KioskSessionTTL is how long an approved kiosk device stays signed in.
+	KioskSessionTTL time.Duration `mapstructure:"kiosk_session_ttl" yaml:"kiosk_session_ttl,omitempty"`
+
+	// DeviceAuthorizationCodeTTL is how long the codes of a device
+	// authorization grant are valid for.
+	DeviceAuthorizationCodeTTL time.Duration `mapstructure:"device_authorization_code_ttl" yaml:"device_authorization_code_ttl,omitempty"`
+	// DeviceAuthorizationSessionTTL is how long the sessions issued by device
+	// authorization grants last.
+	DeviceAuthorizationSessionTTL time.Duration `mapstructure:"device_authorization_session_ttl" yaml:"device_authorization_session_ttl,omitempty"`
+
+	// AuthenticateBrokerAllowedOrigins are the origins of pages which may
+	// embed first-party scripts that request access tokens from the
+	// authenticate service's postMessage broker.
+	AuthenticateBrokerAllowedOrigins []string `mapstructure:"authenticate_broker_allowed_origins" yaml:"authenticate_broker_allowed_origins,omitempty"`
+	// AuthenticateBrokerTokenTTL is how long an access token issued by the
+	// broker is valid for.
+	AuthenticateBrokerTokenTTL time.Duration `mapstructure:"authenticate_broker_token_ttl" yaml:"authenticate_broker_token_ttl,omitempty"`
+
 	// AuthorizeURL is the routable destination of the authorize service's
 	// gRPC endpoint. NOTE: As many load balancers do not support
 	// externally routed gRPC so this may be an internal location.
@@ -174,9 +297,30 @@ type Options struct {
 	// List of JWT claims to insert as x-pomerium-claim-* headers on proxied requests
 	JWTClaimsHeaders []string `mapstructure:"jwt_claims_headers" yaml:"jwt_claims_headers,omitempty"`
 
+	// ClaimsMapping renames, extracts and templates the identity provider
+	// claims of users when they're stored, and adds the mapped claims to the
+	// JWT sent to upstreams.
+	ClaimsMapping ClaimsMapping `mapstructure:"claims_mapping" yaml:"claims_mapping,omitempty"`
+	// JWTClaimHeaderMaxSize is the maximum size in bytes of each
+	// x-pomerium-claim-* header. Values of list claims which don't fit are
+	// dropped, and single values which don't fit omit the header.
+	JWTClaimHeaderMaxSize int `mapstructure:"jwt_claim_header_max_size" yaml:"jwt_claim_header_max_size,omitempty"`
+
+	// JWTGroupsFilter only includes the groups referenced by a route policy
+	// in the groups claim of the JWTs sent to upstreams.
+	JWTGroupsFilter bool `mapstructure:"jwt_groups_filter" yaml:"jwt_groups_filter,omitempty"`
+	// JWTGroupsLimit is the maximum number of groups in the groups claim of
+	// the JWTs sent to upstreams. When a user has more, the groups_overflow
+	// claim is set.
+	JWTGroupsLimit int `mapstructure:"jwt_groups_limit" yaml:"jwt_groups_limit,omitempty"`
+
 	// RefreshCooldown limits the rate a user can refresh her session
 	RefreshCooldown time.Duration `mapstructure:"refresh_cooldown" yaml:"refresh_cooldown,omitempty"`
 
+	// ProgrammaticRedirectDomains are the domains, besides loopback urls,
+	// which programmatic logins can redirect their tokens to.
+	ProgrammaticRedirectDomains []string `mapstructure:"programmatic_redirect_domains" yaml:"programmatic_redirect_domains,omitempty"`
+
 	DefaultUpstreamTimeout time.Duration `mapstructure:"default_upstream_timeout" yaml:"default_upstream_timeout,omitempty"`
 
 	// Address/Port to bind to for prometheus metrics
@@ -185,6 +329,9 @@ type Options struct {
 	// Tracing shared settings
 	TracingProvider   string  `mapstructure:"tracing_provider" yaml:"tracing_provider,omitempty"`
 	TracingSampleRate float64 `mapstructure:"tracing_sample_rate" yaml:"tracing_sample_rate,omitempty"`
+	// TracingDebugGroups are the groups allowed to force requests to be traced
+	// with the x-pomerium-trace header. If empty, the header is ignored.
+	TracingDebugGroups []string `mapstructure:"tracing_debug_groups" yaml:"tracing_debug_groups,omitempty"`
 
 	//  Jaeger
 	//
@@ -219,6 +366,25 @@ type Options struct {
 	//GRPCServerMaxConnectionAgeGrace sets MaxConnectionAgeGrace in the grpc ServerParameters used to create GRPC Services
 	GRPCServerMaxConnectionAgeGrace time.Duration `mapstructure:"grpc_server_max_connection_age_grace,omitempty" yaml:"grpc_server_max_connection_age_grace,omitempty"` //nolint: lll
 
+	// GRPCServerListeners is the number of listeners accepting connections for
+	// the gRPC server, which share the same port using SO_REUSEPORT.
+	GRPCServerListeners int `mapstructure:"grpc_server_listeners" yaml:"grpc_server_listeners,omitempty"`
+	// GRPCServerStreamWorkers is the number of workers handling gRPC streams
+	// for each listener. If 0, every stream is handled by a new goroutine.
+	GRPCServerStreamWorkers int `mapstructure:"grpc_server_stream_workers" yaml:"grpc_server_stream_workers,omitempty"`
+	// GRPCServerMaxConcurrentStreams is the maximum number of concurrent
+	// streams of each connection to the gRPC server. If 0, it's unlimited.
+	GRPCServerMaxConcurrentStreams uint32 `mapstructure:"grpc_server_max_concurrent_streams" yaml:"grpc_server_max_concurrent_streams,omitempty"` //nolint: lll
+	// GRPCServerMaxReceiveMessageSize is the maximum size in bytes of a
+	// message received by the gRPC server. If 0, it's 4MB.
+	GRPCServerMaxReceiveMessageSize int `mapstructure:"grpc_server_max_receive_message_size" yaml:"grpc_server_max_receive_message_size,omitempty"` //nolint: lll
+	// GRPCServerKeepaliveMinTime is the minimum time clients must wait
+	// between keepalive pings. Clients pinging more often are disconnected.
+	GRPCServerKeepaliveMinTime time.Duration `mapstructure:"grpc_server_keepalive_min_time" yaml:"grpc_server_keepalive_min_time,omitempty"`
+	// GRPCServerKeepalivePermitWithoutStream allows clients to send keepalive
+	// pings when they have no active streams.
+	GRPCServerKeepalivePermitWithoutStream bool `mapstructure:"grpc_server_keepalive_permit_without_stream" yaml:"grpc_server_keepalive_permit_without_stream,omitempty"` //nolint: lll
+
 	// ForwardAuthEndpoint allows for a given route to be used as a forward-auth
 	// endpoint instead of a reverse proxy. Some third-party proxies that do not
 	// have rich access control capabilities (nginx, envoy, ambassador, traefik)
@@ -228,6 +394,17 @@ type Options struct {
 	ForwardAuthURLString string   `mapstructure:"forward_auth_url" yaml:"forward_auth_url,omitempty"`
 	ForwardAuthURL       *url.URL `yaml:",omitempty"`
 
+	// ForwardAuthCacheTTL is how long the result of a successful forward-auth
+	// verification is cached for a session and URL. If zero, results are not
+	// cached and every verification is sent to the authorize service.
+	ForwardAuthCacheTTL time.Duration `mapstructure:"forward_auth_cache_ttl" yaml:"forward_auth_cache_ttl,omitempty"`
+	// ForwardAuthCacheType is where forward-auth verifications are cached:
+	// "memory", the default, or "redis", which shares them between proxies.
+	ForwardAuthCacheType string `mapstructure:"forward_auth_cache_type" yaml:"forward_auth_cache_type,omitempty"`
+	// ForwardAuthCacheConnectionString is the redis URL of the redis
+	// forward-auth cache.
+	ForwardAuthCacheConnectionString string `mapstructure:"forward_auth_cache_connection_string" yaml:"forward_auth_cache_connection_string,omitempty"`
+
 	// CacheURL is the routable destination of the cache service's
 	// gRPC endpoint. NOTE: As many load balancers do not support
 	// externally routed gRPC so this may be an internal location.
@@ -248,6 +425,62 @@ type Options struct {
 	DataBrokerStorageCertKeyFile      string `mapstructure:"databroker_storage_key_file" yaml:"databroker_storage_key_file,omitempty"`
 	DataBrokerStorageCAFile           string `mapstructure:"databroker_storage_ca_file" yaml:"databroker_storage_ca_file,omitempty"`
 	DataBrokerStorageCertSkipVerify   bool   `mapstructure:"databroker_storage_tls_skip_verify" yaml:"databroker_storage_tls_skip_verify,omitempty"`
+	// DataBrokerStorageMaxRecords is the maximum number of records of each
+	// type kept by the in-memory storage. If zero, it's unlimited.
+	DataBrokerStorageMaxRecords int `mapstructure:"databroker_storage_max_records" yaml:"databroker_storage_max_records,omitempty"`
+	// DataBrokerStorageMaxBytes is the maximum size of the records of each
+	// type kept by the in-memory storage. If zero, it's unlimited.
+	DataBrokerStorageMaxBytes int64 `mapstructure:"databroker_storage_max_bytes" yaml:"databroker_storage_max_bytes,omitempty"`
+	// DataBrokerRecordValidation makes the databroker reject records whose
+	// type isn't one of pomerium's record types, or whose data is invalid.
+	DataBrokerRecordValidation bool `mapstructure:"databroker_record_validation" yaml:"databroker_record_validation,omitempty"`
+	// DataBrokerNamespace scopes the records read and written by the services
+	// of this deployment, so several deployments can share a databroker. If
+	// empty, the records are in the default namespace.
+	DataBrokerNamespace string `mapstructure:"databroker_namespace" yaml:"databroker_namespace,omitempty"`
+	// DataBrokerClientID and DataBrokerClientSecret are the credential the
+	// services authenticate to the databroker with, when it has clients.
+	DataBrokerClientID     string `mapstructure:"databroker_client_id" yaml:"databroker_client_id,omitempty"`
+	DataBrokerClientSecret string `mapstructure:"databroker_client_secret" yaml:"databroker_client_secret,omitempty"`
+	// DataBrokerClients are the clients allowed to use the databroker, and
+	// the namespaces and record types each of them can use. If empty, the
+	// databroker doesn't authenticate clients.
+	DataBrokerClients []DataBrokerClient `mapstructure:"databroker_clients" yaml:"databroker_clients,omitempty"`
+	// DataBrokerRecordTTLs are the times to live of databroker records by
+	// type. Records which aren't modified within their TTL are deleted.
+	DataBrokerRecordTTLs []DataBrokerRecordTTL `mapstructure:"databroker_record_ttls" yaml:"databroker_record_ttls,omitempty"`
+	// DataBrokerEncryptionKey is the base64 encoded key used to encrypt
+	// databroker records in persistent storage. If empty, a key is derived
+	// from the shared secret.
+	DataBrokerEncryptionKey string `mapstructure:"databroker_encryption_key" yaml:"databroker_encryption_key,omitempty"`
+	// DataBrokerPreviousEncryptionKeys are previous encryption keys, or
+	// shared secrets, which are still used to decrypt records until they're
+	// re-encrypted with the current key.
+	DataBrokerPreviousEncryptionKeys []string `mapstructure:"databroker_previous_encryption_keys" yaml:"databroker_previous_encryption_keys,omitempty"`
+	// DataBrokerLeaderElection is how the leader of the databroker replicas
+	// is elected, either kubernetes or storage. Followers forward requests to
+	// the leader. If empty, every replica serves requests itself.
+	DataBrokerLeaderElection string `mapstructure:"databroker_leader_election" yaml:"databroker_leader_election,omitempty"`
+	// DataBrokerAdvertiseURL is the URL the other databroker replicas use to
+	// forward requests to this one when it's the leader.
+	DataBrokerAdvertiseURL string `mapstructure:"databroker_advertise_url" yaml:"databroker_advertise_url,omitempty"`
+	// DataBrokerLeaderLeaseName is the name of the kubernetes lease or of the
+	// storage lock held by the leader.
+	DataBrokerLeaderLeaseName string `mapstructure:"databroker_leader_lease_name" yaml:"databroker_leader_lease_name,omitempty"`
+	// DataBrokerLeaderLeaseTTL is how long the lease is held without being
+	// renewed before another replica can become the leader.
+	DataBrokerLeaderLeaseTTL time.Duration `mapstructure:"databroker_leader_lease_ttl" yaml:"databroker_leader_lease_ttl,omitempty"`
+	// DataBrokerHistorySize is the number of changes kept in the history of
+	// each databroker record. If zero, no history is kept.
+	DataBrokerHistorySize int `mapstructure:"databroker_history_size" yaml:"databroker_history_size,omitempty"`
+	// DataBrokerHistoryRetention is how long the history of a record is kept
+	// after its last change.
+	DataBrokerHistoryRetention time.Duration `mapstructure:"databroker_history_retention" yaml:"databroker_history_retention,omitempty"`
+	// DataBrokerCompressionThreshold is the size in bytes above which record
+	// data is compressed in storage. If set, the connections of the other
+	// services to the databroker, including their Sync streams, are
+	// compressed too. If zero, nothing is compressed.
+	DataBrokerCompressionThreshold int `mapstructure:"databroker_compression_threshold" yaml:"databroker_compression_threshold,omitempty"`
 
 	DataBrokerCertificate *tls.Certificate `mapstructure:"-" yaml:"-"`
 
@@ -256,6 +489,82 @@ type Options struct {
 	// ClientCAFile points to a file that contains the certificate authority to validate client mTLS certificates against.
 	ClientCAFile string `mapstructure:"client_ca_file" yaml:"client_ca_file,omitempty"`
 
+	// AuthorizeDecisionCacheSize is the maximum number of authorization decisions
+	// the authorize service will cache. If zero, decisions are not cached.
+	AuthorizeDecisionCacheSize int `mapstructure:"authorize_decision_cache_size" yaml:"authorize_decision_cache_size,omitempty"`
+	// AuthorizeDecisionCacheTTL is how long a cached authorization decision is valid.
+	AuthorizeDecisionCacheTTL time.Duration `mapstructure:"authorize_decision_cache_ttl" yaml:"authorize_decision_cache_ttl,omitempty"`
+	// AuthorizeDataBudget is the approximate number of bytes of session and
+	// user records the authorize service keeps in memory. When exceeded, the
+	// least recently used records are evicted, and fetched from the databroker
+	// again when needed. If zero, all the records are kept.
+	AuthorizeDataBudget int64 `mapstructure:"authorize_data_budget" yaml:"authorize_data_budget,omitempty"`
+	// AuthorizeLazySessionLoading makes the authorize service fetch sessions
+	// and users from the databroker when they're first used, instead of
+	// loading all of them on start.
+	AuthorizeLazySessionLoading bool `mapstructure:"authorize_lazy_session_loading" yaml:"authorize_lazy_session_loading,omitempty"`
+	// AuthorizeStandby starts the authorize service on standby: it keeps its
+	// databroker data and policies up to date, but its gRPC health check
+	// reports that it isn't serving until it's activated with the standby
+	// API.
+	AuthorizeStandby bool `mapstructure:"authorize_standby" yaml:"authorize_standby,omitempty"`
+
+	// AuthorizeStreamReauthorizationInterval is the maximum duration of a
+	// streaming request, such as a websocket or gRPC stream, before envoy
+	// terminates it and the client has to reconnect and be authorized again.
+	// Streams are also terminated when the session expires. If zero, streams
+	// are only authorized when they are opened.
+	AuthorizeStreamReauthorizationInterval time.Duration `mapstructure:"authorize_stream_reauthorization_interval" yaml:"authorize_stream_reauthorization_interval,omitempty"`
+
+	// PolicyDataFiles are JSON or YAML documents loaded into the rego data
+	// tree as `data.policy_data.<file name>` for use by custom policies.
+	PolicyDataFiles []string `mapstructure:"policy_data_files" yaml:"policy_data_files,omitempty"`
+
+	// GeoIPCountryDatabaseFile is a MaxMind GeoLite2 or GeoIP2 country or city
+	// database used to add the country of the client IP to the policy input.
+	GeoIPCountryDatabaseFile string `mapstructure:"geoip_country_database_file" yaml:"geoip_country_database_file,omitempty"`
+	// GeoIPASNDatabaseFile is a MaxMind GeoLite2 or GeoIP2 ASN database used to
+	// add the autonomous system of the client IP to the policy input.
+	GeoIPASNDatabaseFile string `mapstructure:"geoip_asn_database_file" yaml:"geoip_asn_database_file,omitempty"`
+
+	// DecisionLogURL is an HTTP endpoint authorization decisions are exported
+	// to in the OPA decision log format. If empty, decisions aren't exported.
+	DecisionLogURL string `mapstructure:"decision_log_url" yaml:"decision_log_url,omitempty"`
+	// DecisionLogBatchSize is the maximum number of decisions exported in a
+	// single request.
+	DecisionLogBatchSize int `mapstructure:"decision_log_batch_size" yaml:"decision_log_batch_size,omitempty"`
+	// DecisionLogFlushInterval is how often buffered decisions are exported.
+	DecisionLogFlushInterval time.Duration `mapstructure:"decision_log_flush_interval" yaml:"decision_log_flush_interval,omitempty"`
+
+	// AuditLogFile is a file access logs and other audit events are appended
+	// to as signed, hash-chained records. If empty, no audit log is written.
+	AuditLogFile string `mapstructure:"audit_log_file" yaml:"audit_log_file,omitempty"`
+	// AuditLogSigningKey is the base64-encoded PEM ECDSA private key used to
+	// sign audit log records.
+	AuditLogSigningKey string `mapstructure:"audit_log_signing_key" yaml:"audit_log_signing_key,omitempty"`
+
+	// LogArchiveURL is the object storage URL access and audit logs are
+	// archived to, i.e. s3://bucket/prefix or gs://bucket/prefix. If empty,
+	// logs aren't archived.
+	LogArchiveURL string `mapstructure:"log_archive_url" yaml:"log_archive_url,omitempty"`
+	// LogArchiveAccessKeyID and LogArchiveSecretAccessKey are the HMAC
+	// credentials used to upload archived logs.
+	LogArchiveAccessKeyID     string `mapstructure:"log_archive_access_key_id" yaml:"log_archive_access_key_id,omitempty"`
+	LogArchiveSecretAccessKey string `mapstructure:"log_archive_secret_access_key" yaml:"log_archive_secret_access_key,omitempty"`
+	// LogArchiveSpoolDir is the directory logs are kept in until they're
+	// uploaded.
+	LogArchiveSpoolDir string `mapstructure:"log_archive_spool_dir" yaml:"log_archive_spool_dir,omitempty"`
+	// LogArchiveBatchSize is the maximum number of records in an archived
+	// object.
+	LogArchiveBatchSize int `mapstructure:"log_archive_batch_size" yaml:"log_archive_batch_size,omitempty"`
+	// LogArchiveFlushInterval is the maximum time logs are kept before
+	// they're uploaded.
+	LogArchiveFlushInterval time.Duration `mapstructure:"log_archive_flush_interval" yaml:"log_archive_flush_interval,omitempty"`
+
+	// Tenants are the tenants routes can belong to. The metrics and audit
+	// events of a tenant's routes can be sent to the tenant separately.
+	Tenants []Tenant `mapstructure:"tenants" yaml:"tenants,omitempty"`
+
 	// GoogleCloudServerlessAuthenticationServiceAccount is the service account to use for GCP serverless authentication.
 	// If unset, the GCP metadata server will be used to query for identity tokens.
 	GoogleCloudServerlessAuthenticationServiceAccount string `mapstructure:"google_cloud_serverless_authentication_service_account" yaml:"google_cloud_serverless_authentication_service_account,omitempty"` //nolint
@@ -265,6 +574,46 @@ type Options struct {
 	AutocertOptions `mapstructure:",squash" yaml:",inline"`
 }
 
+// A DataBrokerRecordTTL is the time to live of a databroker record type.
+type DataBrokerRecordTTL struct {
+	// Type is the record type, e.g. type.googleapis.com/session.Session.
+	Type string        `mapstructure:"type" yaml:"type"`
+	TTL  time.Duration `mapstructure:"ttl" yaml:"ttl"`
+}
+
+// A DataBrokerClient is a client allowed to use the databroker.
+type DataBrokerClient struct {
+	// ID identifies the client. Clients send it as their
+	// databroker_client_id.
+	ID string `mapstructure:"id" yaml:"id"`
+	// Secret is the base64 encoded secret the client authenticates with.
+	Secret string `mapstructure:"secret" yaml:"secret"`
+	// Namespaces are the databroker namespaces the client can use. If empty,
+	// the client can only use the default namespace.
+	Namespaces []string `mapstructure:"namespaces" yaml:"namespaces,omitempty"`
+	// ReadTypes and WriteTypes are the record types the client can read and
+	// write, e.g. type.googleapis.com/session.Session, or * for every type.
+	ReadTypes  []string `mapstructure:"read_types" yaml:"read_types,omitempty"`
+	WriteTypes []string `mapstructure:"write_types" yaml:"write_types,omitempty"`
+}
+
+// A Tenant is a group of routes whose telemetry is isolated from that of other
+// tenants.
+type Tenant struct {
+	// Name identifies the tenant in the `tenant` option of policies and in
+	// the tenant label of metrics.
+	Name string `mapstructure:"name" yaml:"name"`
+	// AuditLogFile is a file the tenant's audit events are appended to, in
+	// addition to the audit log. It's signed with the audit log signing key.
+	AuditLogFile string `mapstructure:"audit_log_file" yaml:"audit_log_file,omitempty"`
+	// MetricsToken is the bearer token required to scrape the tenant's
+	// metrics from /metrics/tenants/<name>. If empty, the tenant's metrics
+	// aren't exported separately.
+	MetricsToken string `mapstructure:"metrics_token" yaml:"metrics_token,omitempty"`
+}
+
+var tenantNameRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
 type certificateFilePair struct {
 	// CertFile and KeyFile is the x509 certificate used to hydrate TLSCertificate
 	CertFile string `mapstructure:"cert" yaml:"cert,omitempty"`
@@ -280,6 +629,7 @@ var defaultOptions = Options{
 	CookieSecure:           true,
 	CookieExpire:           14 * time.Hour,
 	CookieName:             "_pomerium",
+	ClockSkew:              time.Minute,
 	DefaultUpstreamTimeout: 30 * time.Second,
 	Headers: map[string]string{
 		"X-Frame-Options":           "SAMEORIGIN",
@@ -301,11 +651,25 @@ var defaultOptions = Options{
 	RefreshDirectoryInterval:        10 * time.Minute,
 	RefreshDirectoryTimeout:         1 * time.Minute,
 	QPS:                             1.0,
+	AuthorizeDecisionCacheTTL:       30 * time.Second,
+	ImpersonationGrantTTL:           time.Hour,
+	KioskCodeTTL:                    10 * time.Minute,
+	KioskSessionTTL:                 30 * 24 * time.Hour,
+	DeviceAuthorizationCodeTTL:      10 * time.Minute,
+	DeviceAuthorizationSessionTTL:   14 * time.Hour,
+	AuthenticateBrokerTokenTTL:      5 * time.Minute,
+	DecisionLogBatchSize:            100,
+	DecisionLogFlushInterval:        10 * time.Second,
+	LogArchiveBatchSize:             10000,
+	LogArchiveFlushInterval:         5 * time.Minute,
 
 	AutocertOptions: AutocertOptions{
 		Folder: dataDir(),
 	},
-	DataBrokerStorageType: "memory",
+	DataBrokerStorageType:      "memory",
+	DataBrokerLeaderLeaseName:  "pomerium-databroker",
+	DataBrokerLeaderLeaseTTL:   15 * time.Second,
+	DataBrokerHistoryRetention: 7 * 24 * time.Hour,
 }
 
 // NewDefaultOptions returns a copy the default options. It's the caller's
@@ -367,8 +731,22 @@ func optionsFromViper(configFile string) (*Options, error) {
 // variables or from a file
 func (o *Options) parsePolicy() error {
 	var policies []Policy
-	// Parse from base64 env var
-	if o.PolicyEnv != "" {
+	// Parse from a policy bundle, base64 env var or the policy key
+	if o.PolicyBundleFile != "" {
+		if o.PolicyEnv != "" || o.viperIsSet("policy") || len(o.Policies) != 0 {
+			return errors.New("policy bundle file can't be used with the policy option")
+		}
+		b, err := o.readPolicyBundle()
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(b.Policy, &policies); err != nil {
+			return fmt.Errorf("could not unmarshal policy bundle yaml: %w", err)
+		}
+		o.PolicyBundleVersion = b.Version
+		o.PolicyBundleCreatedAt = b.CreatedAt
+		log.Info().Str("file", o.PolicyBundleFile).Str("version", b.Version).Msg("config: loaded policy bundle")
+	} else if o.PolicyEnv != "" {
 		policyBytes, err := base64.StdEncoding.DecodeString(o.PolicyEnv)
 		if err != nil {
 			return fmt.Errorf("could not decode POLICY env var: %w", err)
@@ -391,6 +769,39 @@ func (o *Options) parsePolicy() error {
 	return nil
 }
 
+// readPolicyBundle reads the policy bundle file, after verifying its
+// signature.
+func (o *Options) readPolicyBundle() (*policybundle.Bundle, error) {
+	if len(o.PolicyBundlePublicKeys) == 0 {
+		return nil, errors.New("policy bundle public keys are required")
+	}
+	keys := make([]*ecdsa.PublicKey, 0, len(o.PolicyBundlePublicKeys))
+	for _, raw := range o.PolicyBundlePublicKeys {
+		bs, err := base64.StdEncoding.DecodeString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("bad policy bundle public key: %w", err)
+		}
+		key, err := cryptutil.DecodePublicKey(bs)
+		if err != nil {
+			return nil, fmt.Errorf("bad policy bundle public key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return policybundle.ReadFile(o.PolicyBundleFile, keys)
+}
+
+// checkPolicyBundleUpdate checks that the options don't replace the active
+// policy bundle with one which isn't newer.
+func (o *Options) checkPolicyBundleUpdate(active *Options) error {
+	if o.PolicyBundleFile == "" || active.PolicyBundleVersion == "" {
+		return nil
+	}
+	return policybundle.CheckNewer(
+		&policybundle.Bundle{Version: o.PolicyBundleVersion, CreatedAt: o.PolicyBundleCreatedAt},
+		&policybundle.Bundle{Version: active.PolicyBundleVersion, CreatedAt: active.PolicyBundleCreatedAt},
+	)
+}
+
 func (o *Options) viperUnmarshalKey(key string, rawVal interface{}) error {
 	return o.viper.UnmarshalKey(key, &rawVal)
 }
@@ -499,6 +910,22 @@ func (o *Options) Validate() error {
 		}
 	}
 
+	if IsSidecar(o.Services) {
+		// the authorize service is only used by the sidecar's own envoy, so
+		// it only needs to listen on the loopback interface, where it's safe
+		// to serve without TLS
+		if o.GRPCAddr == defaultOptions.GRPCAddr {
+			o.GRPCAddr = "127.0.0.1" + DefaultAlternativeAddr
+		}
+		if !isLoopbackAddr(o.GRPCAddr) {
+			return fmt.Errorf("config: sidecar mode requires a loopback grpc_address, got %q", o.GRPCAddr)
+		}
+		o.GRPCInsecure = true
+		if o.AuthorizeURLString == "" {
+			o.AuthorizeURLString = "http://127.0.0.1" + DefaultAlternativeAddr
+		}
+	}
+
 	if o.DataBrokerURLString == "" {
 		log.Warn().Msg("config: cache url will be deprecated in v0.11.0")
 		o.DataBrokerURLString = o.CacheURLString
@@ -509,9 +936,117 @@ func (o *Options) Validate() error {
 		if o.DataBrokerStorageConnectionString == "" {
 			return errors.New("config: missing databroker storage backend dsn")
 		}
+		if err := validateRedisConnectionString(o.DataBrokerStorageConnectionString); err != nil {
+			return fmt.Errorf("config: bad databroker storage backend dsn: %w", err)
+		}
+	case StorageEtcdName:
+		if o.DataBrokerStorageConnectionString == "" {
+			return errors.New("config: missing databroker storage backend dsn")
+		}
+		if err := validateEtcdConnectionString(o.DataBrokerStorageConnectionString); err != nil {
+			return fmt.Errorf("config: bad databroker storage backend dsn: %w", err)
+		}
 	default:
 		return errors.New("config: unknown databroker storage backend type")
 	}
+	if o.DataBrokerStorageMaxRecords < 0 || o.DataBrokerStorageMaxBytes < 0 {
+		return errors.New("config: databroker storage limits must not be negative")
+	}
+	if (o.DataBrokerStorageMaxRecords > 0 || o.DataBrokerStorageMaxBytes > 0) && o.DataBrokerStorageType != StorageInMemoryName {
+		return errors.New("config: databroker storage limits are only supported by the memory storage")
+	}
+	if o.DataBrokerNamespace != "" && !tenantNameRegexp.MatchString(o.DataBrokerNamespace) {
+		return fmt.Errorf("config: invalid databroker namespace %q, must only contain lowercase letters, digits, _ and -", o.DataBrokerNamespace)
+	}
+	if o.DataBrokerClientID != "" {
+		if _, err := decodeEncryptionKey(o.DataBrokerClientSecret); err != nil {
+			return fmt.Errorf("config: bad databroker client secret: %w", err)
+		}
+	}
+	clientIDs := map[string]bool{}
+	for _, c := range o.DataBrokerClients {
+		if c.ID == "" {
+			return errors.New("config: databroker client `id` is required")
+		}
+		if clientIDs[c.ID] {
+			return fmt.Errorf("config: duplicate databroker client %q", c.ID)
+		}
+		clientIDs[c.ID] = true
+		if _, err := decodeEncryptionKey(c.Secret); err != nil {
+			return fmt.Errorf("config: bad secret for databroker client %q: %w", c.ID, err)
+		}
+		for _, namespace := range c.Namespaces {
+			if !tenantNameRegexp.MatchString(namespace) {
+				return fmt.Errorf("config: invalid namespace %q for databroker client %q", namespace, c.ID)
+			}
+		}
+	}
+
+	if o.SCIMBearerToken != "" && len(o.SCIMBearerToken) < minSCIMBearerTokenLength {
+		return fmt.Errorf("config: `scim_bearer_token` must be at least %d characters", minSCIMBearerTokenLength)
+	}
+
+	switch o.DataBrokerLeaderElection {
+	case "":
+	case LeaderElectionKubernetes:
+	case LeaderElectionStorage:
+		if o.DataBrokerStorageType != StorageRedisName {
+			return errors.New("config: databroker leader election with storage requires redis storage")
+		}
+	default:
+		return fmt.Errorf("config: unknown databroker leader election %q", o.DataBrokerLeaderElection)
+	}
+	if o.DataBrokerLeaderElection != "" {
+		if o.DataBrokerAdvertiseURL == "" {
+			return errors.New("config: databroker advertise url is required for leader election")
+		}
+		if _, err := urlutil.ParseAndValidateURL(o.DataBrokerAdvertiseURL); err != nil {
+			return fmt.Errorf("config: bad databroker advertise url: %w", err)
+		}
+		if o.DataBrokerLeaderLeaseName == "" {
+			return errors.New("config: databroker leader lease name is required for leader election")
+		}
+		if o.DataBrokerLeaderLeaseTTL < 3*time.Second {
+			return errors.New("config: databroker leader lease ttl must be at least 3s")
+		}
+	}
+
+	if o.DataBrokerHistorySize < 0 {
+		return errors.New("config: databroker history size must not be negative")
+	}
+	if o.DataBrokerHistorySize > 0 && o.DataBrokerHistoryRetention <= 0 {
+		return errors.New("config: databroker history retention must be positive")
+	}
+	if o.DataBrokerCompressionThreshold < 0 {
+		return errors.New("config: databroker compression threshold must not be negative")
+	}
+
+	if o.GRPCServerListeners < 0 {
+		return errors.New("config: grpc server listeners must not be negative")
+	}
+	if o.GRPCServerStreamWorkers < 0 {
+		return errors.New("config: grpc server stream workers must not be negative")
+	}
+	if o.GRPCServerMaxReceiveMessageSize < 0 {
+		return errors.New("config: grpc server max receive message size must not be negative")
+	}
+	if o.GRPCServerKeepaliveMinTime < 0 {
+		return errors.New("config: grpc server keepalive min time must not be negative")
+	}
+	if o.GRPCServerMaxConnectionAge < 0 || o.GRPCServerMaxConnectionAgeGrace < 0 {
+		return errors.New("config: grpc server max connection age must not be negative")
+	}
+
+	if o.CookieIdleTimeout != 0 && o.CookieIdleTimeout < time.Minute {
+		return errors.New("config: cookie idle timeout must be at least a minute")
+	}
+
+	if o.ClockSkew < 0 || o.ClockSkew > maxClockSkew {
+		return fmt.Errorf("config: clock skew must be between 0 and %s", maxClockSkew)
+	}
+	if o.IdpClockSkew < 0 || o.IdpClockSkew > maxClockSkew {
+		return fmt.Errorf("config: idp clock skew must be between 0 and %s", maxClockSkew)
+	}
 
 	if IsAuthorize(o.Services) || IsCache(o.Services) {
 		// if authorize is set, we don't really need a http server
@@ -569,6 +1104,9 @@ func (o *Options) Validate() error {
 	if err := o.parsePolicy(); err != nil {
 		return fmt.Errorf("config: failed to parse policy: %w", err)
 	}
+	if IsSidecar(o.Services) && len(o.Policies) > 1 {
+		return errors.New("config: sidecar mode only supports a single route")
+	}
 
 	if err := o.parseHeaders(); err != nil {
 		return fmt.Errorf("config: failed to parse headers: %w", err)
@@ -641,6 +1179,25 @@ func (o *Options) Validate() error {
 		}
 	}
 
+	// client certificates are only requested when a client ca is set
+	if o.ClientCA == "" && o.ClientCAFile == "" {
+		for _, p := range o.Policies {
+			if len(p.AllowedClientCertificateFingerprints) != 0 || len(p.AllowedClientCertificateSANs) != 0 {
+				return fmt.Errorf("config: `allowed_client_certificate_fingerprints` and `allowed_client_certificate_sans` require `client_ca` or `client_ca_file`")
+			}
+		}
+	}
+
+	if err := o.validateIdentityProviders(); err != nil {
+		return err
+	}
+	if err := o.validateRoles(); err != nil {
+		return err
+	}
+	if err := o.ClaimsMapping.validate(); err != nil {
+		return err
+	}
+
 	// if we are using google provider, default to using ServiceAccount for
 	// GoogleCloudServerlessAuthenticationServiceAccount
 	if o.Provider == "google" && o.GoogleCloudServerlessAuthenticationServiceAccount == "" {
@@ -662,7 +1219,7 @@ func (o *Options) Validate() error {
 	}
 
 	switch o.Provider {
-	case azure.Name, github.Name, gitlab.Name, google.Name, okta.Name, onelogin.Name:
+	case auth0.Name, azure.Name, github.Name, gitlab.Name, google.Name, okta.Name, onelogin.Name:
 		if len(o.Scopes) > 0 {
 			log.Warn().Msg(idpCustomScopesWarnMsg)
 		}
@@ -672,9 +1229,404 @@ func (o *Options) Validate() error {
 	if o.QPS < 1.0 {
 		o.QPS = 1.0
 	}
+
+	if o.AuthorizeDecisionCacheSize < 0 {
+		return errors.New("config: authorize decision cache size must not be negative")
+	}
+
+	if o.AuthorizeDataBudget < 0 {
+		return errors.New("config: authorize data budget must not be negative")
+	}
+	if o.JWTGroupsLimit < 0 {
+		return errors.New("config: jwt groups limit must not be negative")
+	}
+	if o.JWTClaimHeaderMaxSize < 0 {
+		return errors.New("config: jwt claim header max size must not be negative")
+	}
+
+	switch o.SessionTokenFormat {
+	case "", SessionTokenFormatJWT:
+		if o.SessionTokenLegacyMaxAge != 0 {
+			return errors.New("config: session token legacy max age requires the compact session token format")
+		}
+	case SessionTokenFormatCompact:
+		if o.SessionTokenLegacyMaxAge < 0 {
+			return errors.New("config: session token legacy max age must not be negative")
+		}
+	default:
+		return fmt.Errorf("config: unknown session token format %q", o.SessionTokenFormat)
+	}
+
+	switch o.InteropProvider {
+	case "":
+	case InteropProviderCloudflareAccess:
+		if o.InteropCloudflareTeamDomain == "" || o.InteropCloudflareAudience == "" {
+			return errors.New("config: cloudflare access interop requires a team domain and audience")
+		}
+	case InteropProviderAWSALB:
+		if o.InteropAWSALBRegion == "" || o.InteropAWSALBARN == "" {
+			return errors.New("config: aws alb interop requires a region and load balancer arn")
+		}
+	default:
+		return fmt.Errorf("config: unknown interop provider %q", o.InteropProvider)
+	}
+
+	if o.ForwardAuthCacheTTL < 0 {
+		return errors.New("config: forward auth cache ttl must not be negative")
+	}
+	switch o.ForwardAuthCacheType {
+	case "", StorageInMemoryName:
+	case StorageRedisName:
+		if err := validateRedisConnectionString(o.ForwardAuthCacheConnectionString); err != nil {
+			return fmt.Errorf("config: bad forward auth cache connection string: %w", err)
+		}
+	default:
+		return fmt.Errorf("config: unknown forward auth cache type %q", o.ForwardAuthCacheType)
+	}
+
+	for _, domain := range o.ProgrammaticRedirectDomains {
+		if domain == "" || strings.ContainsAny(domain, "/:") {
+			return fmt.Errorf("config: invalid programmatic redirect domain %q", domain)
+		}
+	}
+
+	if o.AuthorizeStreamReauthorizationInterval < 0 {
+		return errors.New("config: authorize stream reauthorization interval must not be negative")
+	}
+
+	if o.ImpersonationGrantTTL < 0 {
+		return errors.New("config: impersonation grant ttl must not be negative")
+	} else if o.ImpersonationGrantTTL == 0 {
+		o.ImpersonationGrantTTL = defaultOptions.ImpersonationGrantTTL
+	}
+
+	if o.KioskCodeTTL < 0 {
+		return errors.New("config: kiosk code ttl must not be negative")
+	} else if o.KioskCodeTTL == 0 {
+		o.KioskCodeTTL = defaultOptions.KioskCodeTTL
+	}
+
+	if o.KioskSessionTTL < 0 {
+		return errors.New("config: kiosk session ttl must not be negative")
+	} else if o.KioskSessionTTL == 0 {
+		o.KioskSessionTTL = defaultOptions.KioskSessionTTL
+	}
+
+	if o.DeviceAuthorizationCodeTTL < 0 {
+		return errors.New("config: device authorization code ttl must not be negative")
+	} else if o.DeviceAuthorizationCodeTTL == 0 {
+		o.DeviceAuthorizationCodeTTL = defaultOptions.DeviceAuthorizationCodeTTL
+	}
+
+	if o.DeviceAuthorizationSessionTTL < 0 {
+		return errors.New("config: device authorization session ttl must not be negative")
+	} else if o.DeviceAuthorizationSessionTTL == 0 {
+		o.DeviceAuthorizationSessionTTL = defaultOptions.DeviceAuthorizationSessionTTL
+	}
+
+	for _, origin := range o.AuthenticateBrokerAllowedOrigins {
+		if err := validateOrigin(origin); err != nil {
+			return fmt.Errorf("config: bad authenticate broker allowed origin %s: %w", origin, err)
+		}
+	}
+
+	if o.AuthenticateBrokerTokenTTL < 0 {
+		return errors.New("config: authenticate broker token ttl must not be negative")
+	} else if o.AuthenticateBrokerTokenTTL == 0 {
+		o.AuthenticateBrokerTokenTTL = defaultOptions.AuthenticateBrokerTokenTTL
+	}
+
+	if o.DecisionLogURL != "" {
+		if err := validateDecisionLogURL(o.DecisionLogURL); err != nil {
+			return fmt.Errorf("config: bad decision log url: %w", err)
+		}
+	}
+
+	if o.DecisionLogBatchSize < 0 {
+		return errors.New("config: decision log batch size must not be negative")
+	} else if o.DecisionLogBatchSize == 0 {
+		o.DecisionLogBatchSize = defaultOptions.DecisionLogBatchSize
+	}
+
+	recordTypes := make(map[string]bool, len(o.DataBrokerRecordTTLs))
+	for _, ttl := range o.DataBrokerRecordTTLs {
+		if ttl.Type == "" {
+			return errors.New("config: databroker record ttl is missing a type")
+		}
+		if ttl.TTL <= 0 {
+			return fmt.Errorf("config: databroker record ttl for %s must be positive", ttl.Type)
+		}
+		if recordTypes[ttl.Type] {
+			return fmt.Errorf("config: duplicate databroker record ttl for %s", ttl.Type)
+		}
+		recordTypes[ttl.Type] = true
+	}
+
+	if o.DataBrokerEncryptionKey != "" {
+		if _, err := decodeEncryptionKey(o.DataBrokerEncryptionKey); err != nil {
+			return fmt.Errorf("config: bad databroker encryption key: %w", err)
+		}
+	}
+	for _, key := range o.DataBrokerPreviousEncryptionKeys {
+		if _, err := decodeEncryptionKey(key); err != nil {
+			return fmt.Errorf("config: bad databroker previous encryption key: %w", err)
+		}
+	}
+
+	if o.DecisionLogFlushInterval < 0 {
+		return errors.New("config: decision log flush interval must not be negative")
+	} else if o.DecisionLogFlushInterval == 0 {
+		o.DecisionLogFlushInterval = defaultOptions.DecisionLogFlushInterval
+	}
+
+	if o.AuditLogFile != "" {
+		if o.AuditLogSigningKey == "" {
+			return errors.New("config: audit log signing key is required to write an audit log")
+		}
+		if _, err := o.GetAuditLogSigningKey(); err != nil {
+			return fmt.Errorf("config: bad audit log signing key: %w", err)
+		}
+	}
+
+	tenants := make(map[string]bool, len(o.Tenants))
+	for _, tenant := range o.Tenants {
+		if !tenantNameRegexp.MatchString(tenant.Name) {
+			return fmt.Errorf("config: invalid tenant name %q, must only contain lowercase letters, digits, _ and -", tenant.Name)
+		}
+		if tenants[tenant.Name] {
+			return fmt.Errorf("config: duplicate tenant %s", tenant.Name)
+		}
+		tenants[tenant.Name] = true
+		if tenant.AuditLogFile != "" && o.AuditLogSigningKey == "" {
+			return fmt.Errorf("config: audit log signing key is required to write the audit log of tenant %s", tenant.Name)
+		}
+	}
+	for _, p := range o.Policies {
+		if p.Tenant != "" && !tenants[p.Tenant] {
+			return fmt.Errorf("config: policy %s: unknown tenant %s", p.From, p.Tenant)
+		}
+	}
+
+	if o.LogArchiveURL != "" {
+		if err := validateLogArchiveURL(o.LogArchiveURL); err != nil {
+			return fmt.Errorf("config: bad log archive url: %w", err)
+		}
+		if o.LogArchiveSpoolDir == "" {
+			return errors.New("config: log archive spool directory is required to archive logs")
+		}
+		if (o.LogArchiveAccessKeyID == "") != (o.LogArchiveSecretAccessKey == "") {
+			return errors.New("config: log archive access key id and secret access key must be set together")
+		}
+	}
+	for _, pattern := range append(append([]string{}, o.LogRedactQueryParams...), o.LogRedactHeaders...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("config: bad log redaction pattern %q: %w", pattern, err)
+		}
+	}
+
+	if o.LogArchiveBatchSize < 0 {
+		return errors.New("config: log archive batch size must not be negative")
+	} else if o.LogArchiveBatchSize == 0 {
+		o.LogArchiveBatchSize = defaultOptions.LogArchiveBatchSize
+	}
+	if o.LogArchiveFlushInterval < 0 {
+		return errors.New("config: log archive flush interval must not be negative")
+	} else if o.LogArchiveFlushInterval == 0 {
+		o.LogArchiveFlushInterval = defaultOptions.LogArchiveFlushInterval
+	}
+
+	for _, f := range o.PolicyDataFiles {
+		if _, err := os.Stat(f); err != nil {
+			return fmt.Errorf("config: couldn't load policy data file: %w", err)
+		}
+	}
+
+	for _, f := range []string{o.GeoIPCountryDatabaseFile, o.GeoIPASNDatabaseFile} {
+		if f == "" {
+			continue
+		}
+		if _, err := os.Stat(f); err != nil {
+			return fmt.Errorf("config: couldn't load geoip database file: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateOrigin returns an error if the string isn't a web origin, i.e. an
+// http or https scheme and host with no path, query or fragment.
+func validateOrigin(origin string) error {
+	u, err := urlutil.ParseAndValidateURL(origin)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return errors.New("origin must only contain a scheme, host and port")
+	}
+	return nil
+}
+
+// validateRedisConnectionString returns an error if the string isn't a redis
+// URL. The errors never include the string, as it may contain a password.
+func validateRedisConnectionString(dsn string) error {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return errors.New("invalid url")
+	}
+	if u.Scheme != "redis" && u.Scheme != "rediss" {
+		return fmt.Errorf("unsupported scheme %q, must be redis or rediss", u.Scheme)
+	}
+	if u.Host == "" {
+		return errors.New("missing host")
+	}
+	return nil
+}
+
+// validateEtcdConnectionString validates an etcd connection string of the
+// form etcd[s]://[username:password@]host:port[,host:port...]. Like
+// validateRedisConnectionString, errors never include the string itself.
+func validateEtcdConnectionString(dsn string) error {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return errors.New("invalid url")
+	}
+	if u.Scheme != "etcd" && u.Scheme != "etcds" {
+		return fmt.Errorf("unsupported scheme %q, must be etcd or etcds", u.Scheme)
+	}
+	for _, host := range strings.Split(u.Host, ",") {
+		if host == "" {
+			return errors.New("missing host")
+		}
+	}
+	return nil
+}
+
+// validateLogArchiveURL validates an object storage URL of the form
+// s3://bucket/prefix or gs://bucket/prefix.
+func validateLogArchiveURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New("invalid url")
+	}
+	if u.Scheme != "s3" && u.Scheme != "gs" {
+		return fmt.Errorf("unsupported scheme %q, must be s3 or gs", u.Scheme)
+	}
+	if u.Host == "" {
+		return errors.New("missing bucket")
+	}
+	return nil
+}
+
+// validateDecisionLogURL validates a decision log URL, which is either an HTTP
+// endpoint or a Kafka topic.
+func validateDecisionLogURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New("invalid url")
+	}
+	switch u.Scheme {
+	case "kafka", "kafkas":
+		if _, err := kafka.ParseURL(rawURL); err != nil {
+			return err
+		}
+		switch u.Query().Get("partition_key") {
+		case "", "user", "route":
+		default:
+			return errors.New("partition_key must be user or route")
+		}
+		return nil
+	}
+	_, err = urlutil.ParseAndValidateURL(rawURL)
+	return err
+}
+
+// GetDataBrokerRecordTTLs returns the databroker record TTLs by type.
+func (o *Options) GetDataBrokerRecordTTLs() map[string]time.Duration {
+	ttls := make(map[string]time.Duration, len(o.DataBrokerRecordTTLs))
+	for _, ttl := range o.DataBrokerRecordTTLs {
+		ttls[ttl.Type] = ttl.TTL
+	}
+	return ttls
+}
+
+// databrokerEncryptionKeyInfo identifies the databroker encryption key
+// derived from the shared secret.
+const databrokerEncryptionKeyInfo = "pomerium databroker encryption key"
+
+// GetDataBrokerEncryptionKeys returns the keys used to encrypt databroker
+// records. The first key encrypts records, and the others are previous keys
+// which only decrypt them. Records were encrypted with the shared secret
+// itself before keys were derived from it, so it's always a previous key.
+func (o *Options) GetDataBrokerEncryptionKeys() ([][]byte, error) {
+	sharedKey, err := decodeEncryptionKey(o.SharedKey)
+	if err != nil {
+		return nil, fmt.Errorf("config: bad shared secret: %w", err)
+	}
+	derived := cryptutil.DeriveKey(sharedKey, databrokerEncryptionKeyInfo)
+
+	var keys [][]byte
+	if o.DataBrokerEncryptionKey != "" {
+		key, err := decodeEncryptionKey(o.DataBrokerEncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("config: bad databroker encryption key: %w", err)
+		}
+		keys = append(keys, key, derived)
+	} else {
+		keys = append(keys, derived)
+	}
+	for _, s := range o.DataBrokerPreviousEncryptionKeys {
+		key, err := decodeEncryptionKey(s)
+		if err != nil {
+			return nil, fmt.Errorf("config: bad databroker previous encryption key: %w", err)
+		}
+		// a previous key may also be a previous shared secret
+		keys = append(keys, key, cryptutil.DeriveKey(key, databrokerEncryptionKeyInfo))
+	}
+	return append(keys, sharedKey), nil
+}
+
+// GetDataBrokerClientSecret returns the secret the services authenticate to
+// the databroker with, or nil if they don't.
+func (o *Options) GetDataBrokerClientSecret() []byte {
+	if o.DataBrokerClientID == "" {
+		return nil
+	}
+	secret, _ := decodeEncryptionKey(o.DataBrokerClientSecret)
+	return secret
+}
+
+func decodeEncryptionKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid base64")
+	}
+	if len(key) != cryptutil.DefaultKeySize {
+		return nil, fmt.Errorf("got %d bytes but want %d", len(key), cryptutil.DefaultKeySize)
+	}
+	return key, nil
+}
+
+// GetTenant returns the tenant with the given name, or nil if there is none.
+func (o *Options) GetTenant(name string) *Tenant {
+	for i := range o.Tenants {
+		if o.Tenants[i].Name == name {
+			return &o.Tenants[i]
+		}
+	}
 	return nil
 }
 
+// GetAuditLogSigningKey decodes the AuditLogSigningKey.
+func (o *Options) GetAuditLogSigningKey() (*ecdsa.PrivateKey, error) {
+	bs, err := base64.StdEncoding.DecodeString(o.AuditLogSigningKey)
+	if err != nil {
+		return nil, err
+	}
+	return cryptutil.DecodePrivateKey(bs)
+}
+
 // GetAuthenticateURL returns the AuthenticateURL in the options or 127.0.0.1.
 func (o *Options) GetAuthenticateURL() *url.URL {
 	if o != nil && o.AuthenticateURL != nil {
@@ -723,9 +1675,20 @@ func (o *Options) GetOauthOptions() oauth.Options {
 		ClientSecret:   o.ClientSecret,
 		Scopes:         o.Scopes,
 		ServiceAccount: o.ServiceAccount,
+		ClockSkew:      o.GetIdpClockSkew(),
+		SAMLAttributes: o.SAMLAttributes,
 	}
 }
 
+// GetIdpClockSkew returns the clock skew tolerated when validating the
+// identity provider's ID tokens.
+func (o *Options) GetIdpClockSkew() time.Duration {
+	if o.IdpClockSkew != 0 {
+		return o.IdpClockSkew
+	}
+	return o.ClockSkew
+}
+
 // Checksum returns the checksum of the current options struct
 func (o *Options) Checksum() uint64 {
 	hash, err := hashstructure.Hash(o, &hashstructure.HashOptions{Hasher: xxhash.New()})
@@ -940,6 +1903,11 @@ func handleConfigUpdate(configFile string, opt *Options) *Options {
 		metrics.SetConfigInfo(serviceName, false)
 		return opt
 	}
+	if err := newOpt.checkPolicyBundleUpdate(opt); err != nil {
+		log.Error().Err(err).Msg("config: could not reload configuration")
+		metrics.SetConfigInfo(serviceName, false)
+		return opt
+	}
 	optChecksum := opt.Checksum()
 	newOptChecksum := newOpt.Checksum()
 
@@ -993,6 +1961,19 @@ func min(x, y int) int {
 	return y
 }
 
+// isLoopbackAddr reports whether addr only listens on the loopback interface.
+func isLoopbackAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // AtomicOptions are Options that can be access atomically.
 type AtomicOptions struct {
 	value atomic.Value
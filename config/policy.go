@@ -1,22 +1,28 @@
 package config
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"os"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/cespare/xxhash/v2"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/mitchellh/hashstructure"
 
+	"github.com/pomerium/pomerium/internal/openapi"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
 )
 
 // Policy contains route specific configuration and access settings.
@@ -28,6 +34,70 @@ type Policy struct {
 	AllowedGroups  []string `mapstructure:"allowed_groups" yaml:"allowed_groups,omitempty" json:"allowed_groups,omitempty"`
 	AllowedDomains []string `mapstructure:"allowed_domains" yaml:"allowed_domains,omitempty" json:"allowed_domains,omitempty"`
 
+	// AllowedGRPCMethods restricts gRPC requests to methods matching one of
+	// the given patterns, e.g. "pkg.Service/Get*". Non-gRPC requests are not
+	// affected.
+	AllowedGRPCMethods []string `mapstructure:"allowed_grpc_methods" yaml:"allowed_grpc_methods,omitempty" json:"allowed_grpc_methods,omitempty"`
+
+	// OpenAPISpecFile is an OpenAPI document of the route's API. Requests are
+	// matched to its operations, so policies can allow operations by id.
+	OpenAPISpecFile string `mapstructure:"openapi_spec_file" yaml:"openapi_spec_file,omitempty" json:"-"`
+	// OpenAPISpec is the parsed OpenAPISpecFile.
+	OpenAPISpec *openapi.Spec `yaml:"-" json:"-" hash:"ignore"`
+	// AllowedOpenAPIOperations restricts the route to operations of the
+	// OpenAPI document with ids matching one of the given patterns, e.g.
+	// "list*". Requests which don't match an operation are denied.
+	AllowedOpenAPIOperations []string `mapstructure:"allowed_openapi_operations" yaml:"allowed_openapi_operations,omitempty" json:"allowed_openapi_operations,omitempty"`
+
+	// GraphQL marks the route as a GraphQL API. The operation of each request
+	// is read from its query or body, so policies can allow it by its fields.
+	GraphQL bool `mapstructure:"graphql" yaml:"graphql,omitempty" json:"graphql,omitempty"`
+	// AllowedGraphQLFields restricts GraphQL requests to operations whose
+	// top-level fields all match one of the given patterns of the form
+	// "type.field", e.g. "query.*". Requests which aren't a valid GraphQL
+	// operation are denied.
+	AllowedGraphQLFields []string `mapstructure:"allowed_graphql_fields" yaml:"allowed_graphql_fields,omitempty" json:"allowed_graphql_fields,omitempty"`
+
+	// AllowedIDPClaims allows users whose identity provider claims each have
+	// one of the given values, keyed by claim name. Every claim must match. A
+	// claim which is a list matches if any of its values match.
+	AllowedIDPClaims map[string][]interface{} `mapstructure:"allowed_idp_claims" yaml:"allowed_idp_claims,omitempty" json:"allowed_idp_claims,omitempty"`
+
+	// AllowedClientCertificateFingerprints restricts the route to client
+	// certificates with one of the given hex-encoded SHA-256 fingerprints.
+	AllowedClientCertificateFingerprints []string `mapstructure:"allowed_client_certificate_fingerprints" yaml:"allowed_client_certificate_fingerprints,omitempty" json:"allowed_client_certificate_fingerprints,omitempty"`
+	// AllowedClientCertificateSANs restricts the route to client certificates
+	// with one of the given DNS, email, IP or URI subject alternative names.
+	AllowedClientCertificateSANs []string `mapstructure:"allowed_client_certificate_sans" yaml:"allowed_client_certificate_sans,omitempty" json:"allowed_client_certificate_sans,omitempty"`
+	// AllowedIPLists restricts the route to client addresses in one of the
+	// named IP lists, which are managed in the databroker.
+	AllowedIPLists []string `mapstructure:"allowed_ip_lists" yaml:"allowed_ip_lists,omitempty" json:"allowed_ip_lists,omitempty"`
+	// AllowedSessionMaxAge requires users to have signed in with the identity
+	// provider within the given duration, so sensitive routes can require a
+	// recent sign in without shortening every session.
+	AllowedSessionMaxAge time.Duration `mapstructure:"allowed_session_max_age" yaml:"allowed_session_max_age,omitempty" json:"allowed_session_max_age,omitempty"`
+	// RequiredACRValues and RequiredAMRValues require users to have signed
+	// in with one of the given authentication context classes, and with one
+	// of the given authentication methods, e.g. "mfa". Users who haven't are
+	// asked to sign in again with the identity provider.
+	RequiredACRValues []string `mapstructure:"required_acr_values" yaml:"required_acr_values,omitempty" json:"required_acr_values,omitempty"`
+	RequiredAMRValues []string `mapstructure:"required_amr_values" yaml:"required_amr_values,omitempty" json:"required_amr_values,omitempty"`
+	// StepUpMaxAge additionally requires the step-up authentication to have
+	// happened within the given duration.
+	StepUpMaxAge time.Duration `mapstructure:"step_up_max_age" yaml:"step_up_max_age,omitempty" json:"step_up_max_age,omitempty"`
+	// IdentityProviderID requires users to have signed in with the identity
+	// provider with the given id. Users without a session are sent to sign in
+	// with it, instead of choosing an identity provider.
+	IdentityProviderID string `mapstructure:"idp_id" yaml:"idp_id,omitempty" json:"idp_id,omitempty"`
+	// AllowedRoles allows users while they have actively assumed one of the
+	// given roles.
+	AllowedRoles []string `mapstructure:"allowed_roles" yaml:"allowed_roles,omitempty" json:"allowed_roles,omitempty"`
+
+	// Denied identities take precedence over any allowed identities
+	DeniedUsers   []string `mapstructure:"denied_users" yaml:"denied_users,omitempty" json:"denied_users,omitempty"`
+	DeniedGroups  []string `mapstructure:"denied_groups" yaml:"denied_groups,omitempty" json:"denied_groups,omitempty"`
+	DeniedDomains []string `mapstructure:"denied_domains" yaml:"denied_domains,omitempty" json:"denied_domains,omitempty"`
+
 	Source      *StringURL `yaml:",omitempty" json:"source,omitempty" hash:"ignore"`
 	Destination *url.URL   `yaml:",omitempty" json:"destination,omitempty" hash:"ignore"`
 
@@ -47,6 +117,33 @@ type Policy struct {
 	// timeout. If unset,  route will fallback to the proxy's DefaultUpstreamTimeout.
 	UpstreamTimeout time.Duration `mapstructure:"timeout" yaml:"timeout,omitempty"`
 
+	// HedgeDelay enables request hedging for idempotent requests. If an
+	// upstream hasn't responded to a GET or HEAD request within the delay, the
+	// request is sent again to another upstream host and whichever response
+	// arrives first is used. It should be set to around the P99 latency of
+	// the upstream.
+	HedgeDelay time.Duration `mapstructure:"hedge_delay" yaml:"hedge_delay,omitempty"`
+
+	// MaxStreamDuration is the longest a request and its response may take,
+	// including streamed responses and websocket connections, which the route
+	// timeout doesn't otherwise apply to. If zero, there is no limit.
+	MaxStreamDuration time.Duration `mapstructure:"max_stream_duration" yaml:"max_stream_duration,omitempty"`
+	// StreamIdleTimeout is how long a stream may go without sending or
+	// receiving any data before it's reset. Since envoy stops reading from the
+	// upstream while a client doesn't read the response, this also drops
+	// clients which stopped reading. If zero, envoy's default of five minutes
+	// is used.
+	StreamIdleTimeout time.Duration `mapstructure:"stream_idle_timeout" yaml:"stream_idle_timeout,omitempty"`
+
+	// TracingSampleRate is the fraction of requests to this route which are
+	// traced. If unset, the global tracing_sample_rate is used.
+	TracingSampleRate *float64 `mapstructure:"tracing_sample_rate" yaml:"tracing_sample_rate,omitempty"`
+
+	// Tenant is the name of the tenant the route belongs to. The route's
+	// metrics are labelled with the tenant and its audit events are also
+	// written to the tenant's audit log.
+	Tenant string `mapstructure:"tenant" yaml:"tenant,omitempty"`
+
 	// Enable proxying of websocket connections by removing the default timeout handler.
 	// Caution: Enabling this feature could result in abuse via DOS attacks.
 	AllowWebsockets bool `mapstructure:"allow_websockets"  yaml:"allow_websockets,omitempty"`
@@ -54,6 +151,55 @@ type Policy struct {
 	// AllowSPDY enables proxying of SPDY upgrade requests
 	AllowSPDY bool `mapstructure:"allow_spdy" yaml:"allow_spdy,omitempty"`
 
+	// AllowH2CUpstream enables proxying to a cleartext HTTP/2 upstream using
+	// prior knowledge, such as a gRPC server without TLS.
+	AllowH2CUpstream bool `mapstructure:"allow_h2c_upstream" yaml:"allow_h2c_upstream,omitempty"`
+
+	// UpstreamMaxRequestsPerConnection is the maximum number of requests sent
+	// over a single upstream connection before it's closed. If zero, there is
+	// no limit.
+	UpstreamMaxRequestsPerConnection uint32 `mapstructure:"upstream_max_requests_per_connection" yaml:"upstream_max_requests_per_connection,omitempty"`
+	// UpstreamIdleTimeout is how long an upstream connection may be idle
+	// before it's closed. Setting it below the idle timeout of any NAT gateway
+	// or load balancer in front of the upstream avoids sending requests on
+	// connections which were silently dropped. If zero, envoy's default of an
+	// hour is used.
+	UpstreamIdleTimeout time.Duration `mapstructure:"upstream_idle_timeout" yaml:"upstream_idle_timeout,omitempty"`
+	// UpstreamTCPKeepaliveTime enables TCP keepalive probes on upstream
+	// connections once they have been idle for the given duration.
+	UpstreamTCPKeepaliveTime time.Duration `mapstructure:"upstream_tcp_keepalive_time" yaml:"upstream_tcp_keepalive_time,omitempty"`
+	// UpstreamTCPKeepaliveInterval is the duration between TCP keepalive
+	// probes. If zero, the operating system's default is used.
+	UpstreamTCPKeepaliveInterval time.Duration `mapstructure:"upstream_tcp_keepalive_interval" yaml:"upstream_tcp_keepalive_interval,omitempty"`
+	// UpstreamTCPKeepaliveProbes is the number of unanswered TCP keepalive
+	// probes before the connection is closed. If zero, the operating system's
+	// default is used.
+	UpstreamTCPKeepaliveProbes uint32 `mapstructure:"upstream_tcp_keepalive_probes" yaml:"upstream_tcp_keepalive_probes,omitempty"`
+	// UpstreamSlowStartWindow enables slow start for newly added upstream
+	// endpoints, such as pods added by an autoscaler behind a headless
+	// service. During the window an endpoint's share of the traffic grows
+	// gradually instead of it receiving a full share at once.
+	UpstreamSlowStartWindow time.Duration `mapstructure:"upstream_slow_start_window" yaml:"upstream_slow_start_window,omitempty"`
+	// UpstreamSlowStartAggression controls how quickly traffic ramps up
+	// during the slow start window. 1 is linear, larger values send less
+	// traffic at the start of the window. If zero, envoy's default of 1 is
+	// used.
+	UpstreamSlowStartAggression float64 `mapstructure:"upstream_slow_start_aggression" yaml:"upstream_slow_start_aggression,omitempty"`
+
+	// UpstreamMaxConcurrentRequests is the maximum number of requests, and
+	// connections, sent to the upstream at the same time. Further requests
+	// wait in a queue of UpstreamMaxPendingRequests. If zero, envoy's default
+	// of 1024 is used.
+	UpstreamMaxConcurrentRequests uint32 `mapstructure:"upstream_max_concurrent_requests" yaml:"upstream_max_concurrent_requests,omitempty"`
+	// UpstreamMaxPendingRequests is the maximum number of requests waiting
+	// for the upstream. Requests beyond it are rejected with a 503. If zero,
+	// envoy's default of 1024 is used.
+	UpstreamMaxPendingRequests uint32 `mapstructure:"upstream_max_pending_requests" yaml:"upstream_max_pending_requests,omitempty"`
+	// PriorityGroups are the groups whose requests are sent to the upstream
+	// with a high priority. They have their own concurrency limits, so they
+	// aren't queued behind other users' requests when the route is busy.
+	PriorityGroups []string `mapstructure:"priority_groups" yaml:"priority_groups,omitempty"`
+
 	// TLSSkipVerify controls whether a client verifies the server's certificate
 	// chain and host name.
 	// If TLSSkipVerify is true, TLS accepts any certificate presented by the
@@ -68,6 +214,19 @@ type Policy struct {
 	// Docker container name).
 	TLSServerName string `mapstructure:"tls_server_name" yaml:"tls_server_name,omitempty"`
 
+	// TLSVerifyHostname overrides the name the upstream certificate is
+	// verified against. This is useful if the backend is fronted by a shared
+	// load balancer whose certificate doesn't match the server name sent via
+	// SNI. Defaults to TLSServerName, or the hostname in the `to` field.
+	TLSVerifyHostname string `mapstructure:"tls_verify_hostname" yaml:"tls_verify_hostname,omitempty"`
+
+	// TLSPinnedSPKI is a list of base64-encoded SHA-256 hashes of the subject
+	// public key info of upstream certificates. If set, the upstream
+	// certificate must match one of the hashes. Unless a custom CA is also
+	// set, a pinned certificate doesn't need to be signed by a trusted CA,
+	// which allows self-signed certificates to be verified strictly.
+	TLSPinnedSPKI []string `mapstructure:"tls_pinned_spki" yaml:"tls_pinned_spki,omitempty"`
+
 	// TLSCustomCA defines the  root certificate to use with a given
 	// route when verifying server certificates.
 	TLSCustomCA     string `mapstructure:"tls_custom_ca" yaml:"tls_custom_ca,omitempty"`
@@ -107,6 +266,16 @@ type Policy struct {
 	//
 	PassIdentityHeaders bool `mapstructure:"pass_identity_headers" yaml:"pass_identity_headers,omitempty"`
 
+	// SigningKey is the base64 encoded PEM ECDSA private key used to sign
+	// the X-Pomerium-Jwt-Assertion header of this route, instead of the
+	// global signing key.
+	SigningKey string `mapstructure:"signing_key" yaml:"signing_key,omitempty" json:"-"`
+
+	// JWTAssertionTTL, if set, limits the lifetime of the
+	// X-Pomerium-Jwt-Assertion header of this route, and adds a unique jti
+	// claim to it so upstreams can reject replayed assertions.
+	JWTAssertionTTL time.Duration `mapstructure:"jwt_assertion_ttl" yaml:"jwt_assertion_ttl,omitempty" json:"-"`
+
 	// KubernetesServiceAccountToken is the kubernetes token to use for upstream requests.
 	KubernetesServiceAccountToken string `mapstructure:"kubernetes_service_account_token" yaml:"kubernetes_service_account_token,omitempty"`
 	// KubernetesServiceAccountTokenFile contains the kubernetes token to use for upstream requests.
@@ -116,7 +285,19 @@ type Policy struct {
 	// to upstream requests.
 	EnableGoogleCloudServerlessAuthentication bool `mapstructure:"enable_google_cloud_serverless_authentication" yaml:"enable_google_cloud_serverless_authentication,omitempty"` //nolint
 
+	// UpstreamOAuth2 adds "Authorization: Bearer" headers with client
+	// credentials access tokens to upstream requests.
+	UpstreamOAuth2 *UpstreamOAuth2 `mapstructure:"upstream_oauth2" yaml:"upstream_oauth2,omitempty" json:"upstream_oauth2,omitempty"`
+
+	// CDN redirects authorized requests to signed URLs on a content delivery
+	// network, instead of proxying them to the upstream.
+	CDN *CDN `mapstructure:"cdn" yaml:"cdn,omitempty" json:"cdn,omitempty"`
+
 	SubPolicies []SubPolicy `mapstructure:"sub_policies" yaml:"sub_policies,omitempty" json:"sub_policies,omitempty"`
+
+	// DenyResponse customizes the response returned by the authorize service
+	// when a request to this route is denied.
+	DenyResponse *DenyResponse `mapstructure:"deny_response" yaml:"deny_response,omitempty" json:"deny_response,omitempty"`
 }
 
 // A SubPolicy is a protobuf Policy within a protobuf Route.
@@ -127,6 +308,20 @@ type SubPolicy struct {
 	AllowedGroups  []string `mapstructure:"allowed_groups" yaml:"allowed_groups,omitempty" json:"allowed_groups,omitempty"`
 	AllowedDomains []string `mapstructure:"allowed_domains" yaml:"allowed_domains,omitempty" json:"allowed_domains,omitempty"`
 	Rego           []string `mapstructure:"rego" yaml:"rego" json:"rego,omitempty"`
+	// AllowedGRPCMethods limits the users, groups and domains allowed by this
+	// sub-policy to gRPC methods matching one of the given patterns.
+	AllowedGRPCMethods []string `mapstructure:"allowed_grpc_methods" yaml:"allowed_grpc_methods,omitempty" json:"allowed_grpc_methods,omitempty"`
+	// AllowedOpenAPIOperations limits the users, groups and domains allowed
+	// by this sub-policy to OpenAPI operations with ids matching one of the
+	// given patterns.
+	AllowedOpenAPIOperations []string `mapstructure:"allowed_openapi_operations" yaml:"allowed_openapi_operations,omitempty" json:"allowed_openapi_operations,omitempty"`
+	// AllowedGraphQLFields limits the users, groups and domains allowed by
+	// this sub-policy to GraphQL operations whose top-level fields all match
+	// one of the given patterns.
+	AllowedGraphQLFields []string `mapstructure:"allowed_graphql_fields" yaml:"allowed_graphql_fields,omitempty" json:"allowed_graphql_fields,omitempty"`
+	// AllowedIDPClaims allows users whose identity provider claims each have
+	// one of the given values, keyed by claim name.
+	AllowedIDPClaims map[string][]interface{} `mapstructure:"allowed_idp_claims" yaml:"allowed_idp_claims,omitempty" json:"allowed_idp_claims,omitempty"`
 }
 
 // NewPolicyFromProto creates a new Policy from a protobuf policy config route.
@@ -148,6 +343,8 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		AllowWebsockets:                  pb.GetAllowWebsockets(),
 		TLSSkipVerify:                    pb.GetTlsSkipVerify(),
 		TLSServerName:                    pb.GetTlsServerName(),
+		TLSVerifyHostname:                pb.GetTlsVerifyHostname(),
+		TLSPinnedSPKI:                    pb.GetTlsPinnedSpki(),
 		TLSCustomCA:                      pb.GetTlsCustomCa(),
 		TLSCustomCAFile:                  pb.GetTlsCustomCaFile(),
 		TLSClientCert:                    pb.GetTlsClientCert(),
@@ -203,6 +400,8 @@ func (p *Policy) ToProto() *configpb.Route {
 		AllowWebsockets:                  p.AllowWebsockets,
 		TlsSkipVerify:                    p.TLSSkipVerify,
 		TlsServerName:                    p.TLSServerName,
+		TlsVerifyHostname:                p.TLSVerifyHostname,
+		TlsPinnedSpki:                    p.TLSPinnedSPKI,
 		TlsCustomCa:                      p.TLSCustomCA,
 		TlsCustomCaFile:                  p.TLSCustomCAFile,
 		TlsClientCert:                    p.TLSClientCert,
@@ -239,8 +438,131 @@ func (p *Policy) Validate() error {
 		return fmt.Errorf("config: policy bad destination url %w", err)
 	}
 
+	if err := validateGRPCMethodPatterns(p.AllowedGRPCMethods); err != nil {
+		return err
+	}
+	if err := validateIDPClaims(p.AllowedIDPClaims); err != nil {
+		return err
+	}
+	for _, sp := range p.SubPolicies {
+		if err := validateGRPCMethodPatterns(sp.AllowedGRPCMethods); err != nil {
+			return err
+		}
+		if err := validateIDPClaims(sp.AllowedIDPClaims); err != nil {
+			return err
+		}
+		if len(sp.AllowedOpenAPIOperations) > 0 && p.OpenAPISpecFile == "" {
+			return fmt.Errorf("config: `allowed_openapi_operations` requires an `openapi_spec_file`")
+		}
+		if err := p.validateGraphQLFieldPatterns(sp.AllowedGraphQLFields); err != nil {
+			return err
+		}
+	}
+	if err := p.validateGraphQLFieldPatterns(p.AllowedGraphQLFields); err != nil {
+		return err
+	}
+
+	if len(p.AllowedOpenAPIOperations) > 0 && p.OpenAPISpecFile == "" {
+		return fmt.Errorf("config: `allowed_openapi_operations` requires an `openapi_spec_file`")
+	}
+	if p.OpenAPISpecFile != "" {
+		p.OpenAPISpec, err = openapi.ReadFile(p.OpenAPISpecFile)
+		if err != nil {
+			return fmt.Errorf("config: couldn't load openapi spec file: %w", err)
+		}
+	}
+
+	for i, fingerprint := range p.AllowedClientCertificateFingerprints {
+		p.AllowedClientCertificateFingerprints[i], err = normalizeCertificateFingerprint(fingerprint)
+		if err != nil {
+			return err
+		}
+	}
+	for _, san := range p.AllowedClientCertificateSANs {
+		if san == "" {
+			return fmt.Errorf("config: invalid client certificate san, must not be empty")
+		}
+	}
+	for _, name := range p.AllowedIPLists {
+		if !iplist.IsValidName(name) {
+			return fmt.Errorf("config: invalid ip list name %q", name)
+		}
+	}
+
+	if p.AllowedSessionMaxAge < 0 {
+		return fmt.Errorf("config: `allowed_session_max_age` must not be negative")
+	}
+
+	if p.StepUpMaxAge < 0 {
+		return fmt.Errorf("config: `step_up_max_age` must not be negative")
+	}
+	if p.StepUpMaxAge > 0 && len(p.RequiredACRValues) == 0 && len(p.RequiredAMRValues) == 0 {
+		return fmt.Errorf("config: `step_up_max_age` requires `required_acr_values` or `required_amr_values`")
+	}
+
+	if p.JWTAssertionTTL < 0 {
+		return fmt.Errorf("config: `jwt_assertion_ttl` must not be negative")
+	}
+
+	if p.AllowH2CUpstream && p.Destination.Scheme != "http" {
+		return fmt.Errorf("config: `allow_h2c_upstream` requires an http destination url")
+	}
+
+	if p.HedgeDelay < 0 {
+		return fmt.Errorf("config: `hedge_delay` must not be negative")
+	}
+	if p.HedgeDelay > 0 && p.UpstreamTimeout > 0 && p.HedgeDelay >= p.UpstreamTimeout {
+		return fmt.Errorf("config: `hedge_delay` must be less than the route timeout")
+	}
+
+	if p.MaxStreamDuration < 0 {
+		return fmt.Errorf("config: `max_stream_duration` must not be negative")
+	}
+	if p.MaxStreamDuration > 0 && p.MaxStreamDuration < p.UpstreamTimeout {
+		return fmt.Errorf("config: `max_stream_duration` must not be less than the route timeout")
+	}
+	if p.StreamIdleTimeout < 0 {
+		return fmt.Errorf("config: `stream_idle_timeout` must not be negative")
+	}
+
+	if p.TracingSampleRate != nil && (*p.TracingSampleRate < 0 || *p.TracingSampleRate > 1) {
+		return fmt.Errorf("config: `tracing_sample_rate` must be between 0 and 1")
+	}
+
+	if p.UpstreamIdleTimeout < 0 {
+		return fmt.Errorf("config: `upstream_idle_timeout` must not be negative")
+	}
+	// keepalive settings are sent to envoy in whole seconds
+	if p.UpstreamTCPKeepaliveTime < 0 || (p.UpstreamTCPKeepaliveTime > 0 && p.UpstreamTCPKeepaliveTime < time.Second) {
+		return fmt.Errorf("config: `upstream_tcp_keepalive_time` must be at least a second")
+	}
+	if p.UpstreamTCPKeepaliveInterval < 0 || (p.UpstreamTCPKeepaliveInterval > 0 && p.UpstreamTCPKeepaliveInterval < time.Second) {
+		return fmt.Errorf("config: `upstream_tcp_keepalive_interval` must be at least a second")
+	}
+	if p.UpstreamTCPKeepaliveTime == 0 && (p.UpstreamTCPKeepaliveInterval != 0 || p.UpstreamTCPKeepaliveProbes != 0) {
+		return fmt.Errorf("config: `upstream_tcp_keepalive_time` is required to enable tcp keepalive")
+	}
+	if p.UpstreamSlowStartWindow < 0 {
+		return fmt.Errorf("config: `upstream_slow_start_window` must not be negative")
+	}
+	if p.UpstreamSlowStartAggression < 0 {
+		return fmt.Errorf("config: `upstream_slow_start_aggression` must not be negative")
+	}
+	if p.UpstreamSlowStartWindow == 0 && p.UpstreamSlowStartAggression != 0 {
+		return fmt.Errorf("config: `upstream_slow_start_window` is required to enable slow start")
+	}
+
+	if len(p.PriorityGroups) > 0 && p.UpstreamMaxConcurrentRequests == 0 {
+		return fmt.Errorf("config: `priority_groups` requires `upstream_max_concurrent_requests`")
+	}
+	for _, group := range p.PriorityGroups {
+		if group == "" {
+			return fmt.Errorf("config: invalid priority group, must not be empty")
+		}
+	}
+
 	// Only allow public access if no other whitelists are in place
-	if p.AllowPublicUnauthenticatedAccess && (p.AllowedDomains != nil || p.AllowedGroups != nil || p.AllowedUsers != nil) {
+	if p.AllowPublicUnauthenticatedAccess && (p.AllowedDomains != nil || p.AllowedGroups != nil || p.AllowedUsers != nil || p.AllowedIDPClaims != nil || p.AllowedSessionMaxAge != 0 || p.RequiredACRValues != nil || p.RequiredAMRValues != nil || p.IdentityProviderID != "" || p.AllowedRoles != nil) {
 		return fmt.Errorf("config: policy route marked as public but contains whitelists")
 	}
 
@@ -273,6 +595,19 @@ func (p *Policy) Validate() error {
 		}
 	}
 
+	if len(p.TLSPinnedSPKI) > 0 && p.TLSSkipVerify {
+		return fmt.Errorf("config: `tls_pinned_spki` cannot be used with `tls_skip_verify`")
+	}
+	for _, pin := range p.TLSPinnedSPKI {
+		bs, err := base64.StdEncoding.DecodeString(pin)
+		if err != nil {
+			return fmt.Errorf("config: couldn't decode pinned spki: %w", err)
+		}
+		if len(bs) != sha256.Size {
+			return fmt.Errorf("config: pinned spki must be a base64-encoded sha256 hash: %s", pin)
+		}
+	}
+
 	if p.KubernetesServiceAccountTokenFile != "" {
 		if p.KubernetesServiceAccountToken != "" {
 			return fmt.Errorf("config: specified both `kubernetes_service_account_token_file` and `kubernetes_service_account_token`")
@@ -285,9 +620,94 @@ func (p *Policy) Validate() error {
 		p.KubernetesServiceAccountToken = string(token)
 	}
 
+	if p.SigningKey != "" {
+		bs, err := base64.StdEncoding.DecodeString(p.SigningKey)
+		if err != nil {
+			return fmt.Errorf("config: couldn't decode policy signing key: %w", err)
+		}
+		if _, err := cryptutil.DecodePrivateKey(bs); err != nil {
+			return fmt.Errorf("config: invalid policy signing key: %w", err)
+		}
+	}
+
+	if p.DenyResponse != nil {
+		if err := p.DenyResponse.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.UpstreamOAuth2 != nil {
+		if p.KubernetesServiceAccountToken != "" || p.EnableGoogleCloudServerlessAuthentication {
+			return fmt.Errorf("config: `upstream_oauth2` cannot be used with other upstream authorization headers")
+		}
+		if err := p.UpstreamOAuth2.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.CDN != nil {
+		if err := p.CDN.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// validateGRPCMethodPatterns checks that each pattern is of the form
+// "service/method", where either part may contain wildcards.
+func validateGRPCMethodPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("config: invalid grpc method pattern %q, must be of the form service/method", pattern)
+		}
+	}
+	return nil
+}
+
+// validateGraphQLFieldPatterns checks that the route is a GraphQL API and
+// that each pattern is of the form "type.field", where either part may contain
+// wildcards.
+func (p *Policy) validateGraphQLFieldPatterns(patterns []string) error {
+	if len(patterns) > 0 && !p.GraphQL {
+		return fmt.Errorf("config: `allowed_graphql_fields` requires `graphql`")
+	}
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, ".")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("config: invalid graphql field pattern %q, must be of the form type.field", pattern)
+		}
+	}
+	return nil
+}
+
+func validateIDPClaims(claims map[string][]interface{}) error {
+	for name, values := range claims {
+		if name == "" {
+			return fmt.Errorf("config: invalid idp claim, name must not be empty")
+		}
+		for _, value := range values {
+			switch value.(type) {
+			case string, bool, int, int64, float64:
+			default:
+				return fmt.Errorf("config: invalid value for idp claim %q, must be a string, number or boolean", name)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeCertificateFingerprint returns a SHA-256 certificate fingerprint
+// as lowercase hex without separators, so "AB:CD:..." and "abcd..." match.
+func normalizeCertificateFingerprint(fingerprint string) (string, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+	if bs, err := hex.DecodeString(normalized); err != nil || len(bs) != sha256.Size {
+		return "", fmt.Errorf("config: invalid client certificate fingerprint %q, must be a hex-encoded sha256 hash", fingerprint)
+	}
+	return normalized, nil
+}
+
 // Checksum returns the xxhash hash for the policy.
 func (p *Policy) Checksum() uint64 {
 	cs, _ := hashstructure.Hash(p, &hashstructure.HashOptions{
@@ -312,6 +732,38 @@ func (p *Policy) RouteID() uint64 {
 	return cs
 }
 
+// Matches returns true if the policy would match the given URL.
+func (p *Policy) Matches(requestURL *url.URL) bool {
+	if p.Source == nil {
+		return false
+	}
+
+	if p.Source.Host != requestURL.Host {
+		return false
+	}
+
+	if p.Prefix != "" {
+		if !strings.HasPrefix(requestURL.Path, p.Prefix) {
+			return false
+		}
+	}
+
+	if p.Path != "" {
+		if requestURL.Path != p.Path {
+			return false
+		}
+	}
+
+	if p.Regex != "" {
+		re, err := regexp.Compile(p.Regex)
+		if err == nil && !re.MatchString(requestURL.String()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (p *Policy) String() string {
 	if p.Source == nil || p.Destination == nil {
 		return fmt.Sprintf("%s → %s", p.From, p.To)
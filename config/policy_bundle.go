@@ -0,0 +1,85 @@
+package config
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/policybundle"
+)
+
+// A policyBundleWatcher calls a function whenever the policy bundle file or
+// its signature file change on disk.
+type policyBundleWatcher struct {
+	onChange func(fsnotify.Event)
+
+	mu      sync.Mutex
+	file    string
+	watcher *fsnotify.Watcher
+}
+
+func newPolicyBundleWatcher(onChange func(fsnotify.Event)) *policyBundleWatcher {
+	return &policyBundleWatcher{onChange: onChange}
+}
+
+// Update sets the policy bundle file to watch.
+func (w *policyBundleWatcher) Update(file string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if file == w.file {
+		return
+	}
+	w.file = file
+
+	if w.watcher != nil {
+		_ = w.watcher.Close()
+		w.watcher = nil
+	}
+
+	if file == "" {
+		return
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Error().Err(err).Msg("config: failed to create policy bundle file watcher")
+		return
+	}
+	// watch the directory instead of the files so that bundles replaced by a
+	// release pipeline or kubernetes config map updates are picked up
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		log.Error().Err(err).Str("file", file).Msg("config: failed to watch policy bundle directory")
+	}
+	w.watcher = watcher
+	go w.watch(watcher, file)
+}
+
+func (w *policyBundleWatcher) watch(watcher *fsnotify.Watcher, file string) {
+	files := []string{filepath.Clean(file), filepath.Clean(policybundle.SignatureFile(file))}
+	for {
+		select {
+		case evt, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			name := filepath.Clean(evt.Name)
+			if name != files[0] && name != files[1] {
+				continue
+			}
+			w.mu.Lock()
+			current := w.watcher == watcher
+			w.mu.Unlock()
+			if current {
+				w.onChange(evt)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Error().Err(err).Msg("config: policy bundle file watcher error")
+		}
+	}
+}
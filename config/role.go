@@ -0,0 +1,85 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultRoleMaxDuration is how long a role can be assumed for when the role
+// doesn't set a maximum duration.
+const defaultRoleMaxDuration = time.Hour
+
+// A Role is an elevated role which users entitled to it can assume for a
+// limited time. Routes which allow a role only allow users while they have
+// actively assumed it, rather than every user entitled to it.
+type Role struct {
+	// Name identifies the role in routes and sessions.
+	Name string `mapstructure:"name" yaml:"name"`
+	// AllowedUsers and AllowedGroups are the users entitled to the role.
+	AllowedUsers  []string `mapstructure:"allowed_users" yaml:"allowed_users,omitempty"`
+	AllowedGroups []string `mapstructure:"allowed_groups" yaml:"allowed_groups,omitempty"`
+	// MaxDuration is the longest the role can be assumed for at a time.
+	MaxDuration time.Duration `mapstructure:"max_duration" yaml:"max_duration,omitempty"`
+}
+
+// GetMaxDuration returns the longest the role can be assumed for at a time.
+func (r *Role) GetMaxDuration() time.Duration {
+	if r.MaxDuration > 0 {
+		return r.MaxDuration
+	}
+	return defaultRoleMaxDuration
+}
+
+// IsEntitled returns true if the user with the given email and groups is
+// entitled to assume the role.
+func (r *Role) IsEntitled(email string, groups []string) bool {
+	for _, u := range r.AllowedUsers {
+		if u == email {
+			return true
+		}
+	}
+	for _, g := range r.AllowedGroups {
+		for _, group := range groups {
+			if g == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetRole returns the role with the given name.
+func (o *Options) GetRole(name string) (*Role, bool) {
+	for i := range o.Roles {
+		if o.Roles[i].Name == name {
+			return &o.Roles[i], true
+		}
+	}
+	return nil, false
+}
+
+// validateRoles checks the roles, and that the roles allowed by routes exist.
+func (o *Options) validateRoles() error {
+	seen := map[string]bool{}
+	for _, r := range o.Roles {
+		if r.Name == "" {
+			return errors.New("config: role `name` is required")
+		}
+		if seen[r.Name] {
+			return fmt.Errorf("config: duplicate role %q", r.Name)
+		}
+		seen[r.Name] = true
+		if r.MaxDuration < 0 {
+			return fmt.Errorf("config: role %q `max_duration` must not be negative", r.Name)
+		}
+	}
+	for _, p := range o.Policies {
+		for _, name := range p.AllowedRoles {
+			if !seen[name] {
+				return fmt.Errorf("config: route %s has unknown role %q in `allowed_roles`", p.From, name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole(t *testing.T) {
+	t.Parallel()
+
+	r := Role{Name: "prod-admin", AllowedUsers: []string{"alice@example.com"}, AllowedGroups: []string{"sre"}}
+	assert.Equal(t, defaultRoleMaxDuration, r.GetMaxDuration())
+	r.MaxDuration = 15 * time.Minute
+	assert.Equal(t, 15*time.Minute, r.GetMaxDuration())
+
+	assert.True(t, r.IsEntitled("alice@example.com", nil))
+	assert.True(t, r.IsEntitled("bob@example.com", []string{"engineering", "sre"}))
+	assert.False(t, r.IsEntitled("bob@example.com", []string{"engineering"}))
+
+	o := &Options{Roles: []Role{r}}
+	role, ok := o.GetRole("prod-admin")
+	assert.True(t, ok)
+	assert.Equal(t, &o.Roles[0], role)
+	_, ok = o.GetRole("missing")
+	assert.False(t, ok)
+}
+
+func TestOptions_validateRoles(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		roles     []Role
+		policies  []Policy
+		expectErr bool
+	}{
+		{"good", []Role{{Name: "prod-admin", MaxDuration: time.Hour}}, []Policy{{AllowedRoles: []string{"prod-admin"}}}, false},
+		{"missing name", []Role{{AllowedGroups: []string{"sre"}}}, nil, true},
+		{"duplicate name", []Role{{Name: "prod-admin"}, {Name: "prod-admin"}}, nil, true},
+		{"negative max duration", []Role{{Name: "prod-admin", MaxDuration: -time.Hour}}, nil, true},
+		{"unknown route role", nil, []Policy{{AllowedRoles: []string{"prod-admin"}}}, true},
+	} {
+		o := &Options{Roles: tc.roles, Policies: tc.policies}
+		err := o.validateRoles()
+		if tc.expectErr {
+			assert.Error(t, err, tc.name)
+		} else {
+			assert.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+// UpstreamOAuth2 adds an "Authorization: Bearer" header to the requests to a
+// route's upstream, with an access token obtained using the OAuth2 client
+// credentials grant. Tokens are acquired and cached by the databroker, so all
+// the replicas share the same token.
+type UpstreamOAuth2 struct {
+	ClientID     string `mapstructure:"client_id" yaml:"client_id" json:"client_id"`
+	ClientSecret string `mapstructure:"client_secret" yaml:"client_secret,omitempty" json:"client_secret,omitempty"`
+	// ClientSecretFile is a file containing the client secret.
+	ClientSecretFile string `mapstructure:"client_secret_file" yaml:"client_secret_file,omitempty" json:"client_secret_file,omitempty"`
+	// TokenURL is the URL of the token endpoint of the authorization server.
+	TokenURL string   `mapstructure:"token_url" yaml:"token_url" json:"token_url"`
+	Scopes   []string `mapstructure:"scopes" yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	// Audience is sent as the "audience" parameter of token requests, which
+	// some authorization servers require to select the upstream API.
+	Audience string `mapstructure:"audience" yaml:"audience,omitempty" json:"audience,omitempty"`
+}
+
+// Validate checks the validity of the upstream oauth2 settings, loading the
+// client secret file if one is set.
+func (uo *UpstreamOAuth2) Validate() error {
+	if uo.ClientID == "" {
+		return fmt.Errorf("config: upstream oauth2 `client_id` is required")
+	}
+	if _, err := urlutil.ParseAndValidateURL(uo.TokenURL); err != nil {
+		return fmt.Errorf("config: upstream oauth2 bad `token_url`: %w", err)
+	}
+
+	if uo.ClientSecretFile != "" {
+		if uo.ClientSecret != "" {
+			return fmt.Errorf("config: specified both `client_secret` and `client_secret_file` for upstream oauth2")
+		}
+		bs, err := ioutil.ReadFile(uo.ClientSecretFile)
+		if err != nil {
+			return fmt.Errorf("config: failed to load upstream oauth2 client secret file: %w", err)
+		}
+		uo.ClientSecret = strings.TrimSpace(string(bs))
+	}
+	return nil
+}
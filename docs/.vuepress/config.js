@@ -98,13 +98,16 @@ module.exports = {
           sidebarDepth: 0,
           children: [
             "identity-providers/",
+            "identity-providers/auth0",
             "identity-providers/azure",
             "identity-providers/cognito",
             "identity-providers/github",
             "identity-providers/gitlab",
             "identity-providers/google",
+            "identity-providers/ldap",
             "identity-providers/okta",
             "identity-providers/one-login",
+            "identity-providers/saml",
           ],
         },
         {
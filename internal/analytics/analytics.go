@@ -0,0 +1,258 @@
+// Package analytics aggregates per-route request statistics in a rolling
+// window.
+package analytics
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+// latencyBounds are the upper bounds, in milliseconds, of the latency
+// histogram buckets used to estimate percentiles.
+var latencyBounds = []float64{
+	1, 2, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 10000, 25000, 50000, 100000,
+}
+
+const topUsersLimit = 10
+
+// An Event is a single completed request.
+type Event struct {
+	Route    string
+	User     string
+	Status   int
+	Duration time.Duration
+}
+
+// RouteStats are the aggregated statistics for a route.
+type RouteStats struct {
+	Route       string       `json:"route"`
+	Requests    int          `json:"requests"`
+	UniqueUsers int          `json:"unique_users"`
+	Denied      int          `json:"denied"`
+	DenyRate    float64      `json:"deny_rate"`
+	Errors      int          `json:"errors"`
+	ErrorRate   float64      `json:"error_rate"`
+	Latency     LatencyStats `json:"latency"`
+	TopUsers    []UserStats  `json:"top_users,omitempty"`
+}
+
+// LatencyStats are latency percentiles in milliseconds.
+type LatencyStats struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+}
+
+// UserStats are the number of requests made by a user.
+type UserStats struct {
+	User     string `json:"user"`
+	Requests int    `json:"requests"`
+}
+
+type routeCounts struct {
+	requests  int
+	denied    int
+	errors    int
+	users     map[string]int
+	latencies []int
+}
+
+func newRouteCounts() *routeCounts {
+	return &routeCounts{
+		users:     make(map[string]int),
+		latencies: make([]int, len(latencyBounds)+1),
+	}
+}
+
+func (rc *routeCounts) add(other *routeCounts) {
+	rc.requests += other.requests
+	rc.denied += other.denied
+	rc.errors += other.errors
+	for u, n := range other.users {
+		rc.users[u] += n
+	}
+	for i, n := range other.latencies {
+		rc.latencies[i] += n
+	}
+}
+
+type bucket struct {
+	start  time.Time
+	routes map[string]*routeCounts
+}
+
+// An Aggregator aggregates events into fixed size time buckets and computes
+// statistics over the buckets in a rolling window.
+type Aggregator struct {
+	resolution time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	buckets []bucket
+}
+
+// New creates a new Aggregator which keeps events for window, with a
+// granularity of resolution.
+func New(window, resolution time.Duration) *Aggregator {
+	if resolution <= 0 {
+		resolution = time.Minute
+	}
+	n := int(window / resolution)
+	if n < 1 {
+		n = 1
+	}
+	return &Aggregator{
+		resolution: resolution,
+		now:        time.Now,
+		buckets:    make([]bucket, n),
+	}
+}
+
+// Window returns the maximum window of the aggregator.
+func (a *Aggregator) Window() time.Duration {
+	return time.Duration(len(a.buckets)) * a.resolution
+}
+
+// Record records an event.
+func (a *Aggregator) Record(evt Event) {
+	if evt.Route == "" {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	start := a.now().Truncate(a.resolution)
+	b := &a.buckets[int(start.UnixNano()/int64(a.resolution))%len(a.buckets)]
+	if !b.start.Equal(start) {
+		b.start = start
+		b.routes = make(map[string]*routeCounts)
+	}
+
+	rc, ok := b.routes[evt.Route]
+	if !ok {
+		rc = newRouteCounts()
+		b.routes[evt.Route] = rc
+	}
+	rc.requests++
+	switch {
+	case isDenied(evt.Status):
+		rc.denied++
+	case evt.Status >= 500:
+		rc.errors++
+	}
+	if evt.User != "" {
+		rc.users[evt.User]++
+	}
+	ms := float64(evt.Duration) / float64(time.Millisecond)
+	rc.latencies[sort.SearchFloat64s(latencyBounds, ms)]++
+}
+
+// Stats returns the statistics for every route with events in the given
+// window, sorted by the number of requests. If window is not positive or
+// exceeds the maximum window, the maximum window is used.
+func (a *Aggregator) Stats(window time.Duration) []RouteStats {
+	if window <= 0 || window > a.Window() {
+		window = a.Window()
+	}
+
+	a.mu.Lock()
+	now := a.now().Truncate(a.resolution)
+	oldest := now.Add(-window + a.resolution)
+	totals := make(map[string]*routeCounts)
+	for _, b := range a.buckets {
+		if b.routes == nil || b.start.Before(oldest) || b.start.After(now) {
+			continue
+		}
+		for route, rc := range b.routes {
+			total, ok := totals[route]
+			if !ok {
+				total = newRouteCounts()
+				totals[route] = total
+			}
+			total.add(rc)
+		}
+	}
+	a.mu.Unlock()
+
+	stats := make([]RouteStats, 0, len(totals))
+	for route, rc := range totals {
+		stats = append(stats, newRouteStats(route, rc))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Requests != stats[j].Requests {
+			return stats[i].Requests > stats[j].Requests
+		}
+		return stats[i].Route < stats[j].Route
+	})
+	return stats
+}
+
+func newRouteStats(route string, rc *routeCounts) RouteStats {
+	rs := RouteStats{
+		Route:       route,
+		Requests:    rc.requests,
+		UniqueUsers: len(rc.users),
+		Denied:      rc.denied,
+		Errors:      rc.errors,
+		Latency: LatencyStats{
+			P50: percentile(rc.latencies, 0.5),
+			P90: percentile(rc.latencies, 0.9),
+			P99: percentile(rc.latencies, 0.99),
+		},
+	}
+	if rc.requests > 0 {
+		rs.DenyRate = float64(rc.denied) / float64(rc.requests)
+		rs.ErrorRate = float64(rc.errors) / float64(rc.requests)
+	}
+	for u, n := range rc.users {
+		rs.TopUsers = append(rs.TopUsers, UserStats{User: u, Requests: n})
+	}
+	sort.Slice(rs.TopUsers, func(i, j int) bool {
+		if rs.TopUsers[i].Requests != rs.TopUsers[j].Requests {
+			return rs.TopUsers[i].Requests > rs.TopUsers[j].Requests
+		}
+		return rs.TopUsers[i].User < rs.TopUsers[j].User
+	})
+	if len(rs.TopUsers) > topUsersLimit {
+		rs.TopUsers = rs.TopUsers[:topUsersLimit]
+	}
+	return rs
+}
+
+// percentile estimates the p-th percentile as the upper bound of the
+// histogram bucket containing it.
+func percentile(histogram []int, p float64) float64 {
+	total := 0
+	for _, n := range histogram {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	rank := p * float64(total)
+	cumulative := 0
+	for i, n := range histogram {
+		cumulative += n
+		if float64(cumulative) >= rank {
+			if i < len(latencyBounds) {
+				return latencyBounds[i]
+			}
+			break
+		}
+	}
+	return latencyBounds[len(latencyBounds)-1]
+}
+
+func isDenied(status int) bool {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden, httputil.StatusInvalidClientCertificate:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package analytics
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregator(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := New(time.Hour, time.Minute)
+	a.now = func() time.Time { return now }
+
+	for i := 0; i < 8; i++ {
+		a.Record(Event{Route: "r1", User: "u1", Status: http.StatusOK, Duration: 3 * time.Millisecond})
+	}
+	a.Record(Event{Route: "r1", User: "u2", Status: http.StatusForbidden, Duration: time.Millisecond})
+	a.Record(Event{Route: "r1", User: "u2", Status: http.StatusBadGateway, Duration: 300 * time.Millisecond})
+	a.Record(Event{Route: "r2", Status: http.StatusUnauthorized, Duration: time.Millisecond})
+	a.Record(Event{Status: http.StatusOK})
+
+	stats := a.Stats(0)
+	if assert.Len(t, stats, 2) {
+		assert.Equal(t, RouteStats{
+			Route:       "r1",
+			Requests:    10,
+			UniqueUsers: 2,
+			Denied:      1,
+			DenyRate:    0.1,
+			Errors:      1,
+			ErrorRate:   0.1,
+			Latency:     LatencyStats{P50: 5, P90: 5, P99: 500},
+			TopUsers: []UserStats{
+				{User: "u1", Requests: 8},
+				{User: "u2", Requests: 2},
+			},
+		}, stats[0])
+		assert.Equal(t, RouteStats{
+			Route:    "r2",
+			Requests: 1,
+			Denied:   1,
+			DenyRate: 1,
+			Latency:  LatencyStats{P50: 1, P90: 1, P99: 1},
+		}, stats[1])
+	}
+
+	t.Run("window", func(t *testing.T) {
+		now = now.Add(10 * time.Minute)
+		a.Record(Event{Route: "r2", User: "u3", Status: http.StatusOK})
+
+		stats := a.Stats(5 * time.Minute)
+		if assert.Len(t, stats, 1) {
+			assert.Equal(t, "r2", stats[0].Route)
+			assert.Equal(t, 1, stats[0].Requests)
+		}
+
+		stats = a.Stats(time.Hour)
+		assert.Len(t, stats, 2)
+
+		now = now.Add(time.Hour)
+		assert.Empty(t, a.Stats(0))
+	})
+	t.Run("top users", func(t *testing.T) {
+		for i := 0; i < 2*topUsersLimit; i++ {
+			a.Record(Event{Route: "r3", User: fmt.Sprintf("user-%02d", i), Status: http.StatusOK})
+		}
+		stats := a.Stats(0)
+		if assert.Len(t, stats, 1) {
+			assert.Equal(t, 2*topUsersLimit, stats[0].UniqueUsers)
+			assert.Len(t, stats[0].TopUsers, topUsersLimit)
+		}
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, 0.0, percentile(make([]int, len(latencyBounds)+1), 0.5))
+
+	histogram := make([]int, len(latencyBounds)+1)
+	histogram[len(latencyBounds)] = 1
+	assert.Equal(t, latencyBounds[len(latencyBounds)-1], percentile(histogram, 0.5))
+}
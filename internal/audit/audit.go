@@ -0,0 +1,129 @@
+// Package audit writes tamper-evident audit logs. Every record includes the
+// hash of the record before it, and records are signed in batches, so records
+// which are modified, reordered or deleted are detected when the log is
+// verified.
+package audit
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// maxRecordSize is the maximum size of a single record in an audit log.
+const maxRecordSize = 1024 * 1024
+
+// A Record is a single line of an audit log. Events include the schema
+// version of their fields, which is the same as the schema version of logs.
+type Record struct {
+	// Seq is the position of the record in the log, starting at 1.
+	Seq uint64 `json:"seq"`
+	// Time is when the record was written.
+	Time time.Time `json:"time"`
+	// Event is the audited event as a JSON object.
+	Event json.RawMessage `json:"event"`
+	// PrevHash is the hash of the previous record, or empty for the first.
+	PrevHash string `json:"prev_hash"`
+	// Hash is the hex-encoded SHA-256 hash of the fields above.
+	Hash string `json:"hash"`
+	// Signature is the base64-encoded ECDSA signature of the hash. Only the
+	// last record of each batch written is signed, which also signs the
+	// records before it through their hashes.
+	Signature string `json:"signature,omitempty"`
+}
+
+// computeHash returns the hash of the record's sequence number, time, previous
+// hash and event.
+func (r *Record) computeHash() string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d\n%s\n%s\n", r.Seq, r.Time.UTC().Format(time.RFC3339Nano), r.PrevHash)
+	_, _ = h.Write(r.Event)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sign sets the hash and signature of the record.
+func (r *Record) sign(key *ecdsa.PrivateKey) error {
+	r.Hash = r.computeHash()
+	sig, err := cryptutil.Sign([]byte(r.Hash), key)
+	if err != nil {
+		return err
+	}
+	r.Signature = base64.StdEncoding.EncodeToString(sig)
+	return nil
+}
+
+// verify checks the hash of the record, and its signature if it's signed.
+func (r *Record) verify(key *ecdsa.PublicKey) error {
+	if r.computeHash() != r.Hash {
+		return errors.New("hash mismatch, the record was modified")
+	}
+	if r.Signature == "" {
+		return nil
+	}
+	sig, err := base64.StdEncoding.DecodeString(r.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if len(sig) != 2*(key.Curve.Params().P.BitLen()/8) || !cryptutil.Verify([]byte(r.Hash), sig, key) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// Verify reads an audit log and checks that every record is chained to the
+// record before it and covered by the signature of a later record. It returns
+// the number of records verified before the first error.
+//
+// Records deleted from the end of a log can't be detected from the log alone,
+// so the sequence number of the last record should be compared with the last
+// one recorded elsewhere, such as a log aggregator.
+func Verify(r io.Reader, key *ecdsa.PublicKey) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+
+	var prev *Record
+	n, unsigned := 0, 0
+	for scanner.Scan() {
+		line := n + unsigned + 1
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return n, fmt.Errorf("audit: line %d: invalid record: %w", line, err)
+		}
+		if err := record.verify(key); err != nil {
+			return n, fmt.Errorf("audit: line %d: %w", line, err)
+		}
+		if prev != nil {
+			if record.Seq != prev.Seq+1 {
+				return n, fmt.Errorf("audit: line %d: expected record %d, got %d, records were deleted or reordered", line, prev.Seq+1, record.Seq)
+			}
+			if record.PrevHash != prev.Hash {
+				return n, fmt.Errorf("audit: line %d: previous hash mismatch, records were deleted or reordered", line)
+			}
+		} else if record.Seq == 1 && record.PrevHash != "" {
+			return n, fmt.Errorf("audit: line %d: first record must not have a previous hash", line)
+		}
+		prev = &record
+		if record.Signature == "" {
+			unsigned++
+		} else {
+			n += unsigned + 1
+			unsigned = 0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return n, fmt.Errorf("audit: error reading log: %w", err)
+	}
+	if unsigned > 0 {
+		return n, fmt.Errorf("audit: line %d: the last %d records aren't signed", n+unsigned, unsigned)
+	}
+	return n, nil
+}
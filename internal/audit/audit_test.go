@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func TestWriterVerify(t *testing.T) {
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// flushing after each write signs every record
+	write := func(w *Writer, path string) {
+		require.NoError(t, w.Write(map[string]interface{}{"path": path}))
+		require.NoError(t, w.Flush())
+	}
+	w, err := NewWriter(path, key)
+	require.NoError(t, err)
+	write(w, "/1")
+	write(w, "/2")
+	require.NoError(t, w.Close())
+
+	// reopening the log continues the chain
+	w, err = NewWriter(path, key)
+	require.NoError(t, err)
+	write(w, "/3")
+	write(w, "<4>")
+	require.NoError(t, w.Close())
+
+	bs, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(bs), "\n"), "\n")
+	require.Len(t, lines, 4)
+
+	n, err := Verify(bytes.NewReader(bs), &key.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	// a log may start after the first record, e.g. after rotation
+	n, err = Verify(strings.NewReader(strings.Join(lines[2:], "")), &key.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	tests := []struct {
+		name    string
+		log     string
+		wantN   int
+		wantErr string
+	}{
+		{"modified", strings.Join(lines[:2], "") + strings.Replace(lines[2], "/3", "/x", 1) + lines[3], 2, "line 3: hash mismatch"},
+		{"deleted", lines[0] + lines[1] + lines[3], 2, "line 3: expected record 3, got 4"},
+		{"reordered", lines[0] + lines[2] + lines[1] + lines[3], 1, "line 2: expected record 2, got 3"},
+		{"not json", lines[0] + "garbage\n", 1, "line 2: invalid record"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Verify(strings.NewReader(tt.log), &key.PublicKey)
+			assert.Equal(t, tt.wantN, n)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, err := cryptutil.NewSigningKey()
+		require.NoError(t, err)
+		n, err := Verify(bytes.NewReader(bs), &other.PublicKey)
+		assert.Equal(t, 0, n)
+		assert.EqualError(t, err, "audit: line 1: invalid signature")
+	})
+}
+
+func TestWriterBatches(t *testing.T) {
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	w, err := NewWriter(path, key)
+	require.NoError(t, err)
+	for i := 0; i < 2*maxBatchSize; i++ {
+		require.NoError(t, w.Write(map[string]interface{}{"i": i}))
+	}
+	require.NoError(t, w.Close())
+	assert.Error(t, w.Write(map[string]interface{}{"i": -1}), "should not write to a closed log")
+
+	bs, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	n, err := Verify(bytes.NewReader(bs), &key.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*maxBatchSize, n)
+
+	var records []Record
+	for _, line := range strings.Split(strings.TrimSpace(string(bs)), "\n") {
+		var record Record
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	signed := 0
+	for _, record := range records {
+		if record.Signature != "" {
+			signed++
+		}
+	}
+	assert.GreaterOrEqual(t, signed, 2, "should sign at least one record per batch")
+	assert.NotEmpty(t, records[len(records)-1].Signature, "should sign the last record")
+
+	// records after the last signature aren't verified
+	last := records[len(records)-1]
+	last.Signature = ""
+	line, err := json.Marshal(last)
+	require.NoError(t, err)
+	truncated := bs[:bytes.LastIndexByte(bytes.TrimSuffix(bs, []byte("\n")), '\n')+1]
+	n, err = Verify(bytes.NewReader(append(truncated, append(line, '\n')...)), &key.PublicKey)
+	assert.Less(t, n, 2*maxBatchSize)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "aren't signed")
+	}
+}
+
+func TestLog(t *testing.T) {
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// nothing is written while audit logging is disabled
+	Log("ignored", map[string]interface{}{"path": "/ignored"})
+
+	w, err := NewWriter(path, key)
+	require.NoError(t, err)
+	assert.Nil(t, SetWriter(w))
+	assert.True(t, Enabled())
+	Log("request", map[string]interface{}{"path": "/"})
+	assert.Equal(t, w, SetWriter(nil))
+	assert.False(t, Enabled())
+	require.NoError(t, w.Close())
+
+	bs, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	n, err := Verify(bytes.NewReader(bs), &key.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	var record Record
+	require.NoError(t, json.Unmarshal(bs, &record))
+	assert.JSONEq(t, `{"message":"request","path":"/","schema_version":2}`, string(record.Event))
+}
+
+func TestLogForTenant(t *testing.T) {
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	newWriter := func(name string) *Writer {
+		w, err := NewWriter(filepath.Join(dir, name), key)
+		require.NoError(t, err)
+		return w
+	}
+	w, a, b := newWriter("audit.log"), newWriter("a.log"), newWriter("b.log")
+	SetWriter(w)
+	assert.Nil(t, SetTenantWriters(map[string]*Writer{"a": a, "b": b}))
+	LogForTenant("a", "request", map[string]interface{}{"path": "/a"})
+	LogForTenant("b", "request", map[string]interface{}{"path": "/b"})
+	LogForTenant("c", "request", map[string]interface{}{"path": "/c"})
+	Log("request", map[string]interface{}{"path": "/"})
+	SetWriter(nil)
+	SetTenantWriters(nil)
+	for _, w := range []*Writer{w, a, b} {
+		require.NoError(t, w.Close())
+	}
+
+	readEvents := func(name string) []string {
+		bs, err := ioutil.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		_, err = Verify(bytes.NewReader(bs), &key.PublicKey)
+		assert.NoError(t, err)
+		var events []string
+		for _, line := range strings.Split(strings.TrimSpace(string(bs)), "\n") {
+			var record struct {
+				Event struct {
+					Path   string `json:"path"`
+					Tenant string `json:"tenant"`
+				} `json:"event"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			events = append(events, record.Event.Tenant+record.Event.Path)
+		}
+		return events
+	}
+	assert.Equal(t, []string{"a/a", "b/b", "c/c", "/"}, readEvents("audit.log"))
+	assert.Equal(t, []string{"a/a"}, readEvents("a.log"))
+	assert.Equal(t, []string{"b/b"}, readEvents("b.log"))
+}
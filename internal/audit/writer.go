@@ -0,0 +1,299 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/logarchive"
+)
+
+// writeQueueSize is how many events can be queued for a Writer before Write
+// blocks.
+const writeQueueSize = 4096
+
+// maxBatchSize is the most records written, and covered by one signature, at
+// a time.
+const maxBatchSize = 256
+
+// A Writer appends signed, hash-chained records to an audit log file. Records
+// are written by a background goroutine in batches, and only the last record
+// of each batch is signed, since its signature covers the records before it
+// through the hash chain.
+type Writer struct {
+	key *ecdsa.PrivateKey
+	f   *os.File
+
+	// last is only used by the background goroutine
+	last Record
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan writeRequest
+	done   chan struct{}
+}
+
+// a writeRequest is either an event to write or, if flushed is set, a request
+// to be notified once the events before it have been written.
+type writeRequest struct {
+	event   []byte
+	flushed chan struct{}
+}
+
+// NewWriter opens the audit log file at path, creating it if it doesn't
+// exist. New records continue the chain of the last record in the file.
+func NewWriter(path string, key *ecdsa.PrivateKey) (*Writer, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("audit: error opening log: %w", err)
+	}
+
+	w := &Writer{
+		key:   key,
+		f:     f,
+		queue: make(chan writeRequest, writeQueueSize),
+		done:  make(chan struct{}),
+	}
+	line, err := readLastLine(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("audit: error reading log: %w", err)
+	}
+	if len(line) > 0 {
+		if err := json.Unmarshal(line, &w.last); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("audit: invalid last record: %w", err)
+		}
+	}
+	go w.run()
+	return w, nil
+}
+
+// Write queues a record for the event, which must marshal to a JSON object.
+// Errors writing the record are logged.
+func (w *Writer) Write(event interface{}) error {
+	bs, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("audit: error marshaling event: %w", err)
+	}
+	return w.send(writeRequest{event: bs})
+}
+
+// Flush waits until the records queued before it have been written.
+func (w *Writer) Flush() error {
+	flushed := make(chan struct{})
+	if err := w.send(writeRequest{flushed: flushed}); err != nil {
+		return err
+	}
+	<-flushed
+	return nil
+}
+
+func (w *Writer) send(req writeRequest) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return errors.New("audit: log is closed")
+	}
+	w.queue <- req
+	return nil
+}
+
+// Close writes the queued records and closes the audit log file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	close(w.queue)
+	w.mu.Unlock()
+
+	<-w.done
+	return w.f.Close()
+}
+
+// run writes the queued events in batches until the queue is closed.
+func (w *Writer) run() {
+	defer close(w.done)
+
+	var events [][]byte
+	var flushed []chan struct{}
+	for req := range w.queue {
+		events, flushed = events[:0], flushed[:0]
+		for {
+			if req.flushed != nil {
+				flushed = append(flushed, req.flushed)
+			} else {
+				events = append(events, req.event)
+			}
+			if len(events) >= maxBatchSize || len(w.queue) == 0 {
+				break
+			}
+			req = <-w.queue
+		}
+
+		if err := w.writeBatch(events); err != nil {
+			log.Error().Err(err).Msg("audit: failed to write audit records")
+		}
+		for _, ch := range flushed {
+			close(ch)
+		}
+	}
+}
+
+// writeBatch appends a record for each event, signing the last one.
+func (w *Writer) writeBatch(events [][]byte) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	last := w.last
+	var buf bytes.Buffer
+	lines := make([][]byte, 0, len(events))
+	for i, event := range events {
+		record := Record{
+			Seq:      last.Seq + 1,
+			Time:     time.Now().UTC(),
+			Event:    event,
+			PrevHash: last.Hash,
+		}
+		record.Hash = record.computeHash()
+		if i == len(events)-1 {
+			if err := record.sign(w.key); err != nil {
+				return fmt.Errorf("audit: error signing record: %w", err)
+			}
+		}
+		line, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("audit: error marshaling record: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+		lines = append(lines, line)
+		last = record
+	}
+	if _, err := w.f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("audit: error writing records: %w", err)
+	}
+	w.last = last
+	// the records are archived as written, so archived audit logs can be
+	// verified
+	for _, line := range lines {
+		logarchive.ArchiveJSON(logarchive.KindAudit, "", line)
+	}
+	return nil
+}
+
+// readLastLine returns the last non-empty line of a file.
+func readLastLine(f *os.File) ([]byte, error) {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// read backwards in chunks until a newline before the last line is found
+	const chunkSize = 64 * 1024
+	var buf []byte
+	for offset := size; offset > 0; {
+		n := int64(chunkSize)
+		if offset < n {
+			n = offset
+		}
+		offset -= n
+		chunk := make([]byte, n)
+		if _, err := f.ReadAt(chunk, offset); err != nil {
+			return nil, err
+		}
+		buf = append(chunk, buf...)
+
+		trimmed := bytes.TrimRight(buf, "\n")
+		if idx := bytes.LastIndexByte(trimmed, '\n'); idx >= 0 {
+			return trimmed[idx+1:], nil
+		}
+		if len(buf) > maxRecordSize {
+			return nil, fmt.Errorf("last record exceeds %d bytes", maxRecordSize)
+		}
+	}
+	return bytes.TrimRight(buf, "\n"), nil
+}
+
+var current struct {
+	sync.RWMutex
+	w       *Writer
+	tenants map[string]*Writer
+}
+
+// SetWriter sets the writer used by Log and returns the previous one. A nil
+// writer disables audit logging.
+func SetWriter(w *Writer) *Writer {
+	current.Lock()
+	defer current.Unlock()
+	prev := current.w
+	current.w = w
+	return prev
+}
+
+// SetTenantWriters sets the writers of the tenants' own audit logs and returns
+// the previous ones. Events of a tenant are written to both the audit log and
+// the tenant's audit log, so a tenant's log never contains the events of
+// other tenants.
+func SetTenantWriters(ws map[string]*Writer) map[string]*Writer {
+	current.Lock()
+	defer current.Unlock()
+	prev := current.tenants
+	current.tenants = ws
+	return prev
+}
+
+// Enabled returns true if audit logging is enabled.
+func Enabled() bool {
+	current.RLock()
+	defer current.RUnlock()
+	return current.w != nil || len(current.tenants) > 0
+}
+
+// Log writes an event with the given message and fields to the audit log, if
+// audit logging is enabled.
+func Log(msg string, fields map[string]interface{}) {
+	LogForTenant("", msg, fields)
+}
+
+// LogForTenant writes an event of a tenant to the audit log and to the
+// tenant's audit log. An empty tenant is the same as calling Log.
+func LogForTenant(tenant, msg string, fields map[string]interface{}) {
+	current.RLock()
+	defer current.RUnlock()
+	tw := current.tenants[tenant]
+	if tenant == "" {
+		tw = nil
+	}
+	if current.w == nil && tw == nil {
+		return
+	}
+	event := make(map[string]interface{}, len(fields)+3)
+	for k, v := range fields {
+		event[k] = v
+	}
+	event["message"] = msg
+	event[log.SchemaVersionField] = log.SchemaVersion
+	if tenant != "" {
+		event["tenant"] = tenant
+	}
+	for _, w := range []*Writer{current.w, tw} {
+		if w == nil {
+			continue
+		}
+		if err := w.Write(event); err != nil {
+			log.Error().Err(err).Msg("audit: failed to write audit record")
+		}
+	}
+}
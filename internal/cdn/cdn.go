@@ -0,0 +1,18 @@
+// Package cdn signs URLs and cookies for content delivery networks, so users
+// authorized by Pomerium can fetch assets from the CDN directly.
+package cdn
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// A Signer signs requests to a CDN.
+type Signer interface {
+	// SignURL returns a copy of the URL which the CDN serves until expires.
+	SignURL(u *url.URL, expires time.Time) (*url.URL, error)
+	// SignCookies returns cookies which make the CDN serve every URL starting
+	// with prefix until expires. Only their names and values are set.
+	SignCookies(prefix *url.URL, expires time.Time) ([]*http.Cookie, error)
+}
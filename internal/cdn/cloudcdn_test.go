@@ -0,0 +1,55 @@
+package cdn
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudCDNSigner(t *testing.T) {
+	s, err := NewCloudCDNSigner("my-key", "nZtRohdNF9m3cKM24IcK4w==")
+	require.NoError(t, err)
+
+	t.Run("url", func(t *testing.T) {
+		u, err := s.SignURL(&url.URL{Scheme: "https", Host: "example.com", Path: "/foo"}, time.Unix(1549751401, 0))
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/foo?Expires=1549751401&KeyName=my-key&Signature=LRJM5A48WJzlGV5eKACS07XLgW4=", u.String())
+	})
+	t.Run("url with query", func(t *testing.T) {
+		u, err := s.SignURL(&url.URL{Scheme: "https", Host: "example.com", Path: "/foo", RawQuery: "a=b"}, time.Unix(1549751401, 0))
+		require.NoError(t, err)
+		assert.Contains(t, u.String(), "https://example.com/foo?a=b&Expires=1549751401&KeyName=my-key&Signature=")
+	})
+	t.Run("cookies", func(t *testing.T) {
+		cookies, err := s.SignCookies(&url.URL{Scheme: "https", Host: "media.example.com", Path: "/videos/"}, time.Unix(1566268009, 0))
+		require.NoError(t, err)
+		require.Len(t, cookies, 1)
+		assert.Equal(t, "Cloud-CDN-Cookie", cookies[0].Name)
+		assert.Equal(t, "URLPrefix=aHR0cHM6Ly9tZWRpYS5leGFtcGxlLmNvbS92aWRlb3Mv:Expires=1566268009:KeyName=my-key:Signature=H3FAOjbJdtMgYc18BbieZQbwpD0=", cookies[0].Value)
+	})
+}
+
+func TestNewCloudCDNSigner(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{"padded", "nZtRohdNF9m3cKM24IcK4w==", false},
+		{"unpadded", "nZtRohdNF9m3cKM24IcK4w", false},
+		{"invalid", "%%%", true},
+		{"wrong size", "AAAA", true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCloudCDNSigner("my-key", tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
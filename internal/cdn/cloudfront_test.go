@@ -0,0 +1,97 @@
+package cdn
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func decodeCloudFront(t *testing.T, s string) []byte {
+	bs, err := base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(s))
+	require.NoError(t, err)
+	return bs
+}
+
+func verifyCloudFront(t *testing.T, key *rsa.PrivateKey, policy string, signature string) {
+	h := sha1.Sum([]byte(policy)) //nolint:gosec
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, h[:], decodeCloudFront(t, signature)))
+}
+
+func TestCloudFrontSigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	s, err := NewCloudFrontSigner("K2JCJMDEHXQW5F", pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+	require.NoError(t, err)
+
+	t.Run("url", func(t *testing.T) {
+		u, err := s.SignURL(&url.URL{Scheme: "https", Host: "d111111abcdef8.cloudfront.net", Path: "/image.jpg", RawQuery: "size=large&q=<>"}, time.Unix(1357034400, 0))
+		require.NoError(t, err)
+		q := u.Query()
+		assert.Equal(t, "1357034400", q.Get("Expires"))
+		assert.Equal(t, "K2JCJMDEHXQW5F", q.Get("Key-Pair-Id"))
+		assert.Equal(t, "large", q.Get("size"))
+		verifyCloudFront(t, key,
+			`{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg?size=large&q=<>","Condition":{"DateLessThan":{"AWS:EpochTime":1357034400}}}]}`,
+			q.Get("Signature"))
+	})
+	t.Run("cookies", func(t *testing.T) {
+		cookies, err := s.SignCookies(&url.URL{Scheme: "https", Host: "d111111abcdef8.cloudfront.net", Path: "/assets/"}, time.Unix(1357034400, 0))
+		require.NoError(t, err)
+		require.Len(t, cookies, 3)
+		assert.Equal(t, "CloudFront-Policy", cookies[0].Name)
+		assert.Equal(t, "CloudFront-Signature", cookies[1].Name)
+		assert.Equal(t, "CloudFront-Key-Pair-Id", cookies[2].Name)
+		assert.Equal(t, "K2JCJMDEHXQW5F", cookies[2].Value)
+
+		policy := `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/assets/*","Condition":{"DateLessThan":{"AWS:EpochTime":1357034400}}}]}`
+		assert.Equal(t, policy, string(decodeCloudFront(t, cookies[0].Value)))
+		verifyCloudFront(t, key, policy, cookies[1].Value)
+	})
+}
+
+func TestNewCloudFrontSigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	ecKey, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	ecPEM, err := cryptutil.EncodePrivateKey(ecKey)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name    string
+		key     []byte
+		wantErr bool
+	}{
+		{"pkcs1", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), false},
+		{"pkcs8", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), false},
+		{"not pem", []byte("bloop"), true},
+		{"not rsa", ecPEM, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCloudFrontSigner("K2JCJMDEHXQW5F", tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package pomerium
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/audit"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// RunAuditVerify verifies the signatures and hash chain of an audit log. The
+// records are checked against the PEM-encoded public key in publicKeyFile or,
+// if it's empty, the audit log signing key in the config file.
+func RunAuditVerify(configFile, publicKeyFile, logFile string, w io.Writer) error {
+	key, err := getAuditPublicKey(configFile, publicKeyFile)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(logFile)
+	if err != nil {
+		return fmt.Errorf("error opening audit log: %w", err)
+	}
+	defer f.Close()
+
+	n, err := audit.Verify(f, key)
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "FAIL: %d records verified before the first invalid record\n", n)
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "OK: %d records verified\n", n)
+	return nil
+}
+
+func getAuditPublicKey(configFile, publicKeyFile string) (*ecdsa.PublicKey, error) {
+	if publicKeyFile != "" {
+		bs, err := ioutil.ReadFile(publicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading public key: %w", err)
+		}
+		return cryptutil.DecodePublicKey(bs)
+	}
+
+	src, err := config.NewFileOrEnvironmentSource(configFile)
+	if err != nil {
+		return nil, err
+	}
+	options := src.GetConfig().Options
+	if options.AuditLogSigningKey == "" {
+		return nil, errors.New("a public key or a config file with an audit log signing key is required")
+	}
+	key, err := options.GetAuditLogSigningKey()
+	if err != nil {
+		return nil, err
+	}
+	return &key.PublicKey, nil
+}
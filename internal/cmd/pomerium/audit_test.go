@@ -0,0 +1,64 @@
+package pomerium
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/audit"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func TestRunAuditVerify(t *testing.T) {
+	dir := t.TempDir()
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	pubKey, err := cryptutil.EncodePublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKeyFile := filepath.Join(dir, "audit.pub")
+	require.NoError(t, ioutil.WriteFile(publicKeyFile, pubKey, 0600))
+
+	logFile := filepath.Join(dir, "audit.log")
+	w, err := audit.NewWriter(logFile, key)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.Write(map[string]interface{}{"i": i}))
+		require.NoError(t, w.Flush())
+	}
+	require.NoError(t, w.Close())
+
+	var out bytes.Buffer
+	assert.NoError(t, RunAuditVerify("", publicKeyFile, logFile, &out))
+	assert.Equal(t, "OK: 3 records verified\n", out.String())
+
+	// append a record signed by another key
+	other, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	w, err = audit.NewWriter(logFile, other)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(map[string]interface{}{"i": 3}))
+	require.NoError(t, w.Close())
+
+	out.Reset()
+	assert.EqualError(t, RunAuditVerify("", publicKeyFile, logFile, &out), "audit: line 4: invalid signature")
+	assert.Equal(t, "FAIL: 3 records verified before the first invalid record\n", out.String())
+
+	t.Run("missing log", func(t *testing.T) {
+		assert.Error(t, RunAuditVerify("", publicKeyFile, filepath.Join(dir, "missing.log"), &out))
+	})
+	t.Run("key from config", func(t *testing.T) {
+		privKey, err := cryptutil.EncodePrivateKey(other)
+		require.NoError(t, err)
+		configFile := filepath.Join(dir, "config.yaml")
+		require.NoError(t, ioutil.WriteFile(configFile, []byte(
+			"insecure_server: true\naudit_log_signing_key: "+base64.StdEncoding.EncodeToString(privKey)+"\n"), 0600))
+		out.Reset()
+		assert.EqualError(t, RunAuditVerify(configFile, "", logFile, &out), "audit: line 1: invalid signature")
+		assert.Equal(t, "FAIL: 0 records verified before the first invalid record\n", out.String())
+	})
+}
@@ -0,0 +1,74 @@
+package pomerium
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/policybundle"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// RunBundleBuild builds a policy bundle from the YAML list of routes in
+// policyFile, signs it with the PEM-encoded private key in signingKeyFile and
+// writes it, and its signature, to bundleFile. The routes are validated first,
+// so that a bad policy is rejected by the pipeline instead of by Pomerium.
+func RunBundleBuild(policyFile, signingKeyFile, version, bundleFile string, w io.Writer) error {
+	if version == "" {
+		return errors.New("a bundle version is required")
+	}
+
+	policy, err := ioutil.ReadFile(policyFile)
+	if err != nil {
+		return fmt.Errorf("error reading policy: %w", err)
+	}
+	var policies []config.Policy
+	if err := yaml.Unmarshal(policy, &policies); err != nil {
+		return fmt.Errorf("could not unmarshal policy yaml: %w", err)
+	}
+	for i := range policies {
+		if err := policies[i].Validate(); err != nil {
+			return fmt.Errorf("invalid route %d: %w", i+1, err)
+		}
+	}
+
+	bs, err := ioutil.ReadFile(signingKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading signing key: %w", err)
+	}
+	key, err := cryptutil.DecodePrivateKey(bs)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = policybundle.Write(&buf, &policybundle.Bundle{
+		Version:   version,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+		Policy:    policy,
+	})
+	if err != nil {
+		return err
+	}
+	signature, err := policybundle.Sign(buf.Bytes(), key)
+	if err != nil {
+		return err
+	}
+
+	// write the bundle first so that a watcher never sees the new signature
+	// with the old bundle
+	if err := ioutil.WriteFile(bundleFile, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("error writing bundle: %w", err)
+	}
+	if err := ioutil.WriteFile(policybundle.SignatureFile(bundleFile), signature, 0o644); err != nil {
+		return fmt.Errorf("error writing bundle signature: %w", err)
+	}
+	_, _ = fmt.Fprintf(w, "OK: %s %s with %d routes\n", bundleFile, version, len(policies))
+	return nil
+}
@@ -0,0 +1,51 @@
+package pomerium
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/policybundle"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func TestRunBundleBuild(t *testing.T) {
+	dir := t.TempDir()
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	privKey, err := cryptutil.EncodePrivateKey(key)
+	require.NoError(t, err)
+	signingKeyFile := filepath.Join(dir, "signing.pem")
+	require.NoError(t, ioutil.WriteFile(signingKeyFile, privKey, 0600))
+
+	policy := "- from: https://from.example.com\n  to: https://to.example.com\n"
+	policyFile := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, ioutil.WriteFile(policyFile, []byte(policy), 0600))
+	bundleFile := filepath.Join(dir, "bundle.tar")
+
+	var out bytes.Buffer
+	require.NoError(t, RunBundleBuild(policyFile, signingKeyFile, "v1.2.3", bundleFile, &out))
+	assert.Equal(t, "OK: "+bundleFile+" v1.2.3 with 1 routes\n", out.String())
+
+	b, err := policybundle.ReadFile(bundleFile, []*ecdsa.PublicKey{&key.PublicKey})
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3", b.Version)
+	assert.Equal(t, policy, string(b.Policy))
+
+	t.Run("invalid route", func(t *testing.T) {
+		badPolicyFile := filepath.Join(dir, "bad-policy.yaml")
+		require.NoError(t, ioutil.WriteFile(badPolicyFile, []byte("- from: https://from.example.com\n"), 0600))
+		assert.Error(t, RunBundleBuild(badPolicyFile, signingKeyFile, "v1.2.4", filepath.Join(dir, "bad.tar"), &out))
+	})
+	t.Run("missing version", func(t *testing.T) {
+		assert.Error(t, RunBundleBuild(policyFile, signingKeyFile, "", filepath.Join(dir, "bad.tar"), &out))
+	})
+	t.Run("missing signing key", func(t *testing.T) {
+		assert.Error(t, RunBundleBuild(policyFile, filepath.Join(dir, "missing.pem"), "v1.2.4", filepath.Join(dir, "bad.tar"), &out))
+	})
+}
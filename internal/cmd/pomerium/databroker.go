@@ -0,0 +1,170 @@
+package pomerium
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/config"
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+
+	// register the record types, so their data can be decoded
+	_ "github.com/pomerium/pomerium/pkg/grpc/device"
+	_ "github.com/pomerium/pomerium/pkg/grpc/directory"
+	_ "github.com/pomerium/pomerium/pkg/grpc/impersonation"
+	_ "github.com/pomerium/pomerium/pkg/grpc/iplist"
+	_ "github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	_ "github.com/pomerium/pomerium/pkg/grpc/session"
+	_ "github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+	_ "github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+// DataBrokerUsage describes the databroker commands.
+const DataBrokerUsage = `usage: pomerium databroker [-config <config file>] [-databroker-url <url>] <command>
+
+commands:
+  types                            list the record types
+  list <type>                      list the records of a type
+  get <type> <id>                  get a record
+  delete <type> <id>               delete a record
+  history <type> <id>              get the changes to a record
+  by-index <type> <index> <value>  list the records of a type with a value for an index`
+
+// adminTokenTTL is the lifetime of the admin token used by the databroker
+// commands.
+const adminTokenTTL = 5 * time.Minute
+
+// NewDataBrokerClient connects to the databroker in the config file, or at
+// the given url if it's not empty, and returns a client and the shared
+// secret.
+func NewDataBrokerClient(configFile, rawURL string) (databroker.DataBrokerServiceClient, []byte, error) {
+	src, err := config.NewFileOrEnvironmentSource(configFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	options := src.GetConfig().Options
+
+	u := options.GetDataBrokerURL()
+	if rawURL != "" {
+		u, err = urlutil.ParseAndValidateURL(rawURL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid databroker url: %w", err)
+		}
+	}
+	secret, err := base64.StdEncoding.DecodeString(options.SharedKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid shared secret: %w", err)
+	}
+
+	cc, err := grpc.NewGRPCClientConn(&grpc.Options{
+		Addr:                    u,
+		OverrideCertificateName: options.OverrideCertificateName,
+		CA:                      options.CA,
+		CAFile:                  options.CAFile,
+		RequestTimeout:          options.GRPCClientTimeout,
+		WithInsecure:            options.GRPCInsecure,
+		ServiceName:             "cli",
+		Namespace:               options.DataBrokerNamespace,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error connecting to the databroker: %w", err)
+	}
+	return databroker.NewDataBrokerServiceClient(cc), secret, nil
+}
+
+// RunDataBroker runs a databroker command, writing the records it returns to
+// w as JSON. Requests are made with an admin token signed with the secret,
+// so admin-only commands like history work too.
+func RunDataBroker(ctx context.Context, client databroker.DataBrokerServiceClient, secret []byte, args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(DataBrokerUsage)
+	}
+	command, args := args[0], args[1:]
+
+	token, err := internal_databroker.NewAdminToken(secret, adminTokenTTL)
+	if err != nil {
+		return err
+	}
+	ctx = grpcutil.WithOutgoingJWT(ctx, token)
+
+	switch {
+	case command == "types" && len(args) == 0:
+		res, err := client.GetTypes(ctx, new(emptypb.Empty))
+		if err != nil {
+			return err
+		}
+		for _, recordType := range res.GetTypes() {
+			_, _ = fmt.Fprintln(w, recordType)
+		}
+		return nil
+	case command == "list" && len(args) == 1:
+		res, err := client.GetAll(ctx, &databroker.GetAllRequest{Type: args[0]})
+		if err != nil {
+			return err
+		}
+		for _, record := range res.GetRecords() {
+			if err := writeJSON(w, record, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	case command == "get" && len(args) == 2:
+		res, err := client.Get(ctx, &databroker.GetRequest{Type: args[0], Id: args[1]})
+		if err != nil {
+			return err
+		}
+		return writeJSON(w, res.GetRecord(), true)
+	case command == "delete" && len(args) == 2:
+		_, err := client.Delete(ctx, &databroker.DeleteRequest{Type: args[0], Id: args[1]})
+		return err
+	case command == "history" && len(args) == 2:
+		res, err := client.GetHistory(ctx, &databroker.GetHistoryRequest{Type: args[0], Id: args[1]})
+		if err != nil {
+			return err
+		}
+		for _, change := range res.GetChanges() {
+			if err := writeJSON(w, change, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	case command == "by-index" && len(args) == 3:
+		res, err := client.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{Type: args[0], Index: args[1], Value: args[2]})
+		if err != nil {
+			return err
+		}
+		for _, record := range res.GetRecords() {
+			if err := writeJSON(w, record, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.New(DataBrokerUsage)
+}
+
+// writeJSON writes a message as a line of JSON, or as indented JSON, with
+// any record data decoded.
+func writeJSON(w io.Writer, msg proto.Message, indent bool) error {
+	opts := protojson.MarshalOptions{}
+	if indent {
+		opts.Indent = "  "
+	}
+	bs, err := opts.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("error encoding record: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(bs))
+	return err
+}
@@ -0,0 +1,102 @@
+package pomerium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestRunDataBroker(t *testing.T) {
+	ctx := context.Background()
+	secret := cryptutil.NewKey()
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(s, internal_databroker.New(
+		internal_databroker.WithSecret(secret),
+		internal_databroker.WithHistorySize(10),
+	))
+	go func() { _ = s.Serve(lis) }()
+	defer s.Stop()
+
+	cc, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}))
+	require.NoError(t, err)
+	defer cc.Close()
+	client := databroker.NewDataBrokerServiceClient(cc)
+
+	sessionType := "type.googleapis.com/session.Session"
+	for _, id := range []string{"session1", "session2"} {
+		data, _ := anypb.New(&session.Session{Id: id, UserId: "user1"})
+		_, err := client.Set(ctx, &databroker.SetRequest{Type: sessionType, Id: id, Data: data})
+		require.NoError(t, err)
+	}
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		err := RunDataBroker(ctx, client, secret, args, &out)
+		return out.String(), err
+	}
+	decodeLines := func(out string) []map[string]interface{} {
+		var records []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+			var record map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			records = append(records, record)
+		}
+		return records
+	}
+
+	out, err := run("types")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Split(out, "\n"), sessionType)
+
+	out, err = run("list", sessionType)
+	require.NoError(t, err)
+	assert.Len(t, decodeLines(out), 2)
+
+	out, err = run("get", sessionType, "session1")
+	require.NoError(t, err)
+	var record struct {
+		ID   string `json:"id"`
+		Data struct {
+			Type   string `json:"@type"`
+			UserID string `json:"userId"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &record))
+	assert.Equal(t, "session1", record.ID)
+	assert.Equal(t, sessionType, record.Data.Type)
+	assert.Equal(t, "user1", record.Data.UserID, "record data should be decoded")
+
+	out, err = run("by-index", sessionType, "user_id", "user1")
+	require.NoError(t, err)
+	assert.Len(t, decodeLines(out), 2)
+
+	_, err = run("delete", sessionType, "session1")
+	require.NoError(t, err)
+	out, err = run("history", sessionType, "session1")
+	require.NoError(t, err, "admin commands should be authorized with the secret")
+	assert.Len(t, decodeLines(out), 2)
+
+	_, err = run("get", sessionType)
+	assert.EqualError(t, err, DataBrokerUsage)
+	_, err = run()
+	assert.EqualError(t, err, DataBrokerUsage)
+}
@@ -0,0 +1,165 @@
+package pomerium
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/open-policy-agent/opa/util"
+
+	"github.com/pomerium/pomerium/authorize/evaluator"
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+const policyTestSessionID = "policy-test-session"
+
+// A PolicyTestCase is a synthetic authorization request and its expected
+// result.
+type PolicyTestCase struct {
+	Name    string                   `json:"name"`
+	HTTP    evaluator.RequestHTTP    `json:"http"`
+	Session evaluator.RequestSession `json:"session"`
+	// GraphQL, if set, is the operation of a request to a GraphQL route.
+	GraphQL *evaluator.RequestGraphQL `json:"graphql"`
+	// User, if set, is the signed in user making the request.
+	User *PolicyTestUser `json:"user"`
+	// Expect is either "allow" or "deny". If empty, the result is only printed.
+	Expect string `json:"expect"`
+}
+
+// A PolicyTestUser is the user used for a policy test case.
+type PolicyTestUser struct {
+	ID     string   `json:"id"`
+	Email  string   `json:"email"`
+	Groups []string `json:"groups"`
+}
+
+// RunPolicyTest evaluates the policy test cases in the given JSON or YAML
+// fixtures file against the policies in the given config file and writes the
+// results to w. An error is returned if any test case does not have its
+// expected result.
+func RunPolicyTest(ctx context.Context, configFile, fixturesFile string, w io.Writer) error {
+	src, err := config.NewFileOrEnvironmentSource(configFile)
+	if err != nil {
+		return err
+	}
+	options := src.GetConfig().Options
+	if options.AuthenticateURL == nil {
+		options.AuthenticateURL = new(url.URL)
+	}
+
+	bs, err := ioutil.ReadFile(fixturesFile)
+	if err != nil {
+		return fmt.Errorf("error reading policy test fixtures: %w", err)
+	}
+	var cases []PolicyTestCase
+	if err := util.Unmarshal(bs, &cases); err != nil {
+		return fmt.Errorf("error parsing policy test fixtures: %w", err)
+	}
+
+	store := evaluator.NewStore()
+	e, err := evaluator.New(options, store)
+	if err != nil {
+		return fmt.Errorf("error creating policy evaluator: %w", err)
+	}
+
+	failures := 0
+	for i := range cases {
+		tc := &cases[i]
+		if tc.Expect != "" && tc.Expect != "allow" && tc.Expect != "deny" {
+			return fmt.Errorf("%s: invalid expect: %q, must be allow or deny", tc.Name, tc.Expect)
+		}
+
+		res, err := e.Evaluate(ctx, newPolicyTestRequest(options, tc))
+		if err != nil {
+			return fmt.Errorf("%s: error evaluating policy: %w", tc.Name, err)
+		}
+
+		result := "deny"
+		if res.Status == http.StatusOK {
+			result = "allow"
+		}
+		status := "PASS"
+		if tc.Expect == "" {
+			status = "----"
+		} else if tc.Expect != result {
+			status = "FAIL"
+			failures++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s (%d %s)\n", status, tc.Name, result, res.Status, res.Message)
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("%d of %d policy tests failed", failures, len(cases))
+	}
+	return nil
+}
+
+func newPolicyTestRequest(options *config.Options, tc *PolicyTestCase) *evaluator.Request {
+	req := &evaluator.Request{
+		DataBrokerData: make(evaluator.DataBrokerData),
+		HTTP:           tc.HTTP,
+		GraphQL:        tc.GraphQL,
+		Session:        tc.Session,
+	}
+	if req.HTTP.Method == "" {
+		req.HTTP.Method = "GET"
+	}
+
+	if tc.User != nil {
+		if req.Session.ID == "" {
+			req.Session.ID = policyTestSessionID
+		}
+		userID := tc.User.ID
+		if userID == "" {
+			userID = tc.User.Email
+		}
+		setPolicyTestData(req.DataBrokerData, "type.googleapis.com/session.Session", req.Session.ID, &session.Session{
+			Id:     req.Session.ID,
+			UserId: userID,
+		})
+		setPolicyTestData(req.DataBrokerData, "type.googleapis.com/user.User", userID, &user.User{
+			Id:    userID,
+			Email: tc.User.Email,
+		})
+		setPolicyTestData(req.DataBrokerData, "type.googleapis.com/directory.User", userID, &directory.User{
+			Id:       userID,
+			GroupIds: tc.User.Groups,
+		})
+		for _, group := range tc.User.Groups {
+			setPolicyTestData(req.DataBrokerData, "type.googleapis.com/directory.Group", group, &directory.Group{
+				Id:   group,
+				Name: group,
+			})
+		}
+	}
+
+	if u, err := url.Parse(req.HTTP.URL); err == nil {
+		for _, p := range options.Policies {
+			if p.Matches(u) {
+				req.OpenAPI = evaluator.GetRequestOpenAPI(&p, req.HTTP.Method, u)
+				for _, sp := range p.SubPolicies {
+					req.CustomPolicies = append(req.CustomPolicies, sp.Rego...)
+				}
+				break
+			}
+		}
+	}
+
+	return req
+}
+
+func setPolicyTestData(dbd evaluator.DataBrokerData, typeURL, id string, value interface{}) {
+	m, ok := dbd[typeURL]
+	if !ok {
+		m = make(map[string]interface{})
+		dbd[typeURL] = m
+	}
+	m[id] = value
+}
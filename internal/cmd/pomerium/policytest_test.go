@@ -0,0 +1,101 @@
+package pomerium
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPolicyTest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pomerium-policy-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	configFile := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(`
+authenticate_service_url: https://authenticate.example.com
+shared_secret: YixWi1MYh77NMECGGIJQevoonYtVF+ZPRkQZrrmeRqM=
+cookie_secret: zixWi1MYh77NMECGGIJQevoonYtVF+ZPRkQZrrmeRqM=
+insecure_server: true
+idp_service_account: e30=
+policy:
+  - from: https://from.example.com
+    to: https://to.example.com
+    allowed_users:
+      - user1@example.com
+    allowed_groups:
+      - admins
+  - from: https://custom.example.com
+    to: https://to.example.com
+    allowed_domains:
+      - example.com
+    sub_policies:
+      - rego:
+          - "allow { input.http.method == \"GET\" }"
+`), 0600))
+
+	fixturesFile := filepath.Join(dir, "fixtures.yaml")
+	writeFixtures := func(contents string) {
+		require.NoError(t, ioutil.WriteFile(fixturesFile, []byte(contents), 0600))
+	}
+
+	t.Run("pass", func(t *testing.T) {
+		writeFixtures(`
+- name: allowed user
+  http: {url: "https://from.example.com/"}
+  user: {email: user1@example.com}
+  expect: allow
+- name: allowed group
+  http: {url: "https://from.example.com/"}
+  user: {email: user2@example.com, groups: [admins]}
+  expect: allow
+- name: other user
+  http: {url: "https://from.example.com/"}
+  user: {email: user2@example.com}
+  expect: deny
+- name: anonymous
+  http: {url: "https://from.example.com/"}
+  expect: deny
+- name: custom rego get
+  http: {method: GET, url: "https://custom.example.com/"}
+  user: {email: user2@example.com}
+  expect: allow
+- name: custom rego post
+  http: {method: POST, url: "https://custom.example.com/"}
+  user: {email: user2@example.com}
+  expect: deny
+`)
+		var buf bytes.Buffer
+		err := RunPolicyTest(context.Background(), configFile, fixturesFile, &buf)
+		assert.NoError(t, err, buf.String())
+		assert.Contains(t, buf.String(), "PASS\tallowed user\tallow (200 OK)\n")
+		assert.Contains(t, buf.String(), "PASS\tanonymous\tdeny (401 login required)\n")
+	})
+	t.Run("fail", func(t *testing.T) {
+		writeFixtures(`
+- name: other user
+  http: {url: "https://from.example.com/"}
+  user: {email: user2@example.com}
+  expect: allow
+- name: no expectation
+  http: {url: "https://from.example.com/"}
+  user: {email: user1@example.com}
+`)
+		var buf bytes.Buffer
+		err := RunPolicyTest(context.Background(), configFile, fixturesFile, &buf)
+		assert.EqualError(t, err, "1 of 2 policy tests failed")
+		assert.Contains(t, buf.String(), "FAIL\tother user\tdeny (403 forbidden)\n")
+		assert.Contains(t, buf.String(), "----\tno expectation\tallow (200 OK)\n")
+	})
+	t.Run("invalid expect", func(t *testing.T) {
+		writeFixtures(`[{name: bad, http: {url: "https://from.example.com/"}, expect: maybe}]`)
+		err := RunPolicyTest(context.Background(), configFile, fixturesFile, ioutil.Discard)
+		assert.Error(t, err)
+	})
+}
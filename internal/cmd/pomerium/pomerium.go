@@ -10,7 +10,7 @@ import (
 	"os/signal"
 	"syscall"
 
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pomerium/pomerium/authenticate"
@@ -24,9 +24,15 @@ import (
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/internal/version"
+	"github.com/pomerium/pomerium/pkg/grpc/standby"
 	"github.com/pomerium/pomerium/proxy"
 )
 
+// authorizationServiceName is the name of the gRPC service envoy calls to
+// authorize requests, whose health check reports whether the authorize
+// service is ready.
+const authorizationServiceName = "envoy.service.auth.v3.Authorization"
+
 // Run runs the main pomerium application.
 func Run(ctx context.Context, configFile string) error {
 	log.Info().Str("version", version.FullVersion()).Msg("cmd/pomerium")
@@ -45,8 +51,17 @@ func Run(ctx context.Context, configFile string) error {
 
 	src = databroker.NewConfigSource(src)
 
+	return run(ctx, src)
+}
+
+// run runs the services enabled in the config.
+func run(ctx context.Context, src config.Source) error {
 	logMgr := config.NewLogManager(src)
 	defer logMgr.Close()
+	auditLogMgr := config.NewAuditLogManager(src)
+	defer auditLogMgr.Close()
+	logArchiveMgr := config.NewLogArchiveManager(src)
+	defer logArchiveMgr.Close()
 	metricsMgr := config.NewMetricsManager(src)
 	defer metricsMgr.Close()
 	traceMgr := config.NewTraceManager(src)
@@ -55,7 +70,7 @@ func Run(ctx context.Context, configFile string) error {
 	cfg := src.GetConfig()
 
 	// setup the control plane
-	controlPlane, err := controlplane.NewServer(cfg.Options.Services)
+	controlPlane, err := controlplane.NewServer(cfg.Options.Services, cfg.Options)
 	if err != nil {
 		return fmt.Errorf("error creating control plane: %w", err)
 	}
@@ -154,7 +169,11 @@ func setupAuthorize(src config.Source, cfg *config.Config, controlPlane *control
 	if err != nil {
 		return nil, fmt.Errorf("error creating authorize service: %w", err)
 	}
-	envoy_service_auth_v2.RegisterAuthorizationServer(controlPlane.GRPCServer, svc)
+	envoy_service_auth_v3.RegisterAuthorizationServer(controlPlane.GRPCServer, svc)
+	standby.RegisterStandbyServiceServer(controlPlane.GRPCServer, svc.StandbyServer())
+	svc.OnReadyChange(func(ready bool) {
+		controlPlane.SetReady(authorizationServiceName, ready)
+	})
 
 	log.Info().Msg("enabled authorize service")
 	src.OnConfigChange(svc.OnConfigChange)
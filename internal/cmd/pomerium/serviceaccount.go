@@ -0,0 +1,151 @@
+package pomerium
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/encoding"
+	"github.com/pomerium/pomerium/internal/encoding/sessiontoken"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/serviceaccount"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// ServiceAccountUsage describes the service account commands.
+const ServiceAccountUsage = `usage: pomerium service-account [-config <config file>] [-databroker-url <url>] <command>
+
+commands:
+  create -name <name> -email <email> [-group <group>]... [-description <description>] [-ttl <duration>]
+                 create a service account, and print its token
+  list           list the service accounts
+  revoke <id>    delete a service account, revoking its token`
+
+// NewServiceAccountSigner returns the encoder of the tokens of service
+// accounts. Tokens are signed like the sessions of the authenticate service
+// in the config file, so the authorize service accepts them in the
+// "Authorization: Pomerium <token>" header.
+func NewServiceAccountSigner(configFile string) (encoding.Marshaler, error) {
+	src, err := config.NewFileOrEnvironmentSource(configFile)
+	if err != nil {
+		return nil, err
+	}
+	options := src.GetConfig().Options
+	return sessiontoken.New(options, "cli")
+}
+
+// RunServiceAccount runs a service account command. Requests are made with
+// an admin token signed with the secret.
+func RunServiceAccount(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	secret []byte,
+	signer encoding.Marshaler,
+	args []string,
+	w io.Writer,
+) error {
+	if len(args) == 0 {
+		return errors.New(ServiceAccountUsage)
+	}
+	command, args := args[0], args[1:]
+
+	token, err := internal_databroker.NewAdminToken(secret, adminTokenTTL)
+	if err != nil {
+		return err
+	}
+	ctx = grpcutil.WithOutgoingJWT(ctx, token)
+
+	switch {
+	case command == "create":
+		return runServiceAccountCreate(ctx, client, signer, args, w)
+	case command == "list" && len(args) == 0:
+		sas, err := serviceaccount.GetAll(ctx, client)
+		if err != nil {
+			return err
+		}
+		for _, sa := range sas {
+			if err := writeJSON(w, sa, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	case command == "revoke" && len(args) == 1:
+		return serviceaccount.Delete(ctx, client, args[0])
+	}
+	return errors.New(ServiceAccountUsage)
+}
+
+func runServiceAccountCreate(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	signer encoding.Marshaler,
+	args []string,
+	w io.Writer,
+) error {
+	var groups stringsFlag
+	fs := flag.NewFlagSet("service-account create", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	name := fs.String("name", "", "Specify the name of the service account")
+	email := fs.String("email", "", "Specify the email policies match the service account by")
+	description := fs.String("description", "", "Specify the description of the service account")
+	ttl := fs.Duration("ttl", 0, "Specify the lifetime of the token, or 0 if it doesn't expire")
+	fs.Var(&groups, "group", "Specify a group policies match the service account by, may be repeated")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 0 || *name == "" || *email == "" || *ttl < 0 {
+		return errors.New(ServiceAccountUsage)
+	}
+
+	now := time.Now()
+	sa := &serviceaccount.ServiceAccount{
+		Id:          uuid.New().String(),
+		Name:        *name,
+		Description: *description,
+		Email:       *email,
+		Groups:      groups,
+		IssuedAt:    timestamppb.New(now),
+	}
+	state := &sessions.State{
+		ID:           sa.GetId(),
+		Subject:      serviceaccount.UserID(sa.GetId()),
+		IssuedAt:     jwt.NewNumericDate(now),
+		AuthTime:     jwt.NewNumericDate(now),
+		Programmatic: true,
+	}
+	if *ttl > 0 {
+		sa.ExpiresAt = timestamppb.New(now.Add(*ttl))
+		state.Expiry = jwt.NewNumericDate(now.Add(*ttl))
+	}
+
+	rawJWT, err := signer.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("error signing service account token: %w", err)
+	}
+	if _, err := serviceaccount.Set(ctx, client, sa); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\ntoken: %s\n", sa.GetId(), rawJWT)
+	return err
+}
+
+// stringsFlag is a flag which may be repeated.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
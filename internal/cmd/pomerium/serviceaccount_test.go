@@ -0,0 +1,81 @@
+package pomerium
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/serviceaccount"
+)
+
+func TestRunServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	secret := cryptutil.NewKey()
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(s, internal_databroker.New(
+		internal_databroker.WithSecret(secret),
+	))
+	go func() { _ = s.Serve(lis) }()
+	defer s.Stop()
+
+	cc, err := grpc.DialContext(ctx, "bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}))
+	require.NoError(t, err)
+	defer cc.Close()
+	client := databroker.NewDataBrokerServiceClient(cc)
+
+	encoder, err := jws.NewHS256Signer(cryptutil.NewKey(), "authenticate.example.com")
+	require.NoError(t, err)
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		err := RunServiceAccount(ctx, client, secret, encoder, args, &out)
+		return out.String(), err
+	}
+
+	out, err := run("create", "-name", "ci", "-email", "ci@example.com", "-group", "deployers", "-group", "readers", "-ttl", "1h")
+	require.NoError(t, err)
+	m := regexp.MustCompile(`^id: (\S+)\ntoken: (\S+)\n$`).FindStringSubmatch(out)
+	require.Len(t, m, 3, out)
+
+	var state sessions.State
+	require.NoError(t, encoder.Unmarshal([]byte(m[2]), &state))
+	assert.Equal(t, m[1], state.ID, "the token's session id should be the service account id")
+	assert.Equal(t, serviceaccount.UserID(m[1]), state.Subject)
+	require.NotNil(t, state.Expiry)
+
+	sa, err := serviceaccount.Get(ctx, client, m[1])
+	require.NoError(t, err)
+	assert.Equal(t, "ci@example.com", sa.GetEmail())
+	assert.Equal(t, []string{"deployers", "readers"}, sa.GetGroups())
+	assert.Equal(t, state.Expiry.Time().Unix(), sa.GetExpiresAt().AsTime().Unix())
+
+	out, err = run("list")
+	require.NoError(t, err)
+	assert.Contains(t, out, m[1])
+
+	_, err = run("revoke", m[1])
+	require.NoError(t, err)
+	_, err = serviceaccount.Get(ctx, client, m[1])
+	assert.Error(t, err, "revoked service accounts should be deleted")
+
+	_, err = run("create", "-name", "ci")
+	assert.EqualError(t, err, ServiceAccountUsage)
+	_, err = run("revoke")
+	assert.EqualError(t, err, ServiceAccountUsage)
+}
@@ -0,0 +1,117 @@
+package pomerium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/autocert"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+// RunSidecar runs pomerium in sidecar mode: the proxy and authorize services
+// for a single route, using the policy in the local config. The route can be
+// given as <from>=<to>, otherwise it's the single route in the config.
+func RunSidecar(ctx context.Context, configFile, route string) error {
+	log.Info().Str("version", version.FullVersion()).Msg("cmd/pomerium: sidecar")
+
+	// the service mode has to be set before the options are loaded, since
+	// their defaults depend on it
+	if err := os.Setenv("SERVICES", config.ServiceSidecar); err != nil {
+		return err
+	}
+
+	var src config.Source
+
+	src, err := config.NewFileOrEnvironmentSource(configFile)
+	if err != nil {
+		return err
+	}
+
+	src, err = newSidecarSource(src, route)
+	if err != nil {
+		return err
+	}
+
+	src, err = autocert.New(src)
+	if err != nil {
+		return err
+	}
+
+	return run(ctx, src)
+}
+
+// sidecarSource applies the route given on the command line to the config of
+// the underlying source, and checks there is a single route.
+type sidecarSource struct {
+	from, to string
+
+	mu  sync.RWMutex
+	cfg *config.Config
+
+	config.ChangeDispatcher
+}
+
+func newSidecarSource(underlying config.Source, route string) (*sidecarSource, error) {
+	src := new(sidecarSource)
+	if route != "" {
+		idx := strings.Index(route, "=")
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid route %q, expected <from>=<to>", route)
+		}
+		src.from, src.to = route[:idx], route[idx+1:]
+	}
+
+	cfg, err := src.apply(underlying.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	src.cfg = cfg
+
+	underlying.OnConfigChange(func(cfg *config.Config) {
+		cfg, err := src.apply(cfg)
+		if err != nil {
+			log.Error().Err(err).Msg("cmd/pomerium: ignoring invalid sidecar config")
+			return
+		}
+		src.mu.Lock()
+		src.cfg = cfg
+		src.mu.Unlock()
+
+		src.Trigger(cfg)
+	})
+	return src, nil
+}
+
+// GetConfig gets the config.
+func (src *sidecarSource) GetConfig() *config.Config {
+	src.mu.RLock()
+	defer src.mu.RUnlock()
+
+	return src.cfg
+}
+
+// apply returns a copy of the config with the route from the command line.
+func (src *sidecarSource) apply(cfg *config.Config) (*config.Config, error) {
+	cfg = cfg.Clone()
+	policies := append([]config.Policy(nil), cfg.Options.Policies...)
+	if src.from != "" {
+		if len(policies) == 0 {
+			policies = append(policies, config.Policy{})
+		}
+		policies[0].From, policies[0].To = src.from, src.to
+		if err := policies[0].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid route: %w", err)
+		}
+	}
+	if len(policies) != 1 {
+		return nil, errors.New("sidecar mode requires a single route, set with -route or in the config")
+	}
+	cfg.Options.Policies = policies
+	return cfg, nil
+}
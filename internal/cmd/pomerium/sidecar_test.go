@@ -0,0 +1,76 @@
+package pomerium
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestSidecarSource(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(policies ...config.Policy) *config.Config {
+		return &config.Config{Options: &config.Options{
+			Services: config.ServiceSidecar,
+			Policies: policies,
+		}}
+	}
+	configured := config.Policy{
+		From:         "https://app.example.com",
+		To:           "http://127.0.0.1:8080",
+		AllowedUsers: []string{"user@example.com"},
+	}
+
+	t.Run("route from config", func(t *testing.T) {
+		src, err := newSidecarSource(config.NewStaticSource(newConfig(configured)), "")
+		require.NoError(t, err)
+		require.Len(t, src.GetConfig().Options.Policies, 1)
+		assert.Equal(t, "https://app.example.com", src.GetConfig().Options.Policies[0].From)
+	})
+	t.Run("route from flag", func(t *testing.T) {
+		src, err := newSidecarSource(config.NewStaticSource(newConfig()), "https://api.example.com=http://127.0.0.1:9090")
+		require.NoError(t, err)
+		require.Len(t, src.GetConfig().Options.Policies, 1)
+		p := src.GetConfig().Options.Policies[0]
+		assert.Equal(t, "api.example.com", p.Source.Host)
+		assert.Equal(t, "127.0.0.1:9090", p.Destination.Host)
+	})
+	t.Run("flag overrides config", func(t *testing.T) {
+		underlying := newConfig(configured)
+		src, err := newSidecarSource(config.NewStaticSource(underlying), "https://api.example.com=http://127.0.0.1:9090")
+		require.NoError(t, err)
+		p := src.GetConfig().Options.Policies[0]
+		assert.Equal(t, "https://api.example.com", p.From)
+		assert.Equal(t, []string{"user@example.com"}, p.AllowedUsers, "should keep the configured policy")
+		assert.Equal(t, "https://app.example.com", underlying.Options.Policies[0].From, "should not modify the underlying config")
+	})
+	t.Run("no route", func(t *testing.T) {
+		_, err := newSidecarSource(config.NewStaticSource(newConfig()), "")
+		assert.Error(t, err)
+	})
+	t.Run("invalid route", func(t *testing.T) {
+		_, err := newSidecarSource(config.NewStaticSource(newConfig()), "https://api.example.com")
+		assert.Error(t, err)
+		_, err = newSidecarSource(config.NewStaticSource(newConfig()), "https://api.example.com=")
+		assert.Error(t, err)
+	})
+	t.Run("config change", func(t *testing.T) {
+		underlying := config.NewStaticSource(newConfig(configured))
+		src, err := newSidecarSource(underlying, "")
+		require.NoError(t, err)
+		var changed *config.Config
+		src.OnConfigChange(func(cfg *config.Config) { changed = cfg })
+
+		underlying.SetConfig(newConfig())
+		assert.Nil(t, changed, "should ignore configs without a route")
+
+		updated := configured
+		updated.To = "http://127.0.0.1:8081"
+		underlying.SetConfig(newConfig(updated))
+		require.NotNil(t, changed)
+		assert.Equal(t, "http://127.0.0.1:8081", src.GetConfig().Options.Policies[0].To)
+	})
+}
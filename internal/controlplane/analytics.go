@@ -0,0 +1,137 @@
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	"github.com/golang/protobuf/ptypes"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/analytics"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+)
+
+const (
+	routeAnalyticsPath       = "/.pomerium/admin/analytics"
+	routeAnalyticsWindow     = time.Hour
+	routeAnalyticsResolution = time.Minute
+)
+
+type routeAnalytics struct {
+	analytics.RouteStats
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// recordRouteAnalytics records an envoy access log entry for a policy route in
+// the route analytics and metrics.
+func (srv *Server) recordRouteAnalytics(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) {
+	current := srv.currentConfig.Load()
+	policy := current.getPolicyForRouteName(entry.GetCommonProperties().GetRouteName())
+	if policy == nil {
+		return
+	}
+
+	dur, _ := ptypes.Duration(entry.GetCommonProperties().GetTimeToLastDownstreamTxByte())
+	status := int(entry.GetResponse().GetResponseCode().GetValue())
+	srv.analytics.Record(analytics.Event{
+		Route:    getPolicyName(policy),
+		User:     getUserFromJWT(entry.GetRequest().GetRequestHeaders()[httputil.HeaderPomeriumJWTAssertion]),
+		Status:   status,
+		Duration: dur,
+	})
+	metrics.RecordRouteRequest(context.Background(), getPolicyName(policy), policy.Tenant, status, dur)
+}
+
+// handleRouteAnalytics returns the per-route analytics as JSON. Access is
+// restricted to administrators by the authorize service.
+func (srv *Server) handleRouteAnalytics(w http.ResponseWriter, r *http.Request) error {
+	window := srv.analytics.Window()
+	if raw := r.FormValue("window"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid window: %q", raw))
+		}
+		if d < window {
+			window = d
+		}
+	}
+
+	options := srv.currentConfig.Load().Options
+	routes := []routeAnalytics{}
+	for _, rs := range srv.analytics.Stats(window) {
+		ra := routeAnalytics{RouteStats: rs}
+		for i := range options.Policies {
+			if getPolicyName(&options.Policies[i]) == rs.Route {
+				ra.From = options.Policies[i].From
+				ra.To = options.Policies[i].To
+				break
+			}
+		}
+		routes = append(routes, ra)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	return json.NewEncoder(w).Encode(struct {
+		Window string           `json:"window"`
+		Routes []routeAnalytics `json:"routes"`
+	}{
+		Window: window.String(),
+		Routes: routes,
+	})
+}
+
+// getRoutePolicies returns the policies of the options by the name of their
+// envoy routes. Route names are derived from the route's id rather than its
+// position, so they don't change when routes are reordered, added or removed.
+func getRoutePolicies(options *config.Options) map[string]*config.Policy {
+	policies := make(map[string]*config.Policy, len(options.Policies))
+	for i := range options.Policies {
+		name := getPolicyName(&options.Policies[i])
+		if _, ok := policies[name]; !ok {
+			policies[name] = &options.Policies[i]
+		}
+	}
+	return policies
+}
+
+// getPolicyForRouteName returns the policy for an envoy route name built by
+// buildPolicyRoutes.
+func (opts *versionedOptions) getPolicyForRouteName(routeName string) *config.Policy {
+	// forced trace and priority routes are copies of the policy's route
+	routeName = strings.TrimSuffix(routeName, "-trace")
+	routeName = strings.TrimSuffix(routeName, "-priority")
+	return opts.routePolicies[routeName]
+}
+
+// getUserFromJWT returns the email, or the subject, of the pomerium JWT
+// assertion. The signature is not verified since the header is always set
+// by the authorize service for policy routes.
+func getUserFromJWT(rawJWT string) string {
+	if rawJWT == "" {
+		return ""
+	}
+	tok, err := jwt.ParseSigned(rawJWT)
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Email   string `json:"email"`
+		Subject string `json:"sub"`
+	}
+	if err := tok.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return ""
+	}
+	if claims.Email != "" {
+		return claims.Email
+	}
+	return claims.Subject
+}
@@ -0,0 +1,134 @@
+package controlplane
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/analytics"
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+func TestServer_RouteAnalytics(t *testing.T) {
+	options := config.NewDefaultOptions()
+	options.Policies = []config.Policy{
+		{From: "https://from1.example.com", To: "https://to1.example.com"},
+		{From: "https://from2.example.com", To: "https://to2.example.com"},
+	}
+	for i := range options.Policies {
+		require.NoError(t, options.Policies[i].Validate())
+	}
+	srv := &Server{analytics: analytics.New(routeAnalyticsWindow, routeAnalyticsResolution)}
+	srv.currentConfig.Store(versionedOptions{Options: *options, routePolicies: getRoutePolicies(options)})
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("secret")}, nil)
+	require.NoError(t, err)
+	rawJWT, err := jwt.Signed(signer).Claims(map[string]interface{}{
+		"sub":   "user-1",
+		"email": "user@example.com",
+	}).CompactSerialize()
+	require.NoError(t, err)
+
+	newEntry := func(routeName, jwt string, code uint32) *envoy_data_accesslog_v3.HTTPAccessLogEntry {
+		return &envoy_data_accesslog_v3.HTTPAccessLogEntry{
+			CommonProperties: &envoy_data_accesslog_v3.AccessLogCommon{
+				RouteName:                  routeName,
+				TimeToLastDownstreamTxByte: ptypes.DurationProto(3 * time.Millisecond),
+			},
+			Request: &envoy_data_accesslog_v3.HTTPRequestProperties{
+				RequestMethod:  envoy_config_core_v3.RequestMethod_GET,
+				RequestHeaders: map[string]string{httputil.HeaderPomeriumJWTAssertion: jwt},
+			},
+			Response: &envoy_data_accesslog_v3.HTTPResponseProperties{
+				ResponseCode: &wrappers.UInt32Value{Value: code},
+			},
+		}
+	}
+	routeName := getPolicyName(&options.Policies[1])
+	srv.recordRouteAnalytics(newEntry(routeName, rawJWT, http.StatusOK))
+	srv.recordRouteAnalytics(newEntry(routeName, "", http.StatusForbidden))
+	srv.recordRouteAnalytics(newEntry("policy-5", rawJWT, http.StatusOK))
+	srv.recordRouteAnalytics(newEntry("pomerium-path-/ping", "", http.StatusOK))
+
+	t.Run("ok", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, routeAnalyticsPath+"?window=5m", nil)
+		require.NoError(t, srv.handleRouteAnalytics(w, r))
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var res struct {
+			Window string           `json:"window"`
+			Routes []routeAnalytics `json:"routes"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, "5m0s", res.Window)
+		if assert.Len(t, res.Routes, 1) {
+			assert.Equal(t, getPolicyName(&options.Policies[1]), res.Routes[0].Route)
+			assert.Equal(t, "https://from2.example.com", res.Routes[0].From)
+			assert.Equal(t, "https://to2.example.com", res.Routes[0].To)
+			assert.Equal(t, 2, res.Routes[0].Requests)
+			assert.Equal(t, 1, res.Routes[0].Denied)
+			assert.Equal(t, []analytics.UserStats{{User: "user@example.com", Requests: 1}}, res.Routes[0].TopUsers)
+		}
+	})
+	t.Run("invalid window", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, routeAnalyticsPath+"?window=forever", nil)
+		err := srv.handleRouteAnalytics(w, r)
+		var httpErr *httputil.HTTPError
+		if assert.True(t, errors.As(err, &httpErr)) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Status)
+		}
+	})
+}
+
+func Test_getUserFromJWT(t *testing.T) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("secret")}, nil)
+	require.NoError(t, err)
+	sign := func(claims map[string]interface{}) string {
+		raw, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		return raw
+	}
+
+	assert.Equal(t, "", getUserFromJWT(""))
+	assert.Equal(t, "", getUserFromJWT("not-a-jwt"))
+	assert.Equal(t, "user-1", getUserFromJWT(sign(map[string]interface{}{"sub": "user-1"})))
+	assert.Equal(t, "user@example.com", getUserFromJWT(sign(map[string]interface{}{"sub": "user-1", "email": "user@example.com"})))
+}
+
+func Test_getPolicyForRouteName(t *testing.T) {
+	options := config.NewDefaultOptions()
+	options.Policies = []config.Policy{{From: "https://from1.example.com", To: "https://to1.example.com"}, {From: "https://from2.example.com", To: "https://to2.example.com"}}
+	for i := range options.Policies {
+		require.NoError(t, options.Policies[i].Validate())
+	}
+	current := versionedOptions{Options: *options, routePolicies: getRoutePolicies(options)}
+	name := getPolicyName(&options.Policies[1])
+
+	assert.Equal(t, &options.Policies[1], current.getPolicyForRouteName(name))
+	assert.Equal(t, &options.Policies[1], current.getPolicyForRouteName(name+"-trace"))
+	assert.Equal(t, &options.Policies[1], current.getPolicyForRouteName(name+"-priority"))
+	assert.Nil(t, current.getPolicyForRouteName("policy-1"))
+	assert.Nil(t, current.getPolicyForRouteName("pomerium-path-/ping"))
+
+	t.Run("reordered", func(t *testing.T) {
+		reordered := config.NewDefaultOptions()
+		reordered.Policies = []config.Policy{options.Policies[1], options.Policies[0]}
+		current := versionedOptions{Options: *reordered, routePolicies: getRoutePolicies(reordered)}
+		assert.Equal(t, "https://from2.example.com", current.getPolicyForRouteName(name).From)
+	})
+}
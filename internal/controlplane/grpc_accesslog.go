@@ -1,18 +1,21 @@
 package controlplane
 
 import (
-	envoy_service_accesslog_v2 "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	envoy_service_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
 	"github.com/golang/protobuf/ptypes"
 
+	"github.com/pomerium/pomerium/internal/audit"
 	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/logarchive"
 )
 
 func (srv *Server) registerAccessLogHandlers() {
-	envoy_service_accesslog_v2.RegisterAccessLogServiceServer(srv.GRPCServer, srv)
+	envoy_service_accesslog_v3.RegisterAccessLogServiceServer(srv.GRPCServer, srv)
 }
 
 // StreamAccessLogs receives logs from envoy and prints them to stdout.
-func (srv *Server) StreamAccessLogs(stream envoy_service_accesslog_v2.AccessLogService_StreamAccessLogsServer) error {
+func (srv *Server) StreamAccessLogs(stream envoy_service_accesslog_v3.AccessLogService_StreamAccessLogsServer) error {
 	for {
 		msg, err := stream.Recv()
 		if err != nil {
@@ -20,25 +23,49 @@ func (srv *Server) StreamAccessLogs(stream envoy_service_accesslog_v2.AccessLogS
 			return err
 		}
 
+		current := srv.currentConfig.Load()
 		for _, entry := range msg.GetHttpLogs().LogEntry {
-			evt := log.Info().Str("service", "envoy")
-			// common properties
-			evt = evt.Str("upstream-cluster", entry.GetCommonProperties().GetUpstreamCluster())
-			// request properties
-			evt = evt.Str("method", entry.GetRequest().GetRequestMethod().String())
-			evt = evt.Str("authority", entry.GetRequest().GetAuthority())
-			evt = evt.Str("path", entry.GetRequest().GetPath())
-			evt = evt.Str("user-agent", entry.GetRequest().GetUserAgent())
-			evt = evt.Str("referer", entry.GetRequest().GetReferer())
-			evt = evt.Str("forwarded-for", entry.GetRequest().GetForwardedFor())
-			evt = evt.Str("request-id", entry.GetRequest().GetRequestId())
-			// response properties
-			dur, _ := ptypes.Duration(entry.CommonProperties.TimeToLastDownstreamTxByte)
-			evt = evt.Dur("duration", dur)
-			evt = evt.Uint64("size", entry.Response.ResponseBodyBytes)
-			evt = evt.Uint32("response-code", entry.GetResponse().GetResponseCode().GetValue())
-			evt = evt.Str("response-code-details", entry.GetResponse().GetResponseCodeDetails())
-			evt.Msg("http-request")
+			fields := getAccessLogFields(entry)
+			// archived logs are partitioned by the policy route rather than
+			// the requested host, so clients can't create a partition per
+			// host they make up
+			var tenant, route string
+			if policy := current.getPolicyForRouteName(entry.GetCommonProperties().GetRouteName()); policy != nil {
+				tenant = policy.Tenant
+				route = getPolicyName(policy)
+			}
+			if tenant != "" {
+				fields["tenant"] = tenant
+			}
+			log.Info().Fields(fields).Msg("http-request")
+			audit.LogForTenant(tenant, "http-request", fields)
+			// the logger and audit log add the schema version themselves
+			fields[log.SchemaVersionField] = log.SchemaVersion
+			logarchive.Archive(logarchive.KindAccess, route, fields)
+
+			srv.recordRouteAnalytics(entry)
 		}
 	}
 }
+
+func getAccessLogFields(entry *envoy_data_accesslog_v3.HTTPAccessLogEntry) map[string]interface{} {
+	dur, _ := ptypes.Duration(entry.GetCommonProperties().GetTimeToLastDownstreamTxByte())
+	return log.WithLegacyFields(map[string]interface{}{
+		"service": "envoy",
+		// common properties
+		"upstream_cluster": entry.GetCommonProperties().GetUpstreamCluster(),
+		// request properties
+		"method":        entry.GetRequest().GetRequestMethod().String(),
+		"authority":     entry.GetRequest().GetAuthority(),
+		"path":          log.RedactURL(entry.GetRequest().GetPath()),
+		"user_agent":    entry.GetRequest().GetUserAgent(),
+		"referer":       log.RedactURL(entry.GetRequest().GetReferer()),
+		"forwarded_for": entry.GetRequest().GetForwardedFor(),
+		"request_id":    entry.GetRequest().GetRequestId(),
+		// response properties
+		"duration":              dur,
+		"size":                  entry.GetResponse().GetResponseBodyBytes(),
+		"response_code":         entry.GetResponse().GetResponseCode().GetValue(),
+		"response_code_details": entry.GetResponse().GetResponseCodeDetails(),
+	})
+}
@@ -26,7 +26,7 @@ func (srv *Server) addHTTPMiddleware() {
 			Int("status", status).
 			Str("method", r.Method).
 			Str("host", r.Host).
-			Str("path", r.URL.String()).
+			Str("path", log.RedactURL(r.URL.String())).
 			Msg("http-request")
 	}))
 	root.Use(handlers.RecoveryHandler())
@@ -34,9 +34,10 @@ func (srv *Server) addHTTPMiddleware() {
 	root.Use(log.RemoteAddrHandler("ip"))
 	root.Use(log.UserAgentHandler("user_agent"))
 	root.Use(log.RefererHandler("referer"))
-	root.Use(log.RequestIDHandler("request-id"))
+	root.Use(log.RequestIDHandler("request_id"))
 	root.Use(middleware.Healthcheck("/ping", version.UserAgent()))
 	root.HandleFunc("/healthz", httputil.HealthCheck)
 	root.HandleFunc("/ping", httputil.HealthCheck)
+	root.Path(routeAnalyticsPath).Handler(httputil.HandlerFunc(srv.handleRouteAnalytics)).Methods(http.MethodGet)
 	root.PathPrefix("/.pomerium/assets/").Handler(http.StripPrefix("/.pomerium/assets/", frontend.MustAssetHandler()))
 }
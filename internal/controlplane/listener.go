@@ -0,0 +1,44 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// listenGRPC creates the listeners of the gRPC server on a loopback port
+// chosen by the OS. When there's more than one, they're all bound to the same
+// port with SO_REUSEPORT, so the kernel spreads new connections between their
+// accept loops.
+func listenGRPC(listeners int) ([]net.Listener, error) {
+	if listeners <= 1 {
+		li, err := net.Listen("tcp4", "127.0.0.1:0")
+		if err != nil {
+			return nil, err
+		}
+		return []net.Listener{li}, nil
+	}
+
+	ctx := context.Background()
+	lc := net.ListenConfig{Control: reusePort}
+	li, err := lc.Listen(ctx, "tcp4", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	lis := []net.Listener{li}
+	for len(lis) < listeners {
+		li, err := lc.Listen(ctx, "tcp4", lis[0].Addr().String())
+		if err != nil {
+			closeListeners(lis)
+			return nil, fmt.Errorf("controlplane: error creating gRPC listener: %w", err)
+		}
+		lis = append(lis, li)
+	}
+	return lis, nil
+}
+
+func closeListeners(lis []net.Listener) {
+	for _, li := range lis {
+		_ = li.Close()
+	}
+}
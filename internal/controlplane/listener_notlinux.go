@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package controlplane
+
+import (
+	"errors"
+	"syscall"
+)
+
+func reusePort(network, address string, conn syscall.RawConn) error {
+	return errors.New("multiple gRPC server listeners are only supported on linux")
+}
@@ -0,0 +1,49 @@
+package controlplane
+
+import (
+	"net"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenGRPC(t *testing.T) {
+	lis, err := listenGRPC(0)
+	require.NoError(t, err)
+	assert.Len(t, lis, 1)
+	closeListeners(lis)
+
+	if runtime.GOOS != "linux" {
+		t.Skip("SO_REUSEPORT listeners are only supported on linux")
+	}
+
+	lis, err = listenGRPC(3)
+	require.NoError(t, err)
+	defer closeListeners(lis)
+	require.Len(t, lis, 3)
+	for _, li := range lis[1:] {
+		assert.Equal(t, lis[0].Addr().String(), li.Addr().String(), "listeners should share the same port")
+	}
+
+	accepted := make(chan struct{}, 10)
+	for _, li := range lis {
+		go func(li net.Listener) {
+			for {
+				conn, err := li.Accept()
+				if err != nil {
+					return
+				}
+				_ = conn.Close()
+				accepted <- struct{}{}
+			}
+		}(li)
+	}
+	for i := 0; i < 10; i++ {
+		conn, err := net.Dial("tcp4", lis[0].Addr().String())
+		require.NoError(t, err)
+		_ = conn.Close()
+		<-accepted
+	}
+}
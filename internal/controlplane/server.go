@@ -4,15 +4,20 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/analytics"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry"
 	"github.com/pomerium/pomerium/internal/telemetry/requestid"
@@ -21,6 +26,8 @@ import (
 type versionedOptions struct {
 	config.Options
 	version int64
+	// routePolicies are the policies by the name of their envoy routes.
+	routePolicies map[string]*config.Policy
 }
 
 type atomicVersionedOptions struct {
@@ -39,40 +46,63 @@ func (avo *atomicVersionedOptions) Store(options versionedOptions) {
 type Server struct {
 	GRPCListener net.Listener
 	GRPCServer   *grpc.Server
+	HealthServer *health.Server
 	HTTPListener net.Listener
 	HTTPRouter   *mux.Router
 
+	// grpcListeners are all the listeners of the gRPC server, which share the
+	// GRPCListener's port.
+	grpcListeners []net.Listener
+
+	// notReady are the gRPC services whose health checks report that they
+	// aren't serving, even though the server is running.
+	readyMu  sync.Mutex
+	notReady map[string]bool
+	running  bool
+
 	currentConfig atomicVersionedOptions
 	configUpdated chan struct{}
+	analytics     *analytics.Aggregator
 }
 
 // NewServer creates a new Server. Listener ports are chosen by the OS.
-func NewServer(name string) (*Server, error) {
+func NewServer(name string, options *config.Options) (*Server, error) {
 	srv := &Server{
 		configUpdated: make(chan struct{}, 1),
+		analytics:     analytics.New(routeAnalyticsWindow, routeAnalyticsResolution),
+		notReady:      make(map[string]bool),
 	}
 	srv.currentConfig.Store(versionedOptions{})
 
 	var err error
 
 	// setup gRPC
-	srv.GRPCListener, err = net.Listen("tcp4", "127.0.0.1:0")
+	srv.grpcListeners, err = listenGRPC(options.GRPCServerListeners)
 	if err != nil {
 		return nil, err
 	}
-	srv.GRPCServer = grpc.NewServer(
+	srv.GRPCListener = srv.grpcListeners[0]
+	grpcOptions := []grpc.ServerOption{
 		grpc.StatsHandler(telemetry.NewGRPCServerStatsHandler(name)),
 		grpc.UnaryInterceptor(requestid.UnaryServerInterceptor()),
 		grpc.StreamInterceptor(requestid.StreamServerInterceptor()),
-	)
+	}
+	if options.GRPCServerStreamWorkers > 0 {
+		workers := options.GRPCServerStreamWorkers * len(srv.grpcListeners)
+		grpcOptions = append(grpcOptions, grpc.NumStreamWorkers(uint32(workers)))
+	}
+	grpcOptions = append(grpcOptions, grpcLimitOptions(options)...)
+	srv.GRPCServer = grpc.NewServer(grpcOptions...)
 	reflection.Register(srv.GRPCServer)
+	srv.HealthServer = health.NewServer()
+	grpc_health_v1.RegisterHealthServer(srv.GRPCServer, srv.HealthServer)
 	srv.registerXDSHandlers()
 	srv.registerAccessLogHandlers()
 
 	// setup HTTP
 	srv.HTTPListener, err = net.Listen("tcp4", "127.0.0.1:0")
 	if err != nil {
-		_ = srv.GRPCListener.Close()
+		closeListeners(srv.grpcListeners)
 		return nil, err
 	}
 	srv.HTTPRouter = mux.NewRouter()
@@ -81,20 +111,60 @@ func NewServer(name string) (*Server, error) {
 	return srv, nil
 }
 
+// grpcLimitOptions returns the gRPC server options limiting the streams,
+// messages and connections of clients.
+func grpcLimitOptions(options *config.Options) []grpc.ServerOption {
+	var grpcOptions []grpc.ServerOption
+	if options.GRPCServerMaxConcurrentStreams > 0 {
+		grpcOptions = append(grpcOptions, grpc.MaxConcurrentStreams(options.GRPCServerMaxConcurrentStreams))
+	}
+	if options.GRPCServerMaxReceiveMessageSize > 0 {
+		grpcOptions = append(grpcOptions, grpc.MaxRecvMsgSize(options.GRPCServerMaxReceiveMessageSize))
+	}
+	grpcOptions = append(grpcOptions,
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             options.GRPCServerKeepaliveMinTime,
+			PermitWithoutStream: options.GRPCServerKeepalivePermitWithoutStream,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionAge:      options.GRPCServerMaxConnectionAge,
+			MaxConnectionAgeGrace: options.GRPCServerMaxConnectionAgeGrace,
+		}),
+	)
+	return grpcOptions
+}
+
 // Run runs the control-plane gRPC and HTTP servers.
 func (srv *Server) Run(ctx context.Context) error {
 	eg, ctx := errgroup.WithContext(ctx)
 
-	// start the gRPC server
-	eg.Go(func() error {
-		log.Info().Str("addr", srv.GRPCListener.Addr().String()).Msg("starting control-plane gRPC server")
-		return srv.GRPCServer.Serve(srv.GRPCListener)
-	})
+	// every service has been registered by now, so they're all serving,
+	// unless they aren't ready
+	srv.readyMu.Lock()
+	srv.running = true
+	for name := range srv.GRPCServer.GetServiceInfo() {
+		srv.HealthServer.SetServingStatus(name, servingStatus(!srv.notReady[name]))
+	}
+	srv.readyMu.Unlock()
+
+	// start the gRPC server, with an accept loop for each listener
+	log.Info().Str("addr", srv.GRPCListener.Addr().String()).
+		Int("listeners", len(srv.grpcListeners)).
+		Msg("starting control-plane gRPC server")
+	for _, li := range srv.grpcListeners {
+		li := li
+		eg.Go(func() error {
+			return srv.GRPCServer.Serve(li)
+		})
+	}
 
 	// gracefully stop the gRPC server on context cancellation
 	eg.Go(func() error {
 		<-ctx.Done()
 
+		// health checks fail while the server is stopping
+		srv.HealthServer.Shutdown()
+
 		ctx, cancel := context.WithCancel(ctx)
 		ctx, cleanup := context.WithTimeout(ctx, time.Second*5)
 		defer cleanup()
@@ -141,6 +211,25 @@ func (srv *Server) Run(ctx context.Context) error {
 	return eg.Wait()
 }
 
+// SetReady sets whether a gRPC service is ready. The health checks of services
+// which aren't ready report that they aren't serving.
+func (srv *Server) SetReady(service string, ready bool) {
+	srv.readyMu.Lock()
+	defer srv.readyMu.Unlock()
+
+	srv.notReady[service] = !ready
+	if srv.running {
+		srv.HealthServer.SetServingStatus(service, servingStatus(ready))
+	}
+}
+
+func servingStatus(serving bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if serving {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
 // OnConfigChange updates the pomerium config options.
 func (srv *Server) OnConfigChange(cfg *config.Config) {
 	select {
@@ -149,8 +238,9 @@ func (srv *Server) OnConfigChange(cfg *config.Config) {
 	}
 	prev := srv.currentConfig.Load()
 	srv.currentConfig.Store(versionedOptions{
-		Options: *cfg.Options,
-		version: prev.version + 1,
+		Options:       *cfg.Options,
+		version:       prev.version + 1,
+		routePolicies: getRoutePolicies(cfg.Options),
 	})
 	srv.configUpdated <- struct{}{}
 }
@@ -0,0 +1,89 @@
+package controlplane
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestServer_Health(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	srv, err := NewServer("test", config.NewDefaultOptions())
+	require.NoError(t, err)
+	const notReadyService = "envoy.service.accesslog.v3.AccessLogService"
+	srv.SetReady(notReadyService, false)
+	go func() { _ = srv.Run(ctx) }()
+
+	cc, err := grpc.DialContext(ctx, srv.GRPCListener.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer cc.Close()
+
+	client := grpc_health_v1.NewHealthClient(cc)
+	for _, service := range []string{"", "envoy.service.discovery.v3.AggregatedDiscoveryService"} {
+		res, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.GetStatus(), "service %q", service)
+	}
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown.Service"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	t.Run("ready", func(t *testing.T) {
+		res, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: notReadyService})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+
+		srv.SetReady(notReadyService, true)
+		res, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: notReadyService})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.GetStatus())
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		stream, err := rpb.NewServerReflectionClient(cc).ServerReflectionInfo(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+		}))
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		var services []string
+		for _, service := range res.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		assert.Contains(t, services, "grpc.health.v1.Health")
+	})
+}
+
+func TestServer_Limits(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	options := config.NewDefaultOptions()
+	options.GRPCServerMaxReceiveMessageSize = 64
+	srv, err := NewServer("test", options)
+	require.NoError(t, err)
+	go func() { _ = srv.Run(ctx) }()
+
+	cc, err := grpc.DialContext(ctx, srv.GRPCListener.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer cc.Close()
+
+	client := grpc_health_v1.NewHealthClient(cc)
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: strings.Repeat("x", 128)})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/log"
 )
 
@@ -84,7 +85,8 @@ func buildAccessLogs(options *config.Options) []*envoy_config_accesslog_v3.Acces
 
 	tc, _ := ptypes.MarshalAny(&envoy_extensions_access_loggers_grpc_v3.HttpGrpcAccessLogConfig{
 		CommonConfig: &envoy_extensions_access_loggers_grpc_v3.CommonGrpcAccessLogConfig{
-			LogName: "ingress-http",
+			TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+			LogName:             "ingress-http",
 			GrpcService: &envoy_config_core_v3.GrpcService{
 				TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
 					EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
@@ -93,6 +95,8 @@ func buildAccessLogs(options *config.Options) []*envoy_config_accesslog_v3.Acces
 				},
 			},
 		},
+		// used to record the user for route analytics
+		AdditionalRequestHeadersToLog: []string{httputil.HeaderPomeriumJWTAssertion},
 	})
 	return []*envoy_config_accesslog_v3.AccessLog{{
 		Name:       "envoy.access_loggers.http_grpc",
@@ -5,8 +5,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/testutil"
@@ -70,6 +72,32 @@ func Test_buildPolicyTransportSocket(t *testing.T) {
 			TLSServerName: "use-this-name.example.com",
 		}))
 	})
+	t.Run("tls_verify_hostname", func(t *testing.T) {
+		testutil.AssertProtoJSONEqual(t, `
+			{
+				"name": "tls",
+				"typedConfig": {
+					"@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext",
+					"commonTlsContext": {
+						"alpnProtocols": ["http/1.1"],
+						"validationContext": {
+							"matchSubjectAltNames": [{
+								"exact": "verify-this-name.example.com"
+							}],
+							"trustedCa": {
+								"filename": "`+rootCA+`"
+							}
+						}
+					},
+					"sni": "use-this-name.example.com"
+				}
+			}
+		`, buildPolicyTransportSocket(&config.Policy{
+			Destination:       mustParseURL("https://example.com"),
+			TLSServerName:     "use-this-name.example.com",
+			TLSVerifyHostname: "verify-this-name.example.com",
+		}))
+	})
 	t.Run("tls_skip_verify", func(t *testing.T) {
 		testutil.AssertProtoJSONEqual(t, `
 			{
@@ -96,6 +124,29 @@ func Test_buildPolicyTransportSocket(t *testing.T) {
 			TLSSkipVerify: true,
 		}))
 	})
+	t.Run("pinned spki", func(t *testing.T) {
+		testutil.AssertProtoJSONEqual(t, `
+			{
+				"name": "tls",
+				"typedConfig": {
+					"@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext",
+					"commonTlsContext": {
+						"alpnProtocols": ["http/1.1"],
+						"validationContext": {
+							"matchSubjectAltNames": [{
+								"exact": "example.com"
+							}],
+							"verifyCertificateSpki": ["NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="]
+						}
+					},
+					"sni": "example.com"
+				}
+			}
+		`, buildPolicyTransportSocket(&config.Policy{
+			Destination:   mustParseURL("https://example.com"),
+			TLSPinnedSPKI: []string{"NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="},
+		}))
+	})
 	t.Run("custom ca", func(t *testing.T) {
 		testutil.AssertProtoJSONEqual(t, `
 			{
@@ -303,3 +354,110 @@ func Test_buildCluster(t *testing.T) {
 		`, cluster)
 	})
 }
+
+func Test_buildPolicyCluster(t *testing.T) {
+	t.Run("h2c upstream", func(t *testing.T) {
+		policy := &config.Policy{
+			From:             "https://from.example.com",
+			To:               "http://grpc.example.com:9090",
+			AllowH2CUpstream: true,
+		}
+		require.NoError(t, policy.Validate())
+		cluster := buildPolicyCluster(policy)
+		assert.Nil(t, cluster.GetTransportSocket())
+		testutil.AssertProtoJSONEqual(t, `{"allowConnect": true}`, cluster.GetHttp2ProtocolOptions())
+	})
+	t.Run("http/1.1 upstream", func(t *testing.T) {
+		policy := &config.Policy{
+			From: "https://from.example.com",
+			To:   "http://grpc.example.com:9090",
+		}
+		require.NoError(t, policy.Validate())
+		assert.Nil(t, buildPolicyCluster(policy).GetHttp2ProtocolOptions())
+	})
+	t.Run("connection options", func(t *testing.T) {
+		policy := &config.Policy{
+			From:                             "https://from.example.com",
+			To:                               "https://to.example.com",
+			UpstreamMaxRequestsPerConnection: 100,
+			UpstreamIdleTimeout:              5 * time.Minute,
+			UpstreamTCPKeepaliveTime:         time.Minute,
+			UpstreamTCPKeepaliveInterval:     10 * time.Second,
+			UpstreamTCPKeepaliveProbes:       3,
+		}
+		require.NoError(t, policy.Validate())
+		cluster := buildPolicyCluster(policy)
+		assert.Equal(t, uint32(100), cluster.GetMaxRequestsPerConnection().GetValue())
+		testutil.AssertProtoJSONEqual(t, `{"idleTimeout": "300s"}`, cluster.GetCommonHttpProtocolOptions())
+		testutil.AssertProtoJSONEqual(t, `{
+			"tcpKeepalive": {
+				"keepaliveTime": 60,
+				"keepaliveInterval": 10,
+				"keepaliveProbes": 3
+			}
+		}`, cluster.GetUpstreamConnectionOptions())
+	})
+	t.Run("concurrency limits", func(t *testing.T) {
+		policy := &config.Policy{
+			From:                          "https://from.example.com",
+			To:                            "https://to.example.com",
+			UpstreamMaxConcurrentRequests: 100,
+			UpstreamMaxPendingRequests:    500,
+		}
+		require.NoError(t, policy.Validate())
+		testutil.AssertProtoJSONEqual(t, `{
+			"thresholds": [{
+				"maxConnections": 100,
+				"maxPendingRequests": 500,
+				"maxRequests": 100
+			}]
+		}`, buildPolicyCluster(policy).GetCircuitBreakers())
+
+		policy.PriorityGroups = []string{"oncall"}
+		require.NoError(t, policy.Validate())
+		testutil.AssertProtoJSONEqual(t, `{
+			"thresholds": [{
+				"maxConnections": 100,
+				"maxPendingRequests": 500,
+				"maxRequests": 100
+			}, {
+				"priority": "HIGH",
+				"maxConnections": 100,
+				"maxPendingRequests": 500,
+				"maxRequests": 100
+			}]
+		}`, buildPolicyCluster(policy).GetCircuitBreakers())
+	})
+	t.Run("slow start", func(t *testing.T) {
+		policy := &config.Policy{
+			From:                        "https://from.example.com",
+			To:                          "https://to.example.com",
+			UpstreamSlowStartWindow:     time.Minute,
+			UpstreamSlowStartAggression: 2,
+		}
+		require.NoError(t, policy.Validate())
+		cluster := buildPolicyCluster(policy)
+		testutil.AssertProtoJSONEqual(t, `{
+			"slowStartConfig": {
+				"slowStartWindow": "60s",
+				"aggression": {
+					"defaultValue": 2,
+					"runtimeKey": "upstream.`+cluster.GetName()+`.slow_start_aggression"
+				}
+			}
+		}`, cluster.GetRoundRobinLbConfig())
+	})
+	t.Run("default connection options", func(t *testing.T) {
+		policy := &config.Policy{
+			From: "https://from.example.com",
+			To:   "https://to.example.com",
+		}
+		require.NoError(t, policy.Validate())
+		cluster := buildPolicyCluster(policy)
+		assert.Nil(t, cluster.GetMaxRequestsPerConnection())
+		assert.Nil(t, cluster.GetCommonHttpProtocolOptions())
+		assert.Nil(t, cluster.GetUpstreamConnectionOptions())
+		assert.Nil(t, cluster.GetRoundRobinLbConfig())
+		assert.Nil(t, cluster.GetCircuitBreakers())
+	})
+}
@@ -13,6 +13,8 @@ import (
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/log"
@@ -55,7 +57,87 @@ func buildInternalCluster(options *config.Options, name string, endpoint *url.UR
 
 func buildPolicyCluster(policy *config.Policy) *envoy_config_cluster_v3.Cluster {
 	name := getPolicyName(policy)
-	return buildCluster(name, policy.Destination, buildPolicyTransportSocket(policy), false, policy.EnableGoogleCloudServerlessAuthentication)
+	cluster := buildCluster(name, policy.Destination, buildPolicyTransportSocket(policy), policy.AllowH2CUpstream, policy.EnableGoogleCloudServerlessAuthentication)
+
+	if policy.UpstreamMaxRequestsPerConnection > 0 {
+		cluster.MaxRequestsPerConnection = &wrappers.UInt32Value{Value: policy.UpstreamMaxRequestsPerConnection}
+	}
+	if policy.UpstreamIdleTimeout > 0 {
+		cluster.CommonHttpProtocolOptions = &envoy_config_core_v3.HttpProtocolOptions{
+			IdleTimeout: ptypes.DurationProto(policy.UpstreamIdleTimeout),
+		}
+	}
+	if policy.UpstreamTCPKeepaliveTime > 0 {
+		cluster.UpstreamConnectionOptions = &envoy_config_cluster_v3.UpstreamConnectionOptions{
+			TcpKeepalive: buildPolicyTCPKeepalive(policy),
+		}
+	}
+	if policy.UpstreamSlowStartWindow > 0 {
+		cluster.LbConfig = &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig_{
+			RoundRobinLbConfig: &envoy_config_cluster_v3.Cluster_RoundRobinLbConfig{
+				SlowStartConfig: buildPolicySlowStartConfig(name, policy),
+			},
+		}
+	}
+	cluster.CircuitBreakers = buildPolicyCircuitBreakers(policy)
+
+	return cluster
+}
+
+// buildPolicyCircuitBreakers returns the concurrency limits of a policy's
+// upstream. Requests of users in the priority groups are routed with a high
+// priority, which envoy sends over a separate connection pool with its own
+// limits, so they don't wait behind other requests when the route is busy.
+func buildPolicyCircuitBreakers(policy *config.Policy) *envoy_config_cluster_v3.CircuitBreakers {
+	if policy.UpstreamMaxConcurrentRequests == 0 && policy.UpstreamMaxPendingRequests == 0 {
+		return nil
+	}
+
+	threshold := &envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+		Priority: envoy_config_core_v3.RoutingPriority_DEFAULT,
+	}
+	if policy.UpstreamMaxConcurrentRequests > 0 {
+		threshold.MaxConnections = &wrappers.UInt32Value{Value: policy.UpstreamMaxConcurrentRequests}
+		threshold.MaxRequests = &wrappers.UInt32Value{Value: policy.UpstreamMaxConcurrentRequests}
+	}
+	if policy.UpstreamMaxPendingRequests > 0 {
+		threshold.MaxPendingRequests = &wrappers.UInt32Value{Value: policy.UpstreamMaxPendingRequests}
+	}
+	circuitBreakers := &envoy_config_cluster_v3.CircuitBreakers{
+		Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{threshold},
+	}
+	if len(policy.PriorityGroups) > 0 {
+		highThreshold := proto.Clone(threshold).(*envoy_config_cluster_v3.CircuitBreakers_Thresholds)
+		highThreshold.Priority = envoy_config_core_v3.RoutingPriority_HIGH
+		circuitBreakers.Thresholds = append(circuitBreakers.Thresholds, highThreshold)
+	}
+	return circuitBreakers
+}
+
+func buildPolicyTCPKeepalive(policy *config.Policy) *envoy_config_core_v3.TcpKeepalive {
+	keepalive := &envoy_config_core_v3.TcpKeepalive{
+		KeepaliveTime: &wrappers.UInt32Value{Value: uint32(policy.UpstreamTCPKeepaliveTime / time.Second)},
+	}
+	if policy.UpstreamTCPKeepaliveInterval > 0 {
+		keepalive.KeepaliveInterval = &wrappers.UInt32Value{Value: uint32(policy.UpstreamTCPKeepaliveInterval / time.Second)}
+	}
+	if policy.UpstreamTCPKeepaliveProbes > 0 {
+		keepalive.KeepaliveProbes = &wrappers.UInt32Value{Value: policy.UpstreamTCPKeepaliveProbes}
+	}
+	return keepalive
+}
+
+func buildPolicySlowStartConfig(name string, policy *config.Policy) *envoy_config_cluster_v3.Cluster_SlowStartConfig {
+	slowStart := &envoy_config_cluster_v3.Cluster_SlowStartConfig{
+		SlowStartWindow: ptypes.DurationProto(policy.UpstreamSlowStartWindow),
+	}
+	if policy.UpstreamSlowStartAggression > 0 {
+		slowStart.Aggression = &envoy_config_core_v3.RuntimeDouble{
+			DefaultValue: policy.UpstreamSlowStartAggression,
+			RuntimeKey:   "upstream." + name + ".slow_start_aggression",
+		}
+	}
+	return slowStart
 }
 
 func buildInternalTransportSocket(options *config.Options, endpoint *url.URL) *envoy_config_core_v3.TransportSocket {
@@ -140,14 +222,16 @@ func buildPolicyTransportSocket(policy *config.Policy) *envoy_config_core_v3.Tra
 }
 
 func buildPolicyValidationContext(policy *config.Policy) *envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext {
-	sni := policy.Destination.Hostname()
-	if policy.TLSServerName != "" {
-		sni = policy.TLSServerName
+	verifyHostname := policy.Destination.Hostname()
+	if policy.TLSVerifyHostname != "" {
+		verifyHostname = policy.TLSVerifyHostname
+	} else if policy.TLSServerName != "" {
+		verifyHostname = policy.TLSServerName
 	}
 	validationContext := &envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext{
 		MatchSubjectAltNames: []*envoy_type_matcher_v3.StringMatcher{{
 			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{
-				Exact: sni,
+				Exact: verifyHostname,
 			},
 		}},
 	}
@@ -159,7 +243,9 @@ func buildPolicyValidationContext(policy *config.Policy) *envoy_extensions_trans
 			log.Error().Err(err).Msg("invalid custom CA certificate")
 		}
 		validationContext.TrustedCa = inlineBytesAsFilename("custom-ca.pem", bs)
-	} else {
+	} else if len(policy.TLSPinnedSPKI) == 0 {
+		// pinned keys are trusted on their own, so the system root CAs are
+		// only used when there are none
 		rootCA, err := getRootCertificateAuthority()
 		if err != nil {
 			log.Error().Err(err).Msg("unable to enable certificate verification because no root CAs were found")
@@ -168,6 +254,10 @@ func buildPolicyValidationContext(policy *config.Policy) *envoy_extensions_trans
 		}
 	}
 
+	if len(policy.TLSPinnedSPKI) > 0 {
+		validationContext.VerifyCertificateSpki = policy.TLSPinnedSPKI
+	}
+
 	if policy.TLSSkipVerify {
 		validationContext.TrustChainVerification = envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext_ACCEPT_UNTRUSTED
 	}
@@ -161,6 +161,7 @@ func buildMainHTTPConnectionManagerFilter(options *config.Options, domains []str
 	}
 
 	extAuthZ, _ := ptypes.MarshalAny(&envoy_extensions_filters_http_ext_authz_v3.ExtAuthz{
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
 		StatusOnError: &envoy_type_v3.HttpStatus{
 			Code: envoy_type_v3.StatusCode_InternalServerError,
 		},
@@ -175,6 +176,8 @@ func buildMainHTTPConnectionManagerFilter(options *config.Options, domains []str
 			},
 		},
 		IncludePeerCertificate: true,
+		WithRequestBody:        buildExtAuthzBufferSettings(options),
+		ClearRouteCache:        hasPriorityGroups(options),
 	})
 
 	extAuthzSetCookieLua, _ := ptypes.MarshalAny(&envoy_extensions_filters_http_lua_v3.Lua{
@@ -240,6 +243,38 @@ func buildMainHTTPConnectionManagerFilter(options *config.Options, domains []str
 	}
 }
 
+// graphQLMaxRequestBytes is the size of the start of request bodies sent to
+// the authorize service, when routes are GraphQL APIs.
+const graphQLMaxRequestBytes = 64 * 1024
+
+// buildExtAuthzBufferSettings returns the settings for sending request bodies
+// to the authorize service, which only reads them for GraphQL routes. Envoy
+// can't buffer bodies per route, so if any route is a GraphQL API, the start
+// of every request body is sent.
+func buildExtAuthzBufferSettings(options *config.Options) *envoy_extensions_filters_http_ext_authz_v3.BufferSettings {
+	for _, policy := range options.Policies {
+		if policy.GraphQL {
+			return &envoy_extensions_filters_http_ext_authz_v3.BufferSettings{
+				MaxRequestBytes:     graphQLMaxRequestBytes,
+				AllowPartialMessage: true,
+			}
+		}
+	}
+	return nil
+}
+
+// hasPriorityGroups returns true if any route has priority groups. The route
+// of a request is then selected again after the authorize service sets the
+// x-pomerium-priority header, so the route cache of every request is cleared.
+func hasPriorityGroups(options *config.Options) bool {
+	for _, policy := range options.Policies {
+		if len(policy.PriorityGroups) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func buildGRPCListener(options *config.Options) *envoy_config_listener_v3.Listener {
 	filter := buildGRPCHTTPConnectionManagerFilter()
 
@@ -36,8 +36,10 @@ func Test_buildMainHTTPConnectionManagerFilter(t *testing.T) {
 								"clusterName": "pomerium-control-plane-grpc"
 							}
 						},
-						"logName": "ingress-http"
-					}
+						"logName": "ingress-http",
+						"transportApiVersion": "V3"
+					},
+					"additionalRequestHeadersToLog": ["x-pomerium-jwt-assertion"]
 				}
 			}],
 			"commonHttpProtocolOptions": {
@@ -57,7 +59,8 @@ func Test_buildMainHTTPConnectionManagerFilter(t *testing.T) {
 						"includePeerCertificate": true,
 						"statusOnError": {
 							"code": "InternalServerError"
-						}
+						},
+						"transportApiVersion": "V3"
 					}
 				},
 				{
@@ -146,6 +149,15 @@ func Test_buildMainHTTPConnectionManagerFilter(t *testing.T) {
 									}
 								}
 							},
+							{
+								"name": "pomerium-path-/.pomerium/admin/analytics",
+								"match": {
+									"path": "/.pomerium/admin/analytics"
+								},
+								"route": {
+									"cluster": "pomerium-control-plane-http"
+								}
+							},
 							{
 								"name": "pomerium-prefix-/.pomerium/",
 								"match": {
@@ -257,6 +269,15 @@ func Test_buildMainHTTPConnectionManagerFilter(t *testing.T) {
 									}
 								}
 							},
+							{
+								"name": "pomerium-path-/.pomerium/admin/analytics",
+								"match": {
+									"path": "/.pomerium/admin/analytics"
+								},
+								"route": {
+									"cluster": "pomerium-control-plane-http"
+								}
+							},
 							{
 								"name": "pomerium-prefix-/.pomerium/",
 								"match": {
@@ -318,6 +339,32 @@ func Test_buildMainHTTPConnectionManagerFilter(t *testing.T) {
 	}`, filter)
 }
 
+func Test_buildExtAuthzBufferSettings(t *testing.T) {
+	options := config.NewDefaultOptions()
+	options.Policies = []config.Policy{{From: "https://api.example.com", To: "https://api.internal"}}
+	assert.Nil(t, buildExtAuthzBufferSettings(options))
+
+	options.Policies = append(options.Policies, config.Policy{From: "https://graphql.example.com", To: "https://graphql.internal", GraphQL: true})
+	testutil.AssertProtoJSONEqual(t, `{
+		"maxRequestBytes": 65536,
+		"allowPartialMessage": true
+	}`, buildExtAuthzBufferSettings(options))
+}
+
+func Test_hasPriorityGroups(t *testing.T) {
+	options := config.NewDefaultOptions()
+	options.Policies = []config.Policy{{From: "https://api.example.com", To: "https://api.internal"}}
+	assert.False(t, hasPriorityGroups(options))
+
+	options.Policies = append(options.Policies, config.Policy{
+		From:                          "https://incident.example.com",
+		To:                            "https://incident.internal",
+		UpstreamMaxConcurrentRequests: 100,
+		PriorityGroups:                []string{"oncall"},
+	})
+	assert.True(t, hasPriorityGroups(options))
+}
+
 func Test_buildDownstreamTLSContext(t *testing.T) {
 	certA, err := cryptutil.CertificateFromBase64(aExampleComCert, aExampleComKey)
 	if !assert.NoError(t, err) {
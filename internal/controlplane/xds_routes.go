@@ -2,13 +2,17 @@ package controlplane
 
 import (
 	"fmt"
+	"math"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_retry_host_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -45,6 +49,7 @@ func buildPomeriumHTTPRoutes(options *config.Options, domain string) []*envoy_co
 		buildControlPlanePathRoute("/ping"),
 		buildControlPlanePathRoute("/healthz"),
 		buildControlPlanePathRoute("/.pomerium"),
+		buildControlPlaneAuthorizedPathRoute(routeAnalyticsPath),
 		buildControlPlanePrefixRoute("/.pomerium/"),
 		buildControlPlanePathRoute("/.well-known/pomerium"),
 		buildControlPlanePrefixRoute("/.well-known/pomerium/"),
@@ -79,6 +84,25 @@ func buildControlPlanePathRoute(path string) *envoy_config_route_v3.Route {
 	}
 }
 
+// buildControlPlaneAuthorizedPathRoute builds a control plane route which,
+// unlike the other control plane routes, is authorized by the authorize
+// service.
+func buildControlPlaneAuthorizedPathRoute(path string) *envoy_config_route_v3.Route {
+	return &envoy_config_route_v3.Route{
+		Name: "pomerium-path-" + path,
+		Match: &envoy_config_route_v3.RouteMatch{
+			PathSpecifier: &envoy_config_route_v3.RouteMatch_Path{Path: path},
+		},
+		Action: &envoy_config_route_v3.Route_Route{
+			Route: &envoy_config_route_v3.RouteAction{
+				ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{
+					Cluster: "pomerium-control-plane-http",
+				},
+			},
+		},
+	}
+}
+
 func buildControlPlanePrefixRoute(prefix string) *envoy_config_route_v3.Route {
 	return &envoy_config_route_v3.Route{
 		Name: "pomerium-prefix-" + prefix,
@@ -106,7 +130,7 @@ func buildPolicyRoutes(options *config.Options, domain string) []*envoy_config_r
 	var routes []*envoy_config_route_v3.Route
 	responseHeadersToAdd := toEnvoyHeaders(options.Headers)
 
-	for i, policy := range options.Policies {
+	for _, policy := range options.Policies {
 		if !hostMatchesDomain(policy.Source.URL, domain) {
 			continue
 		}
@@ -118,8 +142,8 @@ func buildPolicyRoutes(options *config.Options, domain string) []*envoy_config_r
 		routeTimeout := getRouteTimeout(options, &policy)
 		prefixRewrite := getPrefixRewrite(&policy)
 
-		routes = append(routes, &envoy_config_route_v3.Route{
-			Name:  fmt.Sprintf("policy-%d", i),
+		route := &envoy_config_route_v3.Route{
+			Name:  clusterName,
 			Match: match,
 			Metadata: &envoy_config_core_v3.Metadata{
 				FilterMetadata: map[string]*structpb.Struct{
@@ -158,17 +182,84 @@ func buildPolicyRoutes(options *config.Options, domain string) []*envoy_config_r
 						AutoHostRewrite: &wrappers.BoolValue{Value: !policy.PreserveHostHeader},
 					},
 					Timeout:       routeTimeout,
+					IdleTimeout:   getRouteIdleTimeout(&policy),
 					PrefixRewrite: prefixRewrite,
+					RetryPolicy:   getHedgeRetryPolicy(&policy),
+					HedgePolicy:   getHedgePolicy(&policy),
 				},
 			},
 			RequestHeadersToAdd:    requestHeadersToAdd,
 			RequestHeadersToRemove: requestHeadersToRemove,
 			ResponseHeadersToAdd:   responseHeadersToAdd,
-		})
+			Tracing:                getRouteTracing(options, &policy),
+		}
+		if len(options.TracingDebugGroups) > 0 {
+			routes = append(routes, buildForceTraceRoute(route))
+		}
+		if len(policy.PriorityGroups) > 0 {
+			routes = append(routes, buildPriorityRoute(route))
+		}
+		routes = append(routes, route)
 	}
 	return routes
 }
 
+// getRouteTracing returns the tracing settings of a policy's route. Clients
+// can't force requests to be traced with the x-client-trace-id header when
+// debug groups are configured, since that's done with a debug route instead.
+func getRouteTracing(options *config.Options, policy *config.Policy) *envoy_config_route_v3.Tracing {
+	if policy.TracingSampleRate == nil && len(options.TracingDebugGroups) == 0 {
+		return nil
+	}
+	sampleRate := options.TracingSampleRate
+	if policy.TracingSampleRate != nil {
+		sampleRate = *policy.TracingSampleRate
+	}
+	tracing := &envoy_config_route_v3.Tracing{
+		RandomSampling: toFractionalPercent(sampleRate),
+	}
+	if len(options.TracingDebugGroups) > 0 {
+		tracing.ClientSampling = toFractionalPercent(0)
+	}
+	return tracing
+}
+
+// buildForceTraceRoute returns a copy of a policy's route which matches
+// requests with the x-pomerium-trace header and traces all of them. The
+// authorize service only allows these requests for users in the debug groups.
+func buildForceTraceRoute(route *envoy_config_route_v3.Route) *envoy_config_route_v3.Route {
+	traceRoute := proto.Clone(route).(*envoy_config_route_v3.Route)
+	traceRoute.Name += "-trace"
+	traceRoute.Match.Headers = append(traceRoute.Match.Headers, &envoy_config_route_v3.HeaderMatcher{
+		Name:                 httputil.HeaderPomeriumTrace,
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+	})
+	traceRoute.Tracing.RandomSampling = toFractionalPercent(1)
+	return traceRoute
+}
+
+// buildPriorityRoute returns a copy of a policy's route which sends requests
+// to the upstream with a high priority. The authorize service sets the
+// x-pomerium-priority header to "high" for users in the priority groups, and
+// overwrites it otherwise, after which envoy selects the route again.
+func buildPriorityRoute(route *envoy_config_route_v3.Route) *envoy_config_route_v3.Route {
+	priorityRoute := proto.Clone(route).(*envoy_config_route_v3.Route)
+	priorityRoute.Name += "-priority"
+	priorityRoute.Match.Headers = append(priorityRoute.Match.Headers, &envoy_config_route_v3.HeaderMatcher{
+		Name:                 httputil.HeaderPomeriumPriority,
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "high"},
+	})
+	priorityRoute.GetRoute().Priority = envoy_config_core_v3.RoutingPriority_HIGH
+	return priorityRoute
+}
+
+func toFractionalPercent(fraction float64) *envoy_type_v3.FractionalPercent {
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   uint32(math.Round(fraction * 1000000)),
+		Denominator: envoy_type_v3.FractionalPercent_MILLION,
+	}
+}
+
 func mkEnvoyHeader(k, v string) *envoy_config_core_v3.HeaderValueOption {
 	return &envoy_config_core_v3.HeaderValueOption{
 		Header: &envoy_config_core_v3.HeaderValue{
@@ -217,14 +308,18 @@ func getRequestHeadersToRemove(options *config.Options, policy *config.Policy) [
 			requestHeadersToRemove = append(requestHeadersToRemove, httputil.PomeriumJWTHeaderName(claim))
 		}
 	}
+	if len(policy.PriorityGroups) > 0 {
+		requestHeadersToRemove = append(requestHeadersToRemove, httputil.HeaderPomeriumPriority)
+	}
 	return requestHeadersToRemove
 }
 
 func getRouteTimeout(options *config.Options, policy *config.Policy) *durationpb.Duration {
 	var routeTimeout *durationpb.Duration
 	if policy.AllowWebsockets {
-		// disable the route timeout for websocket support
-		routeTimeout = ptypes.DurationProto(0)
+		// disable the route timeout for websocket support, unless the
+		// duration of streams is limited
+		routeTimeout = ptypes.DurationProto(policy.MaxStreamDuration)
 	} else {
 		if policy.UpstreamTimeout != 0 {
 			routeTimeout = ptypes.DurationProto(policy.UpstreamTimeout)
@@ -235,6 +330,67 @@ func getRouteTimeout(options *config.Options, policy *config.Policy) *durationpb
 	return routeTimeout
 }
 
+// getRouteIdleTimeout returns the stream idle timeout of a policy's route,
+// which overrides the stream idle timeout of the connection manager.
+func getRouteIdleTimeout(policy *config.Policy) *durationpb.Duration {
+	if policy.StreamIdleTimeout <= 0 {
+		return nil
+	}
+	return ptypes.DurationProto(policy.StreamIdleTimeout)
+}
+
+// getHedgeRetryPolicy returns the retry policy used to send a hedged request
+// when the upstream hasn't responded within the policy's hedge delay. Only
+// idempotent requests which aren't protocol upgrades are hedged.
+func getHedgeRetryPolicy(policy *config.Policy) *envoy_config_route_v3.RetryPolicy {
+	if policy.HedgeDelay <= 0 {
+		return nil
+	}
+	previousHosts, _ := ptypes.MarshalAny(&envoy_extensions_retry_host_previous_hosts_v3.PreviousHostsPredicate{})
+	return &envoy_config_route_v3.RetryPolicy{
+		RetryOn:       "gateway-error,connect-failure,refused-stream,reset",
+		NumRetries:    &wrappers.UInt32Value{Value: 1},
+		PerTryTimeout: ptypes.DurationProto(policy.HedgeDelay),
+		// send the hedged request to another host
+		RetryHostPredicate: []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+			Name: "envoy.retry_host_predicates.previous_hosts",
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: previousHosts,
+			},
+		}},
+		HostSelectionRetryMaxAttempts: 3,
+		RetriableRequestHeaders: []*envoy_config_route_v3.HeaderMatcher{
+			{
+				Name: ":method",
+				HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+					SafeRegexMatch: &envoy_type_matcher_v3.RegexMatcher{
+						EngineType: &envoy_type_matcher_v3.RegexMatcher_GoogleRe2{
+							GoogleRe2: &envoy_type_matcher_v3.RegexMatcher_GoogleRE2{},
+						},
+						Regex: "GET|HEAD",
+					},
+				},
+			},
+			{
+				Name:                 "upgrade",
+				HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+				InvertMatch:          true,
+			},
+		},
+	}
+}
+
+// getHedgePolicy returns the hedge policy which keeps the original request
+// running when the hedged request is sent, so whichever responds first wins.
+func getHedgePolicy(policy *config.Policy) *envoy_config_route_v3.HedgePolicy {
+	if policy.HedgeDelay <= 0 {
+		return nil
+	}
+	return &envoy_config_route_v3.HedgePolicy{
+		HedgeOnPerTryTimeout: true,
+	}
+}
+
 func getPrefixRewrite(policy *config.Policy) string {
 	prefixRewrite := ""
 	if policy.Destination != nil && policy.Destination.Path != "" {
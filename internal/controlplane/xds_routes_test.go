@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/testutil"
 )
@@ -112,6 +114,15 @@ func Test_buildPomeriumHTTPRoutes(t *testing.T) {
 					}
 				}
 			},
+			{
+				"name": "pomerium-path-/.pomerium/admin/analytics",
+				"match": {
+					"path": "/.pomerium/admin/analytics"
+				},
+				"route": {
+					"cluster": "pomerium-control-plane-http"
+				}
+			},
 			{
 				"name": "pomerium-prefix-/.pomerium/",
 				"match": {
@@ -479,7 +490,7 @@ func TestAddOptionsHeadersToResponse(t *testing.T) {
 	testutil.AssertProtoJSONEqual(t, `
 		[
 			{
-				"name": "policy-0",
+				"name": "policy-1",
 				"match": {
 					"prefix": "/"
 				},
@@ -532,7 +543,7 @@ func Test_buildPolicyRoutesWithDestinationPath(t *testing.T) {
 	testutil.AssertProtoJSONEqual(t, `
 		[
 			{
-				"name": "policy-0",
+				"name": "policy-1",
 				"match": {
 					"prefix": "/"
 				},
@@ -566,3 +577,188 @@ func mustParseURL(str string) *url.URL {
 	}
 	return u
 }
+
+func Test_buildPolicyRoutesWithHedgeDelay(t *testing.T) {
+	routes := buildPolicyRoutes(&config.Options{
+		CookieName:             "pomerium",
+		DefaultUpstreamTimeout: time.Second * 3,
+		Policies: []config.Policy{
+			{
+				Source:     &config.StringURL{URL: mustParseURL("https://example.com")},
+				HedgeDelay: 250 * time.Millisecond,
+			},
+			{
+				Source: &config.StringURL{URL: mustParseURL("https://example.com")},
+				Prefix: "/not-hedged",
+			},
+		},
+	}, "example.com")
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"retryOn": "gateway-error,connect-failure,refused-stream,reset",
+		"numRetries": 1,
+		"perTryTimeout": "0.250s",
+		"retryHostPredicate": [{
+			"name": "envoy.retry_host_predicates.previous_hosts",
+			"typedConfig": {
+				"@type": "type.googleapis.com/envoy.extensions.retry.host.previous_hosts.v3.PreviousHostsPredicate"
+			}
+		}],
+		"hostSelectionRetryMaxAttempts": "3",
+		"retriableRequestHeaders": [
+			{
+				"name": ":method",
+				"safeRegexMatch": {
+					"googleRe2": {},
+					"regex": "GET|HEAD"
+				}
+			},
+			{
+				"name": "upgrade",
+				"presentMatch": true,
+				"invertMatch": true
+			}
+		]
+	}`, routes[0].GetRoute().GetRetryPolicy())
+	testutil.AssertProtoJSONEqual(t, `{"hedgeOnPerTryTimeout": true}`, routes[0].GetRoute().GetHedgePolicy())
+
+	if routes[1].GetRoute().GetRetryPolicy() != nil || routes[1].GetRoute().GetHedgePolicy() != nil {
+		t.Error("expected routes without a hedge delay not to be hedged")
+	}
+}
+
+func Test_buildPolicyRoutesWithStreamLimits(t *testing.T) {
+	routes := buildPolicyRoutes(&config.Options{
+		CookieName:             "pomerium",
+		DefaultUpstreamTimeout: time.Second * 3,
+		Policies: []config.Policy{
+			{
+				Source:            &config.StringURL{URL: mustParseURL("https://example.com")},
+				AllowWebsockets:   true,
+				MaxStreamDuration: time.Hour,
+				StreamIdleTimeout: time.Minute,
+			},
+			{
+				Source:          &config.StringURL{URL: mustParseURL("https://example.com")},
+				Prefix:          "/unlimited",
+				AllowWebsockets: true,
+			},
+		},
+	}, "example.com")
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+
+	testutil.AssertProtoJSONEqual(t, `"3600s"`, routes[0].GetRoute().GetTimeout())
+	testutil.AssertProtoJSONEqual(t, `"60s"`, routes[0].GetRoute().GetIdleTimeout())
+	testutil.AssertProtoJSONEqual(t, `"0s"`, routes[1].GetRoute().GetTimeout())
+	if routes[1].GetRoute().GetIdleTimeout() != nil {
+		t.Error("expected routes without a stream idle timeout to use the default")
+	}
+}
+
+func Test_buildPolicyRoutesWithTracing(t *testing.T) {
+	sampleRate := 0.5
+	options := &config.Options{
+		CookieName:             "pomerium",
+		DefaultUpstreamTimeout: time.Second * 3,
+		TracingSampleRate:      0.0001,
+		Policies: []config.Policy{
+			{
+				Source:            &config.StringURL{URL: mustParseURL("https://example.com")},
+				Prefix:            "/sampled",
+				TracingSampleRate: &sampleRate,
+			},
+			{
+				Source: &config.StringURL{URL: mustParseURL("https://example.com")},
+			},
+		},
+	}
+	routes := buildPolicyRoutes(options, "example.com")
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+	testutil.AssertProtoJSONEqual(t, `{
+		"randomSampling": {"numerator": 500000, "denominator": "MILLION"}
+	}`, routes[0].GetTracing())
+	if routes[1].GetTracing() != nil {
+		t.Error("expected routes without a sample rate to use the listener's")
+	}
+
+	options.TracingDebugGroups = []string{"admins"}
+	routes = buildPolicyRoutes(options, "example.com")
+	if len(routes) != 4 {
+		t.Fatalf("expected 4 routes, got %d", len(routes))
+	}
+	testutil.AssertProtoJSONEqual(t, `{
+		"name": "`+getPolicyName(&options.Policies[0])+`-trace",
+		"match": {
+			"prefix": "/sampled",
+			"headers": [{"name": "x-pomerium-trace", "presentMatch": true}]
+		},
+		"tracing": {
+			"clientSampling": {"denominator": "MILLION"},
+			"randomSampling": {"numerator": 1000000, "denominator": "MILLION"}
+		}
+	}`, &envoy_config_route_v3.Route{
+		Name:    routes[0].GetName(),
+		Match:   routes[0].GetMatch(),
+		Tracing: routes[0].GetTracing(),
+	})
+	testutil.AssertProtoJSONEqual(t, `{
+		"clientSampling": {"denominator": "MILLION"},
+		"randomSampling": {"numerator": 100, "denominator": "MILLION"}
+	}`, routes[3].GetTracing())
+	if routes[2].GetRoute().GetCluster() != routes[3].GetRoute().GetCluster() {
+		t.Error("expected the trace route to use the same cluster")
+	}
+}
+
+func Test_buildPolicyRoutesWithPriorityGroups(t *testing.T) {
+	options := &config.Options{
+		CookieName:             "pomerium",
+		DefaultUpstreamTimeout: time.Second * 3,
+		Policies: []config.Policy{
+			{
+				Source:                        &config.StringURL{URL: mustParseURL("https://example.com")},
+				Prefix:                        "/incident",
+				UpstreamMaxConcurrentRequests: 100,
+				PriorityGroups:                []string{"oncall"},
+			},
+			{
+				Source: &config.StringURL{URL: mustParseURL("https://example.com")},
+			},
+		},
+	}
+	routes := buildPolicyRoutes(options, "example.com")
+	if len(routes) != 3 {
+		t.Fatalf("expected 3 routes, got %d", len(routes))
+	}
+	testutil.AssertProtoJSONEqual(t, `{
+		"name": "`+getPolicyName(&options.Policies[0])+`-priority",
+		"match": {
+			"prefix": "/incident",
+			"headers": [{"name": "x-pomerium-priority", "exactMatch": "high"}]
+		},
+		"requestHeadersToRemove": ["x-pomerium-jwt-assertion", "x-pomerium-priority"]
+	}`, &envoy_config_route_v3.Route{
+		Name:                   routes[0].GetName(),
+		Match:                  routes[0].GetMatch(),
+		RequestHeadersToRemove: routes[0].GetRequestHeadersToRemove(),
+	})
+	if routes[0].GetRoute().GetPriority().String() != "HIGH" || routes[1].GetRoute().GetPriority().String() != "DEFAULT" {
+		t.Error("expected only the priority route to have a high priority")
+	}
+	if routes[0].GetRoute().GetCluster() != routes[1].GetRoute().GetCluster() {
+		t.Error("expected the priority route to use the same cluster")
+	}
+	testutil.AssertProtoJSONEqual(t, `{
+		"requestHeadersToRemove": ["x-pomerium-jwt-assertion", "x-pomerium-priority"]
+	}`, &envoy_config_route_v3.Route{RequestHeadersToRemove: routes[1].GetRequestHeadersToRemove()})
+	testutil.AssertProtoJSONEqual(t, `{
+		"requestHeadersToRemove": ["x-pomerium-jwt-assertion"]
+	}`, &envoy_config_route_v3.Route{RequestHeadersToRemove: routes[2].GetRequestHeadersToRemove()})
+}
@@ -0,0 +1,290 @@
+package databroker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// anyRecordType matches every record type in a client ACL.
+const anyRecordType = "*"
+
+// A ClientACL is the access a databroker client has: the namespaces it can
+// use, and the record types it can read and write in them.
+type ClientACL struct {
+	// Secret is the secret the client signs its tokens with.
+	Secret []byte
+	// Namespaces are the namespaces the client can use. If empty, the client
+	// can only use the default namespace.
+	Namespaces []string
+	// ReadTypes and WriteTypes are the record types the client can read and
+	// write. "*" matches every record type, and the
+	// "type.googleapis.com/" prefix of a record type can be left out.
+	ReadTypes  []string
+	WriteTypes []string
+}
+
+func (acl *ClientACL) allowsNamespace(namespace, defaultNamespace string) bool {
+	if len(acl.Namespaces) == 0 {
+		return namespace == defaultNamespace
+	}
+	for _, n := range acl.Namespaces {
+		if n == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsType returns true if the client can read, or write, records of the
+// type. Types which name a namespace are never allowed, not even by "*", so
+// clients can't reach the records of namespaces they can't use.
+func (acl *ClientACL) allowsType(write bool, recordType string) bool {
+	if strings.Contains(recordType, namespaceSeparator) {
+		return false
+	}
+	recordTypes := acl.ReadTypes
+	if write {
+		recordTypes = acl.WriteTypes
+	}
+	for _, t := range recordTypes {
+		if t == anyRecordType || t == recordType || "type.googleapis.com/"+t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// An ACLServer only allows the databroker clients it knows to make requests,
+// and only to the namespaces and record types their ACL allows. Clients
+// authenticate with a token from grpcutil.NewClientToken. Requests with an
+// admin token are always allowed.
+type ACLServer struct {
+	underlying       databroker.DataBrokerServiceServer
+	clients          map[string]*ClientACL
+	defaultNamespace string
+	secret           []byte
+	clockSkew        time.Duration
+}
+
+// NewACLServer creates a new ACLServer for the given clients, by id. Requests
+// without a namespace use defaultNamespace. Admin tokens are checked with the
+// shared secret.
+func NewACLServer(underlying databroker.DataBrokerServiceServer, clients map[string]*ClientACL,
+	defaultNamespace string, secret []byte, clockSkew time.Duration,
+) *ACLServer {
+	return &ACLServer{
+		underlying:       underlying,
+		clients:          clients,
+		defaultNamespace: defaultNamespace,
+		secret:           secret,
+		clockSkew:        clockSkew,
+	}
+}
+
+// authenticate returns the id and ACL of the client which made a request, or
+// a nil ACL for admin requests.
+func (srv *ACLServer) authenticate(ctx context.Context) (string, *ClientACL, error) {
+	if _, ok := grpcutil.JWTFromGRPCRequest(ctx); ok && AuthorizeAdmin(ctx, srv.secret, srv.clockSkew) == nil {
+		return "", nil, nil
+	}
+	rawToken, ok := grpcutil.ClientTokenFromGRPCRequest(ctx)
+	if !ok {
+		return "", nil, status.Error(codes.Unauthenticated, "missing databroker client token")
+	}
+	clientID, err := grpcutil.ValidateClientToken(rawToken, func(clientID string) ([]byte, bool) {
+		acl, ok := srv.clients[clientID]
+		if !ok {
+			return nil, false
+		}
+		return acl.Secret, true
+	}, time.Now(), srv.clockSkew)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Unauthenticated, "invalid databroker client token: %v", err)
+	}
+	acl := srv.clients[clientID]
+
+	namespace, ok := grpcutil.NamespaceFromGRPCRequest(ctx)
+	if !ok {
+		namespace = srv.defaultNamespace
+	}
+	if !acl.allowsNamespace(namespace, srv.defaultNamespace) {
+		return "", nil, status.Errorf(codes.PermissionDenied, "databroker client %q can't use namespace %q", clientID, namespace)
+	}
+	return clientID, acl, nil
+}
+
+// authorize checks that the client which made a request can read, or write,
+// records of the given type.
+func (srv *ACLServer) authorize(ctx context.Context, write bool, recordType string) error {
+	clientID, acl, err := srv.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	if acl != nil && !acl.allowsType(write, recordType) {
+		access := "read"
+		if write {
+			access = "write"
+		}
+		return status.Errorf(codes.PermissionDenied, "databroker client %q can't %s %s records", clientID, access, recordType)
+	}
+	return nil
+}
+
+// Delete deletes a record.
+func (srv *ACLServer) Delete(ctx context.Context, req *databroker.DeleteRequest) (*emptypb.Empty, error) {
+	if err := srv.authorize(ctx, true, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.Delete(ctx, req)
+}
+
+// Get gets a record.
+func (srv *ACLServer) Get(ctx context.Context, req *databroker.GetRequest) (*databroker.GetResponse, error) {
+	if err := srv.authorize(ctx, false, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.Get(ctx, req)
+}
+
+// GetAll gets all the records of a type.
+func (srv *ACLServer) GetAll(ctx context.Context, req *databroker.GetAllRequest) (*databroker.GetAllResponse, error) {
+	if err := srv.authorize(ctx, false, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.GetAll(ctx, req)
+}
+
+// GetAllByIndex gets all the records of a type with an indexed value.
+func (srv *ACLServer) GetAllByIndex(ctx context.Context, req *databroker.GetAllByIndexRequest) (*databroker.GetAllByIndexResponse, error) {
+	if err := srv.authorize(ctx, false, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.GetAllByIndex(ctx, req)
+}
+
+// Set sets a record.
+func (srv *ACLServer) Set(ctx context.Context, req *databroker.SetRequest) (*databroker.SetResponse, error) {
+	if err := srv.authorize(ctx, true, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.Set(ctx, req)
+}
+
+// BatchSet sets records of a type.
+func (srv *ACLServer) BatchSet(ctx context.Context, req *databroker.BatchSetRequest) (*databroker.BatchSetResponse, error) {
+	if err := srv.authorize(ctx, true, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.BatchSet(ctx, req)
+}
+
+// BatchGet gets records of a type.
+func (srv *ACLServer) BatchGet(ctx context.Context, req *databroker.BatchGetRequest) (*databroker.BatchGetResponse, error) {
+	if err := srv.authorize(ctx, false, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.BatchGet(ctx, req)
+}
+
+// BatchDelete deletes records of a type.
+func (srv *ACLServer) BatchDelete(ctx context.Context, req *databroker.BatchDeleteRequest) (*emptypb.Empty, error) {
+	if err := srv.authorize(ctx, true, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.BatchDelete(ctx, req)
+}
+
+// Query queries the records of a type.
+func (srv *ACLServer) Query(ctx context.Context, req *databroker.QueryRequest) (*databroker.QueryResponse, error) {
+	if err := srv.authorize(ctx, false, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.Query(ctx, req)
+}
+
+// Sync streams the changes to the records of a type.
+func (srv *ACLServer) Sync(req *databroker.SyncRequest, stream databroker.DataBrokerService_SyncServer) error {
+	if err := srv.authorize(stream.Context(), false, req.GetType()); err != nil {
+		return err
+	}
+	return srv.underlying.Sync(req, stream)
+}
+
+// GetTypes returns the record types the client can read.
+func (srv *ACLServer) GetTypes(ctx context.Context, req *emptypb.Empty) (*databroker.GetTypesResponse, error) {
+	_, acl, err := srv.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.GetTypes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.GetTypesResponse{Types: readableTypes(acl, res.GetTypes())}, nil
+}
+
+// SyncTypes streams the record types the client can read.
+func (srv *ACLServer) SyncTypes(req *emptypb.Empty, stream databroker.DataBrokerService_SyncTypesServer) error {
+	_, acl, err := srv.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return srv.underlying.SyncTypes(req, aclSyncTypesServer{
+		DataBrokerService_SyncTypesServer: stream,
+		acl:                               acl,
+	})
+}
+
+// Export exports records. It's only allowed with an admin token, which the
+// underlying server checks.
+func (srv *ACLServer) Export(ctx context.Context, req *databroker.ExportRequest) (*databroker.ExportResponse, error) {
+	return srv.underlying.Export(ctx, req)
+}
+
+// Import imports records. It's only allowed with an admin token, which the
+// underlying server checks.
+func (srv *ACLServer) Import(ctx context.Context, req *databroker.ImportRequest) (*databroker.ImportResponse, error) {
+	return srv.underlying.Import(ctx, req)
+}
+
+// GetHistory returns the last changes to a record.
+func (srv *ACLServer) GetHistory(ctx context.Context, req *databroker.GetHistoryRequest) (*databroker.GetHistoryResponse, error) {
+	if err := srv.authorize(ctx, false, req.GetType()); err != nil {
+		return nil, err
+	}
+	return srv.underlying.GetHistory(ctx, req)
+}
+
+type aclSyncTypesServer struct {
+	databroker.DataBrokerService_SyncTypesServer
+	acl *ClientACL
+}
+
+func (stream aclSyncTypesServer) Send(res *databroker.GetTypesResponse) error {
+	return stream.DataBrokerService_SyncTypesServer.Send(&databroker.GetTypesResponse{
+		Types: readableTypes(stream.acl, res.GetTypes()),
+	})
+}
+
+// readableTypes returns the record types an ACL allows reading. A nil ACL,
+// for admin requests, allows every record type.
+func readableTypes(acl *ClientACL, recordTypes []string) []string {
+	if acl == nil {
+		return recordTypes
+	}
+	var types []string
+	for _, recordType := range recordTypes {
+		if acl.allowsType(false, recordType) {
+			types = append(types, recordType)
+		}
+	}
+	return types
+}
@@ -0,0 +1,96 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestACLServer(t *testing.T) {
+	ctx := context.Background()
+
+	secret := cryptutil.NewKey()
+	proxySecret := cryptutil.NewKey()
+	tenantSecret := cryptutil.NewKey()
+	controllerSecret := cryptutil.NewKey()
+	srv := NewACLServer(NewNamespacedServer(newServer(newServerConfig(WithSecret(secret))), ""), map[string]*ClientACL{
+		"proxy":      {Secret: proxySecret, ReadTypes: []string{"session.Session"}},
+		"controller": {Secret: controllerSecret, ReadTypes: []string{"*"}, WriteTypes: []string{"*"}},
+		"tenant-a":   {Secret: tenantSecret, Namespaces: []string{"tenant-a"}, ReadTypes: []string{"*"}, WriteTypes: []string{"*"}},
+	}, "", secret, time.Second)
+
+	withClient := func(clientID string, secret []byte, namespace string) context.Context {
+		rawToken, err := grpcutil.NewClientToken(clientID, secret, time.Minute)
+		require.NoError(t, err)
+		md := metadata.Pairs(grpcutil.ClientTokenMetadataKey, rawToken)
+		if namespace != "" {
+			md.Append(grpcutil.NamespaceMetadataKey, namespace)
+		}
+		return metadata.NewIncomingContext(ctx, md)
+	}
+	set := func(ctx context.Context, msg *anypb.Any) error {
+		_, err := srv.Set(ctx, &databroker.SetRequest{Type: msg.GetTypeUrl(), Id: "1", Data: msg})
+		return err
+	}
+	sessionData, _ := anypb.New(&session.Session{Id: "1"})
+	groupData, _ := anypb.New(&directory.Group{Id: "1"})
+
+	adminToken, err := NewAdminToken(secret, time.Minute)
+	require.NoError(t, err)
+	admin := metadata.NewIncomingContext(ctx, metadata.Pairs(grpcutil.JWTMetadataKey, adminToken))
+	require.NoError(t, set(admin, sessionData), "admin requests should be allowed")
+	require.NoError(t, set(admin, groupData))
+
+	proxy := withClient("proxy", proxySecret, "")
+	_, err = srv.Get(proxy, &databroker.GetRequest{Type: sessionData.GetTypeUrl(), Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(set(proxy, sessionData)))
+	_, err = srv.Get(proxy, &databroker.GetRequest{Type: groupData.GetTypeUrl(), Id: "1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	types, err := srv.GetTypes(proxy, new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.Equal(t, []string{sessionData.GetTypeUrl()}, types.GetTypes())
+	_, err = srv.Get(withClient("proxy", proxySecret, "tenant-a"), &databroker.GetRequest{Type: sessionData.GetTypeUrl(), Id: "1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "the proxy should only use the default namespace")
+
+	tenant := withClient("tenant-a", tenantSecret, "tenant-a")
+	assert.NoError(t, set(tenant, groupData))
+	assert.Equal(t, codes.PermissionDenied, status.Code(set(withClient("tenant-a", tenantSecret, ""), groupData)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(set(withClient("tenant-a", tenantSecret, "tenant-b"), groupData)))
+
+	controller := withClient("controller", controllerSecret, "")
+	tenantGroupType := "tenant-a" + namespaceSeparator + groupData.GetTypeUrl()
+	_, err = srv.Get(controller, &databroker.GetRequest{Type: tenantGroupType, Id: "1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "clients shouldn't reach other namespaces through the record type")
+	_, err = srv.Set(controller, &databroker.SetRequest{Type: tenantGroupType, Id: "1", Data: groupData})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.Delete(controller, &databroker.DeleteRequest{Type: tenantGroupType, Id: "1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.Get(tenant, &databroker.GetRequest{Type: groupData.GetTypeUrl(), Id: "1"})
+	assert.NoError(t, err, "the records of the tenant should be unchanged")
+
+	for name, ctx := range map[string]context.Context{
+		"missing token":  ctx,
+		"unknown client": withClient("authorize", proxySecret, ""),
+		"wrong secret":   withClient("proxy", tenantSecret, ""),
+		"bad admin token": metadata.NewIncomingContext(ctx, metadata.Pairs(
+			grpcutil.JWTMetadataKey, "not a token")),
+	} {
+		_, err := srv.Get(ctx, &databroker.GetRequest{Type: sessionData.GetTypeUrl(), Id: "1"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), name)
+	}
+}
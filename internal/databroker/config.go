@@ -13,15 +13,33 @@ var (
 	DefaultBTreeDegree = 8
 	// DefaultStorageType is the default storage type that Server use
 	DefaultStorageType = "memory"
+	// DefaultClockSkew is the default clock skew allowed when validating
+	// admin tokens.
+	DefaultClockSkew = time.Minute
+	// DefaultHistoryRetention is the default amount of time the history of
+	// a record is kept after its last change.
+	DefaultHistoryRetention = 7 * 24 * time.Hour
 )
 
+// minGCInterval is the minimum interval between garbage collections.
+const minGCInterval = time.Second
+
 type serverConfig struct {
 	deletePermanentlyAfter  time.Duration
 	btreeDegree             int
 	secret                  []byte
+	encryptionKeys          [][]byte
 	storageType             string
 	storageConnectionString string
 	storageTLSConfig        *tls.Config
+	recordTTLs              map[string]time.Duration
+	clockSkew               time.Duration
+	historySize             int
+	historyRetention        time.Duration
+	compressionThreshold    int
+	storageMaxRecords       int
+	storageMaxBytes         int64
+	recordValidation        bool
 }
 
 func newServerConfig(options ...ServerOption) *serverConfig {
@@ -29,6 +47,8 @@ func newServerConfig(options ...ServerOption) *serverConfig {
 	WithDeletePermanentlyAfter(DefaultDeletePermanentlyAfter)(cfg)
 	WithBTreeDegree(DefaultBTreeDegree)(cfg)
 	WithStorageType(DefaultStorageType)(cfg)
+	WithClockSkew(DefaultClockSkew)(cfg)
+	WithHistoryRetention(DefaultHistoryRetention)(cfg)
 	for _, option := range options {
 		option(cfg)
 	}
@@ -54,6 +74,25 @@ func WithDeletePermanentlyAfter(dur time.Duration) ServerOption {
 	}
 }
 
+// WithRecordTTLs sets the time to live of records by type. Records which
+// haven't been modified for longer than their type's TTL are deleted.
+func WithRecordTTLs(ttls map[string]time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.recordTTLs = ttls
+	}
+}
+
+// WithEncryptionKeys sets the keys used to encrypt records in persistent
+// storage. The first key encrypts records, and the others are previous keys
+// which are only used to decrypt them. Records encrypted with a previous key
+// are re-encrypted with the first one during garbage collection. If no keys
+// are set, records are encrypted with the secret.
+func WithEncryptionKeys(keys ...[]byte) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.encryptionKeys = keys
+	}
+}
+
 // WithSecret sets the secret in the config.
 func WithSecret(secret []byte) ServerOption {
 	return func(cfg *serverConfig) {
@@ -81,3 +120,72 @@ func WithStorageTLSConfig(tlsConfig *tls.Config) ServerOption {
 		cfg.storageTLSConfig = tlsConfig
 	}
 }
+
+// WithClockSkew sets the clock skew allowed when validating admin tokens.
+func WithClockSkew(skew time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.clockSkew = skew
+	}
+}
+
+// WithHistorySize sets the number of changes kept in the history of each
+// record. If zero, no history is kept.
+func WithHistorySize(size int) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.historySize = size
+	}
+}
+
+// WithHistoryRetention sets how long the history of a record is kept after
+// its last change.
+func WithHistoryRetention(dur time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.historyRetention = dur
+	}
+}
+
+// WithCompressionThreshold sets the size in bytes above which record data is
+// compressed in storage. If zero, records are never compressed.
+func WithCompressionThreshold(threshold int) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.compressionThreshold = threshold
+	}
+}
+
+// WithStorageMaxRecords sets the maximum number of records of each type kept
+// by the in-memory storage. If zero, the number of records is unlimited.
+func WithStorageMaxRecords(maxRecords int) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.storageMaxRecords = maxRecords
+	}
+}
+
+// WithStorageMaxBytes sets the maximum size of the records of each type kept
+// by the in-memory storage. If zero, the size of the records is unlimited.
+func WithStorageMaxBytes(maxBytes int64) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.storageMaxBytes = maxBytes
+	}
+}
+
+// WithRecordValidation rejects writes of records whose type isn't known, or
+// whose data can't be decoded or is missing required fields.
+func WithRecordValidation(enabled bool) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.recordValidation = enabled
+	}
+}
+
+// gcInterval returns how often deleted and expired records are collected.
+func (cfg *serverConfig) gcInterval() time.Duration {
+	interval := cfg.deletePermanentlyAfter / 2
+	for _, ttl := range cfg.recordTTLs {
+		if ttl/2 < interval {
+			interval = ttl / 2
+		}
+	}
+	if interval < minGCInterval {
+		interval = minGCInterval
+	}
+	return interval
+}
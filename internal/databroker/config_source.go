@@ -3,6 +3,7 @@ package databroker
 import (
 	"context"
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -19,11 +20,15 @@ import (
 
 var (
 	configTypeURL string
+	routeTypeURL  string
 )
 
 func init() {
 	any, _ := ptypes.MarshalAny(new(configpb.Config))
 	configTypeURL = any.GetTypeUrl()
+
+	any, _ = ptypes.MarshalAny(new(configpb.Route))
+	routeTypeURL = any.GetTypeUrl()
 }
 
 // ConfigSource provides a new Config source that decorates an underlying config with
@@ -33,18 +38,24 @@ type ConfigSource struct {
 	computedConfig   *config.Config
 	underlyingConfig *config.Config
 	dbConfigs        map[string]*configpb.Config
+	dbRoutes         map[string]*configpb.Route
 	updaterHash      uint64
 	cancel           func()
-	serverVersion    string
-	recordVersion    string
+	syncVersions     map[string]syncVersion
 
 	config.ChangeDispatcher
 }
 
+type syncVersion struct {
+	serverVersion, recordVersion string
+}
+
 // NewConfigSource creates a new ConfigSource.
 func NewConfigSource(underlying config.Source, listeners ...config.ChangeListener) *ConfigSource {
 	src := &ConfigSource{
-		dbConfigs: map[string]*configpb.Config{},
+		dbConfigs:    map[string]*configpb.Config{},
+		dbRoutes:     map[string]*configpb.Route{},
+		syncVersions: map[string]syncVersion{},
 	}
 	for _, li := range listeners {
 		src.OnConfigChange(li)
@@ -88,33 +99,27 @@ func (src *ConfigSource) rebuild(firstTime bool) {
 		cfg.Options.ApplySettings(cfgpb.Settings)
 
 		for _, routepb := range cfgpb.GetRoutes() {
-			policy, err := config.NewPolicyFromProto(routepb)
-			if err != nil {
-				log.Warn().Err(err).Msg("databroker: error converting protobuf into policy")
-				continue
-			}
-
-			err = policy.Validate()
-			if err != nil {
-				log.Warn().Err(err).
-					Str("policy", policy.String()).
-					Msg("databroker: invalid policy, ignoring")
-				continue
-			}
-
-			routeID := policy.RouteID()
-
-			if _, ok := seen[routeID]; ok {
-				log.Warn().Err(err).
-					Str("policy", policy.String()).
-					Msg("databroker: duplicate policy detected, ignoring")
-				continue
-			}
-			seen[routeID] = struct{}{}
+			addDataBrokerRoute(cfg, seen, routepb)
+		}
 
-			cfg.Options.Policies = append(cfg.Options.Policies, *policy)
+		err := cfg.Options.Validate()
+		if err != nil {
+			log.Warn().Err(err).Msg("databroker: invalid config detected, ignoring")
+			return
 		}
+	}
 
+	// add the route records, ordered by id so that every service matches
+	// the routes in the same order
+	routeIDs := make([]string, 0, len(src.dbRoutes))
+	for id := range src.dbRoutes {
+		routeIDs = append(routeIDs, id)
+	}
+	sort.Strings(routeIDs)
+	for _, id := range routeIDs {
+		addDataBrokerRoute(cfg, seen, src.dbRoutes[id])
+	}
+	if len(routeIDs) > 0 {
 		err := cfg.Options.Validate()
 		if err != nil {
 			log.Warn().Err(err).Msg("databroker: invalid config detected, ignoring")
@@ -128,6 +133,36 @@ func (src *ConfigSource) rebuild(firstTime bool) {
 	}
 }
 
+// addDataBrokerRoute adds a route stored in the data broker to the config's
+// policies, unless it's invalid or there's already a policy for the route.
+func addDataBrokerRoute(cfg *config.Config, seen map[uint64]struct{}, routepb *configpb.Route) {
+	policy, err := config.NewPolicyFromProto(routepb)
+	if err != nil {
+		log.Warn().Err(err).Msg("databroker: error converting protobuf into policy")
+		return
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		log.Warn().Err(err).
+			Str("policy", policy.String()).
+			Msg("databroker: invalid policy, ignoring")
+		return
+	}
+
+	routeID := policy.RouteID()
+
+	if _, ok := seen[routeID]; ok {
+		log.Warn().Err(err).
+			Str("policy", policy.String()).
+			Msg("databroker: duplicate policy detected, ignoring")
+		return
+	}
+	seen[routeID] = struct{}{}
+
+	cfg.Options.Policies = append(cfg.Options.Policies, *policy)
+}
+
 func (src *ConfigSource) runUpdater(cfg *config.Config) {
 	connectionOptions := &grpc.Options{
 		Addr:                    cfg.Options.DataBrokerURL,
@@ -138,6 +173,10 @@ func (src *ConfigSource) runUpdater(cfg *config.Config) {
 		ClientDNSRoundRobin:     cfg.Options.GRPCClientDNSRoundRobin,
 		WithInsecure:            cfg.Options.GRPCInsecure,
 		ServiceName:             cfg.Options.Services,
+		Compression:             cfg.Options.DataBrokerCompressionThreshold > 0,
+		Namespace:               cfg.Options.DataBrokerNamespace,
+		ClientID:                cfg.Options.DataBrokerClientID,
+		ClientSecret:            cfg.Options.GetDataBrokerClientSecret(),
 	}
 	h, err := hashstructure.Hash(connectionOptions, nil)
 	if err != nil {
@@ -165,15 +204,22 @@ func (src *ConfigSource) runUpdater(cfg *config.Config) {
 	ctx := context.Background()
 	ctx, src.cancel = context.WithCancel(ctx)
 
-	go tryForever(ctx, func(onSuccess func()) error {
+	go src.runSyncer(ctx, client, configTypeURL)
+	go src.runSyncer(ctx, client, routeTypeURL)
+}
+
+// runSyncer syncs the records of the given type from the data broker until
+// the context is canceled.
+func (src *ConfigSource) runSyncer(ctx context.Context, client databroker.DataBrokerServiceClient, typeURL string) {
+	tryForever(ctx, func(onSuccess func()) error {
 		src.mu.Lock()
-		serverVersion, recordVersion := src.serverVersion, src.recordVersion
+		version := src.syncVersions[typeURL]
 		src.mu.Unlock()
 
 		stream, err := client.Sync(ctx, &databroker.SyncRequest{
-			Type:          configTypeURL,
-			ServerVersion: serverVersion,
-			RecordVersion: recordVersion,
+			Type:          typeURL,
+			ServerVersion: version.serverVersion,
+			RecordVersion: version.recordVersion,
 		})
 		if err != nil {
 			return err
@@ -189,12 +235,13 @@ func (src *ConfigSource) runUpdater(cfg *config.Config) {
 			if len(res.GetRecords()) > 0 {
 				src.onSync(res.GetRecords())
 				for _, record := range res.GetRecords() {
-					recordVersion = record.GetVersion()
+					version.recordVersion = record.GetVersion()
 				}
 			}
+			version.serverVersion = res.GetServerVersion()
 
 			src.mu.Lock()
-			src.serverVersion, src.recordVersion = res.GetServerVersion(), recordVersion
+			src.syncVersions[typeURL] = version
 			src.mu.Unlock()
 		}
 	})
@@ -203,26 +250,52 @@ func (src *ConfigSource) runUpdater(cfg *config.Config) {
 func (src *ConfigSource) onSync(records []*databroker.Record) {
 	src.mu.Lock()
 	for _, record := range records {
-		if record.GetDeletedAt() != nil {
-			delete(src.dbConfigs, record.GetId())
-			continue
-		}
-
-		var cfgpb configpb.Config
-		err := ptypes.UnmarshalAny(record.GetData(), &cfgpb)
-		if err != nil {
-			log.Warn().Err(err).Msg("databroker: error decoding config")
-			delete(src.dbConfigs, record.GetId())
-			continue
+		switch record.GetType() {
+		case configTypeURL:
+			src.updateConfig(record)
+		case routeTypeURL:
+			src.updateRoute(record)
 		}
-
-		src.dbConfigs[record.GetId()] = &cfgpb
 	}
 	src.mu.Unlock()
 
 	src.rebuild(false)
 }
 
+func (src *ConfigSource) updateConfig(record *databroker.Record) {
+	if record.GetDeletedAt() != nil {
+		delete(src.dbConfigs, record.GetId())
+		return
+	}
+
+	var cfgpb configpb.Config
+	err := ptypes.UnmarshalAny(record.GetData(), &cfgpb)
+	if err != nil {
+		log.Warn().Err(err).Msg("databroker: error decoding config")
+		delete(src.dbConfigs, record.GetId())
+		return
+	}
+
+	src.dbConfigs[record.GetId()] = &cfgpb
+}
+
+func (src *ConfigSource) updateRoute(record *databroker.Record) {
+	if record.GetDeletedAt() != nil {
+		delete(src.dbRoutes, record.GetId())
+		return
+	}
+
+	var routepb configpb.Route
+	err := ptypes.UnmarshalAny(record.GetData(), &routepb)
+	if err != nil {
+		log.Warn().Err(err).Msg("databroker: error decoding route")
+		delete(src.dbRoutes, record.GetId())
+		return
+	}
+
+	src.dbRoutes[record.GetId()] = &routepb
+}
+
 func tryForever(ctx context.Context, callback func(onSuccess func()) error) {
 	bo := backoff.NewExponentialBackOff()
 	bo.MaxElapsedTime = 0
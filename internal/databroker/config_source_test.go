@@ -75,6 +75,34 @@ func TestConfigSource(t *testing.T) {
 	case cfg := <-cfgs:
 		assert.Len(t, cfg.Options.Policies, 1)
 	}
+
+	for _, id := range []string{"route2", "route1"} {
+		data, _ = ptypes.MarshalAny(&configpb.Route{
+			From: "https://" + id + ".example.com",
+			To:   "https://to.example.com",
+		})
+		_, _ = dataBrokerServer.Set(ctx, &databroker.SetRequest{
+			Type: routeTypeURL,
+			Id:   id,
+			Data: data,
+		})
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			assert.NoError(t, ctx.Err())
+			return
+		case cfg := <-cfgs:
+			if len(cfg.Options.Policies) < 3 {
+				continue
+			}
+			assert.Equal(t, "route1.example.com", cfg.Options.Policies[1].Source.Host,
+				"route records should be added in id order")
+			assert.Equal(t, "route2.example.com", cfg.Options.Policies[2].Source.Host)
+		}
+		break
+	}
 }
 
 func mustParse(raw string) *url.URL {
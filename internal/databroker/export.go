@@ -0,0 +1,181 @@
+package databroker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// adminTokenAudience is the audience of the tokens which authorize exporting
+// and importing records.
+const adminTokenAudience = "databroker"
+
+// defaultExportTypes are the record types exported when none are requested:
+// everything needed to keep users signed in on a new cluster.
+var defaultExportTypes = []string{
+	"type.googleapis.com/session.Session",
+	"type.googleapis.com/user.User",
+	"type.googleapis.com/directory.User",
+	"type.googleapis.com/directory.Group",
+}
+
+// NewAdminToken returns a token which authorizes the bearer to make admin
+// requests to a databroker, such as exporting and importing records, using
+// the given shared secret. It must be
+// sent with the gRPC request using grpcutil.WithOutgoingJWT.
+func NewAdminToken(secret []byte, ttl time.Duration) (string, error) {
+	signer, err := jws.NewHS256Signer(secret, "")
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	raw, err := signer.Marshal(jwt.Claims{
+		Audience: jwt.Audience{adminTokenAudience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(ttl)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// Export returns a consistent snapshot of the records of the requested types,
+// so they can be imported into another cluster.
+func (srv *Server) Export(ctx context.Context, req *databroker.ExportRequest) (*databroker.ExportResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "databroker.grpc.Export")
+	defer span.End()
+
+	if err := srv.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	recordTypes := req.GetTypes()
+	if len(recordTypes) == 0 {
+		recordTypes = defaultExportTypes
+	}
+
+	// block writes so the records of every type are from the same point in time
+	srv.snapshotMu.Lock()
+	defer srv.snapshotMu.Unlock()
+
+	res := &databroker.ExportResponse{
+		ServerVersion: srv.version,
+		ExportedAt:    timestamppb.Now(),
+	}
+	for _, recordType := range recordTypes {
+		db, err := srv.getDB(recordType)
+		if err != nil {
+			return nil, err
+		}
+		all, err := db.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range all {
+			if record.GetDeletedAt() == nil {
+				res.Records = append(res.Records, record)
+			}
+		}
+	}
+
+	srv.log.Info().
+		Strs("types", recordTypes).
+		Int("count", len(res.Records)).
+		Msg("export")
+	return res, nil
+}
+
+// Import stores records exported from another cluster. The records are
+// encrypted with this cluster's shared secret when they're stored.
+func (srv *Server) Import(ctx context.Context, req *databroker.ImportRequest) (*databroker.ImportResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "databroker.grpc.Import")
+	defer span.End()
+
+	if err := srv.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, record := range req.GetRecords() {
+		if record.GetType() == "" || record.GetId() == "" || record.GetData() == nil {
+			return nil, status.Error(codes.InvalidArgument, "records must have a type, an id and data")
+		}
+	}
+
+	srv.snapshotMu.RLock()
+	defer srv.snapshotMu.RUnlock()
+
+	var count int64
+	for _, record := range req.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		db, err := srv.getDB(record.GetType())
+		if err != nil {
+			return nil, err
+		}
+		_, err = srv.write(ctx, record.GetType(), db, record.GetId(), func() error {
+			return db.Put(ctx, record.GetId(), record.GetData())
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error importing record %s/%s: %w", record.GetType(), record.GetId(), err)
+		}
+		count++
+	}
+
+	srv.log.Info().
+		Int64("count", count).
+		Msg("import")
+	return &databroker.ImportResponse{Count: count}, nil
+}
+
+// authorizeAdmin checks that the request has a token from NewAdminToken
+// signed with the shared secret.
+func (srv *Server) authorizeAdmin(ctx context.Context) error {
+	return AuthorizeAdmin(ctx, srv.cfg.secret, srv.cfg.clockSkew)
+}
+
+// AuthorizeAdmin checks that a gRPC request has a token from NewAdminToken
+// signed with the given secret, tolerating the given clock skew.
+func AuthorizeAdmin(ctx context.Context, secret []byte, clockSkew time.Duration) error {
+	if secret == nil {
+		return status.Error(codes.PermissionDenied, "a shared secret is required for admin requests")
+	}
+	rawJWT, ok := grpcutil.JWTFromGRPCRequest(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing admin token")
+	}
+	if err := validateAdminToken(secret, rawJWT, time.Now(), clockSkew); err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid admin token: %v", err)
+	}
+	return nil
+}
+
+func validateAdminToken(secret []byte, rawJWT string, now time.Time, skew time.Duration) error {
+	signer, err := jws.NewHS256Signer(secret, "")
+	if err != nil {
+		return err
+	}
+	var claims jwt.Claims
+	if err := signer.Unmarshal([]byte(rawJWT), &claims); err != nil {
+		return err
+	}
+	if claims.Expiry == nil {
+		return errors.New("token must expire")
+	}
+	return claims.ValidateWithLeeway(jwt.Expected{
+		Audience: jwt.Audience{adminTokenAudience},
+		Time:     now,
+	}, skew)
+}
@@ -0,0 +1,119 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func withAdminToken(t *testing.T, secret []byte, ttl time.Duration) context.Context {
+	token, err := NewAdminToken(secret, ttl)
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutil.JWTMetadataKey, token))
+}
+
+func TestServer_ExportImport(t *testing.T) {
+	oldSecret, newSecret := cryptutil.NewKey(), cryptutil.NewKey()
+	oldSrv := newServer(newServerConfig(WithSecret(oldSecret)))
+	newSrv := newServer(newServerConfig(WithSecret(newSecret)))
+
+	set := func(id string, any *anypb.Any) {
+		_, err := oldSrv.Set(context.Background(), &databroker.SetRequest{Type: any.GetTypeUrl(), Id: id, Data: any})
+		require.NoError(t, err)
+	}
+	s := &session.Session{Id: "session1", UserId: "user1"}
+	sessionAny, _ := anypb.New(s)
+	set(s.GetId(), sessionAny)
+	u := &user.User{Id: "user1", Email: "user1@example.com"}
+	userAny, _ := anypb.New(u)
+	set(u.GetId(), userAny)
+	deleted := &session.Session{Id: "session2", UserId: "user1"}
+	deletedAny, _ := anypb.New(deleted)
+	set(deleted.GetId(), deletedAny)
+	_, err := oldSrv.Delete(context.Background(), &databroker.DeleteRequest{Type: deletedAny.GetTypeUrl(), Id: deleted.GetId()})
+	require.NoError(t, err)
+
+	exported, err := oldSrv.Export(withAdminToken(t, oldSecret, time.Minute), &databroker.ExportRequest{})
+	require.NoError(t, err)
+	assert.Len(t, exported.GetRecords(), 2, "deleted records should not be exported")
+
+	res, err := newSrv.Import(withAdminToken(t, newSecret, time.Minute), &databroker.ImportRequest{
+		Records: exported.GetRecords(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), res.GetCount())
+
+	got, err := newSrv.Get(context.Background(), &databroker.GetRequest{Type: sessionAny.GetTypeUrl(), Id: "session1"})
+	require.NoError(t, err)
+	var gotSession session.Session
+	require.NoError(t, got.GetRecord().GetData().UnmarshalTo(&gotSession))
+	assert.Equal(t, "user1", gotSession.GetUserId())
+
+	got, err = newSrv.Get(context.Background(), &databroker.GetRequest{Type: userAny.GetTypeUrl(), Id: "user1"})
+	require.NoError(t, err)
+	var gotUser user.User
+	require.NoError(t, got.GetRecord().GetData().UnmarshalTo(&gotUser))
+	assert.Equal(t, "user1@example.com", gotUser.GetEmail())
+}
+
+func TestServer_ExportUnauthenticated(t *testing.T) {
+	secret := cryptutil.NewKey()
+	srv := newServer(newServerConfig(WithSecret(secret)))
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"missing token", context.Background(), codes.Unauthenticated},
+		{"wrong secret", withAdminToken(t, cryptutil.NewKey(), time.Minute), codes.Unauthenticated},
+		{"expired token", withAdminToken(t, secret, -time.Hour), codes.Unauthenticated},
+		{"not a token", metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutil.JWTMetadataKey, "x")), codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.Export(tt.ctx, &databroker.ExportRequest{})
+			assert.Equal(t, tt.code, status.Code(err))
+			_, err = srv.Import(tt.ctx, &databroker.ImportRequest{})
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	t.Run("no shared secret", func(t *testing.T) {
+		srv := newServer(newServerConfig())
+		_, err := srv.Export(withAdminToken(t, secret, time.Minute), &databroker.ExportRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("clock skew", func(t *testing.T) {
+		ctx := withAdminToken(t, secret, -2*time.Minute)
+		_, err := srv.Export(ctx, &databroker.ExportRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		srv := newServer(newServerConfig(WithSecret(secret), WithClockSkew(5*time.Minute)))
+		_, err = srv.Export(ctx, &databroker.ExportRequest{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestServer_ImportInvalidRecord(t *testing.T) {
+	secret := cryptutil.NewKey()
+	srv := newServer(newServerConfig(WithSecret(secret)))
+	_, err := srv.Import(withAdminToken(t, secret, time.Minute), &databroker.ImportRequest{
+		Records: []*databroker.Record{{Type: "type.googleapis.com/session.Session"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
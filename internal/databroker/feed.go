@@ -0,0 +1,138 @@
+package databroker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// feedBufferSize is the number of records buffered for each subscriber before
+// it falls behind and has to catch up from storage.
+const feedBufferSize = 128
+
+// A recordFeed pushes the records of a type written through the server to the
+// Sync streams as soon as they're written, so streams don't have to wait for
+// storage to notify them of the change and list it.
+type recordFeed struct {
+	// mu is held while a record is written, so records are published in the
+	// order of their versions.
+	mu          sync.Mutex
+	subscribers map[*feedSubscriber]struct{}
+}
+
+func newRecordFeed() *recordFeed {
+	return &recordFeed{subscribers: make(map[*feedSubscriber]struct{})}
+}
+
+// A feedSubscriber receives the records published to a feed. When its buffer
+// is full, records are dropped and the subscriber is marked as lagged.
+type feedSubscriber struct {
+	records chan *databroker.Record
+
+	mu     sync.Mutex
+	lagged bool
+}
+
+func (feed *recordFeed) subscribe() *feedSubscriber {
+	sub := &feedSubscriber{records: make(chan *databroker.Record, feedBufferSize)}
+	feed.mu.Lock()
+	feed.subscribers[sub] = struct{}{}
+	feed.mu.Unlock()
+	return sub
+}
+
+func (feed *recordFeed) unsubscribe(sub *feedSubscriber) {
+	feed.mu.Lock()
+	delete(feed.subscribers, sub)
+	feed.mu.Unlock()
+}
+
+// publish must be called with mu held.
+func (feed *recordFeed) publish(record *databroker.Record) {
+	for sub := range feed.subscribers {
+		select {
+		case sub.records <- record:
+		default:
+			sub.mu.Lock()
+			sub.lagged = true
+			sub.mu.Unlock()
+		}
+	}
+}
+
+// takeLagged returns whether records were dropped since the last call.
+func (sub *feedSubscriber) takeLagged() bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	lagged := sub.lagged
+	sub.lagged = false
+	return lagged
+}
+
+func (srv *Server) getFeed(recordType string) *recordFeed {
+	srv.mu.RLock()
+	feed := srv.feeds[recordType]
+	srv.mu.RUnlock()
+	if feed != nil {
+		return feed
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	feed = srv.feeds[recordType]
+	if feed == nil {
+		feed = newRecordFeed()
+		srv.feeds[recordType] = feed
+	}
+	return feed
+}
+
+// write calls fn to write the record with the given id, and then publishes
+// the written record to the type's feed and records the change in its
+// history.
+func (srv *Server) write(ctx context.Context, recordType string, db storage.Backend, id string, fn func() error) (*databroker.Record, error) {
+	records, err := srv.writeMany(ctx, recordType, db, []string{id}, fn)
+	if err != nil {
+		return nil, err
+	}
+	return records[0], nil
+}
+
+// writeMany is like write, but for several records written together by fn.
+func (srv *Server) writeMany(ctx context.Context, recordType string, db storage.Backend, ids []string, fn func() error) ([]*databroker.Record, error) {
+	feed := srv.getFeed(recordType)
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	previousVersions := make([]string, len(ids))
+	for i, id := range ids {
+		previousVersions[i] = srv.previousVersion(ctx, db, id)
+	}
+	if err := fn(); err != nil {
+		return nil, err
+	}
+	records := make([]*databroker.Record, len(ids))
+	for i, id := range ids {
+		record, err := db.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		records[i] = record
+	}
+	for i, record := range records {
+		feed.publish(record)
+		srv.recordChange(ctx, recordType, previousVersions[i], record)
+	}
+	return records, nil
+}
+
+// pushesRecords returns true if the records published to feeds can be sent to
+// Sync streams as is. That's only the case when all the writes go through
+// this server. With shared storage, another server may have written records
+// with earlier versions, so a published record only triggers a sync instead.
+func (srv *Server) pushesRecords() bool {
+	return srv.cfg.storageType == config.StorageInMemoryName
+}
@@ -0,0 +1,340 @@
+package databroker
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/internal/election"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// forwardedMetadataKey is set on the requests forwarded to the leader, so
+// they're never forwarded again, even if the leader changed in the meantime.
+const forwardedMetadataKey = "x-pomerium-databroker-forwarded"
+
+// A Forwarder serves the requests to a databroker replica when it's the
+// leader, and forwards them to the leader otherwise, so all the replicas
+// serve the same data.
+//
+// Streams are ended when the leader changes, so clients sync again from the
+// new leader.
+type Forwarder struct {
+	local   databroker.DataBrokerServiceServer
+	elector *election.Elector
+	dial    func(leader string) (databroker.DataBrokerServiceClient, error)
+}
+
+// NewForwarder creates a new Forwarder. dial returns a client for the leader
+// with the given id.
+func NewForwarder(
+	local databroker.DataBrokerServiceServer,
+	elector *election.Elector,
+	dial func(leader string) (databroker.DataBrokerServiceClient, error),
+) *Forwarder {
+	return &Forwarder{
+		local:   local,
+		elector: elector,
+		dial:    dial,
+	}
+}
+
+// leaderClient returns a client for the leader and the context to call it
+// with, or nil if the request is served locally.
+func (f *Forwarder) leaderClient(ctx context.Context) (databroker.DataBrokerServiceClient, context.Context, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(forwardedMetadataKey)) > 0 {
+		return nil, ctx, nil
+	}
+	if f.elector.IsLeader() {
+		return nil, ctx, nil
+	}
+	leader := f.elector.Leader()
+	if leader == "" {
+		return nil, ctx, status.Error(codes.Unavailable, "no databroker leader is elected")
+	}
+	client, err := f.dial(leader)
+	if err != nil {
+		return nil, ctx, status.Errorf(codes.Unavailable, "failed to connect to databroker leader: %v", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, forwardedMetadataKey, "true")
+	// the admin token, the client token, the calling service and the
+	// namespace are checked and recorded by the leader
+	if rawJWT, ok := grpcutil.JWTFromGRPCRequest(ctx); ok {
+		ctx = grpcutil.WithOutgoingJWT(ctx, rawJWT)
+	}
+	if rawToken, ok := grpcutil.ClientTokenFromGRPCRequest(ctx); ok {
+		ctx = grpcutil.WithOutgoingClientToken(ctx, rawToken)
+	}
+	if serviceName, ok := grpcutil.ServiceNameFromGRPCRequest(ctx); ok {
+		ctx = grpcutil.WithOutgoingServiceName(ctx, serviceName)
+	}
+	if namespace, ok := grpcutil.NamespaceFromGRPCRequest(ctx); ok {
+		ctx = grpcutil.WithOutgoingNamespace(ctx, namespace)
+	}
+	return client, ctx, nil
+}
+
+// untilLeaderChanges returns a context which is canceled when the leader
+// changes.
+func (f *Forwarder) untilLeaderChanges(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch := f.elector.Bind()
+	go func() {
+		defer f.elector.Unbind(ch)
+		select {
+		case <-ch:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Delete deletes a record.
+func (f *Forwarder) Delete(ctx context.Context, req *databroker.DeleteRequest) (*emptypb.Empty, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.Delete(ctx, req)
+	}
+	return client.Delete(ctx, req)
+}
+
+// Get gets a record.
+func (f *Forwarder) Get(ctx context.Context, req *databroker.GetRequest) (*databroker.GetResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.Get(ctx, req)
+	}
+	return client.Get(ctx, req)
+}
+
+// GetAll gets all the records of a type.
+func (f *Forwarder) GetAll(ctx context.Context, req *databroker.GetAllRequest) (*databroker.GetAllResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.GetAll(ctx, req)
+	}
+	return client.GetAll(ctx, req)
+}
+
+// GetAllByIndex gets all the records of a type with a value for an index.
+func (f *Forwarder) GetAllByIndex(ctx context.Context, req *databroker.GetAllByIndexRequest) (*databroker.GetAllByIndexResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.GetAllByIndex(ctx, req)
+	}
+	return client.GetAllByIndex(ctx, req)
+}
+
+// Set sets a record.
+func (f *Forwarder) Set(ctx context.Context, req *databroker.SetRequest) (*databroker.SetResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.Set(ctx, req)
+	}
+	return client.Set(ctx, req)
+}
+
+// BatchSet sets several records of a type.
+func (f *Forwarder) BatchSet(ctx context.Context, req *databroker.BatchSetRequest) (*databroker.BatchSetResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.BatchSet(ctx, req)
+	}
+	return client.BatchSet(ctx, req)
+}
+
+// BatchGet gets several records of a type.
+func (f *Forwarder) BatchGet(ctx context.Context, req *databroker.BatchGetRequest) (*databroker.BatchGetResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.BatchGet(ctx, req)
+	}
+	return client.BatchGet(ctx, req)
+}
+
+// BatchDelete deletes several records of a type.
+func (f *Forwarder) BatchDelete(ctx context.Context, req *databroker.BatchDeleteRequest) (*emptypb.Empty, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.BatchDelete(ctx, req)
+	}
+	return client.BatchDelete(ctx, req)
+}
+
+// Query queries the records of a type.
+func (f *Forwarder) Query(ctx context.Context, req *databroker.QueryRequest) (*databroker.QueryResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.Query(ctx, req)
+	}
+	return client.Query(ctx, req)
+}
+
+// GetTypes returns all the known record types.
+func (f *Forwarder) GetTypes(ctx context.Context, req *emptypb.Empty) (*databroker.GetTypesResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.GetTypes(ctx, req)
+	}
+	return client.GetTypes(ctx, req)
+}
+
+// Export exports a snapshot of the records.
+func (f *Forwarder) Export(ctx context.Context, req *databroker.ExportRequest) (*databroker.ExportResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.Export(ctx, req)
+	}
+	return client.Export(ctx, req)
+}
+
+// Import imports a snapshot of the records.
+func (f *Forwarder) Import(ctx context.Context, req *databroker.ImportRequest) (*databroker.ImportResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.Import(ctx, req)
+	}
+	return client.Import(ctx, req)
+}
+
+// GetHistory returns the last changes to a record.
+func (f *Forwarder) GetHistory(ctx context.Context, req *databroker.GetHistoryRequest) (*databroker.GetHistoryResponse, error) {
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return nil, err
+	} else if client == nil {
+		return f.local.GetHistory(ctx, req)
+	}
+	return client.GetHistory(ctx, req)
+}
+
+// Sync streams the changes to the records of a type.
+func (f *Forwarder) Sync(req *databroker.SyncRequest, stream databroker.DataBrokerService_SyncServer) error {
+	ctx, cancel := f.untilLeaderChanges(stream.Context())
+	defer cancel()
+
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return err
+	} else if client == nil {
+		err = f.local.Sync(req, syncServer{stream, ctx})
+	} else {
+		err = forwardSync(ctx, client, req, stream)
+	}
+	return leaderChangedError(stream.Context(), ctx, err)
+}
+
+// SyncTypes streams the changes to the known record types.
+func (f *Forwarder) SyncTypes(req *emptypb.Empty, stream databroker.DataBrokerService_SyncTypesServer) error {
+	ctx, cancel := f.untilLeaderChanges(stream.Context())
+	defer cancel()
+
+	client, ctx, err := f.leaderClient(ctx)
+	if err != nil {
+		return err
+	} else if client == nil {
+		err = f.local.SyncTypes(req, syncTypesServer{stream, ctx})
+	} else {
+		err = forwardSyncTypes(ctx, client, req, stream)
+	}
+	return leaderChangedError(stream.Context(), ctx, err)
+}
+
+func forwardSync(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	req *databroker.SyncRequest,
+	stream databroker.DataBrokerService_SyncServer,
+) error {
+	src, err := client.Sync(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := src.Recv()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+func forwardSyncTypes(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	req *emptypb.Empty,
+	stream databroker.DataBrokerService_SyncTypesServer,
+) error {
+	src, err := client.SyncTypes(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := src.Recv()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+// leaderChangedError returns an Unavailable error if a stream was ended
+// because the leader changed, so the client retries.
+func leaderChangedError(streamCtx, ctx context.Context, err error) error {
+	if streamCtx.Err() == nil && ctx.Err() != nil {
+		return status.Error(codes.Unavailable, "databroker leader changed")
+	}
+	return err
+}
+
+// syncServer and syncTypesServer replace the context of a stream.
+type syncServer struct {
+	databroker.DataBrokerService_SyncServer
+	ctx context.Context
+}
+
+func (stream syncServer) Context() context.Context {
+	return stream.ctx
+}
+
+type syncTypesServer struct {
+	databroker.DataBrokerService_SyncTypesServer
+	ctx context.Context
+}
+
+func (stream syncTypesServer) Context() context.Context {
+	return stream.ctx
+}
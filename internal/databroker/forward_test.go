@@ -0,0 +1,134 @@
+package databroker
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/internal/election"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+type testLock struct {
+	mu     sync.Mutex
+	holder string
+}
+
+func (lock *testLock) Acquire(_ context.Context, holder string, _ time.Duration) (string, error) {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	if lock.holder == "" {
+		lock.holder = holder
+	}
+	return lock.holder, nil
+}
+
+func (lock *testLock) Release(_ context.Context, holder string) error {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	if lock.holder == holder {
+		lock.holder = ""
+	}
+	return nil
+}
+
+func TestForwarder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recordType := "type.googleapis.com/session.Session"
+
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer li.Close()
+	cc, err := grpc.Dial(li.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer cc.Close()
+	dial := func(leader string) (databroker.DataBrokerServiceClient, error) {
+		assert.Equal(t, "leader", leader)
+		return databroker.NewDataBrokerServiceClient(cc), nil
+	}
+
+	lock := &testLock{holder: "leader"}
+	leaderCtx, stopLeader := context.WithCancel(ctx)
+	leaderElector := election.New(lock, "leader", 300*time.Millisecond)
+	go func() { _ = leaderElector.Run(leaderCtx) }()
+	followerElector := election.New(lock, "follower", 300*time.Millisecond)
+	go func() { _ = followerElector.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		return leaderElector.IsLeader() && followerElector.Leader() == "leader"
+	}, time.Second, 10*time.Millisecond)
+
+	secret := cryptutil.NewKey()
+	leader := newServer(newServerConfig(WithSecret(secret), WithHistorySize(10)))
+	follower := newServer(newServerConfig())
+	grpcServer := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(grpcServer, NewForwarder(leader, leaderElector, dial))
+	go func() { _ = grpcServer.Serve(li) }()
+	defer grpcServer.Stop()
+	f := NewForwarder(follower, followerElector, dial)
+
+	t.Run("unary", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs(grpcutil.ServiceNameMetadataKey, "authenticate"))
+		_, err := f.Set(ctx, &databroker.SetRequest{Type: recordType, Id: "1", Data: &anypb.Any{TypeUrl: recordType}})
+		require.NoError(t, err)
+
+		res, err := leader.Get(ctx, &databroker.GetRequest{Type: recordType, Id: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.GetRecord().GetId())
+		_, err = follower.Get(ctx, &databroker.GetRequest{Type: recordType, Id: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err), "the record should only be stored by the leader")
+
+		res, err = f.Get(ctx, &databroker.GetRequest{Type: recordType, Id: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.GetRecord().GetId())
+	})
+	t.Run("admin", func(t *testing.T) {
+		_, err := f.GetHistory(ctx, &databroker.GetHistoryRequest{Type: recordType, Id: "1"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// the admin token and the calling service are forwarded to the leader
+		res, err := f.GetHistory(withAdminToken(t, secret, time.Minute), &databroker.GetHistoryRequest{Type: recordType, Id: "1"})
+		require.NoError(t, err)
+		require.Len(t, res.GetChanges(), 1)
+		assert.Equal(t, "authenticate", res.GetChanges()[0].GetService())
+	})
+	t.Run("sync", func(t *testing.T) {
+		stream := &syncServerStream{ctx: ctx, responses: make(chan *databroker.SyncResponse)}
+		errc := make(chan error, 1)
+		go func() {
+			errc <- f.Sync(&databroker.SyncRequest{Type: recordType}, stream)
+		}()
+		res := <-stream.responses
+		assert.Equal(t, leader.version, res.GetServerVersion())
+		res = <-stream.responses
+		assert.Len(t, res.GetRecords(), 1)
+
+		// the stream ends when the leader changes, and the follower takes over
+		stopLeader()
+		select {
+		case err := <-errc:
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+		case <-time.After(time.Second):
+			t.Fatal("expected the stream to end when the leader changed")
+		}
+		require.Eventually(t, followerElector.IsLeader, time.Second, 10*time.Millisecond)
+
+		go func() {
+			errc <- f.Sync(&databroker.SyncRequest{Type: recordType}, stream)
+		}()
+		res = <-stream.responses
+		assert.Equal(t, follower.version, res.GetServerVersion())
+	})
+}
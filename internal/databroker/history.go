@@ -0,0 +1,154 @@
+package databroker
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// historyTypePrefix prefixes the record type of the storage backends holding
+// the history of the records of a type. They aren't part of the known types,
+// so they're never synced.
+const historyTypePrefix = "history/"
+
+// GetHistory returns the last changes to a record, oldest first.
+func (srv *Server) GetHistory(ctx context.Context, req *databroker.GetHistoryRequest) (*databroker.GetHistoryResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "databroker.grpc.GetHistory")
+	defer span.End()
+
+	if err := srv.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if srv.cfg.historySize <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "record history is disabled")
+	}
+
+	db, err := srv.getHistoryDB(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	record, err := db.Get(ctx, req.GetId())
+	if err != nil || record.GetDeletedAt() != nil {
+		return nil, status.Error(codes.NotFound, "record history not found")
+	}
+	var history databroker.RecordHistory
+	if err := ptypes.UnmarshalAny(record.GetData(), &history); err != nil {
+		return nil, err
+	}
+	return &databroker.GetHistoryResponse{Changes: history.GetChanges()}, nil
+}
+
+// previousVersion returns the version of a record before it's changed, or an
+// empty string if it doesn't exist or history is disabled.
+func (srv *Server) previousVersion(ctx context.Context, db storage.Backend, id string) string {
+	if srv.cfg.historySize <= 0 {
+		return ""
+	}
+	record, err := db.Get(ctx, id)
+	if err != nil || record.GetDeletedAt() != nil {
+		return ""
+	}
+	return record.GetVersion()
+}
+
+// recordChange appends a change to the history of a record, dropping the
+// oldest changes beyond the history size. Failures are only logged, since the
+// change itself was already stored.
+func (srv *Server) recordChange(ctx context.Context, recordType, previousVersion string, record *databroker.Record) {
+	if srv.cfg.historySize <= 0 {
+		return
+	}
+	db, err := srv.getHistoryDB(recordType)
+	if err != nil {
+		srv.log.Error().Err(err).Str("type", recordType).Msg("failed to get record history storage")
+		return
+	}
+
+	change := &databroker.RecordChange{
+		Record:          record,
+		PreviousVersion: previousVersion,
+	}
+	change.Service, _ = grpcutil.ServiceNameFromGRPCRequest(ctx)
+	if p, ok := peer.FromContext(ctx); ok {
+		change.Peer = p.Addr.String()
+	}
+
+	var history databroker.RecordHistory
+	if existing, err := db.Get(ctx, record.GetId()); err == nil && existing.GetDeletedAt() == nil {
+		_ = ptypes.UnmarshalAny(existing.GetData(), &history)
+	}
+	history.Changes = append(history.Changes, change)
+	if n := len(history.Changes) - srv.cfg.historySize; n > 0 {
+		history.Changes = history.Changes[n:]
+	}
+
+	data, err := ptypes.MarshalAny(&history)
+	if err == nil {
+		err = db.Put(ctx, record.GetId(), data)
+	}
+	if err != nil {
+		srv.log.Error().Err(err).Str("type", recordType).Str("id", record.GetId()).Msg("failed to record change")
+	}
+}
+
+// collectHistoryGarbage deletes the history of records which haven't changed
+// within the history retention.
+func (srv *Server) collectHistoryGarbage(ctx context.Context) {
+	srv.mu.RLock()
+	dbs := make(map[string]storage.Backend, len(srv.history))
+	for recordType, db := range srv.history {
+		dbs[recordType] = db
+	}
+	srv.mu.RUnlock()
+
+	cutoff := timeNow().Add(-srv.cfg.historyRetention)
+	for recordType, db := range dbs {
+		records, err := db.GetAll(ctx)
+		if err != nil {
+			srv.log.Error().Err(err).Str("type", recordType).Msg("failed to list record history for garbage collection")
+			continue
+		}
+		for _, record := range records {
+			if record.GetDeletedAt() != nil {
+				continue
+			}
+			modifiedAt, err := ptypes.Timestamp(record.GetModifiedAt())
+			if err != nil || !modifiedAt.Before(cutoff) {
+				continue
+			}
+			if err := db.Delete(ctx, record.GetId()); err != nil {
+				srv.log.Error().Err(err).Str("type", recordType).Str("id", record.GetId()).Msg("failed to delete record history")
+			}
+		}
+		db.ClearDeleted(ctx, timeNow())
+	}
+}
+
+func (srv *Server) getHistoryDB(recordType string) (storage.Backend, error) {
+	srv.mu.RLock()
+	db := srv.history[recordType]
+	srv.mu.RUnlock()
+	if db != nil {
+		return db, nil
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if db := srv.history[recordType]; db != nil {
+		return db, nil
+	}
+	db, err := srv.newDB(historyTypePrefix + recordType)
+	if err != nil {
+		return nil, err
+	}
+	srv.history[recordType] = db
+	return db, nil
+}
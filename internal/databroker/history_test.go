@@ -0,0 +1,89 @@
+package databroker
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestServer_GetHistory(t *testing.T) {
+	secret := cryptutil.NewKey()
+	adminCtx := withAdminToken(t, secret, time.Minute)
+	recordType := "type.googleapis.com/session.Session"
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutil.ServiceNameMetadataKey, "authenticate"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5443}})
+	set := func(srv *Server, userID string) *databroker.Record {
+		data, _ := anypb.New(&session.Session{Id: "session1", UserId: userID})
+		res, err := srv.Set(ctx, &databroker.SetRequest{Type: recordType, Id: "session1", Data: data})
+		require.NoError(t, err)
+		return res.GetRecord()
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		srv := newServer(newServerConfig(WithSecret(secret)))
+		set(srv, "user1")
+		_, err := srv.GetHistory(adminCtx, &databroker.GetHistoryRequest{Type: recordType, Id: "session1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		srv := newServer(newServerConfig(WithSecret(secret), WithHistorySize(2)))
+		_, err := srv.GetHistory(context.Background(), &databroker.GetHistoryRequest{Type: recordType, Id: "session1"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("bounded", func(t *testing.T) {
+		srv := newServer(newServerConfig(WithSecret(secret), WithHistorySize(2)))
+		_, err := srv.GetHistory(adminCtx, &databroker.GetHistoryRequest{Type: recordType, Id: "session1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		set(srv, "user1")
+		r2 := set(srv, "user2")
+		_, err = srv.Delete(ctx, &databroker.DeleteRequest{Type: recordType, Id: "session1"})
+		require.NoError(t, err)
+
+		res, err := srv.GetHistory(adminCtx, &databroker.GetHistoryRequest{Type: recordType, Id: "session1"})
+		require.NoError(t, err)
+		changes := res.GetChanges()
+		require.Len(t, changes, 2, "only the last changes should be kept")
+
+		var s session.Session
+		require.NoError(t, changes[0].GetRecord().GetData().UnmarshalTo(&s))
+		assert.Equal(t, "user2", s.GetUserId())
+		assert.Equal(t, "authenticate", changes[0].GetService())
+		assert.Equal(t, "10.0.0.1:5443", changes[0].GetPeer())
+		assert.Equal(t, r2.GetVersion(), changes[1].GetPreviousVersion())
+		assert.NotNil(t, changes[1].GetRecord().GetDeletedAt())
+
+		types, err := srv.GetTypes(ctx, nil)
+		require.NoError(t, err)
+		assert.NotContains(t, types.GetTypes(), historyTypePrefix+recordType, "history should never be synced")
+	})
+	t.Run("retention", func(t *testing.T) {
+		srv := newServer(newServerConfig(WithSecret(secret), WithHistorySize(2), WithHistoryRetention(time.Hour)))
+		set(srv, "user1")
+
+		srv.collectHistoryGarbage(context.Background())
+		_, err := srv.GetHistory(adminCtx, &databroker.GetHistoryRequest{Type: recordType, Id: "session1"})
+		assert.NoError(t, err)
+
+		timeNow = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		defer func() { timeNow = time.Now }()
+		srv.collectHistoryGarbage(context.Background())
+		_, err = srv.GetHistory(adminCtx, &databroker.GetHistoryRequest{Type: recordType, Id: "session1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
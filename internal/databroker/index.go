@@ -0,0 +1,97 @@
+package databroker
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// recordIndexes are the fields of the record data which are indexed, by
+// record type, in every namespace. Indexes are named after the field they
+// index.
+var recordIndexes = map[string][]string{
+	"type.googleapis.com/session.Session": {"user_id", "idp_session_id"},
+}
+
+// GetAllByIndex returns the records of a type which have the given value for
+// an index, ordered by id, without reading every record of the type.
+func (srv *Server) GetAllByIndex(ctx context.Context, req *databroker.GetAllByIndexRequest) (*databroker.GetAllByIndexResponse, error) {
+	_, span := trace.StartSpan(ctx, "databroker.grpc.GetAllByIndex")
+	defer span.End()
+	srv.log.Info().
+		Str("type", req.GetType()).
+		Str("index", req.GetIndex()).
+		Msg("get all by index")
+
+	db, err := srv.getIndexedDB(req.GetType())
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s records have no indexes", req.GetType())
+	}
+
+	records, err := db.GetAllByIndex(ctx, req.GetIndex(), req.GetValue())
+	if errors.Is(err, storage.ErrUnknownIndex) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s records have no %s index", req.GetType(), req.GetIndex())
+	} else if err != nil {
+		return nil, err
+	}
+	return &databroker.GetAllByIndexResponse{
+		Records:       records,
+		ServerVersion: srv.version,
+	}, nil
+}
+
+// getIndexedDB returns the indexed storage of a record type, or nil if the
+// type has no indexes.
+func (srv *Server) getIndexedDB(recordType string) (*storage.IndexedBackend, error) {
+	fields, ok := recordIndexes[baseType(recordType)]
+	if !ok {
+		return nil, nil
+	}
+
+	srv.mu.RLock()
+	db := srv.indexed[recordType]
+	srv.mu.RUnlock()
+	if db != nil {
+		return db, nil
+	}
+
+	underlying, err := srv.getDB(recordType)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if db := srv.indexed[recordType]; db != nil {
+		return db, nil
+	}
+	indexes := make(map[string]storage.IndexFunc, len(fields))
+	for _, field := range fields {
+		indexes[field] = fieldIndexFunc(field)
+	}
+	db = storage.NewIndexedBackend(underlying, indexes)
+	srv.indexed[recordType] = db
+	return db, nil
+}
+
+// fieldIndexFunc returns an IndexFunc which indexes the values of the field
+// at the dotted path in the record data.
+func fieldIndexFunc(path string) storage.IndexFunc {
+	return func(data *anypb.Any) []string {
+		msg, err := data.UnmarshalNew()
+		if err != nil {
+			return nil
+		}
+		values, _ := getFieldValues(msg.ProtoReflect(), path)
+		return values
+	}
+}
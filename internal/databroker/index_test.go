@@ -0,0 +1,60 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestServer_GetAllByIndex(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(newServerConfig(WithSecret(cryptutil.NewKey())))
+	sessionType := "type.googleapis.com/session.Session"
+
+	set := func(id, userID string) {
+		any, _ := anypb.New(&session.Session{Id: id, UserId: userID})
+		_, err := srv.Set(ctx, &databroker.SetRequest{Type: sessionType, Id: id, Data: any})
+		require.NoError(t, err)
+	}
+	getAll := func(userID string) []string {
+		res, err := srv.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{Type: sessionType, Index: "user_id", Value: userID})
+		require.NoError(t, err)
+		assert.Equal(t, srv.version, res.GetServerVersion())
+		var ids []string
+		for _, record := range res.GetRecords() {
+			ids = append(ids, record.GetId())
+		}
+		return ids
+	}
+
+	set("session2", "user1")
+	set("session1", "user1")
+	set("session3", "user2")
+	assert.Equal(t, []string{"session1", "session2"}, getAll("user1"))
+
+	set("session2", "user2")
+	_, err := srv.Delete(ctx, &databroker.DeleteRequest{Type: sessionType, Id: "session3"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session1"}, getAll("user1"))
+	assert.Equal(t, []string{"session2"}, getAll("user2"))
+	assert.Empty(t, getAll("user3"))
+
+	t.Run("unknown index", func(t *testing.T) {
+		_, err := srv.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{Type: sessionType, Index: "id", Value: "session1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		userAny, _ := anypb.New(new(user.User))
+		_, err = srv.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{Type: userAny.GetTypeUrl(), Index: "id", Value: "user1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,441 @@
+package databroker
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// namespaceSeparator separates the namespace from the record type in the
+// types of namespaced records. Namespaces can't contain it.
+const namespaceSeparator = "#"
+
+// namespacedType returns the type a record of a namespace is stored as.
+func namespacedType(namespace, recordType string) string {
+	if namespace == "" {
+		return recordType
+	}
+	return namespace + namespaceSeparator + recordType
+}
+
+// baseType returns the record type of a namespaced type.
+func baseType(recordType string) string {
+	if i := strings.Index(recordType, namespaceSeparator); i >= 0 {
+		return recordType[i+1:]
+	}
+	return recordType
+}
+
+// typeNamespace returns the namespace of a namespaced type.
+func typeNamespace(recordType string) string {
+	if i := strings.Index(recordType, namespaceSeparator); i >= 0 {
+		return recordType[:i]
+	}
+	return ""
+}
+
+// A NamespacedServer scopes the records read and written by each request to
+// the namespace sent by the client, or to a default namespace. The records of
+// a namespace are stored as a separate record type, so they're invisible to
+// the other namespaces, and clients only ever see the record types they
+// asked for.
+type NamespacedServer struct {
+	underlying       databroker.DataBrokerServiceServer
+	defaultNamespace string
+}
+
+// NewNamespacedServer creates a new NamespacedServer. Requests without a
+// namespace use defaultNamespace. If it's empty, they use the default
+// namespace, which is the records stored without a namespace.
+func NewNamespacedServer(underlying databroker.DataBrokerServiceServer, defaultNamespace string) *NamespacedServer {
+	return &NamespacedServer{
+		underlying:       underlying,
+		defaultNamespace: defaultNamespace,
+	}
+}
+
+// namespace returns the namespace of a request.
+func (srv *NamespacedServer) namespace(ctx context.Context) (string, error) {
+	namespace, ok := grpcutil.NamespaceFromGRPCRequest(ctx)
+	if !ok {
+		return srv.defaultNamespace, nil
+	}
+	if strings.Contains(namespace, namespaceSeparator) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid namespace %q", namespace)
+	}
+	return namespace, nil
+}
+
+// scopedType returns the type the records of a type requested by a client
+// are stored as in the namespace of the request.
+func (srv *NamespacedServer) scopedType(ctx context.Context, recordType string) (string, error) {
+	if err := checkRecordType(recordType); err != nil {
+		return "", err
+	}
+	namespace, err := srv.namespace(ctx)
+	if err != nil {
+		return "", err
+	}
+	return namespacedType(namespace, recordType), nil
+}
+
+// checkRecordType returns an error if a record type sent by a client contains
+// a namespace, so clients can't reach the records of other namespaces.
+func checkRecordType(recordType string) error {
+	if strings.Contains(recordType, namespaceSeparator) {
+		return status.Errorf(codes.InvalidArgument, "invalid record type %q", recordType)
+	}
+	return nil
+}
+
+// Delete deletes a record.
+func (srv *NamespacedServer) Delete(ctx context.Context, req *databroker.DeleteRequest) (*emptypb.Empty, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	return srv.underlying.Delete(ctx, &databroker.DeleteRequest{
+		Type:            recordType,
+		Id:              req.GetId(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	})
+}
+
+// Get gets a record.
+func (srv *NamespacedServer) Get(ctx context.Context, req *databroker.GetRequest) (*databroker.GetResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.Get(ctx, &databroker.GetRequest{
+		Type: recordType,
+		Id:   req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.GetResponse{Record: withoutNamespace(res.GetRecord())}, nil
+}
+
+// GetAll gets all the records of a type.
+func (srv *NamespacedServer) GetAll(ctx context.Context, req *databroker.GetAllRequest) (*databroker.GetAllResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.GetAll(ctx, &databroker.GetAllRequest{
+		Type: recordType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.GetAllResponse{
+		Records:       withoutNamespaces(res.GetRecords()),
+		ServerVersion: res.GetServerVersion(),
+		RecordVersion: res.GetRecordVersion(),
+	}, nil
+}
+
+// GetAllByIndex gets all the records of a type with a value for an index.
+func (srv *NamespacedServer) GetAllByIndex(ctx context.Context, req *databroker.GetAllByIndexRequest) (*databroker.GetAllByIndexResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{
+		Type:  recordType,
+		Index: req.GetIndex(),
+		Value: req.GetValue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.GetAllByIndexResponse{
+		Records:       withoutNamespaces(res.GetRecords()),
+		ServerVersion: res.GetServerVersion(),
+	}, nil
+}
+
+// Set sets a record.
+func (srv *NamespacedServer) Set(ctx context.Context, req *databroker.SetRequest) (*databroker.SetResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.Set(ctx, &databroker.SetRequest{
+		Type:            recordType,
+		Id:              req.GetId(),
+		Data:            req.GetData(),
+		ExpectedVersion: req.GetExpectedVersion(),
+		CreateOnly:      req.GetCreateOnly(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.SetResponse{
+		Record:        withoutNamespace(res.GetRecord()),
+		ServerVersion: res.GetServerVersion(),
+	}, nil
+}
+
+// BatchSet sets several records of a type.
+func (srv *NamespacedServer) BatchSet(ctx context.Context, req *databroker.BatchSetRequest) (*databroker.BatchSetResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.BatchSet(ctx, &databroker.BatchSetRequest{
+		Type:    recordType,
+		Records: req.GetRecords(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.BatchSetResponse{
+		Records:       withoutNamespaces(res.GetRecords()),
+		ServerVersion: res.GetServerVersion(),
+	}, nil
+}
+
+// BatchGet gets several records of a type.
+func (srv *NamespacedServer) BatchGet(ctx context.Context, req *databroker.BatchGetRequest) (*databroker.BatchGetResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.BatchGet(ctx, &databroker.BatchGetRequest{
+		Type: recordType,
+		Ids:  req.GetIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.BatchGetResponse{
+		Records:       withoutNamespaces(res.GetRecords()),
+		ServerVersion: res.GetServerVersion(),
+	}, nil
+}
+
+// BatchDelete deletes several records of a type.
+func (srv *NamespacedServer) BatchDelete(ctx context.Context, req *databroker.BatchDeleteRequest) (*emptypb.Empty, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	return srv.underlying.BatchDelete(ctx, &databroker.BatchDeleteRequest{
+		Type: recordType,
+		Ids:  req.GetIds(),
+	})
+}
+
+// Query queries the records of a type.
+func (srv *NamespacedServer) Query(ctx context.Context, req *databroker.QueryRequest) (*databroker.QueryResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.Query(ctx, &databroker.QueryRequest{
+		Type:    recordType,
+		Filters: req.GetFilters(),
+		Cursor:  req.GetCursor(),
+		Limit:   req.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.QueryResponse{
+		Records:       withoutNamespaces(res.GetRecords()),
+		NextCursor:    res.GetNextCursor(),
+		ServerVersion: res.GetServerVersion(),
+	}, nil
+}
+
+// Sync streams the changes to the records of a type.
+func (srv *NamespacedServer) Sync(req *databroker.SyncRequest, stream databroker.DataBrokerService_SyncServer) error {
+	recordType, err := srv.scopedType(stream.Context(), req.GetType())
+	if err != nil {
+		return err
+	}
+	return srv.underlying.Sync(&databroker.SyncRequest{
+		ServerVersion: req.GetServerVersion(),
+		RecordVersion: req.GetRecordVersion(),
+		Type:          recordType,
+	}, namespacedSyncServer{stream})
+}
+
+// GetTypes returns the record types of the namespace.
+func (srv *NamespacedServer) GetTypes(ctx context.Context, req *emptypb.Empty) (*databroker.GetTypesResponse, error) {
+	namespace, err := srv.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.GetTypes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.GetTypesResponse{Types: typesInNamespace(namespace, res.GetTypes())}, nil
+}
+
+// SyncTypes streams the record types of the namespace.
+func (srv *NamespacedServer) SyncTypes(req *emptypb.Empty, stream databroker.DataBrokerService_SyncTypesServer) error {
+	namespace, err := srv.namespace(stream.Context())
+	if err != nil {
+		return err
+	}
+	return srv.underlying.SyncTypes(req, namespacedSyncTypesServer{
+		DataBrokerService_SyncTypesServer: stream,
+		namespace:                         namespace,
+	})
+}
+
+// Export exports the records of the namespace.
+func (srv *NamespacedServer) Export(ctx context.Context, req *databroker.ExportRequest) (*databroker.ExportResponse, error) {
+	namespace, err := srv.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recordTypes := req.GetTypes()
+	if len(recordTypes) == 0 {
+		recordTypes = defaultExportTypes
+	}
+	namespacedTypes := make([]string, len(recordTypes))
+	for i, recordType := range recordTypes {
+		if err := checkRecordType(recordType); err != nil {
+			return nil, err
+		}
+		namespacedTypes[i] = namespacedType(namespace, recordType)
+	}
+	res, err := srv.underlying.Export(ctx, &databroker.ExportRequest{Types: namespacedTypes})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.ExportResponse{
+		ServerVersion: res.GetServerVersion(),
+		ExportedAt:    res.GetExportedAt(),
+		Records:       withoutNamespaces(res.GetRecords()),
+	}, nil
+}
+
+// Import imports records into the namespace.
+func (srv *NamespacedServer) Import(ctx context.Context, req *databroker.ImportRequest) (*databroker.ImportResponse, error) {
+	namespace, err := srv.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*databroker.Record, len(req.GetRecords()))
+	for i, record := range req.GetRecords() {
+		if err := checkRecordType(record.GetType()); err != nil {
+			return nil, err
+		}
+		records[i] = withRecordType(record, namespacedType(namespace, record.GetType()))
+	}
+	return srv.underlying.Import(ctx, &databroker.ImportRequest{Records: records})
+}
+
+// GetHistory returns the last changes to a record of the namespace.
+func (srv *NamespacedServer) GetHistory(ctx context.Context, req *databroker.GetHistoryRequest) (*databroker.GetHistoryResponse, error) {
+	recordType, err := srv.scopedType(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := srv.underlying.GetHistory(ctx, &databroker.GetHistoryRequest{
+		Type: recordType,
+		Id:   req.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*databroker.RecordChange, len(res.GetChanges()))
+	for i, change := range res.GetChanges() {
+		changes[i] = &databroker.RecordChange{
+			Record:          withoutNamespace(change.GetRecord()),
+			PreviousVersion: change.GetPreviousVersion(),
+			Service:         change.GetService(),
+			Peer:            change.GetPeer(),
+		}
+	}
+	return &databroker.GetHistoryResponse{Changes: changes}, nil
+}
+
+type namespacedSyncServer struct {
+	databroker.DataBrokerService_SyncServer
+}
+
+func (stream namespacedSyncServer) Send(res *databroker.SyncResponse) error {
+	return stream.DataBrokerService_SyncServer.Send(&databroker.SyncResponse{
+		ServerVersion: res.GetServerVersion(),
+		Records:       withoutNamespaces(res.GetRecords()),
+	})
+}
+
+type namespacedSyncTypesServer struct {
+	databroker.DataBrokerService_SyncTypesServer
+	namespace string
+}
+
+func (stream namespacedSyncTypesServer) Send(res *databroker.GetTypesResponse) error {
+	return stream.DataBrokerService_SyncTypesServer.Send(&databroker.GetTypesResponse{
+		Types: typesInNamespace(stream.namespace, res.GetTypes()),
+	})
+}
+
+// typesInNamespace returns the record types of a namespace.
+func typesInNamespace(namespace string, recordTypes []string) []string {
+	var types []string
+	for _, recordType := range recordTypes {
+		if typeNamespace(recordType) == namespace {
+			types = append(types, baseType(recordType))
+		}
+	}
+	return types
+}
+
+// withoutNamespaces returns the records with their namespace removed from
+// their types. Records which aren't namespaced are returned as is.
+func withoutNamespaces(records []*databroker.Record) []*databroker.Record {
+	var out []*databroker.Record
+	for i, record := range records {
+		if typeNamespace(record.GetType()) == "" {
+			if out != nil {
+				out[i] = record
+			}
+			continue
+		}
+		if out == nil {
+			out = make([]*databroker.Record, len(records))
+			copy(out, records[:i])
+		}
+		out[i] = withoutNamespace(record)
+	}
+	if out == nil {
+		return records
+	}
+	return out
+}
+
+func withoutNamespace(record *databroker.Record) *databroker.Record {
+	if typeNamespace(record.GetType()) == "" {
+		return record
+	}
+	return withRecordType(record, baseType(record.GetType()))
+}
+
+// withRecordType returns a copy of the record with another type, so that the
+// records held by the storage aren't modified.
+func withRecordType(in *databroker.Record, recordType string) *databroker.Record {
+	return &databroker.Record{
+		Version:    in.GetVersion(),
+		Type:       recordType,
+		Id:         in.GetId(),
+		Data:       in.GetData(),
+		CreatedAt:  in.GetCreatedAt(),
+		ModifiedAt: in.GetModifiedAt(),
+		DeletedAt:  in.GetDeletedAt(),
+	}
+}
@@ -0,0 +1,130 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestNamespacedServer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	internalSrv := newServer(newServerConfig())
+	srv := NewNamespacedServer(internalSrv, "")
+	withNamespace := func(namespace string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(grpcutil.NamespaceMetadataKey, namespace))
+	}
+	set := func(ctx context.Context, name string) *anypb.Any {
+		data, _ := anypb.New(&user.User{Id: "user1", Name: name})
+		_, err := srv.Set(ctx, &databroker.SetRequest{Type: data.GetTypeUrl(), Id: "user1", Data: data})
+		require.NoError(t, err)
+		return data
+	}
+	userType := "type.googleapis.com/user.User"
+
+	set(ctx, "default")
+	staging := set(withNamespace("staging"), "staging")
+	set(withNamespace("prod"), "prod")
+
+	res, err := srv.Get(withNamespace("staging"), &databroker.GetRequest{Type: userType, Id: "user1"})
+	require.NoError(t, err)
+	assert.Equal(t, userType, res.GetRecord().GetType(), "namespaces should be invisible to clients")
+	assert.Equal(t, staging.GetValue(), res.GetRecord().GetData().GetValue())
+
+	all, err := srv.GetAll(ctx, &databroker.GetAllRequest{Type: userType})
+	require.NoError(t, err)
+	require.Len(t, all.GetRecords(), 1)
+	var u user.User
+	require.NoError(t, all.GetRecords()[0].GetData().UnmarshalTo(&u))
+	assert.Equal(t, "default", u.GetName())
+
+	types, err := srv.GetTypes(withNamespace("prod"), new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.Equal(t, []string{userType}, types.GetTypes())
+	types, err = srv.GetTypes(withNamespace("dev"), new(emptypb.Empty))
+	require.NoError(t, err)
+	assert.Empty(t, types.GetTypes())
+
+	_, err = internalSrv.Get(ctx, &databroker.GetRequest{Type: "prod#" + userType, Id: "user1"})
+	assert.NoError(t, err, "namespaced records should be stored as a separate type")
+
+	t.Run("sync", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(withNamespace("staging"))
+		defer cancel()
+		stream := &syncServerStream{ctx: ctx, responses: make(chan *databroker.SyncResponse)}
+		go func() { _ = srv.Sync(&databroker.SyncRequest{Type: userType}, stream) }()
+		<-stream.responses // server version
+		res := <-stream.responses
+		require.Len(t, res.GetRecords(), 1)
+		assert.Equal(t, userType, res.GetRecords()[0].GetType())
+		assert.Equal(t, staging.GetValue(), res.GetRecords()[0].GetData().GetValue())
+	})
+	t.Run("invalid namespace", func(t *testing.T) {
+		_, err := srv.Get(withNamespace("prod#staging"), &databroker.GetRequest{Type: userType, Id: "user1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("namespaced record type", func(t *testing.T) {
+		prodType := "prod" + namespaceSeparator + userType
+		for _, namespace := range []string{"", "staging"} {
+			ctx := ctx
+			if namespace != "" {
+				ctx = withNamespace(namespace)
+			}
+			_, err := srv.Get(ctx, &databroker.GetRequest{Type: prodType, Id: "user1"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "get should be rejected in %q", namespace)
+			_, err = srv.GetAll(ctx, &databroker.GetAllRequest{Type: prodType})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{Type: prodType})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.Set(ctx, &databroker.SetRequest{Type: prodType, Id: "user1", Data: staging})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.BatchSet(ctx, &databroker.BatchSetRequest{Type: prodType})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.BatchGet(ctx, &databroker.BatchGetRequest{Type: prodType, Ids: []string{"user1"}})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.Delete(ctx, &databroker.DeleteRequest{Type: prodType, Id: "user1"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.BatchDelete(ctx, &databroker.BatchDeleteRequest{Type: prodType, Ids: []string{"user1"}})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.Query(ctx, &databroker.QueryRequest{Type: prodType})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.GetHistory(ctx, &databroker.GetHistoryRequest{Type: prodType, Id: "user1"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.Export(ctx, &databroker.ExportRequest{Types: []string{prodType}})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			_, err = srv.Import(ctx, &databroker.ImportRequest{Records: []*databroker.Record{{Type: prodType, Id: "user1", Data: staging}}})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			// the stream is canceled so a sync which isn't rejected returns
+			syncCtx, syncCancel := context.WithCancel(ctx)
+			syncCancel()
+			err = srv.Sync(&databroker.SyncRequest{Type: prodType}, &syncServerStream{ctx: syncCtx})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+
+		res, err := internalSrv.Get(ctx, &databroker.GetRequest{Type: prodType, Id: "user1"})
+		require.NoError(t, err)
+		var u user.User
+		require.NoError(t, res.GetRecord().GetData().UnmarshalTo(&u))
+		assert.Equal(t, "prod", u.GetName(), "the records of other namespaces shouldn't change")
+	})
+	t.Run("default namespace", func(t *testing.T) {
+		srv := NewNamespacedServer(internalSrv, "prod")
+		res, err := srv.Get(ctx, &databroker.GetRequest{Type: userType, Id: "user1"})
+		require.NoError(t, err)
+		var u user.User
+		require.NoError(t, res.GetRecord().GetData().UnmarshalTo(&u))
+		assert.Equal(t, "prod", u.GetName())
+	})
+}
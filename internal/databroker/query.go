@@ -0,0 +1,191 @@
+package databroker
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+const (
+	// queryDefaultLimit is the number of records returned by Query when no
+	// limit is set.
+	queryDefaultLimit = 100
+	// queryMaxLimit is the maximum number of records returned by Query.
+	queryMaxLimit = 1000
+)
+
+// Query returns a page of the records of a type which match all the filters,
+// ordered by id. Deleted records are never returned.
+func (srv *Server) Query(ctx context.Context, req *databroker.QueryRequest) (*databroker.QueryResponse, error) {
+	_, span := trace.StartSpan(ctx, "databroker.grpc.Query")
+	defer span.End()
+	srv.log.Info().
+		Str("type", req.GetType()).
+		Int("filters", len(req.GetFilters())).
+		Str("cursor", req.GetCursor()).
+		Int64("limit", req.GetLimit()).
+		Msg("query")
+
+	limit := req.GetLimit()
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = queryDefaultLimit
+	case limit > queryMaxLimit:
+		limit = queryMaxLimit
+	}
+	after, err := decodeQueryCursor(req.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+
+	all, err := srv.queryCandidates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].GetId() < all[j].GetId()
+	})
+
+	res := &databroker.QueryResponse{ServerVersion: srv.version}
+	for _, record := range all {
+		if record.GetDeletedAt() != nil || record.GetId() <= after {
+			continue
+		}
+		ok, err := matchQueryFilters(record.GetData(), req.GetFilters())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if !ok {
+			continue
+		}
+		if int64(len(res.Records)) == limit {
+			res.NextCursor = encodeQueryCursor(res.Records[len(res.Records)-1].GetId())
+			break
+		}
+		res.Records = append(res.Records, record)
+	}
+	return res, nil
+}
+
+// queryCandidates returns the records which may match a query. If a filter is
+// on an indexed field, only the records with the filter value are read.
+func (srv *Server) queryCandidates(ctx context.Context, req *databroker.QueryRequest) ([]*databroker.Record, error) {
+	indexed, err := srv.getIndexedDB(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	if indexed != nil {
+		for _, filter := range req.GetFilters() {
+			if indexed.HasIndex(filter.GetField()) {
+				return indexed.GetAllByIndex(ctx, filter.GetField(), filter.GetValue())
+			}
+		}
+	}
+
+	db, err := srv.getDB(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	return db.GetAll(ctx)
+}
+
+// The cursor is the id of the last record of the previous page, encoded so
+// that clients treat it as opaque.
+func encodeQueryCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+func decodeQueryCursor(cursor string) (string, error) {
+	id, err := base64.RawURLEncoding.DecodeString(cursor)
+	return string(id), err
+}
+
+// matchQueryFilters returns true if the record data matches all the filters.
+func matchQueryFilters(data *anypb.Any, filters []*databroker.QueryFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+	msg, err := data.UnmarshalNew()
+	if err != nil {
+		return false, fmt.Errorf("unknown record data type %s", data.GetTypeUrl())
+	}
+	for _, filter := range filters {
+		values, err := getFieldValues(msg.ProtoReflect(), filter.GetField())
+		if err != nil {
+			return false, err
+		}
+		matched := false
+		for _, value := range values {
+			if value == filter.GetValue() {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getFieldValues returns the values of the field at the dotted path in msg as
+// strings. Repeated fields return each of their values. Enums are returned as
+// the names of their values.
+func getFieldValues(msg protoreflect.Message, path string) ([]string, error) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %s in %s", path, msg.Descriptor().FullName())
+		}
+		if fd.IsMap() {
+			return nil, fmt.Errorf("map field %s can't be queried", path)
+		}
+
+		if i < len(names)-1 {
+			if fd.Kind() != protoreflect.MessageKind || fd.IsList() {
+				return nil, fmt.Errorf("field %s has no field %s", strings.Join(names[:i+1], "."), names[i+1])
+			}
+			msg = msg.Get(fd).Message()
+			continue
+		}
+
+		if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+			return nil, fmt.Errorf("message field %s can't be queried", path)
+		}
+		if fd.IsList() {
+			list := msg.Get(fd).List()
+			values := make([]string, list.Len())
+			for j := range values {
+				values[j] = formatFieldValue(fd, list.Get(j))
+			}
+			return values, nil
+		}
+		return []string{formatFieldValue(fd, msg.Get(fd))}, nil
+	}
+	return nil, fmt.Errorf("invalid field %s", path)
+}
+
+func formatFieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return fmt.Sprint(v.Enum())
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	}
+	return v.String()
+}
@@ -0,0 +1,109 @@
+package databroker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestServer_Query(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(newServerConfig())
+
+	var sessionType string
+	for i := 0; i < 5; i++ {
+		for _, userID := range []string{"user1", "user2"} {
+			s := &session.Session{
+				Id:      fmt.Sprintf("%s-session%d", userID, i),
+				UserId:  userID,
+				IdToken: &session.IDToken{Issuer: "https://" + userID + ".example.com"},
+			}
+			any, _ := anypb.New(s)
+			sessionType = any.GetTypeUrl()
+			_, err := srv.Set(ctx, &databroker.SetRequest{Type: sessionType, Id: s.GetId(), Data: any})
+			require.NoError(t, err)
+		}
+	}
+	_, err := srv.Delete(ctx, &databroker.DeleteRequest{Type: sessionType, Id: "user1-session0"})
+	require.NoError(t, err)
+	u := &user.User{Id: "user1", Email: "user1@example.com"}
+	userAny, _ := anypb.New(u)
+	_, err = srv.Set(ctx, &databroker.SetRequest{Type: userAny.GetTypeUrl(), Id: u.GetId(), Data: userAny})
+	require.NoError(t, err)
+
+	query := func(req *databroker.QueryRequest) (ids []string, nextCursor string) {
+		res, err := srv.Query(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, srv.version, res.GetServerVersion())
+		for _, record := range res.GetRecords() {
+			ids = append(ids, record.GetId())
+		}
+		return ids, res.GetNextCursor()
+	}
+
+	t.Run("filters", func(t *testing.T) {
+		ids, cursor := query(&databroker.QueryRequest{
+			Type:    sessionType,
+			Filters: []*databroker.QueryFilter{{Field: "user_id", Value: "user1"}},
+		})
+		assert.Equal(t, []string{"user1-session1", "user1-session2", "user1-session3", "user1-session4"}, ids,
+			"deleted records should not be returned")
+		assert.Empty(t, cursor)
+
+		ids, _ = query(&databroker.QueryRequest{
+			Type: sessionType,
+			Filters: []*databroker.QueryFilter{
+				{Field: "id_token.issuer", Value: "https://user2.example.com"},
+				{Field: "id", Value: "user2-session3"},
+			},
+		})
+		assert.Equal(t, []string{"user2-session3"}, ids)
+
+		ids, _ = query(&databroker.QueryRequest{
+			Type:    userAny.GetTypeUrl(),
+			Filters: []*databroker.QueryFilter{{Field: "email", Value: "user2@example.com"}},
+		})
+		assert.Empty(t, ids)
+	})
+	t.Run("pagination", func(t *testing.T) {
+		var all []string
+		var pages int
+		req := &databroker.QueryRequest{Type: sessionType, Limit: 4}
+		for {
+			ids, cursor := query(req)
+			all = append(all, ids...)
+			pages++
+			if cursor == "" {
+				break
+			}
+			req.Cursor = cursor
+		}
+		assert.Equal(t, 3, pages)
+		assert.Len(t, all, 9)
+		assert.True(t, sort.StringsAreSorted(all), "records should be ordered by id")
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, req := range []*databroker.QueryRequest{
+			{Type: sessionType, Limit: -1},
+			{Type: sessionType, Cursor: "not a cursor"},
+			{Type: sessionType, Filters: []*databroker.QueryFilter{{Field: "unknown", Value: "x"}}},
+			{Type: sessionType, Filters: []*databroker.QueryFilter{{Field: "id_token", Value: "x"}}},
+			{Type: sessionType, Filters: []*databroker.QueryFilter{{Field: "user_id.id", Value: "x"}}},
+			{Type: sessionType, Filters: []*databroker.QueryFilter{{Field: "claims", Value: "x"}}},
+		} {
+			_, err := srv.Query(ctx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v", req)
+		}
+	})
+}
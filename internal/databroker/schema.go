@@ -0,0 +1,121 @@
+package databroker
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/config"
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/device"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/impersonation"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
+	"github.com/pomerium/pomerium/pkg/grpc/kiosk"
+	"github.com/pomerium/pomerium/pkg/grpc/samlassertion"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+
+	// register the record types which are only decoded
+	_ "github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+)
+
+// recordSchemas are the record types which can be written when record
+// validation is enabled, by type URL, and the validation of their data. The
+// data has been decoded before it's validated, and types without a validation
+// only need to be decoded.
+var recordSchemas = map[string]func(id string, msg proto.Message) error{
+	"type.googleapis.com/session.Session": func(id string, msg proto.Message) error {
+		s := msg.(*session.Session)
+		if err := validateRecordID(id, s); err != nil {
+			return err
+		}
+		if s.GetUserId() == "" {
+			return errors.New("user_id is required")
+		}
+		return nil
+	},
+	"type.googleapis.com/user.User": func(id string, msg proto.Message) error {
+		return validateRecordID(id, msg.(*user.User))
+	},
+	"type.googleapis.com/directory.User": func(id string, msg proto.Message) error {
+		return validateRecordID(id, msg.(*directory.User))
+	},
+	"type.googleapis.com/directory.Group": func(id string, msg proto.Message) error {
+		return validateRecordID(id, msg.(*directory.Group))
+	},
+	"type.googleapis.com/impersonation.Grant": func(id string, msg proto.Message) error {
+		g := msg.(*impersonation.Grant)
+		if err := validateRecordID(id, g); err != nil {
+			return err
+		}
+		if g.GetUserId() == "" {
+			return errors.New("user_id is required")
+		}
+		return nil
+	},
+	"type.googleapis.com/iplist.IPList": func(id string, msg proto.Message) error {
+		l := msg.(*iplist.IPList)
+		if err := validateRecordID(id, l); err != nil {
+			return err
+		}
+		return l.Validate()
+	},
+	"type.googleapis.com/kiosk.Device": func(id string, msg proto.Message) error {
+		return validateRecordID(id, msg.(*kiosk.Device))
+	},
+	"type.googleapis.com/device.Authorization": func(id string, msg proto.Message) error {
+		return validateRecordID(id, msg.(*device.Authorization))
+	},
+	"type.googleapis.com/samlassertion.Assertion": func(id string, msg proto.Message) error {
+		return validateRecordID(id, msg.(*samlassertion.Assertion))
+	},
+	"type.googleapis.com/pomerium.config.Route": func(id string, msg proto.Message) error {
+		policy, err := config.NewPolicyFromProto(msg.(*configpb.Route))
+		if err != nil {
+			return err
+		}
+		return policy.Validate()
+	},
+	"type.googleapis.com/upstreamtoken.Token":    nil,
+	"type.googleapis.com/pomerium.config.Config": nil,
+}
+
+// validateRecord returns an InvalidArgument error if the record type isn't
+// one of the recordSchemas, or its data can't be decoded or is invalid.
+func validateRecord(req *databroker.SetRequest) error {
+	recordType := baseType(req.GetType())
+	validate, ok := recordSchemas[recordType]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown record type %s", req.GetType())
+	}
+	if req.GetData().GetTypeUrl() != recordType {
+		return status.Errorf(codes.InvalidArgument, "%s record data has the wrong type %s",
+			req.GetType(), req.GetData().GetTypeUrl())
+	}
+	msg, err := req.GetData().UnmarshalNew()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s record data: %v", req.GetType(), err)
+	}
+	if validate == nil {
+		return nil
+	}
+	if err := validate(req.GetId(), msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s record %s: %v", req.GetType(), req.GetId(), err)
+	}
+	return nil
+}
+
+func validateRecordID(id string, msg interface{ GetId() string }) error {
+	if id == "" {
+		return errors.New("id is required")
+	}
+	if msg.GetId() != id {
+		return fmt.Errorf("id %q doesn't match the record id", msg.GetId())
+	}
+	return nil
+}
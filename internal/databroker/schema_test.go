@@ -0,0 +1,72 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestServer_SetValidation(t *testing.T) {
+	ctx := context.Background()
+	newRequest := func(id string, msg proto.Message) *databroker.SetRequest {
+		data, _ := anypb.New(msg)
+		return &databroker.SetRequest{Type: data.GetTypeUrl(), Id: id, Data: data}
+	}
+	wrongType := newRequest("1", &user.User{Id: "1"})
+	wrongType.Type = "type.googleapis.com/session.Session"
+	undecodable := newRequest("1", &user.User{Id: "1"})
+	undecodable.Data.Value = []byte("not a user")
+
+	for _, tt := range []struct {
+		name    string
+		req     *databroker.SetRequest
+		wantErr bool
+	}{
+		{"session", newRequest("s1", &session.Session{Id: "s1", UserId: "u1"}), false},
+		{"user", newRequest("u1", &user.User{Id: "u1"}), false},
+		{"directory user", newRequest("u1", &directory.User{Id: "u1"}), false},
+		{"directory group", newRequest("g1", &directory.Group{Id: "g1"}), false},
+		{"upstream token", newRequest("t1", &upstreamtoken.Token{}), false},
+		{"route", newRequest("r1", &configpb.Route{From: "https://from.example.com", To: "https://to.example.com"}), false},
+		{"invalid route", newRequest("r1", &configpb.Route{From: "https://from.example.com"}), true},
+		{"unknown type", newRequest("1", wrapperspb.String("value")), true},
+		{"wrong data type", wrongType, true},
+		{"undecodable data", undecodable, true},
+		{"missing id", newRequest("", &user.User{}), true},
+		{"mismatched id", newRequest("u1", &user.User{Id: "u2"}), true},
+		{"session without user", newRequest("s1", &session.Session{Id: "s1"}), true},
+		{"invalid ip list", newRequest("Office", &iplist.IPList{Id: "Office"}), true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newServer(newServerConfig(WithRecordValidation(true)))
+			_, err := srv.Set(ctx, tt.req)
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				_, err = srv.Get(ctx, &databroker.GetRequest{Type: tt.req.GetType(), Id: tt.req.GetId()})
+				assert.Equal(t, codes.NotFound, status.Code(err), "invalid records should not be stored")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		srv := newServer(newServerConfig())
+		_, err := srv.Set(ctx, newRequest("1", wrapperspb.String("value")))
+		assert.NoError(t, err)
+	})
+}
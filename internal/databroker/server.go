@@ -20,13 +20,18 @@ import (
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/signal"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/internal/telemetry/trace"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/storage/etcd"
 	"github.com/pomerium/pomerium/pkg/storage/inmemory"
 	"github.com/pomerium/pomerium/pkg/storage/redis"
 )
 
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
 const (
 	recordTypeServerVersion = "server_version"
 	serverVersionKey        = "version"
@@ -42,6 +47,19 @@ type Server struct {
 	mu           sync.RWMutex
 	byType       map[string]storage.Backend
 	onTypechange *signal.Signal
+	// reencrypted is the set of record types which no longer have records
+	// encrypted with a previous key.
+	reencrypted map[string]bool
+	// feeds push the records written through this server to Sync streams.
+	feeds map[string]*recordFeed
+	// history holds the history of the records of each type.
+	history map[string]storage.Backend
+	// indexed maintains the indexes of the record types in recordIndexes.
+	indexed map[string]*storage.IndexedBackend
+
+	// snapshotMu is held for writing while records are exported, to block
+	// changes until the snapshot is complete.
+	snapshotMu sync.RWMutex
 }
 
 // New creates a new server.
@@ -54,33 +72,130 @@ func New(options ...ServerOption) *Server {
 
 		byType:       make(map[string]storage.Backend),
 		onTypechange: signal.New(),
+		reencrypted:  make(map[string]bool),
+		feeds:        make(map[string]*recordFeed),
+		history:      make(map[string]storage.Backend),
+		indexed:      make(map[string]*storage.IndexedBackend),
 	}
 	srv.initVersion()
 
 	go func() {
-		ticker := time.NewTicker(cfg.deletePermanentlyAfter / 2)
+		ticker := time.NewTicker(cfg.gcInterval())
 		defer ticker.Stop()
 
 		for range ticker.C {
-			var recordTypes []string
-			srv.mu.RLock()
-			for recordType := range srv.byType {
-				recordTypes = append(recordTypes, recordType)
-			}
-			srv.mu.RUnlock()
-
-			for _, recordType := range recordTypes {
-				db, err := srv.getDB(recordType)
-				if err != nil {
-					continue
-				}
-				db.ClearDeleted(context.Background(), time.Now().Add(-cfg.deletePermanentlyAfter))
-			}
+			srv.collectGarbage(context.Background())
 		}
 	}()
 	return srv
 }
 
+// collectGarbage permanently deletes records which were deleted longer than
+// deletePermanentlyAfter ago, and deletes records whose TTL expired. Expired
+// records are deleted like any other record, so syncers are notified. Records
+// encrypted with a previous encryption key are re-encrypted, and the history
+// of records which haven't changed within the history retention is deleted.
+func (srv *Server) collectGarbage(ctx context.Context) {
+	recordTypes := make(map[string]struct{})
+	srv.mu.RLock()
+	for recordType := range srv.byType {
+		recordTypes[recordType] = struct{}{}
+	}
+	srv.mu.RUnlock()
+	// records stored in an external backend may have a TTL before any have
+	// been accessed by this server
+	for recordType := range srv.cfg.recordTTLs {
+		recordTypes[recordType] = struct{}{}
+	}
+
+	for recordType := range recordTypes {
+		db, err := srv.getDB(recordType)
+		if err != nil {
+			continue
+		}
+		// records are cleared through the indexed storage, so its indexes
+		// are rebuilt
+		if indexed, err := srv.getIndexedDB(recordType); err == nil && indexed != nil {
+			indexed.ClearDeleted(ctx, timeNow().Add(-srv.cfg.deletePermanentlyAfter))
+		} else {
+			db.ClearDeleted(ctx, timeNow().Add(-srv.cfg.deletePermanentlyAfter))
+		}
+		if ttl, ok := srv.cfg.recordTTLs[baseType(recordType)]; ok {
+			srv.deleteExpired(ctx, recordType, db, ttl)
+		}
+		srv.reencrypt(ctx, recordType, db)
+	}
+	srv.collectHistoryGarbage(ctx)
+}
+
+// reencrypt re-encrypts the records of a type which were encrypted with a
+// previous encryption key. Once none are left, the type isn't checked again,
+// since new records are always encrypted with the current key.
+func (srv *Server) reencrypt(ctx context.Context, recordType string, db storage.Backend) {
+	if len(srv.cfg.encryptionKeys) < 2 {
+		return
+	}
+	srv.mu.RLock()
+	done := srv.reencrypted[recordType]
+	srv.mu.RUnlock()
+	if done {
+		return
+	}
+
+	srv.snapshotMu.RLock()
+	count, err := storage.Reencrypt(ctx, db)
+	srv.snapshotMu.RUnlock()
+	if count > 0 {
+		srv.log.Info().Str("type", recordType).Int("count", count).Msg("re-encrypted records with the current encryption key")
+	}
+	if err != nil {
+		srv.log.Error().Err(err).Str("type", recordType).Msg("failed to re-encrypt records")
+		return
+	}
+
+	srv.mu.Lock()
+	srv.reencrypted[recordType] = true
+	srv.mu.Unlock()
+}
+
+// deleteExpired deletes the records of a type which haven't been modified
+// within the ttl.
+func (srv *Server) deleteExpired(ctx context.Context, recordType string, db storage.Backend, ttl time.Duration) {
+	records, err := db.GetAll(ctx)
+	if err != nil {
+		srv.log.Error().Err(err).Str("type", recordType).Msg("failed to list records for garbage collection")
+		return
+	}
+
+	cutoff := timeNow().Add(-ttl)
+	var reclaimed int64
+	for _, record := range records {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		modifiedAt, err := ptypes.Timestamp(record.GetModifiedAt())
+		if err != nil || !modifiedAt.Before(cutoff) {
+			continue
+		}
+
+		srv.snapshotMu.RLock()
+		_, err = srv.write(ctx, recordType, db, record.GetId(), func() error {
+			return db.Delete(ctx, record.GetId())
+		})
+		srv.snapshotMu.RUnlock()
+		if err != nil {
+			srv.log.Error().Err(err).Str("type", recordType).Str("id", record.GetId()).Msg("failed to delete expired record")
+			continue
+		}
+		reclaimed++
+	}
+
+	if reclaimed > 0 {
+		srv.log.Info().Str("type", recordType).Int64("count", reclaimed).Msg("deleted expired records")
+		metrics.RecordStorageRecordsReclaimed(ctx, recordType, reclaimed)
+	}
+}
+
 func (srv *Server) initVersion() {
 	dbServerVersion, err := srv.getDB(recordTypeServerVersion)
 	if err != nil {
@@ -118,7 +233,18 @@ func (srv *Server) Delete(ctx context.Context, req *databroker.DeleteRequest) (*
 		return nil, err
 	}
 
-	if err := db.Delete(ctx, req.GetId()); err != nil {
+	srv.snapshotMu.RLock()
+	defer srv.snapshotMu.RUnlock()
+	_, err = srv.write(ctx, req.GetType(), db, req.GetId(), func() error {
+		if req.GetExpectedVersion() != "" {
+			current, err := db.Get(ctx, req.GetId())
+			if err != nil || current.GetDeletedAt() != nil || current.GetVersion() != req.GetExpectedVersion() {
+				return status.Errorf(codes.Aborted, "record %s has changed", req.GetId())
+			}
+		}
+		return db.Delete(ctx, req.GetId())
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -197,14 +323,36 @@ func (srv *Server) Set(ctx context.Context, req *databroker.SetRequest) (*databr
 		Str("id", req.GetId()).
 		Msg("set")
 
+	if srv.cfg.recordValidation {
+		if err := validateRecord(req); err != nil {
+			return nil, err
+		}
+	}
+
 	db, err := srv.getDB(req.GetType())
 	if err != nil {
 		return nil, err
 	}
-	if err := db.Put(ctx, req.GetId(), req.GetData()); err != nil {
-		return nil, err
-	}
-	record, err := db.Get(ctx, req.GetId())
+
+	srv.snapshotMu.RLock()
+	defer srv.snapshotMu.RUnlock()
+	record, err := srv.write(ctx, req.GetType(), db, req.GetId(), func() error {
+		// the version is checked while the record type's writes are
+		// serialized, so no other write can happen in between
+		if req.GetExpectedVersion() != "" {
+			current, err := db.Get(ctx, req.GetId())
+			if err != nil || current.GetDeletedAt() != nil || current.GetVersion() != req.GetExpectedVersion() {
+				return status.Errorf(codes.Aborted, "record %s has changed", req.GetId())
+			}
+		}
+		if req.GetCreateOnly() {
+			current, err := db.Get(ctx, req.GetId())
+			if err == nil && current.GetDeletedAt() == nil {
+				return status.Errorf(codes.AlreadyExists, "record %s already exists", req.GetId())
+			}
+		}
+		return db.Put(ctx, req.GetId(), req.GetData())
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +362,108 @@ func (srv *Server) Set(ctx context.Context, req *databroker.SetRequest) (*databr
 	}, nil
 }
 
+// BatchSet updates or adds several records of a type in a single storage
+// transaction.
+func (srv *Server) BatchSet(ctx context.Context, req *databroker.BatchSetRequest) (*databroker.BatchSetResponse, error) {
+	_, span := trace.StartSpan(ctx, "databroker.grpc.BatchSet")
+	defer span.End()
+	srv.log.Info().
+		Str("type", req.GetType()).
+		Int("records", len(req.GetRecords())).
+		Msg("batch set")
+
+	if srv.cfg.recordValidation {
+		for _, record := range req.GetRecords() {
+			err := validateRecord(&databroker.SetRequest{
+				Type: req.GetType(),
+				Id:   record.GetId(),
+				Data: record.GetData(),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	db, err := srv.getDB(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(req.GetRecords()))
+	for i, record := range req.GetRecords() {
+		ids[i] = record.GetId()
+	}
+
+	srv.snapshotMu.RLock()
+	defer srv.snapshotMu.RUnlock()
+	records, err := srv.writeMany(ctx, req.GetType(), db, ids, func() error {
+		return storage.PutMany(ctx, db, req.GetRecords())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &databroker.BatchSetResponse{
+		Records:       records,
+		ServerVersion: srv.version,
+	}, nil
+}
+
+// BatchGet gets several records of a type. Records which don't exist are
+// left out of the response.
+func (srv *Server) BatchGet(ctx context.Context, req *databroker.BatchGetRequest) (*databroker.BatchGetResponse, error) {
+	_, span := trace.StartSpan(ctx, "databroker.grpc.BatchGet")
+	defer span.End()
+	srv.log.Info().
+		Str("type", req.GetType()).
+		Int("ids", len(req.GetIds())).
+		Msg("batch get")
+
+	db, err := srv.getDB(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*databroker.Record, 0, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		record, err := db.Get(ctx, id)
+		if err != nil || record.DeletedAt != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return &databroker.BatchGetResponse{
+		Records:       records,
+		ServerVersion: srv.version,
+	}, nil
+}
+
+// BatchDelete deletes several records of a type in a single storage
+// transaction.
+func (srv *Server) BatchDelete(ctx context.Context, req *databroker.BatchDeleteRequest) (*empty.Empty, error) {
+	_, span := trace.StartSpan(ctx, "databroker.grpc.BatchDelete")
+	defer span.End()
+	srv.log.Info().
+		Str("type", req.GetType()).
+		Int("ids", len(req.GetIds())).
+		Msg("batch delete")
+
+	db, err := srv.getDB(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	srv.snapshotMu.RLock()
+	defer srv.snapshotMu.RUnlock()
+	_, err = srv.writeMany(ctx, req.GetType(), db, req.GetIds(), func() error {
+		return storage.DeleteMany(ctx, db, req.GetIds())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
 func (srv *Server) doSync(ctx context.Context, recordVersion *string, db storage.Backend, stream databroker.DataBrokerService_SyncServer) error {
 	updated, err := db.List(ctx, *recordVersion)
 	if err != nil {
@@ -241,7 +491,8 @@ func (srv *Server) doSync(ctx context.Context, recordVersion *string, db storage
 	return nil
 }
 
-// Sync streams updates for the given record type.
+// Sync streams updates for the given record type. Records written through
+// this server are pushed to the stream as soon as they're written.
 func (srv *Server) Sync(req *databroker.SyncRequest, stream databroker.DataBrokerService_SyncServer) error {
 	_, span := trace.StartSpan(stream.Context(), "databroker.grpc.Sync")
 	defer span.End()
@@ -270,19 +521,51 @@ func (srv *Server) Sync(req *databroker.SyncRequest, stream databroker.DataBroke
 	}
 
 	ctx := stream.Context()
+	sub := srv.getFeed(req.GetType()).subscribe()
+	defer srv.getFeed(req.GetType()).unsubscribe(sub)
 	ch := db.Watch(ctx)
 
+	// catchUp lists the records changed since the last one sent, including
+	// the ones which weren't pushed.
+	catchUp := func() error {
+		sub.takeLagged()
+		return srv.doSync(ctx, &recordVersion, db, stream)
+	}
+
 	// Do first sync, so we won't missed anything.
-	if err := srv.doSync(ctx, &recordVersion, db, stream); err != nil {
+	if err := catchUp(); err != nil {
 		return err
 	}
 
-	for range ch {
-		if err := srv.doSync(ctx, &recordVersion, db, stream); err != nil {
-			return err
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := catchUp(); err != nil {
+				return err
+			}
+		case record := <-sub.records:
+			if !srv.pushesRecords() || sub.takeLagged() {
+				if err := catchUp(); err != nil {
+					return err
+				}
+				continue
+			}
+			// records up to the record version were already sent
+			if record.GetVersion() <= recordVersion {
+				continue
+			}
+			recordVersion = record.GetVersion()
+			if err := stream.Send(&databroker.SyncResponse{
+				ServerVersion: srv.version,
+				Records:       []*databroker.Record{record},
+			}); err != nil {
+				return err
+			}
 		}
 	}
-	return nil
 }
 
 // GetTypes returns all the known record types.
@@ -360,7 +643,9 @@ func (srv *Server) getDB(recordType string) (storage.Backend, error) {
 func (srv *Server) newDB(recordType string) (db storage.Backend, err error) {
 	switch srv.cfg.storageType {
 	case config.StorageInMemoryName:
-		return inmemory.NewDB(recordType, srv.cfg.btreeDegree), nil
+		db = inmemory.NewDB(recordType, srv.cfg.btreeDegree,
+			inmemory.WithMaxRecords(srv.cfg.storageMaxRecords),
+			inmemory.WithMaxBytes(srv.cfg.storageMaxBytes))
 	case config.StorageRedisName:
 		db, err = redis.New(
 			srv.cfg.storageConnectionString,
@@ -371,14 +656,34 @@ func (srv *Server) newDB(recordType string) (db storage.Backend, err error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to create new redis storage: %w", err)
 		}
+	case config.StorageEtcdName:
+		db, err = etcd.New(
+			srv.cfg.storageConnectionString,
+			recordType,
+			int64(srv.cfg.deletePermanentlyAfter.Seconds()),
+			etcd.WithTLSConfig(srv.cfg.storageTLSConfig),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new etcd storage: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("unsupported storage type: %s", srv.cfg.storageType)
 	}
-	if srv.cfg.secret != nil {
-		db, err = storage.NewEncryptedBackend(srv.cfg.secret, db)
+	keys := srv.cfg.encryptionKeys
+	if len(keys) == 0 && srv.cfg.secret != nil {
+		keys = [][]byte{srv.cfg.secret}
+	}
+	// the in-memory storage is never encrypted
+	if srv.cfg.storageType != config.StorageInMemoryName && len(keys) > 0 {
+		db, err = storage.NewEncryptedBackend(keys[0], db, keys[1:]...)
 		if err != nil {
 			return nil, err
 		}
 	}
+	// data is compressed before it's encrypted, since ciphertext doesn't
+	// compress
+	if srv.cfg.compressionThreshold > 0 {
+		db = storage.NewCompressedBackend(db, srv.cfg.compressionThreshold)
+	}
 	return db, nil
 }
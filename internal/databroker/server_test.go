@@ -2,21 +2,27 @@ package databroker
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/signal"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/storage/inmemory"
 )
 
 func newServer(cfg *serverConfig) *Server {
@@ -27,6 +33,10 @@ func newServer(cfg *serverConfig) *Server {
 
 		byType:       make(map[string]storage.Backend),
 		onTypechange: signal.New(),
+		reencrypted:  make(map[string]bool),
+		feeds:        make(map[string]*recordFeed),
+		history:      make(map[string]storage.Backend),
+		indexed:      make(map[string]*storage.IndexedBackend),
 	}
 }
 
@@ -113,6 +123,109 @@ func TestServer_Get(t *testing.T) {
 	})
 }
 
+func TestServer_Set_expectedVersion(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(newServerConfig())
+
+	any, err := anypb.New(&session.Session{Id: "1"})
+	require.NoError(t, err)
+	res, err := srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any})
+	require.NoError(t, err)
+	version := res.GetRecord().GetVersion()
+
+	res, err = srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any, ExpectedVersion: version})
+	require.NoError(t, err)
+	assert.NotEqual(t, version, res.GetRecord().GetVersion())
+
+	_, err = srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any, ExpectedVersion: version})
+	assert.Equal(t, codes.Aborted, status.Code(err), "stale versions should be rejected")
+
+	_, err = srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "2", Data: any, ExpectedVersion: version})
+	assert.Equal(t, codes.Aborted, status.Code(err), "missing records should be rejected")
+}
+
+func TestServer_Set_createOnly(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(newServerConfig())
+
+	any, err := anypb.New(&session.Session{Id: "1"})
+	require.NoError(t, err)
+	_, err = srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any, CreateOnly: true})
+	require.NoError(t, err)
+
+	_, err = srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any, CreateOnly: true})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "existing records should be rejected")
+
+	_, err = srv.Delete(ctx, &databroker.DeleteRequest{Type: any.TypeUrl, Id: "1"})
+	require.NoError(t, err)
+	_, err = srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any, CreateOnly: true})
+	assert.NoError(t, err, "deleted records should be recreated")
+}
+
+func TestServer_Delete_expectedVersion(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(newServerConfig())
+
+	any, err := anypb.New(&session.Session{Id: "1"})
+	require.NoError(t, err)
+	res, err := srv.Set(ctx, &databroker.SetRequest{Type: any.TypeUrl, Id: "1", Data: any})
+	require.NoError(t, err)
+	version := res.GetRecord().GetVersion()
+
+	_, err = srv.Delete(ctx, &databroker.DeleteRequest{Type: any.TypeUrl, Id: "1", ExpectedVersion: "stale"})
+	assert.Equal(t, codes.Aborted, status.Code(err), "stale versions should be rejected")
+
+	_, err = srv.Delete(ctx, &databroker.DeleteRequest{Type: any.TypeUrl, Id: "1", ExpectedVersion: version})
+	require.NoError(t, err)
+	_, err = srv.Delete(ctx, &databroker.DeleteRequest{Type: any.TypeUrl, Id: "1", ExpectedVersion: version})
+	assert.Equal(t, codes.Aborted, status.Code(err), "deleted records should only be consumed once")
+}
+
+func TestServer_Batch(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(newServerConfig())
+
+	var records []*databroker.Record
+	for _, id := range []string{"1", "2", "3"} {
+		any, err := anypb.New(&session.Session{Id: id, UserId: "user1"})
+		require.NoError(t, err)
+		records = append(records, &databroker.Record{Id: id, Data: any})
+	}
+	recordType := records[0].GetData().GetTypeUrl()
+
+	res, err := srv.BatchSet(ctx, &databroker.BatchSetRequest{Type: recordType, Records: records})
+	require.NoError(t, err)
+	require.Len(t, res.GetRecords(), 3)
+	for i, record := range res.GetRecords() {
+		assert.Equal(t, records[i].GetId(), record.GetId())
+		assert.NotEmpty(t, record.GetVersion())
+	}
+
+	_, err = srv.BatchDelete(ctx, &databroker.BatchDeleteRequest{Type: recordType, Ids: []string{"1", "3"}})
+	require.NoError(t, err)
+
+	got, err := srv.BatchGet(ctx, &databroker.BatchGetRequest{Type: recordType, Ids: []string{"1", "2", "3", "4"}})
+	require.NoError(t, err)
+	require.Len(t, got.GetRecords(), 1, "deleted and missing records should be left out")
+	assert.Equal(t, "2", got.GetRecords()[0].GetId())
+	assert.Equal(t, srv.version, got.GetServerVersion())
+
+	t.Run("validation", func(t *testing.T) {
+		cfg := newServerConfig()
+		cfg.recordValidation = true
+		srv := newServer(cfg)
+		any, err := anypb.New(&session.Session{Id: "1"})
+		require.NoError(t, err)
+		_, err = srv.BatchSet(ctx, &databroker.BatchSetRequest{
+			Type:    recordType,
+			Records: append(records[:1:1], &databroker.Record{Id: "1", Data: any}),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = srv.Get(ctx, &databroker.GetRequest{Type: recordType, Id: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err), "no records should be written when one is invalid")
+	})
+}
+
 func TestServer_GetAll(t *testing.T) {
 	cfg := newServerConfig()
 	t.Run("ignore deleted", func(t *testing.T) {
@@ -139,3 +252,170 @@ func TestServer_GetAll(t *testing.T) {
 		assert.Len(t, res.GetRecords(), 0)
 	})
 }
+
+func TestServer_collectGarbage(t *testing.T) {
+	ctx := context.Background()
+	sessionType := "type.googleapis.com/session.Session"
+	userType := "type.googleapis.com/user.User"
+	srv := newServer(newServerConfig(WithRecordTTLs(map[string]time.Duration{
+		sessionType: time.Hour,
+	})))
+
+	set := func(recordType, id string) {
+		_, err := srv.Set(ctx, &databroker.SetRequest{
+			Type: recordType,
+			Id:   id,
+			Data: &anypb.Any{TypeUrl: recordType},
+		})
+		require.NoError(t, err)
+	}
+	set(sessionType, "expired")
+	set(userType, "user")
+	time.Sleep(time.Millisecond)
+	cutoff := time.Now()
+	time.Sleep(time.Millisecond)
+	set(sessionType, "active")
+
+	// an hour after the cutoff, only the records modified before it expired
+	defer func(now func() time.Time) { timeNow = now }(timeNow)
+	timeNow = func() time.Time { return cutoff.Add(time.Hour) }
+	srv.collectGarbage(ctx)
+
+	res, err := srv.GetAll(ctx, &databroker.GetAllRequest{Type: sessionType})
+	require.NoError(t, err)
+	if assert.Len(t, res.GetRecords(), 1) {
+		assert.Equal(t, "active", res.GetRecords()[0].GetId())
+	}
+
+	// expired records are deleted, so syncers receive the deletion
+	db, err := srv.getDB(sessionType)
+	require.NoError(t, err)
+	record, err := db.Get(ctx, "expired")
+	require.NoError(t, err)
+	assert.NotNil(t, record.GetDeletedAt())
+
+	res, err = srv.GetAll(ctx, &databroker.GetAllRequest{Type: userType})
+	require.NoError(t, err)
+	assert.Len(t, res.GetRecords(), 1, "records without a ttl should be kept")
+}
+
+func TestServer_reencrypt(t *testing.T) {
+	ctx := context.Background()
+	recordType := "type.googleapis.com/session.Session"
+	oldKey, newKey := cryptutil.NewKey(), cryptutil.NewKey()
+	underlying := inmemory.NewDB(recordType, DefaultBTreeDegree)
+
+	old, err := storage.NewEncryptedBackend(oldKey, underlying)
+	require.NoError(t, err)
+	require.NoError(t, old.Put(ctx, "session", &anypb.Any{TypeUrl: recordType}))
+
+	srv := newServer(newServerConfig(WithEncryptionKeys(newKey, oldKey)))
+	srv.byType[recordType], err = storage.NewEncryptedBackend(newKey, underlying, oldKey)
+	require.NoError(t, err)
+	srv.collectGarbage(ctx)
+	assert.True(t, srv.reencrypted[recordType])
+
+	current, err := storage.NewEncryptedBackend(newKey, underlying)
+	require.NoError(t, err)
+	record, err := current.Get(ctx, "session")
+	if assert.NoError(t, err, "records should be re-encrypted with the current key") {
+		assert.Equal(t, recordType, record.GetType())
+	}
+}
+
+func TestServerConfig_gcInterval(t *testing.T) {
+	assert.Equal(t, 30*time.Minute, newServerConfig().gcInterval())
+	assert.Equal(t, 5*time.Minute, newServerConfig(WithRecordTTLs(map[string]time.Duration{
+		"a": 10 * time.Minute,
+		"b": 2 * time.Hour,
+	})).gcInterval())
+	assert.Equal(t, time.Second, newServerConfig(WithRecordTTLs(map[string]time.Duration{
+		"a": time.Millisecond,
+	})).gcInterval())
+}
+
+type syncServerStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *databroker.SyncResponse
+}
+
+func (stream *syncServerStream) Context() context.Context { return stream.ctx }
+
+func (stream *syncServerStream) Send(res *databroker.SyncResponse) error {
+	select {
+	case <-stream.ctx.Done():
+		return stream.ctx.Err()
+	case stream.responses <- res:
+	}
+	return nil
+}
+
+func TestServer_Sync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recordType := "type.googleapis.com/session.Session"
+	srv := newServer(newServerConfig())
+
+	stream := &syncServerStream{ctx: ctx, responses: make(chan *databroker.SyncResponse)}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.Sync(&databroker.SyncRequest{Type: recordType}, stream)
+	}()
+	res := <-stream.responses
+	assert.Equal(t, srv.version, res.GetServerVersion())
+	assert.Empty(t, res.GetRecords())
+
+	var eg errgroup.Group
+	for i := 0; i < 2*feedBufferSize; i++ {
+		id := fmt.Sprint(i)
+		eg.Go(func() error {
+			_, err := srv.Set(ctx, &databroker.SetRequest{
+				Type: recordType,
+				Id:   id,
+				Data: &anypb.Any{TypeUrl: recordType},
+			})
+			return err
+		})
+	}
+
+	// every record is received once, in the order of the versions
+	var lastVersion string
+	seen := make(map[string]bool)
+	for len(seen) < 2*feedBufferSize {
+		select {
+		case res := <-stream.responses:
+			for _, record := range res.GetRecords() {
+				assert.Greater(t, record.GetVersion(), lastVersion)
+				assert.False(t, seen[record.GetId()], "record %s was sent twice", record.GetId())
+				lastVersion = record.GetVersion()
+				seen[record.GetId()] = true
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %d records, got %d", 2*feedBufferSize, len(seen))
+		}
+	}
+	require.NoError(t, eg.Wait())
+
+	cancel()
+	assert.NoError(t, <-errc)
+}
+
+func TestRecordFeed(t *testing.T) {
+	feed := newRecordFeed()
+	sub := feed.subscribe()
+	feed.mu.Lock()
+	for i := 0; i <= feedBufferSize; i++ {
+		feed.publish(&databroker.Record{Id: fmt.Sprint(i)})
+	}
+	feed.mu.Unlock()
+	assert.Len(t, sub.records, feedBufferSize)
+	assert.True(t, sub.takeLagged(), "the subscriber should lag once its buffer is full")
+	assert.False(t, sub.takeLagged())
+
+	feed.unsubscribe(sub)
+	feed.mu.Lock()
+	feed.publish(&databroker.Record{Id: "unsubscribed"})
+	feed.mu.Unlock()
+	assert.Len(t, sub.records, feedBufferSize)
+}
@@ -0,0 +1,398 @@
+// Package auth0 contains the Auth0 directory provider.
+//
+// Users' roles and organizations are read from the Management API with a
+// machine to machine application's client credentials, and become their
+// directory groups.
+package auth0
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/time/rate"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+)
+
+// Name is the provider name.
+const Name = "auth0"
+
+// See https://auth0.com/docs/policies/rate-limit-policy/management-api-endpoint-rate-limits
+const defaultQPS = 2
+
+// maxRetryWait is the longest the provider waits for a rate limit to reset.
+const maxRetryWait = time.Minute
+
+// errNotAvailable is returned by the API for organizations when the tenant
+// doesn't have them, or the application isn't allowed to read them.
+var errNotAvailable = errors.New("auth0: api not available")
+
+type config struct {
+	batchSize      int
+	httpClient     *http.Client
+	providerURL    *url.URL
+	serviceAccount *ServiceAccount
+	qps            float64
+}
+
+// An Option configures the Auth0 Provider.
+type Option func(cfg *config)
+
+// WithBatchSize sets the batch size option.
+func WithBatchSize(batchSize int) Option {
+	return func(cfg *config) {
+		cfg.batchSize = batchSize
+	}
+}
+
+// WithHTTPClient sets the http client option.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *config) {
+		cfg.httpClient = httpClient
+	}
+}
+
+// WithProviderURL sets the provider URL option, which is the URL of the
+// Auth0 tenant.
+func WithProviderURL(uri *url.URL) Option {
+	return func(cfg *config) {
+		cfg.providerURL = uri
+	}
+}
+
+// WithServiceAccount sets the service account option.
+func WithServiceAccount(serviceAccount *ServiceAccount) Option {
+	return func(cfg *config) {
+		cfg.serviceAccount = serviceAccount
+	}
+}
+
+// WithQPS sets the query per second option.
+func WithQPS(qps float64) Option {
+	return func(cfg *config) {
+		cfg.qps = qps
+	}
+}
+
+func getConfig(options ...Option) *config {
+	cfg := new(config)
+	WithBatchSize(100)(cfg)
+	WithHTTPClient(http.DefaultClient)(cfg)
+	WithQPS(defaultQPS)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+// A Provider is an Auth0 user group directory provider.
+type Provider struct {
+	cfg     *config
+	log     zerolog.Logger
+	limiter *rate.Limiter
+	// tokens are the Management API tokens, which are reused until they
+	// expire, since tenants can only get a limited number of them.
+	tokens oauth2.TokenSource
+}
+
+// New creates a new Provider.
+func New(options ...Option) *Provider {
+	cfg := getConfig(options...)
+	if cfg.qps == 0 {
+		cfg.qps = defaultQPS
+	}
+	p := &Provider{
+		cfg:     cfg,
+		log:     log.With().Str("service", "directory").Str("provider", Name).Logger(),
+		limiter: rate.NewLimiter(rate.Limit(cfg.qps), int(cfg.qps)+1),
+	}
+	if cfg.serviceAccount != nil && cfg.providerURL != nil {
+		cc := clientcredentials.Config{
+			ClientID:     cfg.serviceAccount.ClientID,
+			ClientSecret: cfg.serviceAccount.ClientSecret,
+			TokenURL:     cfg.providerURL.ResolveReference(&url.URL{Path: "/oauth/token"}).String(),
+			EndpointParams: url.Values{
+				"audience": {cfg.providerURL.ResolveReference(&url.URL{Path: "/api/v2/"}).String()},
+			},
+			AuthStyle: oauth2.AuthStyleInParams,
+		}
+		p.tokens = cc.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, cfg.httpClient))
+	}
+	return p
+}
+
+// UserGroups gets the directory user groups for Auth0. The groups are the
+// tenant's roles and organizations.
+//
+// https://auth0.com/docs/api/management/v2#!/Roles/get_role_user
+// https://auth0.com/docs/api/management/v2#!/Organizations/get_members
+func (p *Provider) UserGroups(ctx context.Context) ([]*directory.Group, []*directory.User, error) {
+	if p.cfg.serviceAccount == nil {
+		return nil, nil, fmt.Errorf("auth0: service account not defined")
+	}
+	if p.cfg.providerURL == nil {
+		return nil, nil, fmt.Errorf("auth0: provider url not defined")
+	}
+
+	p.log.Info().Msg("getting user groups")
+
+	roles, err := p.getRoles(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	organizations, err := p.getOrganizations(ctx)
+	if errors.Is(err, errNotAvailable) {
+		p.log.Warn().Err(err).Msg("skipping organizations")
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	userIDToGroups := map[string][]string{}
+	for _, role := range roles {
+		ids, err := p.getMemberIDs(ctx, "/api/v2/roles/"+url.PathEscape(role.Id)+"/users")
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, id := range ids {
+			userIDToGroups[id] = append(userIDToGroups[id], role.Id)
+		}
+	}
+	for _, org := range organizations {
+		ids, err := p.getMemberIDs(ctx, "/api/v2/organizations/"+url.PathEscape(org.Id)+"/members")
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, id := range ids {
+			userIDToGroups[id] = append(userIDToGroups[id], org.Id)
+		}
+	}
+
+	groups := append(roles, organizations...)
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Id < groups[j].Id
+	})
+
+	var users []*directory.User
+	for userID, groupIDs := range userIDToGroups {
+		sort.Strings(groupIDs)
+		users = append(users, &directory.User{
+			Id:       databroker.GetUserID(Name, userID),
+			GroupIds: groupIDs,
+		})
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+	return groups, users, nil
+}
+
+// getRoles gets all the roles, with page based pagination, since roles don't
+// support checkpoint pagination.
+func (p *Provider) getRoles(ctx context.Context) ([]*directory.Group, error) {
+	var groups []*directory.Group
+	for page := 0; ; page++ {
+		var out struct {
+			Roles []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"roles"`
+			Total int `json:"total"`
+		}
+		err := p.apiGet(ctx, "/api/v2/roles", url.Values{
+			"page":           {strconv.Itoa(page)},
+			"per_page":       {strconv.Itoa(p.cfg.batchSize)},
+			"include_totals": {"true"},
+		}, &out)
+		if err != nil {
+			return nil, fmt.Errorf("auth0: error querying for roles: %w", err)
+		}
+		for _, role := range out.Roles {
+			groups = append(groups, &directory.Group{Id: role.ID, Name: role.Name})
+		}
+		if len(out.Roles) == 0 || len(groups) >= out.Total {
+			return groups, nil
+		}
+	}
+}
+
+// getOrganizations gets all the organizations.
+func (p *Provider) getOrganizations(ctx context.Context) ([]*directory.Group, error) {
+	var groups []*directory.Group
+	from := ""
+	for {
+		var out struct {
+			Organizations []struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				DisplayName string `json:"display_name"`
+			} `json:"organizations"`
+			Next string `json:"next"`
+		}
+		err := p.apiGet(ctx, "/api/v2/organizations", p.checkpoint(from), &out)
+		if err != nil {
+			return nil, fmt.Errorf("auth0: error querying for organizations: %w", err)
+		}
+		for _, org := range out.Organizations {
+			name := org.DisplayName
+			if name == "" {
+				name = org.Name
+			}
+			groups = append(groups, &directory.Group{Id: org.ID, Name: name})
+		}
+		if out.Next == "" || len(out.Organizations) == 0 {
+			return groups, nil
+		}
+		from = out.Next
+	}
+}
+
+// getMemberIDs gets the ids of the users of a role or organization, with
+// checkpoint pagination, which unlike page based pagination isn't limited to
+// the first 1000 users.
+func (p *Provider) getMemberIDs(ctx context.Context, path string) ([]string, error) {
+	var ids []string
+	from := ""
+	for {
+		// roles have users and organizations have members
+		var out struct {
+			Users []struct {
+				UserID string `json:"user_id"`
+			} `json:"users"`
+			Members []struct {
+				UserID string `json:"user_id"`
+			} `json:"members"`
+			Next string `json:"next"`
+		}
+		if err := p.apiGet(ctx, path, p.checkpoint(from), &out); err != nil {
+			return nil, fmt.Errorf("auth0: error querying for members: %w", err)
+		}
+		for _, user := range out.Users {
+			ids = append(ids, user.UserID)
+		}
+		for _, member := range out.Members {
+			ids = append(ids, member.UserID)
+		}
+		if out.Next == "" || len(out.Users)+len(out.Members) == 0 {
+			return ids, nil
+		}
+		from = out.Next
+	}
+}
+
+func (p *Provider) checkpoint(from string) url.Values {
+	q := url.Values{"take": {strconv.Itoa(p.cfg.batchSize)}}
+	if from != "" {
+		q.Set("from", from)
+	}
+	return q
+}
+
+func (p *Provider) apiGet(ctx context.Context, path string, query url.Values, out interface{}) error {
+	uri := p.cfg.providerURL.ResolveReference(&url.URL{Path: path, RawQuery: query.Encode()}).String()
+	for {
+		if err := p.limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		token, err := p.tokens.Token()
+		if err != nil {
+			return fmt.Errorf("auth0: error getting management api token: %w", err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return fmt.Errorf("auth0: failed to create HTTP request: %w", err)
+		}
+		req.Header.Set("Accept", "application/json")
+		token.SetAuthHeader(req)
+
+		res, err := p.cfg.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case res.StatusCode == http.StatusTooManyRequests:
+			res.Body.Close()
+			if err := waitForReset(ctx, res.Header.Get("X-RateLimit-Reset")); err != nil {
+				return err
+			}
+			continue
+		case res.StatusCode == http.StatusForbidden || res.StatusCode == http.StatusNotFound:
+			res.Body.Close()
+			return fmt.Errorf("%w: %s", errNotAvailable, res.Status)
+		case res.StatusCode/100 != 2:
+			res.Body.Close()
+			return fmt.Errorf("auth0: error querying api: %s", res.Status)
+		}
+
+		err = json.NewDecoder(res.Body).Decode(out)
+		res.Body.Close()
+		return err
+	}
+}
+
+// waitForReset waits until the rate limit resets, which is a unix timestamp.
+func waitForReset(ctx context.Context, reset string) error {
+	wait := time.Second
+	if ts, err := strconv.ParseInt(reset, 10, 64); err == nil {
+		wait = time.Until(time.Unix(ts, 0))
+	}
+	if wait > maxRetryWait {
+		wait = maxRetryWait
+	}
+	if wait <= 0 {
+		return nil
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// A ServiceAccount is used by the Auth0 provider to query the Management API.
+// It's the client id and secret of a machine to machine application which is
+// authorized to read users, roles and organizations.
+type ServiceAccount struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// ParseServiceAccount parses the service account in the config options.
+func ParseServiceAccount(rawServiceAccount string) (*ServiceAccount, error) {
+	bs, err := base64.StdEncoding.DecodeString(rawServiceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceAccount ServiceAccount
+	err = json.Unmarshal(bs, &serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	if serviceAccount.ClientID == "" {
+		return nil, fmt.Errorf("client_id is required")
+	}
+	if serviceAccount.ClientSecret == "" {
+		return nil, fmt.Errorf("client_secret is required")
+	}
+
+	return &serviceAccount, nil
+}
@@ -0,0 +1,194 @@
+package auth0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+type M = map[string]interface{}
+
+type mockAuth0 struct {
+	srv           *httptest.Server
+	tokens        int32
+	rateLimited   int32
+	organizations bool
+}
+
+// page returns the items on a checkpoint page. The checkpoint is the index of
+// the first item.
+func page(r *http.Request, items []M) ([]M, string) {
+	from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+	take, _ := strconv.Atoi(r.URL.Query().Get("take"))
+	if from >= len(items) {
+		return []M{}, ""
+	}
+	end := from + take
+	if end >= len(items) {
+		return items[from:], ""
+	}
+	return items[from:end], strconv.Itoa(end)
+}
+
+func (m *mockAuth0) handler() http.Handler {
+	roles := []M{{"id": "rol_1", "name": "admin"}, {"id": "rol_2", "name": "developer"}}
+	roleUsers := map[string][]M{
+		"rol_1": {{"user_id": "auth0|1"}},
+		"rol_2": {{"user_id": "auth0|1"}, {"user_id": "auth0|2"}, {"user_id": "google-oauth2|3"}},
+	}
+	orgs := []M{{"id": "org_1", "name": "acme", "display_name": "ACME Corp"}, {"id": "org_2", "name": "globex"}}
+	orgMembers := map[string][]M{
+		"org_1": {{"user_id": "auth0|2"}},
+		"org_2": {},
+	}
+
+	r := chi.NewRouter()
+	r.Use(middleware.Logger)
+	r.Post("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&m.tokens, 1)
+		if r.FormValue("grant_type") != "client_credentials" ||
+			r.FormValue("client_id") != "CLIENTID" ||
+			r.FormValue("client_secret") != "CLIENTSECRET" ||
+			r.FormValue("audience") != m.srv.URL+"/api/v2/" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(M{
+			"access_token": "ACCESSTOKEN",
+			"token_type":   "Bearer",
+			"expires_in":   86400,
+		})
+	})
+	r.Route("/api/v2", func(r chi.Router) {
+		r.Use(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer ACCESSTOKEN" {
+					http.Error(w, "forbidden", http.StatusForbidden)
+					return
+				}
+				// the first request is rate limited
+				if atomic.CompareAndSwapInt32(&m.rateLimited, 0, 1) {
+					w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+					http.Error(w, "too many requests", http.StatusTooManyRequests)
+					return
+				}
+				next.ServeHTTP(w, r)
+			})
+		})
+		r.Get("/roles", func(w http.ResponseWriter, r *http.Request) {
+			p, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+			result := []M{}
+			for i := p * perPage; i < len(roles) && i < (p+1)*perPage; i++ {
+				result = append(result, roles[i])
+			}
+			_ = json.NewEncoder(w).Encode(M{"roles": result, "total": len(roles)})
+		})
+		r.Get("/roles/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+			users, next := page(r, roleUsers[chi.URLParam(r, "id")])
+			_ = json.NewEncoder(w).Encode(M{"users": users, "next": next})
+		})
+		r.Get("/organizations", func(w http.ResponseWriter, r *http.Request) {
+			if !m.organizations {
+				http.Error(w, "insufficient scope", http.StatusForbidden)
+				return
+			}
+			organizations, next := page(r, orgs)
+			_ = json.NewEncoder(w).Encode(M{"organizations": organizations, "next": next})
+		})
+		r.Get("/organizations/{id}/members", func(w http.ResponseWriter, r *http.Request) {
+			members, next := page(r, orgMembers[chi.URLParam(r, "id")])
+			_ = json.NewEncoder(w).Encode(M{"members": members, "next": next})
+		})
+	})
+	return r
+}
+
+func newTestProvider(t *testing.T, organizations bool) (*Provider, *mockAuth0) {
+	m := &mockAuth0{organizations: organizations}
+	var handler http.Handler
+	m.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(m.srv.Close)
+	handler = m.handler()
+
+	u, err := url.Parse(m.srv.URL)
+	require.NoError(t, err)
+	p := New(
+		WithProviderURL(u),
+		WithServiceAccount(&ServiceAccount{ClientID: "CLIENTID", ClientSecret: "CLIENTSECRET"}),
+		WithBatchSize(1),
+		WithQPS(1000),
+	)
+	return p, m
+}
+
+func TestProvider_UserGroups(t *testing.T) {
+	p, m := newTestProvider(t, true)
+
+	groups, users, err := p.UserGroups(context.Background())
+	require.NoError(t, err)
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "id": "auth0/auth0|1", "groupIds": ["rol_1", "rol_2"] },
+		{ "id": "auth0/auth0|2", "groupIds": ["org_1", "rol_2"] },
+		{ "id": "auth0/google-oauth2|3", "groupIds": ["rol_2"] }
+	]`, users)
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "id": "org_1", "name": "ACME Corp" },
+		{ "id": "org_2", "name": "globex" },
+		{ "id": "rol_1", "name": "admin" },
+		{ "id": "rol_2", "name": "developer" }
+	]`, groups)
+
+	_, _, err = p.UserGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&m.tokens), "the management api token should be reused")
+}
+
+func TestProvider_UserGroups_NoOrganizations(t *testing.T) {
+	p, _ := newTestProvider(t, false)
+
+	groups, users, err := p.UserGroups(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, groups, 2)
+	assert.Len(t, users, 3)
+}
+
+func TestParseServiceAccount(t *testing.T) {
+	tests := []struct {
+		name              string
+		rawServiceAccount string
+		serviceAccount    *ServiceAccount
+		wantErr           bool
+	}{
+		{"valid", "eyJjbGllbnRfaWQiOiAiaWQiLCAiY2xpZW50X3NlY3JldCI6ICJzZWNyZXQifQ==", &ServiceAccount{ClientID: "id", ClientSecret: "secret"}, false},
+		{"missing client secret", "eyJjbGllbnRfaWQiOiAiaWQifQ==", nil, true},
+		{"invalid base64", "%%%", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceAccount, err := ParseServiceAccount(tt.rawServiceAccount)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.serviceAccount, serviceAccount)
+		})
+	}
+}
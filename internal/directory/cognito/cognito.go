@@ -0,0 +1,373 @@
+// Package cognito contains the AWS Cognito directory provider.
+//
+// Users of the user pool are listed with the ListUsers API, and their groups
+// with the AdminListGroupsForUser API. Requests are signed with the AWS
+// credentials of the service account.
+package cognito
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sigv4"
+	"github.com/pomerium/pomerium/internal/version"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+)
+
+// Name is the provider name.
+const Name = "cognito"
+
+// See https://docs.aws.amazon.com/cognito/latest/developerguide/limits.html
+const (
+	defaultQPS = 10
+	// maxBatchSize is the most users or groups the API returns at once.
+	maxBatchSize = 60
+	// maxRetries is how many times throttled requests are retried.
+	maxRetries = 5
+)
+
+var errThrottled = errors.New("cognito: too many requests")
+
+type config struct {
+	batchSize      int
+	httpClient     *http.Client
+	providerURL    *url.URL
+	serviceAccount *ServiceAccount
+	qps            float64
+}
+
+// An Option configures the Cognito Provider.
+type Option func(cfg *config)
+
+// WithBatchSize sets the batch size option.
+func WithBatchSize(batchSize int) Option {
+	return func(cfg *config) {
+		cfg.batchSize = batchSize
+	}
+}
+
+// WithHTTPClient sets the http client option.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *config) {
+		cfg.httpClient = httpClient
+	}
+}
+
+// WithProviderURL sets the provider URL option, which is the issuer of the
+// user pool, i.e. https://cognito-idp.{region}.amazonaws.com/{user pool id}.
+func WithProviderURL(uri *url.URL) Option {
+	return func(cfg *config) {
+		cfg.providerURL = uri
+	}
+}
+
+// WithServiceAccount sets the service account option.
+func WithServiceAccount(serviceAccount *ServiceAccount) Option {
+	return func(cfg *config) {
+		cfg.serviceAccount = serviceAccount
+	}
+}
+
+// WithQPS sets the query per second option.
+func WithQPS(qps float64) Option {
+	return func(cfg *config) {
+		cfg.qps = qps
+	}
+}
+
+func getConfig(options ...Option) *config {
+	cfg := new(config)
+	WithBatchSize(maxBatchSize)(cfg)
+	WithHTTPClient(http.DefaultClient)(cfg)
+	WithQPS(defaultQPS)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+// A Provider is an AWS Cognito user group directory provider.
+type Provider struct {
+	cfg     *config
+	log     zerolog.Logger
+	limiter *rate.Limiter
+}
+
+// New creates a new Provider.
+func New(options ...Option) *Provider {
+	cfg := getConfig(options...)
+	if cfg.qps == 0 {
+		cfg.qps = defaultQPS
+	}
+	return &Provider{
+		cfg:     cfg,
+		log:     log.With().Str("service", "directory").Str("provider", Name).Logger(),
+		limiter: rate.NewLimiter(rate.Limit(cfg.qps), int(cfg.qps)+1),
+	}
+}
+
+// UserGroups gets the directory user groups for AWS Cognito. The groups are
+// identified by their name, which is what the cognito:groups claim of tokens
+// contains.
+//
+// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_ListUsers.html
+// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_AdminListGroupsForUser.html
+func (p *Provider) UserGroups(ctx context.Context) ([]*directory.Group, []*directory.User, error) {
+	if p.cfg.serviceAccount == nil {
+		return nil, nil, fmt.Errorf("cognito: service account not defined")
+	}
+	userPoolID, region, err := parseProviderURL(p.cfg.providerURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.log.Info().Msg("getting user groups")
+
+	type cognitoUser struct {
+		sub      string
+		username string
+	}
+	var cognitoUsers []cognitoUser
+	paginationToken := ""
+	for {
+		var out struct {
+			Users []struct {
+				Username   string `json:"Username"`
+				Attributes []struct {
+					Name  string `json:"Name"`
+					Value string `json:"Value"`
+				} `json:"Attributes"`
+			} `json:"Users"`
+			PaginationToken string `json:"PaginationToken"`
+		}
+		err := p.api(ctx, region, "ListUsers", map[string]interface{}{
+			"UserPoolId":      userPoolID,
+			"Limit":           p.cfg.batchSize,
+			"AttributesToGet": []string{"sub"},
+			"PaginationToken": paginationToken,
+		}, &out)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cognito: error querying for users: %w", err)
+		}
+		for _, u := range out.Users {
+			for _, attr := range u.Attributes {
+				if attr.Name == "sub" {
+					cognitoUsers = append(cognitoUsers, cognitoUser{sub: attr.Value, username: u.Username})
+				}
+			}
+		}
+		if out.PaginationToken == "" {
+			break
+		}
+		paginationToken = out.PaginationToken
+	}
+
+	groupLookup := map[string]*directory.Group{}
+	var users []*directory.User
+	for _, cu := range cognitoUsers {
+		groupNames, err := p.getUserGroupNames(ctx, region, userPoolID, cu.username)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, name := range groupNames {
+			groupLookup[name] = &directory.Group{Id: name, Name: name}
+		}
+		sort.Strings(groupNames)
+		users = append(users, &directory.User{
+			Id:       databroker.GetUserID(Name, cu.sub),
+			GroupIds: groupNames,
+		})
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+
+	var groups []*directory.Group
+	for _, group := range groupLookup {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Id < groups[j].Id
+	})
+	return groups, users, nil
+}
+
+func (p *Provider) getUserGroupNames(ctx context.Context, region, userPoolID, username string) ([]string, error) {
+	var names []string
+	nextToken := ""
+	for {
+		var out struct {
+			Groups []struct {
+				GroupName string `json:"GroupName"`
+			} `json:"Groups"`
+			NextToken string `json:"NextToken"`
+		}
+		err := p.api(ctx, region, "AdminListGroupsForUser", map[string]interface{}{
+			"UserPoolId": userPoolID,
+			"Username":   username,
+			"Limit":      p.cfg.batchSize,
+			"NextToken":  nextToken,
+		}, &out)
+		if err != nil {
+			return nil, fmt.Errorf("cognito: error querying for user groups: %w", err)
+		}
+		for _, group := range out.Groups {
+			names = append(names, group.GroupName)
+		}
+		if out.NextToken == "" {
+			return names, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+// api calls an action of the Cognito user pools API, retrying throttled
+// requests with exponential backoff.
+func (p *Provider) api(ctx context.Context, region, action string, in map[string]interface{}, out interface{}) error {
+	// empty pagination tokens aren't allowed
+	for k, v := range in {
+		if v == "" {
+			delete(in, k)
+		}
+	}
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	backoff := 100 * time.Millisecond
+	for i := 0; ; i++ {
+		err := p.apiOnce(ctx, region, action, body, out)
+		if !errors.Is(err, errThrottled) || i >= maxRetries {
+			return err
+		}
+
+		t := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (p *Provider) apiOnce(ctx context.Context, region, action string, body []byte, out interface{}) error {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	uri := p.cfg.providerURL.ResolveReference(&url.URL{Path: "/"})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("cognito: failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "AWSCognitoIdentityProviderService."+action)
+	req.Header.Set("User-Agent", version.UserAgent())
+	sigv4.Sign(req, body, p.cfg.serviceAccount.credentials(), region, "cognito-idp", time.Now())
+
+	res, err := p.cfg.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		bs, _ := ioutil.ReadAll(io.LimitReader(res.Body, 4096))
+		_ = json.Unmarshal(bs, &apiErr)
+		// the type may be prefixed with a namespace
+		errType := apiErr.Type[strings.LastIndex(apiErr.Type, "#")+1:]
+		if errType == "TooManyRequestsException" || errType == "ThrottlingException" {
+			return errThrottled
+		}
+		return fmt.Errorf("cognito: error calling %s: %s %s: %s", action, res.Status, errType, apiErr.Message)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// parseProviderURL returns the user pool ID and region of the user pool's
+// issuer URL. User pool IDs are prefixed with their region.
+func parseProviderURL(providerURL *url.URL) (userPoolID, region string, err error) {
+	if providerURL == nil {
+		return "", "", fmt.Errorf("cognito: provider url not defined")
+	}
+	userPoolID = strings.Trim(providerURL.Path, "/")
+	idx := strings.Index(userPoolID, "_")
+	if idx <= 0 || strings.Contains(userPoolID, "/") {
+		return "", "", fmt.Errorf("cognito: invalid provider url, expected https://cognito-idp.{region}.amazonaws.com/{user pool id}")
+	}
+	return userPoolID, userPoolID[:idx], nil
+}
+
+// A ServiceAccount is used by the Cognito provider to query the user pools
+// API. It's the credentials of an IAM user or role which is allowed the
+// cognito-idp:ListUsers and cognito-idp:AdminListGroupsForUser actions.
+type ServiceAccount struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SessionToken    string `json:"session_token,omitempty"`
+}
+
+func (sa *ServiceAccount) credentials() sigv4.Credentials {
+	return sigv4.Credentials{
+		AccessKeyID:     sa.AccessKeyID,
+		SecretAccessKey: sa.SecretAccessKey,
+		SessionToken:    sa.SessionToken,
+	}
+}
+
+// ParseServiceAccount parses the service account in the config options. If
+// it's empty, the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables are used instead.
+func ParseServiceAccount(rawServiceAccount string) (*ServiceAccount, error) {
+	var serviceAccount ServiceAccount
+	if rawServiceAccount == "" {
+		creds := sigv4.CredentialsFromEnv()
+		serviceAccount = ServiceAccount{
+			AccessKeyID:     creds.AccessKeyID,
+			SecretAccessKey: creds.SecretAccessKey,
+			SessionToken:    creds.SessionToken,
+		}
+	} else {
+		bs, err := base64.StdEncoding.DecodeString(rawServiceAccount)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bs, &serviceAccount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if serviceAccount.AccessKeyID == "" {
+		return nil, fmt.Errorf("access_key_id is required")
+	}
+	if serviceAccount.SecretAccessKey == "" {
+		return nil, fmt.Errorf("secret_access_key is required")
+	}
+
+	return &serviceAccount, nil
+}
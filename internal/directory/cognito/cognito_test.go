@@ -0,0 +1,171 @@
+package cognito
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+type M = map[string]interface{}
+
+func newMockCognito(t *testing.T) *httptest.Server {
+	users := []M{
+		{"Username": "alice", "Attributes": []M{{"Name": "sub", "Value": "sub-alice"}}},
+		{"Username": "bob", "Attributes": []M{{"Name": "sub", "Value": "sub-bob"}}},
+		{"Username": "carol", "Attributes": []M{{"Name": "sub", "Value": "sub-carol"}}},
+	}
+	userGroups := map[string][]M{
+		"alice": {{"GroupName": "admins"}, {"GroupName": "users"}},
+		"bob":   {{"GroupName": "users"}},
+	}
+
+	// page returns the items at the index in the token
+	page := func(items []M, token interface{}, limit interface{}) ([]M, string) {
+		from, _ := strconv.Atoi(token.(string))
+		end := from + int(limit.(float64))
+		if end >= len(items) {
+			return items[from:], ""
+		}
+		return items[from:end], strconv.Itoa(end)
+	}
+
+	var throttled int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=ACCESS_KEY_ID/") ||
+			!strings.Contains(r.Header.Get("Authorization"), "/us-east-1/cognito-idp/aws4_request") {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(M{"__type": "UnrecognizedClientException", "message": "invalid signature"})
+			return
+		}
+		// the first request is throttled
+		if atomic.CompareAndSwapInt32(&throttled, 0, 1) {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(M{"__type": "com.amazonaws#TooManyRequestsException", "message": "Rate exceeded"})
+			return
+		}
+
+		var in M
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(t, "us-east-1_TEST", in["UserPoolId"])
+		if in["PaginationToken"] == nil {
+			in["PaginationToken"] = "0"
+		}
+		if in["NextToken"] == nil {
+			in["NextToken"] = "0"
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		switch r.Header.Get("X-Amz-Target") {
+		case "AWSCognitoIdentityProviderService.ListUsers":
+			assert.Equal(t, []interface{}{"sub"}, in["AttributesToGet"])
+			users, next := page(users, in["PaginationToken"], in["Limit"])
+			_ = json.NewEncoder(w).Encode(M{"Users": users, "PaginationToken": next})
+		case "AWSCognitoIdentityProviderService.AdminListGroupsForUser":
+			groups, next := page(userGroups[in["Username"].(string)], in["NextToken"], in["Limit"])
+			_ = json.NewEncoder(w).Encode(M{"Groups": groups, "NextToken": next})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(M{"__type": "UnknownOperationException"})
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestProvider_UserGroups(t *testing.T) {
+	srv := newMockCognito(t)
+	providerURL, err := url.Parse(srv.URL + "/us-east-1_TEST")
+	require.NoError(t, err)
+
+	p := New(
+		WithProviderURL(providerURL),
+		WithServiceAccount(&ServiceAccount{AccessKeyID: "ACCESS_KEY_ID", SecretAccessKey: "SECRET_ACCESS_KEY"}),
+		WithBatchSize(1),
+		WithQPS(1000),
+	)
+	groups, users, err := p.UserGroups(context.Background())
+	require.NoError(t, err)
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "id": "cognito/sub-alice", "groupIds": ["admins", "users"] },
+		{ "id": "cognito/sub-bob", "groupIds": ["users"] },
+		{ "id": "cognito/sub-carol" }
+	]`, users)
+	testutil.AssertProtoJSONEqual(t, `[
+		{ "id": "admins", "name": "admins" },
+		{ "id": "users", "name": "users" }
+	]`, groups)
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		p := New(
+			WithProviderURL(providerURL),
+			WithServiceAccount(&ServiceAccount{AccessKeyID: "OTHER", SecretAccessKey: "SECRET_ACCESS_KEY"}),
+		)
+		_, _, err := p.UserGroups(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestParseProviderURL(t *testing.T) {
+	userPoolID, region, err := parseProviderURL(&url.URL{Scheme: "https", Host: "cognito-idp.eu-west-2.amazonaws.com", Path: "/eu-west-2_AbCdEf123"})
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-2_AbCdEf123", userPoolID)
+	assert.Equal(t, "eu-west-2", region)
+
+	_, _, err = parseProviderURL(&url.URL{Scheme: "https", Host: "cognito-idp.eu-west-2.amazonaws.com"})
+	assert.Error(t, err)
+	_, _, err = parseProviderURL(nil)
+	assert.Error(t, err)
+}
+
+func TestParseServiceAccount(t *testing.T) {
+	tests := []struct {
+		name              string
+		rawServiceAccount string
+		serviceAccount    *ServiceAccount
+		wantErr           bool
+	}{
+		{"valid", "eyJhY2Nlc3Nfa2V5X2lkIjogImlkIiwgInNlY3JldF9hY2Nlc3Nfa2V5IjogInNlY3JldCJ9", &ServiceAccount{AccessKeyID: "id", SecretAccessKey: "secret"}, false},
+		{"environment", "", &ServiceAccount{AccessKeyID: "env-id", SecretAccessKey: "env-secret", SessionToken: "env-token"}, false},
+		{"missing secret access key", "eyJhY2Nlc3Nfa2V5X2lkIjogImlkIn0=", nil, true},
+		{"invalid base64", "%%%", nil, true},
+	}
+	for k, v := range map[string]string{
+		"AWS_ACCESS_KEY_ID":     "env-id",
+		"AWS_SECRET_ACCESS_KEY": "env-secret",
+		"AWS_SESSION_TOKEN":     "env-token",
+	} {
+		k := k
+		old, ok := os.LookupEnv(k)
+		_ = os.Setenv(k, v)
+		t.Cleanup(func() {
+			if ok {
+				_ = os.Setenv(k, old)
+			} else {
+				_ = os.Unsetenv(k)
+			}
+		})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceAccount, err := ParseServiceAccount(tt.rawServiceAccount)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.serviceAccount, serviceAccount)
+		})
+	}
+}
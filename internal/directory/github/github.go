@@ -100,23 +100,17 @@ func (p *Provider) UserGroups(ctx context.Context) ([]*directory.Group, []*direc
 
 	var allGroups []*directory.Group
 	for _, orgSlug := range orgSlugs {
-		groups, err := p.listGroups(ctx, orgSlug)
+		teams, err := p.listTeams(ctx, orgSlug)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		for _, group := range groups {
-			userLogins, err := p.listTeamMembers(ctx, orgSlug, group.Name)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			for _, userLogin := range userLogins {
-				userLoginToGroups[userLogin] = append(userLoginToGroups[userLogin], group.Id)
+		for _, team := range teams {
+			for _, userLogin := range team.memberLogins {
+				userLoginToGroups[userLogin] = append(userLoginToGroups[userLogin], team.group.Id)
 			}
+			allGroups = append(allGroups, team.group)
 		}
-
-		allGroups = append(allGroups, groups...)
 	}
 
 	var users []*directory.User
@@ -158,60 +152,188 @@ func (p *Provider) listOrgs(ctx context.Context) (orgSlugs []string, err error)
 	return orgSlugs, nil
 }
 
-func (p *Provider) listGroups(ctx context.Context, orgSlug string) ([]*directory.Group, error) {
-	nextURL := p.cfg.url.ResolveReference(&url.URL{
-		Path: fmt.Sprintf("/orgs/%s/teams", orgSlug),
-	}).String()
+// graphQLPageSize is the number of teams, or team members, queried at a time.
+const graphQLPageSize = 100
+
+const listTeamsQuery = `query($org: String!, $first: Int!, $cursor: String) {
+  organization(login: $org) {
+    teams(first: $first, after: $cursor) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        databaseId
+        slug
+        members(first: $first) {
+          pageInfo { hasNextPage endCursor }
+          nodes { login }
+        }
+      }
+    }
+  }
+}`
+
+const listTeamMembersQuery = `query($org: String!, $team: String!, $first: Int!, $cursor: String) {
+  organization(login: $org) {
+    team(slug: $team) {
+      members(first: $first, after: $cursor) {
+        pageInfo { hasNextPage endCursor }
+        nodes { login }
+      }
+    }
+  }
+}`
+
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
 
-	var groups []*directory.Group
-	for nextURL != "" {
-		var results []struct {
-			ID   int    `json:"id"`
-			Slug string `json:"slug"`
+type graphQLTeamMembers struct {
+	PageInfo graphQLPageInfo `json:"pageInfo"`
+	Nodes    []struct {
+		Login string `json:"login"`
+	} `json:"nodes"`
+}
+
+// A team is a team of an organization, as a group named by the organization
+// and team slugs, e.g. "pomerium/core", and the logins of its members.
+type team struct {
+	group        *directory.Group
+	memberLogins []string
+}
+
+// listTeams lists the teams of an organization and their members with the
+// GraphQL API. The members of a team include the members of its child teams.
+func (p *Provider) listTeams(ctx context.Context, orgSlug string) ([]team, error) {
+	var teams []team
+	var cursor *string
+	for {
+		var result struct {
+			Organization struct {
+				Teams struct {
+					PageInfo graphQLPageInfo `json:"pageInfo"`
+					Nodes    []struct {
+						DatabaseID int                `json:"databaseId"`
+						Slug       string             `json:"slug"`
+						Members    graphQLTeamMembers `json:"members"`
+					} `json:"nodes"`
+				} `json:"teams"`
+			} `json:"organization"`
 		}
-		hdrs, err := p.api(ctx, "GET", nextURL, nil, &results)
+		err := p.graphQL(ctx, listTeamsQuery, map[string]interface{}{
+			"org":    orgSlug,
+			"first":  graphQLPageSize,
+			"cursor": cursor,
+		}, &result)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, result := range results {
-			groups = append(groups, &directory.Group{
-				Id:   strconv.Itoa(result.ID),
-				Name: result.Slug,
-			})
+		for _, node := range result.Organization.Teams.Nodes {
+			t := team{
+				group: &directory.Group{
+					Id:   strconv.Itoa(node.DatabaseID),
+					Name: orgSlug + "/" + node.Slug,
+				},
+			}
+			for _, member := range node.Members.Nodes {
+				t.memberLogins = append(t.memberLogins, member.Login)
+			}
+			// teams with more members than fit in a page are paginated separately
+			if node.Members.PageInfo.HasNextPage {
+				logins, err := p.listTeamMembers(ctx, orgSlug, node.Slug, node.Members.PageInfo.EndCursor)
+				if err != nil {
+					return nil, err
+				}
+				t.memberLogins = append(t.memberLogins, logins...)
+			}
+			teams = append(teams, t)
 		}
 
-		nextURL = getNextLink(hdrs)
+		pageInfo := result.Organization.Teams.PageInfo
+		if !pageInfo.HasNextPage {
+			return teams, nil
+		}
+		cursor = &pageInfo.EndCursor
 	}
-
-	return groups, nil
 }
 
-func (p *Provider) listTeamMembers(ctx context.Context, orgSlug, teamSlug string) (userLogins []string, err error) {
-	nextURL := p.cfg.url.ResolveReference(&url.URL{
-		Path: fmt.Sprintf("/orgs/%s/teams/%s/members", orgSlug, teamSlug),
-	}).String()
-
-	for nextURL != "" {
-		var results []struct {
-			Login string `json:"login"`
+// listTeamMembers lists the members of a team with the GraphQL API, starting
+// after the cursor.
+func (p *Provider) listTeamMembers(ctx context.Context, orgSlug, teamSlug, cursor string) (userLogins []string, err error) {
+	for {
+		var result struct {
+			Organization struct {
+				Team struct {
+					Members graphQLTeamMembers `json:"members"`
+				} `json:"team"`
+			} `json:"organization"`
 		}
-		hdrs, err := p.api(ctx, "GET", nextURL, nil, &results)
+		err := p.graphQL(ctx, listTeamMembersQuery, map[string]interface{}{
+			"org":    orgSlug,
+			"team":   teamSlug,
+			"first":  graphQLPageSize,
+			"cursor": cursor,
+		}, &result)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, result := range results {
-			userLogins = append(userLogins, result.Login)
+		members := result.Organization.Team.Members
+		for _, member := range members.Nodes {
+			userLogins = append(userLogins, member.Login)
 		}
+		if !members.PageInfo.HasNextPage {
+			return userLogins, nil
+		}
+		cursor = members.PageInfo.EndCursor
+	}
+}
 
-		nextURL = getNextLink(hdrs)
+// graphQL runs a query with the GraphQL API, and decodes its data into out.
+func (p *Provider) graphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	graphQLURL := p.cfg.url.ResolveReference(&url.URL{
+		Path: "/graphql",
+	}).String()
+
+	var res struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	req, err := newRequest(ctx, "POST", graphQLURL, map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	// the GraphQL API only accepts the token as a bearer token
+	req.Header.Set("Authorization", "bearer "+p.cfg.serviceAccount.PersonalAccessToken)
+	if _, err := p.do(req, &res); err != nil {
+		return err
+	}
+	if len(res.Errors) > 0 {
+		return fmt.Errorf("github: error from GraphQL API: %s", res.Errors[0].Message)
 	}
 
-	return userLogins, err
+	err = json.Unmarshal(res.Data, out)
+	if err != nil {
+		return fmt.Errorf("github: failed to decode GraphQL data: %w", err)
+	}
+	return nil
 }
 
 func (p *Provider) api(ctx context.Context, method string, apiURL string, in, out interface{}) (http.Header, error) {
+	req, err := newRequest(ctx, method, apiURL, in)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(p.cfg.serviceAccount.Username, p.cfg.serviceAccount.PersonalAccessToken)
+	return p.do(req, out)
+}
+
+func newRequest(ctx context.Context, method string, apiURL string, in interface{}) (*http.Request, error) {
 	var body io.Reader
 	if in != nil {
 		bs, err := json.Marshal(in)
@@ -224,8 +346,10 @@ func (p *Provider) api(ctx context.Context, method string, apiURL string, in, ou
 	if err != nil {
 		return nil, fmt.Errorf("github: failed to create http request: %w", err)
 	}
-	req.SetBasicAuth(p.cfg.serviceAccount.Username, p.cfg.serviceAccount.PersonalAccessToken)
+	return req, nil
+}
 
+func (p *Provider) do(req *http.Request, out interface{}) (http.Header, error) {
 	res, err := p.cfg.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("github: failed to make http request: %w", err)
@@ -22,7 +22,11 @@ func newMockAPI(t *testing.T, srv *httptest.Server) http.Handler {
 	r.Use(middleware.Logger)
 	r.Use(func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !assert.Equal(t, "Basic YWJjOnh5eg==", r.Header.Get("Authorization")) {
+			expect := "Basic YWJjOnh5eg=="
+			if r.URL.Path == "/graphql" {
+				expect = "bearer xyz"
+			}
+			if !assert.Equal(t, expect, r.Header.Get("Authorization")) {
 				http.Error(w, "forbidden", http.StatusForbidden)
 				return
 			}
@@ -35,45 +39,62 @@ func newMockAPI(t *testing.T, srv *httptest.Server) http.Handler {
 			{"login": "org2"},
 		})
 	})
-	r.Get("/orgs/{org_id}/teams", func(w http.ResponseWriter, r *http.Request) {
-		teams := map[string][]M{
-			"org1": {
-				{"slug": "team1", "id": 1},
-				{"slug": "team2", "id": 2},
-			},
-			"org2": {
-				{"slug": "team3", "id": 3},
-				{"slug": "team4", "id": 4},
-			},
+	members := func(hasNextPage bool, logins ...string) M {
+		var nodes []M
+		for _, login := range logins {
+			nodes = append(nodes, M{"login": login})
 		}
-		orgID := chi.URLParam(r, "org_id")
-		json.NewEncoder(w).Encode(teams[orgID])
-	})
-	r.Get("/orgs/{org_id}/teams/{team_id}/members", func(w http.ResponseWriter, r *http.Request) {
-		members := map[string]map[string][]M{
-			"org1": {
-				"team1": {
-					{"login": "user1"},
-					{"login": "user2"},
-				},
-				"team2": {
-					{"login": "user1"},
+		return M{
+			"pageInfo": M{"hasNextPage": hasNextPage, "endCursor": "MEMBERS_CURSOR"},
+			"nodes":    nodes,
+		}
+	}
+	r.Post("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Org    string  `json:"org"`
+				Team   string  `json:"team"`
+				First  int     `json:"first"`
+				Cursor *string `json:"cursor"`
+			} `json:"variables"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, 100, req.Variables.First)
+
+		var org M
+		switch {
+		case req.Variables.Team != "":
+			assert.Equal(t, "MEMBERS_CURSOR", *req.Variables.Cursor)
+			org = M{"team": M{"members": members(false, "user5")}}
+		case req.Variables.Org == "org1" && req.Variables.Cursor == nil:
+			org = M{"teams": M{
+				"pageInfo": M{"hasNextPage": true, "endCursor": "TEAMS_CURSOR"},
+				"nodes": []M{
+					{"databaseId": 1, "slug": "team1", "members": members(true, "user1", "user2")},
 				},
-			},
-			"org2": {
-				"team3": {
-					{"login": "user1"},
-					{"login": "user2"},
-					{"login": "user3"},
+			}}
+		case req.Variables.Org == "org1":
+			assert.Equal(t, "TEAMS_CURSOR", *req.Variables.Cursor)
+			org = M{"teams": M{
+				"pageInfo": M{"hasNextPage": false},
+				"nodes": []M{
+					{"databaseId": 2, "slug": "team2", "members": members(false, "user1")},
 				},
-				"team4": {
-					{"login": "user4"},
+			}}
+		case req.Variables.Org == "org2":
+			org = M{"teams": M{
+				"pageInfo": M{"hasNextPage": false},
+				"nodes": []M{
+					{"databaseId": 3, "slug": "team3", "members": members(false, "user1", "user2", "user3")},
+					{"databaseId": 4, "slug": "team4", "members": members(false, "user4")},
 				},
-			},
+			}}
 		}
-		orgID := chi.URLParam(r, "org_id")
-		teamID := chi.URLParam(r, "team_id")
-		json.NewEncoder(w).Encode(members[orgID][teamID])
+		json.NewEncoder(w).Encode(M{"data": M{"organization": org}})
 	})
 	return r
 }
@@ -99,16 +120,38 @@ func Test(t *testing.T) {
 		{ "id": "github/user1", "groupIds": ["1", "2", "3"] },
 		{ "id": "github/user2", "groupIds": ["1", "3"] },
 		{ "id": "github/user3", "groupIds": ["3"] },
-		{ "id": "github/user4", "groupIds": ["4"] }
+		{ "id": "github/user4", "groupIds": ["4"] },
+		{ "id": "github/user5", "groupIds": ["1"] }
 	]`, users)
 	testutil.AssertProtoJSONEqual(t, `[
-		{ "id": "1", "name": "team1" },
-		{ "id": "2", "name": "team2" },
-		{ "id": "3", "name": "team3" },
-		{ "id": "4", "name": "team4" }
+		{ "id": "1", "name": "org1/team1" },
+		{ "id": "2", "name": "org1/team2" },
+		{ "id": "3", "name": "org2/team3" },
+		{ "id": "4", "name": "org2/team4" }
 	]`, groups)
 }
 
+func TestGraphQLError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/user/orgs" {
+			json.NewEncoder(w).Encode([]M{{"login": "org1"}})
+			return
+		}
+		json.NewEncoder(w).Encode(M{"errors": []M{{"message": "Your token has not been granted the required scopes"}}})
+	}))
+	defer srv.Close()
+
+	p := New(
+		WithURL(mustParseURL(srv.URL)),
+		WithServiceAccount(&ServiceAccount{
+			Username:            "abc",
+			PersonalAccessToken: "xyz",
+		}),
+	)
+	_, _, err := p.UserGroups(context.Background())
+	assert.EqualError(t, err, "github: error from GraphQL API: Your token has not been granted the required scopes")
+}
+
 func mustParseURL(rawurl string) *url.URL {
 	u, err := url.Parse(rawurl)
 	if err != nil {
@@ -96,9 +96,10 @@ func (p *Provider) UserGroups(ctx context.Context) ([]*directory.Group, []*direc
 		return nil, nil, err
 	}
 
+	r := newMembershipResolver(p)
 	userIDToGroupIDs := map[int][]string{}
 	for _, group := range groups {
-		userIDs, err := p.listGroupMemberIDs(ctx, group.Id)
+		userIDs, err := r.getEffectiveMemberIDs(ctx, group.Id)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -125,10 +126,16 @@ func (p *Provider) UserGroups(ctx context.Context) ([]*directory.Group, []*direc
 	return groups, users, nil
 }
 
-// listGroups returns a map, with key is group ID, element is group name.
+// listGroups returns the groups, including subgroups, with keyset pagination.
 func (p *Provider) listGroups(ctx context.Context) ([]*directory.Group, error) {
 	nextURL := p.cfg.url.ResolveReference(&url.URL{
 		Path: "/api/v4/groups",
+		RawQuery: url.Values{
+			"pagination": {"keyset"},
+			"order_by":   {"id"},
+			"sort":       {"asc"},
+			"per_page":   {strconv.Itoa(pageSize)},
+		}.Encode(),
 	}).String()
 	var groups []*directory.Group
 	for nextURL != "" {
@@ -153,9 +160,114 @@ func (p *Provider) listGroups(ctx context.Context) ([]*directory.Group, error) {
 	return groups, nil
 }
 
+// pageSize is the number of groups or members queried at a time.
+const pageSize = 100
+
+// groupDetails are the details of a group needed to resolve its members.
+type groupDetails struct {
+	ParentID         *int `json:"parent_id"`
+	SharedWithGroups []struct {
+		GroupID int `json:"group_id"`
+	} `json:"shared_with_groups"`
+}
+
+// A membershipResolver resolves the effective members of groups during a
+// directory sync. Groups are often the ancestor of, or shared with, several
+// other groups, so their details and members are only queried once.
+type membershipResolver struct {
+	p       *Provider
+	details map[string]*groupDetails
+	members map[string][]int
+}
+
+func newMembershipResolver(p *Provider) *membershipResolver {
+	return &membershipResolver{
+		p:       p,
+		details: make(map[string]*groupDetails),
+		members: make(map[string][]int),
+	}
+}
+
+// getEffectiveMemberIDs returns the ids of the users who are members of a
+// group, directly or through one of its ancestor groups, and of the members
+// of the groups it, or one of its ancestor groups, is shared with.
+func (r *membershipResolver) getEffectiveMemberIDs(ctx context.Context, groupID string) ([]int, error) {
+	var memberIDs []int
+	seen := map[int]bool{}
+	add := func(groupID string) error {
+		userIDs, err := r.getMemberIDs(ctx, groupID)
+		if err != nil {
+			return err
+		}
+		for _, userID := range userIDs {
+			if !seen[userID] {
+				seen[userID] = true
+				memberIDs = append(memberIDs, userID)
+			}
+		}
+		return nil
+	}
+
+	if err := add(groupID); err != nil {
+		return nil, err
+	}
+	visited := map[string]bool{}
+	for id := groupID; id != "" && !visited[id]; {
+		visited[id] = true
+		details, err := r.getDetails(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, shared := range details.SharedWithGroups {
+			if err := add(strconv.Itoa(shared.GroupID)); err != nil {
+				return nil, err
+			}
+		}
+
+		id = ""
+		if details.ParentID != nil {
+			id = strconv.Itoa(*details.ParentID)
+		}
+	}
+	return memberIDs, nil
+}
+
+func (r *membershipResolver) getDetails(ctx context.Context, groupID string) (*groupDetails, error) {
+	if details, ok := r.details[groupID]; ok {
+		return details, nil
+	}
+
+	detailsURL := r.p.cfg.url.ResolveReference(&url.URL{
+		Path:     fmt.Sprintf("/api/v4/groups/%s", groupID),
+		RawQuery: "with_projects=false",
+	}).String()
+	var details groupDetails
+	if _, err := r.p.apiGet(ctx, detailsURL, &details); err != nil {
+		return nil, fmt.Errorf("gitlab: error querying group: %w", err)
+	}
+	r.details[groupID] = &details
+	return &details, nil
+}
+
+func (r *membershipResolver) getMemberIDs(ctx context.Context, groupID string) ([]int, error) {
+	if memberIDs, ok := r.members[groupID]; ok {
+		return memberIDs, nil
+	}
+
+	memberIDs, err := r.p.listGroupMemberIDs(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	r.members[groupID] = memberIDs
+	return memberIDs, nil
+}
+
+// listGroupMemberIDs returns the ids of the members of a group, including
+// the members inherited from its ancestor groups.
 func (p *Provider) listGroupMemberIDs(ctx context.Context, groupID string) (userIDs []int, err error) {
 	nextURL := p.cfg.url.ResolveReference(&url.URL{
-		Path: fmt.Sprintf("/api/v4/groups/%s/members", groupID),
+		Path:     fmt.Sprintf("/api/v4/groups/%s/members/all", groupID),
+		RawQuery: url.Values{"per_page": {strconv.Itoa(pageSize)}}.Encode(),
 	}).String()
 	for nextURL != "" {
 		var result []struct {
@@ -31,12 +31,30 @@ func newMockAPI(t *testing.T, srv *httptest.Server) http.Handler {
 			})
 		})
 		r.Get("/groups", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "keyset", r.URL.Query().Get("pagination"))
+			assert.Equal(t, "id", r.URL.Query().Get("order_by"))
+			if r.URL.Query().Get("id_after") == "" {
+				w.Header().Set("Link", `<`+srv.URL+`/api/v4/groups?id_after=2&order_by=id&pagination=keyset&per_page=100&sort=asc>; rel="next"`)
+				_ = json.NewEncoder(w).Encode([]M{
+					{"id": 1, "name": "Group 1"},
+					{"id": 2, "name": "Group 2"},
+				})
+				return
+			}
 			_ = json.NewEncoder(w).Encode([]M{
-				{"id": 1, "name": "Group 1"},
-				{"id": 2, "name": "Group 2"},
+				{"id": 3, "name": "Subgroup 3"},
 			})
 		})
-		r.Get("/groups/{group_name}/members", func(w http.ResponseWriter, r *http.Request) {
+		r.Get("/groups/{group_id}", func(w http.ResponseWriter, r *http.Request) {
+			details := map[string]M{
+				"1": {"parent_id": nil, "shared_with_groups": []M{{"group_id": 4}}},
+				"2": {"parent_id": nil, "shared_with_groups": []M{}},
+				"3": {"parent_id": 1, "shared_with_groups": []M{}},
+			}
+			_ = json.NewEncoder(w).Encode(details[chi.URLParam(r, "group_id")])
+		})
+		r.Get("/groups/{group_id}/members/all", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "100", r.URL.Query().Get("per_page"))
 			members := map[string][]M{
 				"1": {
 					{"id": 11},
@@ -45,8 +63,16 @@ func newMockAPI(t *testing.T, srv *httptest.Server) http.Handler {
 					{"id": 12},
 					{"id": 13},
 				},
+				"3": {
+					{"id": 11},
+					{"id": 14},
+				},
+				"4": {
+					{"id": 15},
+					{"id": 12},
+				},
 			}
-			_ = json.NewEncoder(w).Encode(members[chi.URLParam(r, "group_name")])
+			_ = json.NewEncoder(w).Encode(members[chi.URLParam(r, "group_id")])
 		})
 	})
 	return r
@@ -69,13 +95,16 @@ func Test(t *testing.T) {
 	groups, users, err := p.UserGroups(context.Background())
 	assert.NoError(t, err)
 	testutil.AssertProtoJSONEqual(t, `[
-		{ "id": "gitlab/11", "groupIds": ["1"] },
-		{ "id": "gitlab/12", "groupIds": ["2"] },
-		{ "id": "gitlab/13", "groupIds": ["2"] }
+		{ "id": "gitlab/11", "groupIds": ["1", "3"] },
+		{ "id": "gitlab/12", "groupIds": ["1", "2", "3"] },
+		{ "id": "gitlab/13", "groupIds": ["2"] },
+		{ "id": "gitlab/14", "groupIds": ["3"] },
+		{ "id": "gitlab/15", "groupIds": ["1", "3"] }
 	]`, users)
 	testutil.AssertProtoJSONEqual(t, `[
 		{ "id": "1", "name": "Group 1" },
-		{ "id": "2", "name": "Group 2" }
+		{ "id": "2", "name": "Group 2" },
+		{ "id": "3", "name": "Subgroup 3" }
 	]`, groups)
 }
 
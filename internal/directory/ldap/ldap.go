@@ -0,0 +1,193 @@
+// Package ldap contains the LDAP directory provider.
+//
+// Users are listed with a paged search of the provider URL, and their groups
+// are resolved from their memberOf attribute, including nested groups.
+package ldap
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pomerium/pomerium/internal/ldap"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+)
+
+// Name is the provider name.
+const Name = "ldap"
+
+const defaultPageSize = 500
+
+type config struct {
+	pageSize       int
+	providerURL    *ldap.URL
+	serviceAccount *ServiceAccount
+}
+
+// An Option configures the LDAP Provider.
+type Option func(cfg *config)
+
+// WithPageSize sets the page size option, which is how many users are
+// returned at once.
+func WithPageSize(pageSize int) Option {
+	return func(cfg *config) {
+		cfg.pageSize = pageSize
+	}
+}
+
+// WithProviderURL sets the provider URL option, which is an LDAP URL of the
+// search users are listed with.
+func WithProviderURL(u *ldap.URL) Option {
+	return func(cfg *config) {
+		cfg.providerURL = u
+	}
+}
+
+// WithServiceAccount sets the service account option.
+func WithServiceAccount(serviceAccount *ServiceAccount) Option {
+	return func(cfg *config) {
+		cfg.serviceAccount = serviceAccount
+	}
+}
+
+func getConfig(options ...Option) *config {
+	cfg := new(config)
+	WithPageSize(defaultPageSize)(cfg)
+	WithServiceAccount(new(ServiceAccount))(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+// A conn is a connection to the directory.
+type conn interface {
+	ldap.Searcher
+	Bind(ctx context.Context, dn, password string) error
+	Close() error
+}
+
+// A Provider is an LDAP user group directory provider.
+type Provider struct {
+	cfg  *config
+	log  zerolog.Logger
+	dial func(ctx context.Context, u *ldap.URL) (conn, error)
+}
+
+// New creates a new Provider.
+func New(options ...Option) *Provider {
+	return &Provider{
+		cfg: getConfig(options...),
+		log: log.With().Str("service", "directory").Str("provider", Name).Logger(),
+		dial: func(ctx context.Context, u *ldap.URL) (conn, error) {
+			c, err := ldap.Dial(ctx, u, nil)
+			if err != nil {
+				return nil, err
+			}
+			return c, nil
+		},
+	}
+}
+
+// UserGroups gets the directory user groups for LDAP. Groups are identified
+// by their DN, which is what the groups claim of the LDAP identity provider
+// contains.
+func (p *Provider) UserGroups(ctx context.Context) ([]*directory.Group, []*directory.User, error) {
+	if p.cfg.providerURL == nil {
+		return nil, nil, fmt.Errorf("ldap: provider url not defined")
+	}
+
+	p.log.Info().Msg("getting user groups")
+
+	c, err := p.dial(ctx, p.cfg.providerURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer c.Close()
+
+	if p.cfg.serviceAccount.BindDN != "" {
+		err := c.Bind(ctx, p.cfg.serviceAccount.BindDN, p.cfg.serviceAccount.BindPassword)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ldap: service account bind failed: %w", err)
+		}
+	}
+
+	entries, err := c.Search(ctx, &ldap.SearchRequest{
+		BaseDN:     p.cfg.providerURL.BaseDN,
+		Scope:      p.cfg.providerURL.Scope,
+		Filter:     p.cfg.providerURL.Filter,
+		Attributes: []string{"memberOf"},
+		PageSize:   p.cfg.pageSize,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldap: error querying for users: %w", err)
+	}
+
+	resolver := ldap.NewGroupResolver(c)
+	groupLookup := map[string]*directory.Group{}
+	var users []*directory.User
+	for _, entry := range entries {
+		groups, err := resolver.Resolve(ctx, entry.Values("memberOf"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("ldap: error querying for user groups: %w", err)
+		}
+		user := &directory.User{
+			Id: databroker.GetUserID(Name, entry.DN),
+		}
+		for _, group := range groups {
+			groupLookup[group.DN] = &directory.Group{Id: group.DN, Name: group.Name}
+			user.GroupIds = append(user.GroupIds, group.DN)
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+
+	var groups []*directory.Group
+	for _, group := range groupLookup {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Id < groups[j].Id
+	})
+	return groups, users, nil
+}
+
+// A ServiceAccount is used by the LDAP provider to search the directory. If
+// the bind DN is empty, searches are anonymous.
+type ServiceAccount struct {
+	BindDN       string `json:"bind_dn"`
+	BindPassword string `json:"bind_password"`
+}
+
+// ParseServiceAccount parses the service account in the config options. If
+// it's empty, the client id and client secret are used instead, which are
+// also the service account of the LDAP identity provider.
+func ParseServiceAccount(rawServiceAccount, clientID, clientSecret string) (*ServiceAccount, error) {
+	if rawServiceAccount == "" {
+		return &ServiceAccount{BindDN: clientID, BindPassword: clientSecret}, nil
+	}
+
+	bs, err := base64.StdEncoding.DecodeString(rawServiceAccount)
+	if err != nil {
+		return nil, err
+	}
+	var serviceAccount ServiceAccount
+	err = json.Unmarshal(bs, &serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	if serviceAccount.BindDN != "" && serviceAccount.BindPassword == "" {
+		return nil, fmt.Errorf("bind_password is required")
+	}
+
+	return &serviceAccount, nil
+}
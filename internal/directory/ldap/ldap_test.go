@@ -0,0 +1,140 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/ldap"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+)
+
+// fakeConn lists the users of a search of the base DN, and reads groups by
+// DN.
+type fakeConn struct {
+	users  []*ldap.Entry
+	groups map[string]*ldap.Entry
+	bound  string
+}
+
+func (c *fakeConn) Bind(ctx context.Context, dn, password string) error {
+	if password != "secret" {
+		return ldap.ErrInvalidCredentials
+	}
+	c.bound = dn
+	return nil
+}
+
+func (c *fakeConn) Search(ctx context.Context, req *ldap.SearchRequest) ([]*ldap.Entry, error) {
+	if req.Scope == ldap.ScopeBaseObject {
+		if entry, ok := c.groups[req.BaseDN]; ok {
+			return []*ldap.Entry{entry}, nil
+		}
+		return nil, &ldap.Error{ResultCode: ldap.ResultNoSuchObject}
+	}
+	if req.BaseDN != "ou=people,dc=example,dc=com" || req.Filter != "(objectClass=person)" || req.PageSize != defaultPageSize {
+		return nil, &ldap.Error{ResultCode: 2, Message: "unexpected search"}
+	}
+	return c.users, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func TestProvider_UserGroups(t *testing.T) {
+	u, err := ldap.ParseURL("ldaps://ldap.example.com/ou=people,dc=example,dc=com?uid?sub?(objectClass=person)")
+	require.NoError(t, err)
+
+	c := &fakeConn{
+		users: []*ldap.Entry{
+			{
+				DN:         "uid=bob,ou=people,dc=example,dc=com",
+				Attributes: map[string][]string{"memberof": {"cn=engineering,ou=groups,dc=example,dc=com"}},
+			},
+			{
+				DN:         "uid=alice,ou=people,dc=example,dc=com",
+				Attributes: map[string][]string{"memberof": {"cn=devs,ou=groups,dc=example,dc=com"}},
+			},
+			{
+				DN: "uid=carol,ou=people,dc=example,dc=com",
+			},
+		},
+		groups: map[string]*ldap.Entry{
+			"cn=devs,ou=groups,dc=example,dc=com": {
+				DN: "cn=devs,ou=groups,dc=example,dc=com",
+				Attributes: map[string][]string{
+					"cn":       {"devs"},
+					"memberof": {"cn=engineering,ou=groups,dc=example,dc=com"},
+				},
+			},
+			"cn=engineering,ou=groups,dc=example,dc=com": {
+				DN:         "cn=engineering,ou=groups,dc=example,dc=com",
+				Attributes: map[string][]string{"cn": {"Engineering"}},
+			},
+		},
+	}
+
+	p := New(
+		WithProviderURL(u),
+		WithServiceAccount(&ServiceAccount{BindDN: "cn=sync,dc=example,dc=com", BindPassword: "secret"}),
+	)
+	p.dial = func(ctx context.Context, u *ldap.URL) (conn, error) {
+		return c, nil
+	}
+
+	groups, users, err := p.UserGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "cn=sync,dc=example,dc=com", c.bound)
+	assert.Equal(t, []*directory.User{
+		{
+			Id: "ldap/uid=alice,ou=people,dc=example,dc=com",
+			GroupIds: []string{
+				"cn=devs,ou=groups,dc=example,dc=com",
+				"cn=engineering,ou=groups,dc=example,dc=com",
+			},
+		},
+		{
+			Id:       "ldap/uid=bob,ou=people,dc=example,dc=com",
+			GroupIds: []string{"cn=engineering,ou=groups,dc=example,dc=com"},
+		},
+		{
+			Id: "ldap/uid=carol,ou=people,dc=example,dc=com",
+		},
+	}, users)
+	assert.Equal(t, []*directory.Group{
+		{Id: "cn=devs,ou=groups,dc=example,dc=com", Name: "devs"},
+		{Id: "cn=engineering,ou=groups,dc=example,dc=com", Name: "Engineering"},
+	}, groups)
+
+	p.cfg.serviceAccount.BindPassword = "wrong"
+	_, _, err = p.UserGroups(context.Background())
+	assert.Error(t, err)
+}
+
+func TestParseServiceAccount(t *testing.T) {
+	tests := []struct {
+		name              string
+		rawServiceAccount string
+		serviceAccount    *ServiceAccount
+		wantErr           bool
+	}{
+		{"valid", "eyJiaW5kX2RuIjogImNuPXN5bmMsZGM9ZXhhbXBsZSxkYz1jb20iLCAiYmluZF9wYXNzd29yZCI6ICJzZWNyZXQifQ==", &ServiceAccount{BindDN: "cn=sync,dc=example,dc=com", BindPassword: "secret"}, false},
+		{"client id and secret", "", &ServiceAccount{BindDN: "cn=pomerium,dc=example,dc=com", BindPassword: "client-secret"}, false},
+		{"missing bind password", "eyJiaW5kX2RuIjogImNuPXN5bmMsZGM9ZXhhbXBsZSxkYz1jb20ifQ==", nil, true},
+		{"invalid base64", "%%%", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceAccount, err := ParseServiceAccount(tt.rawServiceAccount, "cn=pomerium,dc=example,dc=com", "client-secret")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.serviceAccount, serviceAccount)
+		})
+	}
+}
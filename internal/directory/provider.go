@@ -6,12 +6,16 @@ import (
 	"net/url"
 
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/directory/auth0"
 	"github.com/pomerium/pomerium/internal/directory/azure"
+	"github.com/pomerium/pomerium/internal/directory/cognito"
 	"github.com/pomerium/pomerium/internal/directory/github"
 	"github.com/pomerium/pomerium/internal/directory/gitlab"
 	"github.com/pomerium/pomerium/internal/directory/google"
+	"github.com/pomerium/pomerium/internal/directory/ldap"
 	"github.com/pomerium/pomerium/internal/directory/okta"
 	"github.com/pomerium/pomerium/internal/directory/onelogin"
+	pom_ldap "github.com/pomerium/pomerium/internal/ldap"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/pkg/grpc/directory"
 )
@@ -30,6 +34,21 @@ type Provider interface {
 // GetProvider gets the provider for the given options.
 func GetProvider(options *config.Options) Provider {
 	switch options.Provider {
+	case auth0.Name:
+		providerURL, _ := url.Parse(options.ProviderURL)
+		serviceAccount, err := auth0.ParseServiceAccount(options.ServiceAccount)
+		if err == nil {
+			return auth0.New(
+				auth0.WithProviderURL(providerURL),
+				auth0.WithServiceAccount(serviceAccount),
+				auth0.WithQPS(options.QPS),
+			)
+		}
+		log.Warn().
+			Str("service", "directory").
+			Str("provider", options.Provider).
+			Err(err).
+			Msg("invalid service account for auth0 directory provider")
 	case azure.Name:
 		serviceAccount, err := azure.ParseServiceAccount(options.ServiceAccount)
 		if err == nil {
@@ -41,6 +60,20 @@ func GetProvider(options *config.Options) Provider {
 			Str("provider", options.Provider).
 			Err(err).
 			Msg("invalid service account for azure directory provider")
+	case cognito.Name:
+		providerURL, _ := url.Parse(options.ProviderURL)
+		serviceAccount, err := cognito.ParseServiceAccount(options.ServiceAccount)
+		if err == nil {
+			return cognito.New(
+				cognito.WithProviderURL(providerURL),
+				cognito.WithServiceAccount(serviceAccount),
+			)
+		}
+		log.Warn().
+			Str("service", "directory").
+			Str("provider", options.Provider).
+			Err(err).
+			Msg("invalid service account for cognito directory provider")
 	case github.Name:
 		serviceAccount, err := github.ParseServiceAccount(options.ServiceAccount)
 		if err == nil {
@@ -71,6 +104,28 @@ func GetProvider(options *config.Options) Provider {
 			Str("provider", options.Provider).
 			Err(err).
 			Msg("invalid service account for google directory provider")
+	case ldap.Name:
+		providerURL, err := pom_ldap.ParseURL(options.ProviderURL)
+		if err != nil {
+			log.Warn().
+				Str("service", "directory").
+				Str("provider", options.Provider).
+				Err(err).
+				Msg("invalid provider url for ldap directory provider")
+			break
+		}
+		serviceAccount, err := ldap.ParseServiceAccount(options.ServiceAccount, options.ClientID, options.ClientSecret)
+		if err == nil {
+			return ldap.New(
+				ldap.WithProviderURL(providerURL),
+				ldap.WithServiceAccount(serviceAccount),
+			)
+		}
+		log.Warn().
+			Str("service", "directory").
+			Str("provider", options.Provider).
+			Err(err).
+			Msg("invalid service account for ldap directory provider")
 	case okta.Name:
 		providerURL, _ := url.Parse(options.ProviderURL)
 		serviceAccount, err := okta.ParseServiceAccount(options.ServiceAccount)
@@ -0,0 +1,148 @@
+// Package election elects a leader among the replicas of a service, using a
+// lease which can only be held by one replica at a time.
+package election
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/signal"
+)
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
+// A Lock is a lease held by at most one holder at a time.
+type Lock interface {
+	// Acquire acquires or renews the lease for holder if it's free, expired or
+	// already held by holder, and returns the holder of the lease.
+	Acquire(ctx context.Context, holder string, ttl time.Duration) (string, error)
+	// Release releases the lease if it's held by holder.
+	Release(ctx context.Context, holder string) error
+}
+
+// An Elector campaigns for the leadership of a replica and keeps track of the
+// current leader.
+//
+// The leader renews its lease every third of the lease's time to live. If it
+// fails to renew the lease for two thirds of the time to live, it stops
+// considering itself the leader before another replica can acquire it.
+type Elector struct {
+	lock Lock
+	id   string
+	ttl  time.Duration
+	log  zerolog.Logger
+
+	mu       sync.RWMutex
+	leader   string
+	observed time.Time
+	onChange *signal.Signal
+}
+
+// New creates a new Elector for the replica with the given id. Other replicas
+// use the id of the leader to reach it, so it's typically its URL.
+func New(lock Lock, id string, ttl time.Duration) *Elector {
+	return &Elector{
+		lock:     lock,
+		id:       id,
+		ttl:      ttl,
+		log:      log.With().Str("service", "election").Str("id", id).Logger(),
+		onChange: signal.New(),
+	}
+}
+
+// ID returns the id of the replica.
+func (e *Elector) ID() string {
+	return e.id
+}
+
+// Leader returns the id of the leader, or an empty string if it's unknown.
+func (e *Elector) Leader() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+// IsLeader returns true if the replica is the leader.
+func (e *Elector) IsLeader() bool {
+	return e.Leader() == e.id
+}
+
+// Bind returns a channel which is notified whenever the leader changes.
+func (e *Elector) Bind() chan struct{} {
+	return e.onChange.Bind()
+}
+
+// Unbind stops notifying a channel returned by Bind.
+func (e *Elector) Unbind(ch chan struct{}) {
+	e.onChange.Unbind(ch)
+}
+
+// Run campaigns for leadership until ctx is done, and then releases the lease
+// if the replica holds it.
+func (e *Elector) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		e.campaign(ctx)
+
+		select {
+		case <-ctx.Done():
+			e.resign()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Elector) campaign(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, e.ttl/3)
+	defer cancel()
+
+	// the lease is valid from before it was requested
+	now := timeNow()
+	leader, err := e.lock.Acquire(ctx, e.id, e.ttl)
+	if err != nil {
+		e.log.Warn().Err(err).Msg("election: failed to acquire lease")
+		e.mu.RLock()
+		expired := timeNow().Sub(e.observed) >= 2*e.ttl/3
+		e.mu.RUnlock()
+		if expired {
+			e.setLeader("", now)
+		}
+		return
+	}
+	e.setLeader(leader, now)
+}
+
+func (e *Elector) resign() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.ttl/3)
+	defer cancel()
+
+	if e.IsLeader() {
+		if err := e.lock.Release(ctx, e.id); err != nil {
+			e.log.Warn().Err(err).Msg("election: failed to release lease")
+		}
+	}
+	e.setLeader("", timeNow())
+}
+
+func (e *Elector) setLeader(leader string, observed time.Time) {
+	e.mu.Lock()
+	changed := leader != e.leader
+	e.leader = leader
+	if leader != "" {
+		e.observed = observed
+	}
+	e.mu.Unlock()
+
+	if changed {
+		e.log.Info().Str("leader", leader).Msg("election: leader changed")
+		e.onChange.Broadcast()
+	}
+}
@@ -0,0 +1,84 @@
+package election
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A fakeLock is a lock which never expires, unless it fails.
+type fakeLock struct {
+	mu     sync.Mutex
+	holder string
+	err    error
+}
+
+func (lock *fakeLock) Acquire(_ context.Context, holder string, _ time.Duration) (string, error) {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	if lock.err != nil {
+		return "", lock.err
+	}
+	if lock.holder == "" {
+		lock.holder = holder
+	}
+	return lock.holder, nil
+}
+
+func (lock *fakeLock) Release(_ context.Context, holder string) error {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+	if lock.holder == holder {
+		lock.holder = ""
+	}
+	return lock.err
+}
+
+func TestElector(t *testing.T) {
+	now := time.Unix(1603000000, 0)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	ctx := context.Background()
+	lock := new(fakeLock)
+	a := New(lock, "https://a.example.com", 15*time.Second)
+	b := New(lock, "https://b.example.com", 15*time.Second)
+	changed := b.Bind()
+	defer b.Unbind(changed)
+
+	a.campaign(ctx)
+	b.campaign(ctx)
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+	assert.Equal(t, "https://a.example.com", b.Leader())
+	select {
+	case <-changed:
+	default:
+		t.Error("expected the leader change to be signalled")
+	}
+
+	// the leader steps down after failing to renew the lease for two thirds
+	// of its time to live
+	lock.err = errors.New("unavailable")
+	now = now.Add(5 * time.Second)
+	a.campaign(ctx)
+	assert.True(t, a.IsLeader())
+	now = now.Add(5 * time.Second)
+	a.campaign(ctx)
+	assert.False(t, a.IsLeader())
+	assert.Equal(t, "", a.Leader())
+
+	lock.err = nil
+	a.campaign(ctx)
+	assert.True(t, a.IsLeader())
+
+	// the lease is released when the leader stops
+	a.resign()
+	b.campaign(ctx)
+	assert.True(t, b.IsLeader())
+	assert.Equal(t, "", a.Leader())
+}
@@ -0,0 +1,253 @@
+package election
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// kubernetesServiceAccountDir is where kubernetes mounts the service
+	// account of a pod.
+	kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	// microTimeFormat is the format of the MicroTime fields of leases.
+	microTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var (
+	errLeaseNotFound = errors.New("election: lease not found")
+	errLeaseConflict = errors.New("election: lease was modified concurrently")
+)
+
+// A KubernetesLock is a lock held with a coordination.k8s.io/v1 Lease, like
+// the leader election of kubernetes controllers. The service account of the
+// pod needs to be allowed to get, create and update the lease.
+//
+// Leases are renewed with the time of the holder, but they're considered
+// expired when they haven't changed for their duration as observed locally,
+// so the clocks of the replicas don't need to be synchronized.
+type KubernetesLock struct {
+	httpClient *http.Client
+	leasesURL  string
+	name       string
+	namespace  string
+	tokenFile  string
+
+	mu         sync.Mutex
+	observed   kubernetesLeaseSpec
+	observedAt time.Time
+}
+
+type kubernetesLease struct {
+	APIVersion string                  `json:"apiVersion"`
+	Kind       string                  `json:"kind"`
+	Metadata   kubernetesLeaseMetadata `json:"metadata"`
+	Spec       kubernetesLeaseSpec     `json:"spec"`
+}
+
+type kubernetesLeaseMetadata struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace,omitempty"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+type kubernetesLeaseSpec struct {
+	HolderIdentity       string `json:"holderIdentity,omitempty"`
+	LeaseDurationSeconds int32  `json:"leaseDurationSeconds,omitempty"`
+	AcquireTime          string `json:"acquireTime,omitempty"`
+	RenewTime            string `json:"renewTime,omitempty"`
+	LeaseTransitions     int32  `json:"leaseTransitions,omitempty"`
+}
+
+// NewKubernetesLock creates a new KubernetesLock for the lease with the given
+// name, in the namespace of the pod. It must run in a kubernetes pod with a
+// service account.
+func NewKubernetesLock(name string) (*KubernetesLock, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("election: kubernetes leases require running in a kubernetes cluster")
+	}
+	namespace, err := ioutil.ReadFile(kubernetesServiceAccountDir + "/namespace")
+	if err != nil {
+		return nil, fmt.Errorf("election: failed to read the namespace of the pod: %w", err)
+	}
+	ca, err := ioutil.ReadFile(kubernetesServiceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, fmt.Errorf("election: failed to read the kubernetes certificate authority: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(ca) {
+		return nil, errors.New("election: invalid kubernetes certificate authority")
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs},
+		},
+		Timeout: 10 * time.Second,
+	}
+	return newKubernetesLock(httpClient, "https://"+net.JoinHostPort(host, port),
+		strings.TrimSpace(string(namespace)), name, kubernetesServiceAccountDir+"/token"), nil
+}
+
+func newKubernetesLock(httpClient *http.Client, apiURL, namespace, name, tokenFile string) *KubernetesLock {
+	return &KubernetesLock{
+		httpClient: httpClient,
+		leasesURL:  apiURL + "/apis/coordination.k8s.io/v1/namespaces/" + namespace + "/leases",
+		name:       name,
+		namespace:  namespace,
+		tokenFile:  tokenFile,
+	}
+}
+
+// Acquire acquires or renews the lease for holder.
+func (lock *KubernetesLock) Acquire(ctx context.Context, holder string, ttl time.Duration) (string, error) {
+	lease, err := lock.get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	now := timeNow()
+	spec := kubernetesLeaseSpec{
+		HolderIdentity:       holder,
+		LeaseDurationSeconds: int32((ttl + time.Second - 1) / time.Second),
+		AcquireTime:          now.UTC().Format(microTimeFormat),
+		RenewTime:            now.UTC().Format(microTimeFormat),
+	}
+
+	if lease == nil {
+		err = lock.do(ctx, http.MethodPost, lock.leasesURL, &kubernetesLease{
+			APIVersion: "coordination.k8s.io/v1",
+			Kind:       "Lease",
+			Metadata:   kubernetesLeaseMetadata{Name: lock.name, Namespace: lock.namespace},
+			Spec:       spec,
+		}, nil)
+		return lock.result(ctx, holder, err)
+	}
+
+	current := lease.Spec.HolderIdentity
+	if current != "" && current != holder && !lock.expired(lease.Spec, now) {
+		return current, nil
+	}
+
+	if current == holder {
+		spec.AcquireTime = lease.Spec.AcquireTime
+		spec.LeaseTransitions = lease.Spec.LeaseTransitions
+	} else {
+		spec.LeaseTransitions = lease.Spec.LeaseTransitions + 1
+	}
+	lease.Spec = spec
+	err = lock.do(ctx, http.MethodPut, lock.leasesURL+"/"+lock.name, lease, nil)
+	return lock.result(ctx, holder, err)
+}
+
+// Release releases the lease if it's held by holder.
+func (lock *KubernetesLock) Release(ctx context.Context, holder string) error {
+	lease, err := lock.get(ctx)
+	if err != nil || lease == nil || lease.Spec.HolderIdentity != holder {
+		return err
+	}
+	lease.Spec.HolderIdentity = ""
+	lease.Spec.LeaseDurationSeconds = 1
+	err = lock.do(ctx, http.MethodPut, lock.leasesURL+"/"+lock.name, lease, nil)
+	if errors.Is(err, errLeaseConflict) {
+		return nil
+	}
+	return err
+}
+
+// result returns the holder after trying to write the lease. If another
+// replica wrote it first, it holds the lease.
+func (lock *KubernetesLock) result(ctx context.Context, holder string, err error) (string, error) {
+	if errors.Is(err, errLeaseConflict) {
+		lease, err := lock.get(ctx)
+		if err != nil || lease == nil {
+			return "", err
+		}
+		return lease.Spec.HolderIdentity, nil
+	} else if err != nil {
+		return "", err
+	}
+	return holder, nil
+}
+
+// expired returns true if the lease hasn't been renewed for its duration since
+// it was first observed.
+func (lock *KubernetesLock) expired(spec kubernetesLeaseSpec, now time.Time) bool {
+	lock.mu.Lock()
+	defer lock.mu.Unlock()
+
+	if spec.HolderIdentity != lock.observed.HolderIdentity || spec.RenewTime != lock.observed.RenewTime {
+		lock.observed = spec
+		lock.observedAt = now
+	}
+	return now.Sub(lock.observedAt) >= time.Duration(spec.LeaseDurationSeconds)*time.Second
+}
+
+func (lock *KubernetesLock) get(ctx context.Context) (*kubernetesLease, error) {
+	var lease kubernetesLease
+	err := lock.do(ctx, http.MethodGet, lock.leasesURL+"/"+lock.name, nil, &lease)
+	if errors.Is(err, errLeaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &lease, nil
+}
+
+func (lock *KubernetesLock) do(ctx context.Context, method, rawURL string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		bs, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(bs)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	// service account tokens are rotated, so the token is read every time
+	if token, err := ioutil.ReadFile(lock.tokenFile); err == nil {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	res, err := lock.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("election: kubernetes api error: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return errLeaseNotFound
+	case res.StatusCode == http.StatusConflict:
+		return errLeaseConflict
+	case res.StatusCode/100 != 2:
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("election: kubernetes api error: %s: %s", res.Status, bytes.TrimSpace(msg))
+	}
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return fmt.Errorf("election: invalid kubernetes api response: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package election
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLeasePath = "/apis/coordination.k8s.io/v1/namespaces/pomerium/leases"
+
+// A fakeLeaseAPI stores a single lease, with optimistic concurrency like the
+// kubernetes api server.
+type fakeLeaseAPI struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	lease   *kubernetesLease
+	version int
+}
+
+func (api *fakeLeaseAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	assert.Equal(api.t, "Bearer test-token", r.Header.Get("Authorization"))
+
+	var in kubernetesLease
+	if r.Method != http.MethodGet {
+		require.NoError(api.t, json.NewDecoder(r.Body).Decode(&in))
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == testLeasePath+"/pomerium-databroker":
+		if api.lease == nil {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(api.lease)
+	case r.Method == http.MethodPost && r.URL.Path == testLeasePath:
+		if api.lease != nil {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		api.store(&in)
+	case r.Method == http.MethodPut && r.URL.Path == testLeasePath+"/pomerium-databroker":
+		if api.lease == nil || in.Metadata.ResourceVersion != api.lease.Metadata.ResourceVersion {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		api.store(&in)
+	default:
+		api.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (api *fakeLeaseAPI) store(lease *kubernetesLease) {
+	api.version++
+	lease.Metadata.ResourceVersion = strconv.Itoa(api.version)
+	api.lease = lease
+}
+
+func TestKubernetesLock(t *testing.T) {
+	now := time.Unix(1603000000, 0)
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	api := &fakeLeaseAPI{t: t}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("test-token\n"), 0600))
+
+	ctx := context.Background()
+	a := newKubernetesLock(srv.Client(), srv.URL, "pomerium", "pomerium-databroker", tokenFile)
+	b := newKubernetesLock(srv.Client(), srv.URL, "pomerium", "pomerium-databroker", tokenFile)
+	acquire := func(lock *KubernetesLock, holder string) string {
+		leader, err := lock.Acquire(ctx, holder, 15*time.Second)
+		require.NoError(t, err)
+		return leader
+	}
+
+	assert.Equal(t, "a", acquire(a, "a"))
+	assert.Equal(t, "a", acquire(b, "b"))
+	assert.Equal(t, int32(15), api.lease.Spec.LeaseDurationSeconds)
+
+	// the lease is renewed by its holder
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, "a", acquire(a, "a"))
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, "a", acquire(b, "b"), "the renewed lease should not be expired")
+
+	// the lease expires when it isn't renewed for its duration, whatever the
+	// time in the lease
+	api.lease.Spec.RenewTime = now.Add(time.Hour).UTC().Format(microTimeFormat)
+	assert.Equal(t, "a", acquire(b, "b"))
+	now = now.Add(15 * time.Second)
+	assert.Equal(t, "b", acquire(b, "b"))
+	assert.Equal(t, int32(1), api.lease.Spec.LeaseTransitions)
+
+	// a released lease can be acquired right away
+	require.NoError(t, a.Release(ctx, "a"), "releasing a lease held by another holder does nothing")
+	assert.Equal(t, "b", api.lease.Spec.HolderIdentity)
+	require.NoError(t, b.Release(ctx, "b"))
+	assert.Equal(t, "a", acquire(a, "a"))
+}
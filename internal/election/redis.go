@@ -0,0 +1,77 @@
+package election
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// acquireScript sets the lease to the holder if it's free or already held by
+// the holder, and returns the holder of the lease.
+var acquireScript = redis.NewScript(1, `
+local holder = redis.call("GET", KEYS[1])
+if holder == false or holder == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return ARGV[1]
+end
+return holder
+`)
+
+// releaseScript deletes the lease if it's held by the holder.
+var releaseScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// A RedisLock is a lock held with a redis key which expires after the time to
+// live of the lease.
+type RedisLock struct {
+	pool *redis.Pool
+	key  string
+}
+
+// NewRedisLock creates a new RedisLock for the lease with the given name.
+func NewRedisLock(rawURL, name string, tlsConfig *tls.Config) *RedisLock {
+	return &RedisLock{
+		pool: &redis.Pool{
+			Wait:      true,
+			MaxActive: 1,
+			Dial: func() (redis.Conn, error) {
+				c, err := redis.DialURL(rawURL, redis.DialTLSConfig(tlsConfig))
+				if err != nil {
+					return nil, fmt.Errorf(`redis.DialURL(): %w`, err)
+				}
+				return c, nil
+			},
+		},
+		key: name + "_leader",
+	}
+}
+
+// Acquire acquires or renews the lease for holder.
+func (lock *RedisLock) Acquire(ctx context.Context, holder string, ttl time.Duration) (string, error) {
+	c, err := lock.pool.GetContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	return redis.String(acquireScript.Do(c, lock.key, holder, ttl.Milliseconds()))
+}
+
+// Release releases the lease if it's held by holder.
+func (lock *RedisLock) Release(ctx context.Context, holder string) error {
+	c, err := lock.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	_, err = releaseScript.Do(c, lock.key, holder)
+	return err
+}
@@ -0,0 +1,123 @@
+package sessiontoken
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	// compactVersion prefixes the tokens of the compact format, so they can
+	// be told apart from JWTs, which start with "eyJ", and from later
+	// versions of the format.
+	compactVersion = "v1"
+	// compactMACSize is the size of the truncated HMAC-SHA256 of a token.
+	compactMACSize = 16
+	// compactMaxPayloadSize limits the size of decompressed payloads.
+	compactMaxPayloadSize = 64 * 1024
+)
+
+var (
+	errInvalidCompactToken = errors.New("sessiontoken: invalid compact token")
+	errUnknownKeyID        = errors.New("sessiontoken: unknown key id")
+)
+
+// compactEncoder encodes values in the compact format:
+//
+//	v1.<key id>.<payload>.<mac>
+//
+// The payload is the deflated JSON of the value, and the MAC is a truncated
+// HMAC-SHA256 of everything before it. Unlike a JWT there's no header, and
+// the payload is compressed, which roughly halves the size of session
+// tokens.
+type compactEncoder struct {
+	key   []byte
+	keyID string
+}
+
+func newCompactEncoder(key []byte) *compactEncoder {
+	return &compactEncoder{key: key, keyID: compactKeyID(key)}
+}
+
+// compactKeyID returns the id of a key, so tokens signed with another key
+// can be told apart from tampered tokens.
+func compactKeyID(key []byte) string {
+	h := sha256.Sum256(key)
+	return base64.RawURLEncoding.EncodeToString(h[:6])
+}
+
+// isCompact returns true if the data is a compact token, of any version.
+func isCompact(data []byte) bool {
+	return len(data) > 0 && data[0] == 'v'
+}
+
+// Marshal encodes a value as a compact token.
+func (e *compactEncoder) Marshal(v interface{}) ([]byte, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	signed := compactVersion + "." + e.keyID + "." + base64.RawURLEncoding.EncodeToString(buf.Bytes())
+	return []byte(signed + "." + base64.RawURLEncoding.EncodeToString(e.mac(signed))), nil
+}
+
+// Unmarshal verifies a compact token and decodes its value.
+func (e *compactEncoder) Unmarshal(data []byte, v interface{}) error {
+	parts := bytes.Split(data, []byte("."))
+	if len(parts) != 4 {
+		return errInvalidCompactToken
+	}
+	if string(parts[0]) != compactVersion {
+		return fmt.Errorf("sessiontoken: unsupported compact token version %q", parts[0])
+	}
+	if string(parts[1]) != e.keyID {
+		return errUnknownKeyID
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(string(parts[3]))
+	if err != nil {
+		return errInvalidCompactToken
+	}
+	signed := data[:len(data)-len(parts[3])-1]
+	if !hmac.Equal(mac, e.mac(string(signed))) {
+		return errInvalidCompactToken
+	}
+
+	compressed, err := base64.RawURLEncoding.DecodeString(string(parts[2]))
+	if err != nil {
+		return errInvalidCompactToken
+	}
+	r := flate.NewReader(bytes.NewReader(compressed))
+	defer r.Close()
+	payload, err := ioutil.ReadAll(io.LimitReader(r, compactMaxPayloadSize+1))
+	if err != nil {
+		return fmt.Errorf("sessiontoken: invalid compact token payload: %w", err)
+	} else if len(payload) > compactMaxPayloadSize {
+		return errors.New("sessiontoken: compact token payload is too large")
+	}
+	return json.Unmarshal(payload, v)
+}
+
+func (e *compactEncoder) mac(signed string) []byte {
+	h := hmac.New(sha256.New, e.key)
+	_, _ = h.Write([]byte(signed))
+	return h.Sum(nil)[:compactMACSize]
+}
@@ -0,0 +1,103 @@
+// Package sessiontoken encodes session tokens as JWTs or in the smaller
+// compact format, and decodes tokens of both formats, so the format can be
+// changed without signing everyone out.
+package sessiontoken
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/encoding"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+)
+
+// ErrLegacyTokenExpired is the error for a JWT session token which was
+// issued longer ago than the legacy max age.
+var ErrLegacyTokenExpired = errors.New("sessiontoken: legacy session token is no longer accepted")
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
+// The formats reported by the session token metrics.
+const (
+	formatJWT     = "jwt"
+	formatCompact = "compact"
+)
+
+// An Encoder encodes session tokens in the configured format.
+type Encoder struct {
+	service      string
+	compact      bool
+	legacyMaxAge time.Duration
+
+	jwtEncoder     encoding.MarshalUnmarshaler
+	compactEncoder *compactEncoder
+}
+
+// New creates a new Encoder for the session tokens of the given service,
+// signed with the shared secret.
+func New(options *config.Options, service string) (*Encoder, error) {
+	key := []byte(options.SharedKey)
+	jwtEncoder, err := jws.NewHS256Signer(key, options.GetAuthenticateURL().Host)
+	if err != nil {
+		return nil, err
+	}
+	return &Encoder{
+		service:        service,
+		compact:        options.SessionTokenFormat == config.SessionTokenFormatCompact,
+		legacyMaxAge:   options.SessionTokenLegacyMaxAge,
+		jwtEncoder:     jwtEncoder,
+		compactEncoder: newCompactEncoder(key),
+	}, nil
+}
+
+// Marshal encodes a value in the configured format.
+func (e *Encoder) Marshal(v interface{}) ([]byte, error) {
+	if e.compact {
+		return e.compactEncoder.Marshal(v)
+	}
+	return e.jwtEncoder.Marshal(v)
+}
+
+// Unmarshal decodes a token of either format. Once the format is compact,
+// JWTs issued longer ago than the legacy max age are rejected.
+func (e *Encoder) Unmarshal(data []byte, v interface{}) error {
+	if isCompact(data) {
+		err := e.compactEncoder.Unmarshal(data, v)
+		e.record(formatCompact, err)
+		return err
+	}
+
+	if e.compact && e.legacyMaxAge > 0 {
+		var claims struct {
+			IssuedAt *jwt.NumericDate `json:"iat"`
+		}
+		if err := e.jwtEncoder.Unmarshal(data, &claims); err != nil {
+			e.record(formatJWT, err)
+			return err
+		}
+		if claims.IssuedAt == nil || timeNow().Sub(claims.IssuedAt.Time()) > e.legacyMaxAge {
+			e.record(formatJWT, ErrLegacyTokenExpired)
+			return ErrLegacyTokenExpired
+		}
+	}
+	err := e.jwtEncoder.Unmarshal(data, v)
+	e.record(formatJWT, err)
+	return err
+}
+
+func (e *Encoder) record(format string, err error) {
+	result := metrics.SessionTokenValid
+	switch {
+	case errors.Is(err, ErrLegacyTokenExpired):
+		result = metrics.SessionTokenRejected
+	case err != nil:
+		result = metrics.SessionTokenInvalid
+	}
+	metrics.RecordSessionTokenDecode(context.Background(), e.service, format, result)
+}
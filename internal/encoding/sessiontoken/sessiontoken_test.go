@@ -0,0 +1,105 @@
+package sessiontoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/sessions"
+)
+
+func newTestEncoder(t *testing.T, format string, legacyMaxAge time.Duration) *Encoder {
+	t.Helper()
+	e, err := New(&config.Options{
+		SharedKey:                "80ldlrU2d7w+wVpKNfevk6fmb8otEx6CqOfshj2LwhQ=",
+		SessionTokenFormat:       format,
+		SessionTokenLegacyMaxAge: legacyMaxAge,
+	}, "test")
+	require.NoError(t, err)
+	return e
+}
+
+func newTestState(issuedAt time.Time) *sessions.State {
+	return &sessions.State{
+		Issuer:   "authenticate.example.com",
+		Subject:  "00u1abcdefghijk2",
+		Audience: jwt.Audience{"authenticate.example.com"},
+		Expiry:   jwt.NewNumericDate(issuedAt.Add(14 * time.Hour)),
+		IssuedAt: jwt.NewNumericDate(issuedAt),
+		ID:       "3b0d1f7e-6c2a-4c8e-9d0f-1a2b3c4d5e6f",
+		AuthTime: jwt.NewNumericDate(issuedAt),
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	jwtEncoder := newTestEncoder(t, "", 0)
+	compactEncoder := newTestEncoder(t, config.SessionTokenFormatCompact, 0)
+
+	state := newTestState(time.Now())
+	rawJWT, err := jwtEncoder.Marshal(state)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(rawJWT), "eyJ"))
+	rawCompact, err := compactEncoder.Marshal(state)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(rawCompact), "v1."))
+	assert.Less(t, len(rawCompact), len(rawJWT)*3/4, "compact tokens should be smaller")
+
+	for _, e := range []*Encoder{jwtEncoder, compactEncoder} {
+		for _, raw := range [][]byte{rawJWT, rawCompact} {
+			var got sessions.State
+			require.NoError(t, e.Unmarshal(raw, &got), "both formats should be accepted")
+			assert.Equal(t, state, &got)
+		}
+	}
+}
+
+func TestEncoder_InvalidCompact(t *testing.T) {
+	e := newTestEncoder(t, config.SessionTokenFormatCompact, 0)
+	raw, err := e.Marshal(newTestState(time.Now()))
+	require.NoError(t, err)
+	parts := strings.Split(string(raw), ".")
+
+	other, err := New(&config.Options{
+		SharedKey:          "gXK6ggrlIW2HyKyUF9rUO4azrDgxhDPWqw9y+lJU7B8=",
+		SessionTokenFormat: config.SessionTokenFormatCompact,
+	}, "test")
+	require.NoError(t, err)
+	otherRaw, err := other.Marshal(newTestState(time.Now()))
+	require.NoError(t, err)
+
+	tampered := []byte(strings.Join([]string{parts[0], parts[1], parts[2] + "A", parts[3]}, "."))
+	for _, tc := range []struct {
+		name   string
+		raw    []byte
+		expect error
+	}{
+		{"tampered", tampered, errInvalidCompactToken},
+		{"other key", otherRaw, errUnknownKeyID},
+		{"missing mac", []byte(strings.Join(parts[:3], ".")), errInvalidCompactToken},
+	} {
+		var s sessions.State
+		assert.Equal(t, tc.expect, e.Unmarshal(tc.raw, &s), tc.name)
+	}
+
+	var s sessions.State
+	assert.Error(t, e.Unmarshal([]byte("v2."+strings.Join(parts[1:], ".")), &s), "unknown versions should be rejected")
+}
+
+func TestEncoder_LegacyMaxAge(t *testing.T) {
+	jwtEncoder := newTestEncoder(t, "", 0)
+	e := newTestEncoder(t, config.SessionTokenFormatCompact, 24*time.Hour)
+
+	fresh, err := jwtEncoder.Marshal(newTestState(time.Now().Add(-time.Hour)))
+	require.NoError(t, err)
+	old, err := jwtEncoder.Marshal(newTestState(time.Now().Add(-48 * time.Hour)))
+	require.NoError(t, err)
+
+	var s sessions.State
+	assert.NoError(t, e.Unmarshal(fresh, &s))
+	assert.Equal(t, ErrLegacyTokenExpired, e.Unmarshal(old, &s))
+}
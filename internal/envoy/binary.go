@@ -0,0 +1,62 @@
+package envoy
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+// supportedEnvoyVersion is the envoy release the generated configuration is
+// written for. Patch releases are compatible.
+const supportedEnvoyVersion = "1.20"
+
+// envoyVersionRE matches the version in the output of `envoy --version`, e.g.
+// "envoy  version: 8fb3cb8/1.15.0/Clean/RELEASE/BoringSSL".
+var envoyVersionRE = regexp.MustCompile(`/(\d+\.\d+)\.\d+/`)
+
+// getEnvoyPath returns the path of the envoy binary to run. A configured
+// binary is used as is, so the embedded one doesn't need to be extracted.
+func getEnvoyPath(options *config.Options) (string, error) {
+	if options.EnvoyBinaryPath != "" {
+		if err := checkEnvoyVersion(options.EnvoyBinaryPath); err != nil {
+			return "", err
+		}
+		return options.EnvoyBinaryPath, nil
+	}
+
+	envoyPath, err := extractEmbeddedEnvoy()
+	if err != nil {
+		log.Warn().Err(err).Send()
+		envoyPath = "envoy"
+	}
+	return envoyPath, nil
+}
+
+// checkEnvoyVersion checks that the envoy binary is a supported version.
+func checkEnvoyVersion(envoyPath string) error {
+	out, err := exec.Command(envoyPath, "--version").Output() // #nosec
+	if err != nil {
+		return fmt.Errorf("error getting envoy version (path=%s): %w", envoyPath, err)
+	}
+	version, ok := parseEnvoyVersion(string(out))
+	if !ok {
+		return fmt.Errorf("unknown envoy version (path=%s): %q", envoyPath, out)
+	}
+	if version != supportedEnvoyVersion {
+		return fmt.Errorf("unsupported envoy version %s (path=%s), %s is required", version, envoyPath, supportedEnvoyVersion)
+	}
+	return nil
+}
+
+// parseEnvoyVersion returns the major and minor version of envoy from the
+// output of `envoy --version`.
+func parseEnvoyVersion(out string) (string, bool) {
+	m := envoyVersionRE.FindStringSubmatch(out)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
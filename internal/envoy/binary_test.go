@@ -0,0 +1,70 @@
+package envoy
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func Test_parseEnvoyVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		out    string
+		want   string
+		wantOK bool
+	}{
+		{"release", "\nenvoy  version: 8fb3cb86082b17144a80402f5367ae65f06083bd/1.15.0/Clean/RELEASE/BoringSSL\n\n", "1.15", true},
+		{"patch release", "envoy  version: 2ad5c5b3ae2ed8dd4ce4fd0e9d1f4ea4b5a34a0b/1.15.3/Clean/RELEASE/BoringSSL", "1.15", true},
+		{"other release", "envoy  version: e98e41a8e168af7acae8079fc0cd68155f699aa3/1.16.0/Clean/RELEASE/BoringSSL", "1.16", true},
+		{"garbage", "command not found", "", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseEnvoyVersion(tt.out)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_getEnvoyPath(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "pomerium-envoy-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeEnvoy := func(name, version string) string {
+		p := filepath.Join(dir, name)
+		script := "#!/bin/sh\necho \"envoy  version: 8fb3cb86082b17144a80402f5367ae65f06083bd/" + version + "/Clean/RELEASE/BoringSSL\"\n"
+		require.NoError(t, ioutil.WriteFile(p, []byte(script), 0755))
+		return p
+	}
+	supported := writeEnvoy("envoy-supported", supportedEnvoyVersion+".2")
+	unsupported := writeEnvoy("envoy-unsupported", "1.10.0")
+
+	got, err := getEnvoyPath(&config.Options{EnvoyBinaryPath: supported})
+	assert.NoError(t, err)
+	assert.Equal(t, supported, got, "should use the configured binary")
+
+	_, err = getEnvoyPath(&config.Options{EnvoyBinaryPath: unsupported})
+	assert.Error(t, err, "should check the version")
+
+	_, err = getEnvoyPath(&config.Options{EnvoyBinaryPath: filepath.Join(dir, "missing")})
+	assert.Error(t, err)
+}
+
+func Test_embeddedEnvoyNames(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"envoy-linux-arm64", "envoy"}, embeddedEnvoyNames("linux", "arm64"))
+}
@@ -2,8 +2,10 @@ package envoy
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 
 	"github.com/natefinch/atomic"
 	resources "gopkg.in/cookieo9/resources-go.v2"
@@ -22,7 +24,16 @@ func extractEmbeddedEnvoy() (outPath string, err error) {
 	}
 	defer bundle.Close()
 
-	rc, err := bundle.Open("envoy")
+	// prefer a binary built for this architecture, so a single bundle can
+	// contain binaries for several, and fall back to the unqualified name used
+	// by older bundles
+	var rc io.ReadCloser
+	for _, name := range embeddedEnvoyNames(runtime.GOOS, runtime.GOARCH) {
+		rc, err = bundle.Open(name)
+		if err == nil {
+			break
+		}
+	}
 	if err != nil {
 		return "", fmt.Errorf("error opening embedded envoy binary: %w", err)
 	}
@@ -62,3 +73,9 @@ func extractEmbeddedEnvoy() (outPath string, err error) {
 
 	return outPath, nil
 }
+
+// embeddedEnvoyNames returns the names the envoy binary for the given
+// platform may have in the bundle, in order of preference.
+func embeddedEnvoyNames(goos, goarch string) []string {
+	return []string{"envoy-" + goos + "-" + goarch, "envoy"}
+}
@@ -70,10 +70,9 @@ func NewServer(src config.Source, grpcPort, httpPort string) (*Server, error) {
 		return nil, fmt.Errorf("error creating temporary working directory for envoy: %w", err)
 	}
 
-	envoyPath, err := extractEmbeddedEnvoy()
+	envoyPath, err := getEnvoyPath(src.GetConfig().Options)
 	if err != nil {
-		log.Warn().Err(err).Send()
-		envoyPath = "envoy"
+		return nil, err
 	}
 
 	srv := &Server{
@@ -10,6 +10,7 @@ import (
 const Web = "web" // static asset namespace
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x004\x07\xf5P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00html/dashboard.go.htmlUT\x05\x00\x01\x85=\x16_\xecYOO\xeb8\x10\xbf\xf3)\xbc\x16\xc7m\x8c\xd8=\xac\x9e\xd2j\x11\xf0\x10\x12Z\x10\x7f\x0e\xefT\xb9\xf1$\xb1\xd6\xb1\xb3\xb6S\x8a\xaa~\xf7\x95\x93\xb4M\x13S\xda\x12xh\xb5\xbd4\xf1\xcc\xd83\xbf\x9f\xc7\x93L\xe6s\x061\x97\x800\xa3&\x9d(\xaaY\x90\xdaL\xe0\xc5\xe2(\xfc\xe5\xe2\xf6\xfc\xf1\xc7\xdd%r#\xa3\xa3\xd0\xfd!Ae2\xc4 1\x8aR\xaa\x0d\xd8!.l<\xf8\x03\x8f\x8e\x10\nS\xa0\xcc] \x14Zn\x05\x8c\xeeT\x06\x9a\x17YH\xaa\xfbR6\x9f[\xc8rA- \xec,@\xaf\x16E($\xd5$\xeer\xa2\xd8K=\x1d\xe3S\xc4\xd9\x10g\x94K\\\x8d5F\xb9\x8c\xd5`\xa2f+I-\x8b\x045f\x88#\xaaYC\xd4\x15\x0e*76t\\8\xa7\xa3\xf3Bk\x90\x16\x15\x06tH\xd2\xd3M\x8d\xf9\x9c\xc7(x2\xa0\x83sAyf\x82\x9cG\xb6\xd0\x80\xcaX\x1aS\xf1,Y.\xc8#%12:\x1a\xe2\xf9\xbc2\xbe\x02[\xda#\\\xdb\xe3\xc5\x02#*\x1c\xba\x064\xe2\x19M\x00#\xd2^\x1d\x84\x01\xcfJ\x1b\x03hc\xdd\x96\xa8\xf6\x82QK\x9f\xeeo\x10&A^3F\xa81`\x0d\xe1YBh\x14\xa9B\xdaq\xc4u$`p\xfa{>\x0b\xcc4qN\xb6\xe6\x9beB\x9a!N\xad\xcd\xbf\x11\xf2\xfc\xfc\x1c<\xff\x16(\x9d\x90\xd3\x93\x93\x13\xe2l6\x0c\xba\x01I\xb6\x11OH\x18\x9f\x96\x9ba5\x12+\x9d\xa1\x0cl\xaa\xd8\x10_]>bD#\xcb\x95,\xd1|\xe0\x89\xbc-\xec\xd3\xfd\xcdb\xd1f\xd3@\xa97j\xb9\x1c\xe6K\x8020\xc6\xe1<\xfa\xa1\n\x8d\xa2\x9ay\x03\xc6p%\x11\x03K\xb90AH\xf2\xce\x141\x07\xc1\x0c\xd8\xb6\x00\xa1P\xd0	\x88\xee\xb8\xf3'\xa7r\xf4t\x7f\x13\x92\xf2\xca\xa7BQ\xaa!.#\xbb\x07\xc65D\xcb\xd0\xda#!\xa1\x9e\xc5I\xbdzG\xd2\xd8\xb8\x7f\xd1\xac\xbd\x87\xd0\x0e\x8e;\xb3-\x9es\x99\x17\xd6#@\xc8\xbe\xe40\xc4\x16f\xb6\xbdy\xaa_MF	\xa9_cJE\x01\xeb\xe4\xa9\x02\xf0\xab\x96\xa7\xcen\xaa\x8c\x1b:\x11\xc0<B\xb2\x05\xda\xb6`\x99\x94\x9e\xb3!\xe1S\x90cy\x18\xdeW\xce\x18}\x1d\xd4\xd7G\xd6:,\xcf\x81P\xfdZ4\xece\xdb\x1f/\xeel\xf1\xd0\x12\xd3\x8c\x8b\x97Cy\xf9^Z\x7fIb\x1a\x81\xed\xcf\xcc.\xc6\xbdS\xd3\xa1\xe8\x9a\x1d@\x89\xb3\xbc\xbe\xf8\x1al\xb8\x00vB\xfeu\xc5\xdeQ^\xa1{\x99Q.\x0e\x00\xb8\xb4{\x07\xbe\xe0\xec{\x02\xb8\x8ea'\x8c\xb7\xea\xf6\x0e\xb3\xa62\x01t\xcc\x7f=\x1e\x7f\x1b\xa2\xe0\xa2,\xd6J\xbf\\iU\xe4f/\xd8K\xce\xe0\x1ft\xcc\xd1\x89\xc7pU#\xdc\xcc\xaf\xf3\xe2}^l\xdao5\x95\xbeT\xdc\x81\xec~\x92i\xb7\"\xffYI\xe4\x19\x7f\xe66E\xc1C\xf5\xb0\x18\\\xb3G\xf57\xc8-\x9a\x97\xb3\x9ck0gv\xaf\x8dP\xe7_e\x8b\xce\xec;\x92\xb0\x1f^\xce\xcc#\xdf\x85\x99\xedz\x9f\xc4\xce\xb51\x05\xb0\x83 \xafL\xffG\xbc\xf5{\x1d\xf1j\xfc\xf5D\xb1\xd4\xfa\x8e\xa2\x15UY\x0e\xda(I-\x1cZ\xa5\xd6Sp\x99\xa0\xf7\xd6\xac~\xc8\xfbit\xb4\x8aQ\x03\xde\x8f+G\x9b\x04\xfc\x87\x8b\xd3\xdb\xc9\xf8\xd3x\xf7\xa6aH\xfc\x0d\x8b\x90x{$\xcdnY,`\x86\xdb\x0e\x84\x93\xc2Z%\x97:\xf5]\\\x08\x81k\x9cM1\xc9\xb8\xc5\xa3\x07\x9eHt[\xd8\x90TJ\xed\xf5\xcbnOs V:[\x8fl(l\xf6\x86\xaa\xc7\xdaks\xc62\xbe\xae\xbb\x9f\xd0!t!\x0d\xb8\x1cP\xd3\xed\x0f~D3\xce\x149\xe8)7\xc0\xc6\x85\x01\xfd\xc1M\xb9\xb7\x1apw\xb7\x0f\x8d\x0e\\\xd3c\xc7\x03\xe1\xebs\xa6\x0d]\x99\x9a\xf5\xf6H9c q#\xa56\xdb]\xc8\xbd\x86\x0e\xf1r\xf2\xb1\xae\xa5\xe3B\xf3\xc3\xdb|\x9dt)\xf7\x0e7VS\xab\xb4A\x11\x95\xc8B\x96+M\xb5{\xc7o\x04\x83\xa8T6\x05]\xf6\x85\x83Nz\xf5\xd9!<\xbcpU\xa0\xcd\xe7\xddj\x8a^=\xb1z{?\xf3\x0bsA#H\x95`\xa0\xab\xd6\xf6\x9f0\xa3Y. \x88T\xe63\xd9\xe7\x14|\x0b\xc97*\xd0\xbeHV\x85\xf3\x0d({(0; 	2\xe1\x12@s\x99\xbc\x0f\xc4\xbe+\x83\x83,2:\xfe\xeefm\x7f\x14Y\x15\x8e\x8ew^\xbc\xcfJ\x07\xbcx\xd7H\x19\xf0`\xed)J\x1d\x9d\x8d\"\xd5\x92v\xb1k\xf8\xd4\x81\xaf\xb7\xb2V\xdd4\x0b\xfbJ\x10\x92\xea\xc3XH\xaa/sK\xa5\x7f\x03\x00\x00\xff\xffPK\x07\x08H\xa4d\x94\x96\x04\x00\x00\xce\x1b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x88\xbd\xbbP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00html/error.go.htmlUT\x05\x00\x01P\xfb\xce^\x9cUKo\xdb<\x10\xbc\xe7W\xec\xc7s,\x06\xfe\x8a\xa2-d_\x92\x1c\x02\x04h\x90&\x05z\n\xd6\xe4J\" \x91.\xb9\xf2\x03\x82\xfe{A\xd9N\xf5p\x82\xb6'K\xfb\xe2\xccj\x86n\x1aM\x99\xb1\x04\x82\xbcw>)\xb8*E\xdb^\xa4\xff\xdd|\xbd~\xfa\xf1p\x0b1\xb2\xbcH\xe3\x0f\x94h\xf3\x85 +@\x15\xe8\x03\xf1B\xd4\x9c\xcd>\x89\xe5\x05@Z\x10\xea\xf8\x00\x90\xb2\xe1\x92\x96M\x93|c\xe4:\xb4-\xcc\xe0\xf5\xed\x89v\xdc\xb6\xa9<\x14u\x0dM\xc3T\xadKd\x02\x11\xc7\xd0o$\x00\xa9<MNWN\xef\x8fGh\xb3\x01\xa3\x17\xa2Bc\xc5!\xd6\x8b\x1a\x9b\xb9\xd9\xca\xed^3\xc7\x9c*1\x84\x85P\xe8u/5M\xce\x0e(\x065\x00\xa9\xa9\xf2A\x00N=F9+F\xa9\xe0\xd5B4\x8dF\xc6\xe7\xc7{\x102Y\xbb\x8a\xbc\xa9+\x89!\x10\x07i\xaa\\v{\x9f\xcd?\xacwI\xd8\xe4\xa2m\xc7cvUi\xc3B\x14\xcc\xeb/Rn\xb7\xdbd\xfb\x7f\xe2|.\xe7WWW2\xf6\x0c\x1a\xe4\x08r1_N6_\xcc\xdf)\x9a\x16\xa4R\x9b\xcd \x10H\xb1qv4\xa5\xb7\xc3\x8aB\xc0\x9cF\xfb\x1b\xd60\xedxV9\xeb\xc2\x1a\x15\x89\x88\xe06.#\x02\x18\x1dx\x06C\x14\x8d\xc9 \xb9F{C\xab:\xef\xa4r\xfe\xa0\xb7\xc0\xdce\xb0w5\x84\xc2\xd5\xa5\x86\x027\x04\xa8\x14\x85p	\xcaYF\xc51\xef\x01ue\xac	\xec\x91\x9d\x07\xb4\x1a\xd6\xdem\x8c\xa6\xd1<.\xa8\x82\xad\xe1\xa2k\x1b3G(<e\x8b\xbe\x0c\xc4\xd2\xd3\xcf\x9a\x02\x83&FS\x86T\xe229\xc7{D\x9c\xacn\xdbn\x01\xc9#\xb1\xdf??\xde\xff;\xff\x15\x95\x866\x14\xe1C\xa7F0\x01\xa2\x1f\x9dG\xbf\xbf\xecj\x14\xda\xb7\xf84M\x0fCd\xc4~\x1fyt\xf3\x8e\xfc\xcer\xea\x87\x8e\x94\x06\xa2;#\xb2\x89M3\xe7xj\xd3\x13\xb4\xe8\x99p4\xcdi\xe9\x89qSUN}\xfd\xa7\xf6=\xc5^\x94\xf1\xaa\xa4\x97\xcf\x1f\xdf\xb0\xf1_\x1b\x19\xde\xbf]\xc6>\x978R\xc9\xc4j\xde\xe4\x05\xc3\xc1u5\x93\x86PaYN\x96\xd1}\xcf\xee\xab\xdd\xdd\xb4-\xa4+?>\x0b\xe0\xe1\xc8:\x16\x7f'\x1f\x8c\xb3c\xfdM\xee\x8ca`\xf0\xda{y}L\xe5\xe1\xb2O\xe5\xe1\x1f\xe8\xa4\x90_\x01\x00\x00\xff\xffPK\x07\x08\xd6L6ob\x02\x00\x00\xb2\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x88\xbd\xbbP\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00html/header.go.htmlUT\x05\x00\x01P\xfb\xce^\x8c\xcd1k\xc30\x14\x04\xe0]\xbfBhN,Z:F\xfd\x05\x9d\n\x9d\xcb\xab|\xb1\x1f\x95\x9e\x8d\xf4\x9c8\x18\xfd\xf7\x12C\xc9\xd0\xa5\xe3\x1d\xdc}\xdb\xd6\xe3\xcc\x02\xebFP\x8f\xd2\x8d\x9a\x93k\xcd\x9c2\x94\x8c\xb5B\x19\xc1]\x18\xd7y*\xea\x8c\xb5q\x12\x85hpW\xeeu\x0c=.\x1cq\xdc\xc3\xc1\xb2\xb02\xa5c\x8d\x94\x10\x9e\x0e6\xd3\xcay\xc9\x8fb\xa9({\xa2\xaf\x84 \x933\xfe\xd5\x9c\x12\xcb\xb7\xb1\xb6 \x05W\xf5\x96PG`\xe7\xf46#8\xc5\xaa>\xd6zo\xc6\x82sp\xdb\xd6\x93\xd2\xc7\xfb\x9bu\xbe\x9b\xa7\x8c\xc2K\xf6T+\xb4\xfa\xfd\xc2gb\xe9\xee\xa3\xd6\xfe*\x1c'y\xfcs\xa6\x01~\x96\xe1\x9f\x00\xe7\xc1S\x8c\xd3\"\xfa\x19\xb9\xc4\x84\xe3\xf3\xcb\xbcv\xf52\xfcj\xdb\x06\xe9[3?\x01\x00\x00\xff\xffPK\x07\x08`}f\xf1\xde\x00\x00\x00c\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00r\x9b\xb2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00img/account_circle-24px.svgUT\x05\x00\x01\xa9\xe1\xc2^<\x90Ao\x830\x0c\x85\xff\xcaSv\x8eq\x9c\x90\xc2Tz\xd8.\xbb\xec\xb4_0\xb5\x8c \xb5\xa5\x1a\x88T\xfc\xfa\xc9\x80&\x81\xfc\xf9\xe5=K\xf6q\x9c;<o\xd7\xfb\xd8\x984M\x8f\xd7\xa2\xc89S\xf64\xfcv\x850s1\xce\x9dA\xee/Sj\x8c\x04\x83\xd4\xf6]\x9a6\x9e\xfb6\xbf\x0d\xcf\xc60\x18\x12 \xc1\x9c\x8e\x8f\xef)\xe1\xa7\xbf^\x1b\xf3\x12\xdb\xe0\xdb\xca\xe0\xd2\x98O'\x90\xf7H\xa1\x82@\xb0\x83\x931(9\xfe\xff\xec.X\xc7_\xee@\xa5\xac6\xc8rc\xf8\xb3\xa3\x18\xc1\xf0p\xe4\x03<\xfch7\xb2^\xff\xb5\xb1\xdal\xa0\x9a\x06] 9[\xa1\x12:\xff\xe0\xac#\xa9l\xb4\x9eD\x885V\xd7\x08\xd6\x13W\x88[Y%FI\xf5\x01\x8e\xb8F\x84\xea\x9a\xac\xf5Q\xdd%t\x80\x8dkYL\xb1o\xaf\xeb28I\x98%|\xf0b\xf6{\xdc\x87{\xab&=\xea\xe9/\x00\x00\xff\xffPK\x07\x08\x83\xba\x83\xe4\xf5\x00\x00\x00|\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00r\x9b\xb2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00img/error-24px.svgUT\x05\x00\x01\xa9\xe1\xc2^<\x8f\xcbj\xc3@\x0cE\x7fE\xa8\xeb\xf1hn\x94\xa4\x94L\x16\xed\xa6\x9b\xae\n\xdd\x17\xe2Z\x06?B=\xcc\x04\x7f}\xf1\x83\n-\x0e\x97#\xc4\xbdL\xb9\xa1G\xdf\x0dSdK\xe9\xfe\xe2})\xa5*\x87j\xfcm<D\xc4O\xb9a*\xed-Yd(\x93\xd5mci\xe3\xdc\xd6\xe5u|D\x16\x12\x82\x12\x94\xaf\x97\xfbw2\xbaE\xfe\x10\x12\x83f\xe8\xbb\xccL?m\xd7E\x1e\xc6\xa1f\xbfK[\xf4tX\x87\xd7\x9b\x00\xc2\xdb\xa9\xd2g\x02\x81v\x08\x98t\xa1 \xff\xeb\xf6\xc0\x05\xf9\x0c\xe7\xea\x88U#\xcc}\xa0p4\x87\xec`\xc8\x98{qj\x0e_gC>\xcd\xcb\xeb\xa5\xd1\xf5/\x00\x00\xff\xffPK\x07\x08\xfc\xc6x\x8f\xb7\x00\x00\x00\xf9\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00r\x9b\xb2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00img/pomerium.svgUT\x05\x00\x01\xa9\xe1\xc2^\xc4UMo#7\x0c\xfd+\x84{i\x0fz\x16I}\x16\xeb\x1c\xfaO\x06\x89\xe3Y\xc0N\x16q:Y\xf8\xd7\x17\xa4\xc6N\x8a\xdd\xf4\xd4\xa2\x08\xc2\x91\x1f)\x0d\xf9\x9e\xc8\xf9r^\x0e\xb4|\xdd\xbf\xfd\xf1\xfc}\xb7\x89\x14\x89\xb5\x93\xc6\x0d}?\x1d\x9f\xce\xbb\xcd\xfc\xfa\xfa\xed\xf7\xed\xf6\xed\xed\x0do\x8a\xe7\x97\xc3Vb\x8c\xdb\xf3r\xd8\xdc}9\xd0\xeb\xcb\xf4t~|~9\xed6\xbe<N\xaf\xfb_C\xa6\x90\x91\x7f\xdb\xdc}\xf96\xbd\xce\xf4\xb0\xdb\x9c8\xa2PF\x9eY*\xda\xbd\"v\x8a\x94QH\xdc&h_\x84!r\x1fI\x90$\x082;\x1c\xae\xfe9\x8c\xcda\xec6<\x88[\xdf\x1d\xd6\xed\xc1\xb6\x93mw\x9c\xae\x01\x97\x0d=~=\x1ew\x9b_\xca>\xe9\xbe\x8d\x9f\xe1\xe5\xcf\xe3~\xb7\xd9/\xfb\xa7\xe7\x87\x87\xcd\xd6\xaaZ\xc3\x1e\x1f\x1f?\x96P3\x12IA\x9190R92X\x83\xa0\xf6 \x90l\x89\xf4\x99\x91\xd3\x91-\x01E+\xc4H\x1a\x14\xad\xba\xe3r\xb2 \xb6\x04\xd3\xb4f\x99\x84\x9cv\n\x8c\x1c\x90\xf3\x02\xad\xf6\x82\xccKhHi\xf6\xa5Z|#7#~\x0d\xbfgd\x83\x04\xc5^'m\xac\x8c\xa2s`\xc4f\xcb\x16\xae`\xbb\x9c\x02TBBk\x13\xa34r\x13\xfd/0\xb8!\xeb\"\xc8b\xdeLn\x86w8\xd3=Z\xf6_)\x05T\x1d\x0bF;\x07\xe4\x1e\xd8\xe8X\xa1\xda/\xa7\xd0\xd0L<\xcb_-7m\x1f\xea\xb5\xfc\xedY)z\x86\x81!u\xac\xbc\x00F\xecC\xed\x1bf<$rs\xe3\xc1X\x0bjD\xc9\xd2\x90\xb2\xd3'\x97S4\xea\xad\x0e\xcb\xb2\xe8\x87*k@\xd6\xfb`\x12EK7\xa3\xaa?\xc9\xd2>#wb\x187\x8e\xf8\x19v\xc4;\x13v\x80\xe9\xd9\x9d?\xf3\xfb\xaa\xdf\"|s'E\xce\xc3\\\x1d\xb5\x04\xe4\xb20\xa4L\xc9\x08t\xb3r\"\x84TH\xe9\x06\xd8\xeda%A\xab\xc3\x0c\\\xd0\x9a\xd1\xa1Cm7WO\xee\xa4\xd6j\xab\x19p$\xe4r91\xd4\xf8\xd54\xb1)\xc1W9\x9c\x15\xad\x06\x94Y\x90\xeb\xe4W\xc9\xcd;k\xa6\xac\x16\xbf\xc5\x9a\xd0uQt\xe7z	\x19}\\T\xe4>	\xc9M\x9c\x94\xc3\xca\xcd\x87\xcb\xc4\x84\xd4\x11\x8d\x05\xcd\x13\xa3\x99@\xed&\x10\xb2\x06D\xcb\xa6\xad\xe6\x96C2\x02/\xa7P`\x9e\xc4?a\xbe\x96\xff\x8d\xfa\xf2\x1f1/ze\xbe\xa0TJh\x06H\xb0.\x13J\xa6\x81\x1e}\x16\x0e\x15l4uJ\xe4\x9a\xd9\x04\xd1\xfa\x03\x94\xf9\x18l\xf6Z\x93v\x88\x82m&u\xeb\xad\x9e>r\x10\xfb\xca\n\x13S1]\"\xfd\x18h\xb8\xf1e]\x97\xd0\xc5\x9a\xab\x8d\xe6\xea\xa8\xd9\x9f.\xf2\x19\x85i\x95|\x85\xac\x875\xb8\xa2Y\xc7\x08A\xb1-^\xa6CMG\xf1\xe2\xf7\xc1\x0b.\x8b\xa0\xb3\xcf\xe2n\xa32\xd6\xd9d\x9a\xc4^\xe0f\xa4l\x9f\x19\xb1\xb1'\x12\x9c*N\x8b\xa0\xf1l\xab\xbf\xa9a\xb16\x88j\xbe|\xf6qx\xff\xa0\xa9\xa02qB\x9f\x14]\xc9\xcd\xaa\x98\xad\x837\x87&\xf0'\x01\x06\xc8\xc2\x05\x9cf;k		\x99\xa7l\xac\xb8\x19\xe93S\\\xcc3\xcb\xbf\x11\x81T/\xa7\xa0\x1d\x9a(\xe1\xe7\xa1\x97\x13\x0bz\xa6\xf8\x0f\xde\xf4\x99\xd7X\xda\x1e\xd6\xff\xf3r\xb8\xfb+\x00\x00\xff\xffPK\x07\x08K\xfe\x8b#M\x03\x00\x00d\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb1r\xd0P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00img/pomerium_circle_96.svgUT\x05\x00\x01n\xd5\xe8^\x8c\xfa\xc9\xce\xebJ\xb3-\x86\xbe\xca\x8f\xbfu/\xe4s(R\x14Im\x9fm Y\xd7u\xdd\xd9`]\xd7\xa4\x92\xd4\xd3\xf8Y\xfcd\x864\xe7\xda-\xc3p\xe3k\xac\xc5\xcc\x8c\x8c\x88\x11#F\xa4\xe6\xff\xda\xde\xd5\xbf\x9a\xfc?\xff\xed\x17\xd9>\xad\xff\xe5\x0c\xc9\xba\xff\x97\x91\xb6E\xb6\xff\x974$U\xf1\xef\x7f\xe5\xc9\x9e\xfc\x8f1\x19\x8a\x7fV\xfd\xeb\xb7\xea_\x7fV\xfd_\xff\xe7\xdfe\xe7\xd0\x8f\xdb\x7f\xfe\xbb\xde\xf7\xf9?\x10\x04B\xf8?\xe1\xe3\x7fNk\x85`\xf7\xfb\x1d\xd9\xde\xd5\xdf%\xffq\xf6\xcd\xd8\xfd?-D_\xaf\x17\xf2\xfb\xfa\xef\x7f\xbd\x9b\x02\xd2\xd3\xf9\x9f\xff\xbe\xff\xeb\xfe/\x0c%\xbe\x7f\xff\xfe?\xfeW\xf3\xb5\xf5\xff~\xe1\xff\x81\xfd\x7f\xbe2l\xf2\xbd\xfe\xcf\x7f\xbf\xee\xf7\x7f\xff\xab.\x9a\xaa\xde\xff\xfe\xc7\xbe&\xe3VN\xeb\xf0\x9f\xff\xde\xb2\xa4/\xfe\x7f\xf7\xff\x89\xe1\xff\xff\x7f\xff\xebw\xb9\xff\xa8\xd7\xa2\xfc\xcf\x7f\x7fm\xfc\xc7\xef>\xc8<V\xff{\x9al\x05\x81\xffo\x8dO\x1b6\xbc+B5\x01\x00\x80\xeex5\xe7U\x00\x00\xdc\x02\x00\x18\x1c\x03\"\x00\x00\xfbt\xdf\x14\xf9]\xc0\x84\xb4\x14\x84\x1a\x00\x0c\xde\x02\x00\x0e	\xf2\xf8\xec\xbe0\x00@\x05\xb8\x9e\xb3|\x1b\x0f\x8fO\x9e\x93u\xeeG\xe2\x8d*^\xbad\x03\x02\x14=\xadD\xb2W;X\xdd4\x8aTM\x8a\xd2\x82\xa9!\x96*\x1dC\xde:\xa1\xba,\x86\xa1\xb1A\x89\x1bn\xb5\xd4\xa7\xd03\xbe\xd2\xbdN\x89\x90\x0d\xce\x92T\x8b\xb3\xaaf\x9ee\xc1\xaae\x9a o73\xb7\xa4\xfa!\xbeC5)7\xea}\xa7>\x8f\xceIw\"\x7f\x8a\x1d.?\x8aWA`/\xbf\xdfE\x1b\x16\x8e\xac\xfa\xc9\xd0\x02\x0b\x13\x1c\x1d%\xe2\x15\x11a\x1b\xb1hea\x80\x8d\xf4G\x82\xe2\x11\xb0p\x91\xde\x13\xca\\\xc5{>)(F\xc6\x13\xb6\x0c\x01\xb1\xc19:\xf44\xe4+f}c\xdc\xb1\n^\x00\xae\x03\xc8\x19sa{\x11\xa2+qc\xc4f\x7f\xad\x1e\xbf\xe7%\xa5\x87\xc4m\xe2\xe5\x9e\x8f\xa5n\xf7\x9b\xb4\xf6\x19\x07\x05\xf5\x0c\xeb\x14\xde\x86#\xfd~\xcf\x8b\x87\xe8\xe61\xb1:\xcf+N\xf9!\xf0?\xaf\xa12\xb5\xd5\xbc\xe3\xf4\xb39oG\xe1+\xa3A\xdc\xc4{\xe7\x14\xd3\x87!\xb8n\xf1K\xa7\x06\x18\xc03\x16\x83b\x82\x8a/\xcaH\xb5.\x8d\x8b\xd7\x0e\x15p\x94\xd5\x1b\xfdxs\xa5\x0f7\xef\xecG\x10\x0b`\xd1\x94\x07\x86?m\xa5\xcf\xfc\x1c\xb1\x12GV\x1e\xba\xd5\xd4r1k\xf7(\xd4+\x1b\xa3Gk\xe7\xd3\xb0\x9c\x83\x9e\xa2\xf5\x83<\xa3\xc0e\xca\xa0\x1d\xbb6\x95p!\xae$W\x99\xbd!\x7f\xa3s\xact\xdb\xcaYN/\xc9\xb3\xd5\xa7 \xdd,g\x7f\x19{^~\xff\x92\xcf\x0b\x9b\x03:\xb2\xae\x83\xe7psL\x92K\x85\xa1\x12*\xce\x0b\xcd\x15\xae[\xc4\xea\xa8e\xda\xf7\x86\x96\x91<\xd3\xc5h1b_\xcb\xfa:X\x03\x96m\x11.\xeb\x15\x00\x8d\xa2q\x9c\x1b\x93\x96\xba1\xeeY.\xa9r\x1dp\xeb\x9d\xb2\xef\xa4\xd8\xe9Fns\xe9ZW\x1e\x07	\xc4\xd5+s\x98ct\x9e\xce\xcf^\x99\xe7g\xc4r83&\xc4mL\xda\x97\xb1\xf2v|\xcf1\xbe[\xecm;i\xc5\x9b\x88\x8e\x897\x9a\x84\"F\xbf\x93\xf9\x1d\xa6\"\xbe\x94A\x00p\n|\xa0`\x02B\x03'\xb1\xfb\xe2\xf0\xc4\x88\xads\xe2\xbe\xfe\xdal\x95\xe9\xf5$\xb3\xf7\xc6#\xfb\xf9\xb8\xcb\x02\xb9K\x81\x9b\xf1\x02\xbf\x93\xa6\xa5h\xaf\xb2\xe7\x9cl\x1a\xbe9\x8a=&\xd9X\xa2\xbe\xd1\xa8U\xdc\xeef\xaa\xbfw\xdbWn.(\x05\xa0\xe8\xcbz\xcf\x16\xdf'w\xa4\x96eo\xd1c\x86	\xe6+W8}S?\xa5\xa3/+\xd4d\x81|\xe1\xe9\x8c(1\xe3\xd7\xf0\xb9\x88\x95.\xa0(n+\xbc\x95\x0d\xddns|'\xcb\xc9\n\xee\x91S\xd7\xe8\x81X\xd8D\xb5\x14\x03\x91\xc55?L\xe6C^\x04\xa5\xa6\xccN\xec\x8d\x8f\xfe\xde8\xb7Mg\x14\xaeG\xfb&\xde\x18\xfad\x14\x89\x11AA\xf1\xf4\x98\x93]\xe2\x1a+\x00\x05}\xe05\xaf\xb9\xa4\x82zA\x1e\xdedY\xe9\x1e\xaa\xe48(wCG\xf7\x15\xf6\xe4\x8be\xd2\x03\xb0\xc7\x07\x93\xba\xfe\xb5Y3\xde\xf3\xc0 /\xce;\xc51\x0f/Y&&\x82\xf7d\x99P\xbcGT\xf1\xec\xcd\xeck\x92\xb4:\x8d0\xa3\xc6\x89\x1e\x96;V\x06\x95\x91	\xf1|*}\x16\xfe\xf6\x04\xb3\xc1{\xb2\xd4\xdc'\x87\xae\xf9;\xd2^\x92\x07\x81\x0e\xc1Y\xcd\x0d\x1a9c{\xa38\xb2\xf2\x05\xde\x98\xb7e%\x9d\xcb\x99\x1f\xcc\xc5u\xbb#\xeb\x02'\xe2\\[A\xdcr\x0en|\xf8\xf7V\x99\xf8\x02\x188\x9dV\xec\xb6\xf8N\xfc\x10\x9fEG}1[+\xef\x0f\x94\n\x96\x86lI\xbb\xd1\xbb\xdcV\xd9\x08\xad;\xc5\xe8\x10<\x00\xa0\xf0\xc2\x99\x15\xde\xe3\x15\x19u\x13\xf6f\xb3P\xcbd\x0d\x17\xd2<\xbc\x84\xd9\xe2\xf1\xe1C\x7f\n\xa0\x9f\xc9R\xca\x97\xcc\xbc\xe4:\xb0\xea\x9e\xb7\x96$\x93\x8f\x1a\xc88+\xf0\xc2]\xc8h9\xb9s\xe2\x02\xb0\x02mf\xaa\x83\x9dz\x1f\xf8\xc8\x11<_\xa8gX\xb1\x95e0.\x14E\xe0\xeeh)y\x1d\xc5\xa2P\x0d\xc1\x9d\n\x94\xd1\x11\xb7^\xf5\x86g\xcc0\x9e\xef\xce\x13\x14\xd8&\xf3\x80b\xd2i\xba \xd5,\x13\xbb\xb4Y\xed@1k\xb3z\x83\xff\xfc\xde\xe3\x08\xa4\xef=\xa4$\x93\x08H\x8b\x80\x89\x9c\x18r\x18\xf9\xbe+N1\xdco\x93}\x16L~\x1eFl{4\x1d3N\xda\xfb\x03\xec(\xf0\xae\xee\x93e\x13\xba\xe4tq\xdf\x03E*B\x8eZ\xeb\xa6\xab\x8b\xaeUe\xab\xa9f\xff\xf5\xc5\xa6<h2\xc5#\x95\x18\xbd\xcdm\x95\x95\xb1~Vr\x08\xe4\xccjW\x1df\x8c7\xeb\xd7\xc5u\xa3T\xbby\xa3z@6i1\x91N&\x98}\x1f\xe7\xd4\xca;V\xdf\x8ay\xb8\xab\xde\xb2\xc7\x0c\xe3\xf7\xfe\xbc\xc2|\xc7\xa7*\xdc\xb1\xf1\x99t\x1fqp4a\xc7\x93$T\x9e\\\x1dt\xfd\xca|\xed{/\x85k\xad\xd1\xa4E\xbc0@=\x11\x08\xae8\xd9B\xb1\x07M\x7fO\xde\xba\xc5\xf7/\xf9\xe9\x04S\xc2\x10B\xd7/\xdb\x8b\x7fT\x11%\x84\x8cg\x8bk\x1e\xdex\xd9\x08\x83\x04\xb7Z\xd5|j\x86\x962U\xdct\xfd*Y\xce\xb0\xc4\xba\xc0\xe1\x19\xb7\xee\xcfH<\xbbm\x1d\x8a\x020\xb8$+\xbd3\x9c\x1bc\xa1\xd5\xe4[^o\xf4L\xb1\xd9q% \x16[Qe\xc7<\xf8{\xedP\xa9\xc7J\xb8\xf8\xa9\x92\xed[\xd3\xab\xd9<\xe5W\xa0\xd5\xbdnyI\xa6\x14P/@\x873\xba\xf4\xe0ee\xd9\xc3J\xa5\x98\x928\xbd\xc2\x95\x82~qo[M+\xc9\\\xdd#\xab\x85JA{\xb8LK\xa1\xac\xde\xe3w\xc6>!\x1d\xfa\xcb\xb45\xb8\xd7/\x0e\xb5\xd5\xb2\xba\xa8\xdd#b\xbc\x86Vo*M\xc5\xaa\x17>\x0c\xfa\x0d\xd9\xd1_f\xad\x95\xbc~q\xb3\xdf:\xa9\xbbG\x0c}\xaa\x8a$\x8b\xf8j\xd0]o\xbf\xc9\x8c\xdd-\x10\xe0k/\xf7\xbec\xbd\x1d\x9f\x0b\xacn|uM\xbcYNC\xcfPM\xedCqfs\x89\xdc\xbe6\x82\xa5\xf7\x06[S\x1dT\x0e\xbf<pkl\x1d\x03o\x9c\xbf\xd1\x1f\xa8.\xf6\xc18\xb3\x16G\x0c\x0f\xf5\xd1'&\x8a\xb3v\xf5\xbeO[-\xcb\x84\xca\xdd#@C}\x04Q\xc6E\xbd\xda\xcd\xeb\xca\x1e4R\xc9\xdb\xe2\xe7s\x1by\x1d\xf3\xcd\x95\xd8U\xae\xde\x80L\x1c\xa9d\xe3\xb6N\xed\xfae-\n\xb0\xe1\xe2\xe7\xcbC'\xa58\xd7\x862\x8a\xd2\xabI&C\x9c~\x83\x1e\xe7ui\xe4eo\x8d6\xa0\xe1\xad\xca\x149W\xed\xaa\xd2d\xea\x948\x0f\x8761\xa0\xe2bIG\x91(\xf5j\xb7\xca;\x1d\x82\x99\xa27\xf3x\x16w\xbd:\xeaY/z\xc6\xca\xd8\x07\xccn\xccj\xf3\xf8\xact\xa5\xd1\x81\xb4\xb2oa\xd2_\xe5\x05\xb7\xc5\x86[\xcd\xab\xdf>\xb7CV\xe0\xa4\xb1\x96d\xe6)+S$V<\xc5\xea\xa8\xb3\x18t\xe39\xdb\xc9(\xf2\xb7\xa7\x81\xe8\x06\xce:)\x9a\xa2K\xabn\x89\x9b\xaf#\x9c\xba\xa0^`s\xaasM\x05C\xf0\x9e6\x8c\x0c\xa7I\x83\x96S|>\xb5\x9c\xec\x8d\xbd\x06B\x9c-\x99\xd1\x99\x83~K\x99\x0e_\x81\xe54\x81-/\xdf\x1c*#x\xed\xc2\xf7\x8e\xd9<\x1b\x01\xb7\x12\xe5\x19\xb3\x7f\xefG\xcf\xd6\xb8\x02\x9f\xaaU\x80\xa1\x0c\xb78\xc5 	\xe9\xe9\xb2(&\xd7N\xd9\xf7Rl)K\x1fq\xef\xfb4U\xfb\xce\xe1\xb3\xd4\xe5A4\xe0\xf6\xc86\x9bo)M\xa4N\xbe\x05wG\x89\xb81\xd2\x1f\xce\xe4\xf4\x973\xcb(\xd9\xb4\x81\x82\xcbt\x12\xf44\x15XKW\xdd'\x1be\xb8\x10\xd6\x14\x13|}t\xc2\xfb\xc9 \x15\x9fqD\x7f\xbd\xe5(\xe0U,\xb0\x9a\xfe\xd7\xa3+0\x82\x0f\xc5?\xf6\xe7\x1e\xc8Jw\x1b+\xfb\x06z\x98%\xa1\xb2\xf4u\xe4\x8dq\xcc(A\xb6\xd4\xf7	y\x88\x95\x89\x8b\xf9\xb7N\xbaz\xad\x93\x82-+e{\xe1<\xa9\xc8\xf0\xa8{N\x9fa-\x82\x0f.\xbe\"\xb4\x97\xbby\xad\xa2\x02\x88\xbd3?NIq\xb6\xcdg\x14\xc3\x97\x86\x15 x>\xe8,\x95\\]\xe3\xa0P~\x00\xf6\xda}{+\x98nUi\xabi{K^\xa0\x86\xf1\x8f\x1d\xa7\xd84\x16p\x99\x1bv\x14\xf0\x19x\\oo\xf0\x1b\x95\xb9\xe6\xdd\xb98oJz&\xdeX\x13\xb27\xc6\xafFn\xce:\xc5;\x02\xf0\xa0@\xd9\x13\xb3\xd6fA\xbf[	\xdfIs\x85\xcd\xa2#B\xf6F\x0b\xd0q\xac\xc3\xf1\x95@u\xe8\xad2#\xb2%\x16k\xdbrF\x11|mx\x03\x19\xbeUk\x0c\xf4\xd8\x89\xe5+fTh\x16 \xc9/\xc7\xdf\xbe=Z\xf0\xb9a\x04 \xa3c\x181pu|\xc5h-\xe5\xe6'\xdd\xa5\xd4\x89\x87\x8aU`-#\x7f\x9f\xdc\x1a*7\xf0\xb91\x82\x16+]vT\x00\xa9\x92,\\\xbaK\xb6\x93\x00e\xbf\xebz\xfe\x9b\xaf*\xa4\xfa\x94\x9e>:\xad\x84\xb22Hacd\xcbc\x0f\xe6l^\xbfX\xbb\xad\x9a\xe54\x98%\x8f\x97\xb6\xb1\x1f\xef	\xad\xde\xb1V\x93G\x9e\xeb\x9c\xcd\xe8\x96|\xd7H\x96\xd3\xa1\x9eqG`j\x80vK`\xe78\xfe\x9dA\x80\xfe\xb9n\xde\xf0\xf5\x8f\xf5\xe9a\x05t\x14\x8c\xd3\xfb&?\xab\x1f\xce\xb2gd\x87\xfa\xe7\xda}~+\x99\xee\xbd\x8aVS\xa1\x81\xbc\xc2 \x93Nn\x7f\xf3\xdb,uD\x18\xbaUA1%bD\xcc\xd2\x9f\xaad;\x15a\xc9\x07\x94\x11\x00\x083v|\xd9\x9bs\x01\xf4\xb7\xb7/w\x16s_\x17\xd3:j_\xc8gX\xb3Un\x00-\xe2\xaaN\xed\x06\x07\x9f#\xbb\x1dZ\"NBe\xfb\xe2l{\xc5\x8c\xe2\x11N\xad\xdd\x80	m\x8c\x0d\x82\xde\xb1\xe5\xb7\x1b\xd17~\xe9\xaf\x1e;'\xc5)6\x9bV\xf2\x18\x0c) )\xfd\x01.\xb1\xc1\xdf\x8e/\xb5\xb9\x05B.\"\xe6\x81\xdeT\xe7\xb6\x14\xb5\xcf.\xf5\xc7\xa9Y\xb7\xe2o\x00\x83\xc57\xdf\xb1\xba\xc4'	\xc5\x020\xfa\x13\xb3o\xdb\x93QL\xdfNs#bF:R\x99\xfd\x8b\xebA<X\xcf\x99\xc3\xf3\x1b\xd3\x9d\x94,g\xc5<\xb9\x82\xcdC\x16)&\xa5\x8f/\xbft\xf5\xfcVJ\xf0\xc1\xd9\xc2W\x9a\xbd\x8d\xbc\xfe\xbd\xd1\xb4\xf2\x8e\xc1@\x82\x07%\xe4\xd5\xbb\x9f\xbd\xce\xf1\x1e\x0f\xc6dzg\xf6\x7f\xe7AN\x9a^_\xed	\xc8Z\x90\xba\x12\x18\x7fj}	\x83\x00\x90T%\xf2\xc2i\xd2Ip}\xef\xc6\x05\x1dSnL	E\xac\x1d\xbc\xde\xf1\xe5\xa1\x05\xc6-P\xbaK\xaf\x13\xef\xfar\x9a\x18\xd4\xf9\xc6R.\xce\xbe\xc1\xa45\x9978={_\x19\xf7\xa0\x11\xe2t\xe2\xa0\xdbT9\xb0\xbf\xf1\xb0\xe5\x19\x02\x83\x8faX\xfe\xf2\xa9\x94F!\xb9gj0S\xd0k\xab`5\xcd.y\xf7\xc8\x95+\x1faI\x83\xe9\x16\xc7g\x9c\xf5\xcb\xa3&\xf1\x97\x1f1\xbf\x96b{\xb9On^E\x07(\x7f5\xa2x\x01N1#\xb1{\x85\xfb;\x0bXK\xeb8\xa7\x99T\xba\x01\xc2\x9b\xf2\xc3Y\x9f\xf1\x0e<\xc8\x1aA\x8d\x19\x1f\xe8h\x0e\xa2\xdaW\x86\x9e\xc97\xf6	\x0d\xd1&\x14GVz\xdc\xae\xe4r\xe1\x9dX\xa8v\xc5v\xbd\x8a\x9f\x19E\xe9\xee8\x13V\xccE\x80\xce	|\xd40inx3\xcf\x80\x07\x8b2d\x92\xef\xce3\x1cR`d@\xfe\x83\xfbEWp\xe6\xed'\xab\xd6\xc0\xa0\x97V\xceZz\xd7\xa9\xcd\x8c\xe5\xa1\x99\xda\xcb\xcf\x16\x15WL\xb9\xa2\xceS;\x13\xaf\xd9df\xe1e\x89\x83\x91\xdd\xce\x1a\x0e\xce*j\xbc\xc3\x99My\x91\xcfs@0\xaap	\xee\xdahf\xa1t\x85\xc33\xd0CM8W\xc6\x96\xbd\xed\xc1Bi\xf0\x95Zo-g4\x1f\xbc-\xd4q4\x88\x80\xa2\xda\xc1\xb7\xba\xc6E?\xe3Ao\xa9\xaf\x13\x8a\xcbdSl\xd5\xb15\xb0 4\xc0.G\xfe7\x1f\x85L\xe12\xb5\xfa>\xa1\xd3I@m6\xb3d\xba\xc2\x99\x91\x80\x80\xf0\xcd}s\xe6\xb8\xf7\xbb\xf8\xae\xefs\xb6\xe0\x01\xcf*\xdc\xb3\xd0\x96\x9euj\x85r\xd0\xf7\x99\xd2\x8d/{\xedc\xa7\xa7\xebh\xdd\xa0\x83##x\x92o\x05\x97J\xd87\xf6\xf3\x8b\x8d/!\xa1\xe5d\xc12\\2\x9dx\xdb\xf6d\x16\xfdT\xb9G\xc4\xaf\x95\x8b\xb5\x82\xd7\x7fk\xe5\xc9\x8dU\xcd->\x95\nU/x\x87\xe0\x07\xc7lu#\x10\x0c\xc8r\x8b3\x1bi\xd2\x91\x0c\xf7\x92\xec\xc8\xd3\xa6+\x86\x8d\xef`#\x13iJ\xbd%\x7f0\xe6X(\xa6\xbfA\xce\x92r(\xf6\x9d\xd4\xbb\xfb\x0c\xdf)xf\x80\xfes\x17\xc5m\x19u\xb3\xda\xa9\xe7g\xe7:\x99\xa0\xbdf\xef\x8e{&\x90\xd0J\x90\xbd\xde\xd9k\x08|aoy\xa7{\x8c\x0c\xef\xa9\x8b%\xa7\x19;C\x91\x02\xe0\xd2O'V:\x7f\xe8\xc4\xb2r\xa9\xa5W\xf9*\x8d\xae\xcf]\x99>\xdc\xe6\xa2Uyc\xcd\x93\xfd\xd5\xf6l\xd9=4\x91@\x19.\x83N\xbc`\xeb\x99\x85\xfb\xf9\xcd\xadU\xfa\xa7\xc6b\xe9\x8a\xeb\x0fl\xbc\xc5wR\xa1B\x99\xe9f\x11\xad\xf6\xe5%\x87\xad\x1d\xd8J\x93\x13\xabW\"\xbc*W\x9e\xa1\xcd\xc0i\x8f\x16\xde\x93\x07O\x1eaz\xd0^\xc4o\xbb\xd25v\x8f\xb1l\xd5\xefkdoS\xd6\xe3p\xf9j\xe4W\xc4\"\xe0N(_\xae\xecFu\xe0\x0eV\xed\x9d\xe5}F\xb2\xb3\xa1\x95\xd4\xdb\x03J\xc1\xe0\x0d\xcaD\xff\xb3F^T\x93=\x9d9\x98\xb7\x84\xf5X\x06\x8f}'\xe9\x18+s\xc6\xba\xb8\xff\xf2\xc0\xa8i\x8f\xd0\x19\x1e	\xe7\xa4[\x1a\xdf)h(\xcfPj\x81\x831\xcb\x8fG\x95\x89\xd9+\x99\x9f\xf1F\xbb\x92\x16\x0b[e\xee\x93\xa9\xd4\x11XB@\x80\xfd\xdb7\x1dgI\xd8\x83	\xa1DQ'O\xecQ\xc3{\xce\xcb\xc2Z\xb2\xb3n\xbc\xb5)\xf7\xc1\xf1E\x1a\xa1\xefOl;\x87\x9f\xe6v\xe3lH\x81\x9f17X^\xf7\xc5\x91\xe5\x90\x13\x0c\x06w\xe6 \x8c:a\x1e\x9b\x8bK\x96\xe9\x1eYHe\x0f\x8c\x18\xf0\xc9\xb7\xee\xba\xb9bE_\x99(\xc1\xda\x95W\xa1\x0c\xda\xb0$\xba\xc1K8\xe0\xb6\xea)uN|\x8f\xd9\xfd*\xe5\x11N\xfc\xec.\xa7\xa7LsW\xb9n#j\xac\x80g\x7fmE\xe7\x06\xf4=\x15\\T\x04\x81\xd5\xfa\xd9\xf0\xa6Y\x9cA\x00\xce\xd7A\xd7\xd82\x0b\xd2[\xd7\xa4\x94B'\xdem\xdbGF\xf7`\x92\xc9\x00\x17o\xb4\x87}\xb9K\xea\xf2db\x10\xde\xe8\xafe\x8e<cR\x19\x82K~\xda\xbc\x87\x8aH\xbfU\xe7\xcb\xcf\xfe;\x04\xcf\xdb\xec\xf3\x9d\xc3\xdc\x97W\xec\xf0\xde#\xf1\xe4\x01j\x0f\xc0\xe8M\xe0\x0d\x8el\x86Rb\xd0m\xef\xcc\x9f\xaf\xef\xdfYkY\xb6{\xe4\x95\x15?0\xe1\xcfwnxQ\xf5\x07v\xde\xe2?1\x9d^\x922\xf3\xb9\x18\xed\xae\x84\xe2\x1f\x95\xf5\xe37_jI Q\x1e\xbd\x7f\x9c\xd0\xaf%\xdf\xceg0\x88\xe0\x911\xc1O3\xd92\xb1j{\x15\xf33\xac\x7f9w\xf9NE\x83\xe7\xfc\x1bW7\x81\xfb\xe5j\x16\xc9\x14\xa8\xd5\xe9\xbaU\xcd\xcfv\xa3J\xee\xd2\xbf\x96z	)\xb1\xde\x9c\xdf9\xcb\xbc\xa3\x15\xf7vO\xc3x\xa1,\x15Xo?\x1e\xde\xb4\x0e'\xf1\x03\xfe\xc6\x07\xcdQ\x80K\xaf\x19V\xaf\xd6J\xba\x95l\x95\xc5\xec:\xb7\xacR*\xb8\xe0\xf8\xad\xbf\xb9\xad\\CE\x9d3\x13^\xc5\xe63\x0b\xff\x94\xb9\xd7\x026\x9cG\xfe\xd4\x95\xea\xf9F\xc6\x84\xc4\xcb\x0blOu\xbe\xf3\xa5\xee\xb7I&U\xdf\xd1l\xe6j\xef{\x9f5\xe4\xe6\x8c[\xfak\x9a#\x0fH\xea\x14N\x93\xebW\xe2\x01$\\\x9f\x13_\xe6z\xc7A\xef\xe2\xc83\xf3\xd6\xc2\xa0g.\xae\xe9&\xb7\xfe\xce\xb9\xde_\xce\xc9\x16.\xc0y\xf37\x97Y\xbe\x9f\xd61\x93;\xbb\x8eI4\xeco\x1d\xfb\xe3\x9dX\xba\x9e\x0eYa\xdc\xe2\x9f\xa9P]\xdb\xce,\x0c\xd13\xf6\xc6\xeaP\x12ORqdnTfP\x80\xe8\x9a\xfdp\xeax\xdf\xc3\x03k\xf4\x167o\xb8M\xb7+\x04\xfd\x83\xb1\xc1\x8d\x04\xe3\xf7\x8e\xc3m\x8b\xa9\x9c\x9c1\xed\xf7\xc9\xd6\xab\xfc\xa0\x91@\xfe\xc5\xf0\x99I-\x94\x05_y\xeb\xad\x15\x87j\xc5\xd7\xe0\x19K\x03\x0b\xcc\x03\xb2_N\x91=\xd9\xdc\xe8\xfc\x95\n\xc5\xae\xae\xaad\x13a\xbc@#\xe14\xb2\xfe\x83A%,\x0b\xf0:m\x01\xd1\xe5\x8a\xdb\x05\xdf\xd7S\xb9\x9d\x9cB\xcc\xfe\xe4\x9b?I\x96m\xb3g\xa211ly\x82<\xe4\xc7\x14\xf7\x9e,u\x8b\xf5)\xd8\xfc\x0c\x04h,O\xe6\xe2\xe4_\xdd\xba\x19\xcb8\xbf\xfe\xd5US\x0b\x02bn-\xdf\x8f\xea\x94\xe1\xba\xcd\xadk\xd5\x10\\\x08\x7f:\xc6Wk\x11\xb0Y@o\xee\xb2X[\xcd\x04\xa8]w\x94,\xff\xc9Idc\xcc\x9c\xb2\x9e*Y}\xcf$\x1b\x83B\xfd\xcdR\xce\x97{\xbc\xb7\xf0\x12\xc9f\xd0\xbb\xd8\xbb\xfcJr\xaeX\x0f@\x813\xef\xda\xfa\xf1\xf2\xe2:\x06\xad\xe0K\x1b\x07\xbe\xd4G\x0c\xb7u\xae]I!\x88\xff\xfb|\"\x84\xdc\xc1\x9c\xba@[\xea\xd2V_\xfb\xb6\xbcZ4\x0fA\xd9b_|\xc9^PS\xe2g\xb9\xe9\xa2\xef1\xfc\xa6^\x06\xbd\xe1\x8a\xfd\xad\xb9\xde!\xe2;\x870x\xe0\xb5\xfc\xf7\xfc\xca-\xeb\x07.\xbfZ\xe3[\xb7\xca8\x8c/\x85\x1b)\x9f\xb9\xd6\x1b\xd6N\x84\x11p\xd1\xb3o\x92\x8c~C\xac\x9f\xb5/^\x08*A\x154\xe5\xbb\xce\xef$\xe6:\xf5\x1b{Bs\xad\x84P\x96:'|8 \xbcN\xef\x08\x98\x80g\x9f1\xc3m\x0b\xeb\xd6\x81\xa6\x9e\\\xa5\xb7?~P\xbat\xc1\xd9VA\x83\xa3\xb5\x02\xdf<S\x06t\x93\xcb~\x81\xb2\xdd\xec\xee\x1b\xab\xfe\xbe\xd1\x9f\xa7\xb3\xb8\xd2\xc5\xf7\xce-\x00\x95*w\xf7\x08\xe4\xd0H\xe9\xf6\x87\x03'\xc5\xa0\xc06\x8d7\xf8wUi\xa9\x8d\x06\x81\xf3\x9d\x97\x10Pm\x9c\xf7\xc3\x82l\x86\xb4h0\x953\x07\xf2\x96\xb0\x17\x0c\xb4\x91id\x1d\x93\x14\xe8\xea\xd6\xa5p\x833si\x0c\xd6\xe6\xa9\xbfc\x0f\xf8\x95\xc4\\\xb4.\x809co\xf0\x0f\xbfr\xe3kguX\x06wm\x99\xaf\x0fg\xc4\xd9\xd0\x02\xeb\xa0\xad\x88\xcb~=N\xce]\x899T\x0b\xe3\xfb\xc0\x08\xac\xf6C>J\xb0\xa5\x82%s\x83\xe3\x0b\xa5\xc8mIs\xb5\x9c\x1aED\xcf$\x19\x1b\xe2\xef\xfe\xfbm\x06\xe93\x18Pm\x99\xcf\xab\x7f	\x99S\xeb\x06hp\x06\x1b\xfe\xd4\xcb\x12qD%k\xab_\xe2	s\xcd\xa4*Y\xcf\xa7&~\xbe6\xaf\xba2\xd7\x8d\x9a\x19L4f\xd0\x93\xe0\x9d\xb1\x02U\xfc\xf8\xcc\xab\x8d\x17\xa8Og)\xe5NU\xdam\xa3\xd9\xc110\xaa\x87\xe5Y\x80^\xfe\xf6\xe5\xfb\xdde`\xe9\x7f\xa2\x8e\xef\xab\xe7[yrN\xb2\x81'd\xe3\x98\xfe\xda\x8e\xf3\xd2\x80l\x80n\xc9~AN\x89\x00\xd63\x02B\x8f\xb8B\xfft\x9a\x13,g\x85\x03\x04\xa5\x12x\xb5\x95\x1a\xb9D\x06b\xe81\xdf\xb8s]\x9c\xd9r\xcd*\x87F\x9d#\xd3r\x9bkVh\xd6l\xb9\xea\xc8R\xa7\xf8\x14Qi\xaf`\xe9a\xc0\x103\xaaJV\xdc7\x89\x06\xb8\x88\xb1\xfe\xf2\xad\x12\x19\x01\x90\xf2p\x99.\x83\xce\xbd\x84\xdf\x14\xcd\xa9\x0d\x0c\x10+\xe8Wa\xfb\xc6\xaf\x17\x84O\xa5\xd8N\xecO\xdbb\xa4\x1f!\x93\xbek\x04n\x8c\xbc\xdd:\x95\xae\xb1f\x92\x8c\xb8u\xc1\xf4,\xf6N~\x93\xb7\xa5b\xc9\xd3S~\xf5\xe0\x1eP\x1d\xe9M]\xcan\xab\xe9\xc1\xfdj\x97\x1b\x0f7\xf9\xeb\x83\x11jN\x1a\x9ck\xabr1\xac>2w\xc7\xc1Z\xf1\xc2?\xf8s\x87;\xf3\xae\xe1\x9cM\xf9\x960	\xbc+\x11\x17\x8cL\xbb\x81(\xe2\xab?\xf83\xc2\xc8\x14X\xc4\x99}yK@\xf2\xb9\x82V\xf0i\x12\x9a\xf1\xa2J\xc67N\xcb{\x10\x81\x1e\xe0W\x07\xef\x01\xacc\xf5\xdb/(\\xI(/w\x8d3+f\xc4|\xeb\xb9\xce\xfdo=\x07\xc6y\xc7\xa5_=\x0f\x0esD\x1c.\x9d\x91#=z\x0d\xb7\xf6\x9e\x892:\xbf\xa4\x81\xaa\xa5\xef\xde\x91\x8f\xe8\x13u\x96\x07=\xa9K\xcbm5\x839\xf2\x00M\x13\xc8\xdb?\xd8\xa5\xddH\xc4\x94\x97\x13\x07\xd3\xb6\x98\xf3\xe7\n*u\x06\xe3\n\xe4\xbc\x16b\xabsb\x05\x03\x0c\xb1\xb6\xb1\xe8\xc7t\xca\xa0\xefJ\xcf\x04\xf0\x8b\xffW\xe7T\x91un\xf3\xaa\xc9\x89\xae\x7f\xb9\x02\xf0\xb0\xb8\xfe`\xa0\x7f\x81\x9b\xaf\xf4%g-S\xc9`\x9c;[\x03	*\x8a\x15\xfe\xf6o\xaf\xeav\x99>\x13\xf3\xe2\x02_\xc4\xf0X\xb5\xe5\xa4\xca\xdfH\xfdP\xa7/\x1f\xef\x8e\xdfF`5S\xe7V8\x17\xf7	5c\xe3\xb2\x7flg\xe3\xe4\x9c\xdbs\x05\xd6^q\x89')#\xfdQ\xbf\xd8q,\xb5z\xb2n\xbd\x90\x82u\xdb^#Co\x9b\x9bW\xaa\x00\xb0\x8d\xa7\xfe\xf8]\xec\xe8Z\x1b\x99<\xe2\x90G\xc3\xd2\xe0\xd8\xd8J\x8b\xc7g\xf6\xbfz{pf\xfd\x99\xb0H\x93~N\x0d\xeb\xa7\x00n\x19\xc9<a\xd4\x7f\xfb\xfc{_XM\x89\x83\x8a\xac\xbb\x9d\x13\x9d\x9a\xbb\xd11\xf1\x8d\xb9-\xe7!\xcd\xcc\xd9\xe2o1M|\xeej\x04\x84\x91a7\xa6\x85J\xf2\xa7\xc7,\xcet\xcbhR\xe9\xfd\xa2\x05\xa1o\\x\xbc\xa6\xda=rh\xc8\xfc\xad\xbd\xc5\xc71\xday\xca\x1cL\xf9\xea\x98r\x06\x13_\x88\xbbC\x8f\xf9\xf5\xecn\xf13\x03\x8cx\xc2\x10\xfd#d\xe5\xc4\xc6F\xe6\x86sd%	\xf6\xf2\xb3\xe1\x16\x01\xf4\xb2 \xe9/\xff\xba\xa6q\xe5,\xb4\x1b\x12\x0d\xc0\x88\xc6\xa0\xfb\x97\xd7\x96\xed\x1c\xc1c\xf6\x16\n\x93\xe93\xed\xbb]$\x0d\xe8~\xfb\xb0\xd4\xe9\xf9\x84Y\x8c\x17x\xdb+b\x8co\xaf\xa8\x18J\xe9#\xf7\x8b}_\xceE\x9eJ+\xe2(CVM\\\xec\x8b{\xba\x85\xd2\x7f\xfbH\x04\x96C\x1d\xa8\xdc{\xcd5\xadm\xcfb\xe0\x86JWt\xfc\x99\x1d\xbe\xe7\x1f\x02\xd4\x02TKG\xe2\xe2\xd4\xc8|!\xbctE\xddo\x8ei;\xeb&\xe2\xdb\xac\x10\xcfXu\xcc\xfb\xe4\xbc\xaa\xa8\xa0\xf3\xbf:\xc2\xb1\xb0\x8f\xa87\x88w\x84g\xc0\xd3\xef\xa7u\xd6\"a\xc9\xc4\xc9\x04M?\xfd\xcd_\x9c\xd0d\x03\xf5.\x0eU\xb4\x93n\xb7\xb4\xacR\xb4\x95\xbe=\x88\xeb\xf5\xcd\xcd\xebVy\x16\xd9\xd6O*\xe1\xc94T\x1f\x95u{\xffj\xdb\x1e.\xa6m\xee\xde\x11\xd4\xdf\xf37\x9a\xc5\xacy\x82\xb5\xc8r\xa7@\xfc\xe3\xe3 \xc0\x1b5\xa3r\x9f8\xd7\x04\x99\xa0}\x97z\xfb\xa7\xae{\xc7?PAP\x8d\xb5~\xc2\x9aT\xb8{\xe4\xbe*\x9e\x05\xd3OG\xc4\xaa\xa75\x07\x0d\xae\xd9\x17\xa7\x8e\x8f\x1dq\xeb\xf2\xc5\xb0}\xbfZ\xff\xea)o\xdb^\xc0q|e\xdb[\x98\xca_\xea0\x11\x9a\xfdS\x17\xb3\x15\x16\xa7(P\xd2\x0c\xeb\x164n\xde\xa8\x1a\xfb\x9e\x0e\xe7O\x9f/\x17\x08\x1e\xc7A\xbfr\xf4S\xaf \xfd\xe9K\xe6\x15}\xe7\xe2nq(\xc1\xba2\xff|\xbb\x0b\xdc\x02x<_\xec\xabz\xf3_\xbfc\xc5s\xca\xcd\xfe~\xaa\xba]8\x9e` \xb9\x08\xcf\x1e\xb4\xf4\xd3(\x8d5o\xcf\xc8\xfe41%\xd7\xd1&a\x99d<\xb9a\x07\xe2\x18\xd1\x83\xf7\xadKj\xc0%J+\x13\x81\x7f\xad\x9bMcd\xf5\x06\xd1\xdf^8\xf3\x10g\xcd\xbaQy[P\xeb>w\x9e\x0b\xa4K\xa0i\xcc\xf5\x9b\xcf\xba\xd9J)\x9c~\xfc\xde+\xacP=\xe9\x91\xd9\x91\xe9\xfdOL\xef\x16l[3@\xe9!\x93\xd0'\x18J\xc0\xe0\x1cz\x96\xea\x97\x0fh\xdf\xa2\x1f47g\xab\x14\xa8`S%\x0bM2\xc9\x8a\xe8\x0f\xfc\xf4\x7fc:\xe0/\xe6v\xbaK*\xdd\xf9\x06\xb2=~\xddp+\xff'.Mq\xe5\xd0nN\x8f\xdfp\x0b\x0c#\xb3nl[h\x17\xf7\xeb\xe3 U+\xf1\xd7\x8fL::\x8e\x17kp{\xa9KJ\xff\x97/T)\x02\xf3\xa7\xc5\"+\xb0&\xd6\xc4\xe7\x81\xfd\xd9\xdc\xbb\xcc>*f\xf6\xd3EN\xf4\x19V)(\x046\xfd3\x07\xf5\x0e\xeeA1_v/\xb0\xa1\xca\xf7a\x1c\xc3\x1ai\x04\xdf\xfa\xeeU\x96}gb\xac\xda\xb9\x89\xb4\x9e\xd5\xf0\x9d\x87\xaa\x8c2~\x18\x0d\x02\x8c\xd5\x9b\xd5;\x82g\xa02\xd2\xd5\x9e\xec\xcd\xbc\xfd\xde$d\xae\xefu\xdc\xb5Xo\x99{\xc9\x83\xae[	\x06\x93\x04\xdde\xcb]o)'a\xc9\"~\xf0\xcdR\xdf\x9d{\xea\xd6b@\xcb\xb8\x90G\xfdO\x1b\xc8s\xc91\x9bx\xf4\xd7.G\x1b\xd7*\x06g\xe2\xa1s\xfd\xfc\xe9vr\x13#\xe7\xf0\x98\xa5\x1f\x1a^\xe3\xf0\x88\xfd@\x9a\xfd\xbdu\x7f\xf7\xa6\x1aW\xf0E\x7f\xcdv\xb41\xad\xa2w&\x9e\\\x7f\xf7\xb6\xd3\xa3\xd7A\xec,\xda]\xc3\xed\x19N\"\x00w:\xfd\x83-g\xde\xd9\x05T\xd7\xee\x8b\xd3\xc47\x04\xf0j\x97\xd6\x13\xa0\x15\xa0K\xff\xbc\xfb\xc4\xb2\x92\xc2\x13Z\x8a\xaf\xe0\xd9\xee\x04q\xa2\x8dS\xfd\xd3\xf6\x8e\xaf\x9a$\xb8e<\xd8|\xab\xe0GF7\xb8W\xf4\x15\xa7\x7f\xf5J,/s\xa5\x90~\xf2\x9d\x87\xc2\xcf\n\xe0\xa7\xd6\x11Nk\xea\x9f\xed\x9c\x8c\xc4w\xed\xa9O\x1f\xabc\xd8\xba5\x83\x81&\xa3\x85\xbfsR\xb7\x06^\xbdqG\x7f\x1dg\xa4)7\xc5\xecL<\xfa\xc7\xaf\xf7<H70n>S\xf0a\"h\x1c\x8a\xcb\x08\xe84\xb6\xf9\x83\xeb\xc5:V\xe0b+\xef\xc4\x1cx\x0f^\xcc\x8f\x8cx\x93c\x9f\xf9a\x00\x81\xb0\xc9\xa5\x9dQ\x0eK\x8b\xe2	V#{LRi9O\xa9s\x8a\xe5\xcd\x1e,p\xe6\xd0\xde\x02\xb6\xe0\xc5@\xa0\xe8\xbf\x98m\\\xdf>\x8f::m\x03\xb7\xe6z\x18\x01\x97\x89\xd5\x1f\xfd\xc2\x0d\xafLl\x92L\xb0\xb6\x9e-\xf8\xa0U\x12y\xb5\xec~Z\x8c\xe6\xf7\xb6%uK\\,\xd0$|e\xf2f\xcf\x0bR\xa9\x84x\xdf\xf1\xbf<\xb61\x07N*g\n_\x89\x84L\xbaG\x0e\x80\xec^\xbd\xfe\xf0\x97\xd2\x99\xe3\x94`\xea\xee\xc4\xbe\xb7\x0d \xe7\x87\x86\xa3\x84\xec\xa8~u\xae\xa5\xc9\x98\x83\x17\xb3p\xd6/\xcf5]\x81\x95\xfe\xf3\xfe\xd4\xd82RZ\xcd&\x1c\xfd\xb5\xca\x91f\x1c\x8a\xd6\xe9\xb82\xff\xe9}N\x86\x9d\x80\x10\xd8'O\x1e\xbf<\x98\x01\xe0q\xb0\xff\x83\xafe\xd6c(\x11\xbe\xd2\xed\x0dt\xe0\n\xabO\xbd\xfc\xed\xd92\x1b\x86}mW}\xcb\x86\x1a\xee\xa0X\xc6\xcb\x90\xff\xdb\x93\x1a\xd7\xc7\xbc\x83\x0b25\x8c6a\xbb\xde\x9b\x86\xfb\xc5\xf9\xb7^\x966yH7\xe7\xac\xd3!\x93\x12\xd2`?PO\xff\xcc\xbf\x83\x832\xccslB\x8d\x82\x9f\xda'\x81\xf6\xa7\xfe\x96}\xb5\xaa\x95\x7fhs\xff1\xb8$S\x88Z\x1f\xe9\xf4\x9f\x1e\x15,P\xc8X\xa3\x88a\xff1~\xb6\xfe\xd6\xe6\xa8\xd4\x03\x06T*g\x82\xd8!\x85LMH\x83\xe6\"}\xff\x8bS%\x89\xd9\xb9\xeeGzV\xe5\xaa/\xbe\x84\xc0e\x7f\xdf\x83\x94+\xf6\xf7\xcb\xe0\x8eh9GF\x178\x0b\xcfG\x1aO\xff\xe8a\x99\x0dc^\xf8\xfd6\xe7o\x01\x1d<\x05L\xa08\xeb\xbf\xf7&nSo7[H\x87H\xba\xe3\x16\x86\x83\xbej\xff\xf6\x9b\xae_;%`\xde\xce\xecg\xbcr\xa7>6}\xe3\xb5\xbf\xf9\x14>i\xf5\xa2\xf3\xac\xe1\xcd\xbad\xc9-\x02\xbf\x9a\x883v\xde<:x*\x925W\x9d\x08`)f\x7f\xfb\x08\xb0\x8a\x9e\x89J\xc1\xea\x07\xab\xb9+\xd2\x86\x1b\xbfXR\x8d\x17\x8aN\xfa\x19\xa8\xa5c\xc2\xcd\xd2g}n\xb0?xT\x1c\x8d\xa8\xc4\xfb\xe2\xc7\x84D\xfb\xdb\xcc~\x0c\xeeAi\xbf9Mf\xfa\x12\xab\xea\\\xde\xb5\xcb\xf4\x8cH\xae\xe0(r\x16\xa2\xfcf\xe3\xc1\x99\xa53v\xdcf*\xbbi\xc3\x989\x1e/\x91\xa2\xff\xc1T\xd9\xdd\x1b\x9d\x9e\xb5\xe6\xf8\xfc\xf0X\xa5\xc0\x17\x18\xf7\xef[\x94\xa3\x0d\x13\xbd\x89X\x7f\x1dr\xb4\xd1\xc1er&n\xff\xc3woy\xe7\xee\xca\xe3Jl\xedS3\x03c\x19\x80\xc9X\xe3\x1f^\x88\xf3\x93\x8b\xf4\\\xe9\xcb8\xda\xf4\xe12:\x13\xd7\xfe\xd9;\xcdu|W\xc5+\xbe6~\x93\x91\xa6\xf2~{\x14\xaf\xb8	\xd6q\xb7\x8e$h\x95H>\xa0n\xe2\xfb\xf0\xcf\x9e\xfes_\xfc\x19S\xeax[\x1b\xd6\xe0\x1e\xdbo\x96\xb1ee&\xddh\xe2\x87\xeb\xf5\xe5\x89\x05\x1a.\xe0\x03\xe6\xf6\x97c\x9c\xac\xb0\xeeQ\xb8t\x97~\xc6[\xbf|tN&\x94\x7f8FKP\x91`\xaf\xb8\x8b\xbb\x18\xb6v\xcd\xb1@\xc6X\xe2\xbf\xf7\x0e\x15A\xbd}\xb9\x8f\x9dO\xc4\xf2C\"\x95p\xfa\x87\x0b\xce4\xda3\x1en(s\xc6\xcad\xcf*C\xff\xfe?\x1d3q\x84D\xd7\x16Xw7\xaf\xe4B\x8e7\xde\xb7\x7f\xdf\x92\xc92`\xcdy.U0m\x9c\xf8\xe0\x1d\xfb\xdb[\x7ft?{\xb6\x904Z;P\xc7\xbd\x94\"\xe8\xfa\x8dH	l\xff\xfc;\xd7\xc9t\xc8\x81\x81M\x9d\xd9\xeb\xb7\x00\xb4q\xc0\x07d\xf5\x0f\x8eU%\xb6\xcdJ#yk\xc2\x82\x9a\x13\xe2f\xe9\x9d_\x1e\x87\xe5\xd3\x0b\xd8\x81\xe5Z?\x0d+\x18\xbf\xd8\xff\xc3\x85\x1d\xff*\x9eJ\x9f\xe5\xed\xe4E1\x0f\xb6\xfb\xa0\x1al\xb26z\xbd\x890,\xf8\x1db\x82J\x06\xec'\xcd\xccP\xdf\xa1W\x0f\xb8D\x10\xb8\x99\x06\xd8\xf9\xb8\x07_}\xa1\xdb0\xdbJ;\x08TI\xe6)L\xacM\xb9\"\xcd\xf4\x1c\xdeV\xed\xca\x93\xf3v,,\x00\xfd\xcd\xac\xed\xa5\x8f\xdd/'\xc8V\x04\x12\x989p}\xf9v*`\xd7K\"\xcf\x95V\xcfO\xf8hIqee~\x15\xd6\xef\xdd\x8d5\xaf\xd8\xee\xbc13\x9e3\xd4M\xab\xe7\xc1\xb5f\xc1#\x8e\xca\x8f\xe4=H\x1d\xd2\xbd\xea\x91\xc1IN[*\\`'9\xe7\x1e\xadIcL	1\xba\xdd\xaf\xe6\x9bgt\xdb\xba\x802\xc8G}\x96@y\x985\x15\xbe\xab{\xbaWV\xed\x07k\xf19\x92\xa5\x17\xf5&\xbeO\xf8\xde@/\x9e\x9e\xd6\xa7~dt\xbd\xc5\x1f\xaf/P\xac\xb24\x84n\xb4\x1bK\xef\x07\xdf\xed\xea\x977QB	\xa3\xd2\xda)\x9dE5\x03{\x06\xfc\xba\xa0+\xe6'\xc3Ax\xde\xa6|\xb1\xa8!`\xc3=ZX\xb22\xa17p#q\xcd\x1c\xeb&\xa8\xbf\x9c'\x84t\x86\x99u\xdb\xbd+*<\xea\xe9\x83\x05\xfdN4\xe0\xd5\xec\xde\x11\xc6\x81\xca\x16\xc1\xeaw/\xfav\xb2\xca\x14\xd2\xd5\xc5\x01\x9c\x10&\x84:S\x00\xdb/\x9f\xc8\xe9{\x13\xd0\xe7g\xdex\xfdI	\xd3@\xd0/\xbf\x80\x0b\x11\xfa;\xa4\x0bZ\xc3T\xba\x18\xde\x04+\x08\x89F\xd38\x97WX?{\xe0\xd5\xde3\xf3\xdd\xbd\xf9/\x96g\xab\xcc\x88\x94\xf6\x83\x92\xc5d\xe1\x9cM\x87\x182\x16\xeb\x9dY\x7f\xc7\xa1\xd5\xd4##\xfd\xe2[`\x8f\xd3\xbb!\xf6#z\xba\xd893\xb6\xec\xad\xf1\xb6\xd9X\x17S\xf2\xa7\xa5\xe4\x1e\x81\xbe+S\xce\xd1\xe9Ur\xa3i\x98]g\xe4B\xbd\xa9\xac\x08\x97\xed\xd8:\x12\xec\xa3\xce\x1f\xa8\x96-m\xff\xe6:\xc5K\xc9\x83\x92\xf1sv\xdfu \x18\xba\xc4y\x8f\xb7\xd3\xa1\xb96OC\xca)\x11\xf3\x0e\xdb\x89#w\xaa&q\xb8\"\xecs\x7fs\xdd\xfc\xe3\xaf+?k\xb1~\x03\x00\x18\x00\x1c\xcf\xa6}\x90d\xc8S\x83\xc9U\xa3\x913\xd6\xae\xa6>\xb7\x80y\xe9\xe2!P\xba\x1e\xd1{ \x03\xcb\xac\x0f\x05a\xdfh\xf4\xab\x9d%\xae\xb0\xc7\x95hyD\x92E\xdd\x92\x8e\x8cF<\xd2\x88\x81\xd2\x14C\x7f\x97\x86\x0f\xce/\xc7'M\xe8\x9az\xa2\x10\xbfu\xef:\x00\xfdO\x1b'\xf5f\x9d\xed\xa74\xec\x0c{\xb4W\xe0w\x1d\xba\xbb\x1a\x9af\xbfZk5Z0\x87\x17H\xf2\x1d\xa6\xefw\x92z`\xea\x7f\xb5jp\x94a\x14$e^x\xc0K\xfd\x1d\x8a[\xa8t\x97b'[\x8d\xa5\xc6\xf9\xbc\xb8\xd8\xa7\xd7\xc2x\xd9Q>p?\xbe\x92C\xdb\xde\xcc\n{zP\x9e\xd5\xe0\x0ebJ\xad\x93\xa5\xc7\xa2\xd0\xaa\x06\xc0>(\xfa\xfd>?\x02k\xe1\xfdlu\x8e\xb3\x1cVd\xb2\x83\xd1\xb2!^\xf5\xbe+\xeb\xc4\xfd\xa9\x07\xae\x92\xc8\x074[\x89\xec}@\x94\x08\x8824w\xfc\xfb\xb7'\xe4\xfd\x83\x80>D\x8bW\xa3\xc4\xa1\xd4p\x8b\xaf\xa5\xbcU~\xfc\xb5~\xb3\\\xc0\xb1<\xfa\x8a\x84\x11\x19\x8a\xdf\x9d\x94\xeec?\xef\xce\xd9\xcd\xbb98\xfd\xc4t\xf9rp\x9fZ\x18\x19 \x80d\x13\xbdm\xc1$\x12i]\xfc\xae\x0dg\xff\xc3\xcc\x98\x97-\x9f\xdd\xf2=r\x9a\x10r+\xbd\xcf\xdeL\xaazU~\xe2\xaa.\xd9[\x00s\x89|nG`}g\x08\x19\xc7\xa9\xd1\x84\xf7\x04\xe1\xbcf\xa1W\xab\xce\x97EH<\xabB8xL\x18 _4}\x92I\x03\x7f\xef\x10\xa8K\x04u+x\xceq\xfbl\x1e\x8d\xe8\xa1+'\xf2\x04\x07\x96\xf5\x0f\xbf\xdb\x16L&P\xd8\xe2=\xa9\xd7P\xa3#\xef\xa7[\xf6%\x84\xfe\x88\xc0\x8d\xc2_\x89\x16\x97\xd1\xb5\x89\xc1\xef\xdf\xc4l\n\x95\x98\x9e\x89K\xce\xc5\xcee\x80\xe11{\xc3\xfe\xfc\xd6\xe7\xf1P\x13\xeaU\xf40<\x15\xbbf\x81\x9f\x08\xb4\x11!\xde\x12\xd1\xaaM\x16\x0f<\x96\xd3\x03\xebv{\xd5{\x94\xe2\xfa\x1f[>\x1a\xa7\xb8\x06\xe7z\xb9\xc2\xe6\x9a\xc1{\xf7T\x1d\x94\xacsp{\x114'\xe5_\xca\x92\xb8\x9f\x89\xde\xf3i\xf9\xbd\x89\xf2\xaa\xa9\xbb\x03\xbf\xcd\xb9D\xec{\xb2\x0b\x8b\\\xd71\x03%\x9cTmo\x9fN.\xafN]\xf8\xc5\xb7\x1b\xe7\xe5\xd4\xf9\xbaU|\x07\x9b\xa0@\xb6irqjtWz=\xce\x0d\x98\x16,w\xc7\xb4\xf9\xdbs_y\"\xcfxq\xf6\xdd2G9\xb6H\x91\x07]\xbb\x91\xae\xbb\xaf\xce\xc4]7\xdf\xaf\xf7f\x90\xc4\x96X\xdfY\x81H^\x0f\x14\xa2\xcd\xba:X\xe0\x03.\xf7o{\x94\xe7\x85eUU\x06\x06\x183D\x1f\xb1o\x18\x91fZ\x04\x7f4\xe4k\xcfw\x12>kuN\xb5~\nL?L4eJ\x0f\xb5\xdf.a\xa08\xf9\x81_?\x1fQ\x9e\x1aK\xd6\xd0\xde\xbeE\xb0E\x1f\xe5\xce\x8dK<)\xa5\xa4>r7\xffz(\x1d\xcc\x15=\xfa\xe9\xcc\xf2\x8e\xe6\x8a\x06_\xbd:\x97\xaa\x1a\xec\xc5\xb2\x90\x93g	\x80\xdfxx\xcc\x86\x1a#\xf2\xf9iT\x8b\xfe\xbdI\x18\x85\xe5F\xd65x{\x17\x18\x0c\x08\x88\x05\x15xM\x11\\\xc1[\x8e;\x89\xef\x15\xf5\xf8\xce\x15\xba\xaf\xa7\xc1\xd9\xb0K\xe8.\x8f\xd5K<\xab\xc9\x95k\xb1\xf3\x1b.\x1eW\xf2'\xbe\xc7\xbc\xa3\x97\xa3\x9d\xbe\xb5\x888\xc5\x17\xcbq|g2\x0c<\"%\x8e\xdd,\xfc4#\xa6\xc9\xbf\xf7\x9chn\xb7\\\x8d\x97<y\x12Kr\xd46\xc0\xe5:\xb6\xb2\xe2\x83\x85{}\xaeh\xfd\xd30m\xaa\xb6\xe1\xca\xa6\xc8*x\xcc\x92\x1cc\xb63\x0bK\x92\xdf\x9a\xa9\xbf6\xebu_4D\xe4\x9a%\x8a0\x83~,u\xfa\x1da\xf5\xca,x\xea\x98\x055M\xe92\xc2\x15\xf9\xcf\xfc<\xdd\xd0\x9bE7\xe9\xe2Z\xc6:\x06\xef\xe3\xbb\xde\xb2n,\xb8\xb4\xda\xb74\x12\xb9\x89\x01\xf7\xfb\x1dK\xc7\xf7f\xf3A\xe4\x1c}\x9e\xa3\xaaf\x1d\xfdu\x97\xf58\x17\x91\x08Q\xe6?\xe7m\xb7\x13\xf1\xe9\xa6]\\wA\xd7\xc7\xe2U\xad;\xab\xf8\xee\xe7\x1d\x867\x1a\x8c\x85j\xfb\xcd\xfbB\x14k\xad\xa3\xdd}+\x11\x89'\xba\x89x\xca\x83\xf7\xfd.\xf1\xd6\xfb\x11l\xd7\x1a\xb1\xed\x84\xf7=\xeb\xa9\xcf\xc9\x0d\xf1*\x80\x19\x83oA\x05\xd6~S\x9c\xb3\x10\xc3\x9e\xc4\x15\xb1\xc0\xe0\xa3\x8a\x81\x00\xcf\x98}E1\xa8\xf7\x8d\xa9\xf7\x9d\xae\xf7\x83\xae7\xbd\xa20\xac\xaax\xf4\x88\xee\x9d\xee!\xa42W\x0f\xeeLn,x\x8f0Q\x9fxj\xc0\xa8\xe9\xb6\xe0\\\x8b\xdb}/k\x8bc!\xce,mI\xc7\x81P\xe6\x94J\x96\x19oF\x91lBdG,\xf2\xe1*S\xe0\xab\xb8\x11\x84\xb8\xec?7\xb1\xbe\xddh\x12\x12\x95\x1c\xbdk;X'\xc7\xbf0\x91\xd7\xea\x8a\xa2A\xb2\x03\\\x13\xea\xb2Dn\xf5!\x92PK\xd3JJS\x8bd\xf7\xb7\"dz\x94g\xac\x9be\x98\xb7\xcd\x91\x1a\xb1l%\x1d\x0c\x15\xb4J\x1fJ.<\xf8\x1e\x9c\xa9\x8a\xeb\xa4Z?T\xc5\x0f\x06\x97R\xe5\x96\xd2=*e\x9d\x0b\x01\xa4\xd0\xc1\xf8\x9a\xb6\xe2B\xb18\xa34\x11\xb8\xec>q{M\x99i\xbd\x99\xf0\x110\xe2#`\xd6\xfdn\xa4\xb6\xcf\xed\xe8\x8e\x1b\xdaU\xd2\xcd\x1daQ!\xaf\xd1\xda\x89L'\xf0`\x19\xfb[\xb2\x93\xf1\x8e\xd5\x9b\x16b\x94\x9a\xeeQ\xb1\xe5_\x87o\xea^u\x17\xda\xf8\xf7E\xdf1(\xbbr\x07\xd4\x0f\xce\xde\xafB(\x91\xd7\xe8Z\xdd\xc3:\xe6~\xc2\xc4\xba\xa0@\x80Q\x06fP\x9aA\xe2j}\xa0\x93\xb7a\xaa\xf8\xce\xf4(-\x08-\xe0%>\xb3\xe1W\x03\xa3\x01e,\xa9x\xbc?\xf09\xd8]&\xba\xe2f\x9c\xd3\x8df\xfc Bx'\xba\x05M\xe7;o\x16\x88o\xacgs\xa4\xccjb\xb2?\xc7\xb01\xc7^j3\x0d\x1f\x07b\x16\x161\x84\xbc6\xb6\xb7,\xac\x07\x96\x85$\xbf\xc4\x06\xc3\xbf\xd6Y\xf1U8S\x9f<S\xd27I\xda\x9b\xc9\xdc\x1e\x1fO~\xe2\xfc\xbea\xe6\x88\xde\xdf\xe8n\xb4=e \x88\x86\xe9\x15>\x185\"\x06\xf7\x93 \x97\x11\x0f?\xf08\x9e\xf6\x0b\xc1\x83\xfd\x00\x1e-?\xb0&\xa7\xe8\x1dG\xa5\x05\x1e^pP\xaf\xab\xc1o\xce\xed\xec\xc1\xda\x08\x06 7\x9f\xd6\x82{.\xda\xd7\xc7]]\xbb.0f\xc7\x13A!&\xa8\xe2\x05F\x9e\xa8\xbc\xf4\x8a\xaf\xe2\xdaH\xe2\xa9@\xe2EoS\xc6\xca`t\xb0o\x19Al\xa6A\xe2\xee\xbd\xac\xc4\xbbQ\xe1\xf7G\x8b\xde\xebW\xf6\xa1\x02\x97\xc6d\xf6\xbc\xb1\x1f\x88\xa8\xcd\x89^\x84\xa2\xeb\x93\x99\xb3\xb8\xfdx\x9f\x08f\xd2\x95\xb0\x9e\x06i\x1dK|y\x08==J\xfa0\xdfL+VH\xfd\xe0\xf4\x0d	\x8cM\xcfj\xbc|\x03\x03I\xb8}M#>Y\xc2\xcbCX\xf5\x83\x85\x1bwb\x0b\xef\x92(\x15.\x9e\xd5 \xb4\x0c\xc3\xeb\x1a\x11}\xc7\xb3r\xc4>\x08Q=0|\xee)\xad\xc9)\xad\xdd)\xd3\x9dH@\x80\xe3%a@L3\x0e\x89H\xf0N\x91M{UK\x99\x05\x1f)\xb5uJks\xdc\xed\xef\xe6\x83\xdaw\xff^\xe1%\x08\xab\x9c\xa5\x16\x1eV\x94\x1bgH\x18<\x95\xbcF\xa5G\x1d\x1f,\x15\xa4\xfc\xfd5\\\x97\x9dVO\xedP'M6\x8e\xf3X\xa7\xcax!4O\xc4\xc2\xfdX$]V\xfaPo\xa7\x82\xf4\xa6\x93.\x98\x11\x0e}o\xde\xc4R>\x0d\xb3\x17\xb7\xd8]\xe2\\\xc2\x0b\x93&\n\xb3\xc16\x83\xe79\x0bf\xbc\x81G\xe2\xe3\xdc\x06\xd6\x8b=\xb9xm\xbe\xcd)h\xc1\x05\x19\x10\xd7I\x1f\xed)\x1c\x01\xbeWH\xf4`\xdbX`[<\xdcs\x1c\xeb\xfd Q\x9f\x9b9\x0d\x94<FZ\xfa\xd9B\xfb\x96\x9a>f\x18ud\x98L\xb4\xe9v\xd2\x86\xe2\xf9\xb9	\xb7\x05\xd3f>\xb3\xfd\xdeY\xc5\xf3\xae\xe6\x84\x9f\xd5.\xad	@\xd5\x00\x8f;>\xe4\xf3\x1bW<\xe1\xf2\xe0\x83\xd5\x82<%\x11*$\xa2\xc8N\xd3\x19\xbb\x01v\xc4y\xd6\x84<\xab\xe3W\xef\xdb_\xbb\xda\xcb\xa7\xd8\xdc\xc7\xd3q\xc2\xe7\xed$\x1f\xf5%\x9b\xd5\xc3~\xc1\x17J\xbc\xa2|\xf9d\xe6^N\xe1\x9c\x1e,\xef\xf8@\x13\xadP\xeaJ\x93\xe56V\xd0\x89e'\x04\xe4S\xb2\x984\xf3\x99\xb5B\x95Vz\xf7\xf9\x1e_ii\xad\xd5\x9b\xe2\xaam\xc6T\xfc\x13\xdco\xb2\x8eO\xeb\x85	\xc7u\x13\x0f\x82\x92\xce\xbb\xe1]1Sg:-\xe2I\xedF\xba\xb6GX\xe7\xa3\xf19g\xda\xfb\x19yqggD`\x97e\xb8	\x8f\xaa\xa1\xcd\xfa\xce!\xc4*\x08\xb1Yz\xd6C\x8c\xeaV\xa4\xf0\xbe\xb9\x8a\xb0Lx\xe9C\x897\x12\xbe\xfb\xde6\xd5\x10\xc6\xefc\xd7\x11Vz\xee\xbc\xda#}3)\xc8#\x8fKW\xfa\xea\xc5\xcd\xf4HU2\xf4\xcd\xb8P\x0f\xd8\xef\xb7\xc3\x90\x02e\xdd\xb1U\xea3\xbd\xec\xa3`T\xad\xacd\xee\xfe\xed\x12\xe4T\x1e\x85\x1e\xde\x1f\x1d$\x96\x94\x10-\xcc\xd6\x11}\x80B\x88\xf0\xd6zzI	n8\xf1\xf8\xae\xbb\x13fu\xabf\xadh\x94@U\xc8\xf1\x9c\xd2\x10{\x14bDRBq\xc2\xe8\xb8K\xa7\x80\xdd\x83s\xc0??\xfdk\xd3\xa2\xa5Sj\x14\xbeF\xc6\xa0\x80Y\xb7\xaaRR\x86Q\xc7\xc6-\xe4\xb69~&\xbb\xabx\x93\xfcFD@Jh\xbd	,.\xb2\xed\x94\xb7\xef\xeadu|\xe9{?SC<^\xcb\x8a2\x1f>[H{\xe3\xb7n!\xb6\x8f;j\xaa\xe5~\x8bI\xfe\x95)n\xa6\x87o\x9c\x1f\xc3 }\xf0\xed\xf8\xa6g\x12\x8f\xdb\x95\xb7\x1e\xe8\x03j\x8f\x16\x1e}\xad\xd0%\x0f\xe6l\xaa7\x9f\x0eo=\x94\xee\xb8\x8bV\xe6\x08:\xbe\x0ena\xd9\x10!\x1b\xcd\x984\xbf2\xcb}Q\x9a\x1f\xe3\xe1g\xda\x1d\xf4\xe5fJ\x8e\xafeN~L\xff\x0c\xe5\xab\xf6\xe1\x93\xf0\xe1\xfd\x890\x9a\xb3.\xd8\x98\x90P)\x10\xe6\x86d\xfa3\xf6\xc6\xe6\x8a\x00Z\"\xeaqe\x18\x95\xd5\x013\xdc\xf7\x8c\xbf\xd9\x942\xba\xa3\xe9;<\x92g\x97\x9fQ\xcb\x1e9UpVo\x90\xe1\x89\x1cm\xac\xf9\n\\\x99\x10\xe4\x94\xbe\xbf\xd9\x0d\x96Dj|\x1e\x14D>\x9eE\n\x9d\xc4\\\xf5\xbbt\xc3(2l\x8e\xba\x810\xc7\xdd\xe3.\xc7\xaf\x9b\xc3\xe2%2?\x8c\xe1\x9c\x013\x98V\x89 ~\x18\xbd\x10\xdab\xd92\x96\xc6\x84\xdf\x96\xf3\xc5\xe7'\xe6\xf9U\xf3..4\xe0\xa7\\\x0c\xf8\xb6\xd7\xcawe\xbd\x10)\x85\xd4\xb6\xf8\x0fQ=\xfck\xa0\x19\x9d\xdcj\xfe8\xb5\x12\xae5\x97\n\xa0Y\x84\x9dM\xd3X\x89c\xc7\xff\xac	\x1e=U\\\x9d?\x919:\x01?\xaf\xc3\x87\x9bYi2\x11\xc7\xca\xcas\xd9\x8c\x8f\x8d\xd5\xa9<P\x96\x82#\xca\x05\xa3\xe8$\xd2\xdbG\xca@:\xcfw\\\x1d\xa1\xd9\x7f\xf0\xb7	\xb9\x10\xf1\xcaO>\x9d{}\xa6*l\x0d\xefK\x8ecyB\xe3\xf0K3Uu7\x10\xd3\xc2\x8b\xe9\xb68]\xd4*\xe7\x881\x8f\x0f\xa7\xedL@+ijc\x05\x81\xaa\xefO\x0c\xb97\x02\x99\xd0\xec\xd3\x0b\xa3\x91\\t\xc1;\xbc\x89\xe3\x03W\xc6\x11V}\x9f\x04\xbepc\xc7\xe7\xb3\x12\x8a\xeb='\x08s\xa67\x80\xdc\x89<\xca0\xb7\xcf\xde5\x89\x95\xecJ\xfe\xf9.\xc4f\x85\xa2\x94\xa2?Ke\xaf\xf53\x84\xf7\x02\xcc\xfb\xce{\xc7l\x99\x0b\xb5\xde\x8frlx\x8d\xfbD\xecsg\x10k\x192\xcc\xd4\xab\x9b\x96\"'/'\xd8\x0b\x0b\xebK\x9dt\x82$\x92\xe1`_O\xca\xd2k\x18=9B\x1bR\x7fs\xfc\xaa1\xb3\xa9	\xc4re\xab\x87\x99G\x14n\xcc\xb1\xa7P(\x05J\xf2\x0e\x1f\xcc1\x19\xf3J\x9c\x8f\xfa\xfaP\xe6\xe75,\x05I\x91\x1f\xfb\x98\xe5\\\xa2S^\x98\xb5\x86\nB~/\x99\x17V\xbfL4yf\x9f\x01\xa3\"'\xd8\x8du\xa5\xd8\xf7\x1bb\xe18tq*9F\xbb\xd2,qQ7\xf1\x86G\xcac\x1bL\xf8@\xb2D\xee7^\x08\xd4\x89C\x90\xb35nO.\xa50\xd7\x7f\xfb%\xad\xde	\x8ceS\xe5\xc3\x8dpA\xf5\xea\xa9\xbf\x1a'x\xd4\x82\xf2\xd4\xb0\x85~\x1b\xf8\x03\xdbs+\x17\xaac2\xf8\xf3\xb8\x8b\x86;\xe2JY\xda\xa9\xf0\xb0wuYo\x83\x0b!\xab\xceY\x13o{\xdeSF\x1c\xc2ud\x88\xe5<w\xc8\x98\x1fD\x16.=\xdeF8#:e\xe2\xca\x0d\xa1X\x81\xb6\xfb\xe34\xe5OYd\xcf\x07\xc4'.\x0f\x9e\xee\xf9\xa0\xfb\x0c\xad\xb6\xbb<\xdc\xf8M\xba!\x81\xa8\xc8p\xaf?\x9f\x13\x8a\xe5gPH\xba\xca\x9bk.\xfa\xe1T\xb5\xcb\x0bE<\xd8\xcd\xb7Ur\xc7\x1e\x02<D\xd87Ef'\xfc,I\x12!\xa9G\x89\x9f\xe4\xf7\xeeB\xcf;Y}\xf0\x97]\xb2\x19\xa9/cW\xbe\x99\x0dY>H4\xcdw\xfcY7Gn\x8e\xc1=\xacKF\x17\xb8\x16\x82G\xe5`n\xfb	\x0c9\x1b\xf67\x8b\xbe\xe7\x10i>\x1aS\x07\xd4\x0b}2\xe5\x07#n\xc2\xc4\xb4aY\xb5#\x1c.\x84\xad^\xa5\xd8\x0d\x0b\x17{\xef\xeaa \xac\x1e\xde$9\xf7&\x9c*\xbf\xfa/l\xab\xd9\xa6(,\xa3U\x8a\x92\xeds\x9f>\xf9\x87\xe53\xe2\xf6r\x88`A\xeeG\x0d\x18\x1arltq\xfe\x89,\xaf\xb2?\xfc\x93TC\xdc,\xcbZ=\xde\x0d\x13\xfa	m,xARoz8gc\x9e\x9f\x8b\x05\xadO\xf8$\xfc\xdais\xd7\x06n\x89\x08%y\x07X>\x0d\xd8\xc3k\x03\xb1e\x87\x97\xb4\xe7\x89c\x17\"I\x85hx>\xd5~\xdbDa\x8b,%n\xe8W!\x11P\xc5\x03\xe8\xa2\x86}5\x94\x1a\x15Y\xf0\xb6?\xc6\x89\x18F,\xa6ZQ\xf3\xb7\x97\x17DZK\x04>{\xa7\xf435\x10\xae\x88\xcc\xeb\x08|U\xa3J\xfe\x88T\x1f!\x92o^\xd6v\xfe<\xd8\x9b\x8b\x89}\xd3\xd7\xce'g\x0b\xb6.\x11Q!_\xd1u\xc6b\xa7=Z\xc6\xf7,\xe3\xa6\xd2y\x1a\xf4w5\">/\xee\xe6\xa6\\E\xb1e\xb4\x11w\x8dy_xk\x9c\xb3<\xef\xb8\xfb\xc1W\xe2&\x08\x9e\x86~\x06\xfd\x011\x04Q\xf2\xcb\xf7\xc9\x88\n\xae\xf7\xc2<\x8e\x17\x95\xd6\x04\xfe\x92>x>!\xb5\xde\xc7q\xb6\xebO\xdc\x1f\x87\x16\xb3\x1e.\x9f9e@,H\xb0pJ$[8\xd7\xf5\xbdq\xaejN\xab<wdD\xc2\x0f\x99\xf0T\xbb\x87\xb6\n\xbe\x8a\x8b7\x84\xc8{\xb5u\xd5\x81_j\xea\x83\x0e\xb6*o7\xcd\xfd\x84\x84\x13\x87\xaf\xbc\xb4\xd9\xba\xbc\xd9\x9fz\xf5\xf7\x11\xc5\xcd\x10\xf6\xcfW\xc4\xd4\x0f\xb6\xf7e\x87\xc0\xe0\x0b]v\x86\xa1!(h\xe7\xee\x0co\x81\"8\xed3\x16V\x1f\x8ejOI\xe5\xfb\xa6\xd9\x92\xe9a\xcf:\xc5/\x1c\xc9Q\xd73\xe3\xee\xc6#w|\xaf\xcb\xd0\xbb\xdbO\xe5\xac\xcc\xf0\x9cU\xa5\xda\xb9\x07$\xfb\xf1\xc6_\xbb3d0\xcan\xa1x\xec\xbbT|P\x0b\x9f\x1d/\xbb\n0\x14yR\x8fZ\xf7\xf4\xd6\xc9\x94_\x0e&\xbcZu\x95\xb5\xb9zlD\xc9\x0c\x19\xee\xa1[\xb8hmK<\xfb\xda	\\\x15\xfb\x8c\x00\x8b\xf5~dz\x8fx\xbb\xd3\xe7\xbd\x16<%\xd1\x91\xc5,\x0d\x9d\xea\xac\xe0\xc3\xbb\xf9\x8c=t93\xce\x8a\xd8\xcf\x07w\x1cb\"\xdb\x17\xa5\xf2\xc8\xc7\xd3\xe6Z\xa4\xb0\x84{~\xee\xa1\x8aW=\x1a\xa3\xea\x87\xb4\xb4\xe7\x1e\xb4\xd2Md\x10\xfc\xeagNzjt\x1cy8\xa5F\xf1j\xd1\xd3%=\x13\xa7	\xd2\x0f\x182\x81\nH\xdcC\xde\xcc\x9bRdQB\\\xb9f\x96\xac \xe7^\xd3^\xc3\xbb\xa2G\x04?\xc8\x16I}=\xbc\x81\x82 \xb49\xb6{\x16\xc5E\xff	}\xe7>\xf0]\xee\x10\x01\x8e\xdc\x0f\x1a0$\xb4\xb0\x06\x0f:\xa9\x1fn\xbcI\x8e#&\x06=_;AY&\xf8H\x07\x98|\xce[\xe2\x06\xba\xe9\xc77\xcdxDEx\x05\xfc\xb7\x9f\xaa.\x9e\xf6\xc9H\x94\xcb\x18\xf3\x08\x83e\x94\xf2F\x8e\x03\xd8\x87\xe1\xde\x82\xae\x9ak\xef.?\x88\xa7X\xf0\x9a\\\xb8.\xaa\x14_\x0c\x1c\xe5\xebb\xcd\xbd\x19\\\x1bQYr\xe1\xb2\xfe\xa5\xc15\xa3t\xfas?\xc2SV\x95\x06\xe1L\x88\xbe\x10\xce\xdb\xe7\xdd)\x8f\x1cM\xe6D!\x9fe\xd8\x1c\x1e\xb3\xd0\xe2X<\x1e\xb5\xcd\x8c\xe7\xcb[\xfc\x0f\xfa\xbe	\x9bn\x87\xaa\xf34\xd9\xce\x1a\x8dn\xb4\xdf\xa7\xab\xe2H\x9agJ\x8fr,\n\xc5\x1d\xc5-\x07\x1b\x93\x0daF\x91\xac\xbdc\xa8\x08cS\x07\x8b}2\x13\xadNYX\xae\xae#\xde\x11N\xf0\xcf\xc9\x0cap\x0f\xcf^U\x96\xef]^\x88\xd1*\xa1Bf\x08B7\xd4\x8d\xbbo\xf3J\x90\xb7\x07I\xdb\x1d\xbb<\x12\x96\xa4\x90\xfdm\xb4u\xe9SK\x08\xdf\xaf\x1bC\x87z\xe38cH\xf4\x9b\x94\xe0YJ\xd6\xa6\xeax\x1e\xcc-\xf9\x8f\xa6\x19\xfb\x07\x89\x865.(/4\xb8\x870o\xec-v\xafU\xd1Z\x91\xe1\xae\x02\x9b\xb6\xc0\xc5s2\xe0\x07\xca\x08\xc2\xa8\x1b{,\x93\x9f{\x86]\xfe\x168\xde@\xeeX\xae\xb1\xc4\xb8{\x0c\x18>\x15\xae7\x923\xbe\xfb\xe6b2\x12\x17\x95\xd7	\xa6\xd3:n\xb6\xaf\x15\x17\x11(s\xf9\xd5\xa2\xb1t\x86e\x82\xbfYj\x18\x91wx|\x12\x8a\x92\x17X{\xa5F!l_\xde\xdc\x0b\xf6\xcf<\x06\xc5\xda2\x1bQ\xf0\x9b\x96\xbbm\xaf\x14H\xe1\x8d\x9a\x98\x13\xaa\x124\x81\xcam\xac\xd4\xe6M\xfb\x8an\xac\x92\x15x\xfc\xf8h{\x87\x8d:\xbc\x9cW\xbb\x942\x8e\x8f\x04\xfe\xfc\xce\xac\xd2\xda}\xbaw\x16s	\x8f.\x9a\x11_\x82\x97\x1c\x04!j\xe9\xd3\xe5\x1e\xf3G&\x17\xee\x10}\xf5]\xcf\x01\x97\xf4\xd98\xc2bl\xf1\xbdo\x96\x1b\x9d\x1a\x15\xa2#\xc2\x9c\xbaK\x0f\x8f[G=\xee\xbc\\\xbf\x92\x96}\xa5\x96U4\xcc\x8b9\xb0\xfe*\xe9G\x8fc\x1ez4\xe9\xe4\xaf\x17(\xc5\xdc\xa7\x18\x97\xa5bGYf\xb5<\x06p\x93\x94\x12\x16\x12\xbf\xc5\x8e\x92h]c\x98\xd4\xf8\xa8NK*\x83	\xde=5\x8a\x17(\xa7\xb4\xae2e\xef\x97\xc7\x92\xe9d\xd5\x0c\xc19K\xeb\xb6\x92\xd4B\xe2\xcf\xcf\x86\x92\xf7\xbak\xe3\xd5\xc6\xad\xfe\x15\xbcJ<\x1bE\xbc\xe9}\xc1mEH\xaa6\x110X\x92\x06\xcft\xf2\xd5\xc8\x05\xefU\x00\xbd\x90=\x90md\x9f\xd0W\xf9M\xe1M\xfc\xe8\x9b\xa5hM\x1b\x9e\xdb\x8f\xa9\x86F@(\xf8\x10\xfdX\xe5=*#J\x96/\xa9w\xdfKqh\xa2\xe4`\xf6\x8c\xca\x84\xbd;\xda\xed\x10\xe3\x1e<\xea\xb7t\x84\xfe\x87\xeb\xe1\x85\x0cg\xaf\xad\xcd\x12\x95\x0c\xb5s\x97\x10v\xb1k& \xde\xfd\x8cz\x1c\xbf>\xa3\xcc\x89Z\xdep\x9d\xb3\xbc\xb5\xc7\xcbG\x85Z\xf0\x9e\x0d\xaf\xb1v\x19B\xa0\x9a\xa7\xcf\x8b4\xfa\xb9gh\xf3\x94\xe6\xd8\x1b\xfc\xf3\xe4\xd7\x8b\xd0\xe4h\xa9\xdf\xc3\x07\xd7'\xe4\xec\xa5\xa5#|\x15\xd7\xe5\xf2DU^+\xa6\xa1\x10bMU\xbck(\xab\xe8!\xe6\xfe\xf7\x8e\xce\xea\xaa\x81U\xfb\xc1;\xcf\xc5\x0c\xaf\xba=\xb9'\xec3\x0bb\xb5\x8f\x9e%\xc5\xcc\xd1\xde$\xbe\xa7OH\xc9`\xbax\xf6\xea\xf3u\x9b\xd6g9l\xcb\xa38n\xf3\x9dT\x97\xdd\x9a\x96\xedu\\\x18\x97d\x12\x8c\xc4\xfa!8\x83\xd97\x17\x97|N*@Q\x9c\x001\x8eg}\xa4\xec\xb7\xa2\x04\xac\xf0\xb0suI\xe0\x81\x10H\x93\x926J\xda\x08\xcb:\xf4\x9cz[P\xfb\xd2\xc4g6\xb6{d8\xe7\x95\x86\xbb\x84\x1d>\xf3\x8b(\xe7\xcfEj\x9b\xa7>\xc2\xff\x9b\xa8\xabH\x8f\x9eg\xb6\x0b\xf2\xc0LC33{ff\xa6v\xaf\xfe>I\xde\xef\xfe\xd3\xb4S\x92JE\x92\x8fOA\x8e\x14\x83hq\x8c!xn\x00\xff\x1e\x88\xb2\x8e\xe5L!1\x9f\xbb\xb9\x18\xd4\x07\x8c\xd9\xdb\xe3\x0d\xf9\xf2\x12]\x8aQ\xe9\x98x\xc7f\xe6\xfdU\xb6!7R\xb4>\x14V\x96\xc1*\xac\xfa\x14\x97D\x88\xb6\xd6\xfa\xbeS3\x1f\xb4\xa6WF/\xb5\x90\xa3p\x8c8\x15\xe6\xcbG\x89\xabq\xd1\xc1\xcct\xd2\xd8\xba\xc8\x9bt\x9d\xab\xf5\xaeZC\xbf\x8b\x13\x18tQ\xfa\xd9\x11\xb1\xb8\x8e\x17\xd7\xce\x01\x92\x04b\xd7\xa8\x8a=\"\x8d\x9f\xf2\xd1b\x18\x03\x08>\x85r9\x18\xf5$\x91\xf2c\xce\xa5`dnK\x10\xb6\xdd\xf6)k\xde\x1bp\\5\x0e\xc8m\xbe)C\xb18/\x11s\xc7l\xf3J	\x85_\xaa\xa0\xa7\xcbz\xabh0\xf1\x87t\x81\xf6\xf6\x91h\xe8\xb5;\xc7\x82\xc7w\x1b\x834\xe5p\x87N\xbc4\xd0;y\xe6\xf5\x1ba\x84N\xbd\x81hH\xfb\x8e\xf9\xe32\xaav\x99\xfb|\xefDY\x90\x91\x15;\x1a\xf3G\xd4=	&2^3\xefn\x8b\x86\xafy\xd1\xf0\x05\x11p	\x19\x98G:t\x12zh\xff\x90\xe2\x8a\xd3\xaa\x9ay\xe8\xf4\xfd0{G*\\\xbc\xad\xb7A\xa5\x83F\x87$T\xae=\x96{HX\xbbO]PZ\x99x\xf3\xb6O\x14\xfe\x1dG\x0e7?-\x96\xc2\x15\x88\x88P\x8bjW\x1e\xe3\xe6\xfbAB\x8e\xd8\xee\\?vP\xb7\xa4n\x04\xa4\xaa\xe9`0\x8cM\xa848\x93\xf5Z\xaf\xa7\x1f\xcbxk18\xd5\xfc\x9bb\xda\x82\x10\xca\x9a\xf9\x06BZ{L2\xce\xd6:\x93\xed\xc8v\x9f \xe3j`g\xdc0v\xcc\x9ezZ\xde\xca\x8b\xad\xe0\xbe>\x19NJdRfA\xc4\xedy\xbe\x0f\xcd\xb8rDy]\"Ve\xca(\x99e\xb9\xdf\x17w\xc9\x17Z\xbf\xd9\xc8]\xa8\x086\x94\xee\xc7\xb2\x1c\x83&\x89km\x9a\x81\xac\x1d\xd6R0\xea\xb1u\xee-\xbd\x9c\xfdV=\x99M\xa53K\x07\xa0\x0e\xc1H\xf0\xdd\xc7x-\xc9\xe7\xdb\xf2\x9b\xa1\xe5\xb6\x1bT\xb7\x7fE\xc3\xbe\xa09\x92&\xc5\x9a\xca<\x8c\xd5\xe6\xea\xb9\xc0d\x07}\xce.u\xf7\x86~^V\x92p*E^\xe3\xf6\xa8\x01tD\xaa\x99\xba=\xfa\xcdY\xdf\x197\xdf\xf5rG,#\x11X\x05\xd1Z\x8e#N%\xad~\x7f\x81\xba\x8c\xe8\xed\x93\xb0sY\x0b\xc19~\xb1\x8f:\xf7\xd7\x02\xcc\xfd\x15\xc4\xfdg\n[\xed\xdd>(\xcc\xefU\x84:\xb0\x03\xb6V\"1\xf1\xfd\xe6\xe3\xe7\xd6F\xca\xe4\xcc#\xf5\x03\x03\xb7\xea\xb6\x9b\xfb\x84\x18\x8f*^\x82\xaf\nU\x1e4\xd0\x05\x08\x86@x\x10u\x05\xd6u\x9d\xeate\xbf\x15\x10'\x8e\xbe'Y\xee\xed\xce\xd5\x7f\x9bw\x13Z\x04\xe0\xd7\x0c\xfc\xd1\x8d\x8d\xc6g\xa8\xab\xc2I\x93\xdbw\xb3\xd6\xe5q?\x18\xb6\xe3\x89\xd6\x9f\x91n\x1b\x86U\x861\xed\xa7	q\x0b\xf4\xbb\xd1\x96\x05\xe3q\x1bs\xa8\xbec\x17\x9el|R\xc6\xf9$\xde\xaf\xf1c+\x1ea\xbc\xb4\xdc\xc4\xf5\xe4\xac\xf8\xd1\xf14%y\x9f\\\xfc\x19G\x8fosz\xbe\xda\x19`\xe4\xbc\x84N\xeb\xc7g\x94\xf6T\xc8=#]\x10\xafZ\xf3\x8f\x9e\x8b\x81\xd9\x7f09\xf7~m\x1a\x9d\xe7MI\x1b\xd0\xc2s\xad\xbd\xaf\xab\xef\xa2\x01=n\xdfw\xc5U\xaf\xc0\x8dT\xceR,T\xeb\x81\xb5\xaa7Hu\x11Q\xdc\x00\xf7)\xe2\x94\x9c\xbau4\xd4q\xe84\xacD\xd6_\x14\x1f\x08\xd9\xab\xccN\xcbft\xed!\xfd\xd7>Ge\xa2\xa3W2\x04xa\xd0\xfcR\xb9{t\x87R\xae\xa7\xf43\xf2\x94/c5~ \xda\n\xaf;\xa2\x1b\x1fJI3\x0f\x98rc\xcfE\x81\x00\xe2\x96$z\x8a\xda\xb2o1\xedst\x96\xe4@\xe1\x13\xa7\x17|;\xe7o\xe9\x0d\xa8\xda\x13\xd9\xfc\x86\xe6\xbb\x02V97\x18\x86\xd9\xa6\xe1\xe0\x11,\xb0\xf8\xc4\xc9\x1f\x83`\xa5\xf7\xdeQF\x1bt\xe7\x19K\x12\x82\x08\x0d\xf3\xc8\xd2V`-\xac(\x83\xf8H\x15\xd8!\x91\xa6\xc1\xc7M\xa2\x01\x88\x8f\x80\x0f!u\xa4#\x1cq\xbfy\x85C\x95%\xab\x91\xb0k\xab^Bw\x81iIV\xcf\x01\xd4\xe2\xd4\xdd\xd0G\xe2\x076lK\x0fu\xdc\x9fP;\x87*\x02'l\xef\x8f\xfc\xec\xbb2\xc5i\x0dO\x9ck\x03u\x99\x92V\x89\xa0\xd6\xea\x84\xc3\xa6\xa5\xeb\x91\xc3\xe9K\xf7\x93\xfc\xa4\xb7R\xfe=;\x9fB\x1a\x810\xb6\x8d\xd7\xe0'%aD\xda\x02S\xb3\x1b-\xb7\xae\xd7\xc8\x15\x06_$Sr\xc7\xd1\xceZB]Z\xf7\xec\xf8S	\x89\xcc\xe0\x18\xbdI\xb9\x8aMe\xf3c[\xbf\xb1\xfc\xda@)\xb5\xde\n6\x05\xe2H\xf3k\xc4\xe76\xe6e\x7f\xc7&\xbc\xc8\xfb\x92*\xbc`\x86(\xeb\x9b\x15\x1e\xde\x8c\x1c\x92\xc9jfz\x06\xe2\x7f\xf0\xc3\xf0i\xac*\xd7-\x9c*\xe3#\x87\xaa\x03\xe6\xc0H\x88\x83\x12\xbe\x97\"\xfeb0\x84m\xeb\xf1Xw\xde\xd9\x18\xa6Z\xb2\xd86\xa2\xa0\x0fe\xca\x8fu\\\xfe\x1a\xe4\x92\x10\xf2`\xff\xa1\x1c/\xb82*\x01\xa0\xfb\xd6\x06gm\xc6\x9c\xb9\xae[N\xf57\x19\xf4\xf0\xc9\xb9\x8c\xa0G9Y\xe6\xe9=\xf7\xda\xa5\"$\xe9\xc7\xdf\xbb\xc6l#gc\xce|\xf7\x88\x11Rl\x97\x1c\x03\xc6U\xf8\xa46\x84&\xd5\x01\xdd;\x0f\xf3\x17kz\xfd\x02\x83\x96\x9a9\xc4tr\x9ffOZi9\x942\x8e\x8d\xcf\x94)\xe9\xf0\xe1\xdc\xdc\xec\x89h\x00\xc6\x99\xc8\xce\xde\xf0\x8f-5)\xc8\xdbGH\x89=b\x8be\x13q\x0d\x08I\xfd\xe7\x88\xdcV7\x8b\xaa\xc8|\xf6N\xbbg\x84\xc3*\x02O\x18\x06R<S\x82\xabf\xb2IFO\x1f\x87;B@^{\x84-E\xa4L}\xf8\xd1\xc7\x8e\x91\x08\xc9\xbdi\x05\x90n$h\xdbM\x8d\xf6S,\xe1\x11\xba__~\x8eq\xe5\xb7/J\x93c`\xde1h\x80?2\x1e\\|\xb3[\xf5\x7f\xecH\xe2(J\n\x0e\xa8\x85\xefs\xfb\x84\xd1\xbe\xf0\xabT\xce\x86\xf6^\xb5\x9bF:\xf6\x80#\xa4\x1e\xc4\xfc\xb5\x04\xady\xe4\x07\xd9\xc2]\xff\xa2\xb2\xea-aH*\x8f|\xde\x94\xa3b@\x16&\xde\xb6\x81nm\xb6\xe0\xf6\xf9\xc9\xeb\x81\\\xe6\x14\xff\\-w\xd8\xec!.#\x07\x004=\x06\xd5\x95\xe9\xd1\x94j\xec\xf4\xaa)\xc0\xbe\xdb\xad\xabO\x00\xf9\x1b\xeb\\m7M\xef\x85\xbe\xab\x9b\xabO\x89\xf6\xc4\xc7zK\x96\xa8\xdf\xd7P\x1b\x8b;\xbe\xb4\xb7\xcc\xe5g\x0d\xbf\xb3\x07\xa4\xbck\"\x0c\x9f\xd0\x1c\xfc\xd1\x9c*\xd2\x15O\xa4\x10e\xddbi\xf2\xb1g\x94\xb6;\xacIR\xcb\xcbLO\xd2\x8c\x18\xeb\xc7\xd8\xd4p\xf2\x95\xe5wOu\x08\xc6\x10`\x07#\xd9\x92 \x06\x1eG\xf1\x06\x1c\xe2\x13U\xc0,F\x9a\xf6A%1\xdeswK\xfd\x1eY\xd5p:\xeb\xe6\xbb\xc9ZN\xf8\xad\x00\xe4vI\xac\xc0\x119\x1d\xeaP\xcb\xca>\xeav\x86\x89\xcdN\x19 \x08\xa7\xd2\x97\xb16}\x94\x92\xcc\x10P\xcb\xccw\xfa\x16\xbdEU\xb7\xc7#\xd0\xb7\xdajK\xb0\x16g\xe6\xa4@C\xa3\xf7\x10\x9e\xd3&\xed\x11^\xf1\x00\xd1/^\x05\xda0\xba\xe0\x13=\xbf\xd1>\xfb\x84\xf3\xb6\x12\xb5,'\x92\xda\xbd\x17\x0d\x9fu\xa1\xd54]f\x05G\xec\xc7\xdc\xeep8A\xe6\x85\xa8\xdb\x98\xf7*'\x03~\xec\x95\xd0\xfbTv\x16D\x13\xda\xbe\x04\x92/q;\xc6\xd7\x81\xd2*\x9b]\xa5u\xa1\x8f\x15\xb09f\x8f\xbe\x9d\xef7\xa1~?\xdc&\x88\x0b.\xa8bT\x91\xa3HBv\x0dR\x1b\x176\x84\x14\x81\xe3\xec\x11\xb79\x7f`\xddSg/\xf3DJ\x82\x15k\x0f\x1bk'1\xcf\x83&\xf3$X\xb1y\x04}\xc9\xb5f\xccb\xa3\xc9\xbb\xf7\xb1\xab\xb6\x98r\x1d\xf6\xe9\xf4\xf8\xb0\x17\x1a\x8c\xce\xbb\x7f\"~\xd0\x063\x8f\xb6\xc9\x1e\xd2\x80\xcd\x8e\x88\xc9n}yG\xe9\xb0\xd8K\xd7\x1b<\x9d>\xad\xea\xac\x11\x81\x02\xaf\xc31\xa6$8\x87\x10\xc5\x857\xf3\xe2\x01\xd8j\xe6O\x91\xdd\xbe\xba)$\x1d\xf8\x18\x1agft\x05~\x9ey\xfa\xb4\xa6\x8bu\x9fZ\x10Fm\xcaUa\xe2\x95\x04M\x9b\xbefw\x17\x9e\xd3\xea\xdd\xe0\x10]\xb5\x08\xd6\xa3\xd9\xadH1\xac	\x02:\xc7\xe4Da\xe0\xd5\xd4\xcc\x91\x0e\xf0\xd3\xc9\xda\xd4\x97\x9b\x80Y+\x07\xd8;\xdb\x8az\xf5\x13k\xc2\xdd\xdd\x02s\xdbl\xb8\xcc\"\xe2\xae:\x14\xab\xff\x94\xe8X\xb9c\xde\xa2\xa1\xb6\xa8\xe3\x8d\xbet\x87r4\xaey\x86\x10\x16\xfd\xfe=\xff\xdeU\xf7\x9e\xf1ck\xe3O\xae\xafX\xa8\xdc\xf3cU\xee\xd8\xc5oVR\xe2/\xbd\x04\xca\x9a\xc3x.\x0b\xa7\xf2-\xa5\xed\xa2\xac\x13\x98\xad\xf1\xcc\xc3\xce\xb0\xbed\x0c\xcf\xdd$8\xf8\xf1\x06\x98C4\\dJ\xddCq\xe3\x99\xc7\xdb]u-mlZ\xb8?q\x04F\xf8\xf0\xdd\xbe\xd0]\xf5\xd4\x14$\xb7<\xfa\x927\xc52\xf3.\xa4\xf8\\+\xf1I\xe8q9\x147\xce\x7f\xcef?\xba\xce\xf1\xa5\xa5\x8dF\xf5\xaam\xfe\xa9!\x84\x05\xf9\x14Sp(~(W\xcf]u\x04J\xa0\xc2\xe81\xf9w\x95\x02-\xee\xb9\x0d\x1d\xe7S\x8e\xda\xd9\x85\\\\\xf7\xb4\x05\xb5\xd1Z\x1b\xa6\xe8BD\x0e\xc8\x19\xec\xd9\xec\xa4\x9e\xd0\xc4\x1fy\xfd\x9c8\x90\x07 \xe0\xa7^\x94$\xf2s\xfd\xec\x1d\n6\xe7.\xed\xec\xd7\xf8\x829\x00Z\x0f\x00m\x04y\x83\xf7\xc7\x9e\x82\xdc\xb1d\xd7\xcd\xbbOv:xtFz\x1cb#{\xcaTA\x80\xd9\x8b\x94^\x9f\x03\xbb\x8f\x04\xe1ynyhm\x1a\x1fY\x87\xc2\x89\xce\xd9\n\x82\xc0q\x9c\xcaO\x9c\xcc$\xcd\xe28C\xc7\xa8*\xe7T>\xa9\xbe\xe9\x08\xd4\xc4\x13zE\xaf\x02)\xde\x92$2\xd1\xc0E|\xd7\xc8\xa9\xc5\xc4CH\xd4\xbe{p\xbf\xcf\x92\xf60\x1aM\x18\xa9\xef\xb2\x9a\xd6\xef\xef,\x85\xa2F,\xc8ls\xa6\xe6i\xe1\xcb\xf1\x9f\x88c\xa8Ty\xbb\x10i\xadz\x9ca\xd5\xaf\xc3\xfc\x8b-^0\xa3\xe7\xe9\x17\x14\xe8\x8f\xae\xb7*P7\xd8\xcd\xe1E=[~\xfd\xdfo\x12:'\xc4\x95\xf9\x898\x11jG\xee\x0f\xcb\xd9\x19{kAQ\xf502\x1b\xfd\xe1P\x83\x19*\x10\x89\xf8\xe0\xa8W\xc8\x7f\xdf\xa6\x0dj\xbf\x0eV\x8f\x81\xbf\x18aa4K\xa5\xf9\xb6\xea/N4\xf0\xd9\x94\xfb\xc3b/\x0bG\x08\xbf\xd8[3\xf5\xc4\xdf\xef\x05R%#\x96\xd9o\x1c\xab\xfb\xc5\xbat\xdeZ6D\xc2_O\xbcz\x7f\x18\xd63\xb7\xef\x92\x7f\x83\xf8\x1f.\x9b\xb0\xf8\xf5\xe1q\xd7\xfb\xfb\x9e \xb0\xc0\xd3`\xc1\xcc\x0e\xfe~\x1f\x90s\xbc\xad\x99m\xc6?\xf9\x03\\\xe0\xac\xdeN\x7f\xf8d)\xcf\x10\xc4\x98\x7f\xf1\xad\xda\x94\xf2;\xe3\xfc\xca	\x95\xaf\xec3%\xc2\x9f\xda\xef\\SuK?\xa0\xad\xfc[_\xda\xaf\x8f\xb5\xb3\xf7\xffd\x92\x00\xd9+\xdb?\xac<\x04\x83\xce\xf2\xf7M\xd3\xb4\xa9H\xa1\xfc\xe2\xe0\xb5)\xa5o#u\x86_\x9dl\xeb\x0e\x97F\xb7\xfe\xae\x89\x8f\x82v\xfc\xfb~I\xa2&\x96\xf8\x87U\x9f\x18\"\xe1\x01(\xf8\xc5Jy\xa1~\x92\xd6\xe4\x05\x7f\xdfJm\x1as\xb5\xff\xf0\xe5\xc1<\x8aL0C\x06mX\x92\x99\xb3\x1f=\xfa\xe6\xdcx\xa0O\xd1(\xb4\x04\xb0X$'$\xc6\x1c'\x0d\xb0\x87UV\x85\xc5\xb4\x85\xcd\xf9I	\xd2\x1f\x10\xff\xae\xd9\xbe xG\x83}u\xbe4f\x831\x9a\xee\x08\x98\xb2\xf2}\xb0\xf1}3\xb45=$&?<I>\x87\x042NA\x0bu\x0ee\xf1\x1d>F\xfb\xd0\xe7^_\x1cI\x161\x9b\xd3=\x96H\xc7^q\xe0}\xb0\xe8}L9Z\xd1\xf42\x03\xcf7\x01\xfb\xb8\xd4\x1co=\xbbW\x08\x0e\x14\xca\x13^\xa4\x10>uH\xf6\xe5\xb2O\xc2tG\xf4\x90[\x18\xd5R\xde\xf3(\xdav\x05%\xa5\xe4[\xca\xfdf\x00\xec\xdaR\xaa?7\x92,7\xb8]K+t\x96n\xbe\xe197\xdc\xd7\x0b\x83\xe8\xfb)g2\xb1b>r\x85BV\xbaK>!\x00\xa0,\x7f^3\x9a\\A\x82\xb6\xbe2\x8a\xe2\x11\xdd\x92	\x8d\xf1K\xb6\x8dh\xbb\xd4\xed\xe0DO\xc9HG\xc5\xb7$\xe8\xd15\xab\x1du\x1b\xc0\xfb\x81_\x8c\xf0\x0d\xcfhL\xb4\x19\xf4r$\xaa\x99\xe0\x01\xcd\xfa~\xcb\x87\xd4n\">\x85\x1a\xd3\x06c7\x1c\xaf\x0d\x10cU\xcc\xda\xa2u\xac\xf89CJ\xf4am.\xa1m\xaaD?\xd2\x86\x97S\x0c\x9a\x17z\xcf(\x7fcfe\xa5_,\x9d\xed\xe6K/OnU$\xf2j\xd5T5\xf2'/\x86\x1c\x92\xe5u\xd1;\x00\x10\xbf\xee\xd5mov\xdd\x0f\x10\xd9iK\x93Q\x9f\x9d\xdaS\xd8\xb7\xf9\x00:\x14?\xb2Y\xb3;H\x03\x84\x0dJ\xa1\x82\xe6b\xc3\xd34\xc4q\x00i\xc47\xad\xe3S}w\x10\x96I\xc5xm\xd2\xa7\x98P\xd4\"\xa5\xde\xa6%uR\x1e\x86Q\x18Vu\x051\xb8+ym)\xa9\xfdb1\x0f6\x8dU##\xc2\xc0\xa2W\x06\x0f	,\xbd\x8ar\xa77\xbc\xee:\x8a\xcc\x9d\x87\x8dY\xc2\x98IS\xaf\x04D#\xca\xee\xb4\x7f\x94\x05P\x98\x9d\x8e\xd9\\\xfc$7\xc8\xab$\xf2\xb5\x1a-\x8b\x94*r84\xc6\x07\xb3i\x01,\x03\xb5o}\xc2/\xc4\xda-\x0fcZ\x0d\xb6oe\x8a;\xa6p\xfc\xf2^\x03\xe5\x1eU3O\xe8\xa3~\xf6\xe3s\xb4\xde\xa7\x94B\xd0\xafr\x10\xbf\xca\xd9\x1e\xd8\x0c\xd4\xd0\xe6$\xc96,\x97\x08\xdfNB\x13\xa4\x89\xd8\xdb\x90i9D\xfe\xc0\x1d!G/ #H:2\x00\xe7bU\xa7Qww\xa2\xd4\xa5a\xc1Y\xb1\x14\x8d\xf9\xab\x17\xb9w\xed\xd8\xe5Hbv\xb2\x1f@T\x87\xbeH[\xfdw\xe6\xec\xae\xb71\xa3\x8f\x9bB\xb6\xdf\x89p\xce=\xc4\x18\xbe(=\xb4(\xc20b\xed\x03q\xc7*\xf8\xa0\x98\xfc\xdcf\xcdy4\xae\xe7\xdf'\xcc\xb0FK41Pd\x18\xc20 \x99\x1c\xfd\x90\x80 \x94\xcd\x94S\x8e\xcf\xc5A9(\xe2\xa0}|\xf4\x0d\x0e\xb8\x12(\xd2/&\xc6w\x1bV\xf4\x90`\x183\xba\xd1\x80\xa0\x89\x1f\x1bw\xd3]\xf7\xc3T\xb5\xd7j#\x0dBv\xc5\xe3;\xc6\xc67\x13V\x00W\xa7x\x8bz\x91\x9a\x17#\x89\x029\x89	\xe6\x06\xd8\xd0\x15\xa1 $?P\x0f\xc8!\x89\x8d\x15\xf5a\xeeHhC\x89\xa7O\xcd\xb2%q\x90-\xde\xf7\xf3qP*\xe4\x86\x8d\xb5\x85\xc5\xf3\xf38y|1\x03(\xcc\xf3\xcdv\x18\xc0\xc1 \x007\x8f~\x127\x99c\xb5\xee\x1f\xf8\xe4`\x99\xb0\xc4\x01$\xdb\xae\x0c\xe0\x16\xfaLsM\xdej\x88\x98\xbd\x97\x88\x05\xb3\x7f\x8f\xaeb\x89w_\xbfT\xde\xcf\xcb-\xdb\xc3\x94>\x08\xf9\x0d\xb4\xa5QB\xc7p\xce6\x7f\xdfbx\x10\x8e$\x9b\x17y\xa3KsHRA\xfb\x13 \xd5q-\xad\xc9\x8d\xe6\x10\xae\x99\x07\xb5\xb5=gy\n\x10\xa0\x9d\x98\xbe\xf6]q\nL\x199\xda\xd0v	\x8d\xf0\x82\xc0\x15\x0fW5\x03W\xd5\\ \x96I\x85\xac\x8f\xb9\xf1\xfd1+\xea\xc5\xb0\x97\x8b\xc3\xf8g\xdd\x06\xcbmj'\x1e\xfaQ\xdd\xecq\xf9\x16WI\x849\xdc5\x1a\x1d\x00\x0f\xd6\x9c\x93\xcb\xe2aL\x88\x89I\x12AH\x1c	\x04q*x\xa3iNOrW}L\xcd\x84I\xc3{\xba\x9a\x13\xc0x\x10hv\x17\x8f\x14_)\xcf,\x9a>>3o\xa2_\xc6}\x16\xe2g\x9d\x8f\xa5\xae\xee\x87\xfaP]\xff\x9c\\\xb7\xe2\xbb\xe2\x94G\xd5U\x80L	\xc0\xcf\xd8\xeap|\xbf=\x0f0g\x1e\xf7\xed\x0c\xa5jXB\xe7\x8d)\xba?}\x10!~)\xb2\x88\x9f\xbc\xa6P\x04gU\xba\x8ck\x16\xaf\xcd\x86\xbb\xad\xdd\x02{&\xb5>_\x90|R\x00\x90L\x10/\xcfN2\xd7\x03\xe76\x83\xef\xb2\xa0\xa8\x8d\\Q,\xde\xd5\xb6}8Z\x15\x81\x13\x075@\x96,(\xe5$r\xd6\xf7\xf5\xa4\x02E\x93\xa4\xb7\xea\xeb$t\xac7h\xcd#%h\x94\xc77$\xb4\xfc\x1e<\xa4\xad@\xdb=tP\x0eO_{\x99\xbe\xa88\xa9\xa0Ax\xd2\x1d\xa4\xdc\x14\xabb%\x90\xd6\xf4\xef\x96\xfb\xecY\xad~\x14q\xaf\xc0\x8d\x8d\"\xe6\x86S\x1e\xf9\x13\xc8	\nXJ\xfd\x94\x89\xa4?W\xd6Y\xb3\x8f\x05`\xc5D =I\x15\x0e\x17\x92\x04\xc7\xfcu\x02\xd2\x14#\x19\x9f\x1c\x81e\xb1}E5\xe0C\xec\xf4\x1b\x88\x8f\xccS/{\x90\x18\xdb\x01\xa9\xe4E(JK5_})\xf3\x8b\xe2\x9f\xd1\xf4\xaf\x88i\xc2\x88\xff\x9e\x04gJxc\xdb\xfd\xaanzs\xb70\x0fA\"D\xd2\xc9\x9c5g\x97_\x18W\x8e\x0c\xa6\xf4q[\x8ca\x98\xc7\xf1\xc3\xcf\xb5q\x8dEj\xc3\x15\x05\xc878\x14\xa0\x9d}\x97\xcd\xd76\x9d\xb2\xbf\xf7Q\xc5rdi\x901%\xf4\x02Uf\x9cc\x19\x05\x90\xbd\xee\xbd9\xe3h;\xfb \xa7(\xf7\xc6\xc3\xc6-3\xc2\xe1\x13=\xc7\x90\x13J\x95\xda\x18\x9b\xa6\xdft\xc7i\xf6\xaaJi\xc7b\x14d\x97\x82R\x83m\xd3\xc5\x84f\xb6\x92\x96\xaa\xbc\x14\xba Db\xe3\xaeLC\xc6\x12\xa8\xfe\xca\xc4\x95\xed=\xc9\xf6=\xad\x91\xfc\x04y\xdd\xfar\xa2\xdd\xd6%\xad\xde\xc7\x01\xd9m\xca\xb1\x11\x96\xd1\xbb\xebb\x9f\x82'\x9f\xce\xdb6\\W<\xff@\x1c`\xfe\x99\xb5\xfcqG\xd3\x15d\x99yj\x8b\xfb\"\xfe$i{\x91\xeb\x8b\xf7\xbdO\xf2\x98]\x8b\xc4J\x9e\xc7<\xb3n\xb2\x1a?\x84j\xe5\xa3\x97\x9fA\x18-WO\xb0\xae+\xcc1\x19\x05\xb9\xfb8` \x10\xc5\x9f\xfd\\?\xd6\xce\x9c\xde0e\x93q\xda-\x01\xd2s\x14=\xd27\xbd\x1b\xd6\x00y\xdf\x02\x98\xf3B\xdci\x08\x93\xe2\x98,{H\x00\x93\xba#&#\x93Z\xa0,;\xbb\xb1z\xff.\x84\xa7Q7O\x17\xd6\x97\xd4\x13\xbb,nHD\xe2\xb3\xa1\xf2g\xd1\xa2\xa1\x00\xb6\x82\x9f\x08+XmyE\x1e\xbe\x06\xfc\x9e\"\xc5\xe3!4$\xda\xf7\x1d\xc1A.\xbcI\x11\x1a)\xb4\xabjn\xa4!\x91\xc8R?\x08m\xf7nB\x0b\x1c\xd9\xf8\x06;\xd8\x8dv\x18\"\xe3\xf99s@L\x1dZ\xf0\x02T\xa4\x8e\xf0A\x99\xea\x9b|\x8f\xc1/\xc6\x89#\xe4@I\xa8\xa3\x91\x0c\x10\xd3'\xee\xce\xfd\xa3\xc9\x9e\x00\x9dGs\x14\x9d\xfb\xabcJ\x7fS\xcf.b\x99Z\x06+\x91\xc5i\xb6x!\x0f\x95jY\xdeN\xa2\xa7oRd\x1f$V\xdd\xb2\x04\x1bG\xce\x84\xaf7\xd5\x14\x08NP\xebC\xbeS\x13\x0b\xee\x0dG\x16\xc5i\\l\xe1\x17\xf2\xd42\\\xa3o\xcds)\xa8\xf1}\x1b\x1bI&\x1fH!\xfa\xe0t\xe7\x9c1\xd2e\xee\xb5\xe5d\n\x11-\x89QMkw/A\xe5\xac\xb1u\\\x91\xca\xdd\x12\x1f\x1a\xc1>\xf8:\xdc\xdb\xcc\xe0W7j~	\x00\xc1J	V\x9c\xbb\xe1\x962\xce\x05L\x12RK\xf8b\x08\xdb>\x80\x1b\xfa\xd85 \xc3_\x1cz\xbb5\xbf\xf6|\xb0Q\x10s\x17\xdd+\xa8\xb3v8\x00\xd8\xebTJ\x8c\xed\xbb\xa9\xe4A\xcf\xafZ\x97\xa2\x17L\xf6\x81\n\x04\xc6\xd9 \xd4y\x06o\xd8k\x8cE)\nV\x0e\x99\xab\x8a\x8e\x85w\xed}sX\xf0\xcb\x13\x11\xcc\xda\xb2\xef9\x1d\xb6\x0e\x90[R\xee\xf8\xc9\x07\x94\xe1\xda\x02 \xaf9\xca\x05\xb8\xb3\xb8\xfaU^[0\xdb\xa9\x8a\xd6\xcc \xd4=~\xcf\xb0T\xf4\xb2V\x11\x01\xcb\x12kL]\xbf\x0b\xe1\x12dM\xe6\x98\xde\xd8O\xbe\x84A\xa8\xfaG\xad\xb7\xa4DP\x17\xf8\x86\xdf\x97\x06y\x1e\xc4o\xa6jJ\xf8$\xb4\xf8K\xca$\xc4\x86~\x9a\x03\x00O\xceTg\xdd\xa9\x00\xe97\xea7	\xecz\xa7\xda\xb3\xf7\xf6]\xc3\xf1JE\xb5z?\x04\x93\x93\x19\x9d\xd1\xd4\xe9}j\x0e\xfe\x82\x1d\xde\x8bcy\x19\xb7bO\x86Gt$\x9e\xa5\xf5\xb3P\xc0\x84\xc0\xdbr+\xa9\xb4\xb8\xfe\x0d\xb4f\x84L\x859<\xb2m\x8c\xba\xb4\xd5\xc0\xbd=\xc6w?Fo[\x17\x0dO\x07	D0\x00Qg=\x84\x14\x10D\xdd\\\x9a\xa2\xf7B\x11\xed\x95\xf1S\x8b\x1e\x88\xc0p\x93\xbe\xc3\xe2lRDTr)\x11\xbew\x93\xc6\xb3\x0b\x8dk\x91\xe7\xc4\x96\xbcP\xdf\x9e\x08\xbe\xd03\x92\xa4\xbbB\xc0\x1a\xc0\xe5.|h\x16\x12\x92R\xf9b\xe6\xb0m\xbaEP\xec@@\xa2\x9c\x1bfUNja\xcf\x9f\xedlS\xc6\xb7l\x16\xbb\xa6\x8f0\xaeA\x9e\xdb,\x9c\x88D4\xac\xed\x91\xb5\x16\x0d\n-AY\xd0\xb6\xe5fv56\x88O\x06\x15\xf8g\xac\xb5/\xc2\x87~<Kw\xdf\xd6`k`\xa1_\xd6\xca8\x1e\xdan\x81~Z\xd4(\x11\x0c\xed\xba\xcfI\xe8p\xcbc<\xd4\x0c\xf2\xb7y\xf5\xab\xb2mp\xa8\x1f\x14T\x9b\xf7\\\xe6\xa3\x1e\xed9c\x84'|\x86\x14\xbb~\xa0d%\x0b\xa2\xc8@\xcd\xac\xba\x97\x1f}\xe7ez\xcb\xfa\x96\x98<\xcc\xd9\x07\x1e\x94\x85\x0dH:\xb8\x93\xa3\xdf\xd6\x93p\xec\x07\x8dAk\xbc\xf1\x0d\xb4\x17$\x15\xae\xd1\xae9\x1c\xd80\xd0\xc6\x8f\x15*\x9c\xe3bL\x0b\x98\xab\x18\x0d\xf3\x92\x929D(9\xda\x84Bu\x8b\xa5BI}\x89:\x8e\xfaBCx\xf6?\x90\xffM\x9a\xc2\x9a\x0b\x80y\xcc\xf4\xa8v\xdb^\x9dQt\xc3X^\xb2\xd8\xba[\xba\xb2\x90\xa4\x99)L\xb4S\xf2\xd4\xb6\xfb\x90\xd8\xdc\xae\xcb\xd4\xa1%/\x1b]]\xf1=\xf2\xfc\x10V\xfa\x01\x9d\xd5\xf5\xe0\xf2\xf3\x11\xeb/U\xc1\xa26p\xc8g\x9a\xe9|\x14\xf5p\x96\x85\x02\xb5\x0e\x0f\x10\xf9\xba\x9d>1Q\xa0\xfbBx[j\x1c\x13`b\xe0\xf5\xb8\x15rH\x04\x91\xb07W\x8d\x9f\x9f:~\x05\xdf\xf8\x8b\xdd\x01\x9cE\xf33\x07\xdf\x05\xf6\xbeq\xad\xf8)U\xef\xd9\xdc(\xfe\xbd\x19\x06I\xf7\x92Kj\xe5T\x96w\xd7j\xdb\xb2Fz\x12\xf8\xf6\x15\xe0\xc3\xa8^h\xed\x0c\xa9\xd3\xd8+^\x02ym\x12\x96-\xd6\xb1\xa7\x06%RT\xf3'\x04\xc9j\xaf&@\xa6d\xc8Y\xc3p\xae?\xdb\xf7\xa1%@\x1d\x0e\x18\"\xe7\xba\xb9{W\xdb\x8a%\xfa.\xd2\xf7\xfeL\xe3j\xee\xf9\xa89\x86\x7f\x80\xe0\x83D\xbdpO\xd6r\xd4\x0cr\x85n0\x06\xe8\xc5!\xb9ZN\x94\x12,\xc8K/!e\x98\xbd-{$\x97&\xf4 !\xd9\x9a\xa2\xa5\xd9\\\x97\xc5\x99\xda\xe6\x10\x97\xdd\x94S\xfcy\xc7U\\s\x96\xe4hA_\x8f\x90Q\x81\"W\xc8\x81\x8fUb\x8aq\xb57.\xde\xc9^?r-`\xf9\xca\xc5\xd7U\xabn6\xac\xee\xd4\xcc\xa2~?5\x07\xce`I:\xabUPa\x98\\=\xabyy\x04\xb3-\xb6\xb72Im\xa0|y\x80\xd4\xc9P\xb0\xc2\x05D\x9b\x88C\xea\xcf:\x81\x0dS]\xdc\xa9m\x11i\x81~rL.0\x12\x13\xfd0\x02	\x98t\x9f\x89o\x18\xaa \x9cc\x9a\x0b6\x98x\x06\xa1\xb6\xa9Q\xa1\xac\x15\x82\xe4\xc6\x82\x0e\xb1\x94\xc6\x9cY\x16\x9cB\xa16\xc3R\xf57\xc7t%\x0d\x82\x156\xf9\xce\xf9\xa6\x8em\xce\x1f\xa66$U\xbc\xac)\xba\xb3&E\xbd\xed\x88\x93\xad\xd5n\x99\x136\xb8\x04\xd7\xb8\x8e\x88\xe7u2\xd4\x8cO\xe6\x96\x02\x7f\xb6\x15<\xc7\x1a\x08\xc9\x15\xf9X\xe0`\xbav\x00\x81\x9f\x15\x92\xddxvP\x14e\xbbq5\xd7\x94M\xa8\xf0Y\xc3\x9e\xd5\xc2\xcc{\x01>B\xb1p\x0d]\xd4\xfb\x00\x0cEb\xf5Z\xed\xeb\xae\x81\xfc4T\xfdh\xed\xd7\xbb\x82\xdc\xcdd\x95O\xf75\xfe,nZ\x00\xe6|\xefb\xce\x94\xd5\x16\x88\x88\xb68\xfb\xa7i\xb5\xb3\x8cP&&	\xda\xeb\xc6uma\xb5\xfbl\x88(\x04\x05R\xf3\xa4ru\xa3\xba6)\xe3N\xb5\x16\xe2.@\x1a\xa0 !O\xeaAy\x17S\xb7!|\xf1h\x1b\xf0\x8a\xf3\x02?.\xac\x9a\xe2%:\x154?\x16)\x9e+\xe8+\x9ed}x \x95:G<C\"pxb\xb9\xf5Fm\xb3[ \x9d\xbc'\x1a\xc9\x8c\xc8'\x0fj\xc7v\xedL\xff\x13q\"t\xacF\x96\xd6\x9f\xbb($)\x08N\xe3\x98J\xa0\xf8\x86\xc1\xe5I|\xa8\xc0\xa2\xe6\xc7v\xca\xcb=\x06vmX\x17&_\x98[<\xcd\x06\xefY\xd6\xb7\xceR\xaa\xe0X\xcd\xdb\xe3\x86\xd7\xa4,C\x1b\xccBo\xe5 \x12h\x1a\x81\x82\xb5\xce\xed^Y\x00q\xcc\x07\xa8F\xd7\x1b \xc8V]\xba&^2\xf2\xe9\xd3\xbd\xe5\xf0\xc3\xa3\x84 ~'u0\xb7vE*%|\x08w\xe4\xb69;\xc1q\x82\xbez\x02O\x08\x9cc@\\\xf5\xa1\x1e\xd3\xa4x\xa7\x8fHe\xdfB\xc1\x0b\xe3\x1a\x83l#\xcc\x0d\xe1;\x88\xb0\x82i{c\x10\x13\xe0\x0d\xd0\x82Q\x1f\xf5$*M<\xfc\xec\x83gBz\xc1\xea\xc3\xdb\x8d\xea~\xe1\xc3\xdd<\xf9\xd5\x87|\\\xd69\x9ao\xef\x8dP\x00\x18\xd0$\x7fq\x10\x0e\x99\xd0d\xee9e\xa7}\n\xe1\xc8\x00\x7f\x9a\x1e\xf7\\\xdbgR-p\xfc\x0f\xe14\xf0\x07\x0b\xa6\xa7\n\xac 8-\xb37\xf8\x14\xc5>\x1e\xec\x1b\x14/\xd5`\x89{\xde\xb1\x99\n\xb1\xb7G~\x8d\xdceg\x94\xc6\xc5l\xef\xd6\xec2_\xfd(\xba\xa9\x86[\x01\x13\xb7G\xdb\xf2\x8c\x9f\x96a\x85\\\xf1\xf3\x8dg\xb7\x1bV\x8ab\xcb\xc2\xaa\xc3 [\xb3}\xa5\xd4\xf7S\x8a\x9a7~\xdf\x1a\xe4\xf0;t\xb7q\xb7lJ\x1e\xa1v\xab\x87J>hO\x0fa\x8dB\xc5\x12\xf9\x08fJqO\xb0\"p\xec\xce\x85\x01	nUD*h\xb6X\"a\x95R\xbb\xef\xbc\x1a5L\x17\xd2d\xa6\xcd\x10\xa3\xe1\x05N\xdf\xef\xb6\xcf\xe7E\xb1\x8d\x06\x97\xf9K\xfa`z\xb8\x0c`\x0b\xab\xb3-H\xc8\xa3\x07s\xbe\xf9\x85\xd5w\x0b\x1fW\x17\xf2aQ\xc1\x04\xc2\x8b\\\xd6q\xb7\xbd\x1f4\xbeWU\x1d\xa8Tn[[\xc9\xd1\x1f\xd4\xb1c\x88\xc0\xac\xb9\xec^\xc1\x83\x12\xd5\xdb\xc3\x0f\x95\xd0\x85\xba\xf9*P\x04\xf6\xf6\x05\x14\xd9|\xa3\xdb\xa9\x86\xae\xda\xef\x06:\x01a\x8b5\x92\x92\xc8\x83\x1e\xaa,\xd3'\x14\xdf\xca\xd8\xfb\x86\xbd\x12:\xdf\\\x9f\x82\xb4\xa5\x8b\xd4\xf3\xdc\xbd>\x98\xbc\xef\xdf\xae\x0d\xf3\xbe\xacp6\xdf\xa6\xfd\xbb\xa7SB\x9ab\x9d\xea\x10\xa0\n\xa7~U\x81i\x9a\xb5\xbf\xc3\x99@\x8a\x87..\x8f7F{\xdf\xf1R!\x9b~\x14\x7f\x93\xc6\xc3\xfd\xe0\x0d.\x18\xbc\xb1=F\x9f2\x9e?\xd6\xf8N7\xae\xac\xed7O=\xaf\xbc\xc0j\"U\x99\xd3\x9cL\x8a#	\x92\n%\x0c\xce\xdc<$\xd8 \xac\xc0\xd43\xb1 \xb5[[\xd7\xef\xee_zv\xad\x13\xe8\xbby5~\xc4\x91B\x95ry\x83uS-\xe9\xfd\xa8\xa4\x968\xd6`-\xd1\xbeO\xa8\xf5\xbd\xce\xde\x0dG\x1c\xcb\xd4b[\xb7\x9b\xbe\xc6\xe2\xfa9\x8f\xf9:a\x06@\xf4)\x08,\xed\x88\xe5\x93\xd7M\xbc\xa3\xaaY\xd3\xb9\xbc\x0f\xa70\xa4\xae\xf5\x94\xa7\x87\x85\xa2\xd0+(\x07\xe3\x0f\xb19@Z0\xc8\xb1\x9bm\x9b\\-\x13\xa1\xac4\xae\xb6\xb4b\xbb\xee\x12H\xce9B8\x9bcp=\x90\x9d\xe6\x07\xec\xf1\xd1G\x85\x8e\xca\x92\xcd\xcf\xa7m\xf9\"\x12\x12\xb8\xa0\x00\xf3\x90\xd7uTu)\xfd\xf1e\xba\xa3\xbd\x8d\xc4\xab\xd4s>\x13\xe8\xde9\xbb\xe4\xab\xb3\xf5\xb8\xe4\xee,\xab\xcd\xb1^~\xad\xf7\xebh\x1b6\x87\x99\x145m\x98\xd1\x05!\x89\x99\x1c\xc7%P\xa6\xb8\x88\xecI\x98\xdf\x1fqT\x95i\x1d\xb6]\xbf\xf2\x92\xd7\x8e\xca\xa9\xb6\xa4\xf4\xeeV\xef\x8b%\xb8\xe3\xf3\xd4-\xa6\x0d9\xf8\x8c\x89\xf0\xa2\xb6\x95\xa2\x93\xab\xfd\xce\xb1\x9b\x8d\xefe\x85\x1e\xa2\xffT\xf3\x83?\xc0\x98\x16\xbd\xf7\x1b\xdb	,z\xdb\xbd5x\x1co\x8d\xa4+\xee\xd6\xae\xc7Me\x8dZ\x16\xa5)\xfa\xb3\x7ft;\xe8\x8f\xa1\x0e~2\xac\x0e\xb3@\x1b\xc9\xfas\xed\xc5-J\xf0W\x87\\s#\xd4`\xd4\xd6>\x1f\x07X\x85\xa5\xd0=\xd0\xf8\xa3\x8c\xab1\xa5\x05\x82\xe2)\xd4G{\xe2\xe3'\x14\xacY\xac^@\x18\x05\x9a\x89\x9f!\xec\xa3\x13\xf3\x86\x08\xc8<\xabZ\x8aZ6\x07\xf2\xb9\x7f\xec\x0f^s,\xcdl\x8b<v\x08\xff\xb9\xb9f\xf4\x0b\x9c\xb1\xe9\xcf'M\x9d-\x9c\xd7\xb5Lq8\xb4\xe3\xa2\x82m<\x85\xba-\x10\xd70.q(<\x02\xfa\xb2L0b\xda\xd0C\x89>\xdc\xeb2\xb6uv\xbd(\xb6,\xed\xc7\xd0\xbc\xd8%\xe6n\x17)\x91\xa6\xa1l\xcd|\xb5\x02\xd9\xcb|?\xdeZ\xa1\x01\xf0\x93\xfb\x84Y[06\x17\xb4\xfe\xcd;\x10'\x15d\xcbi\xd2<\xa4\xd0\x1f\xb9]\x9a\x86\xea\x8b=\xd2\x90\xef\xfb\xd9u\xad;\xf3\xd7U,i\x81\xfa\xc1;\xec\xa8\xb52\xfb\xe6\xc1ZD\xd6\x8d\xde\xe3T\xcbJ\x85\x1a\xc6\xd2>RJ\xcf\x11Z`\xf2!\xdf\xa0\xbc\xcd\x87\xd2&\xc9e%\xbc\xd1\xb0\x85\xe7\xe3\x92\xe9\xc7\xfb\xcc\xb8<27*\x8f\xf0\xa8\xb2Z\x96VW\xfa\x8a\x01\xe97N\xa0\x01\x01\x1dv\xbe\x95c2\xcc\xd8\xbc\x03D\x94Vj [\xe1\xfa\x82;P\xe9\xdcW\xa3cf\x10,\xdb\x1c\x0b\xe1\xb3D=\xa8\xae\x81\x14\x05\xe5\xaa\x9d\x00\xb9/@\x85g\x9bo\xef$*\xc2\xc1\x1d\xda\x92\x84\xb2\xdf\x94\xbc\xc8M^\xfb\x8a\xed/\xfd\x12M\xc1\x9b\x9e\xf7\xbb\x11\x90\xb3\xb7`fS\xd3\xe8\xa8\x0c\x08?7f\xb6t\xd0\xd7\xec\xdc?{\xd7\x87\x11h^\x85\xcf\x1d\xe8:\x92	\x98\x1ca:\x17u\x8c\x04\x03\xbf\xcb\xce\xd6h\xc8\x16\xef}\x98(8\x06\xbe\x19\xac\x8c\xba\xfe\xf8lSu\xa3\xe9\xe0iy\xab\x87\xf4\x05\xe53\xce\xf1\x06}<c\xbc\x14\nD\xed\x8c\xa9\xa6\x0b|S1\xb6\xf5\x11\xb5\xa5@\x0f\xbb\x0307\xb3\xd6\xcc\xb6Y\x10\x92\x96\xe9\xb0\x9a\xa6\x0f\xbf\x87\x07\xa7\xa9\xa1\xa8\x11\xeb\x0b\xdfW\x1a\xd8\x95\x95)\xf1\x93\x10\xec=\xc2D\xecMh\xa9\x8f*Cd\x80TP\xc7G\xcba\xcc\xe6\xc9\x9b\xdf\xe7\xf5@\x0f\xd8]\xe6Z\x0b\x052\x94\x15\x8c\x0e9\x91NE\xf8V\xc9#x:wlE\xa12W_\xcb\xcf3\xaa\xa4\xf6\x1aU\x85\xc8\xe83\xaa\xac\xae\xd2\xcc \xa7i\xf0B\xa2{\xbb\x9d\x10\x1d\xad\x02w\xc6J\xdd\x98\xe6\n\xe0L\xd4\x8d\x8eD\x07\\\x9a^A\x98\xf4*\x8f\xf1R\xcb\xa8\x99\xca\xd9\x13\xda\xcaZ\x0b\x9a\xa6\xbe\xf9S\xf3T4>?b\xa4+\xb8w\x87RD\x13Z\x96\x96\x12\x1d\x04\xca\xd6\x87\x02\x89^\x98\xb6x\xf4\x19\x168\xcf/i8\xe8\x8a\xe1\xb4H\xb0q\xed\x18\xa9\xc8'\xf7\x8e\x83z\xddQ\x8cx19\xa8>\x94\"\xc3\x1a\xd5\xa7\x1d9\x18\x8b\xd8\xc4\xf5f=#\xa8\xe3\x13\xfaf\x80\xe51\xba\xea8\xee\x1fqh \x9fW\x95\xbe\xdb$r\x06\xe6\x8c\x97Y\xcc~\xf1\xf2\xaa\xdb9\x9b:F:7\x03\xe4\xcdij\xce\x8e\x01\xeey\xd7d\xba\\\x90\x98\xfa:FC\xf8\x8e\xab\xb2$t~\xc3d\xb3\xb7\xb5\xc1\xcdG\xe8\xa4\x0c\x88<\xc5Z\xd0\x03\xe1WZ\xbc\xa2_\xa8\xd0\xc0\xfb\x0es)\xc4\xc04nQ\x82\x9f\xa8;\x9a\xb9\xb6\x9dL\xa06{xQ\x06\xdc;\x1f\xc5\xea\xc1\x14\x0f\xdc\x85\xba\x8a\xb9\x14\xc5\xeav[B\x15Jp>Fe\x83N\x07\x8c\xf4\x85\xf6\xd0:Z\x96O\x12f\xe6\xc8\xff\xe8\x07eL\xfcC\xdf\"9%\n\x93~\xa9p\n0A\xe4\xdd\xae#\x04\xb7\x06ih\xb3\xf4epQ\xc0\xc5\x9d!M@\\\x81\xd7\xb1\xc4/\x99\xc0\xb2\xed\xc3\x13\xae\x9al\x0f&1\xdd\x98C\xbc	\xbd9\xb6-\x89\x10\x01,\x9d\xdd\xefB\x80\x83\xb2\xbc\x07\x03G\xf6g\x1bWA?\xcb]\xbdwA\xc5\xe0O\x07\xaax\xa4\xb5!+F0\xf9\x90B\xef\xd6$\x00\xdd\x06\xf7\xe5\x94\x9b\xba\x88\xf8\x94\x95k\xc1\xe5<'\xc3\xe7\xdd\xc65\xc1`\x08\x17\xa3\xef\xd9]Z\xc4!h\xc5\xd8p\xbe\xac~\x10GW\xe4&\xf3\xa5\xd5\xfa=\xee\xe9W\xd74o\xa2=\x0f\xaag\xe6a;P\xc7ciZ\x14\xd4\xc09\xa9%\xd51\x83\xc2G\xd0\x94W\xb3u\xb20\x85\x1c\x19\x1a&\x03\xe1j\xb7\x95\x8c\xb1\xc8\x1c\x8d\xc0\xebl\x99IslL\xd6\x84m1:\xf6\xd2\xbd~ir\xe1\xc7~\xd8!\xb0\xda\x1dZ\x97^\x7f\xca\xac\xf1&\xfcD/X2\xcd\xc0P\n\xa9~\x00Xo\xe1\x0d:\xf2m\xaaN\xcd\xea\xd8\xc6\x14\xc1\x1c\xf5\xfa>\xbd\x1b	\xe9\xf3\xa9\xaf\xea\x1c\x93C&\xddC\xc8Q7\x9f\xed\xcb}[\x04\x03S\xeb\x91\xdaP%\x0f\x83@\xdf\xfa\x04\xde\x7fu\xcf\xe2N\xe4\xfapt\xfe\x9c\xf9W\x05\x0d\xeb\x80\x80\xa9\xac}\x87\xf0\xe9/\x0e\xc5\x0b\xedDGzTY\"\x01-I3\x85\xb2vb\xfa.\xf5^\xacc\xfa\xd2:0\xa47w\x87\xac\x10Q\xbbR\xef6Ao\xc7\x90\x88gd4\xb6n3D&\x08\xc2\xa0\xda\xdf\xeen\xe9[\xbb:Z\x05\x90e3+\xd0d\xf0~\xbf\x97\xe2\xf80\x93\x96]\xac\x1a\x99\xe3\xaa)\xb8$\xa6\xa9\xc8\x87~H\xfb\xb5\xf6\x85+\xc1/EwI[\x02H\x165\x8ekLaeF\x9b\x02y\xc0\xbd\xcf\xf9\x01\x8ctiY\xbd\x81\xb9\xa1\x15\x8b\x9e;\x8e\xae\xdf\xc8\x9dA\xdd\xd0\x89\xdc\xfc\x91\x81>\x15\xb9lD\xbb\xb9\xb1=Ez^\x0c\x99\x05\x13\x8b\xd8\xcc\xe9x\x07\x16\xb9l@\xcf;\x15\xbb\nKP6\x19W\x13K4\x8b\x83	\xebm\xde\xc9\xc5\x0caU\xec\xb2r\x9d\xb6\x07\x94Ps\xcf\xb9E#\x06\x9d}W\n?%{\xf9\xe4\xe4M\x87\xf0\xe9\x82e\x99\xcd\xf7+\x08\xab\xd7>_\xc8\xc5\xe6\x8bR\x87p\x0c\x02\x17\xff0\x11\x9d\x13\xfe\xaa\xac\xca\x02\xb1	\xa7Z\x01^\xf0\x9e\xc6\x15\xe1\xa7\n_\xad9\xeb\x0e\xd6o \xc7\xfd\xc9y\x08\x8f\xc0\xdf(]\xd1\xcb\x97\xc4\xd6g\xd1\xe0\xe39\xdd\xa5\x97\x89\x1f\x96Bn\xbc\xd0\xd4\x15{\x98\xfbyl\x1a\xcb\xac\xa4!V\xe4\xf9+\x15\xb2\x13\x0c^\x11?\x97\x87\x84m\x01|\xbd\x9f\x9c\xaf\xdd|\xe44\x7f\x1co1)\xc1\x95,EO\xeb?\n\x0c\xfd\xbd\x1f\x9e\xa9\xb6\xedg|\xe3{mqU\xaf\xa5\x9f\x10\xf7\xfc\x7f\xdcK\x17\xd9\xb9c\xccUp\xbf\x1d\x0e>\x11\x16\xf3\xef\xfd\xf6\xb3\xc0\xfb\xe5\xb5\xc6\xcer\x16\xf0\x00\xe1\xdf\xf3\xe1\x84\x9a\xf0\xa9,\xf1\xf3\xe9\x9a\xe6\xf7\xfd\xb1\xb7\x17\x97~\xe7.\xb3\xb7\xb5\xc4\x9a\xe2\xbfw\xd7b\x94\xd0Q\\\xe4#*\xc4\xbf\xf1\\\x16\xaa\xfc\x97\x7f\xcfU\xb5\xc37\xab\xff~;\x9f3\x08\xfep\x00\xa1\xda~\xf4O%vn\xcde\x84\xc6\xfc\xbd?\x1f\x1e\x18\xcaX9@ \xc1\xc4\x94\\9\xb9_\xee\xac\xcd\xab$\x82\xcb\x0d\xcc\xc7\x99:\\G\xf5\x97\xb3z\xa0\xcb\xf7\xc4\xbe\x88S\xef\x87*\x16Z\xf1B\xc9?\xdd\xbcW\xdfhv\xfaJ\xbf\xdc\x95\xab\xbc%\x85X\xe5>'Qr\xdbi\xce\xbfu\xcf\xed\xd0\x11\xc8\xe2\x1b\x97\xc8M\x9a{0\x12a\x17\xff\xfd\xb6\x0f_/\x1c\xea\xd6\xc5\xefg	\x1e\x13\x90V\xef\x0f\x8b\xe0\xc1\xd0\x17\x03\x02\xe9\x11\xfe\xf8A<9x\xb7\x05B\x10A\xc4t^(\xda\x7f\xef\xda\xe3\xde\xe7Y\x06\x98\xd0	vZ_\xd4#F.e+\xf8'\x83F\xcaU{-g\xb3\xd2\x90\x93\x8d\xd8\xc5D\xf5O~YBA\x80\xa8\xc8*\x1a\xba\xbe,\x7f\x9cl[uhZ\xe2\xc6\x0c\x08\xbb\xde?\x1c\x84\xe9\x98F\xb1\x94\xee$(\xad/j\x08c\x96\xe2\xff\xe4\xbf\xc5*\xbd\x86\xb3\x1f_\"\xd0\x92\x82\x9e\x96\x7f\xfb:\x87\xad\x93\xbc\xb0\xa3/\xee\xfd\xcb\xdb\xec\x85\xeaG_ .\xc1\xc7M\xc9\x9f2b\xa3\x7fs\x19\xca\xf3C$r\x12K\xc6\x16r\x93\xe6\x18\xd8\xfb\xb6\xff\xec\xa9\xed:w4\x98\xcdL\x83M4\xac\xf2\xff\xf1\x19\x15lfz\xb3\x95Z\xe2[\xff\xe9\x88\x84!\x8f\xf5T__\\\xf5\x9d\xd4&^\xff\xd9\xa5\x1c\x1b7\xe7\x8e\x81\xebi\xbe>8xGh\xff\xd9e\x7f\x8d\n\x0b\x9c\x81\xe2? 7t\xbfvc]9\xa7\xf1L5{\x9b`>\xec\xbe\xac\x7f\xfa\xc9\x8a=\xfaY\xffR\xcb\x8b\xc7\x89F\xb0\xe4\xcb\xc3X\xe6\x9f\xfd\xf8\x8e\x1a\x0f\xf2Dj\x96h\xa8\x85Kg\xcf\x1f\xd6C\x97`]\xbe\xe0b\xe4*f\x84\xd6-\xfc\xe52\x19\x86<\x84\xca(\x95\xbdK\xf0\xca\x0d/\xb3\xd7\xfb\xe39\x95\xcbA\xae\xee\xfej\x13\xa5\xf8\xc0\xa9V\xe4\xbf{]j\x8b5\xef\x9cC\x14zR\xd4\xd11l\xbf\xcf\x97\xa7\xec~\xcf\xd8u\xd6n\xf82F\xe4\xf6\x7f\\\x87\xd0\xe2\x9c_\xfe\x8c?\xa8\x07	V:\xb9\x8d\xf8\xef\xef.|K'\xbc|\xb8Jz\xfe8\xe5<\xd7\x8e\xa8\x8c\x0c\xfb@\xc1$\xb4\x19\xb8_\xec\xc7\xe4}.\xc8\xc5\xb4d1E\xa1\xd41W}\x9b\xfe\xa1\xdef\xfe\xfb\x9f\x0d\xa5p-\xc0\xbc\x06\n\\\xe8n\xf5\xc9\xf2\x91\xbf\xb5\x05\x8b	\xaf\x91	o\x1f\xb6b\xdf\xd7\xfe\xcf\x17 fo\x90\xae\x81\xa4\x10Z\x7fjr\x81\x85\x00C\x94~\xc7\x92\xa6uBw\x81\xd8\xbbK4\xcc*\xd4\x02\xf8\x8f\x0bn\xed\xae2;/\xf1P\xf6&\x0f\xff\xd9\xa5\x06E\xc8\xad^c\x18\x97\x1a\xe4\xa8\xdd\xc0_\xd2'\xf8\xdb\x87\xbc\x03\xf5O^\x06q\xccM\xd2\x95\xc1V\xfe\xc7=\xea0q\x05\x07\xa8G\x08\xe3\xa3\xfeoO\xddr\x7f\xf95\x84Q\x0f\x16\xb8dE@\xce\xa9\xfe\xd6\x9f\xf5\xb6\xfd\xfag\x00\xc7\x1e\"\\\xbe\xd57\xbfX\x1fw\x15\x91Rh\xb2ys=\x89\x92\xe6\x06\xfaO_\xf5\x05\xbbGG\x86a\x08\xdd\xad=\xb0]\x0f\x98\xff\xf9z\xfc\xbd\xc8\x08\xab]\x92\xe8\xf7\xd6\xe7c:\x1b\xad_=f\x9b\x0b\x11&\xa3\x82\xde\xf03\xbe\xf9\xd8\xdf\x05\xff\xe3\x07\xec\xea\xfb\xcb\x9f!l{\x93p\xa5\x18\x14\x0b\x7fX\x9d\x8b\x1d\xddE4\x89\xc8ig\x863\xff\xe9D\xc9r\xc5\xa9\xfc-\x87w\xf6\xa7f\xa0\x98\x83\xb0\x8a\x7f\xd8\x9b\x84\xe4\xdc1q\xfdmT\xb1\xf09\xbf\xe0\x7f\xf6\x90\xed\xac\xbf@\xccL\xe7\x8b37\xf0\xd1\x07\xffx+\x056'j\xc9\xad}\xe8\xfc\xd9\xabhb\xa7\xbf\x98\xa8\x0d\xec\xbe4$\xf4\xd0\x10mr\x93u\xaf\xa2\xd6\xfc\xce7,\\{\x19\xb8Y\x0c\x05E\x7f\xa2\xc8\xed\xfe\x8d\xb1\xb2\xe3#\x85\xeef\x9aD\xdct\xfe\xc8I\x85\xfa\x0f\xa3$\xa7H\xb62\x1fi	\xc8\xbd\xf2d\x03t{[U\x9d_y\xaf\xbe\xbb=a:\xe6\xc7\xf5~r \x80\x89\xfb\x7f\xba1\x0b\x16\xf6\x8a\x9d\xd2L\xc7l]\x9fC\x19\xe0\xdf\xfe8\xf0\xa3\x00\x9d\xa3\x17A\xe4\xf46[\xa6\xf2\x7f\x18\xa7\xc3A\x99/\xa1:\xe7\xc7\x8d~\xe4\xd9\x17\x0fi\\\xf9;G3N	t\x814#\x82\x87U\xef\xe8\xb8\\\xce\xb8\xe1\x8d.\xfe\xd3G(\x1a\xb7/\xeaW7\xe8ep6\x86\x12\xf0\x80\x0d~\x89\xff\xfd\xbfK\x0fQ\xe0p\x1d!\\\xae\xd55\xffxC!et\x17\xf3\xd6\x02\xa6\xb7\x990\x15\xff\x9b\xcb\x10\x06\xc1\xa5\xceF\xb9\x04\xf1\xd3y\xe3\x8c)V\xf2O'\xcd\xfaz%!rb\x88)\xc5[\xb0\x0f,\xfe\xf2\x83\x0f\xd3\xdaL\xda\xc3\x8b\x19\x16\xb4>\xabFN[\x88\xd7\x9f\x0foNr\xf9\xb0\x12\xb9\x9aXiB\xd0~\xd9\x18p\xb4\xbf\xdf\xe8\x80]\xaf\x01H\xb9K\xb8\x1b\xa5\xea\x87?;\x0e\x11\xc3f\xdaPZBh\xf5\x7f\xf4\xe1x\x9cZ\xfc\xc3\xa7%\xc1\\\xd5\xc1\xd6\x9aJ\xf0\x94\xaf\xf6\xa8\xce\x1f\x06,h\xc2s	|\xdd\xcd\xc4\xe1o\x0e\xb1\xd1\xc9\xfft$\x08_\xea\xc0}i\x0b\xb6V\x9fA&\xc8\xba\xff\xc6\xfa\xda\xd5o\x8b\xb7\xfd\xa7\x9e\x8d-v#\xb4?\xdb\x14c%\x0cfm\x0e\xa5\x05\x86\xd6\x98\x93\xed\xbao\xfe\xc9\xe3dPx\"-0S\xae\xe2\xa4\x00#X\xe9\x0f\xe7\xb6ul\xea/\x9b2\x94c\xae8j7\xf3\x8f\\u\xf3\xbf\xb1\x1e\xb9~\xfb\xd1 V\xef\x15\xae\xd0b\xca\xf0\x9f\xfe\x86\xf5\x90\xc3	\xd5\xb2\xd8\x13\x0feH]\xfby\xfe\x8bg^\x8c<\xe47\x1fEu\xe5\x10\xf9\xba(\xb6\xf9\xab=\xb4Ah\x97\x81\xf8zcX\xad?\xf9\xe9`\x0f\xc2\xf8OO\xceT\x8fs\x96A\xde\x08)\x05U\xf7N\x1d\xfd\xce}\x9b\"a7\xae \xba\x06=I\xd5\xd7DY\xf7?\xff\x11\xf6\x01\xf3\xf2a\xe7\xda\xe8\xa4\x7f\xf4\x0b?\xc1\xaf\x9e6\xc7\xdb\x02O\xde\x82\x9f\\\xae]\x0c\xf1_\xad\xa0\x06L_\x0cZYJ\x8b\xaf-.'\x1ba\xa6\xb6\xbf\x1c\xbf\xd1F\xbd\xb3\x17km\xcc\xc5\x0f\xfb]p\xe6_\xbd\xd4\\\x85$\x04\xdark\xa2\xa1\x0ci\xe2hf\xf4\x17S\xfb\xd8-\xd0\x11\xda\x16_\x13\x0d\xb9\x98F\xfb(\xf8?\xde_3\xcc\xc7/\xcc\x9d\xe1\x8f=0\x15\xbf\x07\x01\xf1\xd7/\xe0\xe6\xcc[7\x03w\x0b\xb4\xa4\"\xe4\xc7T\x96\x7f\xf9\x9b>Y\x07\xb3\x9c-\xf4\xab@KR\xed\x15\xeb6\xfbo\xadr\xbf\\\xe4\xbb\x85\xc1>r\x93lE\x7f|\xcbj\xaak\x16w`\xa6\x03\x05Z\xb2J\x0f\xdb/\xf4\xfb/N\xf3\x1f\xa0(\xd7X\xd9\"\xa7\xfd\xaa\x8fd\xff\x17G\x88M\x95\x1b\xcf\xd1\xc60\x1c9D\xae\x1e\x1e\x89\xff\xe4i\xee=\x92!\x12HK\xc1\xc9\x86\xea\xb7\xba\xf6W\xeb%/&\xf8\xcb\xc8\xa5a\xbe8\xf8\x80\x16\xc53\xfc\xd6ob&{?\xe3S\x81\x96X\x98tL\xc1\xf5[C\xec\xc4\xdb`\xbb\xc5\x07\x8a\xc9M|\xfd\xeeP\x82\xff\xdaG\xb55\xfb\xeb\xacu(-$\xb7\xd6\x9cl\xcc\xccr\xfdg\xdf\xf0\xc3i/?\xaa\xf8\xe9u\x82\x17, e\x0f\xcb?\xfe\xd0\x922\"\xaa\x81\xddJw\xd6\x96\xec\xdd&o\xff8GWnJX\xfc\xc9\xdal\xeb\xcc%x<w\xe0\xec\xf6\xaf\xbf\x87*\x0d\x1a\xd8\x9b\xaa\x94|\xb80\x94rg\xedr.s\xff\x8b\xf5\x93\xe2\xc4V\x1f\xa8a\xb0\x9d\xdc\xa4y\x06c\x94\xc2_]6e\xadd\xbf\xdfQ\xc8!(\x95\xb2;\x02Y\x8cE\xba\xf7b\x96\xff|NA\xfd\x9d\xf3\xc73\x94\x96lZ`\x87\xec\xf8\x84`\xabo\x88\xfc\xea \x896R\xd8\xa5\xe8\xc7\xbf\xafd>\xaa@\xfd\xd5\xff\xb4miUT\xcb\x1c.\xd1s\xbe\xa0\x83\xc2\x08\x88\x0b\xbf\xf6\xd9	\xd2\x9b\x8f\xa2~z\x88\xe0\x15+\xfd\x08\xa3\xeb\xff\xab\x7f\nQ\xaf\xf4h[\xcf%x\xe8'l\x9c\xcf\xbf\xf5\x8b\xe2\xa1XGp\x86\xf1\xfeS\xbf\xec\x0cg\xf5\xe8\x9f\xef\x9b\xca\xdeS\x10\xeeFdp\xb5\xfax3\xc1\x7f\xba\xf6OL\x92^}\x14\xf7\xd3\x03~t]\x9b%\xd4\xfd\xf2\x1f\x87z,\xab&\xda\x85\xdb^{\xb21;d!+\x7f1\x90\xcb\x1bqb%\x91\xd8\x18\xf7g]\x92#\x00MR\x87\xbf>\xe4\xee9gs\xea-\xd8A\xdc\x19\x9a\x12p^\xd1\x98O\xf9\xaf~\x84\xa5vV\xdf9v	\x89\xc8\x84\xa7\xfd\xcaPF\xec\x94\x15\xa9\xbfu\xd9@\xab\"\xdc\x1e\xe9\x12\xfe\xd4\x84m\xc2\xc7\xd8\xf9g\xcb\xed\xbef\xe88g\xcaG\x12\x0b\xbd\x801A\xfe\xf3Omp\xe7\xc9\xd9\xb9\x03\xf2.\xe3?\x9f\x19\xfe|F\x0f4\x154.H\xeb}\xf6H\xb3\xc2\x9f\xac\xfc\\N\xb0\x11)\xb5\xfc\xd3i\xa8\x9f\xa0\xb7\xaaUd\x04\xdbfK_\x97\xbf0\xd6d\xea\x7fz\x0d\xa5\x9b~xZ\\\xf2u\xcb\x7f\xe6ta\xf2\xdd\xc6\x7fve\xc6\xd9	\xaa\x17$t\xa6\x9exx\x82\x89\xf2_-\xa4\x0d\xd1\xbc	;k@\xe1f\xe8?s\xea\x92\xac4\xde\x9f\xbd\xf7W\xf8K\x94%\xa6K\xd9\xfc\xe3\x94\x90\x1a\xb3\x88\xf8O\x9e\x06Gn\xb9\x98BvQ\xf7\xd2\x9f\xcb\xc97\xf5\xc5\xd8\x99\xfa\xc7\xbb\xael\xfb\x87\xc6\x8aT}\xf3\x8fwXH\xa14\x9e;\xca3[\xfc\xadW\x0d8\xaa\xcc\xd9\xde\x88\x9e\xa3\xa4\xc4\xb4\xa9[\xef_~\x9a\xbf\x8d\x06K\x8b\xf6\x13\xc7=w\xd4@\xc6n\xa4s\xf9\xe5\xd0\x93\x97\x8c\xf1\x89\xa8\x07\x11\xb1\x8d\x99\xd9+\x96#\x11\xb2\xe0\x8f\xa7\xdeU\x0d\\\x0b4Bu\x06\xb8QJ0X\x139\xf8\xab\x0fGg\xdd\xa8Y\x02\xce\x8dmk\xf63\xac^X\xf1\xd9\xefy#\xe4\xab\xfa-j\x02Y$\xb1\x90\xbd\x193'\xe17\xe7\x8dp\xc1\x80\x0f\xc6\xf5\x91\x8b\x7f\xf8\xcb\xddo\xb1\xc0\xe4\x9c\x8a>\xbf\x18\xf1\xadX\xa8\x02e\x8co\xcb_\xeeMp@\xa4\xfd\xcc\xc3'S\x10a\x18&\x1a\x87Qp\xc2\xdfym1\xe9\x18\xe4J\x88\x85\xf4\x95\x19\xb2\x94\xfe\xd5\x03c\xc9\xdf\xe9$\x12d\xe2	\x85\x83+/'?\x82\xa4t\xef\xbf\xbc52v\xeb\xd8[\x1e\xe7c\xa30\xd0\xac\xc9\x05\x1b\xff\xd5B\xda\x10\xdc\xe3f\xafy,q\xaf\x80\xa1\xc6Q\x88\xc5\x7fk\xd5J\\217z\x04\x05\xe7\x87]1\x01\x8e\xc1P\xef\xa3\x0e\x9d\x17\xa2\x1c\xff<\xf1c\xec\x18'\x16\xea\xeb\x8d\xe2\xc5\xe6\x90\xf3\x17\xef\x92\x98 \xd1\xef\xb1\xcf?gP\xd2\x101\x16\x0f\x82_\x9e`O\xa9\x12\x16\xfcD\"\xcd6:\xe68\x83\x16^,\xff\x88|\x10\xfe\xf1 \xfa\x0f\xe2\x92\xdeF\x98\xce\xbe\x19n\xceq\xc1\xea\xe5\x8dgu\xff|\xbb\xbav\xac\xd6\xde\x8b\xcb\x19\xc7?\xb0\xf8e\x08\xfc\x17W>z\x94\x85\xf1\xb6\xfb\xcagv\xee\xca+t\x01\xe4\xf5\x99^c\x8e\xa7\x88\xf4B\xfc\xadw?\x07\xed\xe6]\xf5\xf2\x0f-\x1bkK\x10po\x98\x15\xbb\x10\xde\xbf\xfd?\xdd\x10D\x84t\xe6\x1f\x1d+}\x8aG\xd9Q\xfcwn\x14\xc3\xca\xeb\x8b\x01\xf6<,\x9d3\x15\xe9 \x8b\xb50\x91_\xb6?\xd9\xfd8\xd87\xd7\xaa\x91\xeb\x88g|\xff\xc8v\x87]I\x00\xb9\xfbO\xb6+\x93\x85\xe1\xaf\xd0\xb7eQ/\xbf\x85/\xa6\xc2\x7f~Yj\xc1\x9c^C\xbfC\xe4\xe3\xce\xdc\xf7\x1e\x96\x83{\xfea\xf37/\x0d\x18\x92\x9bFJW\x9cr\xd8\xbc\x83=1\x9b\x17\xc2?\xce\xf7\xd4xY\xfd\x01e\xf4\xda\x99\xf7\x15\xe4\xd4\x1b\xbe\x8c\xf6\xff\xf1P\xbdb\xac6\xde\x93\xdf\x05\xa7<\xc9\xb9a\xff\xc5\xc3\xcdQ\xafD<v\x0d\xb2\x8a\xd1J\xf5\xa9C\x01\x11m\xca\xfe\xfd\xbb\x83\xa86\xa1,8}\xc0=y\xd9\x98\xe9\xd3\xce\x9c~\xb4\xf5&\xfcwO0\xf7\xc5\xee\x8e\x07\x7f\x1c\xad\x88x\xab\xfd\x18\x167\xff\xcd\xd9\xdd}\xaf\x9e\x93h\x84\x1b\xa5z/\xff\xff\xeb\x0bm\x08\nh\x94`k\xf7\xdeW\x88So\xa0\x19\xf9f\xf3T\xf8\xed\xd3%\x0c$\xe2h\x94\xf9\xa1\xfd\x0d\xfa\x95\x1b\xad\xe6#\xd6\xbc\xfe'\xb7\xdcU\x15\xd4>\xa7\xbe+q\x90\x92\xab\x89v\xa6\xae\xf1\xc4\x1b\xfe\xd8\xa5\x99\x07\x99G-\xc2\xb2j\xe3\xb7\xddY\x149\x18\xfa\x9f\xedm\x97|+\x9f\x83\xdc\x18\xe7l{\xc0\xddg\x9aRs\xe5\xfd\xeb	4\x98'k\x92k\x81Yt\xf2\xca\xc7\xf2\x13gn\xce\xfd\xab\xd1\x1d\x18\x16\xea\xd7\xc8M\xe2#\xe8I\x02\xcd\x1aR\xb0\xe8\x9f\x0f\xe9\x81a\x82\xe6\x87\xda7\xc59[\xa3\xc6\xc4\xe9_|[\x95,\xf1\xfdT\n\x17\x00\x7f\x91\x96\xfd5\x98\x08\x13\xe7\xf63\xfe\xc6!v\xcdY\xf7uW\xee\xb5\x9ak\xa1\xd2\xa7\xd1\x87\x1dl\xd0\x872\xdcp\xfc\xfd6d]2\x86\x87\x1b\xa9C\x8cV\x179\x8f\xea\xee\x86\x82E\xebg\xce\xdb6\xdb\xea;\xc5.'\xc2=\x16=\x95Qp8\xe4\xfc\xde\xeflQn)\xee\xa1o\x10\x038p\xc3\x91n\"3|\xc1\xe9X\xf7\xaf\x07\x12Z\xb0\xf5\xe73\xec\n\xcem\x1b\xa9+\xce\x08E\xe4\x07\xa0x\xf3Q\xad\xee\xb7w\xc1\xe8\xb6\x19\xc6\xc5\xaa7\xe8\x0b\xc1\x11\xdf7\xea\xd5T\x02\x95\x92\xd0'\xea\xf3\xdb\xeb\xe9^2\x86\x86\x17\xe9\xa5L.\xe2\xd4\x9f\xe90\xe9\xbfZ!\x14\xa5\xfa-*Jk\xa6\xb8WS\xe7\xa2\x02\x03\xd3\x80\xfe\xb7\xd6\x1e\x9d6}\xbd5\xa1\xdf\xb7H\xb2gV0)_\xfe\xee\xd3\x82!.\xd8\xfbC\x8b\xa2~[\x81\x99\x04\x17\x14\xf9Oy\xb1)\x96\xfd\xf6\xe5\xdc\x1c/z&J\x15F\xdcb\xb6\x8d\xc6\x9fV\xd7f\x8a\xaf\x8dh\xe1@\xed\xf7\xee\xc4\x8bC\x96\xfc\xa6\xc6\xd8V\xe2\xa1x\x1e\xac^L\x8f\x19\x96\x02\xed?6\xb8\xb5\x03k\xaf\x9a\x9d\x85\xa1'\x1e\x9a\xe7\xc1\xba\xc5\xdc\xd8\xf7\x1d\xfezHQ\xe40\xe0\xc5\xb3\xac\x92\x9ar\xda\xddj\x14\xbf\xfe\xd9\xd1\xb0u\xa5\xc5&\x84\xe5tW5\x19m\xec\xe6\xbb\x80\x16l\xfc| \xf17vj\xa7z`f\xa8\xbe{\xb2l\x96\x9ar\xdc5\xa0\x95\xb2>\x0c\xca$\xa1\x18\xfd\xdd;\xb2\x1c\xa6\x00\xfe\xa8\x86n`\xc5\xcdp\n\xda[\xd2	\xf9\xf0\x91\x0b\xff\xed\xfd\x87N\xf8\x9c,\x8cp\xb9\xc4C\xd2\xbcQ\xbf\x15o\x13}\xea'\x1ey\x9b\x9e\x17\x0cI\xed\xd2\xa6\x1d.\x17	\xa2\xfav\xdf\xc6A\x9b\xe27\x07.\x0b\xca\xcc\xcf\"\x13\xd6\x96t\xaf \xa6\xd103X!\x00\x7f}4\xf4\xfe\x98d\xfc\xda\x9a\xf3\x13\xac\x97\x9e\xe91\xc6\x80\x82\xf5\x17\xa7\xf6\xbdi9\x7fL`Q\xc1\xab\xc0H\xea\x98\x16P\x96\xfdw\x9fJ\x90\x8c\xf6\x1eY\xb3}\xd9\x99\x93\xa6\x0eEX a\xdd'\xfd\xb77\xd6\xc5\x0c\x986\xcck\x87)\x9b\xf9\x9c\xad\x1f\xb7\xd2\xe2\xfd\xf2&\x0fL\xcdh=^-,^\x19\xf0\xe0\x03\xed\xfc\xf8\x80\x91\x05\xbfw\x82\xc4#\xa0\x93\x93\xee\x0c\xf5\xe3d\x83\xe6\\\x8c\xf9X\xf9_\xecV '\xbdX\x13({\xff\x8c\x846g\xa7\xe1'5\xe8u\xf3\xfc\x9e\x9b\xe6m\x96P&\x02\xb5\xcf:\xed\x823\xb6<\x90I\x0d\xf9H\xd2_|V\x02\x89\xd1+\x8c\x92\xbft\xe4\xb4\xbb6\x0c\xa9\x97?B\xc1\x96\xcd\xcf\x9a{7U(V\xa6vi\xcc\x8e\x96\x8b$S}\x07\x80\xf1\xa1\xe07\xa7e~\x01\x8c	\xb5\x86\xa1\x11=\xeb\xaa\x07;&\xd6\xfc\xbfX\x97\x80_<~\x8e\xcd\xdd\xbf\xb2\xa1I?I\xc7\xbe\xb9\xcf\xbf\xd8\xc1\xdaP\xeb\x81\x13\x15\x04w\x80;tS\x80OW\xc8\xcb_?\xa6J\xab\xfc\xdeEC\xc6\xc0\x1cs\xe8\xc8OJ2V\xc1\xfe\xb7\xe6\x8c\x88\xbfM\x1c\xcc\x9a\xc3\xba\x81\x1d6\x8aD\xe4e2\xd1\x8dzs\x93\xf6\xdb\xbf\xd1\x95\x00/~\xce\xce\x87m\xeb\x98\x0e\x97A\x02\xfd\x16\xef\x83\x11\x1f\xeb\xea\xf7?n\xe3\xd6\xb8\xb8\xd3+\xb6H\xd3Z\xe2\xe7\x99P\xbfE\xf0`\xc5G\xba\xb8\xdf\xde]\xd7~j\x17s\x0f\xb8\x07hZ\xff\xfbL\xa4\xdf\"S0\xe3\xa3]\x7f\xf1`\xdaW\xeb\xe2L\xaf\xd8\xa7\x1f9\xc5Z\xbb\xecq\xd7\x80L\xa9_\x85\xf8\xd7\xd3\x14MS\xde\xe2\xc7C\x90\n,uN\xf1\xa0\x98\xbc)\xb2\x7f\xb9\n\x0e\x14\xed\xe6p5r)1\x8c\xa8\xc8id'\xbfE!a\xf3\xc7\x85\xff|c\x8c\x13\xb9\x10=\xef\xe8\x13m\xbb\xf1\xc2\xe8\x16\x86\x9a(e\x7fk\x92\xfd9\xcfm.q5&\xd6 \xa1\x83\xc0\x83BG\xa7\xfav{S\x16\xfc\xf9WGLa\xf1\xbdS\xe3\x94\xc2 \xe4\xcd\xc4\x93\x03\x7f\xd5\x02\xb8`\xf7&\xfc\xa7\x93n\xef\xc3]y/~\xd1\xb6q8Zs\x15\xef\xc5o\xca\x82\xf9O\xc6x\xa2\x10?\xf9\xa1\x1b\x18p\xaf\x94\xde2\xac.\x9c\x1aT\x10\xb7\xf4o\xfc\x0e\xc5\x05\x1d\xd4\x98-C\xd1\xf9FN\x0bK\xe4\x88|8P\xc8\x9b\xad\x13\xb6\xdf\x1e\xd7{\x93\x03\xa8f\x8c&\x9b\xe8K\x91\x05\xab\xf4t\x16\xf9\x05\xc2\x7fk]ka\xc78\xf0=\xd2\x17\x8a>)\xd7\xa6Z`_\xdc\xf90\xa1\xfb{.I\"R\x90\xdb\xdd^2\xff39-\xac7\x83V\xa3\x8c\x85\xc9\xfe?\x1b\xd1\x8a7\x7fBpN|gM9>\xbc\xbcba(Fn\x80\x7fc\xd8q\xe1Q\x8aH}\xb9\xcc|I\xcf\x1dRaQ\x00\xa4C+M\x7fdZ\xb2\xfe\xfa\xe7\n&\xc6\xda\xea;Xd\x1f\xb4\xe2\xa0\x9a\xd1~\x8b*\xc6\xde\xcc\xf9/\x1f\xec\xfbt@\xb3v\x84\xa2\"-\x88\x18\x88\xb6\x97\xdf\x12\xfa(\x00cg\xdao\x0f\x0d\xeb\xffX\xfa\x92\xe6uyh\xe9\xef\xf2nY\xa0\x12\x14\xab\xde{\xab\x12&	\xc8\x14\x19w\x80\x8caFD\xfd\xf4\xb7\xf8\xfd\x9f\xfd\xa9\xe4D\xba\xfbt\xbb9\xdd\xa01\xf7\x8c\xf2\x993\xe8\xd6\xc9\xaa|\xf48v\x97\x8f\xb9\xc2%\xc2\xc2\xf0\xb7+U\xbbDh\xdeXR\xb4\xb1\x82\x1fjT+\x9e{q\xd3\x1b\x0c\xef\x08\x9e\xf5\x7f\xde\x7f\"\x8fLJ)\xff0\x863\xf4\x16W\x9c\xa2\xeb\xf0\n\xe9x\xba,\xdc\xe3?Nw\xf1\xa3\xdf\xf8\xfa\xd1\xb6u94Q\xaex\x88\xf3\xd3\xb7\xbc\x00d\x94\xa7\xff\xb4V\xe64V\xd0#\x1a\x07\xb2\xd7\xdeS#\xdb\x1a\xdf\xf3z\x1d\np.\xe3\xff\xb0\xbd\xa4/\xbc\x8a~K\x95c7\xf8\xce-\xce\xe9\x0c\x89\xa5\xb5\x0b\xa3\xd3\x8e\x8cz\xc7\xcbR9\xddN&\xbe\x05^\xe5+\x19\xd5C\x0e\x9e\x80L\xbft\"X\xed\xb8Gm\xa4\x8e\x1e\xbdE:=\xc0\xc3\x92\xcf\xf3x\xaa\x05A\x0c\x05G\xba\x88>\xa6K<#\xbb~c\xcb7<_L\xc4r\xad\xf2\xc9x\x82\x95\xd5.\xbd\xf4R<\xa7%\xb1\x9e\xa4%\x04\x16\xedy\xe2\x12o\\Fq\xc2\xfc\xf8\xe2J)\x93\xd2\xad\x12\xcby\xd7\x0d\xcfZ\xd1\xf3\x9b\x8d\xed!F\x93{_*k\x1a\x9f\xc3\xc2\xe2\xdb\xb21\xef\xbc\xfe\xb7\x17\xfd.\x90[\xaa\x02\xe9\xe8z\xd6\xe0\x99\xbc\xef>\x98\xb0\x00\n\xb0B\xf4\x0f\xc3\xea\xea\xa8\xc0\n\x02\xa7\x08\x92\xca\x87\xd7\xf6L\x16\xa8\x00\xe3\xf7_~\x90\x1e\xa2tQj\xac\xf8\x8asn\x1ccp&w\xb4\x1d\xa9\xd8L\xa1\xe9\xb6\xd0\xd8}\x8c\x1e\xc5\xbb\xd7`\xbd\x03c\x8e\xf8\xfc\xeb2\xcdO\xabS\xcdfp\xdc\xa4\xcb\x9f\x97\x99Hr\x82X\xd0e\xca\xeb\xdb\xa8s\x81S\xf9bw\x08.\xdb\xddB,\xf8\x91\xfd]\xd8\xcb\"AL\x0fG\x92\x0eg\xd1\x9bs>VHKoW\xc9F:0\xb2Q\xff\xcbv\x16\x07i\x1c\xa36\n\x14O\x0f\xdcQ\xdc\xd4\xb0\x0c29\xf8\xdb\xe73\x9d\xe1\xafl\x8b.r\xbf\x83\xd2\x82\xef\xd4\x9a\xc3\xcb.\xbd\x0c1\x9b\xfb7\xa3\xe8\xb8\x8av\xcda\xcbK=_\x9aLg\xad\xc8\xf4~\x82w\x08\x83L\xfc\x87-\x1f\xe3\xd9\xb9\xe5\xdd\xd9\xcd\x942h\xb5\xb6\xd4\xfc`\xde\xe5L\xfc\x95f\xae\xfe\xed\xb2\xc2z\\^\xb6\xa6v\xdb\xc8%^\xbdj\xbfJ\x9c\x8e\xd8\xf2\x10\xc0!\xfc\x9dwN\xb4\xa4\x8e?\xd2u\xfe\x96\xb1/\xb6q\xadxg\xe2\x8d\x1d\x908x\xcf\xe0vP\xb2}\xd6\x93\xbbI#>\xe6\xf8\xd3=\x9a\x9env\x9fF=I\x9f\x00^\xa9\x15\xa6\xc2\xbf\xbe\xfbq1\x0b\x91\xc1VHt\xb1\xf6\xab\xc8w\xda\xa7\xb9f\xa4\xd9<Fj\xfftKooqi\xbf\x05\xaf\xc4~t4\xdc\xd6\xe3\x99G\x91\xd8[\xc0(\xffv\xf5\x9e\xc1\xed\x0d\xfb\xe3\xa0\xfa^h\xc9\xfaLv%@\xc5\xa6Yb\xf3\xe7\x9d\x9e\xcf\xb2\x03\xb2\x89\xcfk\xccM50\x062\xbd\xb9\x1coK{\xd4\xfa\xcf?\xdcX\xab\xbb\x02\xe5\x88\xbf\xf36\xe88\x88\xc9!\xf6\xf3\x82r\xa0\xb6\x90\xfa\xc7;2\xc2\x03\xb85\xb3s\x9f\xcas\xa6\xf9\xe4\xf5R\x96L\n\xb7\xf4$\x15\x7f\xf7<\x9e(\x00x\xf7@V=(\x8e\xafP\xc3\x7f\xccoE\x04\xe2\xaf\xb4\x9eJ\xf0\x97\xd5\xd5D\xbcl\xdc\xdf\x7f\x9et\xf4\xc1\x88\xdd\xa3wa\x9abj\xb7{\xfb\xa7\x91\x89\xfb\xb1P\xcbO\xaa\xef<-\xcf\x1a\x82n\xe5r\xb1\x88M\xf6\xbfl\xf64\x9a\x19\xda\x8cM)\x8f\xb7Q\xf7w\xfeZ\xa7c\xf0\xdb\x1e\x164\xc1\xdf\x8f+\xf7:\xe7\x88\x82qhc\x05\x06\xad\xde\xdbxR\x877{H\x99dEQ$\xd5\x1a\xe1u\x1aWK\xa7\xfe,\xe1\xd4\xe8\x03\xfe\xdb\xef\xbf\xe78\xa5\xdf\xd4\x1e^\x0en\xbcs\xd3\x92W$}\x9dq9\xeb\xa5\x8f\nK\xd6G\x97\x8e\xce\\\xba\xb9{\x0b\x9c7\x89\xf5\x03\xf7\xe8a\xfd\xfdJI5\xf6\xa2\xe9G\x177*\xa0\x01\x0c[\xbc\xe9d\xd4\xe9\xa9\x1dlV\xb1H\xd6\x0e^k\xcd\x9a3Ujr\xc9\x80\"\x95\x8a%\xce\xffjF\xaa\x14\x12\xc2\x81\xbb)c:~\xbfI\x99\x18\x85\x00s\x89\xdbH\x80f\x19g\x94\x14\xc1\xd6\xe6\xdf\x1f\xcb\x06\x81\xf3\x08\x9ew\xe0\x92\xf9\xc5\xd5y\x06\xef\xe0\xfeo\xc6\xf8\xcf\xf4\x15\xa3\xf7\x8dCwe$\xf1V\xb5\x92\x8eI\xfb+\x89\x85\xe6\x0dK\x8e\xff%X	\x0d\xd9l{\xf6\xbe\xbc\xca+n\xf6\xa7\xbb/e\x15\xb2\x026@\xea\xdd\xb6\xdd\xf3\xc2Y\x00\xd0\xc6\x84r.U\xc7d?JN\xdc\xe2$\n\xc8.\x93;jd\xec\xb5\xc4rN\xa4\x88\x13\xf6$iC\xb3\x9b\x84\xbaw\xd2^\x96O\xde\x99\xfe\xcb'd4-P\x9f\x0e\xb7\xc3\xb1.\x073\xa6\xbfJ\xd1J\xf6\x8bF\xe8\x0b\x10\x0d:\xd8}\xf8\xb1\x94\xe2\x98K\x8d{\xdd({?x\x92\x9e`\xf0\xe9\x02\xbcw\x9d\xea\x04\xeb}\x9f\xd6V\xaa\xc4\xdb\x03\x11\xbf\xfd\xab\xa9%\xb6\x94(\xb1`:H\xcc^C9f\xb8\xb1P;\x1f$yx\x80\xed[\x99r\xffR\x86L\x12\x0b\xb1\xff\xe3\xd4-4M\x0f^HLCD\xc2\xd7\xb7j\xc5$6\xbe\x12\x7f	!\x12D\x7f`\x9em\xed\xe2[\x1a%\x8c$m\xea\xb2\x96\xe6\xad\xb7dyx\x1e\xd4\xcbG\xb1`\xba\xc1\x1c&\xde\xceu\xd7\xe4\xcajS\xdf\xf0M\xb2Y\xd7\xc5\xcf\x98\xa2X\x9c\";1\" >J1\x97w\x9e>u\xf9\xe7\x14\x8c\xe6u\xb1\xe2xm\x13]\x0dw\xf2\xaco\xc3\x94!\xadO\\\x11\x9c4\x91\xdfgJ\xaf^\xdb\xfe\x0c\x96\xa5<\xe2\xde\xf2\xee\xc3\xd3\xeb.\x1fW\x80\x98\xd7\xae\xf1\xb7\xc2\xb4N{}\x15\x1b\x92\x8dW\xcf\x97\xc8Gj%\xfd\xe6cvQ\x8e\x8a\x0d\x99H\xa5N\xfbu\xb1u|\xe9\xd7\xaa=\xb7\xaa\xef\x9c+\xbe\x17u/O\x8c\x0cP\xeb#\x8c\xb2 \xe6\xdb\xf3\xf37\x9f\xd0{3r\xc9 \xd9\xd4\xed\xfd'\xf5W\xf6J\xe6\x18|6\xb5@O\x10\xf1\xc4\xc7z\x87\x8cRf\xfa\xe9\x91\xdd6\xaf}F\x89\xe2\x89#\\\x05?-UA\xac\x0e\x7f~0\x89\xc4\xdb6\x91G\xcb\x8a\xe50\xa5\xf7\xa5R0:\xd5\xdcI;\xbeD\xa6baA\x95\x8cN\xce\xf9Tb\x01\x15UGg\xad\x15\xe9{\xb2\xe1Z\xf9\xc6\xbfH=o\xb7\xf7\xc7\xb3_\xd8\xeb\xcd;\xd4\xf7\\\x9b\xd2Y\x03{\xdd\xad\\+\x1f\x19/\xa5\x16\xfa\x1c4\x0c\xbfk\xd3\xe4\xe8\xddv\x144\xda\xc57\xc7k\x9f1{{\xd0\xb2\xa5\xa1\xb0\xbdK\x0c\xf0\x05\x96\xb5\xbc\x12_\xf2\x8c\xd2\xce\xe0|\x81w\xb2\x86\x95\xa4+\x9e\xb3\x1e\x82|\xb3Yt\xdb\x02K$\x1a\xe1\xb1\x17\xaf+46\xbbx\xf8\xfa\xc9\x0d\x14\xfc\x0e\xbc\xaa\x95\xa7\x85\xcb\x0d\xbcY94G\xbe\xd0\xe5nj;\x0e~3\xa9\xa7\xbc\xeb{~E\xeed\xff\xddB\xca\x7f\xb2\\\xbal\xd7\xddG\xf8\xd0\xe8a|\xb5\xbc\x8aA\xf4>[N]\xf9\xc4x+4x\xa4\xd9G\xf0w\xa9\xd2\x17\xb8\x00\xe9*\xb8\xad8I\xfb\xfb\xe4\xdbK\x99\x84[S\xfa\xb9\xday-\xc9'\xa4HeGR\xba\x88t\x98\x14.\x8b[2\x0c\xac\x00\xaf\xb4\xfb\xdc\x0e\xfd*\xad\xa2\x8b\xb9P{\x0b\xe2\xa5\x8c\xe3\x18M\xf1\x94i\xb13\xcd\xafc\xfd`\xa4\xeb\x86r\xe8\x00\x0b`\xb9{.\xa8\x01\xf6\x0d%\xb4\xd1\xa2X\x0c\xfcN\xf3\x9di\xe8\x85I\x1bY\xa8\x01\xd4W\xb3\x8c\xbd.i\x90O\x7f\x17\xbd\xe8\xf4\xc7\xc1=)\x98\x0f\xb2\xaaU\xa7\x8e\x0b0\x0bt\x1b\x8e@\xedQ\xa8\xec\x98m\xac\x02\x1d\x97Z\x07\x963\x1aS	\x8c\xc1w\x1f\x177\xbd\x08~\xf9a\xef\x87\xd2\xa0=I\x10h-6\x1a\x88o\x9c\x87Y\xa4\xe7YvH\x13\x84\x8a`5\xdfh\xae\xd2?<j\xb0}+\x91\xfc\xdcHE|\xbf\x05\xbe\x93\x0c\xb5\xa0\xda\xe0\x1a<nBO\xe0J\x82\xa9\x1b3\xf4\xd9\xee4p\x12\x1a\x1b\x9aK\xb6\xfd2\xf8\x12\x90]\x85\x1c\xf6=:\xba\xb1\xf3N\xee\xe0\xde\xe2\xef+\x1a\x0c\x9d2{\x9ds\xf1\xd3\x0b\x0c\x85B@}ieJ\xe6\xb5\xc4\xce\x0em\xf0\x19\xcf\xee\xa8}e\xcdk\x8f\x11\xa3x\xea\x04\x9e\xc3k\xdc\xf0\x1b\xd7\xab<\x97sI'\x97_5eI\xf2N%\xa0CN\xd2@cH\xdc\"\xb9\x14@\xbe\xe0\x9aA\x05|\x89p%\xc9\x14\xc89\xaa\x81QR\xfe9\xecw\xc7\x86F\xc8s\xd7WG\xff\xfe\x82\"\x17\x99-\xfe\x88>\xe6\x1f\x91\xd6C/\xeb\xcf\x8fL\x89\xbc\xd6\x8cV\xc53\xf1\xfa\xf2\x17AfK \xa8\xb7R\xdcu\xa6uV\x15\xc0\x02\xbe\xae\xd8\xabb%\xf2z3\x16UOQ'\xce\x82\x0d\xd0ldo\xaa\x82B\x8c\xbd\x19\x86\xf0	\x94\x07\x1d\x89\xb9\xe3r9R\x0dG\xc7t\x15\xfc\x03\x10\x19\xc4nY\xa6dNK\xd2\xc3Qd\xcb\x93\xa0-4~\x94:\xf9\xe0H\x14}\xd3\xfb\x9b\xd9\n\x8b^\xe0vAo\x19{\xfdsq=\x00\xd9\xeaD\x1b\xad\x17\xe9\x9b\x80\xfdL\xef\x88W\xc1\x7f\x94a\xca\\\x1f/\x14b\xc3S#\x01\x8a1\xa4:Y\xebE|\xbc\x9a\xc1w<\x9a\x1f\x02\xe6\x83\x9f\xe3\xa5r\xc1-\x04\x17\xd9\xa7/w\xd4\x04\xd4\xc6r\xb8\x95\xb5\xdb\xde\xef\x95\xe1-\x95\xa8\xeb}\xb3\x8b\x80\xc6/\x1fVz\x97\xdf\xc0\xea\xb1\xd7!?C\xf6W\x8d$e\xb7|\xf1\xe0\x88g\x95\xce]\xc7\x9d\xf6\x97^\xbb\x05\x90?\x0e\x8c\xf15\x7f\xfc\x9c$\xef\x93\x7f\x98\xed\xca\xcd\x18\x12\x92\x7f\xa5\xbcbH\x13\xe3\x86\xe4	\xa9\xf0p|\xd9/\xda\xdf\xeb\x00\x10\xedL\xc3\xfd\xfb\xe4\xd3\xfc\x8c\x1a\xb6\xbc\x05\x0f\x83D\x828\x0eW\xbe$\x0f\x9f\xfbI1ue\x16\x86\xe7|T\xb7\xd8\xfa\xf2\xb8\x0f\xeeU\xab\x90\xe89\xbczX	*[E\xc0\xd4*\xc3{\x1d\x16\xf4>\x87\x17(\xf1\xe8lm\xf8\x16H\x95\x8f\x08(4\xe9\x02\x84\xba\xfb\xf9\x02\xbaHE\x87\x9e\x9a\xd7\xfb/\x18\xf2\x96Y-\xe0\xbb\xd9\x14\xbb\xa1\xe9\xd4\xf5\xe8\x9b\xc3\xab/E\xa1:o\xd9\xbb\xfa\xda3\x8f\xbc\x82\xd8054\xc2\x98w\x1a\x17\\\x94y\xfdts\xd6j4\xf1\xf4b\xcb\xebB\x8f\x03Wf\x82\x01\xf6\x1e\xfa;~.%\xbfl\x94\x8f\xe4\xc1\x90[eAH\x9f\x06\xf7T\x7f2T\x1c\x0e\xa7\x9ci~\xd5h\xe8I\xd9nJ\x8f\xce\xb4\xc7\xbd\x82\x13:\xb4T\xc3\xbe\xaf\xb3\xf3\xaf\xd2\x85\xb6\x93\x9e\x82i\xab\xfd\xa2\x7f\xc9x\xbeF0\xde\xcc\x1e\xbe\n>\xd4E\xaa\xef\xfc\x9d\xa6\xe59\xac\xd7R\x12z\x1d\xc4oT\x82\x98\xd9F|\x1e\x9b\xe3\x06C\xf8\x03\xc8\xa0#A\x9ax\xc5c\xe0T\xad\xe2\x18\x7fT\xd5\x85\x1bWv\x82\x92\xad\xd5x\xe7S\xc4ov\x0f\xcd\xac\x9d\x1e\x07\xc4\xb5Xo\x0e\xc6\x90<\x92$-\xa2\x8e\xd9\xa2\xfa\xb2\xa7%+\xb7\xde\x91Og7\xd5\x81\x183\xcf\xcc'\xd9t\xda\xcfn\x03\xadj\x15w\xb6~\x9f\x1fc\xa4\xc2\xf9\xd86\xe1W\xc16\xac3\xf7\x18\x893\x9b\xc7\x8e\xdc\x1e\xe3Z\xf5\x8eb\xb3\xfb\xfe\x1b7b\xf6\xed\x92\xc8\xde\xcf\xac\xc6R5j\xe5\x82{(\xf2\xba\xb7\x02K\xc0f\xa0\xee\xdf.\xba\xf8\xa9\xad\x1d\x97;?p\xb4;Z\x19#E\xfe\xa1\x9e\xa8\xc7\xc11\x83\xef\xd2Y\x1f\xad\xe5\xa3p\xc4\xd3\xd7\xd3\x06R\xfc\xcekf\x860\x14\x10+\xfa\x1bcV!\xd1\xa72\xcf$e\x93}\xfa9\xd8\xc0\xd6E\xfa\x99\xef\x0eyi\xc3\xa9>2\nWI\xec\xdb;\x03\xd86a-\x8e\x8d\x19I\xedf\x850\xbd\xea^\x05\x92\x1ds\xbf\x1ds\xba\x0bW!\xc8\xb7\xfb[k\xc7\x80Q\x9a\x92\x9cn\xf6\xbd\xfe:~\xe4\x8a@Lk1\x95\xfa\xe1\xe1\xf5:0\xeb\x90(\xfa/p\xca6\x89\x8c\xc4p\x80\x1cR3\x90Z\xc1\xb0\x11\x13\x99\xaf\xe8H\xe7\xb1FF\x9d\xce\x10\xe5\xd0\xff>Gu\xcb\x98\x0f\xc6\x87\x01,\x15\xd6\x0en~\x08\xde\x87\xdb\x89\xb5\x94\xb9\x94\x04%,\x1f\xcb\xfd\x19\x1di\x1d\xb7\xa2Q>V\x98\x80z\xdd\xf8\xcd\xf4\x9b\xb0V\xb0:Z\x8a\x87\xb5\xf3lrud\xe8\"#\x16p\x02r\xb0\x98y\xa4\x1ch\xf2sD\x07\xb6\x99t\xe45\x7f\x83\xc7&\xfcb\xdc\x95\xa51\x10\xf7\x18\xa4o\x05f\x92\xf1\x0d\";\xe4S\x96\xc5\xf0c\xf0z\x92\xb8\x87M\xbd\xd4q\x8a\xe2\xed9\xea\xdf<\xff\x18Xn\x1f\x11Q\xbd\x11\xf1\xf3\x8b\xaf\xd4\xe7\xf4S\xae\xbd\x01>\x02,\x00\x8d\x8e\xc9\x18#\x80.\xa5[\x81\xb9,,\xa2\xc0\xac\xa3/WR(\xc6\xfa\xa0\x08\xca\xac(~%\xda?\xe3\xca\xe4\xc2\xd7\x18\xd51&s$\x9d\xe5#E\x0ft\xbcR\x86\xd4]V\xb5:!\xc9\\\x80G.j\xac\xb5\xb4Lt\xb5o\xec\xbd\x9e\xdc-G\x1dP\xd2\xd7\x9b\x89x\xfd\x9bZ\x7f=\xfd\xf1\xcc\x1b\x82C\xf0\xfb\xc6)*\x1e\xf6\x86\xf3\xcef\x85<\xad\xc6&\x1a>\xf0\xbe\xb5'\xb6\xfdI=\xbcejN\x96n\xbeO_\x17\x8f\x81\xbc\xdf\xa5'\x97\"\x12\x7f\xf5\xfd\"\xfdd\x9e\xd7\xae\x0d[\n@\xea!\xd7\x99\x99>p\xa1\xd9A&\x13\xd9x\x0dY\x8e6\x1c\xfb\xe4\x18ro\xa4\xa0\x8au\x9a\xedxj\xb1\xf9\xf2\xdf\x8b\xf8\xf8\xa63\xda\x98\xe6\xbc\x0d\xb7\xf1\xc2\x9e\xde|\x13\xeasw.\xed\x151\x89\xfb+~O\xca2`\xb3\xb4\x9e\x14>\xd5bBQ7q\x0c\xe2\xca+k\xbd7V\x7f_\n\xf6E\xdd\xe6\xf8*y\x00\x95z\xdb\xaeO1RU\",U\xa4\x1c&r^\x9e\xa2.\xf7	)\xce\x19x'$\x17\x9b\xd8m\x92\x1b\\\xd8{q\xe2+\xc3x\x1ec\xbc\x9e\x0d\xa8\x05\xfc\xefZ<\x94\x0bS\x18\xbcMg\xa7\x11\xcf2M\xbc?LR\x1d4vd\xe5_Fz\x94/ \xf6\x90\xb2(\xfbx\x97\xd4E\x91\x98l\xb0-o\xc6)\xed\x0f\x86#\x07\xf8\xdb\xa5\xcf\xf7\xd2\x13l\x1c,\x14\x8b\xc4\xc7\xe1N\xe6*+t\x10\x08\xd7\xcb\xfa\xb4\xb5\xf85r\xf6m\x1e\xcc\x1b;\x9asB\xa4$}1vHf\xfd\xfb:\xd9\x9f`\xeb\x16\xa2\xe0\xe0\xb2\xa66%\xeex\xa8\xbf2\x9d\\\x92\x18ET\xf2\x07\x03T&\xc7\xd8J\x19)\xcc\xf7\xd0\x97\xce\x82\xae[d_\x9a\xf7\x02\x99\x18\xbd\xdb\x0b<d\xcb\xfd^X\x9f#\x80\xf3rV\xcd\xa6\xc9R\xe1\x84\xd4-\xc2\xe9\x92\xab\xe6\xa5z\xff\xf2\xc7%\x9dc\xc4:R\x00\xe4\xd7\xa6\xa7\xc2\x07p\xfc\xf1\x98|\xed\x93\xf0\n\x9e}\xca\xae\xf7s\xa4\x9c\xc8}p(q\xc2\x0b\xbb\xa9\xf7uE\x9f>d\xac\xae\x84\x10B\x84]\x8f\x97g\x8a\xcb\xb2\xfc\x9f\xff\xf9\x7f\xec\xff\xfe\x7fvy\x97\xff\xfb\x7f\x01\x00\x00\xff\xffPK\x07\x08\xff9\xbd(rt\x00\x00\x12\xa0\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00r\x9b\xb2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00img/supervised_user_circle-24px.svgUT\x05\x00\x01\xa9\xe1\xc2^D\x92\xdd\x8e\x9c0\x0c\x85_\xc5\xca\xbd\x0f\x8e\xf3G\xaae/z\xd5\x9b>D\xc5N\x87\x91\xe6gU\x10\xacx\xfa*	3+\xa1\xe4\xe3\x9c\xc4\x18\xdbo\xf3z\xa6\xaf\xdb\xf5>\x0ffZ\x96\xcf\x1f]\xb7m\x1b6\x87\xc7\xbfs\xa7\"\xd2\xcd\xeb\xd9\xd0v\xf9X\xa6\xc1\xa874\x9d.\xe7ii\xbc^N\xdb\xcf\xc7\xd7`\x84\x84\xd4\x93z\xf3\xfe\xf6\xf9g\x99\xe8c0\xbf\xadE\xce\xa4#\x07\x04%a+\xe4\xe1\xfb\xb2[\x99\x0bR\xc5\xf6\xf0!\xf0\x81\xdc\xd0\xca~s\x88\x96\"\x9c\x1f-$\x91\x90Ev\xe8c\xdd\xdbR4I\xfc\x14\xf9\xe5p\xbbR\x85b\xf3\xcbf\x88\xad\xeeS\xfd\xbe\xb9\xdf\xb8H\xa1\x1f-Jl\x85+q%6\xaaK\xf9b\x8d\xde\xde\xf9\xe5\xcc\x15\xab\xc3/g,\x198[\x82\x84\xef\xa3\x95\xf6\x9bP\x86u\xabC\n#+<#\x05\xf6p\xac\x88\x1c`={\xe4\xd8\xeeZX%\x87\x98\xd8\"f\xaan!\x84V\x04\x85\xf4\xe5G\xa0Zt\x8f\xbe\x9c\xcc\xa4\x10= \xf6\xfb\xb3722\xb4\x94\x07\xa1\xd5\x03)3\xc4\xaf\xec!\xa9&\xed\x95\x14\xd9\xb3\xc2\xba\xd2\xbf\x06G#\x14Y\xe129\xf4\x9e,l\xcd/\x159\x95\x08\x91\x02$s\x82\x0f\x95v\xd3\x1d\xf3\xf1\xf7r\xbd\x0e\xe6\xfe\xb8\x9fL\x9d\x15!\x99\xd4\xaf\xea\x7fI=T\xc6\xee\xfd\x7f\x00\x00\x00\xff\xffPK\x07\x08uq\x02\xd2d\x01\x00\x00\x9e\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00r\x9b\xb2P\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00style/main.cssUT\x05\x00\x01\xa9\xe1\xc2^\xbcX[o\xdc\xbc\x11}\xf7\xaf`\xd7\xf8\x00;\x10\x15]V\xbb\x8e\xf2\xd6\x14A\x0b\xd4yH\xd0\x87>R\xd2h\xc5\x9a\"\x05\x92\xf2\xaeS\xe4\xbf\x17\xbc\xe8\xba\xbb\xb6\x9b\x87/\x88\x01\x8b\xd7\x993g\xce\x0c\xfd\x01\xfd\xf7\x06\xa1\x96\xc8\x03\xe59\x8a>\xdf \xd4\x91\xaa\xa2\xfc\xe0\xbf\xf0\x11\x8a'\xaaq-\xb8\xc6\xaa\x15B7v\x92pM	\xa3DAe\x97\xb5\xe2'\x16\xeat\xb6\xee \xc9\x8b*	\x83\xf9a\x1aN\x1a+\xfa\x130\xa9\xfe\xd3+\x9d#.\xb8]Q\x88\x93\x99\xb0[\x0b!+\x90\xb8\x10'3c\x0f\xaeIK\xd9K\x8e0\xe9:\x06X\xbd(\x0dm\x80\xfe\xca(\x7fz$\xe5\x0f\xfb\xfdUp\x1d\xa0\xcd\x0f8\x08@\xff\xfa\xc7&@\xdfE!\xb4\x08n\x10Bh\xf3w`\xcf\xa0iI\xd07\xe8a\x13 E\xb8\xc2\n$\xad\xc7{\x8cm9\x8a%\xb4f\x88Q\x0e\xb8\x01zht\x8e\xe2pkF\x7f\xdd\xdc\x84\x9d\xa4-\x91/\x16\xc2R0!st\xbb\x83m\n\x0ff>df\x83\x9d\xfc\xf8\x01\x91l\x1f\xd75\xfa\xf0qZ+\x0f\xc5]\xbc\xcb\x02\x14\xc7i\x80\x92,\xbb\xb7\xdb*\"\x9f\x86]\xb7\xdb$\xf9\xdbnw\xb6m\xb7\x0b\xd0\xd6\xec\x8c\x92{g\x8b\x85\xb4\x15\\\xa8\x8e\x94`\xf7\xcf<\x89\xc2\x87\xcc;\xb3\xc0\xf1\xc7\xd7G\xc1\x05\xfe\x0e\x87\x9e\x11\x19\xa0G\xe0L\x04\xe8QpR\x8a\x00}\x11\\	FT\x806\xff\xa4\x05H\xa2\xa9\xe0fVl<\x98_D/)H\xf4\x0d\x8e\x9b\x00M\xf7\xff\x85\xb6\x9d\x90\x9apm\xcd+D\xe5`\xaa\xa8\xea\x18y\xc9Q\xcd\xc0\x85\x95\xc1	WTBi\xce\xce\x91\x14G3L\x18=pL5\xb4*G%p\x0d\xd2\xd2\x83\x94O\x07)z^Y\x1c\xc8\x12\xbf\x00EadP\\`\x95\xa6\x01J\xf7\x01\xda\xc6v\xc6\xf0\x8d\xd6/\xb8\x14\\\x03\xd79\xb2\x06\xe3\x02\xf4\x11\x80[ko[B\xf9\xfb\xcc-\x05\xeb[~\xf1\xdc\xc9\xea#\xadt\x93\xa38\x8a\xfe0\x9f-\xe5\x13\x9b\xa2\xe8\xb9q\x97R^\x0bCv{\xf1+\xa7]\x81\xe6\xccT\x9f\xc7\xb8\x10Z\x8b6GI\x98H\xcf\\\xe5\xcc\xff\x7f]\xec\x84\xa2>J\xc0\x88\xa6\xcf6e-\xf3\xacQ9bP\xeb3\x17\x13\x7fk\x13\xafi\x99\x84\x0bV\x1e\xfd\x8em\x14\xad\x0f\x9e\xfcd\xa05Hl\xa2\xe6T*L\xbb\xd3\xb8\\K\xc2U-d\x9b\xa3\xbe\xeb@\x96D\xc1\x8a\x0fq\x1c\x05h\xb7\x0fP\x92\xfa\xe4i\xe2PS\xcd\\\xd6\\\xbeu\x12\xc5polFq\x98d\x03\x9cMr\xb6s\x00b\x90\x85\xd4\xfe{\xd3\xcc\xab\xce\xcdUi\xb8y\x85\xda.\x8b\x9c\x14\x94DV\xd6 /\xa0\x92T\xb4W\xe6\xb0a\xdfjb\xeb\xeep\xa39\x8a\xbb\x13R\x82\xd1\xca\xa5X\x14 \xff?\x8c\x13\x97]\x96\"\x07)\x8e9\x8a\xc7o\xd5H\xca\x9f\xfc\xc8XQ\x10N#w|KN\xd8g\xc2vJ\x84a\xe4\xc1\xaf\x1a\x81\xf6n\xce\xdd\xb5\xc5\xa1!\x95\xb97r\xe6\x98\x15\x91_\xb9\xb66\xda;E\xbd\xa9)\xb0J\x81\x9e\xd5\xba)+\xfc\xbdse\xb9\xad\xcb\xba\xac\xeb\xac\xfa\xedK\xdf\x82x(ws\x89^\x9a\xcaH\x01\xcc\x1a|9\xeb\xdeL[/\xa4C\x16n\x935\xbeQwr%\xfe\x8a\xa0\xbc\"A\xe7\xd9zfz\xce\x85\xbe\xcb\x19Q\x1a\x97\x0de\xd5\xfd\x9c\x91\x03\xf8\xaf0\xed\xfe\x12\x1c\xaa#N\xb4f\xcc\x89\x93l\xe5Y\x84\xe2l&	>#\xa5\xb1\xd6\x1eJ\xdbCHK/\x7f\xfe\x14\xd2k1\x87+\xdd\xcd\x036\x8613\xc459\xd6\x82R\xe4\xe0\x14c\xbc8	3\xcf\x8d\xfd\xa8\x0d\xa1u\xc1	\x1f\x83\x93O\x8f\x0b\xb6^R\xc0\xab\x15\xff\x15\xbe\x8a^\x9b~e\xe2X\xd9KeN1`\x98\xefcC5X\x89\xb1\x8b\x8e\x92tv\xdf3\xc8\x9a\x19\x967\xb4\xaa\x80\x8f\xf8M\x13\xc0\x18\xed\x14U\xcb\xd0\x84\n\x18\x94:\xcfI\xadA\xfaf\xc8Sf\xb3\xf9\xbc`0)\x94`\xbd\xb6vy\xe4?9\xf7\x07\xe0=\x1a\xd2}\x0d\xd9\xa9E\xe7\xc1\x1f\x13\xd8\x0e\xe1\x81\xd5\x82\x1anbx\x06\xae\xd5\xe0\xbb\x895\xefz=\xa7\x9e\xd2/l\x06\xce\n-[\x1b\x8d7\xeb\x80\xbdk\xf7\xd4\xe0\x92\xae\x03\"	/\xe7s\xa6E\xbe4qi\xec<\x8aC!\x89\xd3\"\xad\xe7q\xf5\xbe\xafIaKLG$\xf8\x16,4\xde\xbc\xaf\xe2{\xe9\xb0\xc3\x86\x1e9\x1aH\xf2\x8e\xe6),z\xad}r\x0dF;~\x9e\xd1v\xe8\x95\xd7\"\xbb\xedNh\xd7\x9d\x9c(dQ\x80\xcc\xcf\xa7\xd4\xeaB|\x1f\x98\xa4\xe9N(\x1dV\xcc\xd5\xf7\xe1\x1d\xe2\x1b\x9d\xa5\xdb\xde\xa5\xdb\xa8\x95\x9euWSi\x06\xf9BO\xc7\x9c\xa9\xa0\x14\xae[\x9e1\xcb#\x136\x84\xd5#\xbf|\x19\x8d>\xaf\xcb\xe84R\x10E\x8d2\x13V\xdee\xd1\x1f\x08\xdb\xbb\xee\x17g\xd6=c\xeb3\xe3\xf9\x8a\xbc1y\xec\xfa\x94\xa9\xf9\xb0\xbf2\xa2\xe1\xdfw8\xb6g\xaec\xb1\xefN(6\x01\x89.\x86\xe3\xde\xbd\x03\"\x1b\x8d\xdd\xb4l\x1d\x93_7\xa1\xa8kl	\xe1\xd2qN\x84l\x9ffE\xe6i*\x84\xc9\xe4Q\xa0G\xb6Rn\xdfa\x9a\x14\xee1\xb9\xd0\x818Y\xcaH\xe2c\xbe\x92\xf7g\x90\xe6\xe5\xc7\x86\xa2\xa0E7\x7f>\xf5\x1a\xaa\xe5k\xae\xdcg\xfbj\xf1\x9aY\xbd\xabfJo\xfa.\xec\xcc\xbf\x9cgoe\xcf\xaa\x14\x8f\xcd\xf4\xcc\xd1\xb1\x97\xb2C^%\xf1\xacGZ\xb57\x17\xa6\xcc\xb1\xcb\x89\xeb\xcd\xed<Nx\xaaF\xcb\xf8\xa6\xf3\x9c\xb3V\xbe\xddA.\x1a\x01\xe7\xc7\xf5>\xd5\xaf2\x86\x9f-\x1a\x81o\x80T\xd7\x80\x7fo\x87s\x16\x8f\xdf\x81\xde\x13\xf2O\xc6\xfd\x1d-\xd5\x12z-\xba\xb7p7K\xae\x82n3F\x8e\x7f\xe2\xb8\xd8j\xfd/\x00\x00\xff\xffPK\x07\x08L\xbb\xd3^\xd3\x05\x00\x00^\x12\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x004\x07\xf5PH\xa4d\x94\x96\x04\x00\x00\xce\x1b\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00html/dashboard.go.htmlUT\x05\x00\x01\x85=\x16_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x88\xbd\xbbP\xd6L6ob\x02\x00\x00\xb2\x06\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe3\x04\x00\x00html/error.go.htmlUT\x05\x00\x01P\xfb\xce^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x88\xbd\xbbP`}f\xf1\xde\x00\x00\x00c\x01\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8e\x07\x00\x00html/header.go.htmlUT\x05\x00\x01P\xfb\xce^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00r\x9b\xb2P\x83\xba\x83\xe4\xf5\x00\x00\x00|\x01\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xb6\x08\x00\x00img/account_circle-24px.svgUT\x05\x00\x01\xa9\xe1\xc2^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00r\x9b\xb2P\xfc\xc6x\x8f\xb7\x00\x00\x00\xf9\x00\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfd	\x00\x00img/error-24px.svgUT\x05\x00\x01\xa9\xe1\xc2^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00r\x9b\xb2PK\xfe\x8b#M\x03\x00\x00d\x08\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfd\n\x00\x00img/pomerium.svgUT\x05\x00\x01\xa9\xe1\xc2^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb1r\xd0P\xff9\xbd(rt\x00\x00\x12\xa0\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x91\x0e\x00\x00img/pomerium_circle_96.svgUT\x05\x00\x01n\xd5\xe8^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00r\x9b\xb2Puq\x02\xd2d\x01\x00\x00\x9e\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81T\x83\x00\x00img/supervised_user_circle-24px.svgUT\x05\x00\x01\xa9\xe1\xc2^PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00r\x9b\xb2PL\xbb\xd3^\xd3\x05\x00\x00^\x12\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x12\x85\x00\x00style/main.cssUT\x05\x00\x01\xa9\xe1\xc2^PK\x05\x06\x00\x00\x00\x00	\x00	\x00\xb2\x02\x00\x00*\x8b\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x98\x8dP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00html/broker.go.htmlUT\x05\x00\x01\x90b\xd2jl\x92\xc1\x8e\xe20\x0c\x86\xef}\n\x93\x03*\x12\xdb^WlZ	\xedr\xdc\x81\x03\x979\xa1\xd0\xba%\xa2M\xaa$\x0c\x83B\xde}\x946\x852\x1a.8\xbf?\xff\xb6\xecZ[b\xc5\x05\x029*yF\x95\x9cL\xdb\x10\xe7\":\xfb\xb7\xfd\xbb\x7f\xdfm\xc0+yD\xfd\x1f4L\xd4\x19AA\xa081\xa5\xd1d\xe4b\xaa_\xbfI\x1e\x01\xd0\x13\xb2\xd2\x07\x00\xd4p\xd3`\xbe\x93-*~ii:\xbc=\x94\x8e\x14=\xca\xf2\x16p](\xde\x19\x10R\x14\x98\x11k\x937\x1f9\xd7\xfb\xfa_\\]Da\xb8\x14\x10/\xc0\x06\x11\xe0\x83)\x90\x8a\xd7\\@\x06\xd6&\xdb>v\xee\xcf\x0b\xd1\xa2\xd6\xacF\x8f<t\x00s\xebp\x05\xa4\x0b3&\xac(P\xeb\x83\x91g\x14d9\x01\xa7\x89\x95\xef\xb2\xee\x85\xbd\x07\x9d\x9b\x92\xf8\xd9q\x85\xfa\xc0L\xcfm\x86\xe7\xdaL\xa9o\xb3\x19\xa6j4\x90\xc1\x95\x8bR^\x13\xd9\xa1@\x05\xf7\xfb(tL\xa10\xcf\"^A\x1c\x8a\xe6\xf3\xb1|\x96\x8d\x06\xd3\xed@H'\x9d\xd4\xe6\xff\xb0\x848,c\x19\xf6\xb6x:\xbbG\xe4{\x84\xf6\xc3<\xaf\xae!U4Rc\xfc\x83\x81[\x8c*M\x87\xcb\xfa3\xd2t88M\xfd\xa7\x94G\xd6\xa2(\x9d\x8b\xbe\x06\x00PK\x07\x08P\xa4[\xdcK\x01\x00\x00\x83\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe3\xb8P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00html/dashboard.go.htmlUT\x05\x00\x01\x1b\xae\xd2j\xec[Os\xdb\xba\x11\xbf\xfbSl9:\xb431\xe9:\xef\xd0\xc9P\x9a:\xb6\xe3\xbaI_2Nrx'\x0fD\xac$\x8cI\x80\x0f\x00ek4\xfa\xee\x1d\x80\xa4\xc4\x7f\x92(\x99\xb6\x94\xba\xbc\xd8\xf8\x0f\xec\x02\xbf\xdf\xee\x02\x9a\xcf)\x8e\x18Gp(Q\x93\xa1 \x92\xba\x13\x1d\x85\xcebq\xe2\xff\xe5\xea\xeb\xe5\x8f?\xbe]\x83\xc9\x19\x9c\xf8\xe6\x0f\x84\x84\x8f\xfb\x0er\x07\x82	\x91\nu\xdfI\xf4\xe8\xf4\x1f\xce\xe0\x04\xc0\x9f \xa1\xe6\x1f\x00_3\x1d\xe2\xe0\x9b\x88P\xb2$\xf2\xbd4m\xcb\xe6s\x8dQ\x1c\x12\x8d\xe0\x98\x16(\x97\x83\x02\xf8\x9e\xc9\x1a\x9c\x98\x7f\x87\x82\xce\xb2\xee(\x9b\x02\xa3}'\"\x8c\xdb\xb1J\xb9\x8c\x8f\xc4\xe9P<-K\xb2\xb2 $J\xf5\x9d\x80HZ(\xaa\x17\x9e\x9a1Q\x96\xea\x98\xe5\x9c\x0f.\x13)\x91kH\x14J\xdf\x9b\x9c\x97k\xcc\xe7l\x04\xeeO\x85\xd2\xbd\x0c	\x8b\x94\x1b\xb3@'\x12\xc1\xaee\xf5\xf9,\x1a\xe7\xb3a\x81\xe0\x0e(\x19\xf4\x9d\xf9<m|\x83\xda\xb6\x07'k\xef,\x16\x0e\x90\xd0HW\xa1\x04\x16\x911:\xe0UG\xc7Pa\xc3H\xa5\x0c(\x8d[)\xcafA\x89&?\xef\xbe\x80\xe3\xb9q\xa61\x8f(\x85Zy,\x1a{$\x08D\xc2\xf5}\xc0d\x10\xe2\xe9\xf9o\xf1\x93\xab\xa6c3\xc9J\x7fOQ\xc8U\xdf\x99h\x1d\x7f\xf0\xbc\xc7\xc7G\xf7\xf1\xbd+\xe4\xd8;?;;\xf3L\x9bR\x83\xfa\x828-\xad\xc7\xf7(\x9b\x0eN\x8a9#!#\x88PO\x04\xed;7\xd7?\x1c \x81f\x82\x9bu\xb8\xdf\xd9\x98\x7fM\xf4\xcf\xbb/\x8bEU\x9b\nm\xbdr.\x80\x1f\xe7\x02\x8aP)#\xe7\xc1\x1f\"\x91\x10d\x9aW\xa8\x14\x13\x1c(j\xc2B\xe5\xfa^\\\xebb\xc40\xa4\nu\xb5\x00\xc0\x0f\xc9\x10\xc3z>\x80\xafb\xc2\x07?\xef\xbe\xf8\x9e\xfd\xaf\xa9\n\x81\x89\xc4\x91]\xd9\x1dR&1\xc8\x97V\xcd\xf1=R\xef\xc1\xf7\xb2\xd1k%\x85\x8d\xfb;\x89\xaa{\xa8\xcd\xc4M\xb3\x0d3g<NtCc\x00=\x8b\xb1\xefh|\xd2\xd5\xcd\x93~\x992\xacH\x9bkLI\x98\xe0\xea\xf0\x98\x99\xd4wb\xfaY\xd4iW\x952E\x86!\xd2\x86Bo\x83h\xeb\x92M\x0fe\x036\x8c\xd9\x14\xf9=\xdfO\xde7\xa61\x1c\x8f\xd4W\x90\xb5Z\x96\xd3R\x0d;\xb5\xedN/\x9c6\xaaeD\"\x16\xce\xf6\xd5\xcb'\xdb\xfa(\x15SX\xd8\xee\x9ai\xd3\xb8s\xd5\xd4Tt[\xa6\x83vG\xc5\xb4\xbc\xbd:\x0em\xdc\xd2u\xc2\xab@\xd3-}5)/\xa5{\x1d\x11\x16\xee!`\xdb\xee\x19\xf2E\xd3\xbe#\x1c\xca\xd6\xd0J\xc6\x1b\xebv\xbe\x99%\xe1c\x84\x1e{\xd7\xbb\xff\xd0\x07\xf7\xca\x92\xb5\x90\xb3\x1b)\x92X\xed$v{\"\xf0O\xe818[,\xd6\x1a\x13\xb6\xe7\xf5zi\xb4\x173\x95\xd9\x83\xb3\xb1i\xc52k\xad\xecn\x0eS;\x92\x7f\xadC\xd4\x90\xff\xc8\xf4\x04\xdc\xef\xa9\xb1\xe8\xde\xd2\x1f\xe2\x01\xf9\x86\x9a\xd7O1\x93\xa8.\xf4b\xb1\xb3\xd1\x98\xb5\x85\x0b\xbd^c\xaf\xa4\x97\x0b\xf5\x83\xb5\xd1\xcc\xe6z\xaf\xa4\x9d[\xa5\x12\xa4{\x89<m\xfa\x7f\x89\xb7\x96xzN\xd6\xaa\xe2\xbb&\xba	\x8a\x96\xaa\x8ab\x94Jp\xa21C\xed\x9dO\xc9\xaa\x0b\xc6\xc7\xf0\\\xce\xeaFy\x07\x83\xa7\n\x19\x15\xc4\xfbrtTV\xc0\xff09m\x87\xbf\x83\xe9}\xdd\xf1\xac\x9e2&\xf8\x8d$|/h\\\xa9Y\xf0\x03\x9d0\xbb#\xdd[u\x11\xc7RL\xb1i\xcd\x85\x83\x98\xf3\xe7|\xbe\xa2\xe1v\xb8\x9a\xf7\x0f\xc3\x99i\x9d'?\xce\xd6)x\x83\xcd\xb5\x9c\xce7\xe4\xd4@\x14\xb1\xbd\x915\x96\xf1:]\xbe\xc06\xaa\x8c\xef{\xcd\xf1-\xdfk\x0c\xa9\x15\x83\xab\xa3\x10\x8bA\xd9l\xfca\xa2\xb5\xe0\xb9k\x9b\xa5FI\x18:\x99m\xa2\x92a\xc4\xb430\xe1<\xf8\x9ah\xdfK+U\xc7\xb7\xc1\xc1U\x96\xef\x99\xe0\xe0\xe0\xa4\xb1B\x96\xc8R\xe9\x86\xb9P*\x89\x90\xde\x89\x10W\x08\xf8\nQ\xe5l\\\x90f\xe0zX\xf9%b\xb8*\x89QN\x99BzoB\xca/\x1c\xcb-\x0b\xbbHA\xcd2\xafGv\xbf}\xfd^\x08\xed\x16\xd6de\xe6Q)\xe2\xaa`-\xc0d;h\xc2(5\xb7\x14K\x90\xeeU\"\xa8`\xe2=}'\xef\xf6^f\xa5\xf7\x89d;u\x9c\xbb&Y\x87v$\x11b\xfb\xf8\xf3\xfe\xc1c3\xce\x81\x00\xb7\xb6\xfc\xe6qR\xfb\x7f\x0f\xbc\xed\xc8#\xd8*A$j;ie{\xca\x9a\x80\xf9\xe9\xb7Z+\xec/7\xed\xca\xec\xac|\xee;\xcc\xb4k\x88\x9d\xcf\xa1\xe7\x06J\x8e>\x99n\xab\xb7Q\xbb@\xf0\x95\x14\xf1\x9e\xf0[w\x973XhH\x94k\xa6\xe8| X\xb6\xa8\xfc6@y'\xc8%V:\xbba\xe3\xfe\x98\xdb\xfe\xb6\xaeR\x03\xe0:\xc4)\xd19\xbd\x02\x91\x08\x82\x873\x18\x1b\x03\x17)\x8c\x84\x04\x02!\x8b\x98Ii\x16\xa1[\x1d\xa5\xd3;\xbe-0\xad0\xc4@/\xe9#\xdd\xf7`\x80$[i\n5\xf5u\x96h\xb5\xce\xa7\xab\xcf\x17\xb1\x91e\x9d\xb3\x06\xcb\x7f\xe1\xafI\x0cZ\xc0|n\xee\x00\xfeC\x9e\xae\x12IL\xa3\xc5\xe2o\xbe\x97\xb6_7\x83uv\xa9\x01/\xb3\xb4\xd6(\xd8B\x94\xad\xf0\xba\xd6\xad\xf9\xca\xf2M;\x82\xfa\x01\xeb\x98 \x9b\x0b\xe3\x90\x048\x11!E\xd9wn\x7f\xbf<\xfd\xfb\xf9\xfb\xdf\x9a\xabJ\xfc3a\xf2\xd9\x81\xcbm\x82\xcd\xd5\xdd\x81h\xf3\xae\x8eB\xb8\xef\xcf\xa2\xa6Z\x07e\xe6n\x889\xb5\xa3;\xf4\x8c\x1a}\xc0\xa5cM#\xc6_\x93\x8b\x8d\xebw\xca\xf8)y#\xfeQK*&F\x0f\x1e[\x86\\~\x05>\xb6{\x87)-\x89\x16RA@8\x98\x07`B\x12\xc9\xc2\x19\x14\x16\x03\x84\x0b=Ai\x9f[\xbd,)\xef\x1f\x0f^\xa1\xdd*\xf2\x95\x06\xa9\xb7 ^\x17\xd7\x9e-\xf8\xc4\xc8\xee\x9f\xf8D\xa28D7\x10\xcf\xc4\xbf\xed\x9c\xbc%\xb0\xdb\x8a7\n\x92\xb4\xdd\xa9\xa3 \x0f\xe4c\xc6\x11%\xe3\x95\xb3\xfck\x90\xc8I\xab\x9d{aA\xa6Q\xde\x99\xbd\xa8\xb0!L\x90\x9d\xfa\xa2\xe7X\xabS\"\xacJi}\xab\x14\xe6T){\x01\x923o \xb2\xb8k=\x08\xfe\xaa\xb1\xc0<\xfa\xbb\x82A&\xb85\xfbP\xe9\xb7\x16\x1cl\xa1\x92\xe7\x90\xa5\x97\x86\xd8w#\xcd\x97\x8b\x1c\xda\xe7\nK\x14\xec\x95Q\xd0^\xc6T\xfbk\x84\x8bg=@\xbdK7\x1aR\xf88\xdb\x0b\xc4\x0b\xb1\xb1\xc6\xf2]b\x89\x1b\x1f\xe8\xac\x1e\x13,\xe7\xbc\xf5\xda\xa6\xa30b\x87\xb7\xd2\xad\xec\x8e\xa2P\xcb\x02\xcc19\xbdu\xdc'\xd4\xb8\xdecoX\xe5\x1e\x97\xc3\x05\xa3@u\xb1\xce\xe5\xcd5\xf4\xc6`\x1eR-\x16\x16\xbe{l\xb1x\x97-e>\xef\x8dM\xb6\x8dDt*\x95\xe3\x0d\xccfw\x8f{rc}\xbd%\x82\xac\xb0eQ2%\xee\xfc\xcc\x84z\xb8\xc2)\x0b^\xf9\x02-]<<\x98\xf1\x81\xda	\xbc\x0d\xaa\xdc\xc9O\xb4\xe2\xd9\x8b\xf4\x9e\xc1y\xed\x7fgQ\xa9\x01p\xcd5J\xd0\x13\x84@P\x045\x11\x8f\x1c\x04\xb79\xa9\x92]\xc8L\x84,\xad>lz\xec\x02=j!\xa3q\xb7\xaeP$?\x0b\x16\xba\xf2\xa3g@\xe94\x12\\\xa8\x98\x04\xe8\xac\xe8\xa7G\xed\xeb\xd9\x8b1\xa6\x0c\xbd\xcc\xb9\x14\xd4FRm\xc6m|A\xa9D\xa5l\x00\xd5t<\xc8Fy\xd1X\xb3\x99\xc36\xd4mh\x0cU\xa3\xc6\xee\x9c\xcc\x18\x14\x14\x9b\xd9\xb5\xc0R\x8d\xe5\xdb\xaf\x11K\x81\xd0\x8f\x97W\x9fNo\xfe\xf5\xef\xcf\x07\x8d\x84\xee\xfd\x92\xbfQ\x82\xe9.M\x9f\xd1\xbf\x86\x0cC1\x1c\xceRXl\xaa\xbd\x1b!fF\xb9\x05\xf9;\x91\xe8\xca\x85}\xbb\x1di-\x95\xad\x86@\xa3\xf0\xa4\x1d3\x7f\x14\x12L0x0.X\xc5\x06:J\x92\xef(\xc6\xfb,\x8e/\x91x)Q\x16\x83\x05B\xf7PT\xfe\xb9@\xe1o\xcd\xddm\x94\xf9\xce\x0e\xae=,\x9e\xc4\xa9x8F\xcfv\xe9(\x17\x11\xb1:\xcfuF\xc3\xfeLx\x14\xbf\xc8\xca\xeeY\x1b\xfb\xc9\x0c\x8a\x0dO\n\xdf\x01\x1e\xef\xcb\x19\x8b\xcd[q\xbd\x85#[p\xf0\xa4\xb5\xd6r\xaair\xf3\xe4\xf3\xdc\xbc\xe3u\xe7\xee\xec\xf1=\x807\xb7J-\xab\xf9^\xfa\xcb{\xdf3\xbf\xcb\x1f\x9c\xcc\xe7\xc8\xe9bq\xf2\xdf\x01\x00PK\x07\x08\x06>\xe0\x14K\x08\x00\x00/@\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00:\xb3P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00html/device.go.htmlUT\x05\x00\x01p\xa4\xd2j\xacV\xcdn\xe36\x10\xbe\xe7)\xa6\xbc[\x0c\xd2\x1e\x8a\x82\x16\xe0&\xd9m\xfa\x83\x04\xdb\xec\xa1\xa7\x82\x16G\x16Q\x8a\xd4\x92#;\xae\xa0w/h\xc9^IV\x16\xebt\xe7bq\xfe93\xfc\xc6M\xa30\xd7\x16\x81)\xdc\xea\x0c\x93\x82J\xc3\xda\xf6J|w\xf7x\xfb\xfc\xd7\xd3=DNz%\xe2\x0f\x18i7K\x86\x96AVH\x1f\x90\x96\xac\xa6|\xf1#K\xaf\x00D\x81R\xc5\x0f\x00A\x9a\x0c\xa6O\xaeD\xaf\xebR\xf0\xee|\x905\x0daY\x19I\x08,Z\xa0?\x05\x05\x10\xfc\xe8D\xac\x9d\xda\xf7\xde\x94\xde\x82VKVJm\x0f\xa1F\\ms\xb7X\xbb\x97\x93\xa4\x97eF\x86\xb0d\x99\xf4j :\x17.bH\xf4#\x1d\x00\xa1\xcb\xcd\x88\x01G\x87:s\x96MD\xc1gK\xd64J\x92\xfc\xf8\xe1w`<\xa9\xfa\xbbs\x19\x02R\xe0\xba\xdcp\x99e\xae\xb6\xf4w\xa6}fpq\xf3C\xf5\x92\x84\xed\x86\xb5\xed\xd4\xdfKilX\xb2\x82\xa8\xfa\x89\xf3\xddn\x97\xec\xbeO\x9c\xdf\xf0\x9b\xeb\xebk\x1emF\x06|\x92{q\x93\xde\x1d\xfa	Ao,h+xq3\xd4\x11\\\xe9\xed\x90\xd14:\x87\xe4\x0f\x0cAn\xf0\xd0\x8a#\x89\x80\x19ig\x87\xda\x00\xa2:\x96\xa3\xeclX\xda4\x9f\xed\x05\xaf\x86\xfa\x82\xcf8i\x1a4\x01A\xe7\x90\xacj*\x9c\xd7\xff\xca\xa83\x8e\x9e;_B\x89T8\xb5dO\x8f\x7f>\x9f\xf5\xc9V5\x01\xed+\\\xb2B+\x15\xa7s+M\x8d\xb1!c\xcf\xc9\xc7\x80\xfe\xd6)l[\x06V\x96\x9d\xca\x91\xf9$\xbd,\xdbv\xea\x7f\xf6\xfa\xb3\x05\x98h\x00\xac\xa0{U\xa0\x03\xc8\xf0\x8f\xb6\x1b wl	\xc8\x00\"\x90wv\x13Kw_Jm\xdaV\xf0\x9eu\xe6l\xa7\xa9\x00*\x102\xa7\xf0L*B%\xed\xb1%\x84/\xb4(\x9du\xa1\x92Y\xd7\x99\xd7\xea x4L\x933\x87\x8f\xd6\xecAV\x95w[\x04M\xb1M{WC \xe9	\x15P\xa1\xc3\xe9&{W\xfb\x80&\x9fz\x99LA$Ar}D\x82!	\xfa\xfc\xde\xc7\xd4M\xe68\xff[\xa3\xd1\xd2\x83\x1a\x8d\xca\x91\x04\xf9s\xff\x91\x04\xa9\xb4\xb3\x14\x9cz\xa4\x9aR\xd4i\x9aW\xc3\xbdf(\xf8|\xd0\xa6A\xfb\x86,\x1f\x9e@*\xe51\x84\x0b3}\xa8V\x9d\xdd\xe5\xa9~\xb1l\xf1\x8d\x80\xdc\\^\xbah\xb8\x8av\xdf8\xa1\x0f\xf8\xa9\xc6@\xa8.l\xe5\xc9nE\xc9*<\xeb\x12/ML\xf0\xd9Q\x15|f\xb2gqo\xbc\x81r\x83\xc3\xcdu\x9cyH\xb2\xe0\xf3w\x1a\x8d\x82\xb3\xf9\x11\xeb\x9a\xc8\x9d\x9e{wb=\x06\x86z]jb\x10\x81S\x1e\x90{9\\G\x1d\"q\x85v\xcf\xd2;\xb4{\xc1;\xfb\xf4k\x82@^\x1bsY\xa4\x1eCX\xba\xea>\xe6\xe3\x9d-$\xc1\xe3\x05\x86\x9cn_|a;\xbc\xbf\x7ff\xf0j\"o\xc7\xf5{K\xe8O\xd0\x0b\xa1p;\x0b\xae\x83\xbd\x1e\xe1\x939\xa8\xcbc\xf7\x02\xd24\x04\x800r\x8d\xe6\x9c\xdf\xe3x\x1a\xb1\xb9G\xe69\x9d\xc3\xca\x9b\x11\xc0\xebKmV\xbb\x1b\x98\xb8-\xe6\xe5}\xeb\x0f\xf7\x98\xd7\xa8\x8c\xcc\xb0pF\xa1_\xb2\x9fo\xef\xde-\xde\xff\xf2\xebo\xf3\xba\x1e?\xd5\xda\xa3\x9a\x11N\xfe\xbfD\x12|\xb6F\x82\xcfW\xf5\xad/\xed\xab\xa7<\xbdu\x96\xb4\xad\xff\xd7\xfc\x8ev\xc1\xc8dp8}\n\xde\x01\x8d\xe0\xf1\x0frz\xd54hU\xdb^\xfd7\x00PK\x07\x08\x16\xdc\xe8\x86t\x03\x00\x00\xb5\x0b\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x01\x8cP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00html/error.go.htmlUT\x05\x00\x01\x93_\xd2j\x9cU\xcbn\xdb:\x10\xdd\xfb+\xe6r\x1d\x8b\x81oQ\xb4\x01\xedM\x9cE\x80\x00\x0d\xdc\xa4@W\x01-\x8e%\x02\x12\xa9\x92#? \xf0\xdf\x0b*v\xaa\x87\x93\xa6Y\x99\x9c\xe7\x99c\xceQ\xd3(\xdch\x83\xc0\xd09\xeb\x92\x9c\xca\x82\x850\x11\xff-\xbf]?\xfc\xbc\xbf\x81hYLD\xfc\x81B\x9al\xce\xd00Hs\xe9<\xd2\x9c\xd5\xb4\x99~a\x8b	\x80\xc8Q\xaax\x00\x10\xa4\xa9\xc0E\xd3$\xdfIR\xedC\x80)\xbc\xdc\x1epO!\x08\xfe\x1c\xd4&4\x0daY\x15\x92\x10X,\x83\x7f\x90\x00\x08~\xaa,\xd6V\x1d\x8e-\x94\xde\x82VsVJm\xda\xfe=\xab6\x1b;]\xdb\xfd\x8b\xe7\xe8K\x0b\xe9\xfd\x9c\xa5\xd2\xa9\x8ek\xec\x9c\xc6\x96\xe8z1\x00B\x97Y\xcf\x00\xa7\x82:\xb5\x86\x0d\\\xde\xa5s\xd64J\x92|\\\xdd\x01\xe3IeKt\xba.\xb9\xf4\x1e\xc9s]f\xbc\xe5}:\xfbT\xed\x13\xbf\xcdX\x08\xc32\xfb\xb20~\xcer\xa2\xea\x8a\xf3\xddn\x97\xec\xfeO\xac\xcb\xf8\xec\xf2\xf2\x92\xc7\x9c^\x02\x1f@\xceg\x8b\x11\xf3\xf9\xec\x8d\xa0q\x80\xe0Jo\xbb\x19\xc2cJ\xda\x9aA\x95\x0e\x87%z/3\x1c\xf0\xd7\xe7\x99pO\xd3\xd2\x1a\xeb+\x99\"\x8b0o\"\x19!\x8c\x1a\x9e\xc1\x10\x1f\xcdNS\x0e\xc9\x12\xcda\x85\xd2[\x13\xc2\xe4\xb5f\xaf\x01zN\xbc\x02\xe1+i\xde@\x16A\xc5\x90w\xa0B\xa3\x06@\x9aFo \xb9\x96f\x89\xeb:\xfb\x00\xca\xdb\x0d\x1cl\x0d>\xb7u\xa1 \x97[\x04\x99\xa6\xe8\xfd\x05\xa4\xd6\x90L)\xfa\x1dHUj\xa3=9I\xd6\x814\n*g\xb7Za\xaf#\x00\xe5XB\xcb^L\x1b8\x85\x84\xdc\xe1f\xde}\xb0l\xe1\xf0W\x8d\x9e@!I]x\xc1\xe5\"9\xc7E\xcfv$\xa3% Y!\xb9\xc3\xe3\xea\xee\xe3\xf3\xaf\xb1\xd0\xb8\xc5\x08\x1f\xda\xbd\x01\xed!*\x87u\xd2\x1d.\xe20\x90J\xd3+\xdf\x99\xa7i:\x18\xe2D\xe4\x0eq\x8e\xb6\xdeq\xbe\xb33\x9d\x19\xa9c\x13\xfc\xcc:t\x1fzT\x9b\xe9\xc6Z\x1a\x0b\xca\x89\xea\xb8\xdd\xfe\xb8\xde'\x95H\xb4\x1d\xef\xcfX\x81\xde+4'\xdbS\xaa]Z\xe0\xd3\xd7\xcf\xaf\x08\xce?K\xce_tp\xa8H\\\x0e^\xc9H\x14\x9c\xcer\x82\xf6X\xd6\x84\n|)\x8bbDF\xfb\x7f\xb6\xaf\xf2v\x19\x02\x88\xb5\x1b\xf6\x02\xb8?N\x1d\x83\x7f\xa0\xf3z\xac\x12#u\xeb\x1bz\xd7\xce\xe5\xe5(\xf8\xf3gI\xf0\xf8\x91\\L\x9a\x06\x8d\na\xf2{\x00PK\x07\x087W=\xb1\x8a\x02\x00\x00\\\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00html/header.go.htmlUT\x05\x00\x01\x9a\xcd6_\x8c\xcd1k\xc30\x14\x04\xe0]\xbfBhN,Z:F\xfd\x05\x9d\n\x9d\xcb\xab|\xb1\x1f\x95\x9e\x8d\xf4\x9c8\x18\xfd\xf7\x12C\xc9\xd0\xa5\xe3\x1d\xdc}\xdb\xd6\xe3\xcc\x02\xebFP\x8f\xd2\x8d\x9a\x93k\xcd\x9c2\x94\x8c\xb5B\x19\xc1]\x18\xd7y*\xea\x8c\xb5q\x12\x85hpW\xeeu\x0c=.\x1cq\xdc\xc3\xc1\xb2\xb02\xa5c\x8d\x94\x10\x9e\x0e6\xd3\xcay\xc9\x8fb\xa9({\xa2\xaf\x84 \x933\xfe\xd5\x9c\x12\xcb\xb7\xb1\xb6 \x05W\xf5\x96PG`\xe7\xf46#8\xc5\xaa>\xd6zo\xc6\x82sp\xdb\xd6\x93\xd2\xc7\xfb\x9bu\xbe\x9b\xa7\x8c\xc2K\xf6T+\xb4\xfa\xfd\xc2gb\xe9\xee\xa3\xd6\xfe*\x1c'y\xfcs\xa6\x01~\x96\xe1\x9f\x00\xe7\xc1S\x8c\xd3\"\xfa\x19\xb9\xc4\x84\xe3\xf3\xcb\xbcv\xf52\xfcj\xdb\x06\xe9[3?\x03\x00PK\x07\x08`}f\xf1\xdb\x00\x00\x00c\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe1\xa9P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00html/idp_picker.go.htmlUT\x05\x00\x01\xd6\x93\xd2jlTOo\xdc.\x10\xbd\xef\xa7\x98\x1f\xca\xf1\xb7&\xda\xf6PE\xd8R\x95DU\xa4\xaa\xdd\xb6\xe9\xa1\xa7\x885\xb36\xaa\x01\x0b\xf0\xfe\x11\xe2\xbbW\xd8\xbb+\xff\xc9\xc90\x8f\xf7\x86\x99y&\x04\x81{\xa9\x11\x88\x14\xed[+\xcb\xbfh\xb3\xda\xab\x86\xc4\xb8b\xff=}\x7f|\xfd\xb3}\x86\x14)V,}\xa0\xe1\xba\xca	j\x02e\xcd\xadC\x9f\x93\xce\xef\xd7\x9fH\xb1\x02`5r\x91\x16\x00\xccK\xdf`\xb15\n\xad\xec\x14\xa3\xc3\xbe\xc7B\xf0\xa8\xda\x86{\x04\x92\x18\xa3\xa4\x00\x8c^E\xd8\xce\x88\xf3EM\xc8\x03H\x91\x13\xc5\xa5\xeeSM\xa2R\xef\xcdzgN7\xe4\x82\x95\x0dw.'%\xb7b\x04-\xc1uJ\x89vr\x06\x80IUM\x02p\x15\x94\xa5\xd1d\x069[\xe6$\x04\xc1=\xff\xfd\xf3+\x10\x9a\xb5\x97\xda)w\x0e\xbd\xa3RU\x94\x97\xa5\xe9\xb4\x7f+\xa5-\x1b\\o>\xb6\xa7\xcc\x1d*\x12\xe3\\\xef\xa4\x1a\xedrR{\xdf>Pz<\x1e\xb3\xe3\x87\xcc\xd8\x8an\xee\xef\xefi\xe2L\x08tv\xf7zS\xfc\x92\x95\x06\xa9\x19\xad7c\x90Q!\x0f\x93\xc0\xdeX\x05\n}mDN\xbe<\xbf\x12\xe0\xa5\x97F\xa7z\xb2\xcf\xfd2\xc6YsB\xb0\\W\x08w\x9a+\xfc\x1f\xee\x0e\xbc\xe9\xd0\xc1C\x0e\xd9\x8f\x0e\xed9\xc6\xdb\x89\x01\x8aq\xc2gR\xb7\x9d\x07\x7fn1'\xb5\x14\"Y*i\xa5\xa4\xbdh\x8c\x04zj\x8adi7\xab1\x04\xd4\"\xe5\xe9?Su\x87\xfd\xb5\xa7\x04\x00\xd6^G\xa8\xd09^!)\x1ekc\x1cBm\x8e\xe0\x0d\xb8\xa1g\x19\xa3\xed\x94\xcb\xe8\xbb\x92\xd7\"\xb3\x17\x81\xdaK\x7f\xdeZs\x90\x02\xed\xa2\xde\x91\xe5\xf6\x0d\x8e\xadzI\xb0\xeb\xbc7z\xa2>\xb2\xdc\x80\xc2\xbek\x9a\xb9S\xe0\xd2E\xd7\xed\x94\xf4K\xf4\xd6\xd5a2[n\xb9Z\xfa\x0dF\xcd~yZ\xe2\xf3\xfb\xa6\xdf8\xfb\xd6\xcfi\x860:\\v\xcaX\xd8\xee6\xc0\xd11F\x93\x17\x8b\xd5\xbb\xa4\xd1\xe6\xb6dtx$\x18M/H\xb1\n\x01\xb5\x88q\xf5o\x00PK\x07\x08~\x95\x08$\x16\x02\x00\x00\xda\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00v\x90P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00html/kiosk.go.htmlUT\x05\x00\x01\x01g\xd2j\x9cSOk\xe3>\x10\xbd\xe7S\xccO\xf7Xm~{X\x8ab(mo\x85-%=\xeciQ\xa5\x89=\xd4\x92\x8cf\xf2\xa7\x18\x7f\xf7\xc5I\xd3\x8d\x1d(\xcb\x9e,\xbd7\xff\xde\x1b\xab\xeb<\xae)\"\xa87J\xfcV\xd4\x12\x1a\xd5\xf73\xf3\xdf\xfd\x8f\xbb\xd5\xcf\xa7\x07\x18\x90rf\x86\x0f46VK\x85Q\x81\xabmf\x94\xa5\xda\xc8z\xfe]\x953\x00S\xa3\xf5\xc3\x01\xc0\x08I\x83\xe5S\n\x98i\x13\x8c>\xde\x0f\\\xd7	\x86\xb6\xb1\x82\xa0\x86\x0c\xcc\x9fM\x01\x8c>\x151\xaf\xc9\xbf\x7fT\xf3\xb4\x05\xf2K\x15,\xc5C\xab\x11Jq\x9d\xe6\xafi\xff\xc9|p\xae\xb1\xccK\xe5l\xf6g\xd4%9\x1fZb\x1e\xc5\x00\x18\n\xd5\x08\x80SAr)\xaa	\xc5\xd9-U\xd7y+\xf6\xe5\xf9\x11\x94.\xda\x0f\xed\xda2\xa3\xb0\xa6Pi\xeb\\\xdaD\xf9\xe5(\xbb\x06\xe7\x8bo\xed\xbe\xe0m\xa5\xfa~Zo\x1f\x9a\xc8KU\x8b\xb47Z\xefv\xbbb\xf7\x7f\x91r\xa5\x17WWWz\xc8\x19%\xe8\xc9\xec\xf5\xa2\xbc\xc7-9\x04\xa6*\x02E\xa3\xeb\xc5y\x8c\xd1\x9e\xb6#\x80\xd1	\xa5x\x8e\x8d\xad\n\xc8l+\x9c\xd8\x04p\xcbo`#X\x1f(\x12K\xb6\x922H\x02\xdb\xb69m\x11\xa4&\x06\x7f\x9cf\x9dS\x98\xa4\x1bnm<Y+\xb8\x97yH1qk\x1d\xaa\xb2\xeb\x8a{\xcb\xf5k\xb2\xd9\xbf<?\xf6\xbd\xd1Cx	\x1b\xa6X\x81\xd48\xa9\xe6\x92\xc7\x9b\x11v\xa1\xf4\xefT\x99\xfa\xfa\xab\xa1\x0er\x8a\x17\xc6|\x97<\x0es\xd5\xd7\xe5?\xf4\x85\xa3\xe2\x8d\xa0\x07\x0e\xb6i.\xec]\xd5xP\x05\xb8o)#\x83\x15\xf83\xc1\xd0\xfd\xe1H\xdcJq\xcb+\n\xd8\xf7\x05\xac\x06\xd3[[M\xfd\xd9Q\xd3\x80KQ(n\x10\xecFR\xb0B\xce6\xcd;\xa4\xe8\x86m\xe1iY\xc4\xa7\x1d\xfa\xe2kK\x8d\xbe\xf8{F\xb6\x9f]>\x8fF\x1f\x1f\xb8\xd1\xc3\xeb/g]\x87\xd1\xf7\xfd\xec\xf7\x00PK\x07\x08a\xbal'\xff\x01\x00\x00\x91\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00e\xa7P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00html/sign_in.go.htmlUT\x05\x00\x01\x1e\x90\xd2j\xacUMo\xdb0\x0c\xbd\xe7Wh\xba\xc7*\xb2\x1d\x86A60l=\x0c\x18\xd0`m\x0f;\x15\x8aE\xdb\x02\xf4\xe1It\x92\xc2\xf0\x7f\x1fd;Yb{m\x81M\x97H|\xd4\xa3\xf8\xc8\xd0m+\xa1P\x16\x08\x0d\xaa\xb4O\xca&\x15\x1aM\xbbn\xc5\xdf}\xbd\xfb\xf2\xf0s{K\xa2%[\xf1\xf8C\xb4\xb0eJ\xc1R\x92W\xc2\x07\xc0\x946X\xac?\xd2lE\x08\xaf@\xc8\xb8!\x84\xa3B\x0d\xd9\xd6\x19\xf0\xaa1\x9c\x0d\xe7\x1ek[\x04Sk\x81@h\xbc\x01\xfe\x1c\x94\x10\xceN$|\xe7\xe4\xf3\xc8&\xd5\x9e(\x99R#\x94\xedC]Y\x95-\xdcz\xe7\x8egd\xc4r-BHi.\xbc\xbc\x80\xe6\xe0:\x86\x04\x7f\xe5C\x08W\xa6\xbc2\x90\x13\xa1\xca\x9d\xa5\x13(\xf8<\xa5m+\x05\x8a\xc7\x1f\xdf	eI=\xe6\xceD\x08\x80\x81)S2\x91\xe7\xae\xb1\xf8\x94+\x9fkXo>\xd4\xc7$\xecK\xdauS\xbe\xa3\xd16\xa4\xb4B\xac?1v8\x1c\x92\xc3\xfb\xc4\xf9\x92mnnnX\xbcsu\x81M\xde^m\xb2{UZ\xa2,g\xd5\xe6\x12\xe4L\xaa\xfd\x95\xa1p\xde\x10\x03X9\x99\xd2\xed\xdd\xfd\x03%\"G\xe5lL(\xf9\xdco\xbbn\xa6\x8e\xad\x1b$\xf8\\CJ+%e\xec\x89\xbd\xd0\x0d\xf4\xb7\xeeQ t\x1d%V\x18Hi\x88G:{e\x80\x9e\xfc\xda\x1a\x1bD\x15$\xb9\xf5\xde\xf9\xbe'.\x17\xafOU0\x10\x82(\x81fm{\xf2\xe5\xac\x9es\x81\x95s\x96B\x81\x96\x01p\xeaN\x08\xd7b\x07zn'\x84\x87Z\xd8\xec1\x80\x8fIq\xd6\x1f\x97\xfczi\x16\x002\xaa\xd1\x8c\x14\xd3\x9a\x0fk\x90\x14\xe1\x88\xcb\xf8\x98~\x9f\xc1\xb2\xc7\x9f2\x9c\x1e;\xef\xafa\x89\x06]\xeeL\xad\x01_}W\xf4-\\\xde\x84\xc5\x98\x1e~5\xca\x83\\\x00'U\x8f\x8b\xb3\xbf\xa8\xfc\x9a\xfa[\x11\xc2\xc1y\xf9\x0f\xea\xd7#\xc5r\x96\x83\xfa/\xfb\xbc\xb9\x02o\x11=o\xbc\x07\x8b\xeb\x97C\xfe\x17y9[n{\xce\x16\xff\x88\x97c\xb2\xd0p9^G\xa9w\x0d\xa2\xb3'=\xc6S\xd1hMG\x1dC\xb33\n\xe90\x8b\xbeY\xce\x06\x9fi\xf8\xe9Dbq$e\xabE\x87\x8b\xc3y\xcb\xd9\xf0\xad\xe0,~H\xb2U\xdb\x82\x95]\xb7\xfa=\x00PK\x07\x08\x18Nz9P\x02\x00\x00\xde\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00img/account_circle-24px.svgUT\x05\x00\x01\x9a\xcd6_<\x90\xcdn\x83@\x0c\x84_e\xb4=\xdb\xeb\xb5\x17\x02U\xc8\xa1\xbd\xf4\xd2S\x9f\xa0J( \xe5O\x05A\x94\xa7\xaf\x9c\xa0J{\xf8<\xe3\xb14\xbb\x1d\xe7\x0e\xb7\xd3\xf1<6\xa1\x9f\xa6\xebk\x8c\xcb\xb2\xf0b|\xf9\xed\xa2\x8aH\x1c\xe7.`\x19\x0eS\xdf\x04\xcd\x01};t\xfd\xf4\xe4yh\x97\xb7\xcb\xad	\x02\x81fh\x0e\xbb\xed\xf5{\xea\xf13\x1c\x8fMx)\xdblm\x15ph\xc2gR\xe8{\xc9\xb9\x82B\xb1B\xd21;%\xf9\x7f\xb4\n\x94\xe4+m\xb8\xf0m\xcf\xdeO\x02\xdb'.K\x08\x0c\x89-\xc3`#=\x89\x0cF\xf6\x18\xc8\x87'\xb8\xe6\xc1\x94Y\xf7\xa4\\\xc0\xefo\x12%\xd6\x8aJ2Ve\xf1X]#\x93\xb1TpU*<$A\xc1\xf5\x06\x89\xa5F	\xb7=Y\xbb\xe9\xdb\x05\xfc\x00\xb9\xa3z\x0fqm\xefu\x05\xd2k\x9e5\x7f\xc8=\xac\xffq\xbe\x9c\xdb\x10w\xdb8\xce\xdd\xeeo\x00PK\x07\x08\x83\xba\x83\xe4\xf6\x00\x00\x00|\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00img/error-24px.svgUT\x05\x00\x01\x9a\xcd6_<\x8b\xcdj\xc30\x10\x06_e\xd9\x9ee\xad\xbe(I)\x96\x0f\xed\xa5\x97\x9e\n\xbd\x17\xe2j\x0d\xfe	\x95\x90\x82\x9f\xbe81]\xf60|\xcc\xb4\xa9D\xbaM\xe3\x9c\x02k\xce\xd7\x17kk\xadM=4\xcbo\xb4\x10\x11\x9bJd\xaa\xc3%k`x&\xed\x87\xa8\xf9\xc1e\xe8\xeb\xebr\x0b,$\x04O\xf0\xdc\xb5\xd7\xef\xact	\xfc!$\n_\xe0\xdfee\xfa\x19\xc61\xf0\xbc\xcc=\xdb]zLO\x87\xfb\xf1\xbdq \xbc\x9d\x1a\xffL \xd0\x0e\x0e\xc9o\xe4\xe4\xff\xcd>\x18'\x9f\xee\xdc\x1c7{k\xd7\xc9\x91;\xaaA1P\x14\xac\x93\x18\xaf\x06_gE9\xadl\xbb\xd6\xa6\x12\xbb\xbf\x01\x00PK\x07\x08\xfc\xc6x\x8f\xb5\x00\x00\x00\xf9\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00img/pomerium.svgUT\x05\x00\x01\x9a\xcd6_\xc4U\xcd\x8e\xe3F\x0f|\x15\xc2\xdf\xe5\xcb\xa1\xcb\"\xd9\xbf\xc1x\x0ey\x13\xc1\xeb\xb1\x16\xb0g\x16cG\xb3\xf0\xd3\x07\xd5\x92gw\xb1;9%\xc8\x85j\x15\xd9-v\x15I=\\\xe6\xa3\xcc\x9f\x0fo\x7f\xbc|\xddm\x06\x19D\xbd\x89\x0f\x1b\xf9z>=_v\x9b\xe9z\xfd\xf2\xfbv\xfb\xf6\xf6\x867\xc7\xcb\xebqk\xc30l/\xf3q\xf3\xf8p\x94\xeb\xeb\xf8|yzy=\xef6\xd7\xd7\xf1\xf9r\x1a\xaf\x87\xff\x87$!!\xfd\xb6y|\xf82^'\xf9\xb4\xdb\x9cu@\x96\x844\xa9\x15\xd4\xbdch2HB\x16\x93\x84,\x11\xdefS\x98\xed\x071D\x0b\x86\xa4\x12\xe1-$d\x89\xf06\x05\xb5\x82\xba\x0f\xcbn\xe2\xc1\xba\xed\xbb\xc3\xba=\x18\xa2	\xb7w\\\xee\x01\xb7\x8d<}>\x9dv\x9b\xff\xe5C\xf4C]^\xc3\xeb\x9f\xa7\xc3ns\x98\x0f\xcf/\x9f>m\xb6\xbc\xd5\x1a\xf6\xf4\xf4\xf4\xfd\x15JB\x14\xcb\xc86\x05E\xcc'\x85z0\x94\x16\x0c\x96\x98H\x9b\x14)\x9e\x94	8j\x16E\xf4\xe0\xa8\xa5;ng\x06)\x13\x8c\xe3\x9ae4\xe9\xb4KP\xa4\x80\x94fx\xe1\x07\x92\xce\xa1\"F\xee\xd4\xd9\x19_\xc9L]\xe3\xd7\xf0\xbd\"\x112d~\xce\x18\x93\xb3\x90\xa2KP\x0c\x95\xcb\x1a\xee`\xbd\x9d\x03\xdcBD\xad\xa3\"W\xe9\x86G\x0eLA+\x92\xcf\x86d\xf4&z\xd3\xea]\x9cq\x8fJD\x11c@\xf1e\xa1\xa8\x97\x80\xd4\x82\x92\x8e\x15*\xedv\x0e\x15U\"\x9c\xf9;s\xf3{\xfe\xbc\"R\xda\xf3Y\x84\xaa\xe5\x1c\x14V\x96U\xbf\x80bh}%\xef\x18y\x88\xe4!\xae\xe7,\xc7\xcc\xc1I\x94\xcd\x151u\xfa\xecv\x1eH=\xef\xc1,\xb3\x7fw\xcb\x12\x90|\x1f(\xd1\xc0t\x13\x8a\xf7\xa7\xf0\x02\x17\xa4&\nr\xd3\x91~\x06\x8f\xf8\xc6\x04\x0f\xa0\x9e\x8c\xcb\xdd\xdfW\xed=\xa2on\xe2Hi1wG\xc9\x01)\xcf\n\xcbc$\x81\xdd\xac\x9c\x98 fqy\x07X=\xeab\xa8e1K\xa0\xa1V\x12CO\xce\x8b\xb9{R\x13\x97\x84\xb4\x9a\x05\x1e\x04)\xdf\xce\n'\xbf\x1eG\xa5\x12z\x97\xa3k\xef\x85@\x9e\x0c\xa9\x8c\xbd\x94\xba\xf9\xc6\x1a\xe5\xf5\xdc\xab\xd8#\x9a\xcf\x8e\xd6\xb9\x9eCB\xaf}\x9d\x91\xdahr/jR\x1aH\xcb\x8f\xc5\xa4\x82\xd80\x90\x05O\xa3\xa2R\xa0\xfa.\x10\x92\x07\x0c\xcc\xa6\xae\xe6=\x87H\x02o\xe7\x90AO\xd4_0_\xf2\x7fF}\xfe\x97\x987\xbf3\x9f\x91\x8bDT\x02\x16\xd8e&\x91\x1a\xf8\xa9\xcf\xc2E\x05\x8e\xa6&Q\xf8\x08\x9c ^~\x82\x92\x9e\x02g/\x9b\xb4\xc1\x1c\xca\x99\xd4\xd8[\xed\xde[\x9d\x83\xa1\xad\x05\xa9\xa2\x92\xa9\xcb ?\x07\x12'_\xec\xba\x88fl\xae\xba4WCI\x94\x8e\x1dR\xfd\x82\xcc\xba\xe8\x92\xaf\x10{\xd8CW4\xf1b\xa5\x05dn\xe9\xd7\xecP\xf5.z6n\xed\xf3\xd1\xf3lh\xcael\x1c\x95C\x99\xd8!\xa3\xf1\x03\xdd03\xda\x04\xe3\xd83\x0b\x9d*\x8d\xb3\xa1\xea\xc4\xd5\x0fj0\x96\x83\xa8\xa4\xdbG?\x87o?47\x14\x15\x8dh\xa3\xa3\xb9t\xb3\xd6)\xd74S\xf0\x08\xfd \x80\x80\xcd\x9a\xa1q\xe2Ys\x88H:&\xb2\xd2\xcd\x92\xbe\xaa\x0c3=\x93\xfd\x13\x11\x88\xe5v\x0e\xde\xe0Q\"~}\xd8\xed\xac\x86\x96d\xf8\x1bo\xfc\xc8\xcb_\xe8\xf6\xf8\xf8\xb0=>>l/\xf3\xf1\xf1\xaf\x01\x00PK\x07\x08K\xfe\x8b#h\x03\x00\x00d\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00img/pomerium_circle_96.svgUT\x05\x00\x01\x9a\xcd6_\x04\xc0W\x12\xabL\x82(\xe8\xad\x9c8O3\xa1\xe9B \x04\xa8\xa6\xff\x8e\xc0C\xe2\x04\x89\x7f\xa9\xc0\x9b\xc4{\xb4\x9a\xbb\x96^\xd9\xfd\xfe{=\xaa?M\xfe\xcf_\xbf\xc8\xb6q\xf9\x0f\xec\x93e\xfb\x8f\x95\xb6E\xb6\xfdG\xed\x93\xaa\xf8\xfb'O\xb6\xe4\xbf\x86\xa4/\xfe\xf9\xeb\x17\xd96.\x7f`\x9f,\xdb\x1f+m\x8bl\xfb\xdf\xff\xa3\xf6IU\xfc\xfds\xf5\xdd\xb0\xfe\xf3\xb7\xde\xb6\xe9\xdf\x18v\x9e\xe7\xbf\xce\xd7\xbf\xc6\xa5\xc2\x88\xe7\xf3\x89\xadG\xf5\xf7\xcf\xd5w\xc3\xfa\xef\xabk\x06\xf4\xcf\xdfz\xdb\xa6\x7fc\xd8y\x9e\xff:_\xff\x1a\x97\n\xc3?\x9f\x0fvu\xcd\x80\xfe\xfe9\x9a\xe2\xe4\xc6\xeb\x9f\xbf\xcf?\xcf?\x04N\xfd!p\xea\xef\xff\xfcw\xd3'U\xf1\xa7\xc9\xff\xf9\xeb\x17\xd96.\xff\x81}\xb2l\xff\xb1\xd2\xb6\xc8\xb6\xff\xa8}R\x15\xffE\xfc\xfd\x93'[\xf2_C\xd2\x17\xff\xfc\xf5\x8bl\x1b\x97?\xb0O\x96\xed\x8f\x95\xb6E\xb6\xfd\xef\xffQ\xfb\xa4*\xfe\xfe9\x9b|\xab\xff\xf9\xfby>\xff\xfe\xa9\x8b\xa6\xaa\xb7\x7f\xfe~\x9e\xcf\xbf\x7f\xb6%\x19\xd6r\\\xfa\x7f\xfe\xaeY\xd2\x15\xff\xcf\xf3_\x04\xf9\xff\xfe\xfdsu\xcd\x80\xfe]/E\xf9\xcf\xdf<\xd9\x92\x7f7}R\x15\xd84T\xff\x7f\x9a\xac\x05E\xfe\x7f\x8d\xcfY\xce\xf9\xd4\xe4jdY\x965\xa1W\x8b^\xc5\xb2,i\xb3,k\x89<\x1b\xb1,+\xbc\xdd\x83\xa1Y\x96e\xf9\x90S\x83\xd0`Y\x9elY\x96\xdd\xd5S\"'\xf7C\xb0,[\xb1b'\xda\xbeC\x86\xfb/\xcf\xe9:\xf7#\xe5\xc1\x14\x1fSuX\x8a-:N\x8b\x80WC\xa2n\x1aM\xadFMk\xd9\xb1\xa1\xe6*\x1dB\xc9\xbeN}\x9e-\xcb\x10\x82\x92\xb4\xdcj\xae/\xb9\xe3}\x0d}.\x95\x02\x96h\xab\xba-\xdaU3M@\xb6k\xc0Q\xf4\xe3\xf1\xcdm\xb5~)G\xa8'\xe5\xca\x1cO\xe6\xf7B0\xdd\xa8\xfc\xad \x12\xbc\x8aOA\x11\x1f\xbf\xdb\x14\xe7, \xd0\xfd\xa4oY\x9b\x90\xa1\x89S\xf1\x82)g\x1b	xe\x13\xac\x10\x99\xaf\x04'#\xd6&\x15nK\x98\xef\xa2<\xf3Q\xc3	:\x1e\x89\xb9\x0f\xa8\xf5\x9c\xa2\xddLC\xa9\xe2\x97\x83\x10\xf7E\xf6\x02\xf6\xdeY\x90\xf17\xb1\x15!\xbeP\x0f^i\xb6\xcf\xe2I[^2fH=F	tR\xac\xa2\xcdo\xd2\xda\xe7!\xce\xd6\xd3Y\xa7\xe7\xa3\xdfS3\xa4\x1ey\xf1R\xdc<\xa6\x16\xf8\xbe\xe3T\xea\x03\xff\xf7\xe9\xab\xaf\xb1|\x9f$\xf7n\xae\xc7^\xf8\xda`Q\x0f\xe5\x89`1\xfexJD\xb3_\xc2\x9a%X2\x13\x88SIp\xe5\xc3X\xa9\x81\xd2\xb8\xf8l\xa7\xc6\xeeeu\xe0?o\xaa\xcc\xfe\xe1]\xdd\xc0\xc62;\x1b\xda\x8b \xdf\x8e\xd6e~\x8e\xd9	\x04\xda\xcb\xb4\x9b\x1a\x14\x93\xf1\x8cB\xb3r\x08n\xb07)\x0d\xcb)\xe8\x18\xce\xdc\xe9+\n\\\xbe\x0c\xda\x01\xb5\xa9J\xcaq\xa5\xba\xda\xe4\xf5\xf9\x81O\xb1\x86\xd6E\xb4a\xa7\x82\xc9\xeeR6]m\xb8}\xac-/\xad-/\x93\xdf\x87\x98\x02.\xb2\xef]\x12\xc9\xef\x90$\xb7~\x86Z\xa8\xc1\x0f\x9ek\"\x9a\x95j\xaf\x01\xe7{}\xcb\xab\xde\xd7%8%\x12>\xf3\xf2\xd9\x05\xeb,\xdb\"\x9c\x97;`\x0d\x86#IqHZ\xe6\xc1\xbbW9\xa7\xda\xbd\x9fk\x07\xcb\x0e\xa91D\x83\xb8\xba\\mj\xaf\x9df\x95\xc5+\xf33'\xb8<\x9d\xde\x9d6M\xefH\x10I~H\xa8\xc7\x90\xb4\x1fk\x91\x9c\xf8\x99\x13\x12\x9a\x9du\xbd8\xcd\x1b)\xc4\xc7+G\x9f\n\xc1\x1d\xc9t\x84\xa9B\xcee\x10\xb0$\xc3\xfeN\xf9\xcbR\x06{Q\x9b\xaf\xf4o\x82Z\x11\x8c\xbbZ\x8d!j\xb5\xf1\xf3\xa6\xb3c\x95\xb0\xedz=\x81Loj\xe0f\x92,m\xf4\xd7\xd6\x8cO\xd9\x890\x1b{\x9e\x12Q\xec\xf1\xc9*P\xf5\x83\xc3\xed\xe2\xf1\xfc\xa6\xe6\xb19\xbe\xf6p\xd9Rf5s^\x9e\xd9\xec\xfb\xf4\x86\xd5\x00x\xb3\x19\xf3|0\xdd\xb9&\x9a\xab\xfe+\xa19/\xa7\x01d\xfaC\xa6\x13\xa6\xc5\xbc_\x9f\xefY\xa9L\x19\xc7IG\x93\xec\xacG\x9b#J\x08\x80da\x9f\x11\xack|\xc7lbdZ\x86?\xb1\xd9\xfd\xfe\xf8\xcc?%\x85-\x0dm\x82\xb17\xbc\xbag\x03\x1f\xab\xc9kb\x87wM\xbc\xf2\xdc\xc5k*\xaf\xb0\x05#qCN\xa3\xc4\xb5\x16\x96-\xb8\x9d\xac%\xc3\xa55\xdc\x0b\xf2\xf0\x01\x80\x86^\xba\n!.>\xf0\xc1\xfd\x84\x1d\xfd\x11\xf8tg\x85\xfdG\xa8\xa8\xfb\xac\xf6Dv\x12k\xd1\xb7\xe8]\xca\x90\x877\x00\xd4HI\x1e\x00\x94\xe6\xbd\xa2J\x12\x1e\xdf\xae\xa6i\x1b\x19\xd47j`\xf4\xb2\xdd\xa1\xb2\x98\x8cN\xa8\xf7[\xeb\xb20\x0fo\x00\x82\xc9\x92<\xa06\xcf\x11r\xb5\xf4\xc4\xda[\xf5N\xd6<\xd9\xab\x9a\x1a<\x82C\xfb`D\xba\xf2e\xc9\x9a\xd6y\xa1\xe1\x0d\xa7\x17\x7f\x8bh\x83\xc0\x94E\x85\x14\xdb\xea$m\xb8\x8b\xc3\xcb\x7f\xb6\xda(\x15\xacEri%\xac\xb3\x0f\xe3\x97\xf2.\x10\xb3\x886\xac\xb5\xe3w\xaa\x85\xc0\x9dB\xc9\xb9\xd1Q\xae\x0b\xb0B\xfb\xc9\xf0\xe6\xc9\xbeX\x96!\x0b8i\x92'i\x00w\x13\xe1\xe1\x08\xa7\x91\x01\x83\x94\xd3<\xbc\xe5\xc9\x96\xc8\xfe\xc7\xfd\n\xd6\xbc\x92\xb9\x047\xe0?\xa0\x0e\xec\xba\x93\xec9\xc9\xc0^\xb3\x80\x14dI~\xca\x19\x07\x92\xa7\xa8\xcc,Q\xe0\xcd\xc4\xa0\x13\xe9\xcf^\x8a\xa0\xec\xf9r=\x9d\x95P\xd9\x16\xef\x9e\x8a\xc2\xba\x1b^\xaa\x1eb\x04\xfc\xd4C\xf6\xc9\x04\xda\x00\x95\xb5\xd3\xbd\xfe\x1d\xf3\xbc\xe7\xbb\xd3x\xcaB\x93y\xac\xf6\xe5\xd2t\xc6\xaa	P\x9b\xba\xdam\xcf\xf0K\xb3x\xbd\xff\x06\xfc\x07\xec\x81Zw\x92\xad&\x99J\x9d\x9c\xc2\xf2\x11\x8cO\x91\xa0\x8f\xa7\x06\x8b\xfe\xf9\x18\x9d\xab\xe0\xf3k\xb7b\xc7\xe3\xb8\x98\x87i\xe7\xf7'b\xd8\xa3z\x8e\xb6C\x99*Dq\xd7\xb1\x9aZ\x84\"\xb3\xd4\x0d\xaa\x0b\xd4\xea\xc0n\xaa\xc9\xffh\xa2\xb9\x82\xde\x00\x8c\x84UJt|\xd7\x05hC\xfd\xae@\xc8\x82\xccn\x17\xf3\xccxo2\xef[D\x83Z\xbby\xa3{,\xf8rJ\xa2^|0\xf9>)\xea\x95\xb7/\xbe\x1dK\xe7\xa6{\xf3\x16\xf3\xbc\xdf\xf9\xd3r\xe6\x1b9V\xe1F\x0c\xef\x04\xfd\x94\x1e\x1a\xf2F&I\xa8\xbd\xc5:@\xdd\xc2\xdbM5y\x1fMl\xed\xe1\xcb)da\xb1\xf5Ha\xa4\x06\xb3\x99\x11v\x8e#E\xbdZ\xd1\xec\xfb7x\xc3`LxJF\xdd\xbc~\xa4W\x151r\xc8{\x8e\xb2\xe4\xe1C\x02V\x18$\xa4\xdd\xea\xdf\xb7a\x19)_\xc5\x0d\xea\x16\xd5\x86\xfd\x1c\x9b\xb2Hf\xe2\xb2\xbd#\xe5B\xeb\xd2\x17\x05\xcb\x93*\xd0:\xd8_+o\xe3\xd5\xe8\xdb^gu|\xb1:q%c\xb6P1%\xe2_\xd2\xb3\x86L\xea	*\xa9\xfc\xaad\x9d}\x9f^\xbe\xcd\x1b|\x02\xa3\xeeL\xdbK2\xad8\xcd\x82E$o\xaa/	h\xf3\x16V:\xc3\x97\xd4\xe5\x15\xae\x1at\xb3\xfbXkNK\xa6\xea\x19\xd9\xed\xa9\x15\x9cG\x02N\x0d\x81\xfe\x8c\x8fLx\x9f\\\xe8\xcf\xe3\xda\x90^7Cf\xad\x81>\xeb\xe8\x15\xf1^\xc3\xe9\x0f\x9dcb\xdd\x0b_\x16w\x9c\xc2\xe0\xcf\x93\xd1\xaa^7\xbb\xd9Z\x03}V\xd13\xe2\xb9K\xd7T\xa0\x90\x8b\xc5\xa1\xce9\xe8L\xd8l6 \x97\x0et>\xb4\x0f\xe8\x8b\x81\x8d\x86\x0fj\xe2\xd5\x86\x0d7\x9dz\xea\xec\x1a\x9c\xbes\xe4v\xb5\x15\xcc\x9d\xd7;\x86\x0eq\x10\xea*\x84\x8f\xc61	\xf6 \xa5\x07\xf7;\xf5\xd9\xd9y8\x19q\xc4K\xa79\xf8\xd4\xc8\x88\xf6\xa6?\xb7q\xad\x01\xa0t\xf1\x19\xb1\xdci\x0el\x94\x89Q\xa7\xa3iY\x84\x9d\xc3*\xb0\xce~>\xb5\x91\x87x\x9e\x92\x91\x82*\xd7l\xd8L\x19\x98d\x15W\xa4\xa3n^\x8a\x82]I\xe5\xa7\xe1^p1\x1a\xbcW\x9c\xd7\xb4NO2p\x92\xdc\xc1v\xa4d\xaa\x83\x04\xbc%ZY\x83lu\xbe\xc8\xc5j\xd3\xb5&\xd3\xc7\x04\xbe \xf7%X\x9dTJ.\x8a\x14\xb5\xd3\xd1\x026.d'\x86[\xbf\xfb\xbbx\x9a\xd5^Of\xd1\xf1v&\xbc\xce\xec\xc1/\x8eDN\x1a*-\xc4\xa6\x95\xf3\x08\x93\xee.\xefs\x9d\x9ds\xad%\x1dLv\xb7\x9d\x82,\xaaC\xad\x02\xfe\x0d\xb41R*\x89\x11L\x1c\xce\x16\xd7xp\xbdx\x0d\xf8^\xdf\xb2\xd1\x83\xbd\xea\xa4h\n\x94Vh\x8e\x9b\x82]IQ\x9fq/pD\x1d\xdec\xc1S\x92g\xf4\x03/\x1ajo\xe4\x8c\x94\x8f\xad\x08\xbc\xa13\xd8\x90\x14J~\x80S\xd0\xad)\x8f\xc8\x85\xb5a\x138`\xbetM\xd5\x06\xf6\xb3\xc9\xe4\xa4\xa1l\x9a\xac@\\\xa8\xf2\x8a\x85\xd99\xd7Z\xd2\xb9\xc9\x1e\x16\xd6gj\x9d%p^\x9ca\xd1\xabrz\xb9\x02N\x80\x1a\x96]\xa7\xc6\xb66w\x91x<\xc7\xb1\xda6\x91\x9cT\x94\x07QO:\x83\xd0\xac\xbe\xad5\x91>\xfa\xf6\xb9A-\x12\x87\xc8|\xc1\x11v7\x9c\x00N7m\xa0\x91\x80K\x82\x8ec\x02{F\xd5stp^\x0c\xcf\x9a\xe1\x03\x94V\x08\x86\xcf\x8b\xc7*)\x13\xa9\xee>@\x14H:\x11\xd8M7\x88\xab\xcbU\xec\xc0\xfe\x18\xe9\xb5\xbd\xb7\x00h\xe81T\xce\x83\xed\xce,	\xb5\xb9\xab#o\x88c^\x0b\xb2\xb9~\x8e\xd8K\xa9\xbe\xa4\x92\xab/	\xa0z\xa9\x93B(+m\xfd\x90\x12\xad\x81s\xaf;\xd1\x9c\xceZa\x7f\xa4\xf2\x89\xf0\x0e\xa0i\xa9\xa2\x82U:8\xbd.U\x83\xeb\xea\xf3\x9a\xe5\xab\xfd\xc2bd\xde\x9b\x02\x93\xdc\xa8\x81\xf8	^\xacpo\xbe\xb3\x16<Zt\xcen\xda\xce\x06\xf3i\x10\xd2k#\x19!\x8de\x12\x88\xfd\x86\xb3R\xc6\xbe\xee\xc3\xeb\xfdF\xe7\xefi\x83\xb7\xe8\x8dI\xc7\xc7\xab\xf0=\x85\x07\xefW\x838eH\xf3\xf6\x80}1l\xd9Q\x93\xd1fA\xb7\xd9\x89\x84\xd4\xa9\"&\x05*\xa7\xf0\xe0\xe4\x13B{\x87\xbe\x16\xe8\x90[\xaboD\xb7\xd4l\xafk\xcek\xb2o\xf4\x07\x0b\xceC\xb7\x87\xc0\x8ca\x0c\xee\x98\xd7\xcfo\xc1&\xf9\x0d\xfdu5yM\xf6\xc5~`\xd9\x8c\x8b\xcf\x88?\x17\xe8kVkk\x0f?A\xb7V'\x1e\xaeT\x81=\x0f\xd2st\xebS{\xb0\xbf\x07/\x1b\xb1\x86\xb2\xbdb\xb1*\xc9\xc2\x19\xdd\xc0I\x02\\\xa8\x02{\xee\xa4\xe7\xe8\xe0U\xc8t)7\xfeLN\x0b\x81\xd6\xabace\xf3k\x0b\xa6lZ\xd6\x94G\x8f\xc5\xb0aC\xd8`\xb8\x8dU\xf8y\xef\xd3\xee\xa0\xbd|%\xec\xbdL\xd9\x84\xaf	\x8f\x1e\x8bjC\x84{\xd6\x13;S\x8bm\xd7\xe4D\x10\xfaO\x1ec\xcd\xdf\xfd\xf0\xfa5\xe75\xc1\xe7\xfa\x85\xe5\xa2`\x18\x8f\x07xW\x93\x8a\xf2 {GNh\xfe\xee\xcd\x97\xd6\x92G\xc7\xa2\xd8M\x85\x07`9\x83L\xbd\xc4\xed\x90\xd6IET\x18\xbaU\xc1\xf0%fE\xfc\xdc]\xba\xea\xc0\x8a\xb2\xc1~\x02\x8ce\xa9o\x0c}\xe0M\xb9\xccv\x8f\xc3\x07\xc8\xe6\x9f\xcb\xfc\xb5\xf7\xda\x97\xf3\xe9\xac\x85*\xb7X#\x12+\xa4\xa3\x1e\x92S\xe4\xb4}K\xc5I\xa8\xad]\x1dy\xeb'\xe65\x8f\x82\xb5\xf1`\xbf\xa7C\x08A\xd0A\x07\x1cn\xc4=\xa4\xb9\xbb;\xe2\x1a5X\xac\x0e\xa7\xe51\xdb\xa7,\xcd\x98/\xf6V\x1a\xf2\x80\xbe\xda\xe66\x1b\x8a\x115\xf5\xdc\xaa\xc3\xc7\\\xd4\xbe0\xd7?X\x0bn%=X\xe2,\xa0\xbd\xc3X\x9f\xe3\x8b>\x95\x82\xe5\xcd7\xe1<\xd67\xaf}}'\xcd\xad\x88\x1f\xb8H\xe77\x80\xea\xa5Wv\xc1\x83Sx\xad	\x8f6Z\xb5\xe1Bx\xa0:\x9b\x17P\x18>\xe5v\xc0\xbf\x01\xaa\xa7C+\xd9\x1f)\x14\xbe\xd6lm\xe4u\xc7\xcaq\xda\x11\xb3=\xcd\xbe\x189\xaf\x8en\xf2\x10\xf4^/\xfe\xcbwp\xf2\xaf5\xe1\xd1)\xaa\xe3\xc7\xe3\x93\x95\xa5kYE%km\"9\xa9h\x0e\x83\x80\xa5\x99J\x91\xe4\xeb\xcb%\xc1\xbd\xbeyM\x0c\x10_\xae|y*D\xdb{\x1d\xf4A\xdf\xb2\xd6#\xd0\xd0m\xd6\x89w\xaf\x17\xaf)A\x9d\xaf\x02\xe3\x92\xc2\xc1\x8eF\x93y=\xec\x84\xe7\xc2\xbb;\x87Q\x17\x8c\x03\xb4\xea pj_\x98\x1d0\x9d\xac%\xc5gXJ\xeb\xa4\"\xad\xb4\n\xd5\xbdR\x8b\x1f\x83\xceXd\xbbi6\xd5{F.\xa8|L\xa0-\x1e\xcd\xd0\xe7\xe1RI\x8c\xf0\xa5p/pD\x1d\x12~\xad\xc6\xce\xfc\x1c\xdd\xbc\x8av\xb6\x0c\xcc\x18\xc6\x9a\x17\x90\x0c?P\x9bW\xb8c\xd0\x19\x0bk\xcf-\x84\xd77\xa9L\x8b\x0d\x1f\xda\xbb\x9aT\xd4e\x12<w\xba\xc6pk\"{.\x9a\x82\xa8\xf6\xb5\xbe\xe3\xf3Ux\x9f\x96\xe2P\x1a\x04ZG:\x15(g	\xc6r\xb5i\x8e\xebU\xd2\xc4k\x1az\x92|X\xf17\xc5\"\x18\xf8\xb8\xf5\xe5\xc4\xfe\xe0\xdf\x81\xc4\xceZ\x9f\xa9\xbe;Mg\x9f\xb2V\xc6\x8259\x11\x84\xb3\xa9\x91\xfc\xe1'\x8b\xd1\x9cA\xa7.\xa2=w.\xac\xbf\x99 \x9d\xdf\xd4\x995\x08\xb4\x8e\x89+\xbe\\p\xf86\xae\xc4kV\xc0\xcf\x12P\xc53r\xda\xc9 \xd9\xab\x8a\x1ao\x87\xd3\x17\xcc\xe0\xbaz\x8c`\n\x97\x12\xef\x95\xe3g\xc6\xd4D2c\xbb\xd3\x90\xaf\x85w\x80\xb7\xbe\x84S\xed}\xad6[\x1b\x0e\xdf\x97\xe4\xc8u\x1c\xf5\n\xcb0m\xef\xdb\xa8q\xf1\xdf\xb0sk\xea\x9b\x94\xe6\xf2\xd9\x18\xdbul\xf7\x02\x1bZ\xec\x06\"\x7fR\x91V\x00\x86\x04\xcc\xe2\xfb\x94\xc9%\x01\xb3:\xfc\x9c\x99\x9a\xf8\x8dd\x8c\x0d\x0fq\x9dT\x04\xdd\xe7S9\xea\xe7\x94\xcdd 	\x9a\xf8.\x8c\xb9\x13`\xad1\x10?\xae\x94k|\xe0\xb5\xaf\x8d\x1b\xef\xbdu\x03t\x0e\xbc\xec\xa9\xbe\x1d\xdc:\xe5<\x84\xdf\x9a\x9c\x08\xfa*\x16\xda0\x0b\xe6\xfe\x06\\\xe2\xad\xeb\x9b\x9f\xcdK\x17_\x91\xb4T.\xd1\xca^\x07c}~\x8bCU\x8b\xb3\xcf\xa4r\xd5\xc9\xde.\xfb\xc1>\xd9h`e\xeb\x14\xc4\x19NV\x9a \x9a\x17?\xaa\x13y\xc6x\xc7g\xe3Cb\xe0#C\xab\xd7\xa4\x94\xd6IE\xd0\xc6	\xf3`s\x81\x06\xa1\xd2!\xb5s\xb7\xe9<R\xf6\x9d\xb1\xdc\x9a\x9c\x08\xfa\x9a\xdb\xf2\xfaj\xb7c'M\xf0\xbe\xf8\xa0\xbd'\xefIz_V\xc5+\x19x\x1d\xdc\xea\x93\xf5\xe5\xad\x95 z\x0d\xbc\xe4\xe9\xb3\x0d\xd2L\x98N\x85a\xd9\xdb\xbc`\xac!\xbfGJY\xb9\xcc\xdc\xe9R\x95F\xf7\xef\xa9\x8d?qu\xf1\xaa|\x08\xdfK\xd0\xf9\x0d\xa0\xc9v\xba\xf3\x8b\x05Z\x7f[\\\xe2\x05k\xc7\xcf\xe2\xa5\x8b\xafH\\\xaa\x94h{\xaf\x83\xb1z\xc7\xf5\xefl\xbc\xd9\x87\xa9\\\xe1\xfc\xf8\xb0\xa9\xd6\x80\xb5\xf1\x80B\x0d\xcfV\x1da\xac\xdf\x89\xfc\xa9\\0\x9d\x0e\x7f\x8e[4K\x1e\xe8=0\x9c\xe9\xcey\x91\xb4n\x1aj\x9c\x8e\x10\x84\xaa\xdb\x96\xc8Y\xc7\xac#\xcf\xd9\xfbh\xe2'\x120\xf6Ii1\xf4\x01\x1a\xf4^\xdc\x05\xbd\x83\xf3qE\x00\xaex\xa5vN\x8f3gp\xb0eb\xc6\xd0\x07h\x00\xb3\xfe\x15.8\x05\xd3\x9a\x08\x9e\xc0\x93\xb1\x0f\x13\xc4\xdb\x19\x1c\xea\xe2)\x883\x9cx=\xed0.##\xf9\x1aM\xdb\x90\x90\x86\x87`:\xd5\x96\x85\x04?\x07\x1dt\x806\xf2[\x05\xa4\x89l\x8c;i\x89\xb0\xd5\xa6.\x19K\x13;\xcb\x93\xa5\xd8M\x9c2\x04\xe1\x9c\x08;\x1f\x9e*\xc3\\\x12\xb5E\x8d\xe4\xc1\x8fM\xb44\xb2\x1f\x92\xbdj\xcf\x1e\xfa\n\x87q\xcf7\xb1^=^\x8d\xbe\xed\xc6Y\x9f\xb2~\xc6?\xce\xf2~\xce\x10\x80P\x94-\x9e\x84S\x10FH\x9e\x86\xe6\x16\x93y|F6V9=\xaf\x04R\x02\x81\xd6\xa1\xa9\x12\x14_\x1b\x19\xd9\xde\xb4O\xa1\xf5F?'\xa6%\xa9$+\xae\xd5[E0~\xc6\xc2v\x97`8Gir\xe7\xcb\xd3\xc6	U\xae\xdb(\x86 \x93\xd9\xfd\x9c!\x00at\xad\xac\xb9\xa5\xb2\x8b+l`\xb7~\xd6\x1f\x9c@\xf2\x18KJu\x80\x1a\x07\x08l\xfa@M\xcah\\\xe2=\xd6m\xe0M\xefL2\xc0\x92\xca\x83\xf3\x083\x86\xb1\x8a\xf2d\xe41\xc9\xea\xeey\x8a<k\xd4yJLT\xf4\x8c\xf8\xee\xd4\x14\xee\xd0\xe1\xa4\xa2\xd9?B\xf6\xfd\x98|	A\xfe9\x7fb(y\xaf\xc4\x03\xfdi\xbcX\xdel\x02\xaf\x87\xe0\x1b\xaa\x89\xc5\xb5\x1d\x9c~\x11\x92'\xf3\xbe\xc5y^\x9f\x91WVR\xcf\x87\x81\x94@ \xf6\x1f\xa6\xfe\x9d\xc8\x9b\xfd7arsRf\xbe\x18\xe3\xe8N\x18\xe9U\xd97\xc5\"\xe8\xab-\xcd\xaa\x8c\xc7m?\x18\xfa\xb5\xea;\xf9\xc4\xf6\n\xfb\xca\xf8\x80In\xd48\x80Z\x8c\xad\x8a\xa5\xe9\xac\xe5k4mWB:\x1e\xbc\xa7SQXw\x95\xc5U{\xf6pR\xe8\x94\xd5\xab\xcbu\xabZ\x9a\x9cFW\xdd\xb9\xfb\xcc\xf5\x1c2J\xbd\xc2\x1b5\x0e\x98\xa7\x0d\xaf\xc4\xc3\xbd,\xeb\x83\x0bL`\x1f~\xdc\x1f\x9cy\x8e\xca\x8f%\xcc\x18\xc6*\xc2s\x9c%\xd5\xcftV\x9f\xd6N\xd0B\xb7\xda\xfcE\xc8-\xab\x94	\xees\x80\xb1\x86\xa6\xb6r-\x1d\x87W&\x7f\x8a\xd5\xe7g\xe9\x0d\xc4\xcf\xcc\xae\xa4\x84\xb5\xbd\xd7\xc1X\xf7|+\xe3C\xea\xe3\x05\x8e\xa7\xc3y\x8by\xd3o\x93L\xadH\xe5WMb\xed\xa1\xc6\x01K(N\x998w\xf78E\x1e\xab\xeac8\x8e\xae_);\xab\x92\xe6\x94\xf8@\xec \xc4\x9f\xca \xf1\xd3\xda\x9eA\xc7\xdfb\x83F\xb7\xae@\xc8z\xa5\xb4N*\x82\xd9,\x06\xa4\xf4\xf5\xe7qml\xdfO\xeb\x98\xcf\xe1f\x12*wv\x0f$\xac\xc9\x89`\xac\xdeoHW\x848\xfbW*W\xf7\xba\xf13Ou\xbc\xb3\n\xe6\xa9*\x17\xadA \x0e\xda\xc4\x16ltO~8\"\xc9\xf7\xc8\xc0\x1e\xbc\xd9\xcd\x1bq5\x9d\n\xc3\xeb\x005\x0e\xe8\xddH\xb6\xb4	\xc6\x9e\xb8\xce_\xed\x12\xadq{\x8e\x8eY\xe5;\x87\x05 \x86\xb1\x8a\xde\x99\xda\x9e@\xf6\xb5\xc3l\xed8\xd4+\xa9f\xdf\xb1\xda\x0b\xecw?\x05u\x841\xf0\xc0w\xe5\xf2O*\x17\x9b\xbe\xe8\xaaC\x85\xf1|Z\x89h\xd0\xf5\xa1\xc3IEZX\x16\xec\xe7rd\xcc\x04\x95\xb8\xc9\xbeo\xa6\xa0\x1da\xa1d\xab\xf6\xec\xe1$]\xb4 \xb4\xd9;1\xf8\xf8l%\x8a\xde\xc1k\x8c;\x0f\xa8h\xb6\x7f\x85\x90_\x81|Z\xf3\x9b\xbfE\x10g}\xca\xba\x99\xc0\xc3k\xe1\x1d\x80\xaa\xb1e\x03jjm\xdf\x8f\xea\x94\x17\xd1\xea\xd6\xb5n\xc9\xeeyv\x93\x87\xa0\xaf\xd7\n+d\x01\xb7\xba\xf3l\xaf5\x1f\xe0N\x8d\x18\x00\x12\x1f\x88\x1d\x8c\x1c\x82\x9fR\xc1\xd3U\xbb\xeb\xf8d\xe5\xf1\xd3<\x04\x06v\xd0\x01\xde!\x7f\x14\xba\xe9M\x14{\xb7_\xa9\xf0\x8e\xcd\x80-H\xfe\xa8m\x9d\xdf\x00\x9a]hq\x1a9\xb7q\xe0\xab]\xc4\x8b+r\x9dJ\x0d\xd9\x98\x01 \xf1\x81\xd8A*<\xc5\x9d\xbfL\x99\xb3\xf5\xb9\xad\xd6\x9a\x0f\x1c\xb0\xd8\x9ct\xb2eKx\x1d\x8c\x81\x17\xd4\x8c\xf2\x9b\x1f\xa6\xe2{\xbc\xb4\xea\xb7\xc5\xad\xa4\xe6$\x10\x88\x1d\xa4\xe2\xa7\x88\xf1d\xe0\xb5R\xc4\x8bk\xe5\x96\xf5\x8b\x04\x9f\xd6\xf2z\x08\xb4\xa1\x1f>\x9a80>\x7f/\x0f\xa2\x1d)+\x10\xa3w\xd7$\x19w\x9cD7\x19\x08\xc6*\xc5$\xb8\x86\xa7\x12B>R\xf9\xfb2\x1f\xc2u~\x97J\x0e\x81\x8a`\xf8\x82lx_\xde\x1e\xf0\x81$\xbcc^\\g\xc1\xad\x03C\xbf\xc4\xcal\x03\xaf\x87@C\xe9L\n\xad\x86\x07{k\x07\xfe\xf7Jy\x16\x8d\xaeP\xe5;\xb7>\x1c\x04\x1d\xe0u\xcf\x95\xfb\xbd\xe1\xec\xaa\xb7\xd4\xc1G\xc0V:@\xcf\x88\xcdO+\xe5Z\x1dN*\x82)q\xcaB\xd3x\xbd\xff\xd4\xb5\x96Y96\x80`>\x0d\x8c\xadV\xd1[\xb5g\x0f\xc17\xe4\x14\x8b\xaf\xe0\x14\x805\x11\xee30\x06\xbe\x01&\xa1j\xa7k\xda\xb7&\xf6p\x12\xd3\x98]\x9a\xb7y\xc4\x1e\xebW*\x7fs\xa6\xccN\x99\xf08\xb3\xfb9C \x0e\x9fM0\xcf2x\x1a\xf3t\xffD+\xce\xfa\x96\xb5w\xce\x8e\xc4l\xd3P\xe3\x80\xdcU\xf9]\xb7	\xa9\x0b\xac\xc0n\x7f\xf4\xabd\xd7T\xb6\x81\xd8C_.\x15qM\x9a\xbb\x15\xf5(\xa2:>\xc9\x84\x90<: \xf6pb\xd3w\xd0\xe3\xc6<]w\xf7\x913X\x9b\x16\xdb\x90<\xd1\x83\x18\xc6*\x9a#\x91\xaa\x80\xb1\xf8%\x99\xf0\xf7D\xeb\xaa\xfd~\x1b\xca\x0f5\x0e\xb8\xeb\xea\xbb\xac\xcc\xc4\x13\x8a5\xb1\x1d\xcd\x1e\x99 3\xc5\x8d\x1a\x07x\xb5\xf5a\xeb\x0b\xce%@\xba\xd6\xae+'\xf4\xd0\"\x98\xee,\xaf\x82\xed\x00j\x9c\xee\xf9t\xf9\xb3\xf4\x7f\x11\x92\xba\xea}ho\x11&+\xfb>\x858\xe6`\xac\xa28/\xadS\x08\xf05\xd9\xeeS\xd4\"\x96\xe8x\x19\xe3\x06R\xe3\"\x7fR\x11\x0c\xe6\xab\"Y\x0cg\x92\xf3n+=r\xa9\x8c\x8dO\x8f\x7f\xf6\x10\x88(\xce\x1cP\x0b\xdan0\xd7\xc0\xb7\xe2\xea~+<k\xd6\\\x87@E\x9a\xcfP\x95\xf1	\xe6\xee\x0cxj\xc2u\xd5\x8e\xbb&1X1\xe2m\xc2\x8ca\xac\"-\xb2\x02V\xcd\xc3y\xbc-.\xf7\x12i\xd5\x0cX[\x04K-l\xb7\xc8+\x10{\xd8\xc9\xf2\xaf\xd2\x1c\x18\xfb\xe3:[\xe9O\xceT\x03\xd6\x96,\x0e\x91\xb7\xd9\x97\x86\x1a{\xa2\xe9H\\f\xc2\xccb\xef\x92V\xb0\xce\x95@_\x9e\x06\x81\xd8Aw?\xf5\x81[\xf5\xb9Dk\xcd\xf5.\x18\xce\xf4!\x9d+x\xf6\x10X\xa1\x01\xd3\xe0ZZ]\x8c\xcf\xea\x07\xc4'\xc9.\x95$s\xad\x0e'\x15A\xb7\x7f\xf2G}N\xd9\x98\xaf	\x9f\x9cO-\x12\x83\x81oW6\x8a\xa4j\xd5\x9e=\x04V\x18}e\x01\x83\x93\x0f\xd6\x84M~w\xd0\xca>G\x9f\xdfx\xd6U\x0b\x02\x11\xcdG\xaf\xb0f@\xde\xe8|\x06g\x1d\xeb\xe2gf\x19R\xfe\xa8\xb8\x04P\x03'\xed\x1b\xf1\xbf\xf9a\xd6\xb9\xcfK\xab\x1eX\xd7\x93T\x9d\x04\x02\xb1\x87\xfc\x1e\x89\xa4zEP}u\x06io\x1d\x1fe\\~\xab=S\xab\xa8\x81\xd3 E\xdc\x85\xc3\xf9\xc5\x8d\xfa\xdc\x8ak\xcd\x13\x10\xf4\xe7\xf7\xcb\x82U\xcc6\x0d5\x0e\xe0\xdcH!\xb4\x0f\x8c\x83q\x9d\xbf\xd3\xef\x0e*}b\x87\x85\x05y-\xc76\x82\xb1F\xb0<\xb5\xb4\xb1\xe2\xc7\\\xca\xe3Gef2\xbb\x02\xb1\x87\x00\x84b\x15\xd9\xd7:-\x06HL\xb3k\x92\x8c\x95\xce\xe2~\xf6\x10\x88\xa8\xfb\xb0\x0f_\xebJ\xd1\x9e\xc7\x92'Dw\xb2{\x9a\xad\x18Af\x92\x1b5\x0e\xf0*\xb4\x01\xeeJ\xbe\xb7\x18\xf8\nA\xc6\xba\x03\x92*?\xb0\xfa\xa5\x8f\xbc\x03\xd0\x06\xfd6b\x97o\n\x1f\x05\xbc\xc5_hX\xab\x98\xad@\xec!\x00a6\x8c\xf0Z\xdf\x0bko\x95\x98x\xaa6p?\x1d\xc6*\x82\xb6^\xbd\x05\xb7\x9ei\xd9~\xac\x9f\x81\xe7\xd6\xd5\xcd+]f\x89Ub6\x0d5\x0e(6|\xa9\xad\x0c\x0c\xe4)\xe1ai\x89Bl\xa7\xc5\xeb7\xf9\x95\x0c\xbc\x1eN\xe6;\x11\xb0&\xfd]\x06\xd1\x8d\xc1\xb9f4\xff>\xa3\x0e\xc6\x1a:\xb6Y0\xb48\xa8\xe8\x1am\xa2\x02k\xf1\xc1\xc5T\xad\xa2\xc6\x01y\xc8\xf1S6\xfbk\xccQ\xbf\xa7\x1e\xb1\xf2\xc0\x0b+\xdf\x9eZr-\xbc\x03\xd0\x0c\xc7G\xc6\xd1Z\xe7\x17-\x1b\xfa\xd6M\xc6Kj<#\xc8\x9d|\x1cs0V\xd1\xec\x93\x04\x07\xdf@<S\xa9\xda\xc7\x9c'\x94\x0f\xe6n\xa7\xc7\x9f\x08\xc6*\x9a\xfd\xccb\x072\xe1\xa9\xee\x15\n q\x88\x81\x7f\x90\"]\xa9\xb23\xf3\x0e@\xb3[\x04\xa7\x97\x05Iw\xfb\xf7=\x0e\x8bh\xe3\xa8O\x0c\xf6\x8c8\xe2t\xef\xe7\x0c\x818\xcc\xeb5\xb0\xaf\xc9\x9b\x19\x02pW\xda\xa1M\xa1\xad\xd3UG\x18\xab\xc8\xccG\xc2\xe6\xbd\xc0[?\x11o\xad\x95[V<\xa3u\x91;\xa9\x08\xfa W$&\xad\xa8\xbd\x0c\x05=q\x89\x81oW\xae=\xd5\xe4Zx\x07\xa0\x19R\x81\x0d\x99\x1d\x07\x9d\xd7\xdc\xe3\xd2v\x02\xc1>p\xf5\x8e\xf6\xb3UG\x18\xab\xc8\xccw\xf94\x02\xdcH\x07\xea\x16\xf5\xe8\xfb\xc1$\xf5\x8e\x10\x8c5\xe4\xb7\xc8~(\xe4:i\xd4;\xd6\xe1\xf79\xc2O\x15\x15\\\x1e\x80\x18\xc6*\x826\xf1S\xcc\x06\xf3\xf6\xf0\n$\xeex\xdbW\xadP6\xa0.>h\xbaQ\x06^\x0f'3N8\xba9M\x14\x87:\x8e\xd4\xc7#-\xab\x14oU\xaf\x87@\xec\xcc\xd5\xcd\xebV{\x17\xd9\xda\x8d:\xe5\x01\xee\xd4_\x95\xfd8\xe0\xa4\"\xe8\xf47\xdf6Oo\x0f\xea@\xe2\x8e\x95\x13\x08{\x1a\xcfZ\x11\xc4K\xa6\xae\x85w\x00\x9aa/\x9f\x0ff\xc2A\x97\xc0{<\xf9\xa0=J\xb3\xd5U\x0b\x02\xb1\x83\xfe\x8e\xcb\xb2n-\xf5\xfb\xaciM|F\xee\xa7\x92\x04v\xec&\x0f\xc1X\xf7\x8cf\xe7\xd8{\xf2\x95\x11I1TV\x94\xcf\x96\xe3\xfb\xd5\x82\xd7\x01j\x1c\xe0\xad\xeb\x87\x85\xd0\xd7\xd6\xad=S\xf0\xbb\x83\xf6\x8bq\x82>\xf2\x0e@\x93\x1d\x16\x97\"3\xeat\xd6-\xdb\xb8y\xa3\x1b\xc21\xee0\x81@\xec`9\x9f\xeck\xdf\xb9O\x8e\xff\xea\x85M\x93L\xadH\xfe\x13q\x8d\x0f\xd0\x0c\x19\xd9\xbe3\xff:\xdc\xf9\\\x83s\x7f\x7f\x84OuH\x93\x8a`\xacy\xb0\\\x9d\xebp\xe7\nm\xf2\xfef{Z\x8c\xc8\xec\xc5\xa9:\xbf\x01\xd4\xd8\xd3\xfa\x8e\x9c_\x133\xa0\x8eV\x95\xc8T\xeb-\xf6\x1b\xab\x0c\x11\xd7{@\xec!\xd3\x93*c\x94\x89,}\x96\xd5\xe1\x08\xba:\xd8(\x95m \xf6p\x92NR\xf8\xd6\x8d.9\xb2^w9|\xcf'W\xb2\x86\xc1\xdfA\x07\x1d\x80&;eH\xee\xe5'\x8b\xd1\xd8\xa1~q\x03\xbfa\xe3\xa1\xab\x16\x04b\x07\x9f\xf6\xd9\xb6\xdf\x00\xe7\xfaL\xc5\xdfl_\xb2<)\xe2W\xa9\x8b=\x04\x9cos/N\x9c\xb2E\x0dtv\xd5U\x1bO2\xd5\x8e\xb8\xdf\xf9\xeb\xea\x005\x0e\xf0z\xf2\xc3?.wN\xd5\xa7\xd4\x9cBG\xde\x0f\xd2\xce#\xae\xf1\x01\x9aaS\xdc\xf9\xe94\x97'\xad\xa4\xcd\xf6\x03\xbf\xacB[\x18\xb7\x08\xc4\x1e\xfal\xaaWJ\x1e\xce\xe3\xfd\xe5\xa2}\xff\x08\x96\xb8\x95\xa6\xaau\x95\x0c\xbc\x1eN\xba\x1a\xb1\xd3\xaf%\";\xb0G\xe1KN\xbd\x80\x1a\x07x\x1b\xca\x9c\xbd\xe2'?\x9dAbNg\x95\xb2\x85,\xa4\x81\x94@ v\x90\xf4N%\x9f7/pN]\xea\xc28>k\xac\x91}\x1b5\x0e\xd0\xe6m\xe3c\xa2\xda\xc4\x91\xb6\xdfUO\xb3*Se\x8c\x05'\x15\xc1  \x04\xb3Y\xbc=x\x07:\xaf\xde\xed%<\xbe\x8fS\x10g\x08\xc4\xae3I\xd7\x16\xbcy\xeaT\xeft\xddJ\xb6\xf8$@\xb7\x03Pgk\x17e\x03\x85\xdc\xa5f\xae\x9f\xf0\x99\xba\xb5\x12p\x80\x94\xf3\xa8\xeb\x00j\x1c0\x95\"\xbf*{wo Z\xc5V\xb3\xc4/\x19\xc2[@\x8d\x03\xd0F\xafJ\x04w\x8f\x9f\xbb\xbe\x91\x0c\x91\x8c\x84\xdf\xc9	\x11\xde\x01\xd48`J\x0d\xb1\x90\x8a\xee\x9e\x9ch\xe5[\xcdD_2\xb9o\x015\x0e@\xed\xf8\xeaL6\x86\xb3\xf14Hg:G\x85e\x9f\\\xaa\xf3\x1b@\x0d\x9c6af\xab{\xf3\x95q\x94\x1a\x8a\xf5j\x973\x13\xd6(X\x94\x8a\xeb\xa4\"\x18\x03-=\xaf\xd3\xd6|\x8d\xcc6\x18\xc4\x891\x8cu\xe7\x01\x15A_\xff\xd2\xec#\x93\xd8\xd5\xb7\x0bi\xe0MK\xfcD\xc2u~\xb9\xc8\x9fT\x04c0O\x95F\xfb\xc9\xb86v\xf8[\xd8\xf3W\x9b\x98h45@\x0d\x9cr:R\x8e\xda\xd3\xdf>Q\xc7g\xeb\xd6<\xc16\x19'\xaf\xc9\x89`\xac\xa2%\xf0\xeaU\xdc\xbb{\xbf\"C{h_\xf4%\xa3\xfb\x16P\xe3\x00tL\xbd\xfa`\x87\xd5\xe7\x0b)LdC\xc4I\x80\xb1\xc8\x10\x9a\xa0\x83\x0e@\xb3\xbd/\xacK,\x12\x8cE\xf6\xe8\xbdX\x1ax\xe5\x01b\x9f\x87@\xec v\x9eM\xaen\xbc\xb6\xdbF\x14\x8fg5\x08\xfb\xa8\x966|\xab\x08\x16\xf3!\xec\x02\x0b\xa7\xd0Y\x03\xa1\x90\x94@f\xb8<\xe2\x1a\x1f\xa0\xc6\xf5\x9dk\xaf\xa3\xcb\xb1H{\xaa\xfb\x81\x153\xa5Z\xc1\xb3\x87@\xec?\x99\xd2$\x99l\xaf\x9dPHA\xab%`\xb1\x9dn\x9c\xadF\xf6:\x18\xabh\x8e\x8b\xf9\xfcR\xbe6z\x93\xe7\x05\xa9Z\x9ed\x87$\x80\x1a\x07\xb4\xb1\xc8^L\xce\x17\xbe\x16\xc9\x99\xfa\x8c {\n[\xf5\xe9&\x0f\xc1XC\xdfaL\x08}\x83\xb1\xef\xad=\x9bK}#2r\xb6W@\xec\xe1d\xa4\xc9\x90\xb3\x1f~\x16m\x83t\xa6\xb3\xe6*v\xe1\x04\x9d\xdf\x00j\x1c\x80\x95v\xb3\xca{w/ 2\xac]3\x90IjS\xcc\xc1XE0#.\x96\x92\x85\xb7D\xef\xf1\xd9\xba\xf57`%\x92\xdd\"\xbc\x03\xa8q\xc0<\x99\xf1\xa9R\xbe\x86\xb6\xe6\x84\xe7rV\xbfz\xf6+\x19x=\x04B\x18v\xb5Su\xad\x10\x1a$\xc4\x89L\x02\xa7\x94\\\x0b\xef\x00\xd4\xb8>\xe1\xedb\x90\xe9a\xb4\xca\xeb}\xac\x06\xe9\x17\x97 \xce\x10\x88\xdd\xdc&/\xf5\x01\xaf:\xed35\xa1-\xe1w\x9a\xe9Ek\x10\x88=\xc4y\xfe=4\xa1\xc1\x9c\xbf\xda\xa7Y\x03N*\x82\xc1\xbc-v\xb5H/c\xea~\x96\x98d\x1aU\x9b\x03\x97\x06 \x86\xb1\x8a`0\x9fr&XE|v?k5H\xbf8\x05q\x86@\x1c\xb4\xba'X\x9d\xc9\xf9 \x86\xb4\x9c\xe9	mqbdn\x91?\xa9\x08\xc6Z\x12\x0bS\xdd\x0d\xdc\xa4\x83\xaa+\xe2\xb3\xc6\xc4l\xad\xde*\x82\xb1v\xc7\xfev[\xe2\x1e\xcd\xd7\xc0\x9b\xb2h\x93\xf9\xc0\x91\xa9\xbc\x02\xb1\x87@\x08cI\xe6\x078\x05\xfe\x1ap\xc1[&dF\xb4\xd7\xea\xad\"\x18kw\xe26\xf5\xfap\xe4\xb4\x8f\xd4'i\x13$\xdbU-^\x07\xa8q\x00\xea\x16\xa4\x05\xfc\x01'?\x93\xb4'\xf3s\xb8\x87d\xec\x15\x10{8\xc9\xbf\xb4\xfapy\xd6H\xdf\xba\x14\xe85b\x11\x8cU\x14g\xc2\xb4z\\\xf0\xd6T{\xaa\x90\xc2\x9e\xa5\x92M*\x82\xb1\xe6\xb1v\xd1\xf1Q)\xdb]o7OM]I\x0b\x02\xb1\x87L\xe3\x85\nL\x7f=3#>\\ms2\xa7\x86\xf0:\x18\xabH\x83\x06U)\xcf\xd9\x8f)\x95\xf3\xd7I\xf8Y\xe2\x8b1nM\xec!\xe0\xbb\x92\xa8\xea\x1cl\xc6\xfd\xf5\xac\x08T\xe7\xa0\x886\xa6\x81\xc4\x07b\x0f'\xf5\x8a\xa1\xdb\x8c%\x1aW\x82\x9f\xe2\xe1V\x18n\x8a9\x18\xab\x08\x96\xe8\xd9\x98\xdcd4\xfb\xcf \x9d\xe9\xacR\xd6\x97y7\x90\x12\x08\xc4\x1e\x1a\xfd\xc8\xad\n\xd1\xdd;\x88V.\xb8\xbf\xe2\x97t\xe0-\xa0\xc6\x01\xe8\x00\x9b\xf8\xd4^w\xe2\x18\xbf\x9a\xefy\xdbb\xf9L\xb0\xd6\xe4D0VQ\x9c_bd\xe6ZW\xc6\xd1j\xf6\xb7\x85\xbe\xa4\x01o\x015\x0e@\xe3T\xc7O]\xb9\xe3{\x95V\x805\x95\x87`\xac\"\xcd+\x1e\xb2\xbd?\xed=	Z-\x02\xfbi~\xc9\xad\x17P\xe3\x004N\xdd\xef9\xfb\x13\xa1\xd5\xf1\xba4\x82%\xbeV\xfb\xd2P\xe3\x00m\xa2\xddh\x94\xfa\xfbc\x1bQ<\x9f\x96\xcbJ\x01\xff\x08:\xe8\x004\xc3\xac\xb0\x9fQ8\xa3\xdb\xbc\xe2\xb5\x9b\x7f\xa6\x08(-\xf6y\x08\xc4\x0e\x1a	\xaeP\xc2\x1d\xa3\x18\xc5g\xeb\xd4\xa2\xc0\x02B\xa0\x82\x0e:\x00\xcd0\xeb+\x8a9|\xd0\xc5\xf0\x17	R\x9f\xa8\xe59vH\x02\xa8q\xc0\x95F[&\x9d+\xce_\xb16:\x93\xces\x005\x0e\xe0b>\x8e\xb0\xe8^\x03\xfb\xe9\xe6\x15(@\xbcJ\xbe\x03P\xe3\x80d\xb4\xad\xb3\x16=\x97)\xf86N|\xf6\x88\xfd\xf50_\x08:\x00\xcd\x8e\x9c4F\xdb3\xfb\xb3T\xa3\xd3\xf5\x1b\x85\x91\x85\xee\xbd\xc8+\x10{\x08\xb8Pd{!\x85\x93\xd7\xad\x01\xdb\xc6\x81\x14\xd0\x95\xbdVo\x15\xc1X\xd7b\xe7[\x19\xb4d\x8fDP\x8br\xdc\xcc\x1d\x84@\xeca?\xff:\x99\xd8\x89\xdc\xe8\xc6~a\x07F\xb4W\xf0\xec!\x10\x91\xf4)\xdeZ\x97\xe5\xed\xe8E\xb1\xc4\xae\xcf^\xb7\x84di\xcczU\xce\xb0\x90\xb6\x93\x90u:\x10~i\xf6\x0d\xcd\xed\xf4\xea\x9eT)\x8a\xfc\xa6\x01q\xbd\x9e\x01\x04\x9co:g\xb6\x96N\x10\xe8*\x90\x18B\xa9\xbf\xa0\xa2\xbf\xe9\xd5\x1fv\xed\x82\x11\x1e\xd0&\x02\xb6{|kg\xeeb\xd7\x12\x93\x0c\xd8\x11\x9b\x9c\x19<\x97\x8f\xef\xa42q\x7fT\xfaZ8\xfd\xfa\x85\xaf\x96V\x16\x01H\x8b\xbc\x00\xb1\x87\xd6\x92W\x02\xba\x1e\xfcD\xe6<\xf30\xea\xa9w\xedI\xf6\xa8\xbd\xf2#\xb0\x05)\xa4\xdd\xbb\x1ex\x92\x16\x8d\xb9\"ea\x04\xb9\xf8j\xbf\x1c\xc1\x97'\xc1\xb5\xdb\xdd@ v\xf8\xba\xa2\x80\xb1\xe8W}\x95\xac\xf6\xfa\xd6LxT\xcft\xab\xec\xda\x0f\x96\xe2\xb7's\xa7\x98M\xfc\x1c\xc9\xad9\xbdx|\xdb\xbf\xfa\x95q\xf5\x1a\xff\xbc\xae\xc0\x89\xca60\xae1\x1e\x02\xb7\xed\x12\xdat\xd4\xb8>NiaT\xda\x1bc\n\xb8a\x11\xef@Zf|!\xfc\xa4\xdf)\xcf[\xb5(\x9eO\x03cW\xd2\xe3\xe49+\x13ne\x1f4i|\x87\xba	jq\x86@\x0e\xb9\x8c\xf8\xd6-:*&\xdc\xeb\xf1G\x04\xddF5\xec\xa7\xd9\xbc=\x8c\x03](\x82\xc5G\x1f\xeeq	\xda\x18r\xd5-\xb2$%\x8f\x18s\xa5\xec\xd9\xc2X\xf3@z\xac2\xfe\xfeM\xabd\xbe\x19y\xec)\xee\xe3\x17\xe7L\x85\xfevr\x05g\x10:W\xf4\x07%\xc8rbp\x1c)\xe6\x15\xd1M\x1e\xfbi\x9f\xd9\xf7@\x87\x84$\x80&\xbb\xcc\xa8\x94\xf3\x83R \x80|M_H\xf5\x99@tp2\x8f8\xb4\x9bz\xe0UZ4\xe6\xaa ^\x97\xf7\xc0\x9cW\xf4v\x89k\xe2\x1d\xe0-\xf1\xba:\x04\x8a\x19\xf0k\x19\xd0a\xa7\xef\x02\x06\xee\xc8\xac\x92\x07\xc7\x9d\xd9}E\xeei6\x95\x1d\x91\xc0\x89\xed=!~\xfa\xf4;\xf5\xb2\xe5\x9c8\xe6`\xacy)\xbd3\x80\xbc&\xf7\xa8\x03\xd92U\xd1{\x1d\x10\xe1\xb91\x8d}*j\x11\x7f\x84\xed(\xd2\x1bS\xd3\xe4\xb9`\xc2{;D4\xa9\x08\x96\xe8\xce\xafZ\xa9\x0f\x96ey\x96\x85\x9e\xc3\xf9l\x92ao\xe3L\xee\x1a\x8f\xe0P\xbb\x86\xfe^\x03\xfec*\xbb\xcc\x98f\xc4m\x01`\xedo\xbdk\x98p\xe0\xd1Z\xbdU4\xc7\x15\xf1\xba\x13#\x8fh\xba\xa8[\x1a\x02<\x92\xb0F	\xb4\xa6\xe8\xbb\xa7\xda\xffHi\xde\x7fi\xc2\xd5\xcc\x1b?\xc9\x07:\xea\x80\xed\x00\x9aa\x93\xd4\xab}\xb5\xbf\xd2r2\xe2\xd5\xde\x81\x8f\x10\xbe\xb9\x06\x9ef\xdd\xd8/lkp\xf2\xb7\xff\xb0I\xbe\x9d\xe9q$\xa9\xc7\x8e\x1d\x8cu-\xb6D\xc6\xb2\n\x9a\xf9\xded \xa9\xdd\xf3T\xd6PC\xb7\xe6$kM\xa4\xd6\xf5\xbe\xc5\xd8\xe7\x96\xc2\xfa8Q\xde\x8b\xb1\xcfC\x00B\xc7Y\xbf\x15\xf1\xf6N0\xe9\xc1\x93\x8d\x19\xbdN\xe6\x8e\x88B\xbb\xeaY\xe1\xc5p\xc7q\xfdd\xc1&\xbb\xc9F\x10\xce\xbb\x1d}\x85\xdej\x85\x90\xac:\xdf\x05&\xf5|\x9b\x81\xab%`?\xbf\xadJw>K\x95\x18\x1bex\x0e\xfdg\xac\"\x98w/\xea\xf4O\xbc\xf84Z\x1c\xaa\x8d8\xfbF*\xd9\xe5\xcf_\xeaC\x10\x03Q\x90\xf0O$\x0fX_\xc4>\x0f\x81\x86~\xce\xfb	/4m\xdf\x1ev#\x8f\xf2y\x17\x7f\xb5<\xf0\xac\xcc&\xab\xe2\xad3\xa1\xd2X\xeb\x92O\xa3\xbf:\xde\x01\xde2\xe4e+e\x8f|\x8b`\x13\x9e\xe2\xc2m\x937\xd1\xbaY\x95\xbf\xb8\xaaK\xe1\x11\x9c\xb9J\xbf\xd7=\xb0\xbd\x1eN\x80$\x99\xe1{>\x13L\xf4\x9a\x99[\xec:\x9fg9\xf1\xec\n\x13\xcf}$X\xfa\xc3q\x17\x9d4g\x07c\x0d\xe1.\x15\xd4\xad\xec\xc1\xfd\xf1[=\x0e3C\x17$`<{A\xf0w\x1f\xad3\x01(\xfcl\xc9\x8e6\xeb\xd3\xe0\"oR\x11\x0c\xb69<\xfd\x01;W\x86\xfc$F\\F\xf7\xaa\x04\xdd}\x80h\xd5\x98\xe4\xeb}I\x15\xde\xc2T\x06\x04\x19\x0b\x0f\xa2UG\x18\xeb\x9et\x1ar\xbd(\x1eA\xa6\nj\xe6\xf3\x17\xb1mD)\x8fD\xb1\xeb\xaf@\x06\x9e \x9a\x81\xfdx|\xea-JI3\xf2&\x15A\x1f\x8fS\xd28\xa7z\xbe\xc3\xe6\x9e\xd8c\xf3t\x93-\x05\xb8\x8b[\x114\x17\xe3\xdf\xda\x9c\xb8\xbf\x91\xdb\xf2q\xee\x80\xd8CI\xff\x9an/\xadS\xaeR\xdb\x96l\xf2\x0c\xea:\xe6O\x95\xa4u\xc7\xdb\xc6K\xcc\xab\xcb\x94\xaf\x8ew\x00\x1a\xa6\xf92\xa5\xba\xd5|H\x8c\xa7L\xb7ir\x8bz\xf4\xd4:3\xce\xad3-\x04\xf1I\x18\x13\x8cU\xf4\xc9\x130\x91\xc5\xd5\xa1y\x8arbV#\xeft\x9dF\xbd\x9f\xbe>QO\xf3{|\x8e\xd5\xa2\xa95\xb1\x11\x8c\x01\x95|^\xf8\x897\xcb\x02\x89\xc0g\xc5\xdc\x7flQ\x9e\x17\xb6]U\x19\xdb\x9f1Ou\x91p\x9c\x11\xfdM\x8b@\x9c!\x10\xbb\xcf\x96o\xf4\xf9\xae\xf5)5\xba1\xf8\xfaabhc\xba\xeb\xddz\xcb=#\x82\x17yw@\xec!.1C)X\xc6\xe1\xdb\x94PtQ\x0e\x1fb\xe2\xa9)\xa3v\x91\xbb\xfa\xf7KCg\xae\x99\x11\x9cT\x04\xcb'\x9ek\xc6\xf9\xe9\xf4\xa9\xd4\xf5`+\xe6\x99\x1e=[f\xa5U:\xf7\xc9\xd2c\x0c\\\xbfF\xb79\x80f\xd8[\x85\xedF\xf6\xdd{\x1b\n,\x9e\x0d\xa8\x19\x97%C\x93]\xd9\x9b\xf7'Mn\x15\xf3\x82o\x15\x99\xbe\x99\x06W#\xcc\xa1;\xbf\x16/\xf1\xec&\xd7\xee\xd9\xc9\x1f\xa4\xb2\xdf\xc9\xd5\xf1\x0e@\xfb\xb4\xe174.\xdf\x9e\x15\x92\x91\x8ay\xdfk\x973	\xf6\x15iq\xecf\xe1\xaf\x19\x08\x03\xc0XEf4\xb5k\xae\xc7s\x9e\xbc\xa99\xd9k\x87%A\x1d\xdbY\xf1#\xc2\xad\xbe\x16\xbc\xd6P\xe3\x806\xd5\xdbp\x11Rl\x91=~N\xf6!\xdb\xf8Y\xa0\xe9\xa7\xd1_5\xef\x00T/\xdbl`\x8a\xd8\xccQDX\xdck\xaeS\xd5;]\xb3\xfa\x16\x12\xb3O\xb2\x9e\xa6\\\x19\x91\x1aH \x10;8>\xf0\x87\xcd5\xe9\xec\xda\xd62\x04\xc7>\xd7\xa9j\xdb\x0f\x81\xbd\x8d\xda\xb7\x0d\x1a{(\x81\xa8A \x0e&\xb95\xab\xcfFp\xef\xf2\x1c\xd7\x0d{\xef\xee'0\xe3\\\xc1\"L\x9b\x12\x08\xc4\x0e\xae\x8f\x0b\xf3\xb9\xa6\x9d]w\xc6\x97\xd7\xecU\xad;\xe9\xe4\xe6\xe7\x88 \x1b\xe3\x8c\xe5j\x05^\x0f'9\x8a\x8d\x16\x1aO\xdfN\x14\xea\x8d\xaf\n\x99J\xec\xf1|\xaa\x92}\xbc\x82\xf5^\"\xa1\x1d\xc9\xae\x13<\xfd=\xba!Y\x05g\xc6\x93kP\xb1K\xb7j\xf0*\x94\xb0\xa3IM)\x88\xf3U\xc5\xac|^\xb1\xf0\x89b\xb6\xdeV\xbe\xde6\xae\xdev\xae^\xcd\x8a!\x88\xaa\x92\xf0=z\"\xd3\xc3hm\xaa^\xe2\x95<\x04\xf6\x18\xceD\x7f\x93\xa9uF\x0dZ\x83k)\x1e\xcf\xad\xacmQ8I~nK.\x0e\xe42gt\xba\xcc\xa4o\x14\x81\xef\x89m\x98M\xbf\\m\x0c|\x9d\xb4\x82\x90\x04\xfe{U\xea\xc7\x83\xa3O\xaa\x02\xd1Q;\xc12B\xff&\x14\xc9\xa8+\x86c\x93\x8d%\x0d\xb9.K\xecQ\xef\n}\x1aiZ\xa9ij\xd3\xc2vhrfFy&\xb8YFx\xeb\x14\xe9\x91 T\xea\xce3A\xabu\xa1\xea\x9e\xbb\xd4\xb1W\xaa\x93&\xad\xd7/]\xf3\x83\xdeet\xd02\xa6\xc7\xa4\x02\xbc1\x96\x96\xd1\x19\xdf\xe3Z\xdc8\x11g\x8c\xa1\xb0\xae\xb0\x8d\xe2V3\xdf\xb4^\xbf\xe7+\xe0\x95W\xc0/\xdb\xd3J\x1d_\xdc\xf0\x8d\xb4\x8c\xbb\xe4\x9a'&\xe0r^\xe35\x8c\xbe0\xf0\xce2\xf6\xd7d\xa3\xe3\x8d\xa8W#$\x18=\xdd\xa2b\xcdOl\xc3\x1e\xfaV\xa1\x1bo\xfc\xe7ln\xc4	\\\x80X\xfdG\n\xcf\xbb\x90K\xec3\xb86z\xd9\xfb\xd4\x8d\x84R\x17\x0c\x1b\x10\x8cEX\x8ca\xd1\xa4^\xef\xf8\xe8\xad\x84\xae\x1c\x99\x19\xa5\x05e\x04\x92*e\xce\x19k\xa3\x83\x07\x8c5\xa7\xca~\xfc\xcew\xef\xa0Lq\x95\xd5\xba\xc6\x07\xc7\xfbA\x84I0z\x04\x0d\xf2\xe1!\xb0\xcaAtB\x8e\x95YM\x8d\xceo\xefW~\xdfJc\xe2\xce\xd7\x8e}\x0b\x9b\xeaC\xc9\x18\xdaG\x16\xd6\xbd \x9c\xb44\xc7\x16/}\x96I\xf3\xf5sb~y\xa6\xa5\x07M;\xeb\x97\x7f\xbc~\x1ex\x93\xd2\xb6\x12\xdf\x01\x7f\x1e\xf8f\xb5\x1dca\x98A\x98\x15\xd9[5\xa6\x04\xcf\x8b\xa2\xe7\x81\x0c\x7f\xe7\xbe\xbf\x9d\x0fF\x06\xdb\xcez\x1cx\x11M\xcep\x1b\x89\xab\xf3\xb9{\xc1\xce|\xee\x86|\xc0\xc7\xd5\xb1K#[,\xbd\xfa\x9c\x11<s\xc5\xb9\x7f\xee\xe2:uA\xf0\x1b\x99\xc8\x1a5\x9e:Y\x10\xf4\x85\x83\xb9\xd3|\x9d4\x06\x9aLe\x9a,:\x87\xb1\x16\x9e\xe0\x82m\xcd(j\xfdZ4\xe9>\xcbJyZ\x15\xf9|\xb5\xf8\xb3\xfed?&p9\x02\x08\xd7C\xf8\x9d\x98\xde\\\xf8Mi\xa69~s\x81t^\xc7\x85\x11_\xae\x92\x97\xcb\xa2\xed}\x8eo\x0f\xe3\xc6W\xc9\xed\xdf\x83o\x95\n\xab_\xa2\xb9b\x81\xb5\x9aYM\x96\x07ka\x89\xb8-i$%sx{\x98\xa0\xff\x88p\x15/b\x96\\\x1ag\xc2\xd9\xb3\x1b\x8c\x03gx\xdf\x03fndV\x0e\xc4\x0f\xa3\xaa\x17AN\x1dc49c\xb4\x1b\xf3uG\x9a\xa5\xd8\xfd\xa3\x12\xac\x92f\"\x16\xd1\xec\x91b\xab\xf1\xa9\xe62\x0b~j\xea\x98\x8c\xd1\xe6\xa4\xdb=\xbf/f\xdb\xfcgE\x96lX\xe5\x023Kg\xc5\xb8q\x86\x85\xc1[\xcbk\\}\xd5\xf1.0A*=?\xfd};i\xf56v}4\x80\xb5_\xfb2V\xd6\x07\xe3$*\x96\x9f\xfb\xac\x9a@\xebB\xb3\x1d\x0b\xda\x1b/\xae\xe0\x87\xb3\xef\xba\xefC)\xc1e};e\x8d\xdd9\xceU\xb2\xf8rT\xf1m\x88\xd5\x92$\xd1>3\xc9\"#\xe5u\xad\xbd\xe0\xc5\x1e(>\xab\xef\x88\x1a^\x88A\xc6*\xcbh\x0e\xce\x18\x0e,\xb9UX\xf4\x12\xdaX\x16Z2\xdcr\x92\xe8\xfc \xd1\xdf\xebw\xec\x190DF\xfa[C\xe7\x91~}\xc2\xb2\xea\xc8\xfa\xf2\xd1j:I\x1b*\xd7\xef!?f\xc2\x98\xa4\xcc\xf1;\xb8(\xd7S\xcf)?\xab]\xce\x90Y\xdd`%\x12\xfa\xa7\x94?\xc4\xe2}\xce/)X\xecSbTJ?\xa9(r\xd2t\"\x1e\xac0\x90\x92\xf0=%\xc1$\xef\xcew\x12\xfd\xbd\x1a\x1f\x9f\x11r\x9fL\x87\x91\x9c\xd6\x8b~\xd57\xf8V/\xe7s~p\xea\x13\xe5\xf3/\xfbn\xe5\x18N\xe9.H\xd0g\x0d\xc5\x0eUT~\x05q\x15d\x93\x9a7J\xc6~\xa5@\xa8\x93\x94\xd9\xcb\xa9sZ\xe7\xbe\x8f\xe1\x93\x96\xf6R\x1d\x8cX\xad\x13\xa1\x93\xbf\xe0\xf9\x00&9.7!\xef\xf7C\xd9)F\xbd\x9e\x96w\xc7|\x9d\x99\x9cB&\xb5\x1b\x99\xc6\x16\x11\xc8\xc7\xe3k\xca\x8c\xe3\x1dy1r2*p\xca2\\\xe5W\xd5p\xdf\xfa)b\xd4\"\xcb\xf1\xb7\xf4\xec\x97\x12\xd5\xad\xc2\x90]s\x17a\x99H\xea\x8fQ\x1e\xf4yt\x9d\xf3\xd5\xc33>\xf6\xcd\xc4\x04\xf5\xbdIz\x87u\xcd\xa8a\xaf<.]5\x10\x05i\xfdz\xb4\xaeZ\xe6j\xdd\xb8\xc7:\xc7\x01yZf\xec'\xb1\xa8]f\x96]\x14\x0c\xba\x9d\x95\xfc\xd3\x7f\xdc2H\xc1 w\xe7\xf3\x85NjN)\xc5&\x1c\x133\xfbS\x0e1\xc9^./)\xd9\x07I\xbdd\x90\x82'\xf5\xad\x1e\xd5d\x14\x8d\x16\xe8\x1a=\\c\x1a\x12\xafB\x89hF.\xae3\xda\x9f\xea%\x13\xcf\xe0\xea\xc9_HV\x9d\xefp\x8am2z\x14~\x06\xdeb\xd8o\xdd\xeaZ\xc9XV\x1d[\x8fP\\\xa7\xf8\x9dl\xae\xe6\x8d\xe0\xc0\x14\x96V\xf1z\x95\x05R\x11\xda1o\x8f\xea\x12Lr\xee:?\xd3C2^\xca\x8a\xf9\xbe|\xa1P\xb7\xc6o\xddBi_O\xfc\xab\x97\xdb#\xa6\xa5O\xa6\xb9\x99\x19\x1e\xa44\x84A\xfa\x92\xda\xe1\xe0&\x9a\x8c\xdbE\xb2_\xf8\xeb4^\xed\xb9w\xb5\xc6\x95\x12;ec\xbd\xfa\\\xf8\xe8N\xf5I\xbax\xf5\x1dX$\xd5\xc1#,\x1b*\x14\xa2\x89P\xa7Of\xbb\x1f\xc6\xf0c2\xfc\x8d\x1b\xc4?n\xa6\xe5\xe4R\xe6\xf4\xef\xeb_!\xb8k\xff|S\xfe\xf9|c\xbc\x01\x97\x99\x18\x12\xfa\xd4\n\x8c\x7f`\x99\xf9\x8e\xbd\xa1\xb9#\x16/1}\xbf3\x82\xc9\xea\x80\xef\x9f[&=\x1cF\x1b\xdc\xe1\xebC	\xcb\xb3\xdb\xcf\x98y\x8b`\x15\\\xd5\xc1fd\x02\xa2U\xf8~\x02\x17P2H\xb9\xe7!\xacgI\xa5\xd6\xef\xc5\x9c\xd8\xcf\xb3i\x19\xa9\xfc]\x1f\xa5\x1bF\x91\xe5\x88\xcc\x83\x0ds\xd2\xdd\x9f \xfe<\xa0@\x96\xd8\xf4\xb2\xfakb\xf9\xfek\x97\x18\xe6\x87\xd1\x07\xe3lA(cuH\xa4u\xbe>R~\x11\x9e_5Gq\xe3\x814\xe6J \xb5\x9dQ\x1e\x95\xfd\xc1\xd4\xf4d\xd6\xd9\x7f)\xfa\xee\xdf=\xc7\x9b\xf4ZK\xfbe\x94\xe7R\x8b\xa9\xcc6\xb3\xbc	i\x1akq\x0c\xfd\xdf\x92\x90\xd1['\xf5\xe9\x17}\x07\x18H\xd3\xd2\xff\xc4IP\xc7/\x06\xed\xac\xbc\xe6\xd5\xfa9D\x9d\x82\x9e\xb15\x12\xd3\xee3\x8a.*}\xfc\xd4\x8cM\xa7\xe9I\xea\xc3\xf9\xed~\xe4\xf1=\xc5\x10\xf3\xca_>^[}\xa5\xfa\xd9Z^\xbb1\xdf\xa1\xbcNk\xf7\xcbo\xaa\x9bn\xa0\xa4\x85\x17smq\xb9\xb8]N\x11\xff\xdd\x7f\xa2\xb1\xf1\x01\xa7\xa5\xa9C\x14\x14\xae\x1f\xbf\xf8\x14\x0f\xec\xe4\xc3o\x97\xde\x04\x87\xe5\x8a\xcb\x1e\xe1C\x19^\xa46\x0cg\xd5uI\xe0\xcb\x0fax\xbf+\xb9\xb8\x8f)\xc1\xf8+}\xb0\xd8\x93\xca\xa3\x8cp\xbb\xec\xa8i\xa2\x14\x16\xfa]\xc9\xc5}\xc8\xf1\xb7\xc2qF3\xdf\xa5\xb6\xd5\xe6\x15\x9e\xcf\x82\x9d\xb6M\xf2\xf6\xc9\xfe\xce\xcc\xf2\xdc\xcb\xa1\x91\x0c\xf1\x17	\xef\x8d\xc7\xec\xb9\xcf\x88\xafY=\x8c\x14\xbb$\x90\x10\x1f\"\xaco}4)\x9aJ\xfa]\xf8\xbc\x19\xdb\xac\xcf\xe8-RF\x9f\xfa+\xf4\xab\xe6\x9b\x8dM\xa0\x94\x8bP\xbd\xbey\xc4\x90\xd6\x14{\x1a\x833lI?\xcf\x17\xbf\x8f\xd6\xb4P\xd7\xab\xbe\x7f\xcc\xf7\xf7\xe9\xe7\x82f\xe8\x9f\xb3O W\xb9T\x92'\xa3a\x82P\xdaJ\xfeC\xd4\x9f/\x9e\xbc\xb3_O0\x11\x0c6kY\x18\xe18N\"\x1cz\x14\xa7*\xb4\xda\x85\x13\xa8\x9by(\x0f2\xd2^k\xff=_X\x96\x80n\x95\xe4@\x1fE\x0c\xbbZ\xeb\xf1\x16S\x86p\xfd\xc3/9\xfdI\x11\x82\x90j?q8g\xdc\xac\xde\xe6\xa7\x81\xc1\xab\x96\xb5\xb7A\xcc\xdca\x91/b\xcb\xed\\\xae\xf6\xd1\x92\xae\xfd\xa9X\xee@je\xe9\xa4\xf2\xcb\xd9\xf4yy\xf4\xeey\n\xfa\x945\xf1\xba\xe5\x1dc\xc5\xe1\xb9\x0c<5_\xd7v\xf2\xdf\x1f\x06\xe4\xdb\x8c\xd7\xe1\x9c0\x93\xf9\x92\xda\x03c\x04\x99s\xba\xfd\xfa\x82_Yd\xef\xd7I\x8eb\x1e\xbc\xdd\xeb\xc5u\x19^\xadO\xd0?\xa4U}`\x81\xa2\x81s\xab\x7f\xbf\xebT\xca_\xaf\xd1\\\x957\xf7Tt\xfd\xa5\x1b\xb7\x17*d\xb0}\x0f\xbb\x14\xf7-d\xc9\x10\x13\x0e\x86\xce\xae\xf37'I\x84\xa5\x1e\xa3\xfc\x12\x02\xc8\xd7\xc4M\x1b]\xfd\xc8\x8fS\n\x19m\xce\x03*\x0f~\xc5\xe6\x1f\x16\x8d\xd3\x93|\xd7\xcd\x9e\x7f\x87\xe0\x19\xd6%o\xcab{\xb2\xaf\n\x12n\xfb\x0b,\x90\xf5\xdb!\xe0\xc7\x14b\xcd\xcf\xe0\xeb\x80\xf9\xe0o\xbe\xfc\x11\xd4C\x1e\xf96,\xabv8\xfb\x1b\x13\xaaO\xa9\xa0~\x16c\xef\xa8^\x16&\x98\xe1C\x05\xb97\x92L\xc9\xea?2l\xab\xc9a\x18\"\xe3t\x86\x01\xce\xb5\x8d\xbf\xfc'H\x19\xf5\xf8@*\x98\xb1\xe7^\xb3<w\x8aBt\x8b\xfe\x85\xcd\x9f\xb2\xdb\xfd\x8b\xd6C\xf2[\x96\xb5\xbe\x1f\x0d\x1f\xfa	g\xcddA3\x07\xd7_\x935M\xef\xd9>\xed_\xf8\xa6\xfc\x1a\xb6\xb9\xeb\xb0n\x89\xc9%\xfdd\x89|\xec\x89\x97\xd7\x06J+\xf4\x1fu\xcb\x13\xe8\x14\n\xcd\x84xx\xbd\xf5n]\x15y\x8dl-n\xb8O\xa1R\xa7N\x06\xa7\x8b[\xce\xdd0zTd\xc1\xe1\xfc\xac\x0b\xb3\xacXI\x8d\xa2\x96\x1e\x1f/\x88\x8c\x96\n|\xe1\xc9\x98Wjab\x11}\xef=\xf0u\x83)\xa5=\xd2}\x8cJ\x9a{*\x96v\xfa\xbd\x84\x87K(]\xd3\xd5\xf0\x97\x0b\x85P\x97\x98\xa2\xd1\x9f\xe8\xbeb\x05\x19\xaf\x96\xf7=\xdbz\xe8\\\x9e\x06\xddS\x8f\xa8\xdfG|\xb8\xa9X1B\x19\xad\xd4\xd3\xe0\x8f\x9bl\xadk\x02\xd3F\xba?r\xa1\x1e\xb2\xec\x19\xf8\xaf7_'\x81aZ~\xfb>\x1d1\xc1}\xcc\xfck\xff0iM\x91\x1f\xf5G\xe6#V\x9b]\x1cg\x9b\xf9&\xfd\xa1o	\xfb\xe5J\x19,\x03j\xc6\x82Y\xd4\"`\x93\"\xea:\xebZ\xf4\x9c\xd3%q\xcf\xa8D\xea3\xf9\xad\xa3\x97\xb1\xc8\xbeN*\x0f\x8c\xca;\xbdu\xf5^\x9ak\xe6\x87\xf7\x8e\x0e\xd6\x87\xe1\xfeB\n\xc6\xe1'/\x1d\xa1.\x1f\xce\xaf^\xfcm\xc0\xc9oxv\xefO\xc4\xd7/\xa1\xf3\x01\xa4\x88\xf3\x83\xcf\x1b\xcfs'[p\xf0	\xfbCf(\xd1\xf8\x0d\x85\xdd\x85\x83\xde1jy<\x0cG\xfdz\xc4\xbbN\xc9\x9b\xc4r\xdc\xf5\xbe1zH\xd8\x93\xdc\xea2\xf4\x9e\xce[\xbb\xaaoxM\xbaVm\xe2\xeb\xa4\xbb\xe1!\xdd\x1b\xec\xb33\xca\x1e\xa1\xb2o\x9bZ\xfcp\x9b\x9c\xa0\x97\xdd\x05\xdb\x17yR\x0f\x06z{\xcb\xf8\x05\x1fH\xc8\x9fV_\x801U\xaf\x95*\xf9>#=|\x0dg\xa3m\xa9wW\xc3\xc0\xd5\x89\xdf\xc0\x12\xb1\xd9\x0d|\xe7Q\x87;\xfe\x8e\xa5\x90\x18\x95\x8bl~n\xb8\xd4\x14d\xff|~\xdf\xb1\x87\xcfW&\xda\x91\xf0\xfb\x91\x10R#\xdd~\x18]\xc2~\x9e1\xd5\nC$\xe2\xfb\xf7\x0cu\xb2\xea\xf0\x18\xd7\x7f\xb4m\xbc\xb7\xa0U\x1f\n\x8f\x91w7\x89\xea\xdb\xe0\xe2\xc8#\x19=\x8a\x17\x9b\x1bo\xf5\x9d\xc0&H\x7fl\x9f\xc9L@\x93\x1ev\xf0\x07\xa3\x01E\xc5\\P\xf3sV\xd0Sg\x18\x9f\xfe\xa8\xb8\x01#w\xba\xc5R\xdf\x0c\x1flAQ\xc6\x14;\x9d\x80\x93\x8a\xff>}\xf8\xec%\x94C* \xb1\xe7\xce\xb1<}\xdaDC\x06H\xed\xfa\x87\xf4\xa5\x87\x81P\x82N\xaaaP\x96	9p\x01\x01\xaeiM\xdc\xc0\xfc\xfa\xf1\xc3\xb0^Q\x11\xde\x81\x04\xe2\xcfCw\xc9\xb4K\x06\xaa\x9c\x87X\xc2x\"c\xb4\x03\xdbw\xd6\xd9-\xf7\x11\xa0j\xaa\xbd'xQo\xa5\x90\x0cP\xb8.\xae\x15y\xe9\x08{\xf9\xb9\x85\xef\xd6\xf4\xae\x83\xe9\x02=\x8bY\xf71\xce%cL\xee\xf7\xdc\xc3\x0b\xe8Z\x83\x89\xdf\x13\xff`\xa2\xb7M\x1b,\xf7\x1cO\xa6D\xa3\xdfe\xd8\xec\x1e?s\xcaP\xbc^\xb5\xc3\x0f\xd7\xc7\x9b\xfd\x1f~<\xe4\xd5tB\x1d\xbe\xbf\x02\xb2\x07\x0b\x0d\xceq\xb9:\x89\xa5y\xa6u\xb8(\xe0\xa7\xb2\xe1\xa4\x0d\x89!Y1~P\xe8\xda\xdb\xfb\x8a\xb2V\xbd\xb7\x857?r\xfa\x98\x85\xe5\xe2B\xe5\x89\x89\xb2\x7f\x8d\xdf\xf0\x0c\x9e\xe1\xd5\xe9\xda\x8c\x89\xdf\xf3\x83Y\xad\x16jt\x86a\\\xc3<\xc4\xe7:-\x14\xfdx\xd1\x9c\x83\x84\xf9\x95\x084\x83m\x87\xd5\xd6\xa5\xcf\xcc\xe1y|\x1e<\x17\x9a\x0d\x84CHu\xab\x9a\x90YJ\xd7_\x1dz\xde\x99\xdb c\xe6-\x82C\xf7\xa2\xf1\xb0&e\xed\x83\x07\xcf\xf0\xcc\x1bg\x8d\xdd{\xd1\x8cV\xe1\xc5\xbb \xc65p\xc9\x9c\x0e\xa4\x9e\xb1\x820BCGd\xe0\xbde\xc4\xed\xaf\x01\xf4zz#rC\xa0\x86\xcd\xe3\xd9\xfeW\x91f\xa3\xc2\xe1\xe8\x9a\x9b\xcfhR\xd1>\x17;^\xf6\xfep|\xa3\xb8\xa9@\x9b\xca\xe1\x1a\xd3X\xbd\xc22!\x0f\x81\xe9\x07\xec\x08\xf7_\xc20`>k\xaf4\x18L\xe8\xca\x87{\x9f\xdd;\x8f\xd9bi\xf9\x95*\xa4\xd5\xc8\xdd\xb6\xd3\n\xac\xf0\x06C\xc9)]\x0b\x9a@\x17WAm\xf3\xa6\xfdD\x0fA\xcb\n2~\xfd\x8c\x0d\x11\x83y\xde\xf0\xd3\xce% \xc9\x81\"\xdf\xf0qu\xea\x82~\xe8\xc8b1\x91\xf0\xd9\xb0\xe2[\xf6\x92\x9d\xa2\x14#}\xbb\xe2k\xfa\x01z\x16w\xc5\xd7\x8fz\n\xc4\xa4\xcb\x86\xe1,\x86\x96\xdc\xbaf~p\xa9Ua&&O\xa9;w\xe7\xfe@\xcc\xeb)\x81\xfa\x93\xb4\xc2'\xb5\xed\xa2\xe1?\xfcNtw\xc9\xbd:\x92\xf0\xf0\xbdIG\x7f\xb9\xd9R\xc9}\x86w\x05&\x86\xda<\xe9\xe5\xde\xb3\x0fU+\xcfB\x95\xd6\x18j\x89\x81\x1a\xeb\xcb\x0c\xaf\xea\xb2\xd52\x18\xcf\xa7\xa7G\xf1|\x82\x943u\xbe\xec\xfcr\x9f3\x93\xae\x9a>\xb8&uY\x17\x9a\x99i\xf2\xfd[q\xfaY\xa36^\x1c\xd2\xee>\xc1\xa7$\xb3A!\x9b\xce\x97\xddV9i\xdd\xa1\x02\x9eH\xd2\xe0\x9d\x8e\xbe\x1e\xb9\xec\xb1\xc8l'g/l\x1d\x84\xf7\xe9\xeb\xd2\xaaI_r\xef\x9a\xb9h\xbf\xcey\xad\xe15\xe9Z\xdf\xc8\x18s\xbe\x14?\xd6%\x8f\xc9\xa8R\x90J\xe6\xe8:5\x0e\xbf8\xdd\x7f;^\xe7\xc3\xce\x1d\x9c\xb6\x8fI\xef\xdc\xebC\xddC\xff'v\xe7\x8d\xf5Wg,\xcd\x1c\x95<\xb3\x89\xb7\x1c\xa2\xd8\xfd&l\xbc\xf9\x19\xf3\xda\xa3\xef\xbd\x07\xda\x94\xe8\xe5\x834E\xdb[:\xb2|U\xb8}>\xb3\xfe3\xd4.O\xc9L\xf3\xf6%\x85\xc3\x7f\xcf\x0co\xde\xea\x14{\xbd\x7f]\xd2rS\x06\x88\xe6\xfa\xe8\x7f\xa49bW\xa7\xce\x88\xf2u\xd2\x04\xe5\x85\xeb\x92Q\x8c}!\xc7\x86\xaeyw_V\xd1K\xc9}\x9d\x0f;\xb8\xb8z`\xd7~p\xe4\xb9\x92\x91\x15\xda\x92g\"\xbc\xb3 \xd6\xbb\xe8]2\xfc\x14mM\xe2{\xe6\x88\x95<a*W\xa7\xbf?\x8fqy\x97\xfd:\xbf\x8a\xfd1=i}\xde\xecq^?\xfbM\x88I\xa6\x9e\x91R\xbfd\xd8\x7f\xbb\xe6\x16\x93\xdf\xc5\x048NRlL\x92Y\x17i\xdb\xa3(YA~9\xb9>'\xe7\x8eQX\x93\xd2\x0eN;\x98 @nJ\xbd5\xa8}u\x942\x87\xd8<:\x9c\xf2\xca ]\xca	\xdf\xf9M\x95\xd3\xef\xa6\x8d\xd5\xd3_\xe1\xd3\x96C\xec\x95\xad\x9d\x8fm\xf3C\xb8WB\x9d\xba|`\x88PH\x9dT\xf2\xca\x15'\xbf\xf3	Q:\xdeT\x13\x93L\xdcEp\x9d\xcd\xb4\xdd\xf3\xda\xe7;\xe6\xa3\xa3\xccJ\x12\x1c\xe0\xc0e\xf8( \xb4\xa9\xd4\x97\x85\x19\x04\xaf6a\x1e\xdc\xccHw\xe2\xda\xbd\x19?\x1d/5,\xbaQ\xc7\x12\xd3\x8eCk\xa7\x0f\xda\xb1\xf7\x1a\xee\xa5\xf6\xfa\x8d\xb6g|\xb2\xdcM\xd6\x80{\xeb\xefl_\xf8\x87,c\xe4\xde\x01\xa9%\xe4\xee\xcaO-\xc4\xc9\x07%\xc4\xcfT\xf1:=\x8a\xe4t\x1dR\xd9\xeb\xd8\xc3\x12\xc5y\xf9\xba\x8c5,\x15\xd6M\x1a\xffH\xbfB\x95\xfbR\xb6\xed\x12\xe9t\xc9\x0cs\xf9\x85\xdfL\xf6\xe9w\xeb.\x02d\xbeO\xday\xd4\x87K\x04\xa8\xd5\x8e\x94\xf4N\xd7\xa9\x0c\xdaT\xfc\xe5\xb9\xbds\xe3uD\xe70\xfd\x02\x92\xd2\x99\xdb\x93\x0cyYH\xb7\x1b;\xf0\xcdSWh\xed \xf1\x12\xba\xe0:c\xb8$\x1d\xca8\x95\x08\x9ay4s\x80~\xe6\xfe\xc1\xf7'\x85\xe7O\x83\x84\xb4\xfd\x89|\xf8jOZ\x9a\xde\x1f\x00\x12\xf8\xea\x7f\x17\xbb4\xb4\xca\x87\xf3t\x18L\x8c\xb4\x8fO?\xf3\xa9%SH\xf8\xa5s\x96\x19\xa3\xe5\x11\x1c\xe6\xa5g\xde\xbf\xae\xe3\xdf\xe6U\x931^`\x84\xf4\xac_\xda\x9e\x86o\xf3\xbe\x08\x9f\xa7\xe6#\xd5\xd7\x05\xd3-\xb9\xe9\x1erQ58\xe6\x87\xe637x\x93\x835l?\xa7e\xdc\xa5\xe4m \xfd\xc5\xa46\x12\x94:%\xaeA\xd0\xd6\x12\xd2\xac=\xd7v\xff\xb5\x95o\x1b\x11\xddd\x90[X\xb1\xdf\x90\xdb\xf48?\xd4\x9b\x9c\xb0e:\x937-\xd3Q\x9ex\x01\xbf\xa4\xe9\x82\xaan\xe2\xa9|\xdf%\xb2H\xd4N6\xf3|9v~W\xf6Wy'\x1d\xbf\xbf$\xacbt7T\x94\x103\xe9\xb7V\xc7	\xc6}\xfdR\xf6:=\xb4\xb6\xa5\xfe\x8c[;\x17g\xf2e\xe2\x81\xfbx\x98\xfe\xc4	\xef\xb7t\xe1\x94\xd3\xe7\xaf\x16fCK\xbf\x8eW\x1c\xee\x1e\xa0e|\xa5D\x1ceS\xac\x088Y\x9a\x13t\x1e\xfd\xd7kSn,\x9b\xdbw\xd3\xbc\x90\xc5M\xcd\xd2\xf2\xfd\xed\xb4\xc7'\xa0A\x02\xe6S?x\xeb7\xbc\xcd{\xda\x9d\x8eLh\x02\x07\xd8\xabT\xc2\x80\x07\xb4\xd5.\xf7\xa3\xcc\x83\xcf|E\xdc\x90\x97\xa2\xb7u?\xf2\x02C\xbb\x8f\x8f\xa1\xdd\xbd\xb0\xbdz\xbf\xd6\xee\xf9z\xe1\xc2R\x04/\x1b\xb7\xb1\xda\x8ad6<\xee\xb4\xbb\x0e\xadcL\xde\\c\xd73\xdeVY7C\x1bQ\xddZ\x84\xa3\xf7\x03\xcf\x02>\xd1'\xc30\xff\xe1\xafTY`eY\xc6\xfa\xa7\xf8\xde\xc5#\x8cl}\x89\x92\x14.\xf6\xde\xfe\xaa{\x16k\xe2!L	&{\x9d\xfe}\x85\x9b\xaf\x03q\xfb\xd0\xf3o\xb6\xa6\xf1t.\x92\\\xde\x91\xd6n\x81\xfe5\x0c+\xf7\xc3\x8f\x1bG\xd4!~\xee\xf9cY\xf8;\xacC\xfe\xa5/\xe4\xfe\x8ef!\xca\xc3\xb4\x97\x8e\xdb\xb8j2\x86\x94q\x7f\x94*,{{z\xaf\x8d\xf0adx\xa5\x92\xd7\xe9_=<\xcc\xfe\xfci\x9bG\xd2\xc3\xe8\xdb\xb5\x1bnA\xdc2>\x7fv\x9f\x8c\xbaA)\x9cz*yf{\x91J\n\x8ff\x0e\xd0k\x18f5\xae0\xeb\x9dj\xf5\xb1\xefm\x13\xa0\xd7z\xb8\xae#Mz\x81\xcd\xb4\xba\xe5R\x06\xac\x13\xd7\x8a\xd6\xa0\xc1(\xbd\xde\x06\xb6\xf4\x01\xaf\xa6\xcc\xa1\xbf|\xfd\xfd\xdc\x0c+R\xf4\xfb\xf5F\x94\x02\x0b\xb3\xd1\x92\xe15\xb5O\xdd\xb8K\xc9\xeb\xd4\xfe\x13\xdc\xb2!\xe2#\xfbJw\xc0\x1f\x9d\x83r\xa5\xec\xe3\xab\x13\x18W!\xcb\xf7Jh\x13>-\x84n\\\x8c\x1a'\xf0\xd1\xa7\xc6\x92J\"\xf5\x08k\x9aj\x19fN~Y\xbf\x0c\xc1\x96\xd3\x88y\xf7\xbc\x9e	\xf5\x90\xde9D/\xd0R\xc9p\xfb\xe6==\xac|\xa8H\x92\xfc\x9a\x86\xfd\x0ep\x91{\xf7\xbcr\x19\x14'\xdf\xc7\xf2b5\xa4\xdbg\x97\xd3\xcf'\xa5\x91\x90\xce\xbf*\xae\xf9\x05cP\x97\\`\x0d\x11h\x1a\xbe\x1e\xf4\xcb\xc3\xde\xdd\xc3}\x12e\xa0\x13<u\xdci\xf1~\x16\x96\x02\x02q\xd1&=\x7f\x1e\x19\xa9EI9x\xcf\xfa\xcd\x1c\xd5g\x8d\\\xef\x8b\x7f\xe5\x93Y\x8f\xcb\xd76T\x04XO.\xed\x9anm\x93\xc7\xef\x8f\xf6\x8e\xec}\xc6t\x85\x91'\x99b\xa6b\xc3\xfd\xaa\xfe\x94\x1d\xff\xfe\xec\xba\x1b\xa5\xdbg\xce\x15\xf9\xe5l\xfa&\xc6\x01\x86\x93s\xb7#7\xca)#\xd0F\x9c\x19\x9c`<t\xbd$v\xdf\xfb\x11\x89\x9a\xda\xb6\xb6\x95\xf2\xcb\xf9\xe8\xf0\x1b^\x85\x18)\xec\x9b\xfc\xccr\n\xc8>\xafN\xe7\"\x99\xb8\x8b\xe0>crl\xdd\x05n\x8a\xd4\x1a\xa7{\xf7\x1e\xeaPP\xdc\x85\xec\xdfY\xda\xe6L\x06\xbd\xe1\xc9X\xbf$\x83\xef\xaa\xe3\x89D\x01\x89	\x0d\xc2\xbd\xde\xab\xe1~\xc8\"\x9ff\xbf/\x8cK\xf1\x81\x8d\xa5\x8f\x8e\x92\x90\xea\xdf\xbb*\x19\x16\xf1\x0e\xc4yn\xdf\xa1n\xdf\x83\x81\xfaR\xb6\xb8:`\x9e\x17c*\xa7\xb5\xee\xee\xe4\xa5\xb2\xe8\x0bX{16\xf4\xf6\x84\x89\x1e\xcf\xe3\xd0\x90=U]\xca\xee\xfb\xa1\xc4\xfa\x1d!\xdd?S>\xa1>\x9d\x12\x8dC\x7foK\xe90\x01\x11\xb5\x9dQ4Z\x90\xcc\xf4`\x0c\x89\xeb\xac!AK\xf5\x98\x92\x8fn\x12\xaf\xf8\xfb|E\xc5\xfa<\x16\x01\x17v\xce\x84\xed\x88c\x16Hl\xaa\xdf\xf8\xabZ\xa2Z\x1eW5\x0fC\xe3\xea\x135F\x17\xef\xa4fK\x05\xe8\xd1\x0dT\xb2\xb5\x86\xbb\xce\xb1\xc9<\xe1\xd2=\xd5\x10Rs\xa8\x98\x84c<\x89\xd8=\xd7\xc0\xa9u3+\xb2\xc4\xe5\x8e\xb89;\xdc/\x02l\xc3\xf1G\xfcNTo/\xd9\xa4W^\x9b\xfb\xc6\x1bJ$\xeeo\x87[\xaa\xc4\x98:\n\xc4y^H\x9a\xa0\xf9;.\x1e\xb4\x13\x88\xda|0\xdd\xf7\xccF\x7f\xf5\x9d\x9f\xab\x9ck7	\xf3\xef\xf5\xa1;\xcf<B\xcc\xc0\xf0\x86\x12\xcf\xb7t'\x07p\xd7\xc8\xf5d\x9eado}\xd6\xf8\xb1\xcd\x97\x1f,\xa30\xc9\xf9`h\xf7^:q\xa0\x93'\xd6=\xc1J\x0d?K\xd4\xaaS9\x89\xd9_\xf4\xdfK\x01p\xf4}Z=\x95\xed`l@>\x12?\x82\xf3\x8c9\xa5Yc\xf3\x15\xc1u\xf6\x94<e\x84s\xaf\xf9\xf5\xcb\xad\xd2\xd8\xf1\x8f\xc7\xe7\xd3y\xc5\x9e\xe8A\x1fk\\\x7f\x83\xf8\xc1\xdd\xf3\xa1\x83\xd3{\xba3g\xefu\xd3\xf7\xf7\xfe\xba''\x05g\xfej\xa9\xcb\xbas\x8e*\xef\xdb\x00\x95\xc5\xaf\xbf\x0f\x1c\x87\xfc\x9a\xfc\xdf\x00\x1f\xb1\xe0\x98\x04+D\x1f\x1e\xbf4\xbb\x08t\x15J\x0c\xa1Ns(\xf7.yv\xf2|\xf8%Mki\x9e\xe8Q\x9cP]y\x1a3\xf0{W\x1d\xbbI\xbcb\xfd\x89\x93\xc4c\xc1\x02\xc5\x92\x9f,\xdeu\xd2\xf1\xb0\xa9+(\x1e\x83\x14h\xda\xf5\x92\xa5pI\x9d9v[b\x02~\xbf\x95\xd5oV\xb4\x94rk\xf1\x91~sjz\xac\x81\xdd\xbclf\x9c\xb8\x13\xcc\x9b\x1f}\xb9>y\x88\xe2\xa6\xb6y\xa8\xf5\x97\x9a\xd3	\x81i\x89y\xf7\xbf\xac\xb5\x98\xe2\x80\x02\xf1\xfc\x15si\x89\xd6h\x0f\xbc\xeci\xaf\xe0^\xc5s\xfb\xd2\xdf\x0e\x9f\xde\x1e\xa1\xef\x02x\xd4~\xb0\xe3\xdbk\xfb\x05\xcb\xe0R\xf6]\xcb\xcc8nD\xfcma\x80\xaei\xfc\x808\x1e\x07\xf5M|Os>|\xb4a\xec\xfdd\x0ecX\x8a\x94\xf6\x84\xaeU}x\x15\xdf\xc4\x0b\xfaW}SD:\x86u\x17\xee\xeb\xeb\x03\xb8d\xcf\xad\xfduZ\x1e\x97\x92\xdf\xce\xfd\xa6\xcbA\x81\xdf\xc5\xcf\xa24\xbeE \x05\x05\xddI\xf4\xf3[b\xcc\xcc\xfb\x15%\x07X7@\xea0\x87\x0b\xd7!\x18`\x02%F\xc6Uk\xf1+k\xa1I\x08\x9f\xbd\xb9Q\x9cT\x9d\xa2>\xa6Z\xd5%\xa1Q\xa5\xcd}~\x8b:\xebS\x1dw?\xf1zq\xfb\xcb\xeb\xec{\xb9\x02\x01i\xc8L\x83\xb9\xff\xa2\xd8\xe3\x925`\x93C\x1f\xefN^-n\xd7\xf5\xea\x1d\xf7W\x0d\xec)\xa0^\x8f\xdb\xe6YS\x16\xedU\x0c\xc2\x0c\x0e\x82\xb4>\xbe q\xfb\xe0[\xdf\xba)F\x0dv\x1a\x1ao&\x9f\x02\xbb\xce\xa1\xbfj\xd3!\x9b\xab\x14\xc5N\xebS \xf6\x82\x1a\xbd\xe2\xaa-\xb9\xc5\xc1\x87\xb8\xb8g\xdc\x7fMZ\x80\xeb\xc1\xe0\x14\xb4\xe4\x97\x14\xf5\xdc\xbah{\xe1\x8f[\x03\x89-\xaf\xd8\xd5(Z\xdf\xe6\xb3HZ\x13\xff\xf8.\\-\xe9\x85\xc5\xd5\x81\xbf8\xb3g\xce\xf3\x17\xcf\x93\x80:\x8a\xe6E\x96\x1e\x97\x92\xdf\xce\xb6R\xdb<$\x03\xd4/\x1bv\xae|\xf8J\xd0MiBP\xd6\xe7\x9e\xbfx\x9e\x04\xf7Q4\xf7\x16\x9e_\xad[c\xd7+\xb8g\xbe\xa4\xeb\xa4\x1e\xa1\xf3>8Y\x0d\x7f\x9f\xd1S\xa7\x14\x7f\xa7\x8a\xb8\xa9\xbf\\\x9ew\xc6\xda\x1e\x83\xd5m\xa9\xdf\x18\xd6\x8f\x0e\xf1\xa1\xe9E\xfb\xbd\xde\x1eiS\x15\x1f\x98rs2|\xb7\xa5\xe1|\x14M\xfd1f\xcd_\xce0\xc0\x827\xfa\xcd\xbf\xe7Q\xb4L\xefE\x87\xd2\xb92\xecC\x85\xbdGZ:\xf7\x89\xba\xa2O7\xae\xaa\x13\xa6M\x1f\\5\xb0\xa7\xf4=\xd6\x1f\xa3\x02\xb0\x98\x87)\xc1dq$\xae\xac\xf7V\xd5\xf5\x95\xe2<\x8a\x86zQ/\xb1\x83l\xfa\x9bdO\x0b[~~u\xc3\xa6\x04\xf5\xe0<\x9d\xb7\x0e\xb5\xf1\xf5}\x95\x1a\xea\x83\x9d\x90\xf8G\xca\x92\xe7\xfc\x8d\xca\xfe\x15\xb9\x9d\xa0o=\x8f	\x8f\xe7C\x9d\x12W\x96\xe9k\xd7M1za\xd5\xb6\xc8\x0b\xf73~X\xfa\xc0\xac\xf3\xf1\x9c)\xfa\xc0\x8e\xeb\xdb{\xa9m)\x8e\x936W\xb2\xd9\xef`\x0b\xf4\xd0';nS\x98\x8c\xc2\x92\x9b\xc8a\x9b>\x16\x97\xf0\xfcm\x9bS\xdf\x9a5!\xb0V\x95\x97\xec\xad\x16E\x91\xe7y \xf4\xbc\xc2F\xd5h\xdb\xa8a\x01\xe0\xed\xc2\xa5\xc1\x1dw\x8f\x92:}\x98\xb5\xe0\x11\xbfk\x9a&\xfa\xcfc\xa7~S`\x97R\x04	\xfa\xf5=Zl9\xb6\xfc\x03\xc9\xcf+b\xe5\xb6I\xca\x8f~\xfc\x06\xd9\x974j$\x86/ojP\xf3o^\xb8\x02\x9eeb\xf5n|\xa2\xb6\xcan\xc0\x81[\xfa\xe9\x8f\x1c\xa17\xbc\xb6\xcd\xcd\x18\xcc\xed\x1c8\xa9\xcf\x06}\xab\x15\x06-\x97\xff\\t;\x005\xb6\xffV\x873\xe0\xa5g\xdd\xf1\x10\x88=\xec\x1bc\xa9\xadgP\x9c\xac\xc2\x05\x12@\x8d\x03\xbc\xe1\x99\x112u\xbd_0Sb\x1b\xc1XC\xa0\x9d\x90\xd5\x92\x98&\xf6\x10\x88\x9d\x99\xab\xd5\xaf\x06*\x82\xb1\xee\xb9\\\xcc\xc3XE0\x18G\x9e\x12\x11\x8cUd\xc6P\xf2\x80\x8a`\xac&\xd48\xb8\x95m5\x1d\xef\x00\xd4\xc0)\xaf\xa8H\xd8\xcfp\x82\x0e@\x8d\x03\xb6\xf4{\xe4\xc2\xed\x85\x93\x8a`\xac%\x94%L\xa7\xf0v\xe0\xa4\"\x18\x03\xcf\xc26\x83\xc3\x92\xaf7\xa9\x08\xc6\x1a\"\xb6\xee\xb0\x06\xae\xea\x80\x8a`\xac\"<{sz\xdd\xfb@\xec\xe1$\xa7	A\x18\x03\x10{\x08\xb4>\x16\x16\xd6\x86\x93\x8a\xa0\xaf\xfe\x14\x97\xcd	a\xd3`\xac\"\x18\x839\xbe\xb0\xaf\x1a\xdb\x08\xc6\x1a\x8a\xdb\xe9\xb4\x16\xee\xe8\x80\x8a`\xac\"<\xa3\x1ft\xab\xce0V\x11\xcc\x88'\x8e\xd9\xa3\xea\xf5\x10\x88\xfd\x0c\x88L\x05^\x0f\x81\xd6\xc7\x9f\xc3\x88m\xd4C v\xf3\xb4\xe0\xb9\xd1L\x005\x0e\x10\x02\xaf\xeex\x07\xa0\x19\xcaL\xcfQ0V\x11\x0c\xe6\x9e\xa5\"\xe1\xf1\xf4&\x18\xab\x08\xfa\xfaF[=\xf4\xbc\x1e\x02\xb1\x9b5v\xaf\x81\xd8C \x84\xde\xd0I\xac7<\x8d\x8fa\xc9f\xca]z\xf0K\xf9n}\x9dY\xa5~\xe4\x07G\x06JD\x93\xec\xba}\x1e\xdcj\xe5Ef\xb1u\xf6\xe5\xdd(\xc7>\x17\xf6\xfeM\xc92\x12\xef\xe6\x83\xb5\xc5v\x7f\xc8/\x16\xbe\xe2\x85\xc0bN9V.<\x0e\xf6c\xf5'M*\xa7@\xd3\xe7*c\xac\x9d}\xc42}&\xe1\xe1\x9fF}~\xb6\xa5\xdcy\x9a\xceB.\xfd\xb4d$\xafK\xc1c\xc7\xca\xbd\x8e\xb5O_\xc5\xe73\x0e\x8f\xf3\x17am\x98k6\x9c\xb6\xe6\x16\xbd\xf5\xf5L#Ab\x08!\xb6i\xee\xe6\x93+b\x9b58\xe9\xd9\x0fJ9m\x85\xd7\xabn2F\x8e\xe9;W\xda\xd9xpS\xcd\x00w\xa8dE\xa9\xde\xdfR\x9e\x9e[\xee\xa4\xf3;\xe5\xd1\xb1\xdf8\xf6\xba\xaf|\xa0#+\x14\x02G\xcc\x14\xb5\xd9\x95\xed\xf9x0\x96;L\xc9\x87\x9e0\xeac\xfd\x94\xd7\xeb\x1d|j:\xfa\x90\xc2\x98\xcc\xdd\xab\x1e\xcb\x1a\xd9\xc1\x99\xb3\xf2Z\x085\x8d\xc1O\xc9ikY{\xf8\xb2\xbewV\xfc\xf9[\xd0E\xda\x80\xc1\x94\x08J\xd6;1\xb3<\xee\xfc\xa4\xb5\x83\n7\xb1$5d,\x86\x0dk\x8f0&\xd5,\xad\x8fNf2\x1d\xe5\xf2g\xb5f\x87\xd2f \x7fNy~\xe7}\x88\x99\xfb\xeb\x18^\xc2A\x9a\x85\x15\xff\xc8x\xf8V\xbf\xcfx\xa6VA\x13\xb7V\xf4E\xa5\\i\x86\xd2\xa7\xa2L\xa3\xde<\x1e\xd2\xcf\xd9\x9b\xf9N\xf6\xe3|\x04\xdf\xb8\xfe\xd0A\x9bl\xda\x99}\x0f\xf3|\xe8\xcf\xf0T\xcc\x92[\xb0\xcf\x83\xfab\xb2\xaf\xbeR\xa9\x12>\x9f'\xcf?h#<>\xfa\xbb/\x8f\xe6I&r\xd6\xed\xb3|e\xfd\xebe\xd1r\xfb\xfd\xc8\xa0WO\x96UY\x0e8\xa2\xe4\x1d\x852\xd5\x8c\\\xff\xc8P\xc0\xaa\xca*\x89\x8e`q	\xe6\xdeI?\xc6\x16\xbc\xf8\x0d\xa2\xdb\x99:\x89=R\xbf2s\x9c4?\xcc-?\x82\xee\xc5-\x1fw\xcd\xb3Gf6:\xf9\xe5\xc33:0\x01\xd0\xc4\xcf\xaa\xb4$P\x8b\xc0\xe6_\xe1\x1b\x99U\xfd \x13L\xfb\x95\x1b~?\xb9o-\xe0\xa4Vb\xf5]\x98\xd2B\xaa\xbc0\xde;b\x9c\xb5\xa8\x86\xfeu\x82kY\xaf\xb5\x86W.\xfb\x98[\xa4\xd8{\xcf\x87/\xe2\x12L{U\x1bM\xd7~>\x06\xefy\xa34Q\xee\xa9\xa5\xf6\xd9\x9a'\x94\x0bo(%\xb8\x1f\nA\xc4\x1d\xfb\xe0\x1d\xb2h4\xe6h\xb6\x17\xb3k\xa4\xb7\x15\x1c\xf3!\xdd	\x06\xceQ\xda\xdf\xbc\xa3\xc9o\xb4\xac\x8f\xa0\xf4]\xe9c\xb5\xbf\x81\xff6\xed\x974\xda\xb0\xca\x94\xef\xddS\xf6\xb6\xf8$+d9|e\x99\xef\x07\xdcw%\x9c\xae\xf0\xae\x17\xa9\x9c\x87Y\xf2\xf0\xf3\xd6\xd3\xdf\xe9'd\xa5E\x9a\xe4\xa9\n\xfe$\xc9G\xd4\xdb\xfa*?<_1c^]\xaf\x9d\x7f\xa6\x98\xf4\xc6\xbe\xeb\xa5\xcf\xb8\xc7\xe7\x8f,\xfe\x91Rx\xd4~\xf1A\x11I\xb2\x9d\x13 \xe2\x15\xb9\xa1qT\xcd~\x9clQ\xc2Z\xeb>\xd8\xf3[\x08\xef\x85\xe4\xc2\x83\xf5\x8b\x07_\xc6\xef\xfa\x05\x03\x90f\x1d\xfdz\xa44)\x9a\xf3\xe3\xfb\xdc\x83\x17\xf6T\xceg\xfbP|\x9a\xec\n\xe6b\x8f@\xac}Y\xf8l\x9a\xf5\x95%\xa4X\x82\xeb\xa6\x1dR\x0b\xe2\xc0\x8d\xa9\xc6\xa5\xed:\xed4\xdcY\x84\x89\xc3pp\x0d\xf9\xe0q\xec\x81W\xa7\xbeQ\x07\x9d\x92\xa5\xee\xae\xef\xde&\x13q\x0c\xbd\xa7\xf2u\x94\xc7\xdbz\x9d\xfdP\xd2\x07\xf0	\xb3\x85\x91\x94\xb1\xcbom\n\x8e\xba\x97\xe9\xc7\xa4\xed0\x1e\xca\x17\xf5\xf1I\xd0?O\x1b+\xd5\xb7\x0d{\xab\xd3\xfb\xce\xd0I\xf04]\xdd\xc4\x1d\xec\x9aM\xd3\xea\xab\xdd\x1e4\xe8\xa6\xdc\xea\x9d`\xf0\xf1\x92=__mI9\x81y\x88\xcf\x85\xea\x7f\xdf\xa3\xe0U\x9c1\xd2W\xf5\xf9\xe6\xcf\x0e\x1f	\xbc\x10\xf0\xa2d\xf1\xa2\x182\xc22\x19\x9fsI'<.\xb3`n\x92\xbc\xf9\xd0\x0f\x03\xb1\xf6\x0d\x8e\x9fA#\xad\xfaZ\x1c\xdc\xba\xbb\x16_\xc8\x94\x89\x8e\xf2\x15\xac\x0f\x01+y;U\xa4\xd5\xe8	\x93\x94e\x8a\x92y\xfa\xe1\x85\xb1\x08;\xd3\xec\xcf\xe8(\xda\x90\x19(\xf3\x83/\xf1d\xf6\x0f\x16>1\xb3\xd9\x05\"\xfb\xc9ib}>\xeb5\x08\xe6\xeb\xc7:\xe7H\xfd<m<-09\x17s1M{n|3\xbd\x17\xd5\xce\xd7\xa2)\x1e\n#>\xf8B\xa6\x00Z\x7f\xbfVx\xb0[\x1a\xb6\xf5\xf0\x8c\x81\x9f?\xb7\x83Tu\xb7\xbf\x081\xbc\x19:\x0b\xcf\xb4d^\xc4\x9b\x03\x9f<,\xb9wiV\xfca-\x16\xd6\xb2\xb1u\xfd0\xfa\x8c\x1f\x0f\xd9\xc4\xde\xf9\xd6\xc8\xe6\xb4\xbe\xf9\xd9\x10\x9a\xc4\xcbJ#UUKp\xb4yAk\x0d\x08<\xb2_\x06\xc6\xd1\x19\xa3nT\xca\xb9\xae\x1e\x15\x98d\xd2\x9f\xb9\xf8\xd9\xd1'\xd4\xabW)\x109f\xe4\xeb\xcf\xa7\xb4\xf4@\x90\xa8\x0b\xec\xeb\xac:\xa6\xf8\x9b\xab\xddl\x9b\x15\xbc\x9c}0\xbc\xd7m\"\x9fU\xab\xe0d\xec\xa3\xe9\xbf9u\xb9\xad\x98\xdc \xe0o\x91\xef*UJ\x0d;_\xd3\xd3S\xa2\xd7\xc3R\xcb3\x8fd\xfd\xdc\x93\xc6\x1a\\\xd2\xc3\n6\xc0>\xbd\\\xbc\xf1L\x96\xf1P\xd8\xb7\x87\xdc\x87D\"D\xabgY\\[0\x15vR\xcb\xe7\xf6\xa4S\x11\x98\x9b[i\x92k\x1e\xb1\x0c\x83\xd7\xeb#\x97B\xf1c\xcc\xdf\xeb}u\xa6\xbb\x07l\xe5\x07\xc2o\xa3xS~W\xdfo;\x81Y\xaf\x8e\x1a\x17\x9eO\xe9I\x7f\xa2!\xa9\xb6&\xddI>\xefXRm\xc3:\xeb|?\x0d\xc3S\x18Jc\xef\xb2\xf8\x8b\x17\xccC90\x94a\xdf\xe47\xce\xae6\xeb\xcc\xf7w\xacE\xa8\x04\x96\xf64\xfa\xe83>\x0b3L\xc9\x84y\xd0\xad\x0e\xef\x94\xb5\xb5\x85;\x89MRZ\xe3\xe4\xc2\x9a\xedp\xff\x0c\xce\x15\xa5\x94Z\xc4_\x92\x8b\xe3_\xbc\xbc?\xdc^\xe4\xf2B\x86/\x8c\x1b3\x06x\xf3\xacK\xd1\x87\x9d\xf3\x8f\\\xa4\xb9\xd8x>\x11\x1aGa\x1a\n\x19=\xcb\x9fB\xed\xc9\xd2\xd2\\\xdb~4Z\xe8\x9f\xb0\x99n^\xfa\xd6e\xfe\x01\xc7\xba>\xbfu\xccs\x01\x99|\x16\xc7!\xafL\xa0\xcf\x06\xce\xf3[W\xa1\xbb\x12\xf6c\xf8\xfe\x8eU\xb9\x9c\xcetDEa\xcf\xd2\xe2\x7f\x84\xdb\xcb\xda\x92\xa5\xfa\x08\x7f\xc7F\xaf\x83c\xd1d.\x08$4\xcb*)\xdf\xabXLBp\x0b\x03\x86\xbf\xf2	\x8a\xd6\xbe\xfb)\xa9\xbc0\xfeXW\xfc\xe1IR\xa5J\xe9tY\x0b\xbbA\xd4'\xbd\xb1}k\n\xfb\x0cAp\xca\xbf\xf8\xa88\x03\x13\\\xeb\xc1n;\xe1\xf4\xc8\x8f\xb2\xb5\xb7\xbe(z\x98\xcc\x11\xb0	\x1d\x95\"c}\x93\x83,\x97\xdfHA\x8d9\x84Of\xfdh=\xfa\xe6\xd9\xf1\x94\x88p\xab\x98\xf4\x1c\xb5\x00e\x8f9\x13z\xca\xf2\xa6\xaf2\x11\xa7P>\xdc\x96\xa1\xa5\xf5\xa44\"X\x96\x85xc\xbc\x7f\xd0\xd2\xb3c^MQ\xf2\xdd\xe7)QI\xecz\xfe\xd79*\xdf\xc2:.<\xb0\x06w\x82\x05\x7f\xd2\xe1pn\xe9C\x8a\xed\x8f\x08\xbd\x97\xc4\xac\xfe\xf9b\x8b_\xf4[\x91\x9bu=O)\x9e\x1a1k%\x1b\x18\xa9\xf7\xfc\x91\xbak\x95\x9c\xdek\xe8\xccN\xb2\x8f\x9fN\xaa\xed\xc1\x9c\x8bD& \xf7&*	\xe3d\x84\xbe\xf0\xccA\x9e\x1fv\xa4\xc7w\x94%\x17\x11\x02'\xcf\xb1\xcaV\x12\xf1\x07\xfb\x92\xc1\xb0\xfeY\xbbO\xd7.\xa9\xf1\x0d\xd1\x9a\x04a\x1cf\xb3\xff{B\x90\xfbS\xf0+\x05>\xc64\xa1\xadC#J\x94\x95\xc8$\x17\xeb\x8f\x947\xbaO\x9e\xc2:\xefM1\xf8\xc8RP~\xb4\xa3\x95\x9f\xf9\xa0qeX\xd0\xeaQS\xd7\x87 \xaf\xf7\x84\x8ey`\xdf{\xd3in\xfexx\x13#Za\xea\xf8s\xcc\xda\xfb\xa3\x97\x89R~\x8f\x868/\x08\x9b_\xe7\xb7|(\xf8\xef\xfd\xbc\x9b)\xdd\x97\x14}_\x18\xe9\x8c:\xcc\x98\xad\xb4\xf9\xc7c)c92\xe6\xdf\x0c\xe8\xf53\xdc\xa0\xcc%\xe8\xf5\xdf\xf5%R$\xff\xc5\x9e\x0d4\x04\xe3;\x85d\x10\xbf\xb0\xc2\xa6S\xa0\xea\xa4\x7f\x94\xf0\x97\xe2\xa2\x9bo\x84h\x96\xd6\xf7\x18b47\x0f\xa5\xa6\x95F\xe8\xdd\x87\x8a\xf6\xd9{\xa4%\xcf8\x0f~\xcb\xf6vR\xa6\x1aK\x16\xa6\xf8h\xa6\xe7\xebPX\x122\x96`R\xab\xd2\xc3\xb2\xa4\x92\x04\xd3o\xa4\x1c\x8a.\xe9\x94\xd4\xab\xef\x99\x8e\xbe\xe7\x03w-\xf5\x9a\x96)f\xc7n\xffw\x7f0A\xc0\xde\x07[T9\xbeQZ\xf8\xa3\x15\xfa\xc9\xf9n\x9c>\x1e\x02=0\x8du\xc4\xe2S?^n\x15\xe1\x0e\xdc@\xcb\x1d\xf3o\xf2\xbb=\x96@q_\x14\x9b\xd2\xc9'\xf90\x1b\xbcJ\x1e\xffa\xcd\xbb\x95\xba|7\x0e\xf5\xdb\x1b\x90j\xe8w\x12\x97\xe7\xc8<z\x02\x9f\xc7C\x8d\xe5\xd1q\x8fGm\x06D\x9f\x99\xe8T\xbeF\xa7\xcbs\xf98\xe6\xd3\xf8-k\x07\xe7i\xd4\xde1\x921\x82|\x10`\xd0\xfd\xa7\x8aa/'\x95\xfb\xe0\xde_\x84v+\xefM\x0b\xce'E\xbe\xcd\xcf\xe1g[\x15\x13\x92\x9a\xca\x91\xf8;\xaa8\x1c\x9cg7eiJ\xcd\xd1\xfdl\xeb\x8dx\x8f\x9f\x81\x88\xe2E\xa5p\xed\xc1\xa7\x0e\xbej\x16\xe1\xd3r~\x93&\x9ag\xdd\xa2\x18\x0eQOII\x0d\xb3\xc8{\x90}\x87k\xde\xea\x98u\xad/G\xee\xfd%v\x93\x97\xa6_\x0e\x8f$*@S\xbd&\xb5\xf5\xc1\xc4\x9ab\xac\xe7<\xa7f\xb2W_\xec\xdd\x1b\x8c\xe7n\xa1V\xdf\x84\xe0\xbb\xe1 \x1fm]b\xb5A\xfan^\xaa]\xb7j\x8b\x85\xb9qV\xbe(\x0f\xd5\xd32D\xbe\xcd\x8f\xa7q2\x03&\x1c\xe6\xdeN\xea<\xe3\xbe\xbe2\xcf\xd2<\x86<\xed\xf4`IY\xc3\xdf\xf0\xcdg\xb8\xe9zF\x13\x9dQY\x82if\xd1\xdcB\xe7\xda7\xdbZ\xd6/'\x154$\x17\x8e\xd4\x91\xf3\xe8\x8fwDk;O\x1be\x7f\xcfW\x88Y\xdd\xf1\x9e\xb1\xefH\xc4\xe2\xde}K\xfe\xfd\x98I\xec\xfb^\xa7gf\xaf;kZ\x8f\xa1\x08_~\x9a3\nO\x889\xff1\x9f>\x98C9ScWf\xd6\xb5\xdc_>>\xb8\xd7\xd3\xfdEUf\x0d\xd9\x83=\xcdx-\x96\xefw\xb2;\xc9\xf1CeLB\xeb\xa8?\x85ED\xd5\xc0\x90\xd27\xa67m>V\x99K\xbfe\x1e\xdb\x1f\x19&\x9d\xa3\xab.\xa4\xb7\x8b\xb2\xe2\x0b\xb3'\x07\xe2\xf9uI\xe5\x8f)pIC<q\xf5\xc3'\xed$\xdd\x1f\x141{Y+|HBY\xf7WHe\xafe\xa4\xe0\x1c\x1bk\xff0Il?\x9d\x82Xe\x8a\x8a\xb8\x83/\xbaK\x99F}\xc2\xee\xf0G\x1e\x1e\x9e\x04\xc39x\xbf\x11\x87\xbf\xb0T\xdd\x98)\x97d\xa8T\xf7\x98\x0d\x83\xfe\xb4\xb2Cky\x9f\xe7GSk\xf38\x05z\xe4\xb9\xdf\xdd{\xa3\x0e\xec\xaf\xd2F\xb1]}\xa7w\xfeHK\x93\xb2\xbeR\x19B\xe0\xe5DV\x0c\x97\x8f\xd1\xc5R\xf4\x0f\x85Q\x9e\xf6\xe4\xfbCy\xcd\xbf\xf3#?\x00Z\xf1'=\x94\xd5\xd1:\xda\x9c\x8d\xc1o\x94\x7f\xc7\xd5w\x93\xb9\xa4\x9df\x1b\xee\x8aa'\x11\xb4\xe2\xd1[\xe3Z\xb2\xc4\xee;^\xe7\xbdv\x9eHA\xde3\xaa7\x12\xf7g\xf4\x19\xc3l\xbf\n\xa4\xf98\xfa \x99H\xa6\xf8\x95\x9b\xd5\xbe[\xbc\xa9\xcd6\xb5\x7f\xab\xbc\x0f\xaf\xbb\x9b\xa4)\xe5h\xfe#\xea\xd3\xea\xb3\xe0\x91\xa5*\x8d\x84\x10P}\xf8\x06\xad\xb1\x0bvr\xbb\x81c=\xc6\x9f\x92\xfd\x1cP4\x83a5\x9bff\xe5}\x95<6`9mOV\xc6\xf8~\xb4\xb7\x9c\x06\xd3\x00\xe7jr\xa9\x15\x9a\x991e\x87\x0f\xb9Q\x9e\xde\x84g\xcf\x8fI\xd8\xb4~N=V\xb1\xc5\xceo\xda\x1c\xd0\x16\xe6Fk\xef<:\xaa\xff\x9c\xacH?\xccO\x9bH\xb7\xef\x03\x0cOI\xcd\xc1*R\xda<_\x9bA\x90\xa9SA\x10\xa91\xbeP(\xc7!o\xe6\x19\xaf2\xaf/\xcb1\xe5/%u5\xf6\xbc	7\x85\xc6\xfe\xc5\xf6\xd7\x1c.\xb64d \xedV\x1f\x1cI\x15\xbf\xe0\xbc\x86\xd1\\k\x87\xc2\x8b3\x9ecSX\x06\xd4y\xdb\xc9\xcb\x0c7\xf6\x90=w\xf8\xaa\xef\x94\xac\x9eD\xaa*\xeb\x88{\xfd\xbe<\x08\xfc\x9c\x9e\x8a\x13\x0e\xf6\xeb\xf5\xe2\x9an2\xa7\x98\x8b\x18\xff\x9c\xfc\x96\xd3\xfc\x04\xde\x0f!x\x91\xfe\xe4;/x=X\x86&\xcb\xa9X\xa6E\xc3\x84\x1e\x15mg-\xfb=a\xfc\xc1&\x85\xfbi\xcb\xf79:q\xf60\x87c\x91R6/fO\"\xb4\xd1^\xae\xaa\xd6\xb6<x\xb1!M}`\xd3MS\x8d\x83\xe6\x9a	I\xf42\xa2\x14huo:0U1\xeb\xf4\xa5\xe6\xbf\x9d\x07m`\xa2L\x9c1|\xa6M\xc8\x1c\x86\xf8{\x073z\x17<\xf4\xdc0\xb3JF\x90?\xb1\xa8\xb9\xa1\xc4\x08|\xf6\xd9\xc3^\xd1\xd1\xf9\x04\xcc\xd6\xbd\x13\"\xc0\xd0\x19*5\xec\xb4\xf9[?\xe2\x1e\x9eAG'T\xda\xc3g\xdd\xd5Sc\xbaW\xc0K\xcfu2\x92\xb8\xbc\x8e,\x93e\xcf\xdb\x8c\xb5\xcf\x1f\xd9\xcf\xf7v(\x0b\xbe\x8aK\x9a\x1b~cAiI\xac\xa9\xfd23\x85\xcc\x9c\xc3~0\x04hY\xbf2\x89\x99\x8c\xe74\xb8\x84\x95\xa0\xc9I\xf2\xaaH\xebu\xa8+\x15=\xfb\xee\x91q\xd66\x1f\x13\xf7 l\xf3|\x14\x9d\x03\xd1\xf3\xf9\x05\xce\xa7\xa4n:p?\x9bs(\xfe%\xbc(Q\xfa\xf5\x00\x99s=\x11\x85\xea\x9f\x94\xd3\xf1\xf3\x90lX\xd7?\x7fz\x84\xf7\x04\x9e\x92\x8f\xb0h}=\xfc\xd0\xd2\x11\x9f\x12\x93\xfc2\xf5\x9d\x19{\xe7%3e\xce\x84\xd0<)\xcb\xeb\xe7;\xc4H\x11\x9f\x1f\x9a\xd7\xe9\x9d\x1e\x05\xb9\xf9\xf6\xaf\x05A\xf3\xa9g\x9c\x8e\xee\xa6\x03\xcb\xfeFGu\xa6{\xeb\x0ba^\xa6\xaft\xbe\x0f\x82y`\xde\x87\x16v\xfe\xf9~\x9a\xcf\xde\\R\xe6\x1b\xb7\xf1\xf3M \xfc\xaa\xda7t\xbe.\x1bk\x9e\xed^\x94]\xe1\x17\xe9\xf5g\xe1Y\x9e\xb7Yfk\x08\xf1\x8b\xbc \xee\x1a\x8c \x97X\xfe\x86p\x9dM\x95Z\xea5\xdd;~\xff&\x8c\xc6\x87\\\xeb\x94\xdc8\xecm'9\xb1\xf6\xb6<6\xac\xd7\xba\x16X7\xce\xfd\x82\xd8\xc3\xf3\x17\x0eN\x83&\x86\xe1\xf2\xcc*}/\x99\x92eb\xc0}\xe5\x92\x06\xbb\xdf]b\xfc\xfb\xf0\x9d\xb9[\xac/\xa3t\xcfz.Q\xa1\xac\x1f\xa8\xfb\xb8\xc6\xbc\xa4\x9c\xb8D3f\xf8\xd3\x9b\x08<t\x86\xccx\x8aN\x91\x05\x003k2\x92\xc9B-\x9d{\x98\x8c\x12\xffdro\xc6\x15\n_\xfe\x8e\xf5\xbf\xdf\xbc\x0c\xdb\xcep\x95\x86\xe7\xe9M\xbbX\xbc:\xec\xe3+N\xf6<\x12\xbe\xf0Z\xd9\xedNw\xb2<j|\xdd\x1b_\xf0\xb3\x02\xa7\x08A\xe2\x93\x86?\xbe\xcb\xfay/EQz\x80I\xbf\xd6\x9c\xf3\x9f\xebe\x7f\xc3'EZC\xde\xdc\"|F\x00.\xfe\xc5D\x9f\x0c\xcc.xd\xdew\xfe=T\xc5\xbc\x83\xc3.PS,G\xf5\xdc\x1e\xe2\x1cj4#\xd3\xeb\x07\x15I\xa2\xf7\xaf\xf7\x9c\x87\xf0\xe7\xb7\xaao\xffR\xbd\xf7\xe2\xfa\x93\xc5\x10:K\xb9\xb2i\xdb\xdeM\xed\xa7m^\xbc\xb9t\xee\x97\xdf\x12\xf7\x11mJe\xac?\x1f@\xdc\xf4\xbd\xf0L\xd3,\xdd\x05ODZZui<a\x17,m#\xc8\x99b\xbaA\xf8\x8b*\xf8v\xbd\xdb\xdbq\xec \x97\xf0u\xe6\xe1pY\xdd\xdd\x1fou\xaa\x7fi\x0ca\xbecEO\x03\x85\xd7\xecD\x0e\x03\xf9)g\xaa\xefm\xa9\xb9\xca\xb8AY\x9e\xa9'RFk\x876M\xbf\xc5\xdd\xf5d\x9fz\xccu\xd2\xa2\xbb\xa4\x8ey\xa9\xf9x{\xd3\x0c,\xf9\xbe\x00\xadE\xb6\x85\xac1X\x96\xfee\xfd\xf6\xadu\xfc\xeeM& \x9b\xa7\xf9\xf8\xec]\xb6\xcbx(\xb8:ez\x8f\xe0\xca(2n\xa8\xf1J\xcb*\\^\xc0,?\xa9\xb2\xa0MD\xb1c\x9d\xf9\x06I_\x12[\xf5\xc5\xe3\xef\x93\x9a\xedG\x9c\xb1\xc4\xba\x98u\x1d\xed5\x1b\xbc8\xb9\x9b\xbe\xf2D.\xbaC\x11)o\x8b\xfe`v\xde~>\xbfq\xba\xe2P\x08.\xf0\\\x0bK1\xaf\xab\xae\x85,\x10#<c\x1e\xe6\xaaLS\x07t9~U\xa4\xf5i>p\xa6\xdfE\x0c\xed\xab\xc7\x9c#\xe5\xc6t\xb2\xe7\xf6-;\x0b\xc7iC\xa8\xe7?\xeb\xfe\xd9\xdaL\x0e~\"\x07U\xed'\x9f\x8c\x92\xa5D	\xc3\xfc\x91\xc7o\x89X\"?=.\xa9\x03j?\xa1y\xd1\xf74\x17\xb4\xb5\xb0\x8b9\xca\xe1Q\xebm6zG\xb8m\xba\xc5\xd6>\x8fo!\xe5\xef\xcc<1\x9fh\xaf\x7fC\xe8$\xdd\xbd[>$\xf4\xf1\x0d\x11r\x11Nj\xc1}\xdc\xe17\xc2%8\x1fs\xf5\x0e\xc3\xb9\x92u\xd5\x99\xebi=\x98\xa4\x02y\x96\x9b\x92;\xb8k\xb3`n\xe7\xeb\xd8\x95\x90\xa5\x9fxZG\x97\xd7\xbed\x87$\xe3?\xfd\xe9\x983\x05\xbcN\x9b\xda\xb4C8\xc0e\xdfY_\xe1\xa5v\x93\xd1\xc7\x19\xf1z\xc7\xcf6X\"\xf7\xbd=\xbd)	\xc1\xfe\xf0\x03O3\xdf\x9b\x8f\xbb\xaf\x9e\xbd}\x02c\xcf	\xe4\x92\x96\x0c\x9e\xb2-\xd7\xf7z\x97<\xf7a\xe7Q\xe9\x1aB\xb8\x0e\xbe\xea\xdc\xec\xcd~?\xd7\x15\xc7\xf6\xec\x0f\xd3\x94\xc7o\xdc\xff\x86Y\x81\x7f\xdf\xf1\xb3\x99=i\xf2\xc3\xfc\xfd\xf4W\xef\xb3[&\x16\xb0\xb5\x0f_T\xeb/e\x1e~un\xda\x19.\xcf\xbf\xa7\xa1\xc1\xd0\xa1\x86f\x91\x18\xe9\xf3y&S\xe2\x82\x02\xe3v\xf3\xbe\xe0T\xbc\xbcG\x18\xcd\xb58h#\xc9\xa5\xa2\xd6\xdei\x83\xbdi\x95\x98\xd3\x0fm\xae\xb2\xefv\xfc\"\xf7\xa8\xf8\x91\xa7\x8c\xd2e\xd9\x9a\xa6v\x06a\xdf\xb31\xce^\xaew\xa3\xe5eM\xec2C\\\x0b\xe8\xb2\xd2\xdb7Ssr\x06\xfcP^:FmyJ\xf3L\xc1\x17\xaa\x97\xf0\x15|y\x96\x95\xbc\x10\xef\x00\xcd\xfev:t|\xc1kx+\x1d{\xbc\x94\x0e\xef\x00\xa7%q\xb1\xc7\xb7\xe4\xd1ne{\xda\xc3\xfb\xf8\x8dk\xa5\xa4\x82\xb3_\xc1~\x04\x8c\x96k\x18W\xbc\xf5\xf1m?s\xfb\xd8+\x9d4=o\x9c\x87P\xf4\xcf1h10yr\xe0\xe5\x93\xb6=\xe8e|\x14\xefdv\xbf\x0b\xfd\x92p\xef\xf0\xbf\xb2\xfc\xe2~1\xbd\xd3\xb32\xb5\x05\xd7\xee\xfa.\x99\"\xec\xcf\xfb7SO{\xa9\xb1\xe4\xcb\xf4\x9d\x0dX\x0c?\x0f\xd2\xac?^[rC{.M\xeb\x07\x98\xb9g.\xbf\xbe\xa6\x8e\x8e\xb0h\xf5\xe3!+C\xc2C\xc2\xa2\xd8s\xa5\x11s\xb8\xb4~\xa4\xbeI\xecNp\xb5\xd3\xf5\xd3\xe5\xaa\xa2\xe9L\xfb\x1d\xe7\x07X\xe5\x1f\xa6la\xfa\xae^'4\xba]e\xb0\xd77a\x8b~\xc7\xeeX\n\xbfz\xf7\xfa\xca\x9e\xee7\xeb\xc3\x9c\xcdR3\xebj$\xe8\x8f\xf2\xf1\x8b\xbe\xbf\x84\xc5_y\x0d\xf8\x92FM7~\xec\xb1\xf7-\xacD\x0d\xcf\x88\xe2\x8e\x0e\xa7B\xd8\xbfr\xbd\x03,\x95<\xe4\x8cY/-\xc5\xc9\xaf@\x1f\xc22L\xebk\xc5\x9dq(5_\xa4}E%?>/}b	?\x00\xbdzg\xe3t\xb5$\x16\xe6\xe4j\xe9\xb6\x05\x85\\\xef\x1dP\xa9\xe4\xb3\x05\x85\xd5\x14\x9a\xe9\xa5\x9f\x0f\xb6\x13\xc11\x1f\xb6\xff\xea\xac\xecmw\x05\x98\xd9j\xf7\xf0D\xd2\x8d\x86~\xa1\xb7\xdc\xdf\xa2\xd8\xebE\x1a\xbes-a\x06&\xe5\xb6\xe7\x9c\x97\x9aWU\xe5!l\x1a\x04\xafp\xbb\xa4@W\xdf\xf0\xf0\xe5\xe0CiI\x9c\xcb\x1f\xcfS\xe7\xd6\x17\xe9\xd7Nj#\xfcl~\xf6\x16\x841\xf6\x91\xae\x1avMx3_w\x01 \xae\x14\xae+s;\x9d\x14\x08R\xb42\xad/\x07\x86\xd5\x81\xb3\xeex\x9c\x0c\xb8\xc8\x81\x83\x9eP\xccz\xf9\xae\xe9\x91i\xf8\x9a\xf4\xf7\xdb]C\xdf \xae\x1b\xc8\xbf\xb9\x97x\x83\xb4\xbb\xdd\xcc\x067\xbb\x05\xe04\xf6\x0c\xba@\xe7\x87\x07}\xf0\x1aH\xb9\xce{C\xb8\xf7\xa6\xc3{\x91\xa9O]\x80\xfc\xbb\x9b\xd41\xfa\xa4\x07NWK]\x1a\xfc\xb0\xfav\xccb\xc4\x99M\xd9\x07Qn\xa1\x85\xd3\xeb\xf7\xcc4\xec8\xfcT\xf6I,\x0e\xeb\x17%\xf4\xcc\x11\x0c|]\xf7\xe6\xa34[|T\xd1\x1bn\xa7j\xb5X\xfc\xf6\x9c\x91\xd9\xb3!\x97\xa4\xe2pj\n\x8896\xac\x9d:?7\x1b\x0b\xf4\xf1\x03_e0\x8eW\xe4'f'x\x9e:\xbfX\xf3}}\x0e\x89\xee#\x95\x8d\x7f\x8c\xdf{\xa4(	N\xd3P\xa2Sb\x9f\xe7l\xe9#r^\x0f\xe7m\xa38\xc2\xde*>u\xf9{W(2\x99/\x81r@4\x9f\xa4\xcc6]\xfa\x14\xcc\xe7\x9d\x92\xf3\x18\x89\xc1\x83\xfb$\xc7=R\x18R\xc7{e\xf1\xe0{\xcd\xdd$\xea[\xbe\x80c\x11\x01\x89_\x0d\x06\xde\x81V\xfb\x9c\x14\xe0\xf4I\x8b\xadS\xd2\x8f\xe7a\xf0?^=\x98\x9d\n7E\xdd\xc7\xb7\x92\xa6\xb4\x7f\xdes7E$\xfe|K\xc1okv-\xe0\x89W\xc1~\xf1t\x9c\\/\x0c\xf6\xc0\x89\x86]+\xf5\xa3[\xe2\x9f\xaei\xb0\xff@\xf8,\x07\xf6\xe4\x1aL\x7f\x87r?\xaa/\xe3\xcd\xcb5\x0d\xba\xe4\xe9\x9f\xa2\xa6\xde\xdaW\xa73SL	T\xb1	\x86\x17\xcbWMX\x8bN_\x01\xb6o5\xdbk\xf6\x97T4q\x1e\x8d\x86\xdbu\xd8\x8eU*\nc\xecs\xc8\xb3\xea\xe59\x8d\xad~\xe6I\x05\xfb\xf7\xf6\xdaq\xd94=C\xcd\xe4\xf2|\xe0z\x8d\xcf\xcf5\x9d\xfbb\xd3\xac\x86\xabL	K_\xb0m\xe3\xa3\x92\x896\xed\xdb\xa2LI\xc5g\xe3\xc5\x7f\xda`v\xb96_\xe6Q4HPv\xcc\xfcRS\xdf\xf3\xf4\xb9\x8d\xf0eD\xce\xeb\xc1\xbd\xed\xc0q\xf1`\xbbI\x13M\xea\xcb/=\ng\x92\xfaF\xfe\xd9\xee\xfc\xeb\x9di\xdb\xab\xfbt\x80\xa3\"\xcc\x925S\xccK;\xfc\x1c\xb9\xdeJe\xf8\xd9\xb5\x06\xf3?\xb3\xb3<-\x9f\x00M\xae7\xb3\xa8\xd7\x9dO\x85\x03\xd1\x19s3\x1b\x12\xeby\xbeW,ws\xd4\x9fC\xdb\x9b\x0fx\x10\xe3l\x16\x98\xc9\xbe\xdb\xe5\x18\xb3\xf5b{-\xd99\x10\x98\xdd\xa4\xa9oY\x8acI\xf0]\xff\xe3\x96\xda\x0f/D7\x97\x9c1\xae\xa9G4\x820,I\x95SX\xad\xf7\x14\xf4\x86\xd7v=\x8cx\xac9\xbd\xc2yTKY\xcb\xafk\xd3\xce\xf4\xc2\xbe\x1c\xdf\x0e\x9c\xf4T\x1em,\xf1I\xf7j\x86\xea\x0bU\xf9\xbcIb\x10M2\xe0\x12\xbb\x11l\\\xe2\x13\xf4\xda\x8eXj\n2zqQ7\x99d\xa4Y<NYwu\xf7\x0ei\x88\x93\xfa\xcd\x0b\xc7\xae\xdb\x87\xeak\xce6\xd4\xaf\x80}\x0d\xae#\xfbW\xce\xed.\xdd\xc3~\x15\xaf\xc6\x1b\xc7\xc1\xbc\x7f\xa28\xc1\xfa\xfc=\x1dr\xd8\x19\x80\xfc\xce\xf3\x9c\xf7\xc5\x06\x9f\x94r'uR\xc7'\x17\xf1\xc0\xf2\xde\x99\x005>\xf3\xaf\xc2\xbf\xb5j+\x1b\\?\x1e\xe9\xdb\xed\xed\x93\x82\xd4\xfb\x0e\xe2\xe9\xb5\xbb\xb2T\xbb\xdc\xcb\xbb\xa0\xdd\xecz\x1e\xb9~.\xa6\xc6\xfd\xec\x9bl\xf1S7\x0dMc\x1c\xd4\xd8'\xb34\xbd\xe5L\xb1=\x04\xb3\xf0\xdc!\xe1\xd7\xd9\xe3\x07;mj\xb5C\x08\xec\xaa\x83\xb1\x8a\xe6\x90\x96\xf1B\x91\x83\xb3vO\x15\x7f\x02\xaf\x87@\x1b\x98\xban\x87\xf7,\xb4\xda\xe8\x00X\x7fN\xff\x0d] \xf6\x10p\xe1N7N\x17\xf2\x05\xde\xce\xab\xfd\xee)\x8b\x9dT\x04c\x0d\x9d#\xbe\xec\xb06\x16\x8e\xb7\x1e\xe7\xc3\x07b\x0f\x01\xe7\xf7/\x13\xdf\xd41<\xaf\xa6\xaa`\xac\"\x08\x97l\xd7\x8f\xd4a\x97\xba\x949S\x02*\x82\xb1\x8a\xa4 \xfa\x04a\x96v/1<\x1b\xa7\xab\x15\xb1H\x9b\x1a\xa0\xc6\x01\xda\xea\x9aE\x98\xa5\xddK\x0c\xcf\xed\xdc<\x8f\x87@\xec\xa1\x0f\xeaK\xbf\n\xa9qJ>\xa14\x16\x88=\x04\x1a:\xf1g\xc2)\x1e\xf1\x14MRM\xd5\x8dG\x8d\x03\xd0\x0c\x0b\x99\xe2S\x83t\xdfl\xe9O\x1d0\x10\x8cU\xf4\xc9\xef\x8d\xfc\x11v\xb9\xac@\xca\xb4\xec~F\x1d\x8cU4\x87\xf7\xdeV\xda7\xbee\x0f\x88=\x9c\x949\xca\xa4\"uy\x99Q\xeaF\xb3'\x15\xc1XCC\x8d\x1a\x8a\x18]c\x97\xf8^sVV\xa6\xbe\xd9\xa4\"\x18khX\xd0\x0f\xfa\xa8\xac\x9d\xf7q\x8e\xdei>\xe4	\x8a=\x04b\x07\xf1\xe7\x8f|x\xf2)\x8a3\x04b\x07\x15\xef\x9e\xc7'A\x88\x12\xa9\x0bbV\xc3XE\xd0\xd7\xc3\xd6\x158\xf6\xd1\xbfz\xdc\xae]I\x0fX%W,\xaf\x87@\xec \xfe!\xf2I\xbb-{\xb6b\x9fW\x8c\xd0!% \xf6\x10\x88]\x9e?=\x8f\x00\xc4$\x19\xba>\x8e,\x82\xb1\x8a\xe6b\xd5\xb4\xc8	Y\x0cw P\x11\x8cu\xcf\xb4M#\x1bs\xa7\x17\xd5\xda\x954\x825s\xc9\xf2z\x08\xc4\x0e\xe2\x9f;\x9b\xe4\xdb\xb0\x97\xf5GyZ\x94}\xfa\x11\x88=\x04\x9c?\xf8\xb5\x1d\xdd\xb8\xad\x8f\xce\xa1C\xa0\"\xe8\x83K\x1f\x9f|\xf4\xeef5=\xf3\x80\x0b$ \xf6\x10\x88(\xdf.*R\xa2P6f\x9f\xef5\xdb \xef\xbb\x06b\x0f\x01\x17\xd6M\xe3t\x06;\x9b\xb17K\x86\x95\xffj\xa0\"\x18\xeb^\x81\x9b\x89^\xcd\xb9\x16\xb9\x96(\xce\x10\x88\x1d\xa4\xf1'\xe4 p\xf5\xd1\x01w\x0f\xaap\x82.\x10{\x08\x94\xd08x\xa7\xf3\x1c\xa8\xb9:\xb2\xdf\x0d\xa5\xb1\x93\x8a`\xac\xa1v\xefT\xee\xb1y\xaa{b<j \x10{h\xed)\xaf	l1\xc0Y4On\x19'\x16\xc1XEI\xb6\x04\xac\x99Kc\xa9\x8c\x90\x97\x0coL\xc7\x93\xb5L\xd48\x00\xcd\xae\x0dB\xa4\xf4\xb4fI\x06\xc8\x9cOr\xc2IE0\xd6e\\Wv<\xeb\xf8\x82\xed\x9e\xd3\xeck\xa8q\x00B\xa9\xff\xcc\x83X\x81\xbb\x08\xf3\xf9\x9d'7\x04b\x0f'I\xc9\x91R\x1c\xed^Gjv\xe1\xb1\x96\xa50V\x11\xcc\xb5\xd1\x1a\x16\xde\xa62=\xca\xca`E3\x04b\x0f\xf3Mq~[\xe8\xd8S\x83~\xac\x118m\x07P\xe3\x80\xe7ho?a\x0b\xaf\x17|\x8aV\xdc;\x95\x04P\xe3\x80\xe7\xe8\xe0\x87\xbc\xe1\xe3\xc5\x17\xf2\xe9!\x18\xab\x08:\xdf\x80Ih\xbf\xf5TR~U\x88G=\x04b\x0f\xaf\xfd\xe9\x90Z4\x9a\x92\x98\xeb\xa4\x03\xee\xaa=\x99\xbb\x1a`\xac\"\xe8\xcc/\xe6\xady$\xac\x9e\x9e\xf3<j\xbd\xb7\\\xc2\xd7P\xe3\x00o4\xf1)0\xf1\xf9\xe2\n\xee\xbe\xbf\xf6\xa4\"\x18k\xe8\xc9.\x15\xd1TO\xd9\x7fN\xb58h\"\xf7|\x18\x92\x0c\x81\xd8C\xb9\x9f\xfa\xd7\"RK\xb3K\x86Y\xf8\x9a\x87\x03\xd48 \x99\x9a=O\xb6]Z\xd5\xa5J}\x07\x02\x15\xc1X{\x06\xc4\x01\xf6\xce\x0fs\xedi\x83\x06	\xbb|y\xa8q\x00\x9a\xd3\x06\xd3\xaf4\xf7\xc2\x90\xef\xe5=\xc1\xad\x145\x0e@\x9b\xcd\x86\x05\xee\xbd %v'X\xc6\x89E0VQ\xe2\xe4\xcb-L>\xfe\x82\xb8\xc8G\x13\x81\xf1v\x81z\x08\xc4>i\xbf\xdf\xdb\xdd<<\x84\x84\xb8\xbbV[\xf1\x0e@\x8d3ID.V\xc90;Pf\xe4\xa1z\xf2\xa8\x87@\xeca\xb9\xe3\xce\xda\xd0\xbe\xef?\x8f\xfa\x8b\xb8\xa6}\x98\xd9\xa4\"\x18k(\xfc\xedt@\x96\x0eM\xb5K\xed\n\xe1'\xe9,\x18\xab\x08&\xb3\xf3\xa4L\x16`\x10\x89|4\x99\xe7\xf77\xbeO\x04c\x155\xe5\xf1\x136\x1f\xff\xc2^\xdcc\xf2\x19\x8a=\x04b?\xef\\\xe7\x8c\x92I\x05v=\xb0\xbc\xe9@\xa0\"\x18\xabI\xaa\xda\x85;\xa7\xf8\xc2\xb1\xbdf3\xecJY\x19\x10{\x08\x840\xa2y\xa7\x8b\x1cw\xee\x00\xe9\x9f\xdb\x0fs*	\xa0\xc6\x01\xc9\xc2\xb9\xe3\x93\x1d>\xe9h\x0f\x15\xbe\xb6\x9e\x06\x81\xd8C\\\xe4R\xaa\x94\x9d\xd2}n\xb58hA\xcf\xf5\x1d0\x10\x8c5\xc4-cE?\xcf\xcf\xf3c\xf2\xbduL\x92V!\x18\xab\xc8\xcf\x9c\xef\x88\xf8A\xf2EU?\x83\xc0i$\x80\x1a\x07$\x13\xd7\x9d\xb2\xef\xcc\xa6I\x85U\xe3v\xbc\x9c\x81\xd8F0\xd6\x90\x12\x13\xc9\xc4^\xf2\xe8\xd1K\x01\x15\x03s\xda/\x006\x82\xb1\x8an}qZ\xca\xb4\xcd\xcb\x81<\xb0\xbc\x07)-\xe3\xfbD0V\x91\x99q8\xcc\x16F3m\xb3v\\\xfe\xc5>\xda\x8aw\x00jl\xfcT\x1f\x8d\xadg^`\xb7_.\x8f\x15\xd5\xeb!\x10\xfby\xb5_\xec\x8f\x02\xf6v9\x01\x0f,\xef\xbb\x0bO\x8d\xcf\x01j\x1c`\x861\xf5\x1a\x9f\x9a\x11\xe0h\xd2\x9bO\x98\x8f[X	F\x13j\x10\x88=\xf4%\xe3p%}o\x90\x9e{[e\xa8\x9e\xf0\xf8b?J\xe3s\x80\x1a\x07\x98\xa1\xf3A\x81g\xf3\x0d%\xee\x8e\xd5T\xbc\x03P\xe3\xfaO\xb5sF\xf3\xd0<\xb6\xfd\xb2~,\xa9^\x0f\x81\xd8\xcf\xc8\xf7\xbc\x1d\x0cF>z\xe1\xd9\xc0n U+\x8am\x04c\x0dU\xd3\x0dsJ\xe2%\x9fT\xb3;\xe3N\\RQ\xe3\x00\xd4OU\xaf\x9d\x82\x94\x90^\xedr \xb0\xebL\xda=\x04c\x15\xcdv\xb4\xbb\xb8\x1a8\x9aTh\xa2W\xff\xb8\xf0ak\x1e\x82\xb1\x8a>\x1e7\xed\xe8\x11\xf3\xbbxTj\xd1\"\xde\x01\xa8q|\xc2\xf8\xb2\xb5/\x8f\xfesry`y6\xe4A6\xa9\x08\xc6\xc0\x8b\xbc\xa1(\xbd\xb96U\xef\xcco\xed\x04\xf6\x04P\xe3\x00\xaf\xf2\xb7\xd1su'\x91P\xedr \xb0C\xa3Q4\x08\xc4\x1e\xfa\xa2\xf8c\xd6\xb7+\xcf\xde\\\xeb\x03\xc6zI\x83x\x07\xa0\xc6\xf1\x7f\xdf\xa2\xb5\xb5\x87\xbf\xd4l\xaf\x85\x167SZ\x06\xc4\x1e\x02)T}o\xd0\x06_\x1e\xf1\xe7\x14\xf2\xca\xb7l+\x0d\x02\xb1\x87>\xaf`\xe2\x19h\x9e\x19\xf3\x05/{$\xc5\xc90V\x11\x8c\xe7\x86\x8b\xddqVQ\xde\xa5\xaa\x0d\x9aA8\x95\xa2\x19x\x07\xa0\xc6\xf1O\xa5\xbc\xdb\xce\xa0&x\x8b\xbbo\xb1\xb9/\xa9\xa8q\x00B\xd3\xaa\xf8\xfdKKB(\xad*\x8a\x9d\xefy\xde\xd5\x00c\x15A\x18\x12'\xfdK;	L<\xa1\xec;\xc3U\x12P\x11\x8c5$\xd6#\xa2~\xb0\xf3\x8b\x89\xef5{\xe5V\xca\xc8&\x15\xc1\x18xv_vC\x92<a\xf7T3\xa6l\xed2\x80@\xec\xe1\xdc\x07\xe2b\xec^\xb0#=\x8a\xc1m\xbe8\xa7\x03\x06\x82\xb1\x86\xc4\x05\x910E\x0b_\x07\xdbG\xf5\xce\x1c?=\xc4;\x00\xcd6\x9c=\xa8\xcc\x9e]\xbb\x92\xb6\xb3\x14\xf5\xcd&\x15\xc1\x18xl\x9b!-\xcf\xe5\xd1\xd5F\x87W\x0c?\x015|\xab\x08\x063s\x0f0\xd4\xea\x90\x0fO\xee7\xbeY\x04c\x15IA\xb5g\xb2\xe8i\xe3\xa1I\x86\x8a\xe2\xc8\xd6\xcc\x00\x07\xa8q@\x1b:\xd9\xab{\xce\xa3\xabI\x86\x92\xf5\xddw\xcd\x84g\x0f\x81\xd8\x9b~\xda\xfdp~\xf3y`yl!,\x9eGA \xf6\x90?x\xf3\xd0M\xcf\x99=-*(\xe54\xd5\x11\x88=\x04\"\xfal\x9cMZ\xf6\xec\xbb\x85\xa7E\xb1vKe\x9dt\xc0@0\xd6\x90\xd2\x8e;}\xcf\xbe\xb7t|\xafX\x81\x07T\x04}\x10\xeb\x9a\xc5\xaf\xa4i?=-\x9a\xe4\x93k\xc7\xcf\x8d\x1a\x07\xa0\xcd\x16\xaeG\x96O\xa1:\x07v\xfd\x03\xa7\xfcu\x1a	\xa0\xc6\x01\xd4\x0c\x94\n\xdaZ\xe7\xfb\x1dO(\xc5)\x10\xa1\x07T\x04}\xcd9:\xda'<y\xccx\xc5\x00n\xadkb\x0f\x81\x86\xa2\x9b\x14\xdd\xb1\xe3c?\x1d\xed7ze\xd9\x89:\x18\xabHJ\x14\xb8\x92\xa6\xcdxZd\x91\xf2\xda{;\x04b\x07\x17\xea\xae\xc8\xc5\x12<\xd5\xe4{\xa1\xbc\x97g\xf4\x86\xb1\x8a`1W\xcbmO\xa5/\x8f4?\x95\xbcb\x0c\xec\xb87\x88w\x00j\x1c\xfc\xe4\xb5[\xe8\xc0{\x83\x8d\x08\xbd\x11c\xbeh\x04\xcf\x1e\x02\x11\xe5\x8c\x110\x15\xee\x14\xba=\xd5t\xebTi\x0d!\x10{8\xf1}\xc4\xbd\xcf\xa4N\xe6\xc6\x1c\xbd\x13:\x88\xff\xd6G\x07\xc4\x1e\x02\x19iXk\x029\xbax\xdf\x97S{jR>q\x1c\x08T\x04c\xb5W\xed\xd0j=\xe0{\xf3\xc6\xf7\x1a4X#\x17-\xaf\x87@\xec\x93Z\xfe\xde\xbfNL\x9f\xcfXN\x8e\x00\xe3H\x8eh\xee\x9d\x1d)-\x03b\x0f\x81\xfar\x17\xde\xed6_\x1e\x93~\xc4m\xba\x11\"\x8a+~>\x01\x81\xd8\xc1(\x98iq\x91\x83\xda\xe5\xc0\x1e\x0dk\xe1\x01\x80\x1a\x07\xf4\xf3\x1c\x17Y1\x0e\xfe\x18\x9c\xdb\x8d\xd9/\x9c\xc0\xde\"\x821\xf0\x1aQ\xbe\xd3N\xd27H\x880\x9b>\xa7\xd89\xee\xa4\"\x18\xebr&\xe9\x85\x1e\xcc\xd36z\xe7\xe7\xf4+\xfb\x82\x10\x88=\x9c$iU\xad\xd5\xdb\xfcp\xb1\xa7\x06-,o\xb5/\x0d\x02\xb1\x87\xa6\xba\xb4\xcc\xf3\xed\x04\xb4\xb7\xd7zw\xb0\xde\xde \xde\x01\xa8q7R\x96o\xbd\x93\x96\x0d>D\xe8\x8d\xa5\x99?\x1b\x7fR\x11\xf4\xf5P\x01\xe6\xab\xf1\xe7\xa5\x84\x8a1\xd8t\xa6\xa8\x1e\x82\xb1\x8a\xf8\xb4\x92zN\x96\xa8\x99uj\x97\x03\xb2->\xaa\xa8\xf4\xe1[E\xd0YR\xfe\xcb\x83C\xfczach\xaa\xc7\xc3\xac2\xcf|\x82.\x10{\x88\xcb\xf5\x00\xee!t(\x99J\xc4\xb3\xfe)\xcf\x84Z\x18+\x00*\x82\xb9\x86>@\xc2\xeb5\x1e}^2\xbc:\x12Br\xbbQ\xe3\x00T/S\xf2\xea\x86D\xbdd)\xd33\x9c\x14\x95\xf1\xcd\"\x18k\xc8\x19z{\xe1\xd7'\xdc\x0d-\x8a\xb5[*k\xd4\x01\x03\xc1X\xf74\x80\x19\xfbSk]n\x8d\x93\xcc\xed\xadt\x1b7\xac\x92\x18\x90;\xee\xa4\"\xe8\xeb\x1b\x06'P\x04\x867\xcf_\xf9\xe7\x08;\xc9\x99lyA\x08\xc4\x1e\xfa\xf2\xf19\x85\x8f4\xa6\xd3\x9c\xf2\x92\xe1\xed\xa4r\xd4a\x07\xc4\x1e\x023L6\x0c\xecO\xb11\xf5\x08\xbe#RR\xc6\x89E0\xd6P0\xcc\xe2\xc2\x19O\x7f6\xf4(\xd6\xee&Jr\xe3\x06\x005\xee\x84\xff\xa8<'u9\x19\xec\xfa\x07\x9e \xe4\x08	\x88=\x04f\xa8\xe1\x81\x93\x8f\xa6\x98\xec\xcc1\xb6\xdb\xb8	U\xb9\xb3\xdf\x04\xac\x93\x8a`\xac\xce\xcb\xf5!\xb3\x18\xdc\xe9\x05W\x8b\xc8\xd4\n:\x9d2pY\x07\x0c\x04c\xe0\xf1L\x9er\xad\x11\x9ck\xceHqU\xd6\xd0\x01\xa8q|b\xf8U\x1a.\x8f\x1a\xbc\xc5\x1d:\x9d\x86\xb1\xdfJ\xdeF\xe0\xf5pR\xc6\x84u\xa9\xa0\xc5\x08\xa9\x0e\xd9\x01f\xe3\x1a\x89\x89\x87n\x07\xa0\xce\x01\xc6[\xf34\n\xd8\x08\xaf\xd4\x1c\xf3\xa6H\xf1<\xd48\x00u\xf643\x83\xfc\xd8f\xae.\xb9\x0bM\xd0/\x84\xa4\x06b\x0f}\xa1(\xef\xac\xa4\x88Q\x962\x05\x0e\xa4\xd9\x8b\xa8q\x80\xd7\xe1\x19\x8b\x9d$\xdf\x06\xce\xfb\x12vg9\xa4\x8cTR&\xb8x\x18\xabh\xceF&{\xb1\xc6\xaf\x16v\xe7\xa0\xf8G\xa0y\xe8v\\:\xc6\x08\x96e\x83\x0eu\xa2\xed;\x00u\xce\x1c\xd2\xb6AO\x94\x94\xc9?\x85\xa5sY\xf5z\x08\xc4\xbe\xcb\x85#\xee%\x8a\x8e\xa0\x98\xd9o\xf5\xe6\x95S\x94\xd5\xe6F\x8d\x03\x10\x9a:\xf6[\xdb\xdf9\x0d\xd3\xaeR\xd9\xe7\xa0)\x19\x17\x8e\xef\x13\xc1XC\xde\xd1\xcd\xdf)\x0de\xfe\x16\xc9\x97\xb1fR\xe6\xa1\xc6\x01\xa8\xb3\xb5\xfc-\x9b\xa4\x13\x9c\xa2\xfa\x16\xd0\xa2\x9a\x0f\x9e%_\xf0\x02\xa8\x81\xfe\x8b\x17\xce3<\x8d\x85\xe4\xa5\x0c\xdc\xb0\x93v.}\xdao\x18\xab\x08F!E\xbf~\xeb2\xc8\xa7(\xd2\x86Dro\xcfk \x10;\xa8\x16\x11\x87]\x81\xf4\xe1*\x9d\xb4m\xa4\xf9;'\x9c\x92\xe0\xf9	\x04b\x07\xdd\x93ph8S\xa6\xbd\xcc\x86\x93\xf2\xbc7\xc1\xb4\x82V\xf3\xd2 \x10{X\xec\x0bYj\xf7\xce\xa7\xac\xed\xaedx\xb3\xd4\xbb\xe3\x1d\x80:\xc8X\xa4\xf0uneK\xb6E\xbd\xc5\xc6{\xc26\xd1K\xd2\x86\xaa\xf4\x19\xa9\x13\xc1XC\xcb\x86\xb4Cp\xc0\xee\xaeZ\xd2\x95\x96(\xbe!\x1a\xd4o&\xde\x1e\xba\x1d\x806\xc7\xc7\x081\x1e\x84S's\x97\x11^\\'\x01\x15\xc1X\xf7$\xbf\x80m\x86p\x08\xc9xH\x00\xd1<-\xce\"%a\x9cO\x04c\x0d\xb5\x1d\xfa\x1e|\x0d\x02\xc7\x96\xb6\xf0\xb0D\xf1\xed\xa0E\x8d\x1eJ\xe3\xa1\xdb\x01hs\x1c\x85\xce\x0cwz\xfej\xee\x05\xd3C\xfc\x91\x00w\xdcIE0\xd7\xbc!\xdeQ\xbb<\xe9\xd3\x19\xf8\xdf\x81\xc6\x95?5\x18\xab\x08\xc63\x8c=\x96\xe6\xfb\x8e\xd1U;G3\\\xb9\x8d\xfc\n\xa2\x1f\xc3XE06nN?1\xe5\xb5/\xec}\x8bJ\x0c\xd1\x8f\xd5\xac\xf6\xa5A \xf6\x10\xec!Y\x1a\xf7&,\xa2\x9do\xf4Pq{\x83x\x07\xa0\xd9\x06{$\xad\x8b\xf6\xb4\xb2\xce\x8a\xf5\xbey=\xa4W\x95\xb7\xb7fO*\x82\xc5,\xe6\x19\xaf\xa37T\xc6\x99\xed\xafz\xe0\xf5\xb5.g1W,\xaf\x87@\x1c\xdalq\xbaUX\xd7Z\"\xe0\xf4=\x0d\x8b\x1f4\x18\xab\x08:\x8b\x0b\xcb!\n:\xbcR\x8b{wO\xee7\xbeY\x04c\x0dy\xd9\xb3\x93qk\x81\xf7-\x861D\x1fV9\xb84\x16\x03\xaf\x87@D4ak\x8cy}\xdc\xf9\xb9\xae\xb5D\x04\x93yJ\xa5\xa0k0V\x11\xcc\x17\x000\xed\xda\xf4E\x0d\xbd\x98\x9e\xccWc\xea\x9a@\xdd>@\x0d4S/\x81\xcc(\x8e\x93\xd6\xfd\xea\x85{\x11+\xfb\xf1\xbc\x06\x02\xb1\x9bw\xe5P\xaf\x95\x9eY{\xab\xdb\x87\xb3\x0c\x1f\x06\xa4\xea}\xa3\xc6\x01\x1e27\xce\xa4\xa7\x8c\xb4>\xd1\xad\xac#t:\xe5\xe0\x1d\x8d\xcf\x01jl\x1c\x17\xcb\xdbHM\xea\x12\xf5(z\x0e\x1a\x91q\xaf\xf1}\"\x18\xeb\x9eab\xe6\xc5,\xb3jo\xb5Q\x92R\xcf\x11\x12\x10{8\xa9I\xe4\xba\xb1\xec\x8f\x8f\xf7M\xd4\x1c\xd1<-. \xa5\xa1\xbe:\xe0\xf5p\xe2\xa6\x94sng\xe2o\xab\xdaG&>+\x1d-X\xf5:\x19\xc3\xf1;\x80\x1a8Mc\xc2\nx%7\x84Q\xeb\x12\x0f\x99\xe6\xa8\x18\\\xb2P\xe3\x80y\x1e\xbe\xe0\xeeC\x87\x97\xf0\x96\x0c\xce\xc2\xc8\xf8\xf7\xd3\xaea\xac\xa29H-\xd5Y\xf5\xf9\xc9>l\xbc\xe2i'RX!\xe3u\xb2\xb9Q\xe3\x00\xafze\\y]hQ\xdf\xfc<\xd3\xbajw\xcf\x80\xbe\x1e\x8c`\x9e\xc0j\x16\xde\x01\xa8s\xea\x84\xe4C\x00\x91>R<\xf5\xbb\x83\x16\xc42\xa6\xe6\x94\xde3\x97\x8a\x1a8\x1dc\xc2~\xf0Q\xbe\x19\x93\x0fx\x00\xee>dcJ\xcb\x80\xd8C_\x92\xcb;+\x18\xad\xea\xc3\x16\xc4\xf6\xcex\x06\xa9=\xda\x81w\x00\xea\xec:\xbe\xe1\x14}\xee;\x8b\x92sPI9\x1d7\x1e5\x0e\xf0P\x98q\xc7\xf5\x91$\xfd\xb0<3\xf2\xf6g\xe0\x9e\xf9\xce\xc5d\x12C\x1f\xa0\xd9\x86\xc1\xd93@\xec\xde\x16;\xcf\x9f\xf7Y\xeb\xda\xc0\x08\xa5\x11\x8c<\xa6\xc1IE\x10\x86>G\xffb\xa3\xab\x0biU!\xc4\xc1\xce\xb6\xa4a\xa9\xcf\x05\xc6\x1a\x9ak\xc4}'\xed\x9b\xf8>\x94V\x0dB\\\xb7\xd8\x83\xfc\xdd\xa8\x87@D\x1dC#\xf4\xce\xceq\x92A\xcckG\xad1\xc2\xe4y\x0d\x04\"\x9a\x9b\xdc\xe2\"\xca\xb2\x9b\xbd\xe8\x8d:t\xd2E|e\\x^O\xc9C\x8d\x03\xb4\x0d\xac\xa4\xe9\x83{\x89\xc6\xd9\x021\xcf\xef\xe8U\xa8\xd3\xc9\xbe\xd8\xc8\x97\x02\xd48@[9\x9eT\x1fn\x07|\xc7\xb3\xc2\nm\xa2v\xe7\x9f\x88>\x85\xc0\xc1;\x80\x1a8]\x9fHH\xe9\xcc\xf0\xc7]Ze\x0dv\xfa\xa1\xc2Yr\x19\xa0\"\x08g=\xcdX\x9aY\xe4Y[\x1d>\x10%p7\xbf\xca~UY\x0d\x1d\x80\xc6\xf1\xc5\x0e\xe7\xa8P\xd6\x1c5\xb7(\xc5\x01\x1aX2\x13\x1f\x1e\x82\xb1\x86\xf4v\xed\x95\xf7>W\xdb\xe5M\xbb\x9e\xe8!\xc9b\xa2\xe5\xa1\xdb\x01hY\xaa\x9aw\xbb\x08\x97\xd4w\xe1\x19Q\x19~\xc4\x17\xc7I@E0V)\x9a\xd5\xee5\xa9\xe6\x1f7\xf0r\xdf\xbc\x08\xee\x11q\xce\x19\xc7\xd0\x07h\xb6\xad\x9dE\xa4\x86\x86\xa9!\xd5\xd9<\xb7\xda\x0dky\x84\x0e@\xc3\x84\xd8\x92\xd5\xdaw1r\xef\xc2\xc0\x91\xfb\xa8\x87\xd3}\x18\x89\x07\xc4\x0e\xe2\xd4)\xbez;^XF\x8f\xa2'\xd2\xec\x9d5O+\x1d\xa9\x13\xc1X}\xda\xf1\xce\x99\x8f\xbcu\xb7@\xacS\xaeG\x13L+\xbd\xac\xce2\x80@\x1c\xe6A~\xb1\x01\xa6]S\xbf\x88vW\x0b\x8fD\xae\xe8S\x96\xc7\xf9D0V=\x99\xd5\x0b\x92Q~\x9f\xc0\xae\x17\x0d\xa1\x18\xa6\xa7\x98qyu;\x00\xb5N\xac2\x9c\xc2,r\x97\xac5\x1f\xc8&\xb8\xd1\x83u\x9f\xde\x1b\xc6*J\xdc\xec\xd1E\xcc\xe4\xfbFpN\x93\xee-\xa4T\n\x83\x06c\x15\xc1\x08\xfb\xbd\xc3s\x9d\x9d\xe5\xa7\x18\x9a\x9c\xe8%\xf9=\xf8K\xe3s\x80\x1a\x9b\xfb>k\x88\xf5\x8c\xe7\x1d\xde\xdb\xfeT\x19v6\x992z\x08\xc6\xbaWh\x85\xdb:/\x9f5H\xdbD\x0d}\xc54ke\\:R'\x82\xb1\x9aP\xe1\xaf\n\xbdA\xb39\xc7\xfb\xfa\x95*Si\x1e\xf5\x9f\n\x1c|\xaf\xf1\x1b@\xb3#?`xn\x8d\x8b\x7f\xad\xb5_\x1d\x96\xf0\xf4C:VV:\xad\xbd]x\x07\xa0z\xa9\x8d\x9d\xdf`6\x07\x9aVS\xab\xc3\x12\xbe~H\xd8\xcaI\xa7\xbc\xf33\xef\x00\xb4/\x9b\xb6\xb3\x07z\xc3\x87\xa6\xb5\xd4\xea\xb0D\xa0\x1f\x12\x9b\xb1\xdd\xa9\xed\xcd\xc2;\x00\xf5\xcbd\xed\xbc	\xb3\xa5\xd7\xb4\x9a\xca\xa6\xd2\xe1\xd6\xa3|(\x0c\xf8\xa9\xd4\x8d\x1a\x07\xcc\xd3+\x8e\x05K\xe8VQ\xce\xc8\xd8\xde\xa4\x95a\xd3*KD?\x86\xb1\x8apO\xd5\x0e\xfe\x0d\x02\x87\x91\xfc\x80	\xecJ\xb1\xd3C\x12#.=\x1d\\\nP\xe3\x80.\x8c\x94L\x82pm#m>\xde\x99\xd1\x8c,\xd33\xear\x974\xd7H\x00\xcd\x0e\xb5W&Y\x11\xbeM\xe0H\xfd\x04\x1b\xb8\x9b\xa5\xca3a\xf3\xfc\x04\x02\xb1\xf7\xb3\xdf\x11\x1b\x9b\xec{\xbe`FP\xf1\xdcI\xf3\xf0\x8c[*\x7fo\x17\xde\x01\xa8YZ\x7fQ\xef]\x18\xb5\xb9CkmN\xd21\xbaU\x9e\xb1\x9b\xe7'\x10\x88}\xb7\xbd\x9eB\xef\xfa\x8eg\xe0\xad\x9a\xc3\x11M\x0e\x1e\x1b\x8c\x17\xd6\x9f\x0ex=\xf4\xa5\xf1\x85@\xc8\xe5\xbed\xff\x02\xbb\xc6e\xba#.\x1e\x13\xd3jn\xc4\x19\x02\xadc\x96*}\xbc4\xa33\xb9H\x1f\xb3\xc4\x9b\xe4\xb3\xb1\xe8\xdf\xc3\xc7\xa5\x005\x0e\x98Jq!y\xec^\xe3\xfb\x19\\1_\xc7\x9a\xf7\xdd\xf9\xedd}\xc7\x9fT\x04\xa3\x80\x16\x95z\xf9\x8e\x89{\xf5v\x8d\xeb\x15\xd2\xca\x17k\x91\x8a;R'\x82\xb1\xaaewz\xfa\xd8\x10\xb9\xf6\x14\xf3\x82\xbf\xc3ld\x19V\xa9\x1e\xb8\x14\xa0\xc6\x01\xdf0\x83\x8c*1?>1o\x1a:(\x16G\xf5A4\xafB\xd3O\xe5#[^\x0f\xc17\x14M\x92\xfb\x82\x1bYt\xeb\xd5\x12\x02f\xb0\x1c\x12 \xb9\x83\xdd\xacf\xe1\x1d\x80\x96\xa5_\x9f\x83\xb6\xfa\x92*\x8f\x84\xe4I_\x98\x1e\xf2\xebT\x1f\xec7\xd1V \xf6\xd0\xeaG\xf5ad\xe8\x9d\xd9\xa3f\x11V\xeds.\xde\xd3\x97\xb9\xb3k\x04\x98Q{\xf6pR\xe9\x88[N\x0c\x96],\x01W\x8e\x1a\xc9sh'U\xd8\xd0\xe0XJ['\x15\xc1x\x86n&\xa4\xe8\xed\xea#\xc5z\xab\xc3\xcf\xd1g\xdcB4\x11\xf4\xfar\xc7\xf9D0V\xfb\xd8\x1d\xcew\xe3v]S\x8dmTH\x1e\xf7\xf2\xd3C\\IN\xaf\x08_\nP\xe3\x00\xf1\xa5b\x8c\x16\xa18\x10\xbd\xceH\xf5\xecl}\xcf\x1b4\x96a\x97*\xde\x9b\x85w\x00Z\xd3\x0d\xec\xbc\xdf!	\xefG\xdfV\xe2\x02-,\xb4\xd4n}h\xa8\x87\x93\xd6\xbfE\xa1\x9a\x15\xc2\x04J\xe0\xd5\xbe\x94!-|\xb1\x04)\xa2\x1b\xcd\x10\xc8\xfd\xcbm\xf4\xd4\xd6\xa2\x83G\xb3K\xba\x96H-\x13\xd10\x0c\x1f2\xb6@\xf3>@k\xbcp\xdf\xe6\x00\x96\xaf{>\x9f\xf0\xd5^\x17\xb3\x9e\x93;\xa6\xd2\x83\xb0I\x9e\xdd\xc1XK\xd2\x8a%-4\xbc\xa1\x03\xbdi\x9d\xf8\x19\xbc\xa7\xedU	\x99\x90\x9e5_-0\xd6=\xcf\xda\xb9\xfc\xce\xa6\xee\x19s\xb3c\xac\xb55O\xf9\xb8b@Y\xcf\xc7Q4\x0b\xef\x00o5\x18\xa8\xa42)\xe0\x8eg\x8d\x9e\xf9\xf6\x1d\xf7\x11\x96\xa4DZ!\xf7\xe9\x80\xd7Cy\xb7e\xd2\n\x02\xbb\x0c\x92\xdag?\x1d\x05WV\"\xf5\x9f\xda\xdc\xa8q\x80\xe0\xf2\x02-5@\xf2%\x9bjm}\xb4gg\xfa\xdaBy\x9aL\xdb\x9f\xa1\xee\xf5p\xd2\xa2XuV\x1d\xf3\x9e\x0fs\x02\xd4\xaf\xcfT?\xad\x89\x06\xcb\xd8\xe9\x14h\xc7\xef\x00\x9aaB\xb0\x80\xd1D\xf4\xd6\xceI{\x05v\xed\xf3\xfd3\xa0O\xc3\xe20\xf2\x07\xab\x05\xc6\xc0\xcb\"\x86O\x9f8LG\x8a\xf7\x96\xe2\x1dK\xb0C\xcaG\xf8r\x1a\xa9g\x93\x86\x1a\xc7'\xac\x17\x8b\xe2\x98\xeb\xa2@\xf2\xb4\xc0\x99\xf8S\x0e\xab \x13\x03\xaf\x83\xb1:S\xec\xaf\xea\xca>r\xeeQ\xea\xc8{\xee\xccq\xfbV^\xc6=N'\x86>@h\xda\xf9o\xf3\x02\x96\x97z\xbe0\x9b\xf6^\xc3\xf9\xc8\xc9#d\x83\x8cw\xc7\xf9D\xd0\x07`\xb1\x95\xa2\xa7\x9cL\xaa\x82N\xed*\xd5\x0f\x96MZ\x19\xfeW\x99\x85,{\x1d\x8c\x81\x16W\xf4\xd96N\x179\xd0kv\xf5W\xf33\x0e,\x8f#A\xc8\xfe(m\x05b\x07\x9b\xf8\x12>\xcb]\xc5>\xdf\xc5\x8d\xe4Q\xd0\x9bzRx\xb1F\xc6\x9eO)C\x0d\x9c\xa0a\xa2\xe8\x1d\xbf\xde\x84\x11\xcd\xb9\x93\x19\xf3\xa4%iN\xb2\x1fd\x85)\xe3\xc4\xd0\x07h\x98V\xb3\xe4\x1f\xc0\n\xa1\xc67~\x1d\xf9v\x97\x9b{\x06\xdb\xd3{\x08\x9d\xe7'\x10h\x9d\x12W\xdf\x83\xf1*\xe0G\xb8\xeet\xde\xfb\xe1\x96\xc9\xf7\x0c\x1e\x12\xe1u0\x06\x14\xa9\x1c\xec\x80\x8f\xb2\xef\x85\x96\xa8-\x90h^\x05W\x9e\xaa\xc5\xb7\x1a\x9cT\x94\xe7UO\x8a&\xa0\xf6\xf857\xa4>\xc2\xf9x\x15\xe0\\;\\\x1d\xaeO\x07\xbc\x1eZ\xbb\xb3\x93\x12\x0e\xee\xe5\x1c5\x10\xc4\xf0\x19\xfbE\x89^dcq\xb2/\x05\xa8\x81\x13\xfb$\x95v\xb1\x8d\xb9\xa22\xd5\x87\xdb&\xad\x99\x10\x9e)!\x94\x1a\x9cT\xe4\xe6\\@\x82\x87\xdb\x01\xab\x19%\xdb\x97\x90\xee\xbb\xcb!\xf1$\xff\xab\xac\\\n\xbc\x1eN\x8a\x9c\xf0\xf4\xf9\x82)Zx4\xf9\xe4\x04\x1c\xdc\xa3\x1fm9w\xa7\xd19\xfe\xa4\xa2\xc4\xb9,\xae{\xcf\xb2o\xe7\x96g\x8dA\xbf\xbf\n\xbe\x8cML\x12\xc6\xf9D0\xd7\xdb\x85\xfd>\xbe\x08\xbd\xc19i~\xe0\xd5\xbeE\xe0\xc1\xeft-\xd6$\x9d\x18\xfa@\x1c\xb4\x97\xcd3\xfa\xb3\x8b%6\xe8\xb4\xe1\x0bfy<\xb0g\xfaHv.\x8a\x84F\x85o\x0d\xc5\xf5\xda\xcb?\x8b!Zm\x04\x03/{\xea\x0b\xa6\x874\x9c\xf2\xc0\xd2O'\x86\xb1\xeeY\xe2\xce	\xb7=\xad\x94V\xf9\\i\x89\xda\xe4\xa0\xc9^*\xa7p\x94\xc0>`\xac=_\xee\xc06\xf7-$\xf54\xf0\xa6\x1f\xd1NT\xb2:\xa9\x7fyE\x83\x93\x86\x88n\xfcb\x92\x05\xb3n\xf4:kQ\xed\xb9\x96\x13:#%\xa1\x92,~\xd1\xe0\xa4!bBR)p pNiJ\xa7\xfbN\xaaD/\x19\xb6\x10^'\x0c\xb8E\x04\x19\x82epv\xc5\xfd\xc3\xb0 \xb0\xdd 7H\x07.\xdb\xab)2\xd6 \x0d\xdf\xf1'\x15\xf9y\xba\xc5\xdc\xa1\xbc8C\x9a`|\xd6\x9d\xa0\x01\xd8\xfd*hq\xcb	\x04\xdb\xbf!\x90B]4\xbb\x013\xd6\xad\xfa\x80v\xe0e\xcf\xd9\xa4\x9d\xc9J\xb6%\x85\xc1\xe9:\x80:\x87bH\xf6\x0b z9H\x9e\x92\xf8\xac;1qJ\x82g\xb8o\x95\x18\\+\x02\xaf\x83\x96M\xc02N0BP\xc76#c\xbb\x19\xect\x10E\xc2\xa3\x90N67j\xe0dZdC<\x95'\xdeT\xa3\x19\xa3_-\xa9\x15vs\x13\xeb3,7j$\x10\xd1\x8cWB\x1c\xbfR\xddhZi\xe0e\x0f\xccBN\x8e>ZI\xefhR\x0d\x02m\x18\xd2\xc6J\xa5\xf8t9\xe8w\x03/{\xa0\x11\xb0J@\xd0b\xd3Qxh\x10h\xe8\xf5\x18\x15\x8cU\xa9\xa7 \x8e.y\xde\xb5)\x0e\x9b4f\x02_\xf2\x83\xe7'\x10(\xa1iz,\x0dc\x14r0\xdc\xee\xba\xe3\x93X\xbf\x857\x1d\xb2\x1c\xc3\xfb\xe3#\xef\x1a\x07(i\x94<\x04\xe1\x94\xd7\xbd2\x95\xc1\x12\xc51\x7f\xca\xf4%Ylz\xb2\x05\x9bxR\x86\x1a\xc7|U\xf5)\x1f\xec\x01\xb3E\xd3\xf8kJ\xb9\x98\x9f\xa3o\xa2G$\xefV|!\x12^\x07sM\xfc\xd9\xe5C\xf5\xfaX\xb2\xbd\xae\x8d>\xba3{\xd6\xdd>\xaa\x105\xc4\xab\x0c\x08\x95\x7f\x03\xb4\xc6\x83\xfc\xe9\x06\x8a\\\xd7\n\x07\x83\xe5\x19c\xee\xf5\xf4\xe50,x\xab\x9f\xf8\xae\x01j\xd2A\xdb\xf9\x16f\xd3\xc7\xf3\x05x	\x9d\xa0)>\xc0V	\x97\xbe\xec#\x92\x91\xdd\xdd\x0e\xb0\xf0M\xfb\xd4\x1d\xd5\xc9\xbeM\xd5\xef\x81\xd7\xbc\"\xd13\x12Y\x173\x89\x0c_\x9c\xf9\x05c-I\xb9\xe3\xd4\x0bA\x87\xd9\xdck\xfc5%\xcd-z\xd5\x03\x0f\xaeS.\xb9\x9c\x8c\xde\xd0\x07Z\xcf\xe9\x95\xf8\x18f7SN\xaf\xcb\xa3D\xf2\xf8\x89\xdd\x19?\xadd\x86\xaf\x9f~\x0cc5\x89x\xe5\x9c\xa1\xdba|5\xce\xa9\xb1\xd6\x12\xe0\x88\xe6E\xa8\xf8\xc6?j\x8c-\x91\x94\xa1\xd9\xa6\x88\n0\\Y\xf7hQ;\x1e\x1d\xf3\x97\xddk_\xe7\x88\xe6iq\xcb\xa9\x1c\x97\xf7\xdd\x807\x98\x06\xabe\\y\xa5hQI\x1e\x1d\xb3R\xed\xb5\xcf\xe9\x9b\xd40CA\xb6\x8f\xb7/\x05h\xb6\xb5\xfe\xc4\x19\x15\xf5\xb1b{]\x1ec\x8a\x8b\xaa\x0e\x85\xccyT\x80\x044[5\xe2\x0e}\xc1\xd3\xabo\xc6.4k\xc0=\xac\x05M\xf2\xec\xfd\x19\x14\xe7\x17\xe3\x943\xb0x\xa8\xc27\xf0\xe2}g\xf5\xf3[\xba\xbeF8\x81\x04\x8e\xc0\xab;q^_\x85\x0eN\xab`\xcd\xe9]jb?w\xfd\x8b\xbd3a@o\xc7\xf7\xfc\x1a\x1a\xf0\x16\xbd*D\xef++\x04\xfa\xfc\xdch\x86>\xab\x0fl\xfc\xb1\xbc\xfa\xc1!c\xb1\xec\xa6\xf6\xa1~H(p\xd3\xecb\xfc\x18\xfa@[\xd9\x95\x14>\x8c\xd3\xf1\xb3P\xed\xb5/*\x9b43J[\xf9\x85\xdc{\x1d,fN\x12\xaa\x1e\xa6h\xe5\xd18K\xaf,\xee\xe08b\x0c\xfbA\xfd\xa5<\x87]\xd8y\x07\xbcB\xf5`x\xba\x8a\xe3\x98\x9b\xe39Sc{^6\xbcq\x1f\xc2\xe7\xe4\n\xd6&-\x12\x88}\xber-\xf9U\xb8\x04\xb5j\x14\xf3\x81\xdf\xab\xbe=\x8f\x033\xab\x13\xc6\xaa$7\xd4\x8b\x08\xbc>i9\x1f\xfdh\xad\xec5\xf7\xe9\x10\x12x\x07Y\xdd\xc9s\xff\n\x00Fj_v\"\xe5\x81\x0b%\x80\xd6\xb8\xb5J\x0e_\x1b\x8d\xb4\xecI\x9f+R\x1f}\xc7\xa5\x9d\x94f\xfc\xea\xc2\x8cg\xa5\xa3\x01&\x1c\xd9YX4B_\xa7\xc6\x85G\xd4\"\xda\xb0\x0dB\x89\xb1\xda;Z\xea\xf4\xcc/\x18\xablwH\x91\x98\x9f\xb0\x86\xbe\xdf\x91\xbe\x9d\x8c\x0d#\x7f\xc9O\xe0*\xcc\x00\xd9\x1d\x06s?e\xdcu\x1a(\xb0\x13\x14\xeb\xaa\x03O\xdaIivc\xb8o\x1d\xbe\x80\xef\xa1\xc9\x89\xed#1H\xa3\x03\xf7\x16\x8d\xba\x86\x1e\xba\xea@\x9b\xf6S\x9a\x0d\x99\x92\xe1\x86\xca\xca\xa4\xcc\xeb\xe07{v\xc15Q\xce\xa4\xde\xa2\xeaux\xf4\x90<y&\xf3q\x9bNp\x80f\x17\x97j\xa9\xd0\xec\xbcwUZ\x93\xa2\x97!\xd9sv\xd2\xb2\xfa\x988eB\x97\xa4H\x83\xe6\xc1\x95\xec\xc6\xb3;L\xe6@,\xb8\x86\xd4+\xf4\xce\xc7Q\xd7P\xac\xab\x10\xe6\x9b\xb43\xb6v\xff\x82\xb2\xe0\x1fg|\xf1>x\xbb\x91:\xb0^6Pn&E^gF\xbb\xe4\x99`\xdf\xfc\x95\x11\xb1\x8add\xa5\xe2=)C\x9d\xbd\xcb$[\xb2\xdb\x07xu,E\xde`\xc6\xbc\xecI\xf2\xfc\xb2\xd8\x96T\xbf\xdc\xf7\x94%.\x04\xc0[\xd8\x90\xcdI\xc9E\x134=\xbf\x86+\x8eT\x10\xe1\xe9\xce\xf8O\x92\x7fp\xd8\x99eRfw0}\xe2<V\x11\x8c\xba\xa2\xd8\xad4x\x81\x88\xe7}\xd3[\x0e\x89'%\x8c\xdbH\x85\xe6\x0e\x11xC\xbe:\x1e\xc9b5\x81Zu\xe0\xd1\x01I\x1c\xa9\xc0\xc3\xc1\xce\xf8n\x15\xa6\x8f\x8f\xbbq!\xd0=9bX>f\x91\x06\xf7f\xe5\xdd\xad\x1d}\xdbC\xc53x\\ \x9f\xe8\xda!\x95\x90\xa4E\x1fm\xce\xa42\\\x17\x8b\xe1Y5Ng\x18\xb5\xee\xad5\xafiCK4OK}\xaf\x17&\x1c\xd5\x1dX\x03\xf0z\xce\xcf\xb8\xef-G\x82t:1\x8cG\x9b\xa7d\xb4\xf4\xfd\x8b`sR\xfa\xf4+	wa\xe7\x9d)\xfe\x14\xee\xcfN\x8a!q\x9f\x0e!\x81\xbe:\xf51\x81\xc5-\x14\xf5\x03\xb61ha\x91\xc0\x1a\x8c\xf8\xf6\xdd\xd0`4\x01	U\n\x85\xba\na1/y\xd4b\x95\x12\xb8:\x8c\x18~\x1a?\xef\n\xba\xfe\xeb'\xc4\xc8\x1116\xa4\x8aI>c\xeb~\x83!0\xeaN\x82Q>n\x03[32VG\xa4\xa9\xd6\xba\xb7=W\xee\xa0B\x9a\x15\xde\x1ce\x9d@	\x84\xda\xe7 Y\xaa\x02M2M\xff\xf3\x19\x8e\x16\xca\x9e\xcbUo\xf076|[f\xbd\x92\xf7\xf9E\xc0	M\xbbi&\xdf\x1c\xb7\xa1\xe2\x99\x9a:\xb3\xa3\xbe\xbf\xcb\x9b\xf3J\xf8eS]\x85\x0f\xd3@q\xf9\x8a2o\x98\x15{\xaf'\x13\xcc\x1bV}V\x84\x8f\xaf*ct\xb2\xd6\xbdm0@\xbeV\xef\xf5D\xefH\x1cu\xb1\x93V\x8e\xd3\xe6\xd1!\x9a+\xe3\xca\xe7\x93(\x1e\xed\xaf\x9et-\xa9\xbaS\x1a8\n\x0d`\x90@\x82\xc6\x0e\xa9\xc0\xf75l\xf9\xd5\x1a\xd3\xf5B\xce\x98_yX\xb5\x1bN\xd4'b\xe3\xd3\x1c\xd8\xad|\x87\x1a\x8f\xb4E\xb4\xe1<\xaf\xf9\xb8\x7f*\x81\x1942>\xb8\x8a\x8c\x1f\xe7\x04\xa8\xa9\xc5O6d\x7f$\xa7\xa3	r*\xff\x01S`\xd7\x9dd\xeb\xb4\x93\xd2\xac\xc6(\xaf\xaag\xa4l\xaf'\xe3\x9dr\xef\xf3;\xb0f\xd6\xcd\xee\x93{u@k\x9f\xfa\x98\xb8I\x92\x96Q\xff8\xa3\x86\xce\x84\xf4\xb4\n\xeb\x88|\xb48\xa9F\xf2\xf1#\xcf|\x98\xcd\x84\xca\x7f@\x17\xa8u'9\x8b\xf5\xbb~\x0f=e(\xbck\xc3[\x02_\xb6\xc9\x1c<\xe2\x17\xac\x88m\xb1\xc3\xe3F\xf6p\xbe\xdd\xa4\x95Q^\x13\xc0\x0e\x07F\xdf#\xf2Q=U\xb2\xdeH4\x18X\xfe\xady;i1\xc0\x0c\xe4\xda\xe7`D\xfb\xe9W\xc5W\xe3=\xbeP\x8f[\xd9C\x88\xfcg3#\xef\xc5N\x19{T\xf6\xeev\x96\xcf\x85\x13\x98oO\x1da\xf9\xa3\xf6\xcc\x0c\xd9\x90\xe10\xde?\x1ff\x1dBm\xae\x8aL\x90N\xd1G\xd7\xf3K~5\x1e]\x8ba\xc3M\x1d\x89\x06\x7fH\xafZ\xc0\x0e\x8f\"\xd9\xae\x0d\x1b~j\xcdH\xe8N+d\xd3\x8f\xe6\xd5db\xddo\xf0\x0b\x8c\xba\xd3\x1cvg\x82\xe24\x0e\xb5\x9b\x82\x87\xd4V\x90P\xbeFs\xdb~\xe4\xf0$\x9f6|*\x0c\xa3\xeb\x0d\x1ai6!\x94\xb4_`W]\x12\xe9\x89n\x93b\x88\xcc@\xe8\x18\xfd\xcb=\"s\x8bp\xb4L\x0d\xa77\xe9\xc2r\x05\xeb\xdf\xf9$\x9f\xd9\xe3\x02\xe09\x92k\x0d\xd4\xa7S<\x83\xe3\xa9\x10\x98%-\x95\xc0Ha\xe5\xaeF\x1e\xe1\xa8\x89;^\xaf\xdc\x9dM\xc8f?\xdf\xa7\xe9\xb7a#\x01y\xb2$\x0f\xa8\xd4b\xbe\x9aH\xd7\xf8\x07_\xb23)\x06\xabYD\xd2\x13%?\x9b\xb7\xd9.\x13\xf0\xb7\xea\x9f,\xde\x867\x00}U\xe9#t\xf0 =$6\x13\xf4;\x88\xbe\xe1;\xc50\xc0^\xfa[K\x12\xe7y\xcat\x13\xa7\\|\xe6\x93v\x17\xc5\xa5\x03\xb1s#({\x13\xf7^\xb6w-\xe7\xf3O\xfa\x0c:y1lI\xa2\x08O\xa6\x98#9\xbarjr\xa9J\x0bJl\xd6\xa3\xcd\x11$\x04\x806J\x8c\xb4H\x92_\xf3\xdf\x9f\xfey\x14\xcc\xadO\xf2\x14\xc3%\x12(\x11G\x9c\xcb\xe1\x1f\xf4\x80M\x9f\xd5\x9d\x06a\xb2\x94\xa4[\xf0*f\xad\xdd#\xfa|\x15\xcchf\xe7,\xb8\x9e\x94\xd2\xedxDo\xedN\xadK\x07b'\xad\x1c\xa7yc\xf0\x0c~w\x9cr\xa5\xfb=A\xd1\x7f1\xa6H\xeb\xa9\x8d\xc6\x8b5\xce\x8e\xc0\xba\x9f0\xb0J&\x17p\xed\x17c\xbe\x1d0\x05b\xddiPK\xe82\xe2\x7f\x8dA\x0b?\xf1\xfdV?-V1\xa40\xb0\xaf\xde\xcc\xb4\xf1\x15\x9a=\xfb\xc8x,\xdeC\xec\x85\xda\x17\x96\xbf\x1e\xd0h\x85\xa0\x8e5\x94-\x86\x0d;`n\xfe\xb1\xf2\xee\x9d.\xdc\xf9h\xa9sT&\x1a#\x8ew\x1bjKOU\xdf\x9d{$\xce\xaf\xfc\xe5\x08{\x90\xa7\xa5\x0e\xb0\xf4\x91\x1aC\xc4\xf5\xf3\xeb\xc1\xbd\xaa\x0ff\x1d'\xa6\x1dt\x89m\xc8i\xf1\xadz\x93\xac\xd4\x9c\xe7'\xe7#Y\x86\xccZG\xd2s\x86\xd4\x9a\xf3\x9a8$\xb0\xa42\xf2H`\xc1\xb7\xb1\xd3&\n\xbbbFI\xbck]\xcf\xf1\x18\xec\x94\xce\xaa\xc1\xfb\xf7)]\x89~\x94\xfa\xfb\x8b\x16\xbb\xe5)\x11%\x9e\x9e\xe86\x894\xb2\xfdFVq?\x04\xb7\xdaH~`\x11\xc6e\x97G\xa7\x0e\x17\xf1\xc9\xc9v\x95\xa2\x13\xe9\xf0\xd4m1\x00w\x9f\xe6\xc7:@\xa0?-.\xe6\xa1\x0f\xc2MZ\x99:+52`>\xf4\x9e\x7f\xd5x\x9b^_e\x19M\x05\x9b\xcc%\x81B\x92n\x8fo\x08\x17\xed\xde\x88\xef\x15\x9c\xfd\n%\x10\xd0{\xfaE\xd0\x99\x9e\xcd-\xa2\xd9\x81\x89^F\xd5\xfb\xa9\x93\xb5\xf9z|\xa5*\x92\x1e\xf7s\xa8\xec\x95\xfb\x9c\xd1\x97n\x8f\x95}\xc4\xdc\xd1\xd1\xec3[\x0d\xa3\xb4.\x9cd\x97\x95\x92\xcd\xb6\xcdR\x86\xe0\xe43\x02\xe9Z\xc8&]\x1f\xbf\xc2\xa5\xd3%\xe60[\x08Hq;\xb5\x94\xb9\xc8\xd7\x1b\xc7\x93\xfbK0[\x90\x0f)\xb6\x1bT$\x11\xd0\x18m\x04\xed\x90\xc6N\xd9\xd8w\xee\x1a\xc2\x87\xd5W,\xcb\xb2\x1cp\xbc\xb7\xb8 PU\xd5?\xff\xfc\xc5\xfe\xe7\xbf\xb1\xf5\xa8\xfe\xe7\xff\x0e\x00PK\x07\x08\xff9\xbd(^x\x00\x00\x12\xa0\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00img/supervised_user_circle-24px.svgUT\x05\x00\x01\x9a\xcd6_D\x92\xcd\xae\xe3 \x0c\x85_\xc5b\xef\x13c \x84Q\xdb\xc5\xacf3\x0f1\xca\xed4\x95\xfas5\x89\x92\xab<\xfd\xc8$\xed\x95\"\xfc\xe5\x1c\x03\x06s\x18\xe7\x0b}\xddo\x8f\xf1\xe8\x86i\xfa\xfc\xd14\xcb\xb2`	x\xfe\xbb4*\"\xcd8_\x1c-\xd7\x8fi8:\x8d\x8e\x86\xf3\xf52L\x1b\xcf\xd7\xf3\xf2\xf3\xf9utBB\x1aI\xa3;\x1d>\xffL\x03}\x1c\xddo\xefQ\ni\xcf	II\xd8\x0bE\xc4\xce\xa2\x97\xd1\x90*n#\xef\x02\xefh\xb1~\xeb=\xa0\xf5\xd4\"\xc4\xdeC2	y\x94\x80\xae\xadq\x1bL\x93\xcc/\x91\xdf\x0eoS\xaa`6\xef\xa2\xfd\x89\xaf\xeeK\xfd\x9e\xb9\xde\xd9\x12S\xd7{\x04;\x1a\x82m&\xedFu\xb0\x1d\x03\xbfE~;c\xc5\xea\xf0\xdb\xe9\xad\x82\xe0m\x91\xf4\x9dZi\xbd\x0b\x15\xf80\x07\xe4\xd4\xb3\"2r\xe2\x88\xc0\x8a\x96\x13|\xe4\x88R\x0bH\xec\xe1\x95\x02\xda\xcc\x1em\xa1\xea\x1a!Y\xa1\x1e\n\xe9\xec P5=\xa2\xb3\xccB\n\xd1\x1d\xdan}\xf5Fz\x86\xda\xf5 m\xf7\x81\\\x18\x12g\x8e\x90\\\x8b\x8eJ\x8a\x12Y\xe1\x83\xf5o\x83\xbd\x11\x8a\xa2\x08\x85\x02\xbaH\x1e\xbe\xd6\x97M\xce\xb6BK	R8#\xa6J\xabk\xf6\xf7\xf1\xf7z\xbb\x1d\xdd\xe3\xf98\xbb\xfaV\x84d\xd08k\xfc%\xabkN\x87f\x9c/\xa7\xff\x03\x00PK\x07\x08uq\x02\xd2f\x01\x00\x00\x9e\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00style/main.cssUT\x05\x00\x01\x9a\xcd6_\xbcX\xdf\x8f\xdb\xb8\x11~\xf7_1up@\x12\x88:I\xb6\xec\x9c\xf2\xd6+\x0e-\xd0\xdc\xc3\x05}\xe8#%\x8d,v)R \xa9\xb5\xf7\x16\xfb\xbf\x17\xa4\xa8\x1f\x96\xec\xddM\x1fz\xb8\x0019$g\xbe\xf9\xe6\x9bQ>\xc3\xf3\x06\xa0\xa1\xea\xc4D\x06\xd1\xd7\x0d@K\xcb\x92\x89\x93\xffE\xce\x98?0C*)\x0c\xd1\x8d\x94\xa6v\x9bT\x18F9\xa3\x1aKg\xd6\xc8?\x89\xd4\x97\x95\xddI\xd1']P\x8e\xf3\xcb\x0c^\x0c\xd1\xecO$\xb4\xfcO\xa7M\x06B\ng\x91\xcb\x8b\xddpGs\xa9JT$\x97\x17\xbb\xe3.\xaeh\xc3\xf8S\x06\x84\xb6-G\xa2\x9f\xb4\xc1&\x80\xbfr&\x1e\xbe\xd1\xe2\xbb\xfb\xfd\x9b\x14&\x80\xedw<I\x84\x7f\xfdc\x1b\xc0\x1f2\x97F\x06\x1b\x00\x80\xed\xdf\x91?\xa2a\x05\x85\xdf\xb1\xc3m\x00\x9a\nM4*V\x8d\xefX\xdf2\x88\x156v\x893\x81\xa4Fv\xaaM\x06q\xb8\xb7\xab/\x9bM\xd8*\xd6P\xf5\xe4 ,$\x97*\x83\x0f\x07\xdc\xef\xf0\xcb\xd7\xcd\xcb&\xe4\xf6\x80\xdb\xfc\xf93\xd0\xf4\x18W\x15|\xfey\xb2U\xa7\xfcc|H\x03\x88\xe3]\x00I\x9a~r\xc7J\xaa\x1e\x86S\x1f\xf6I\xf2\xb7\xc3au\xecp\x08`oOF\x89;\xb4	\x1d\xa4\x8d\x14R\xb7\xb4@w~\x16I\x14~I}0W8~\xff\xed\x9b\x14\x92\xfc\x81\xa7\x8eS\x15\xc07\x14\\\x06\xf0M\nZ\xc8\x00~\x95BKNu\x00\xdb\x7f\xb2\x1c\x155L\n\xbb+\xb7\x1e\xcc_e\xa7\x18*\xf8\x1d\xcf\xdb\x00\xa6\xf7\xff\xc2\x9aV*C\x85q\xee\xe5\xb2\xeca*\x99n9}\xca\xa0\xe2\xd8\xa7\x95\xe3\x85\x94Laa\xef\xce@\xc9\xb3]\xa6\x9c\x9d\x04a\x06\x1b\x9dA\x81\xc2\xa0\xb2\xcb9-\x1eNJv\xa2t8\xd0k\xfc\x02\x88\xc2\xc8\xa2x\x05\xf1n\x17\xc0\xee\x18\xc0>v;\x96o\xacz\"\x85\x14\x06\x85\xc9\xc0\x01Fr4gD\xe1\xbc\xfd\xd0P&\xde\xe7n!y\xd7\x88\x9b\xf7N^\x9fYi\xea\x0c\xe2(\xfa\xc9\xfel\x98\x98\xd8\x14E\x8fu\xff(\x13\x95\xb4d\x87\xe7[^N\xb7\xdd\x81f\xe5\xaa\xafc\x92Kcd\x93A\x12&\xca3W\xf7\xee\xffh\x88\xad\xd4\xccg	95\xec\xd1\x95\xacc\x9es*\x03\x8e\x95Y\x85\x98\xf8W\xebxI\xcb$\xbcb\xe5\xd9\x83\xb2\x8f\xa2\xe5\xc5S\xf8\x1c\x8dAEl\xd6\x9cJD\xe1\xae\xbd\x8c\xe6FQ\xa1+\xa9\x9a\x0c\xba\xb6EUP\x8d\x0b>\xc4q\x14\xc0\xe1\x18@\xb2\xf3\xc5S\xc7\xa1a\x86\xf7Us\xfb\xd5I\x14\xc3\xa3\xf5\x19\xe20I\x078\xeb\x04\x9e\xef\x001\xc8\xc2\xce\xfd\xf7\xa6\x9bw\x83\x9b\x816\xbe\xbc@\xed\x90F\xce\x9b\xb0\xa0\xaat\x0ey\x01U\xb4d\x9d\xb6H\x0dh/6\xf6=\x80\xfdj\x06q{\x01-9+\xfb\x12\x8b\x02\xf0\xff\x87q\xd2W\x97\xa5\x189)y\xce \x1e\x7f\xebZ1\xf1\xe0W\xc6\x8e\x02d\x17\xf5\xd77\xf4B|%\xec\xa7B\x18V\xbex\xab\x11h\x1f\xe6<\\\xd7\x1cjZ\xdaw\xa3\xde\x1d\x9b\x8a\xc8[.\xbd\x8d\x8e\xd6\xdb\x97\xcd\xa6b\xc8K\x8df\xd6\xeb\xa6\xaa\xf0\xef\xce\x95\xe5CUTEU\xa5\xe5\xff\xfc\xe8[\x10\x0f\xedn\x96\xd6\xe8\xdaUNs\xe4\xf0|\xb7\xea\xde,[/\xa4C\x15\xee\x93%\xbeQ{\x81\xe8\x15AY	\xe5\xb4\xb5\xae\xd6\x95\xeb\x99\x90\xe6c\xc6\xa96\xa4\xa8\x19/?\xcd\x199\x80\xff\n\xd3\x16\x99swZ\xa9\xeeEk\xc6\x9c8I\x17\x91E\x10\xfb\xa5y-+[%\xeeR\xd6\x9cBV\xc8\xfe&\xcf?\xda\x199\x87kwh/7*%\xb5\xc4\xb5\xed\xb6A\xad\xe9\xa9W\x8c\x91\xb2I\x98zB\x1eGm\x08]\x08\xce\xcef\xca\x97\xc7\x0d_\xd7\x98\xbe\xd2\xf1_\xe1\xab\xec\x8c\x9dW\xa6\x91\xaa\xe8\x94\xb6\x1ad\xc1\xb0\xbf\xcf53\xe8$\xc6\x19\x9d\x15m\xed\xb2|DUq\xcb\xf2\x9a\x95%\x8a\x11\xbfi\x039g\xadf\xfa:5\xa1F\x8e\x85\xc92Z\x19T~\x18\xf2=p\xbb\xbd\xee\x1b4\xd7\x92w\x06\xbfN\xc8\xff\xd2^\xe6<\xf5\x99s\xd9\x9a\xaa\xd3\xc8\xd6\x83?H\x0bqKd`\xb5d\xb6\x0f\x12|Da\xf4\x10\xfb\xcbf\xc3D\xdb\x999\xf5\xb4y\xe23p\x16h\xbdl6}4\xcb\x84\xbd\xeb\xf44-\xd3\xb6E\xaa\xa8(f\xc6nD\xbe\xb5qkm\x9d\xc5\xa1\x91\xc4\xbb|W\xcd\xf3\xeac_\x8eG\xae\xc5\xb4T\xa1\x1f\xc1BK\xbf\xf7u|/\x1d\xf6\x00\xb1\xf4\xc8` \xc9;\x86\xa70\xef\x8c\xf1\xc558\xdd\xf3s\xe9\xe18+/Ev\xdf^\xe0\xd0^zQH\xa3\x00\xec\x9f_vV\xd3\xe3\xf8S`\x0b\xbc\xbd\xc0n\xb0\x98K\xfe\x97w\x88o\xb4*\xb7c\x14]i\xa5\xef	\xeb$\xac!\x1f+9\xf6\x87l\x99\x91\x12\x0b\xd9O\xcbC\xfe'd\xc2\x9a\xf2\n\x9e\xaf\xdbh\xb4j\xa3\xd3JN5\xb3S0\xe5\xc5\xc74\xfa	\x88{\xcb\xcf\xfd=\xdaa\xd5q\xbe\xbc3\x9e[d\xb5\xadcg2\x9b\x91\xdc_95\xf8\xef\x8f$vw.sql/\x10\xdb\x84D7\xd3\xf1\xa9\xff\x0e\x88\\6\x0e\x93\xd92'/\x9bPV\x15q\x84\x80\xe7\xa5~\xa5\xc7]\x9a\xa7\x9e\xa6R\xdaJ\x1e\x05zd+\x136\x19\xc4\xd0\x9c\xe3J\x07b/\x04C\xbbK|\xce\x17\xf2\xfe\x88\xca~\xf9\xf1a\xac4\xb2\x9d\x7f>u\x06\xcb+\xe2\x1e\x8acz,\xaf\xbef\xae\x9b\xf6\\\xe9\xed\xdcEz\xf7o\xd7\xd9[\xd5\xb3h\xc5\xe30=\x0b\xd4\x93\xcc/y\x95$\xb3\x19\xc9\xef\x0c\x1d\xf6\xc6\x96\xbd\xf6\xfa\xcc\xd4\x8d\x96\xc3\xed<O\xc4\x83\xb2\xca\xefn^s\xce\xcb\xb7'Ho\xdd\xbb\xd9\xc7q\x7fN\xf5V\xd6\xf1\x95\xd1\x08|\x8d\xb4\xbc\x07\xfc\x9dO\xa6\xb7\xf3\xe1\xd1\xfc!\xe8=!\xff\xcf\xb8\xbfc\xa4J\xd2\xf9\x01#\xdb\xb7p\xb7&wAw\x15\xa3\xc6\x7f\xe2\xb89j\xfdw\x00PK\x07\x08L\xbb\xd3^\xeb\x05\x00\x00^\x12\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x98\x8dP]P\xa4[\xdcK\x01\x00\x00\x83\x02\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00html/broker.go.htmlUT\x05\x00\x01\x90b\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe3\xb8P]\x06>\xe0\x14K\x08\x00\x00/@\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x95\x01\x00\x00html/dashboard.go.htmlUT\x05\x00\x01\x1b\xae\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00:\xb3P]\x16\xdc\xe8\x86t\x03\x00\x00\xb5\x0b\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81-\n\x00\x00html/device.go.htmlUT\x05\x00\x01p\xa4\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x01\x8cP]7W=\xb1\x8a\x02\x00\x00\\\x07\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xeb\x0d\x00\x00html/error.go.htmlUT\x05\x00\x01\x93_\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ`}f\xf1\xdb\x00\x00\x00c\x01\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xbe\x10\x00\x00html/header.go.htmlUT\x05\x00\x01\x9a\xcd6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe1\xa9P]~\x95\x08$\x16\x02\x00\x00\xda\x04\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe3\x11\x00\x00html/idp_picker.go.htmlUT\x05\x00\x01\xd6\x93\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00v\x90P]a\xbal'\xff\x01\x00\x00\x91\x04\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81G\x14\x00\x00html/kiosk.go.htmlUT\x05\x00\x01\x01g\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00e\xa7P]\x18Nz9P\x02\x00\x00\xde\x06\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8f\x16\x00\x00html/sign_in.go.htmlUT\x05\x00\x01\x1e\x90\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\x83\xba\x83\xe4\xf6\x00\x00\x00|\x01\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81*\x19\x00\x00img/account_circle-24px.svgUT\x05\x00\x01\x9a\xcd6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\xfc\xc6x\x8f\xb5\x00\x00\x00\xf9\x00\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81r\x1a\x00\x00img/error-24px.svgUT\x05\x00\x01\x9a\xcd6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQK\xfe\x8b#h\x03\x00\x00d\x08\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81p\x1b\x00\x00img/pomerium.svgUT\x05\x00\x01\x9a\xcd6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQ\xff9\xbd(^x\x00\x00\x12\xa0\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x1f\x1f\x00\x00img/pomerium_circle_96.svgUT\x05\x00\x01\x9a\xcd6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQuq\x02\xd2f\x01\x00\x00\x9e\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xce\x97\x00\x00img/supervised_user_circle-24px.svgUT\x05\x00\x01\x9a\xcd6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x9d\x8d\x0eQL\xbb\xd3^\xeb\x05\x00\x00^\x12\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8e\x99\x00\x00style/main.cssUT\x05\x00\x01\x9a\xcd6_PK\x05\x06\x00\x00\x00\x00\x0e\x00\x0e\x00(\x04\x00\x00\xbe\x9f\x00\x00\x00\x00"
 		fs.RegisterWithNamespace("web", data)
 	}
+	
\ No newline at end of file
@@ -0,0 +1,408 @@
+// Package graphql reads the operation of GraphQL requests, so routes can be
+// authorized per operation.
+//
+// Only the executable definitions of a document are parsed: the operation's
+// type, name and top-level fields, and the fragments they spread. Arguments,
+// directives and nested selections are skipped.
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// Operation types.
+const (
+	OperationTypeQuery        = "query"
+	OperationTypeMutation     = "mutation"
+	OperationTypeSubscription = "subscription"
+)
+
+// An Operation is the operation executed by a GraphQL request.
+type Operation struct {
+	// Type is query, mutation or subscription.
+	Type string
+	// Name is the operation's name, if it has one.
+	Name string
+	// Fields are the names of the top-level fields the operation selects,
+	// including the fields of the fragments it spreads. Aliases are
+	// resolved to the field names.
+	Fields []string
+}
+
+// ErrNoOperation is returned when a request doesn't contain a GraphQL query.
+var ErrNoOperation = errors.New("graphql: request has no operation")
+
+// ParseRequest returns the operation of a GraphQL request. GET requests have
+// the query and operation name in the URL. POST requests have them in a JSON
+// body, or the query is the body if its content type is
+// application/graphql.
+func ParseRequest(method, contentType string, query url.Values, body []byte) (*Operation, error) {
+	document, operationName := query.Get("query"), query.Get("operationName")
+	if method == http.MethodPost {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		switch mediaType {
+		case "application/graphql":
+			document = string(body)
+		case "application/json", "":
+			var params struct {
+				Query         string `json:"query"`
+				OperationName string `json:"operationName"`
+			}
+			if err := json.Unmarshal(body, &params); err != nil {
+				return nil, fmt.Errorf("graphql: invalid request body: %w", err)
+			}
+			document, operationName = params.Query, params.OperationName
+		default:
+			return nil, fmt.Errorf("graphql: unsupported content type %q", mediaType)
+		}
+	}
+	if document == "" {
+		return nil, ErrNoOperation
+	}
+	return Parse(document, operationName)
+}
+
+// Parse returns the operation with the given name in a GraphQL document. If
+// the name is empty, the document must have exactly one operation.
+func Parse(document, operationName string) (*Operation, error) {
+	p := &parser{lexer: lexer{src: document}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	doc, err := p.parseDocument()
+	if err != nil {
+		return nil, err
+	}
+
+	var op *operationDefinition
+	for _, o := range doc.operations {
+		switch {
+		case operationName == "" && op != nil:
+			return nil, errors.New("graphql: operation name is required for documents with several operations")
+		case operationName == "" || o.name == operationName:
+			op = o
+		}
+	}
+	if op == nil {
+		if operationName != "" {
+			return nil, fmt.Errorf("graphql: unknown operation %q", operationName)
+		}
+		return nil, ErrNoOperation
+	}
+
+	fields := make(map[string]struct{})
+	if err := doc.collectFields(op.selections, fields, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	result := &Operation{Type: op.typ, Name: op.name}
+	for field := range fields {
+		result.Fields = append(result.Fields, field)
+	}
+	sort.Strings(result.Fields)
+	return result, nil
+}
+
+type document struct {
+	operations []*operationDefinition
+	fragments  map[string][]selection
+}
+
+type operationDefinition struct {
+	typ        string
+	name       string
+	selections []selection
+}
+
+// A selection is a top-level selection of an operation or fragment. It's
+// either a field, a fragment spread or an inline fragment.
+type selection struct {
+	field          string
+	fragmentSpread string
+	inlineFragment []selection
+}
+
+// collectFields adds the field names of the selections to fields, following
+// fragment spreads. visiting holds the fragments being collected, to reject
+// cycles.
+func (doc *document) collectFields(selections []selection, fields map[string]struct{}, visiting map[string]bool) error {
+	for _, s := range selections {
+		switch {
+		case s.field != "":
+			fields[s.field] = struct{}{}
+		case s.fragmentSpread != "":
+			name := s.fragmentSpread
+			fragment, ok := doc.fragments[name]
+			if !ok {
+				return fmt.Errorf("graphql: unknown fragment %q", name)
+			}
+			if visiting[name] {
+				return fmt.Errorf("graphql: fragment %q spreads itself", name)
+			}
+			visiting[name] = true
+			if err := doc.collectFields(fragment, fields, visiting); err != nil {
+				return err
+			}
+			delete(visiting, name)
+		default:
+			if err := doc.collectFields(s.inlineFragment, fields, visiting); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type parser struct {
+	lexer
+	tok token
+}
+
+func (p *parser) next() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("graphql: offset %d: %s", p.tok.offset, fmt.Sprintf(format, args...))
+}
+
+// expect consumes a punctuator.
+func (p *parser) expect(punctuator string) error {
+	if !p.tok.is(punctuator) {
+		return p.errorf("expected %q, got %q", punctuator, p.tok.value)
+	}
+	return p.next()
+}
+
+// expectName consumes a name and returns it.
+func (p *parser) expectName() (string, error) {
+	if p.tok.kind != tokenName {
+		return "", p.errorf("expected a name, got %q", p.tok.value)
+	}
+	name := p.tok.value
+	return name, p.next()
+}
+
+func (p *parser) parseDocument() (*document, error) {
+	doc := &document{fragments: make(map[string][]selection)}
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.tok.is("{"):
+			selections, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operationDefinition{
+				typ:        OperationTypeQuery,
+				selections: selections,
+			})
+		case p.tok.kind == tokenName && (p.tok.value == OperationTypeQuery ||
+			p.tok.value == OperationTypeMutation ||
+			p.tok.value == OperationTypeSubscription):
+			op, err := p.parseOperationDefinition()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.tok.kind == tokenName && p.tok.value == "fragment":
+			name, selections, err := p.parseFragmentDefinition()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.fragments[name]; ok {
+				return nil, fmt.Errorf("graphql: duplicate fragment %q", name)
+			}
+			doc.fragments[name] = selections
+		default:
+			return nil, p.errorf("unexpected %q, expected an operation or fragment", p.tok.value)
+		}
+	}
+	return doc, nil
+}
+
+// parseOperationDefinition parses
+//
+//	OperationType Name? VariableDefinitions? Directives? SelectionSet
+func (p *parser) parseOperationDefinition() (*operationDefinition, error) {
+	op := &operationDefinition{typ: p.tok.value}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokenName {
+		op.name = p.tok.value
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+	if p.tok.is("(") {
+		if err := p.skipBalanced("(", ")"); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.skipDirectives(); err != nil {
+		return nil, err
+	}
+	var err error
+	op.selections, err = p.parseSelectionSet()
+	return op, err
+}
+
+// parseFragmentDefinition parses
+//
+//	fragment Name on Type Directives? SelectionSet
+func (p *parser) parseFragmentDefinition() (string, []selection, error) {
+	if err := p.next(); err != nil {
+		return "", nil, err
+	}
+	name, err := p.expectName()
+	if err != nil {
+		return "", nil, err
+	}
+	if err := p.skipTypeCondition(); err != nil {
+		return "", nil, err
+	}
+	if err := p.skipDirectives(); err != nil {
+		return "", nil, err
+	}
+	selections, err := p.parseSelectionSet()
+	return name, selections, err
+}
+
+// parseSelectionSet parses a selection set, keeping its fields, fragment
+// spreads and inline fragments and skipping any nested selection sets.
+func (p *parser) parseSelectionSet() ([]selection, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selections []selection
+	for !p.tok.is("}") {
+		if p.tok.kind == tokenEOF {
+			return nil, p.errorf("unterminated selection set")
+		}
+		s, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, s)
+	}
+	if len(selections) == 0 {
+		return nil, p.errorf("empty selection set")
+	}
+	return selections, p.next()
+}
+
+func (p *parser) parseSelection() (selection, error) {
+	if p.tok.is("...") {
+		if err := p.next(); err != nil {
+			return selection{}, err
+		}
+		// a fragment spread is named, an inline fragment has an optional
+		// type condition
+		if p.tok.kind == tokenName && p.tok.value != "on" {
+			name := p.tok.value
+			if err := p.next(); err != nil {
+				return selection{}, err
+			}
+			return selection{fragmentSpread: name}, p.skipDirectives()
+		}
+		if p.tok.kind == tokenName {
+			if err := p.skipTypeCondition(); err != nil {
+				return selection{}, err
+			}
+		}
+		if err := p.skipDirectives(); err != nil {
+			return selection{}, err
+		}
+		selections, err := p.parseSelectionSet()
+		return selection{inlineFragment: selections}, err
+	}
+
+	// Alias? Name Arguments? Directives? SelectionSet?
+	name, err := p.expectName()
+	if err != nil {
+		return selection{}, err
+	}
+	if p.tok.is(":") {
+		if err := p.next(); err != nil {
+			return selection{}, err
+		}
+		if name, err = p.expectName(); err != nil {
+			return selection{}, err
+		}
+	}
+	if p.tok.is("(") {
+		if err := p.skipBalanced("(", ")"); err != nil {
+			return selection{}, err
+		}
+	}
+	if err := p.skipDirectives(); err != nil {
+		return selection{}, err
+	}
+	if p.tok.is("{") {
+		if err := p.skipBalanced("{", "}"); err != nil {
+			return selection{}, err
+		}
+	}
+	return selection{field: name}, nil
+}
+
+func (p *parser) skipTypeCondition() error {
+	if p.tok.kind != tokenName || p.tok.value != "on" {
+		return p.errorf("expected \"on\", got %q", p.tok.value)
+	}
+	if err := p.next(); err != nil {
+		return err
+	}
+	_, err := p.expectName()
+	return err
+}
+
+// skipDirectives skips any directives, e.g. @include(if: $flag).
+func (p *parser) skipDirectives() error {
+	for p.tok.is("@") {
+		if err := p.next(); err != nil {
+			return err
+		}
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if p.tok.is("(") {
+			if err := p.skipBalanced("(", ")"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// skipBalanced skips from an opening punctuator to its closing punctuator,
+// including any nested pairs of them.
+func (p *parser) skipBalanced(open, close string) error {
+	depth := 0
+	for {
+		switch {
+		case p.tok.kind == tokenEOF:
+			return p.errorf("expected %q", close)
+		case p.tok.is(open):
+			depth++
+		case p.tok.is(close):
+			depth--
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
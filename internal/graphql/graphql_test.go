@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		document      string
+		operationName string
+		expect        *Operation
+	}{
+		{"shorthand", `{ me { id } }`, "",
+			&Operation{Type: "query", Fields: []string{"me"}}},
+		{"named mutation", `
+			# delete a user
+			mutation DeleteUser($id: ID!) @audit(reason: "cleanup") {
+				removed: deleteUser(id: $id, input: {tags: ["a", "}"]}) { id }
+				__typename
+			}`, "",
+			&Operation{Type: "mutation", Name: "DeleteUser", Fields: []string{"__typename", "deleteUser"}}},
+		{"selected operation", `
+			query A { a }
+			mutation B { b1, b2 }`, "B",
+			&Operation{Type: "mutation", Name: "B", Fields: []string{"b1", "b2"}}},
+		{"fragments", `
+			query Q {
+				...Top
+				... on Query @include(if: true) { inline }
+				... { untyped }
+			}
+			fragment Top on Query { top ...Nested }
+			fragment Nested on Query { nested(first: -1.5e3, text: """block "" }""") }`, "",
+			&Operation{Type: "query", Name: "Q", Fields: []string{"inline", "nested", "top", "untyped"}}},
+		{"subscription", "\ufeffsubscription { events(after: \"\\\"x\") { id } }", "",
+			&Operation{Type: "subscription", Fields: []string{"events"}}},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			op, err := Parse(tc.document, tc.operationName)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, op)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		document      string
+		operationName string
+	}{
+		{"empty", ``, ""},
+		{"only fragments", `fragment F on Query { a }`, ""},
+		{"ambiguous operation", `query A { a } query B { b }`, ""},
+		{"unknown operation", `query A { a }`, "B"},
+		{"unknown fragment", `{ ...F }`, ""},
+		{"fragment cycle", `{ ...A } fragment A on Query { ...B } fragment B on Query { ...A }`, ""},
+		{"duplicate fragment", `{ ...A } fragment A on Query { a } fragment A on Query { b }`, ""},
+		{"unterminated selection set", `{ a { b }`, ""},
+		{"empty selection set", `{ }`, ""},
+		{"unterminated string", `{ a(b: "c) }`, ""},
+		{"unexpected character", `{ a ; }`, ""},
+		{"type definition", `type Query { a: String }`, ""},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.document, tc.operationName)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	expect := &Operation{Type: "mutation", Name: "M", Fields: []string{"deleteUser"}}
+
+	op, err := ParseRequest(http.MethodPost, "application/json; charset=utf-8", nil,
+		[]byte(`{"query": "query Q { me } mutation M { deleteUser(id: 1) }", "operationName": "M", "variables": {}}`))
+	require.NoError(t, err)
+	assert.Equal(t, expect, op)
+
+	op, err = ParseRequest(http.MethodPost, "application/graphql", url.Values{"operationName": {"M"}},
+		[]byte(`query Q { me } mutation M { deleteUser(id: 1) }`))
+	require.NoError(t, err)
+	assert.Equal(t, expect, op)
+
+	op, err = ParseRequest(http.MethodGet, "", url.Values{"query": {"{ me }"}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Operation{Type: "query", Fields: []string{"me"}}, op)
+
+	_, err = ParseRequest(http.MethodGet, "", nil, nil)
+	assert.Equal(t, ErrNoOperation, err)
+	_, err = ParseRequest(http.MethodPost, "application/json", nil, []byte(`[{"query": "{ me }"}]`))
+	assert.Error(t, err, "batched requests are not supported")
+	_, err = ParseRequest(http.MethodPost, "text/plain", nil, []byte(`{ me }`))
+	assert.Error(t, err)
+}
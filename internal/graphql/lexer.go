@@ -0,0 +1,137 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	// tokenValue is a number or string. Their values aren't needed, so they
+	// aren't decoded.
+	tokenValue
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	offset int
+}
+
+func (t token) is(punctuator string) bool {
+	return t.kind == tokenPunctuator && t.value == punctuator
+}
+
+// A lexer reads the tokens of a GraphQL document.
+//
+// https://spec.graphql.org/June2018/#sec-Language.Source-Text
+type lexer struct {
+	src string
+	pos int
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, offset: start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "..."):
+		l.pos += 3
+		return token{kind: tokenPunctuator, value: "...", offset: start}, nil
+	case strings.IndexByte("!$&():=@[]{|}", c) >= 0:
+		l.pos++
+		return token{kind: tokenPunctuator, value: string(c), offset: start}, nil
+	case isNameStart(c):
+		for l.pos < len(l.src) && isNameContinue(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenName, value: l.src[start:l.pos], offset: start}, nil
+	case c == '-' || isDigit(c):
+		l.pos++
+		for l.pos < len(l.src) && (isNameContinue(l.src[l.pos]) || strings.IndexByte(".+-", l.src[l.pos]) >= 0) {
+			l.pos++
+		}
+		return token{kind: tokenValue, value: l.src[start:l.pos], offset: start}, nil
+	case c == '"':
+		if err := l.skipString(); err != nil {
+			return token{}, err
+		}
+		return token{kind: tokenValue, value: l.src[start:l.pos], offset: start}, nil
+	}
+	return token{}, fmt.Errorf("graphql: offset %d: unexpected character %q", start, c)
+}
+
+// skipIgnored skips white space, line terminators, commas, comments and the
+// byte order mark.
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.pos++
+		case c == '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.src[l.pos:], "\ufeff"):
+			l.pos += len("\ufeff")
+		default:
+			return
+		}
+	}
+}
+
+// skipString skips a string or block string.
+func (l *lexer) skipString() error {
+	start := l.pos
+	if strings.HasPrefix(l.src[l.pos:], `"""`) {
+		l.pos += 3
+		for l.pos < len(l.src) {
+			switch {
+			case strings.HasPrefix(l.src[l.pos:], `\"""`):
+				l.pos += 4
+			case strings.HasPrefix(l.src[l.pos:], `"""`):
+				l.pos += 3
+				return nil
+			default:
+				l.pos++
+			}
+		}
+		return fmt.Errorf("graphql: offset %d: unterminated block string", start)
+	}
+
+	l.pos++
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\\':
+			l.pos += 2
+		case '"':
+			l.pos++
+			return nil
+		case '\n', '\r':
+			return fmt.Errorf("graphql: offset %d: unterminated string", start)
+		default:
+			l.pos++
+		}
+	}
+	return fmt.Errorf("graphql: offset %d: unterminated string", start)
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -8,6 +8,10 @@ const (
 	HeaderReferrer = "Referer"
 )
 
+// HeaderEnvoyExternalAddress is set by envoy to the address of the client
+// which sent the request.
+const HeaderEnvoyExternalAddress = "x-envoy-external-address"
+
 // Pomerium headers contain information added to a request.
 const (
 	// HeaderPomeriumResponse is set when pomerium itself creates a response,
@@ -17,6 +21,16 @@ const (
 	HeaderPomeriumResponse = "x-pomerium-intercepted-response"
 	// HeaderPomeriumJWTAssertion is the header key containing JWT signed user details.
 	HeaderPomeriumJWTAssertion = "x-pomerium-jwt-assertion"
+	// HeaderPomeriumDenyReason is set on responses to denied requests to the
+	// code describing why the request was denied, e.g. "group-mismatch".
+	HeaderPomeriumDenyReason = "x-pomerium-deny-reason"
+	// HeaderPomeriumTrace forces a request to be traced, when it's sent by a
+	// user in one of the tracing debug groups.
+	HeaderPomeriumTrace = "x-pomerium-trace"
+	// HeaderPomeriumPriority is set by the authorize service to "high" for
+	// requests of users in a route's priority groups, which selects the
+	// route's high priority upstream connection pool.
+	HeaderPomeriumPriority = "x-pomerium-priority"
 )
 
 // HeadersContentSecurityPolicy are the content security headers added to the service's handlers
@@ -0,0 +1,334 @@
+// Package ldap implements an identity provider for LDAP directories, such as
+// Active Directory and OpenLDAP, for environments without an OpenID Connect
+// or SAML identity provider.
+//
+// Users sign in with their username and password on a form of the
+// authenticate service. The provider looks up their entry with a service
+// account, and authenticates them by binding as that entry with their
+// password.
+package ldap
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	"github.com/pomerium/pomerium/internal/identity/oidc"
+	pom_ldap "github.com/pomerium/pomerium/internal/ldap"
+)
+
+// Name identifies the LDAP identity provider.
+const Name = "ldap"
+
+// claimsKey is the key of the user's claims in the tokens returned by
+// Authenticate and Refresh.
+const claimsKey = "ldap_claims"
+
+// refreshInterval is how often the entry and groups of a signed in user are
+// read again. Users who were deleted, or who no longer match the user filter,
+// are signed out.
+const refreshInterval = time.Hour
+
+// userAttributes are the attributes read from the entries of users.
+var userAttributes = []string{"mail", "displayName", "cn", "givenName", "sn", "memberOf"}
+
+// ErrInvalidCredentials is returned when a user signs in with an unknown
+// username or a wrong password.
+var ErrInvalidCredentials = pom_ldap.ErrInvalidCredentials
+
+var timeNow = time.Now
+
+// A conn is a connection to the directory.
+type conn interface {
+	pom_ldap.Searcher
+	Bind(ctx context.Context, dn, password string) error
+	Close() error
+}
+
+// Provider is an LDAP identity provider.
+type Provider struct {
+	url          *pom_ldap.URL
+	bindDN       string
+	bindPassword string
+	redirectURL  url.URL
+	dial         func(ctx context.Context) (conn, error)
+}
+
+// New creates a new LDAP identity provider. The provider URL is an LDAP URL
+// with the search users are looked up with, the client id is the DN of the
+// service account, and the client secret is its password.
+func New(ctx context.Context, o *oauth.Options) (*Provider, error) {
+	if o.ProviderURL == "" {
+		return nil, oidc.ErrMissingProviderURL
+	}
+	u, err := pom_ldap.ParseURL(o.ProviderURL)
+	if err != nil {
+		return nil, err
+	}
+	if o.RedirectURL == nil {
+		return nil, errors.New("identity/ldap: missing redirect url")
+	}
+	return &Provider{
+		url:          u,
+		bindDN:       o.ClientID,
+		bindPassword: o.ClientSecret,
+		redirectURL:  *o.RedirectURL,
+		dial: func(ctx context.Context) (conn, error) {
+			c, err := pom_ldap.Dial(ctx, u, nil)
+			if err != nil {
+				return nil, err
+			}
+			return c, nil
+		},
+	}, nil
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
+
+// GetSignInURL returns the URL of the authenticate service's sign in form,
+// which is the callback without a code.
+func (p *Provider) GetSignInURL(state string) string {
+	u := p.redirectURL
+	q := u.Query()
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// credentials are the username and password entered on the sign in form.
+type credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Code returns the code Authenticate is called with for the username and
+// password a user entered on the sign in form.
+func Code(username, password string) string {
+	data, _ := json.Marshal(credentials{Username: username, Password: password})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Authenticate authenticates the user of the username and password of a code
+// returned by Code, and hydrates v with their claims. ErrInvalidCredentials is
+// returned if there's no such user, or the password is wrong.
+func (p *Provider) Authenticate(ctx context.Context, code string, v interface{}) (*oauth2.Token, error) {
+	var creds credentials
+	data, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("identity/ldap: invalid code: %w", err)
+	}
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("identity/ldap: invalid code: %w", err)
+	}
+	if creds.Username == "" || creds.Password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	c, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	entry, err := p.findUser(ctx, c, fmt.Sprintf("(%s=%s)", p.url.Attribute, pom_ldap.EscapeFilter(creds.Username)))
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	// the user is bound on another connection, so the groups are still
+	// read as the service account
+	uc, err := p.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uc.Close()
+	if err := uc.Bind(ctx, entry.DN, creds.Password); err != nil {
+		return nil, err
+	}
+
+	return p.newToken(ctx, c, entry, v)
+}
+
+// Refresh reads the entry and groups of the user of a token again. An error
+// is returned if the user no longer exists, so their session ends.
+func (p *Provider) Refresh(ctx context.Context, t *oauth2.Token, v interface{}) (*oauth2.Token, error) {
+	if t == nil || t.AccessToken == "" {
+		return nil, errors.New("identity/ldap: missing user dn")
+	}
+
+	c, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	entry, err := p.getUser(ctx, c, t.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("identity/ldap: user %s no longer exists", t.AccessToken)
+	}
+	return p.newToken(ctx, c, entry, v)
+}
+
+// UpdateUserInfo hydrates v with the claims the user of a token had when it
+// was returned.
+func (p *Provider) UpdateUserInfo(ctx context.Context, t *oauth2.Token, v interface{}) error {
+	if t == nil {
+		return nil
+	}
+	claims, ok := t.Extra(claimsKey).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return setClaims(claims, v)
+}
+
+// Revoke is not implemented by LDAP.
+func (p *Provider) Revoke(ctx context.Context, t *oauth2.Token) error {
+	return oidc.ErrRevokeNotImplemented
+}
+
+// LogOut is not implemented by LDAP.
+func (p *Provider) LogOut() (*url.URL, error) {
+	return nil, oidc.ErrSignoutNotImplemented
+}
+
+// connect returns a connection bound as the service account. If there's no
+// service account, searches are anonymous.
+func (p *Provider) connect(ctx context.Context) (conn, error) {
+	c, err := p.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.bindDN == "" {
+		return c, nil
+	}
+	if err := c.Bind(ctx, p.bindDN, p.bindPassword); err != nil {
+		_ = c.Close()
+		// not wrapped, so invalid service account credentials aren't
+		// mistaken for the user's
+		return nil, fmt.Errorf("identity/ldap: service account bind failed: %v", err)
+	}
+	return c, nil
+}
+
+// findUser returns the user matching the URL's filter and the given filter,
+// or nil if there's no such user.
+func (p *Provider) findUser(ctx context.Context, c conn, filter string) (*pom_ldap.Entry, error) {
+	entries, err := c.Search(ctx, &pom_ldap.SearchRequest{
+		BaseDN:     p.url.BaseDN,
+		Scope:      p.url.Scope,
+		Filter:     "(&" + p.url.Filter + filter + ")",
+		Attributes: append([]string{p.url.Attribute}, userAttributes...),
+		SizeLimit:  2,
+	})
+	if pom_ldap.IsResultCode(err, pom_ldap.ResultSizeLimitExceeded) || len(entries) > 1 {
+		return nil, errors.New("identity/ldap: more than one user matches")
+	} else if err != nil {
+		return nil, fmt.Errorf("identity/ldap: error searching for user: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries[0], nil
+}
+
+// getUser returns the user with the given DN, or nil if there's no such user,
+// or it doesn't match the URL's filter.
+func (p *Provider) getUser(ctx context.Context, c conn, dn string) (*pom_ldap.Entry, error) {
+	entries, err := c.Search(ctx, &pom_ldap.SearchRequest{
+		BaseDN:     dn,
+		Scope:      pom_ldap.ScopeBaseObject,
+		Filter:     p.url.Filter,
+		Attributes: append([]string{p.url.Attribute}, userAttributes...),
+	})
+	if pom_ldap.IsResultCode(err, pom_ldap.ResultNoSuchObject) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("identity/ldap: error reading user: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries[0], nil
+}
+
+// newToken returns a token for a user, with their claims, and hydrates v with
+// the claims. The access token is the user's DN, which can't be used with the
+// directory.
+func (p *Provider) newToken(ctx context.Context, c conn, entry *pom_ldap.Entry, v interface{}) (*oauth2.Token, error) {
+	claims, err := p.claims(ctx, c, entry)
+	if err != nil {
+		return nil, err
+	}
+	if err := setClaims(claims, v); err != nil {
+		return nil, err
+	}
+	token := &oauth2.Token{
+		AccessToken: entry.DN,
+		TokenType:   "ldap",
+		Expiry:      timeNow().Add(refreshInterval),
+	}
+	return token.WithExtra(map[string]interface{}{claimsKey: claims}), nil
+}
+
+// claims returns the claims of a user. The groups claim is the DNs of the
+// user's groups, including nested groups.
+func (p *Provider) claims(ctx context.Context, c conn, entry *pom_ldap.Entry) (map[string]interface{}, error) {
+	groups, err := pom_ldap.NewGroupResolver(c).Resolve(ctx, entry.Values("memberOf"))
+	if err != nil {
+		return nil, fmt.Errorf("identity/ldap: error reading groups: %w", err)
+	}
+	groupDNs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupDNs = append(groupDNs, group.DN)
+	}
+
+	claims := map[string]interface{}{
+		"iss":                p.url.Scheme + "://" + p.url.Host,
+		"sub":                entry.DN,
+		"preferred_username": entry.Value(p.url.Attribute),
+		"groups":             groupDNs,
+	}
+	for claim, attribute := range map[string]string{
+		"email":       "mail",
+		"given_name":  "givenName",
+		"family_name": "sn",
+	} {
+		if value := entry.Value(attribute); value != "" {
+			claims[claim] = value
+		}
+	}
+	if name := entry.Value("displayName"); name != "" {
+		claims["name"] = name
+	} else if name := entry.Value("cn"); name != "" {
+		claims["name"] = name
+	}
+	return claims, nil
+}
+
+func setClaims(claims map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("identity/ldap: couldn't unmarshal claims: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	pom_ldap "github.com/pomerium/pomerium/internal/ldap"
+)
+
+// fakeDirectory is a directory with entries by DN, and their passwords.
+type fakeDirectory struct {
+	entries   map[string]*pom_ldap.Entry
+	passwords map[string]string
+	searches  []*pom_ldap.SearchRequest
+}
+
+type fakeConn struct {
+	dir *fakeDirectory
+}
+
+func (c fakeConn) Bind(ctx context.Context, dn, password string) error {
+	if password == "" || c.dir.passwords[dn] != password {
+		return pom_ldap.ErrInvalidCredentials
+	}
+	return nil
+}
+
+// Search matches the base DN, or entries with the uid of an equality filter.
+func (c fakeConn) Search(ctx context.Context, req *pom_ldap.SearchRequest) ([]*pom_ldap.Entry, error) {
+	c.dir.searches = append(c.dir.searches, req)
+	if req.Scope == pom_ldap.ScopeBaseObject {
+		if entry, ok := c.dir.entries[req.BaseDN]; ok {
+			return []*pom_ldap.Entry{entry}, nil
+		}
+		return nil, &pom_ldap.Error{ResultCode: pom_ldap.ResultNoSuchObject}
+	}
+	var entries []*pom_ldap.Entry
+	for _, entry := range c.dir.entries {
+		if strings.Contains(req.Filter, "(uid="+entry.Value("uid")+")") {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (c fakeConn) Close() error {
+	return nil
+}
+
+func newTestProvider(t *testing.T, dir *fakeDirectory) *Provider {
+	redirectURL, _ := url.Parse("https://authenticate.example.com/oauth2/callback")
+	p, err := New(context.Background(), &oauth.Options{
+		ProviderURL:  "ldaps://ldap.example.com/ou=people,dc=example,dc=com?uid?sub?(objectClass=person)",
+		ClientID:     "cn=pomerium,dc=example,dc=com",
+		ClientSecret: "service-password",
+		RedirectURL:  redirectURL,
+	})
+	require.NoError(t, err)
+	p.dial = func(ctx context.Context) (conn, error) {
+		return fakeConn{dir: dir}, nil
+	}
+	return p
+}
+
+func newTestDirectory() *fakeDirectory {
+	return &fakeDirectory{
+		entries: map[string]*pom_ldap.Entry{
+			"uid=alice,ou=people,dc=example,dc=com": {
+				DN: "uid=alice,ou=people,dc=example,dc=com",
+				Attributes: map[string][]string{
+					"uid":         {"alice"},
+					"mail":        {"alice@example.com"},
+					"displayname": {"Alice Smith"},
+					"givenname":   {"Alice"},
+					"sn":          {"Smith"},
+					"memberof":    {"cn=devs,ou=groups,dc=example,dc=com"},
+				},
+			},
+			"cn=devs,ou=groups,dc=example,dc=com": {
+				DN: "cn=devs,ou=groups,dc=example,dc=com",
+				Attributes: map[string][]string{
+					"cn":       {"devs"},
+					"memberof": {"cn=engineering,ou=groups,dc=example,dc=com"},
+				},
+			},
+			"cn=engineering,ou=groups,dc=example,dc=com": {
+				DN:         "cn=engineering,ou=groups,dc=example,dc=com",
+				Attributes: map[string][]string{"cn": {"engineering"}},
+			},
+		},
+		passwords: map[string]string{
+			"cn=pomerium,dc=example,dc=com":         "service-password",
+			"uid=alice,ou=people,dc=example,dc=com": "alice-password",
+		},
+	}
+}
+
+func TestProvider_GetSignInURL(t *testing.T) {
+	p := newTestProvider(t, newTestDirectory())
+	assert.Equal(t, "https://authenticate.example.com/oauth2/callback?state=STATE", p.GetSignInURL("STATE"))
+}
+
+func TestProvider_Authenticate(t *testing.T) {
+	defer func(now func() time.Time) { timeNow = now }(timeNow)
+	now := time.Date(2020, 10, 15, 17, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+
+	ctx := context.Background()
+	dir := newTestDirectory()
+	p := newTestProvider(t, dir)
+
+	var claims map[string]interface{}
+	token, err := p.Authenticate(ctx, Code("alice", "alice-password"), &claims)
+	require.NoError(t, err)
+	assert.Equal(t, "uid=alice,ou=people,dc=example,dc=com", token.AccessToken)
+	assert.Equal(t, now.Add(refreshInterval), token.Expiry)
+	assert.Equal(t, map[string]interface{}{
+		"iss":                "ldaps://ldap.example.com:636",
+		"sub":                "uid=alice,ou=people,dc=example,dc=com",
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"name":               "Alice Smith",
+		"given_name":         "Alice",
+		"family_name":        "Smith",
+		"groups": []interface{}{
+			"cn=devs,ou=groups,dc=example,dc=com",
+			"cn=engineering,ou=groups,dc=example,dc=com",
+		},
+	}, claims)
+	assert.Equal(t, "(&(objectClass=person)(uid=alice))", dir.searches[0].Filter)
+
+	var updated map[string]interface{}
+	require.NoError(t, p.UpdateUserInfo(ctx, token, &updated))
+	assert.Equal(t, claims, updated)
+
+	for _, creds := range [][2]string{
+		{"alice", "wrong"},
+		{"alice", ""},
+		{"bob", "alice-password"},
+		{"", ""},
+	} {
+		_, err := p.Authenticate(ctx, Code(creds[0], creds[1]), &claims)
+		assert.True(t, errors.Is(err, ErrInvalidCredentials), creds)
+	}
+
+	dir.searches = nil
+	_, err = p.Authenticate(ctx, Code("*", "alice-password"), &claims)
+	assert.True(t, errors.Is(err, ErrInvalidCredentials))
+	assert.Equal(t, `(&(objectClass=person)(uid=\2a))`, dir.searches[0].Filter, "usernames should be escaped")
+
+	p.bindPassword = "wrong"
+	_, err = p.Authenticate(ctx, Code("alice", "alice-password"), &claims)
+	if assert.Error(t, err) {
+		assert.False(t, errors.Is(err, ErrInvalidCredentials),
+			"invalid service account credentials shouldn't be reported as the user's")
+	}
+}
+
+func TestProvider_Refresh(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestDirectory()
+	p := newTestProvider(t, dir)
+
+	var claims map[string]interface{}
+	token, err := p.Authenticate(ctx, Code("alice", "alice-password"), &claims)
+	require.NoError(t, err)
+
+	dir.entries["uid=alice,ou=people,dc=example,dc=com"].Attributes["memberof"] = nil
+	token, err = p.Refresh(ctx, token, &claims)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{}, claims["groups"], "groups should be read again")
+
+	delete(dir.entries, "uid=alice,ou=people,dc=example,dc=com")
+	_, err = p.Refresh(ctx, token, &claims)
+	assert.Error(t, err, "deleted users should be signed out")
+}
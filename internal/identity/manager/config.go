@@ -10,10 +10,13 @@ var (
 )
 
 type config struct {
+	providerName                  string
+	authenticators                map[string]Authenticator
 	groupRefreshInterval          time.Duration
 	groupRefreshTimeout           time.Duration
 	sessionRefreshGracePeriod     time.Duration
 	sessionRefreshCoolOffDuration time.Duration
+	mapClaims                     func(claims map[string]interface{})
 }
 
 func newConfig(options ...Option) *config {
@@ -58,3 +61,28 @@ func WithSessionRefreshCoolOffDuration(dur time.Duration) Option {
 		cfg.sessionRefreshCoolOffDuration = dur
 	}
 }
+
+// WithProviderName sets the identity provider name used to label the metrics
+// recorded by the manager.
+func WithProviderName(name string) Option {
+	return func(cfg *config) {
+		cfg.providerName = name
+	}
+}
+
+// WithIdentityProviderAuthenticators sets the authenticators of additional
+// identity providers, by id. Sessions are refreshed with the authenticator of
+// the identity provider they signed in with, or the default authenticator.
+func WithIdentityProviderAuthenticators(authenticators map[string]Authenticator) Option {
+	return func(cfg *config) {
+		cfg.authenticators = authenticators
+	}
+}
+
+// WithClaimsMapping sets the function which maps the claims of users when
+// their user info is refreshed.
+func WithClaimsMapping(mapClaims func(claims map[string]interface{})) Option {
+	return func(cfg *config) {
+		cfg.mapClaims = mapClaims
+	}
+}
@@ -1,11 +1,13 @@
 package manager
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/btree"
+	"golang.org/x/oauth2"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pomerium/pomerium/pkg/grpc/session"
@@ -60,9 +62,58 @@ func (u *User) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UpdateUserInfo updates the user with the user info of the authenticator.
+// If mapClaims isn't nil, it maps the claims of the user info before they're
+// stored, while nested objects, which aren't stored, are still available.
+func UpdateUserInfo(ctx context.Context, authenticator Authenticator, t *oauth2.Token, u *User, mapClaims func(claims map[string]interface{})) error {
+	if mapClaims == nil {
+		return authenticator.UpdateUserInfo(ctx, t, u)
+	}
+	return authenticator.UpdateUserInfo(ctx, t, &mappedUser{User: u, mapClaims: mapClaims})
+}
+
+// A mappedUser is a User whose claims are mapped when they're unmarshaled.
+type mappedUser struct {
+	*User
+	mapClaims func(claims map[string]interface{})
+	// claims are the unmapped claims, since authenticators may unmarshal
+	// several responses into the user.
+	claims map[string]interface{}
+}
+
+// UnmarshalJSON unmarshals json data into the user object, mapping the
+// claims of all the data unmarshaled so far.
+func (u *mappedUser) UnmarshalJSON(data []byte) error {
+	if u.claims == nil {
+		u.claims = make(map[string]interface{})
+	}
+	if err := json.Unmarshal(data, &u.claims); err != nil {
+		return err
+	}
+
+	// the claims are copied, including nested objects, so renamed claims
+	// are mapped again
+	raw, err := json.Marshal(u.claims)
+	if err != nil {
+		return err
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return err
+	}
+	u.mapClaims(claims)
+	mapped, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+	return u.User.UnmarshalJSON(mapped)
+}
+
 // A Session is a session managed by the Manager.
 type Session struct {
 	*session.Session
+	// version is the version of the session's databroker record.
+	version     string
 	lastRefresh time.Time
 	// gracePeriod is the amount of time before expiration to attempt a refresh.
 	gracePeriod time.Duration
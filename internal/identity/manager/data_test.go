@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -8,10 +9,12 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
 )
 
 func TestUser_UnmarshalJSON(t *testing.T) {
@@ -31,6 +34,49 @@ func TestUser_UnmarshalJSON(t *testing.T) {
 	}, u.Claims)
 }
 
+type mockUserInfoAuthenticator struct {
+	mockAuthenticator
+	responses []string
+}
+
+func (a mockUserInfoAuthenticator) UpdateUserInfo(ctx context.Context, t *oauth2.Token, v interface{}) error {
+	for _, res := range a.responses {
+		if err := json.Unmarshal([]byte(res), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestUpdateUserInfo(t *testing.T) {
+	authenticator := mockUserInfoAuthenticator{responses: []string{
+		`{"preferred_username": "joe", "address": {"country": "US"}}`,
+		`{"email": "joe@test.com"}`,
+	}}
+	mapClaims := func(claims map[string]interface{}) {
+		claims["upn"] = claims["preferred_username"]
+		delete(claims, "preferred_username")
+		claims["country"] = claims["address"].(map[string]interface{})["country"]
+	}
+
+	u := User{User: new(user.User)}
+	assert.NoError(t, UpdateUserInfo(context.Background(), authenticator, nil, &u, mapClaims))
+	assert.Equal(t, "joe@test.com", u.GetEmail())
+	assert.Equal(t, map[string]*anypb.Any{
+		"upn":     mustAnyString("joe"),
+		"country": mustAnyString("US"),
+	}, u.GetClaims(), "the claims of all the responses should be mapped")
+
+	u = User{User: new(user.User)}
+	assert.NoError(t, UpdateUserInfo(context.Background(), authenticator, nil, &u, nil))
+	assert.Equal(t, "joe@test.com", u.GetEmail())
+}
+
+func mustAnyString(v string) *anypb.Any {
+	any, _ := ptypes.MarshalAny(&wrapperspb.StringValue{Value: v})
+	return any
+}
+
 func TestSession_NextRefresh(t *testing.T) {
 	tm1 := time.Date(2020, 6, 5, 12, 0, 0, 0, time.UTC)
 	s := Session{
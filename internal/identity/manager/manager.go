@@ -3,8 +3,11 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -12,16 +15,34 @@ import (
 	"github.com/google/btree"
 	"github.com/rs/zerolog"
 	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/tomb.v2"
 
 	"github.com/pomerium/pomerium/internal/directory"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/scheduler"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 )
 
+const metricsServiceName = "cache"
+
+const (
+	directoryGroupTypeURL = "type.googleapis.com/directory.Group"
+	directoryUserTypeURL  = "type.googleapis.com/directory.User"
+
+	// directoryBatchSize is the most directory records written by a single
+	// databroker request.
+	directoryBatchSize = 1000
+
+	// maxSessionUpdateAttempts is the most times a refreshed session is
+	// written when it's changed concurrently.
+	maxSessionUpdateAttempts = 3
+)
+
 // Authenticator is an identity.Provider with only the methods needed by the manager.
 type Authenticator interface {
 	Refresh(context.Context, *oauth2.Token, interface{}) (*oauth2.Token, error)
@@ -209,6 +230,11 @@ func (mgr *Manager) refreshLoop(
 }
 
 func (mgr *Manager) refreshDirectoryUserGroups(ctx context.Context) {
+	if mgr.directory == nil {
+		// directory users and groups are managed elsewhere, e.g. with SCIM
+		return
+	}
+
 	mgr.log.Info().Msg("refreshing directory users")
 
 	ctx, clearTimeout := context.WithTimeout(ctx, mgr.cfg.groupRefreshTimeout)
@@ -222,6 +248,8 @@ func (mgr *Manager) refreshDirectoryUserGroups(ctx context.Context) {
 
 	mgr.mergeGroups(ctx, directoryGroups)
 	mgr.mergeUsers(ctx, directoryUsers)
+
+	metrics.SetDirectorySyncSuccess(ctx, metricsServiceName, mgr.cfg.providerName, time.Now())
 }
 
 func (mgr *Manager) mergeGroups(ctx context.Context, directoryGroups []*directory.Group) {
@@ -230,6 +258,7 @@ func (mgr *Manager) mergeGroups(ctx context.Context, directoryGroups []*director
 		lookup[dg.GetId()] = dg
 	}
 
+	var records []*databroker.Record
 	for groupID, newDG := range lookup {
 		curDG, ok := mgr.directoryGroups[groupID]
 		if !ok || !proto.Equal(newDG, curDG) {
@@ -238,36 +267,24 @@ func (mgr *Manager) mergeGroups(ctx context.Context, directoryGroups []*director
 				mgr.log.Warn().Err(err).Msg("failed to marshal directory group")
 				return
 			}
-			_, err = mgr.dataBrokerClient.Set(ctx, &databroker.SetRequest{
-				Type: any.GetTypeUrl(),
-				Id:   newDG.GetId(),
-				Data: any,
-			})
-			if err != nil {
-				mgr.log.Warn().Err(err).Msg("failed to update directory group")
-				return
-			}
+			records = append(records, &databroker.Record{Id: newDG.GetId(), Data: any})
 		}
 	}
+	if err := mgr.batchSet(ctx, directoryGroupTypeURL, records); err != nil {
+		mgr.log.Warn().Err(err).Msg("failed to update directory groups")
+		return
+	}
 
-	for groupID, curDG := range mgr.directoryGroups {
-		_, ok := lookup[groupID]
-		if !ok {
-			any, err := ptypes.MarshalAny(curDG)
-			if err != nil {
-				mgr.log.Warn().Err(err).Msg("failed to marshal directory group")
-				return
-			}
-			_, err = mgr.dataBrokerClient.Delete(ctx, &databroker.DeleteRequest{
-				Type: any.GetTypeUrl(),
-				Id:   curDG.GetId(),
-			})
-			if err != nil {
-				mgr.log.Warn().Err(err).Msg("failed to delete directory group")
-				return
-			}
+	var ids []string
+	for groupID := range mgr.directoryGroups {
+		if _, ok := lookup[groupID]; !ok {
+			ids = append(ids, groupID)
 		}
 	}
+	if err := mgr.batchDelete(ctx, directoryGroupTypeURL, ids); err != nil {
+		mgr.log.Warn().Err(err).Msg("failed to delete directory groups")
+		return
+	}
 }
 
 func (mgr *Manager) mergeUsers(ctx context.Context, directoryUsers []*directory.User) {
@@ -276,6 +293,7 @@ func (mgr *Manager) mergeUsers(ctx context.Context, directoryUsers []*directory.
 		lookup[du.GetId()] = du
 	}
 
+	var records []*databroker.Record
 	for userID, newDU := range lookup {
 		curDU, ok := mgr.directoryUsers[userID]
 		if !ok || !proto.Equal(newDU, curDU) {
@@ -284,36 +302,63 @@ func (mgr *Manager) mergeUsers(ctx context.Context, directoryUsers []*directory.
 				mgr.log.Warn().Err(err).Msg("failed to marshal directory user")
 				return
 			}
-			_, err = mgr.dataBrokerClient.Set(ctx, &databroker.SetRequest{
-				Type: any.GetTypeUrl(),
-				Id:   newDU.GetId(),
-				Data: any,
-			})
-			if err != nil {
-				mgr.log.Warn().Err(err).Msg("failed to update directory user")
-				return
-			}
+			records = append(records, &databroker.Record{Id: newDU.GetId(), Data: any})
 		}
 	}
+	if err := mgr.batchSet(ctx, directoryUserTypeURL, records); err != nil {
+		mgr.log.Warn().Err(err).Msg("failed to update directory users")
+		return
+	}
 
-	for userID, curDU := range mgr.directoryUsers {
-		_, ok := lookup[userID]
-		if !ok {
-			any, err := ptypes.MarshalAny(curDU)
-			if err != nil {
-				mgr.log.Warn().Err(err).Msg("failed to marshal directory user")
-				return
-			}
-			_, err = mgr.dataBrokerClient.Delete(ctx, &databroker.DeleteRequest{
-				Type: any.GetTypeUrl(),
-				Id:   curDU.GetId(),
-			})
-			if err != nil {
-				mgr.log.Warn().Err(err).Msg("failed to delete directory user")
-				return
-			}
+	var ids []string
+	for userID := range mgr.directoryUsers {
+		if _, ok := lookup[userID]; !ok {
+			ids = append(ids, userID)
+		}
+	}
+	if err := mgr.batchDelete(ctx, directoryUserTypeURL, ids); err != nil {
+		mgr.log.Warn().Err(err).Msg("failed to delete directory users")
+		return
+	}
+}
+
+// batchSet sets the records of a type, directoryBatchSize records at a time.
+func (mgr *Manager) batchSet(ctx context.Context, recordType string, records []*databroker.Record) error {
+	for len(records) > 0 {
+		n := len(records)
+		if n > directoryBatchSize {
+			n = directoryBatchSize
+		}
+		_, err := mgr.dataBrokerClient.BatchSet(ctx, &databroker.BatchSetRequest{
+			Type:    recordType,
+			Records: records[:n],
+		})
+		if err != nil {
+			return err
+		}
+		records = records[n:]
+	}
+	return nil
+}
+
+// batchDelete deletes the records of a type, directoryBatchSize records at a
+// time.
+func (mgr *Manager) batchDelete(ctx context.Context, recordType string, ids []string) error {
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > directoryBatchSize {
+			n = directoryBatchSize
+		}
+		_, err := mgr.dataBrokerClient.BatchDelete(ctx, &databroker.BatchDeleteRequest{
+			Type: recordType,
+			Ids:  ids[:n],
+		})
+		if err != nil {
+			return err
 		}
+		ids = ids[n:]
 	}
+	return nil
 }
 
 func (mgr *Manager) refreshSession(ctx context.Context, userID, sessionID string) {
@@ -349,12 +394,24 @@ func (mgr *Manager) refreshSession(ctx context.Context, userID, sessionID string
 		return
 	}
 
-	newToken, err := mgr.authenticator.Refresh(ctx, FromOAuthToken(s.OauthToken), &s)
+	previousToken := s.GetOauthToken()
+	newToken, err := mgr.getAuthenticator(s.GetIdpId()).Refresh(ctx, FromOAuthToken(previousToken), &s)
+	if err != nil {
+		metrics.RecordTokenRefreshFailure(ctx, metricsServiceName, mgr.cfg.providerName)
+	}
 	if isTemporaryError(err) {
 		mgr.log.Error().Err(err).
 			Str("user_id", s.GetUserId()).
 			Str("session_id", s.GetId()).
-			Msg("failed to refresh oauth2 token")
+			Msg("failed to refresh oauth2 token, will retry")
+		mgr.retrySession(s)
+		return
+	} else if isInvalidGrantError(err) {
+		mgr.log.Info().Err(err).
+			Str("user_id", s.GetUserId()).
+			Str("session_id", s.GetId()).
+			Msg("oauth2 refresh token was revoked or expired, deleting session")
+		mgr.deleteSession(ctx, s.Session)
 		return
 	} else if err != nil {
 		mgr.log.Error().Err(err).
@@ -364,18 +421,81 @@ func (mgr *Manager) refreshSession(ctx context.Context, userID, sessionID string
 		mgr.deleteSession(ctx, s.Session)
 		return
 	}
+	// identity providers don't always issue a new ID token on refresh
+	s.Session = proto.Clone(s.Session).(*session.Session)
 	s.OauthToken = ToOAuthToken(newToken)
+	if s.OauthToken.IdToken == "" {
+		s.OauthToken.IdToken = previousToken.GetIdToken()
+	}
 
-	res, err := session.Set(ctx, mgr.dataBrokerClient, s.Session)
-	if err != nil {
+	updated, res, err := mgr.updateSessionToken(ctx, s, previousToken)
+	if errors.Is(err, session.ErrChanged) {
+		// the session was signed in again or deleted, and the change will be
+		// received from the databroker
+		mgr.log.Info().
+			Str("user_id", s.GetUserId()).
+			Str("session_id", s.GetId()).
+			Msg("session changed during refresh, discarding refreshed token")
+		return
+	} else if err != nil {
+		// identity providers which rotate refresh tokens have already
+		// invalidated the previous one, so keep the new one to retry with
 		mgr.log.Error().Err(err).
 			Str("user_id", s.GetUserId()).
 			Str("session_id", s.GetId()).
-			Msg("failed to update session")
+			Msg("failed to update session, will retry")
+		mgr.retrySession(s)
 		return
 	}
 
-	mgr.onUpdateSession(ctx, sessionMessage{record: res.GetRecord(), session: s.Session})
+	mgr.onUpdateSession(ctx, sessionMessage{record: res.GetRecord(), session: updated})
+}
+
+// updateSessionToken writes the refreshed token of a session, unless the
+// session was changed since it was read. Identity providers which rotate
+// refresh tokens invalidate the previous one, so if only other fields were
+// changed the token is written to the latest session instead. ErrChanged is
+// returned if the session was deleted or signed in again.
+func (mgr *Manager) updateSessionToken(
+	ctx context.Context,
+	s Session,
+	previousToken *session.OAuthToken,
+) (*session.Session, *databroker.SetResponse, error) {
+	pbSession, version := s.Session, s.version
+	for attempt := 1; ; attempt++ {
+		res, err := session.CompareAndSet(ctx, mgr.dataBrokerClient, pbSession, version)
+		if !errors.Is(err, session.ErrChanged) || attempt == maxSessionUpdateAttempts {
+			return pbSession, res, err
+		}
+
+		any, _ := ptypes.MarshalAny(new(session.Session))
+		getRes, err := mgr.dataBrokerClient.Get(ctx, &databroker.GetRequest{
+			Type: any.GetTypeUrl(),
+			Id:   pbSession.GetId(),
+		})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, session.ErrChanged
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("error getting session from databroker: %w", err)
+		}
+		current := new(session.Session)
+		if err := ptypes.UnmarshalAny(getRes.GetRecord().GetData(), current); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshaling session from databroker: %w", err)
+		}
+		if current.GetOauthToken().GetRefreshToken() != previousToken.GetRefreshToken() {
+			return nil, nil, session.ErrChanged
+		}
+		current.OauthToken = s.OauthToken
+		pbSession, version = current, getRes.GetRecord().GetVersion()
+	}
+}
+
+// retrySession schedules another refresh of a session after the cool-off
+// duration.
+func (mgr *Manager) retrySession(s Session) {
+	s.lastRefresh = time.Now()
+	mgr.sessions.ReplaceOrInsert(s)
+	mgr.sessionScheduler.Add(s.NextRefresh(), toSessionSchedulerKey(s.GetUserId(), s.GetId()))
 }
 
 func (mgr *Manager) refreshUser(ctx context.Context, userID string) {
@@ -401,7 +521,7 @@ func (mgr *Manager) refreshUser(ctx context.Context, userID string) {
 			continue
 		}
 
-		err := mgr.authenticator.UpdateUserInfo(ctx, FromOAuthToken(s.OauthToken), &u)
+		err := UpdateUserInfo(ctx, mgr.getAuthenticator(s.GetIdpId()), FromOAuthToken(s.OauthToken), &u, mgr.cfg.mapClaims)
 		if isTemporaryError(err) {
 			mgr.log.Error().Err(err).
 				Str("user_id", s.GetUserId()).
@@ -646,16 +766,19 @@ func (mgr *Manager) onUpdateSession(ctx context.Context, msg sessionMessage) {
 	if msg.record.GetDeletedAt() != nil {
 		// remove from local store
 		mgr.sessions.Delete(msg.session.GetUserId(), msg.session.GetId())
+		metrics.SetActiveSessions(ctx, metricsServiceName, mgr.cfg.providerName, mgr.sessions.Len())
 		return
 	}
 
 	// update session
 	s, _ := mgr.sessions.Get(msg.session.GetUserId(), msg.session.GetId())
+	s.version = msg.record.GetVersion()
 	s.lastRefresh = time.Now()
 	s.gracePeriod = mgr.cfg.sessionRefreshGracePeriod
 	s.coolOffDuration = mgr.cfg.sessionRefreshCoolOffDuration
 	s.Session = msg.session
 	mgr.sessions.ReplaceOrInsert(s)
+	metrics.SetActiveSessions(ctx, metricsServiceName, mgr.cfg.providerName, mgr.sessions.Len())
 	mgr.sessionScheduler.Add(s.NextRefresh(), toSessionSchedulerKey(msg.session.GetUserId(), msg.session.GetId()))
 
 	// create the user if it doesn't exist yet
@@ -715,6 +838,15 @@ func (mgr *Manager) deleteSession(ctx context.Context, pbSession *session.Sessio
 	}
 }
 
+// getAuthenticator returns the authenticator of the identity provider with
+// the given id, or the default authenticator.
+func (mgr *Manager) getAuthenticator(idpID string) Authenticator {
+	if a, ok := mgr.cfg.authenticators[idpID]; ok {
+		return a
+	}
+	return mgr.authenticator
+}
+
 func isTemporaryError(err error) bool {
 	if err == nil {
 		return false
@@ -725,5 +857,30 @@ func isTemporaryError(err error) bool {
 	if e, ok := err.(interface{ Temporary() bool }); ok && e.Temporary() {
 		return true
 	}
+	// identity provider outages and rate limits
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+		code := retrieveErr.Response.StatusCode
+		return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+	}
 	return false
 }
+
+// isInvalidGrantError returns true if the identity provider rejected a refresh
+// token because it was revoked, expired or, for providers which rotate refresh
+// tokens, already used.
+func isInvalidGrantError(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		return false
+	}
+	var body struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(retrieveErr.Body, &body) == nil {
+		return body.Error == "invalid_grant"
+	}
+	// some identity providers respond with a form-encoded body
+	values, err := url.ParseQuery(string(retrieveErr.Body))
+	return err == nil && values.Get("error") == "invalid_grant"
+}
@@ -0,0 +1,177 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+type mockAuthenticator struct {
+	refresh func(context.Context, *oauth2.Token) (*oauth2.Token, error)
+}
+
+func (a mockAuthenticator) Refresh(ctx context.Context, t *oauth2.Token, v interface{}) (*oauth2.Token, error) {
+	return a.refresh(ctx, t)
+}
+
+func (a mockAuthenticator) Revoke(context.Context, *oauth2.Token) error {
+	return nil
+}
+
+func (a mockAuthenticator) UpdateUserInfo(ctx context.Context, t *oauth2.Token, v interface{}) error {
+	return nil
+}
+
+func newTestDataBrokerClient(t *testing.T) databroker.DataBrokerServiceClient {
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(grpcServer, internal_databroker.New())
+	go func() { _ = grpcServer.Serve(li) }()
+	t.Cleanup(grpcServer.Stop)
+	cc, err := grpc.Dial(li.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cc.Close() })
+	return databroker.NewDataBrokerServiceClient(cc)
+}
+
+func TestManager_refreshSession(t *testing.T) {
+	ctx := context.Background()
+
+	rotate := func(ctx context.Context, t *oauth2.Token) (*oauth2.Token, error) {
+		return &oauth2.Token{
+			AccessToken:  "ACCESS_TOKEN_2",
+			RefreshToken: "REFRESH_TOKEN_2",
+			Expiry:       time.Now().Add(time.Hour),
+		}, nil
+	}
+	invalidGrant := func(ctx context.Context, t *oauth2.Token) (*oauth2.Token, error) {
+		return nil, &oauth2.RetrieveError{
+			Response: &http.Response{StatusCode: http.StatusBadRequest},
+			Body:     []byte(`{"error":"invalid_grant","error_description":"refresh token reused"}`),
+		}
+	}
+	unavailable := func(ctx context.Context, t *oauth2.Token) (*oauth2.Token, error) {
+		return nil, &oauth2.RetrieveError{
+			Response: &http.Response{StatusCode: http.StatusServiceUnavailable},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		refresh          func(context.Context, *oauth2.Token) (*oauth2.Token, error)
+		update           func(s *session.Session)
+		wantDeleted      bool
+		wantRefreshToken string
+		wantIDToken      string
+		wantRetry        bool
+	}{
+		{"rotated", rotate, nil, false, "REFRESH_TOKEN_2", "ID_TOKEN", false},
+		{"invalid grant", invalidGrant, nil, true, "", "", false},
+		{"unavailable", unavailable, nil, false, "REFRESH_TOKEN_1", "ID_TOKEN", true},
+		{
+			"changed", rotate, func(s *session.Session) { s.IdpSessionId = "OTHER_SID" },
+			false, "REFRESH_TOKEN_2", "ID_TOKEN", false,
+		},
+		{
+			"signed in again", rotate, func(s *session.Session) { s.OauthToken.RefreshToken = "REFRESH_TOKEN_3" },
+			false, "REFRESH_TOKEN_3", "ID_TOKEN", false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestDataBrokerClient(t)
+			mgr := New(mockAuthenticator{refresh: tt.refresh}, nil, client)
+
+			expiresAt, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+			s := &session.Session{
+				Id:        "SESSION_ID",
+				UserId:    "USER_ID",
+				ExpiresAt: expiresAt,
+				OauthToken: &session.OAuthToken{
+					AccessToken:  "ACCESS_TOKEN_1",
+					RefreshToken: "REFRESH_TOKEN_1",
+					IdToken:      "ID_TOKEN",
+				},
+			}
+			res, err := session.Set(ctx, client, s)
+			require.NoError(t, err)
+			mgr.onUpdateSession(ctx, sessionMessage{record: res.GetRecord(), session: s})
+
+			if tt.update != nil {
+				changed, err := session.Get(ctx, client, s.GetId())
+				require.NoError(t, err)
+				tt.update(changed)
+				_, err = session.Set(ctx, client, changed)
+				require.NoError(t, err)
+			}
+
+			mgr.sessionScheduler.Remove(toSessionSchedulerKey(s.GetUserId(), s.GetId()))
+			mgr.refreshSession(ctx, s.GetUserId(), s.GetId())
+
+			got, err := session.Get(ctx, client, s.GetId())
+			if tt.wantDeleted {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRefreshToken, got.GetOauthToken().GetRefreshToken())
+			assert.Equal(t, tt.wantIDToken, got.GetOauthToken().GetIdToken())
+			if tt.update != nil {
+				tt.update(s)
+				assert.Equal(t, s.GetIdpSessionId(), got.GetIdpSessionId())
+			}
+
+			tm, key := mgr.sessionScheduler.Next()
+			if tt.wantRetry {
+				assert.Equal(t, toSessionSchedulerKey(s.GetUserId(), s.GetId()), key)
+				assert.False(t, tm.IsZero())
+			}
+		})
+	}
+}
+
+func TestIsInvalidGrantError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{&oauth2.RetrieveError{Body: []byte(`{"error":"invalid_grant"}`)}, true},
+		{&oauth2.RetrieveError{Body: []byte(`error=invalid_grant&error_description=revoked`)}, true},
+		{&oauth2.RetrieveError{Body: []byte(`{"error":"invalid_client"}`)}, false},
+		{errors.New("invalid_grant"), false},
+		{nil, false},
+	} {
+		assert.Equal(t, tc.want, isInvalidGrantError(tc.err), "%v", tc.err)
+	}
+}
+
+func TestIsTemporaryError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{context.DeadlineExceeded, true},
+		{&oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusBadGateway}}, true},
+		{&oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}, true},
+		{&oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusBadRequest}}, false},
+		{errors.New("error"), false},
+		{nil, false},
+	} {
+		assert.Equal(t, tc.want, isTemporaryError(tc.err), "%v", tc.err)
+	}
+}
@@ -111,13 +111,16 @@ func FromOAuthToken(token *session.OAuthToken) *oauth2.Token {
 	}
 }
 
-// ToOAuthToken converts an oauth2.Token to a session oauth token.
+// ToOAuthToken converts an oauth2.Token to a session oauth token. The raw ID
+// token of the token response, if any, is kept too.
 func ToOAuthToken(token *oauth2.Token) *session.OAuthToken {
 	expiry, _ := ptypes.TimestampProto(token.Expiry)
+	idToken, _ := token.Extra("id_token").(string)
 	return &session.OAuthToken{
 		AccessToken:  token.AccessToken,
 		TokenType:    token.TokenType,
 		RefreshToken: token.RefreshToken,
 		ExpiresAt:    expiry,
+		IdToken:      idToken,
 	}
 }
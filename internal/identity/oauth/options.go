@@ -3,7 +3,10 @@
 // authorization with Bearer JWT.
 package oauth
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+)
 
 // Options contains the fields required for an OAuth 2.0 (inc. OIDC) auth flow.
 //
@@ -33,4 +36,11 @@ type Options struct {
 	// AuthCodeOptions specifies additional key value pairs query params to add
 	// to the request flow signin url.
 	AuthCodeOptions map[string]string
+
+	// ClockSkew is the clock skew tolerated when validating ID tokens.
+	ClockSkew time.Duration
+
+	// SAMLAttributes maps claims to the SAML attributes they're read from,
+	// for the providers which use SAML.
+	SAMLAttributes map[string]string
 }
@@ -0,0 +1,54 @@
+// Package auth0 implements OpenID Connect for Auth0
+//
+// https://www.pomerium.io/docs/identity-providers/auth0.html
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	oidc "github.com/coreos/go-oidc"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	pom_oidc "github.com/pomerium/pomerium/internal/identity/oidc"
+)
+
+const (
+	// Name identifies the Auth0 identity provider
+	Name = "auth0"
+)
+
+var defaultScopes = []string{oidc.ScopeOpenID, "profile", "email", "offline_access"}
+
+// Provider is an Auth0 implementation of the Authenticator interface.
+type Provider struct {
+	*pom_oidc.Provider
+}
+
+// New instantiates an OpenID Connect (OIDC) provider for Auth0.
+func New(ctx context.Context, o *oauth.Options) (*Provider, error) {
+	var p Provider
+	var err error
+	if o.ProviderURL == "" {
+		return nil, pom_oidc.ErrMissingProviderURL
+	}
+	// the issuer of Auth0 tenants has a trailing slash, which must match
+	if !strings.HasSuffix(o.ProviderURL, "/") {
+		o.ProviderURL += "/"
+	}
+	if len(o.Scopes) == 0 {
+		o.Scopes = defaultScopes
+	}
+	genericOidc, err := pom_oidc.New(ctx, o)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed creating oidc provider: %w", Name, err)
+	}
+	p.Provider = genericOidc
+	return &p, nil
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
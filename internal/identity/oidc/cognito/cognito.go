@@ -0,0 +1,156 @@
+// Package cognito implements OpenID Connect for AWS Cognito user pools.
+//
+// https://www.pomerium.io/docs/identity-providers/cognito.html
+package cognito
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	go_oidc "github.com/coreos/go-oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	pom_oidc "github.com/pomerium/pomerium/internal/identity/oidc"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+const (
+	// Name identifies the AWS Cognito identity provider
+	Name = "cognito"
+)
+
+// Cognito doesn't support the offline_access scope, refresh tokens are always
+// returned.
+var defaultScopes = []string{go_oidc.ScopeOpenID, "profile", "email"}
+
+// Provider is an AWS Cognito implementation of the Authenticator interface.
+type Provider struct {
+	*pom_oidc.Provider
+
+	// accessTokenVerifier verifies access tokens, which unlike ID tokens
+	// have a client_id claim instead of an audience.
+	accessTokenVerifier *go_oidc.IDTokenVerifier
+}
+
+// New instantiates an OpenID Connect (OIDC) provider for AWS Cognito. The
+// provider URL is the user pool's issuer, i.e.
+// https://cognito-idp.{region}.amazonaws.com/{user pool id}.
+func New(ctx context.Context, o *oauth.Options) (*Provider, error) {
+	var p Provider
+	var err error
+	if o.ProviderURL == "" {
+		return nil, pom_oidc.ErrMissingProviderURL
+	}
+	if len(o.Scopes) == 0 {
+		o.Scopes = defaultScopes
+	}
+	genericOidc, err := pom_oidc.New(ctx, o)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed creating oidc provider: %w", Name, err)
+	}
+	p.Provider = genericOidc
+	p.accessTokenVerifier = genericOidc.Provider.Verifier(&go_oidc.Config{SkipClientIDCheck: true})
+	return &p, nil
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
+
+// LogOut returns the logout endpoint of the user pool's domain. Cognito's
+// discovery document doesn't have an end session endpoint, but the logout
+// endpoint is on the same domain as the authorization endpoint.
+//
+// https://docs.aws.amazon.com/cognito/latest/developerguide/logout-endpoint.html
+func (p *Provider) LogOut() (*url.URL, error) {
+	authURL, err := url.Parse(p.Oauth.Endpoint.AuthURL)
+	if err != nil || authURL.Host == "" {
+		return nil, pom_oidc.ErrSignoutNotImplemented
+	}
+	return authURL.ResolveReference(&url.URL{Path: "/logout"}), nil
+}
+
+// LogOutURL returns the URL which signs the user out of the user pool, and
+// then redirects them to redirectURI. Instead of the standard end session
+// parameters, Cognito requires the client ID and a logout URI, which must be
+// one of the app client's sign out URLs.
+func (p *Provider) LogOutURL(redirectURI string) (*url.URL, error) {
+	u, err := p.LogOut()
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("client_id", p.Oauth.ClientID)
+	if redirectURI != "" {
+		q.Set("logout_uri", redirectURI)
+	}
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// UpdateUserInfo hydrates v with the claims of the user info endpoint, and
+// the user's user pool groups as the groups claim. The user info endpoint
+// doesn't return the groups, so they're read from the cognito:groups claim of
+// the tokens.
+func (p *Provider) UpdateUserInfo(ctx context.Context, t *oauth2.Token, v interface{}) error {
+	claims := map[string]interface{}{}
+	if err := p.Provider.UpdateUserInfo(ctx, t, &claims); err != nil {
+		return err
+	}
+
+	groups, err := p.getGroups(ctx, t)
+	if err != nil {
+		log.Warn().Err(err).Msg("cognito: couldn't get user pool groups")
+	} else {
+		claims["groups"] = groups
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: couldn't unmarshal claims: %w", Name, err)
+	}
+	return nil
+}
+
+// getGroups returns the cognito:groups claim of the ID token, or of the access
+// token when there's no ID token, which is the case for tokens of existing
+// sessions. Users without groups have no claim.
+//
+// https://docs.aws.amazon.com/cognito/latest/developerguide/amazon-cognito-user-pools-using-tokens-with-identity-providers.html
+func (p *Provider) getGroups(ctx context.Context, t *oauth2.Token) ([]string, error) {
+	var claims struct {
+		Groups   []string `json:"cognito:groups"`
+		ClientID string   `json:"client_id"`
+		TokenUse string   `json:"token_use"`
+	}
+
+	if rawIDToken, ok := t.Extra("id_token").(string); ok {
+		idToken, err := p.Verifier.Verify(ctx, rawIDToken)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid id_token: %w", Name, err)
+		}
+		if err := idToken.Claims(&claims); err != nil {
+			return nil, err
+		}
+		return claims.Groups, nil
+	}
+
+	accessToken, err := p.accessTokenVerifier.Verify(ctx, t.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid access token: %w", Name, err)
+	}
+	if err := accessToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	if claims.TokenUse != "access" || claims.ClientID != p.Oauth.ClientID {
+		return nil, fmt.Errorf("%s: access token isn't for client %s", Name, p.Oauth.ClientID)
+	}
+	return claims.Groups, nil
+}
@@ -0,0 +1,135 @@
+package cognito
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/identity/manager"
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+type M = map[string]interface{}
+
+func newTestProvider(t *testing.T) (*Provider, func(claims M) string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "KEY"))
+	require.NoError(t, err)
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/us-east-1_TEST/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(M{
+				"issuer":                 srv.URL + "/us-east-1_TEST",
+				"authorization_endpoint": "https://pomerium.auth.us-east-1.amazoncognito.com/oauth2/authorize",
+				"token_endpoint":         "https://pomerium.auth.us-east-1.amazoncognito.com/oauth2/token",
+				"userinfo_endpoint":      srv.URL + "/oauth2/userInfo",
+				"jwks_uri":               srv.URL + "/us-east-1_TEST/.well-known/jwks.json",
+			})
+		case "/us-east-1_TEST/.well-known/jwks.json":
+			_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+				{Key: key.Public(), KeyID: "KEY", Algorithm: "RS256", Use: "sig"},
+			}})
+		case "/oauth2/userInfo":
+			_ = json.NewEncoder(w).Encode(M{
+				"sub":            "SUBJECT",
+				"email":          "user@example.com",
+				"email_verified": "true",
+				"username":       "user",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	p, err := New(context.Background(), &oauth.Options{
+		ProviderName: Name,
+		ProviderURL:  srv.URL + "/us-east-1_TEST",
+		ClientID:     "CLIENT_ID",
+		ClientSecret: "CLIENT_SECRET",
+		RedirectURL:  &url.URL{Scheme: "https", Host: "authenticate.example.com", Path: "/oauth2/callback"},
+	})
+	require.NoError(t, err)
+
+	sign := func(claims M) string {
+		claims["iss"] = srv.URL + "/us-east-1_TEST"
+		claims["sub"] = "SUBJECT"
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+		raw, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		return raw
+	}
+	return p, sign
+}
+
+func TestProvider_LogOut(t *testing.T) {
+	p, _ := newTestProvider(t)
+
+	u, err := p.LogOut()
+	require.NoError(t, err)
+	assert.Equal(t, "https://pomerium.auth.us-east-1.amazoncognito.com/logout", u.String())
+
+	u, err = p.LogOutURL("https://app.example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://pomerium.auth.us-east-1.amazoncognito.com/logout?client_id=CLIENT_ID&logout_uri=https%3A%2F%2Fapp.example.com%2F", u.String())
+}
+
+func TestProvider_UpdateUserInfo(t *testing.T) {
+	p, sign := newTestProvider(t)
+
+	getClaims := func(t *testing.T, tok *oauth2.Token) *manager.User {
+		u := &manager.User{User: &user.User{Id: "USER_ID"}}
+		require.NoError(t, p.UpdateUserInfo(context.Background(), tok, u))
+		assert.Equal(t, "user@example.com", u.GetEmail())
+		assert.Contains(t, u.GetClaims(), "username")
+		return u
+	}
+	getGroups := func(u *manager.User) []interface{} {
+		groups, ok := u.GetClaims()["groups"]
+		if !ok {
+			return nil
+		}
+		var lst structpb.ListValue
+		require.NoError(t, ptypes.UnmarshalAny(groups, &lst))
+		return lst.AsSlice()
+	}
+
+	t.Run("id token", func(t *testing.T) {
+		tok := (&oauth2.Token{AccessToken: "ACCESS_TOKEN"}).WithExtra(M{
+			"id_token": sign(M{"aud": "CLIENT_ID", "token_use": "id", "cognito:groups": []string{"admins", "users"}}),
+		})
+		assert.Equal(t, []interface{}{"admins", "users"}, getGroups(getClaims(t, tok)))
+	})
+	t.Run("access token", func(t *testing.T) {
+		tok := &oauth2.Token{AccessToken: sign(M{"client_id": "CLIENT_ID", "token_use": "access", "cognito:groups": []string{"admins"}})}
+		assert.Equal(t, []interface{}{"admins"}, getGroups(getClaims(t, tok)))
+	})
+	t.Run("access token for another client", func(t *testing.T) {
+		tok := &oauth2.Token{AccessToken: sign(M{"client_id": "OTHER", "token_use": "access", "cognito:groups": []string{"admins"}})}
+		assert.Nil(t, getGroups(getClaims(t, tok)))
+	})
+	t.Run("invalid id token", func(t *testing.T) {
+		tok := (&oauth2.Token{AccessToken: "ACCESS_TOKEN"}).WithExtra(M{"id_token": "INVALID"})
+		assert.Nil(t, getGroups(getClaims(t, tok)))
+	})
+}
@@ -26,3 +26,11 @@ var ErrMissingRefreshToken = errors.New("identity/oidc: missing refresh token")
 
 // ErrMissingAccessToken is returned when no access token was found.
 var ErrMissingAccessToken = errors.New("identity/oidc: missing access token")
+
+// ErrIDTokenExpired is returned when an id_token has expired, even allowing
+// for clock skew.
+var ErrIDTokenExpired = errors.New("identity/oidc: id_token is expired")
+
+// ErrIDTokenNotValidYet is returned when an id_token isn't valid yet, even
+// allowing for clock skew.
+var ErrIDTokenNotValidYet = errors.New("identity/oidc: id_token is not valid yet")
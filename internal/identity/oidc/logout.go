@@ -0,0 +1,78 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// backChannelLogoutEvent is the event member of logout tokens.
+const backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+// logoutTokenMaxAge is how long after it was issued a logout token is
+// accepted, before allowing for clock skew.
+const logoutTokenMaxAge = 5 * time.Minute
+
+// ErrInvalidLogoutToken is returned when a logout token isn't valid.
+var ErrInvalidLogoutToken = errors.New("identity/oidc: invalid logout token")
+
+// A LogoutToken is a verified back-channel logout token. It identifies the
+// sessions to log out by the user's subject, the identity provider's session
+// id, or both.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+type LogoutToken struct {
+	Subject   string
+	SessionID string
+}
+
+// VerifyLogoutToken verifies a logout token sent by the identity provider to
+// the back-channel logout endpoint.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#Validation
+func (p *Provider) VerifyLogoutToken(ctx context.Context, rawLogoutToken string) (*LogoutToken, error) {
+	// the verifier checks the signature, issuer and audience
+	token, err := p.Verifier.Verify(ctx, rawLogoutToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLogoutToken, err)
+	}
+
+	var claims struct {
+		SessionID string                     `json:"sid"`
+		Events    map[string]json.RawMessage `json:"events"`
+		Nonce     *string                    `json:"nonce"`
+	}
+	if err := token.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLogoutToken, err)
+	}
+	if _, ok := claims.Events[backChannelLogoutEvent]; !ok {
+		return nil, fmt.Errorf("%w: missing back-channel logout event", ErrInvalidLogoutToken)
+	}
+	// the nonce is prohibited, so ID tokens can't be used as logout tokens
+	if claims.Nonce != nil {
+		return nil, fmt.Errorf("%w: nonce is prohibited", ErrInvalidLogoutToken)
+	}
+	if token.Subject == "" && claims.SessionID == "" {
+		return nil, fmt.Errorf("%w: sub or sid is required", ErrInvalidLogoutToken)
+	}
+
+	now := timeNow()
+	if token.IssuedAt.IsZero() {
+		return nil, fmt.Errorf("%w: iat is required", ErrInvalidLogoutToken)
+	}
+	if err := validateIDTokenTimes(token.IssuedAt.Add(logoutTokenMaxAge), token.IssuedAt, now, p.ClockSkew); err != nil {
+		return nil, fmt.Errorf("%w: issued at %s", ErrInvalidLogoutToken, token.IssuedAt)
+	}
+	if !token.Expiry.IsZero() {
+		if err := validateIDTokenTimes(token.Expiry, time.Time{}, now, p.ClockSkew); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidLogoutToken, err)
+		}
+	}
+
+	return &LogoutToken{
+		Subject:   token.Subject,
+		SessionID: claims.SessionID,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	go_oidc "github.com/coreos/go-oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+type staticKeySet struct {
+	key *rsa.PublicKey
+}
+
+func (ks staticKeySet) VerifySignature(ctx context.Context, rawJWT string) ([]byte, error) {
+	jws, err := jose.ParseSigned(rawJWT)
+	if err != nil {
+		return nil, err
+	}
+	return jws.Verify(ks.key)
+}
+
+func TestProvider_VerifyLogoutToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	p := &Provider{
+		Verifier: go_oidc.NewVerifier("https://idp.example.com", staticKeySet{&key.PublicKey}, &go_oidc.Config{
+			ClientID:        "CLIENT_ID",
+			SkipExpiryCheck: true,
+		}),
+		ClockSkew: time.Minute,
+	}
+	events := map[string]interface{}{backChannelLogoutEvent: map[string]interface{}{}}
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":    "https://idp.example.com",
+			"aud":    "CLIENT_ID",
+			"iat":    now.Unix(),
+			"jti":    "JTI",
+			"sub":    "SUBJECT",
+			"sid":    "SID",
+			"events": events,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(claims map[string]interface{})
+		want   *LogoutToken
+	}{
+		{"valid", func(map[string]interface{}) {}, &LogoutToken{Subject: "SUBJECT", SessionID: "SID"}},
+		{"subject only", func(c map[string]interface{}) { delete(c, "sid") }, &LogoutToken{Subject: "SUBJECT"}},
+		{"session id only", func(c map[string]interface{}) { delete(c, "sub") }, &LogoutToken{SessionID: "SID"}},
+		{"no subject or session id", func(c map[string]interface{}) { delete(c, "sub"); delete(c, "sid") }, nil},
+		{"wrong issuer", func(c map[string]interface{}) { c["iss"] = "https://other.example.com" }, nil},
+		{"wrong audience", func(c map[string]interface{}) { c["aud"] = "OTHER" }, nil},
+		{"no event", func(c map[string]interface{}) { delete(c, "events") }, nil},
+		{"nonce", func(c map[string]interface{}) { c["nonce"] = "NONCE" }, nil},
+		{"no issued at", func(c map[string]interface{}) { delete(c, "iat") }, nil},
+		{"issued too long ago", func(c map[string]interface{}) { c["iat"] = now.Add(-time.Hour).Unix() }, nil},
+		{"issued in the future", func(c map[string]interface{}) { c["iat"] = now.Add(time.Hour).Unix() }, nil},
+		{"expired", func(c map[string]interface{}) { c["exp"] = now.Add(-time.Hour).Unix() }, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			tt.modify(claims)
+			raw, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+			require.NoError(t, err)
+
+			token, err := p.VerifyLogoutToken(context.Background(), raw)
+			if tt.want == nil {
+				assert.True(t, errors.Is(err, ErrInvalidLogoutToken), "expected an invalid logout token error, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, token)
+		})
+	}
+}
@@ -10,9 +10,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	go_oidc "github.com/coreos/go-oidc"
 	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2/jwt"
 
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/identity/oauth"
@@ -27,6 +30,8 @@ var defaultScopes = []string{go_oidc.ScopeOpenID, "profile", "email", "offline_a
 
 var defaultAuthCodeOptions = []oauth2.AuthCodeOption{oauth2.AccessTypeOffline}
 
+var timeNow = time.Now
+
 // Provider provides a standard, OpenID Connect implementation
 // of an authorization identity provider.
 // https://openid.net/specs/openid-connect-core-1_0.html
@@ -51,6 +56,9 @@ type Provider struct {
 	// AuthCodeOptions specifies additional key value pairs query params to add
 	// to the request flow signin url.
 	AuthCodeOptions map[string]string
+
+	// ClockSkew is the clock skew tolerated when validating ID tokens.
+	ClockSkew time.Duration `json:"-"`
 }
 
 // New creates a new instance of a generic OpenID Connect provider.
@@ -68,7 +76,9 @@ func New(ctx context.Context, o *oauth.Options) (*Provider, error) {
 		return nil, fmt.Errorf("identity/oidc: could not connect to %s: %w", o.ProviderName, err)
 	}
 
-	p.Verifier = p.Provider.Verifier(&go_oidc.Config{ClientID: o.ClientID})
+	// the expiry is checked by getIDToken instead, so clock skew is tolerated
+	p.Verifier = p.Provider.Verifier(&go_oidc.Config{ClientID: o.ClientID, SkipExpiryCheck: true})
+	p.ClockSkew = o.ClockSkew
 	p.Oauth = &oauth2.Config{
 		ClientID:     o.ClientID,
 		ClientSecret: o.ClientSecret,
@@ -103,6 +113,24 @@ func (p *Provider) GetSignInURL(state string) string {
 	return p.Oauth.AuthCodeURL(state, opts...)
 }
 
+// GetStepUpSignInURL returns the url of the provider's OAuth 2.0 consent
+// page, asking the provider to authenticate the user again, with one of the
+// given authentication context classes, if any, even if they're already
+// signed in with the provider.
+//
+// https://openid.net/specs/openid-connect-core-1_0.html#AuthRequest
+func (p *Provider) GetStepUpSignInURL(state string, acrValues []string) string {
+	opts := defaultAuthCodeOptions
+	for k, v := range p.AuthCodeOptions {
+		opts = append(opts, oauth2.SetAuthURLParam(k, v))
+	}
+	opts = append(opts, oauth2.SetAuthURLParam("prompt", "login"))
+	if len(acrValues) > 0 {
+		opts = append(opts, oauth2.SetAuthURLParam("acr_values", strings.Join(acrValues, " ")))
+	}
+	return p.Oauth.AuthCodeURL(state, opts...)
+}
+
 // Authenticate converts an authorization code returned from the identity
 // provider into a token which is then converted into a user session.
 func (p *Provider) Authenticate(ctx context.Context, code string, v interface{}) (*oauth2.Token, error) {
@@ -181,7 +209,37 @@ func (p *Provider) getIDToken(ctx context.Context, t *oauth2.Token) (*go_oidc.ID
 	if !ok {
 		return nil, ErrMissingIDToken
 	}
-	return p.Verifier.Verify(ctx, rawIDToken)
+	idToken, err := p.Verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims struct {
+		NotBefore *jwt.NumericDate `json:"nbf"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	var notBefore time.Time
+	if claims.NotBefore != nil {
+		notBefore = claims.NotBefore.Time()
+	}
+	if err := validateIDTokenTimes(idToken.Expiry, notBefore, timeNow(), p.ClockSkew); err != nil {
+		return nil, err
+	}
+	return idToken, nil
+}
+
+// validateIDTokenTimes checks that an ID token with the given expiry and not
+// before times is valid at the given time, tolerating the given clock skew.
+func validateIDTokenTimes(expiry, notBefore, now time.Time, skew time.Duration) error {
+	if now.Add(-skew).After(expiry) {
+		return ErrIDTokenExpired
+	}
+	if !notBefore.IsZero() && now.Add(skew).Before(notBefore) {
+		return ErrIDTokenNotValidYet
+	}
+	return nil
 }
 
 // Revoke enables a user to revoke her token. If the identity provider does not
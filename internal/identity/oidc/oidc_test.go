@@ -0,0 +1,57 @@
+package oidc
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestValidateIDTokenTimes(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		expiry    time.Time
+		notBefore time.Time
+		skew      time.Duration
+		want      error
+	}{
+		{"valid", now.Add(time.Hour), now.Add(-time.Minute), 0, nil},
+		{"no not before", now.Add(time.Hour), time.Time{}, 0, nil},
+		{"expired", now.Add(-time.Second), time.Time{}, 0, ErrIDTokenExpired},
+		{"expired within skew", now.Add(-30 * time.Second), time.Time{}, time.Minute, nil},
+		{"expired beyond skew", now.Add(-2 * time.Minute), time.Time{}, time.Minute, ErrIDTokenExpired},
+		{"not valid yet", now.Add(time.Hour), now.Add(time.Second), 0, ErrIDTokenNotValidYet},
+		{"not valid yet within skew", now.Add(time.Hour), now.Add(30 * time.Second), time.Minute, nil},
+		{"not valid yet beyond skew", now.Add(time.Hour), now.Add(2 * time.Minute), time.Minute, ErrIDTokenNotValidYet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateIDTokenTimes(tt.expiry, tt.notBefore, now, tt.skew))
+		})
+	}
+}
+
+func TestProvider_GetStepUpSignInURL(t *testing.T) {
+	p := &Provider{
+		Oauth: &oauth2.Config{
+			ClientID: "CLIENT_ID",
+			Endpoint: oauth2.Endpoint{AuthURL: "https://idp.example.com/authorize"},
+		},
+		AuthCodeOptions: map[string]string{"prompt": "select_account"},
+	}
+
+	u, err := url.Parse(p.GetStepUpSignInURL("STATE", []string{"urn:example:mfa", "urn:example:hwk"}))
+	require.NoError(t, err)
+	assert.Equal(t, "STATE", u.Query().Get("state"))
+	assert.Equal(t, "login", u.Query().Get("prompt"))
+	assert.Equal(t, "urn:example:mfa urn:example:hwk", u.Query().Get("acr_values"))
+
+	u, err = url.Parse(p.GetStepUpSignInURL("STATE", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "login", u.Query().Get("prompt"))
+	assert.Empty(t, u.Query().Get("acr_values"))
+}
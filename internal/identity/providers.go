@@ -10,14 +10,18 @@ import (
 
 	"golang.org/x/oauth2"
 
+	"github.com/pomerium/pomerium/internal/identity/ldap"
 	"github.com/pomerium/pomerium/internal/identity/oauth"
 	"github.com/pomerium/pomerium/internal/identity/oauth/github"
 	"github.com/pomerium/pomerium/internal/identity/oidc"
+	"github.com/pomerium/pomerium/internal/identity/oidc/auth0"
 	"github.com/pomerium/pomerium/internal/identity/oidc/azure"
+	"github.com/pomerium/pomerium/internal/identity/oidc/cognito"
 	"github.com/pomerium/pomerium/internal/identity/oidc/gitlab"
 	"github.com/pomerium/pomerium/internal/identity/oidc/google"
 	"github.com/pomerium/pomerium/internal/identity/oidc/okta"
 	"github.com/pomerium/pomerium/internal/identity/oidc/onelogin"
+	"github.com/pomerium/pomerium/internal/identity/saml"
 )
 
 // Authenticator is an interface representing the ability to authenticate with an identity provider.
@@ -31,24 +35,39 @@ type Authenticator interface {
 	UpdateUserInfo(ctx context.Context, t *oauth2.Token, v interface{}) error
 }
 
+// A StepUpAuthenticator is an Authenticator which can ask the identity
+// provider to authenticate the user again, e.g. with a second factor, when a
+// route requires a step-up authentication.
+type StepUpAuthenticator interface {
+	GetStepUpSignInURL(state string, acrValues []string) string
+}
+
 // NewAuthenticator returns a new identity provider based on its name.
 func NewAuthenticator(o oauth.Options) (a Authenticator, err error) {
 	ctx := context.Background()
 	switch o.ProviderName {
+	case auth0.Name:
+		a, err = auth0.New(ctx, &o)
 	case azure.Name:
 		a, err = azure.New(ctx, &o)
+	case cognito.Name:
+		a, err = cognito.New(ctx, &o)
 	case gitlab.Name:
 		a, err = gitlab.New(ctx, &o)
 	case github.Name:
 		a, err = github.New(ctx, &o)
 	case google.Name:
 		a, err = google.New(ctx, &o)
+	case ldap.Name:
+		a, err = ldap.New(ctx, &o)
 	case oidc.Name:
 		a, err = oidc.New(ctx, &o)
 	case okta.Name:
 		a, err = okta.New(ctx, &o)
 	case onelogin.Name:
 		a, err = onelogin.New(ctx, &o)
+	case saml.Name:
+		a, err = saml.New(ctx, &o)
 	default:
 		return nil, fmt.Errorf("identity: unknown provider: %s", o.ProviderName)
 	}
@@ -77,7 +96,13 @@ func NewAtomicAuthenticator() *AtomicAuthenticator {
 
 // Load loads the current authenticator.
 func (a *AtomicAuthenticator) Load() Authenticator {
-	return a.current.Load().(authenticatorValue)
+	v := a.current.Load().(authenticatorValue)
+	if v.Authenticator == nil {
+		return v
+	}
+	// return the authenticator itself, so the methods of a provider beyond
+	// the Authenticator interface can be used
+	return v.Authenticator
 }
 
 // Store stores the authenticator.
@@ -0,0 +1,176 @@
+package saml
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+const (
+	nsMetadata = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	bindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	protocolSAML2 = "urn:oasis:names:tc:SAML:2.0:protocol"
+)
+
+// maxMetadataSize is the largest identity provider metadata document read.
+const maxMetadataSize = 10 << 20
+
+// idpMetadata is the metadata of an identity provider needed to sign users
+// in.
+type idpMetadata struct {
+	entityID string
+	ssoURL   string
+	certs    []*x509.Certificate
+}
+
+type entityDescriptor struct {
+	XMLName           xml.Name
+	EntityID          string             `xml:"entityID,attr"`
+	EntityDescriptors []entityDescriptor `xml:"EntityDescriptor"`
+	IDPSSODescriptors []struct {
+		KeyDescriptors []struct {
+			Use          string   `xml:"use,attr"`
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SingleSignOnServices []struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"SingleSignOnService"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+// fetchIDPMetadata fetches the metadata of an identity provider from its
+// metadata URL.
+func fetchIDPMetadata(ctx context.Context, metadataURL string) (*idpMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", version.UserAgent())
+	res, err := httputil.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(res.Body, maxMetadataSize))
+	if err != nil {
+		return nil, err
+	}
+	return parseIDPMetadata(data)
+}
+
+// parseIDPMetadata parses the metadata of an identity provider, which is
+// either an EntityDescriptor, or an EntitiesDescriptor with a single identity
+// provider.
+func parseIDPMetadata(data []byte) (*idpMetadata, error) {
+	var root entityDescriptor
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+	if root.XMLName.Space != nsMetadata {
+		return nil, fmt.Errorf("invalid metadata element %s", root.XMLName.Local)
+	}
+
+	var idp *entityDescriptor
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+		idp = &root
+	case "EntitiesDescriptor":
+		for i := range root.EntityDescriptors {
+			if len(root.EntityDescriptors[i].IDPSSODescriptors) == 0 {
+				continue
+			}
+			if idp != nil {
+				return nil, errors.New("metadata has more than one identity provider")
+			}
+			idp = &root.EntityDescriptors[i]
+		}
+	default:
+		return nil, fmt.Errorf("invalid metadata element %s", root.XMLName.Local)
+	}
+	if idp == nil || len(idp.IDPSSODescriptors) == 0 {
+		return nil, errors.New("metadata has no identity provider")
+	}
+
+	md := &idpMetadata{entityID: idp.EntityID}
+	for _, desc := range idp.IDPSSODescriptors {
+		for _, kd := range desc.KeyDescriptors {
+			if kd.Use != "" && kd.Use != "signing" {
+				continue
+			}
+			for _, c := range kd.Certificates {
+				der, err := decodeBase64(c)
+				if err != nil {
+					return nil, fmt.Errorf("invalid signing certificate: %w", err)
+				}
+				cert, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, fmt.Errorf("invalid signing certificate: %w", err)
+				}
+				md.certs = append(md.certs, cert)
+			}
+		}
+		for _, sso := range desc.SingleSignOnServices {
+			if sso.Binding == bindingHTTPRedirect && md.ssoURL == "" {
+				md.ssoURL = sso.Location
+			}
+		}
+	}
+	if md.entityID == "" {
+		return nil, errors.New("metadata is missing the identity provider entity id")
+	}
+	if md.ssoURL == "" {
+		return nil, errors.New("identity provider doesn't support the HTTP-Redirect binding")
+	}
+	if len(md.certs) == 0 {
+		return nil, errors.New("identity provider has no signing certificate")
+	}
+	return md, nil
+}
+
+type spMetadata struct {
+	XMLName         xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string   `xml:"entityID,attr"`
+	SPSSODescriptor struct {
+		AuthnRequestsSigned        bool   `xml:"AuthnRequestsSigned,attr"`
+		WantAssertionsSigned       bool   `xml:"WantAssertionsSigned,attr"`
+		ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+		AssertionConsumerService   struct {
+			Binding   string `xml:"Binding,attr"`
+			Location  string `xml:"Location,attr"`
+			Index     int    `xml:"index,attr"`
+			IsDefault bool   `xml:"isDefault,attr"`
+		}
+	}
+}
+
+// Metadata returns the metadata of Pomerium as a service provider, which is
+// given to the identity provider.
+func (p *Provider) Metadata() ([]byte, error) {
+	var md spMetadata
+	md.EntityID = p.entityID
+	md.SPSSODescriptor.WantAssertionsSigned = true
+	md.SPSSODescriptor.ProtocolSupportEnumeration = protocolSAML2
+	md.SPSSODescriptor.AssertionConsumerService.Binding = bindingHTTPPost
+	md.SPSSODescriptor.AssertionConsumerService.Location = p.acsURL
+	md.SPSSODescriptor.AssertionConsumerService.IsDefault = true
+	data, err := xml.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
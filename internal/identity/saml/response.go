@@ -0,0 +1,283 @@
+package saml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+const (
+	nsAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+
+	statusSuccess        = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	confirmationBearer   = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	nameIDFormatEmail    = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	assertionVersionSAML = "2.0"
+)
+
+// An assertion is a verified assertion about a user signing in.
+type assertion struct {
+	id           string
+	nameID       string
+	nameIDFormat string
+	// expiry is when the assertion can no longer be used to sign in.
+	expiry time.Time
+	// sessionExpiry is when the identity provider wants the user's session to
+	// end, if it does.
+	sessionExpiry time.Time
+	// attributes are the values of the attributes by name, and by friendly
+	// name when they have one.
+	attributes map[string][]string
+}
+
+// parseResponse parses and verifies a SAML response, and returns its
+// assertion. Either the response or the assertion must be signed by the
+// identity provider. Encrypted assertions aren't supported.
+func (p *Provider) parseResponse(data []byte, now time.Time) (*assertion, error) {
+	root, err := parseXML(data)
+	if err != nil {
+		return nil, err
+	}
+	if !isElement(root, nsProtocol, "Response") {
+		return nil, fmt.Errorf("unexpected element %s", root.Tag)
+	}
+
+	// the assertion is signed, or is part of a signed response. Only the
+	// signed elements are used from here on.
+	signed, err := verifySignature(root, p.idp.certs)
+	responseSigned := err == nil
+	if responseSigned {
+		root = signed
+	} else if err != errNoSignature {
+		return nil, err
+	}
+
+	if err := checkStatus(root); err != nil {
+		return nil, err
+	}
+	if destination, ok := attr(root, "Destination"); ok && destination != p.acsURL {
+		return nil, fmt.Errorf("response destination %s doesn't match %s", destination, p.acsURL)
+	}
+	if issuer := element(root, nsAssertion, "Issuer"); issuer != nil && text(issuer) != p.idp.entityID {
+		return nil, fmt.Errorf("response issuer %s doesn't match %s", text(issuer), p.idp.entityID)
+	}
+	if len(elements(root, nsAssertion, "EncryptedAssertion")) > 0 {
+		return nil, errors.New("encrypted assertions are not supported")
+	}
+	a := element(root, nsAssertion, "Assertion")
+	if a == nil {
+		return nil, errors.New("response must have a single assertion")
+	}
+
+	signed, err = verifySignature(a, p.idp.certs)
+	if err == nil {
+		a = signed
+	} else if err == errNoSignature && !responseSigned {
+		return nil, errors.New("neither the response nor the assertion is signed")
+	} else if err != errNoSignature {
+		return nil, err
+	}
+
+	return p.parseAssertion(a, now)
+}
+
+func checkStatus(response *etree.Element) error {
+	status := element(response, nsProtocol, "Status")
+	if status == nil {
+		return errors.New("response is missing status")
+	}
+	code := element(status, nsProtocol, "StatusCode")
+	if code == nil {
+		return errors.New("response is missing status code")
+	}
+	if value, _ := attr(code, "Value"); value != statusSuccess {
+		if sub := element(code, nsProtocol, "StatusCode"); sub != nil {
+			value, _ = attr(sub, "Value")
+		}
+		if msg := element(status, nsProtocol, "StatusMessage"); msg != nil {
+			return fmt.Errorf("identity provider returned %s: %s", value, text(msg))
+		}
+		return fmt.Errorf("identity provider returned %s", value)
+	}
+	return nil
+}
+
+func (p *Provider) parseAssertion(e *etree.Element, now time.Time) (*assertion, error) {
+	a := &assertion{attributes: make(map[string][]string)}
+	a.id, _ = attr(e, "ID")
+	if a.id == "" {
+		return nil, errors.New("assertion is missing id")
+	}
+	if version, _ := attr(e, "Version"); version != assertionVersionSAML {
+		return nil, fmt.Errorf("unsupported assertion version %s", version)
+	}
+	issuer := element(e, nsAssertion, "Issuer")
+	if issuer == nil || text(issuer) != p.idp.entityID {
+		return nil, errors.New("assertion issuer doesn't match the identity provider")
+	}
+
+	subject := element(e, nsAssertion, "Subject")
+	if subject == nil {
+		return nil, errors.New("assertion is missing subject")
+	}
+	nameID := element(subject, nsAssertion, "NameID")
+	if nameID == nil || text(nameID) == "" {
+		return nil, errors.New("assertion is missing name id")
+	}
+	a.nameID = text(nameID)
+	a.nameIDFormat, _ = attr(nameID, "Format")
+	expiry, err := p.checkSubjectConfirmation(subject, now)
+	if err != nil {
+		return nil, err
+	}
+	a.expiry = expiry
+
+	conditions := element(e, nsAssertion, "Conditions")
+	if conditions == nil {
+		return nil, errors.New("assertion is missing conditions")
+	}
+	expiry, err = p.checkConditions(conditions, now)
+	if err != nil {
+		return nil, err
+	}
+	if !expiry.IsZero() && expiry.Before(a.expiry) {
+		a.expiry = expiry
+	}
+
+	for _, stmt := range elements(e, nsAssertion, "AuthnStatement") {
+		if v, ok := attr(stmt, "SessionNotOnOrAfter"); ok {
+			tm, err := parseTime(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid session expiry: %w", err)
+			}
+			a.sessionExpiry = tm
+		}
+	}
+	for _, stmt := range elements(e, nsAssertion, "AttributeStatement") {
+		for _, attribute := range elements(stmt, nsAssertion, "Attribute") {
+			var values []string
+			for _, value := range elements(attribute, nsAssertion, "AttributeValue") {
+				values = append(values, text(value))
+			}
+			if name, _ := attr(attribute, "Name"); name != "" {
+				a.attributes[name] = append(a.attributes[name], values...)
+			}
+			if name, _ := attr(attribute, "FriendlyName"); name != "" {
+				a.attributes[name] = append(a.attributes[name], values...)
+			}
+		}
+	}
+	return a, nil
+}
+
+// checkSubjectConfirmation checks that the subject has a bearer confirmation
+// for this service provider, and returns when it expires.
+func (p *Provider) checkSubjectConfirmation(subject *etree.Element, now time.Time) (time.Time, error) {
+	for _, confirmation := range elements(subject, nsAssertion, "SubjectConfirmation") {
+		if method, _ := attr(confirmation, "Method"); method != confirmationBearer {
+			continue
+		}
+		data := element(confirmation, nsAssertion, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if recipient, _ := attr(data, "Recipient"); recipient != p.acsURL {
+			continue
+		}
+		v, _ := attr(data, "NotOnOrAfter")
+		expiry, err := parseTime(v)
+		if err != nil || !now.Add(-p.clockSkew).Before(expiry) {
+			continue
+		}
+		return expiry, nil
+	}
+	return time.Time{}, errors.New("assertion has no valid bearer subject confirmation")
+}
+
+// checkConditions checks the validity period and audience of the assertion,
+// and returns when it expires, if it does.
+func (p *Provider) checkConditions(conditions *etree.Element, now time.Time) (time.Time, error) {
+	if v, ok := attr(conditions, "NotBefore"); ok {
+		tm, err := parseTime(v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid not before time: %w", err)
+		}
+		if now.Add(p.clockSkew).Before(tm) {
+			return time.Time{}, errors.New("assertion is not valid yet")
+		}
+	}
+	var expiry time.Time
+	if v, ok := attr(conditions, "NotOnOrAfter"); ok {
+		tm, err := parseTime(v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid not on or after time: %w", err)
+		}
+		if !now.Add(-p.clockSkew).Before(tm) {
+			return time.Time{}, errors.New("assertion is expired")
+		}
+		expiry = tm
+	}
+	for _, restriction := range elements(conditions, nsAssertion, "AudienceRestriction") {
+		found := false
+		for _, audience := range elements(restriction, nsAssertion, "Audience") {
+			if text(audience) == p.entityID {
+				found = true
+			}
+		}
+		if !found {
+			return time.Time{}, fmt.Errorf("assertion audience doesn't include %s", p.entityID)
+		}
+	}
+	return expiry, nil
+}
+
+func parseTime(v string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, v)
+}
+
+// A ReplayCache records the assertions which have been used to sign in until
+// they expire, so they can only be used once.
+type ReplayCache interface {
+	// Add adds the assertion with the id from the issuer. It returns false if
+	// the assertion has already been added.
+	Add(ctx context.Context, issuer, id string, expiry time.Time) (bool, error)
+}
+
+// A replayCache is a ReplayCache in memory, which is used when the provider
+// isn't given a shared one.
+type replayCache struct {
+	mu  sync.Mutex
+	ids map[string]time.Time
+}
+
+func newReplayCache() *replayCache {
+	return &replayCache{ids: make(map[string]time.Time)}
+}
+
+// Add implements ReplayCache.
+func (c *replayCache) Add(ctx context.Context, issuer, id string, expiry time.Time) (bool, error) {
+	return c.add(issuer+"\x00"+id, expiry, timeNow()), nil
+}
+
+// add adds an assertion to the cache. It returns false if the assertion is
+// already in it.
+func (c *replayCache) add(id string, expiry, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, tm := range c.ids {
+		if now.After(tm) {
+			delete(c.ids, id)
+		}
+	}
+	if _, ok := c.ids[id]; ok {
+		return false
+	}
+	c.ids[id] = expiry
+	return true
+}
@@ -0,0 +1,287 @@
+// Package saml implements a SAML 2.0 service provider, so users can sign in
+// with identity providers which only support SAML.
+//
+// Users are sent to the identity provider with the HTTP-Redirect binding, and
+// it posts its response to the authenticate service's callback with the
+// HTTP-POST binding.
+//
+// http://docs.oasis-open.org/security/saml/v2.0/saml-bindings-2.0-os.pdf
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+	"github.com/pomerium/pomerium/internal/identity/oidc"
+)
+
+// Name identifies the SAML identity provider.
+const Name = "saml"
+
+// defaultClockSkew is the clock skew tolerated when validating assertions if
+// none is configured.
+const defaultClockSkew = 3 * time.Minute
+
+// claimsKey is the key of the user's claims in the tokens returned by
+// Authenticate.
+const claimsKey = "saml_claims"
+
+var timeNow = time.Now
+
+// defaultAttributes are the attributes claims are read from by default, by
+// claim. Attributes match by name or friendly name, and the first attribute
+// the assertion has is used.
+var defaultAttributes = map[string][]string{
+	"email": {
+		"email", "mail", "emailAddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+	},
+	"name": {
+		"name", "displayName",
+		"urn:oid:2.16.840.1.113730.3.1.241",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+	},
+	"given_name": {
+		"givenName", "firstName",
+		"urn:oid:2.5.4.42",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+	},
+	"family_name": {
+		"sn", "surname", "lastName",
+		"urn:oid:2.5.4.4",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+	},
+	"groups": {
+		"groups", "memberOf", "isMemberOf",
+		"urn:oid:1.3.6.1.4.1.5923.1.5.1.1",
+		"http://schemas.microsoft.com/ws/2008/06/identity/claims/groups",
+		"http://schemas.xmlsoap.org/claims/Group",
+	},
+}
+
+// errRefreshNotSupported is returned when refreshing a session, since SAML
+// has no way to do it without the user.
+var errRefreshNotSupported = errors.New("identity/saml: sessions can't be refreshed")
+
+// Provider is a SAML 2.0 service provider.
+type Provider struct {
+	// entityID is the entity id of Pomerium as a service provider.
+	entityID string
+	// acsURL is the URL of the assertion consumer service, the callback the
+	// identity provider posts its responses to.
+	acsURL     string
+	idp        *idpMetadata
+	attributes map[string][]string
+	clockSkew  time.Duration
+	replays    ReplayCache
+}
+
+// New creates a new SAML service provider. The provider URL is the URL of the
+// identity provider's metadata, and the client id is the entity id of
+// Pomerium.
+func New(ctx context.Context, o *oauth.Options) (*Provider, error) {
+	if o.ProviderURL == "" {
+		return nil, oidc.ErrMissingProviderURL
+	}
+	if o.ClientID == "" {
+		return nil, errors.New("identity/saml: missing service provider entity id")
+	}
+	idp, err := fetchIDPMetadata(ctx, o.ProviderURL)
+	if err != nil {
+		return nil, fmt.Errorf("identity/saml: could not get identity provider metadata: %w", err)
+	}
+	return newProvider(o, idp), nil
+}
+
+func newProvider(o *oauth.Options, idp *idpMetadata) *Provider {
+	p := &Provider{
+		entityID:   o.ClientID,
+		acsURL:     o.RedirectURL.String(),
+		idp:        idp,
+		attributes: make(map[string][]string, len(defaultAttributes)),
+		clockSkew:  o.ClockSkew,
+		replays:    newReplayCache(),
+	}
+	if p.clockSkew == 0 {
+		p.clockSkew = defaultClockSkew
+	}
+	for claim, attributes := range defaultAttributes {
+		p.attributes[claim] = attributes
+	}
+	for claim, attribute := range o.SAMLAttributes {
+		p.attributes[claim] = []string{attribute}
+	}
+	return p
+}
+
+// SetReplayCache sets the cache which records the assertions which have been
+// used to sign in. By default they're only recorded in memory, so an
+// assertion could be used once with each replica of the authenticate service.
+func (p *Provider) SetReplayCache(c ReplayCache) {
+	p.replays = c
+}
+
+type authnRequest struct {
+	XMLName                     xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID                          string   `xml:"ID,attr"`
+	Version                     string   `xml:"Version,attr"`
+	IssueInstant                string   `xml:"IssueInstant,attr"`
+	Destination                 string   `xml:"Destination,attr"`
+	AssertionConsumerServiceURL string   `xml:"AssertionConsumerServiceURL,attr"`
+	ProtocolBinding             string   `xml:"ProtocolBinding,attr"`
+	Issuer                      struct {
+		Value string `xml:",chardata"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameIDPolicy struct {
+		AllowCreate bool `xml:"AllowCreate,attr"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+}
+
+// GetSignInURL returns the URL of the identity provider's single sign on
+// service, with an authentication request. The state is sent as the relay
+// state, which the identity provider posts back with its response.
+func (p *Provider) GetSignInURL(state string) string {
+	req := authnRequest{
+		ID:                          "id-" + uuid.New().String(),
+		Version:                     assertionVersionSAML,
+		IssueInstant:                timeNow().UTC().Format(time.RFC3339),
+		Destination:                 p.idp.ssoURL,
+		AssertionConsumerServiceURL: p.acsURL,
+		ProtocolBinding:             bindingHTTPPost,
+	}
+	req.Issuer.Value = p.entityID
+	req.NameIDPolicy.AllowCreate = true
+	data, _ := xml.Marshal(req)
+
+	var buf bytes.Buffer
+	fw, _ := flate.NewWriter(&buf, flate.BestCompression)
+	_, _ = fw.Write(data)
+	_ = fw.Close()
+
+	// the metadata was validated, so the URL is valid
+	u, _ := url.Parse(p.idp.ssoURL)
+	q := u.Query()
+	q.Set("SAMLRequest", base64.StdEncoding.EncodeToString(buf.Bytes()))
+	q.Set("RelayState", state)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// Authenticate verifies the SAML response posted by the identity provider,
+// which is the code, and hydrates v with the claims of its assertion.
+//
+// The returned token can't be used with the identity provider. It expires
+// when the identity provider wants the user's session to end, if it does.
+func (p *Provider) Authenticate(ctx context.Context, code string, v interface{}) (*oauth2.Token, error) {
+	data, err := decodeBase64(code)
+	if err != nil {
+		return nil, fmt.Errorf("identity/saml: invalid response: %w", err)
+	}
+	now := timeNow()
+	a, err := p.parseResponse(data, now)
+	if err != nil {
+		return nil, fmt.Errorf("identity/saml: invalid response: %w", err)
+	}
+	ok, err := p.replays.Add(ctx, p.idp.entityID, a.id, a.expiry.Add(p.clockSkew))
+	if err != nil {
+		return nil, fmt.Errorf("identity/saml: could not record assertion: %w", err)
+	} else if !ok {
+		return nil, errors.New("identity/saml: assertion has already been used")
+	}
+
+	claims := p.claims(a)
+	if err := setClaims(claims, v); err != nil {
+		return nil, err
+	}
+	token := &oauth2.Token{
+		AccessToken: a.id,
+		TokenType:   "saml",
+		Expiry:      a.sessionExpiry,
+	}
+	return token.WithExtra(map[string]interface{}{claimsKey: claims}), nil
+}
+
+// claims returns the claims of the user of an assertion.
+func (p *Provider) claims(a *assertion) map[string]interface{} {
+	claims := map[string]interface{}{
+		"iss": p.idp.entityID,
+		"sub": a.nameID,
+	}
+	for claim, attributes := range p.attributes {
+		for _, attribute := range attributes {
+			values, ok := a.attributes[attribute]
+			if !ok {
+				continue
+			}
+			if len(values) == 1 && claim != "groups" {
+				claims[claim] = values[0]
+			} else {
+				claims[claim] = values
+			}
+			break
+		}
+	}
+	if _, ok := claims["email"]; !ok && a.nameIDFormat == nameIDFormatEmail {
+		claims["email"] = a.nameID
+	}
+	return claims
+}
+
+func setClaims(claims map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("identity/saml: couldn't unmarshal claims: %w", err)
+	}
+	return nil
+}
+
+// UpdateUserInfo hydrates v with the claims of the assertion a token was
+// returned for. SAML has no user info endpoint, so nothing is done for the
+// tokens of existing sessions.
+func (p *Provider) UpdateUserInfo(ctx context.Context, t *oauth2.Token, v interface{}) error {
+	if t == nil {
+		return nil
+	}
+	claims, ok := t.Extra(claimsKey).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return setClaims(claims, v)
+}
+
+// Refresh returns an error, since sessions can only be renewed by signing in
+// again.
+func (p *Provider) Refresh(ctx context.Context, t *oauth2.Token, v interface{}) (*oauth2.Token, error) {
+	return nil, errRefreshNotSupported
+}
+
+// Revoke is not implemented by SAML.
+func (p *Provider) Revoke(ctx context.Context, t *oauth2.Token) error {
+	return oidc.ErrRevokeNotImplemented
+}
+
+// LogOut is not implemented by SAML.
+func (p *Provider) LogOut() (*url.URL, error) {
+	return nil, oidc.ErrSignoutNotImplemented
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return Name
+}
@@ -0,0 +1,425 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/identity/oauth"
+)
+
+var testNow = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+const (
+	testIDPEntityID = "https://idp.example.com/metadata"
+	testSSOURL      = "https://idp.example.com/sso?tenant=1"
+	testSPEntityID  = "https://authenticate.example.com"
+	testACSURL      = "https://authenticate.example.com/oauth2/callback"
+)
+
+// testResponseTemplate is a response with an assertion. Signatures replace
+// the <!--sig:ID--> comments.
+var testResponseTemplate = template.Must(template.New("response").Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_response" Version="2.0" IssueInstant="2020-10-01T12:00:00Z" Destination="{{.Destination}}">
+  <saml:Issuer>{{.Issuer}}</saml:Issuer><!--sig:_response-->
+  <samlp:Status><samlp:StatusCode Value="{{.Status}}"/></samlp:Status>
+  <saml:Assertion xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" ID="_assertion" Version="2.0" IssueInstant="2020-10-01T12:00:00Z">
+    <saml:Issuer>{{.Issuer}}</saml:Issuer><!--sig:_assertion-->
+    <saml:Subject>
+      <saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">user@example.com</saml:NameID>
+      <saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+        <saml:SubjectConfirmationData NotOnOrAfter="2020-10-01T12:05:00Z" Recipient="{{.Recipient}}"/>
+      </saml:SubjectConfirmation>
+    </saml:Subject>
+    <saml:Conditions NotBefore="{{.NotBefore}}" NotOnOrAfter="{{.NotOnOrAfter}}">
+      <saml:AudienceRestriction><saml:Audience>{{.Audience}}</saml:Audience></saml:AudienceRestriction>
+    </saml:Conditions>
+    <saml:AuthnStatement AuthnInstant="2020-10-01T12:00:00Z" SessionNotOnOrAfter="2020-10-01T20:00:00Z">
+      <saml:AuthnContext><saml:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:Password</saml:AuthnContextClassRef></saml:AuthnContext>
+    </saml:AuthnStatement>
+    <saml:AttributeStatement>
+      <saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"><saml:AttributeValue xsi:type="xs:string">Test User</saml:AttributeValue></saml:Attribute>
+      <saml:Attribute Name="urn:oid:2.5.4.42" FriendlyName="givenName"><saml:AttributeValue xsi:type="xs:string">Test</saml:AttributeValue></saml:Attribute>
+      <saml:Attribute Name="department"><saml:AttributeValue xsi:type="xs:string">engineering</saml:AttributeValue></saml:Attribute>
+      <saml:Attribute Name="groups"><saml:AttributeValue xsi:type="xs:string">admins</saml:AttributeValue></saml:Attribute>
+    </saml:AttributeStatement>
+  </saml:Assertion>
+</samlp:Response>
+`))
+
+type testResponse struct {
+	Destination, Issuer, Status, Recipient, NotBefore, NotOnOrAfter, Audience string
+}
+
+func newTestResponse() *testResponse {
+	return &testResponse{
+		Destination:  testACSURL,
+		Issuer:       testIDPEntityID,
+		Status:       statusSuccess,
+		Recipient:    testACSURL,
+		NotBefore:    "2020-10-01T11:59:00Z",
+		NotOnOrAfter: "2020-10-01T12:05:00Z",
+		Audience:     testSPEntityID,
+	}
+}
+
+type testIDP struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func newTestIDP(t *testing.T) *testIDP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    testNow.Add(-time.Hour),
+		NotAfter:     testNow.Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testIDP{key: key, cert: cert}
+}
+
+func (idp *testIDP) metadata() *idpMetadata {
+	return &idpMetadata{
+		entityID: testIDPEntityID,
+		ssoURL:   testSSOURL,
+		certs:    []*x509.Certificate{idp.cert},
+	}
+}
+
+// response renders a response, signing the elements with the ids.
+func (idp *testIDP) response(t *testing.T, r *testResponse, ids ...string) string {
+	var buf bytes.Buffer
+	require.NoError(t, testResponseTemplate.Execute(&buf, r))
+	doc := buf.String()
+	for _, id := range ids {
+		doc = idp.sign(t, doc, id)
+	}
+	return doc
+}
+
+// sign signs the element with an id, the way identity providers do.
+func (idp *testIDP) sign(t *testing.T, doc, id string) string {
+	root, err := parseXML([]byte(doc))
+	require.NoError(t, err)
+	e := root.FindElement("//[@ID='" + id + "']")
+	require.NotNil(t, e)
+	nsCtx, err := etreeutils.NSBuildParentContext(e)
+	require.NoError(t, err)
+	e, err = etreeutils.NSDetatch(nsCtx, e)
+	require.NoError(t, err)
+
+	ctx, err := dsig.NewSigningContext(idp.key, [][]byte{idp.cert.Raw})
+	require.NoError(t, err)
+	sig, err := ctx.ConstructSignature(e, true)
+	require.NoError(t, err)
+
+	out := etree.NewDocument()
+	out.SetRoot(sig)
+	signature, err := out.WriteToString()
+	require.NoError(t, err)
+	return strings.Replace(doc, "<!--sig:"+id+"-->", signature, 1)
+}
+
+func newTestProvider(t *testing.T, idp *testIDP, attributes map[string]string) *Provider {
+	redirectURL, err := url.Parse(testACSURL)
+	require.NoError(t, err)
+	return newProvider(&oauth.Options{
+		ClientID:       testSPEntityID,
+		RedirectURL:    redirectURL,
+		SAMLAttributes: attributes,
+	}, idp.metadata())
+}
+
+func encode(doc string) string {
+	return base64.StdEncoding.EncodeToString([]byte(doc))
+}
+
+func TestProvider_Authenticate(t *testing.T) {
+	timeNow = func() time.Time { return testNow }
+	defer func() { timeNow = time.Now }()
+
+	idp := newTestIDP(t)
+	p := newTestProvider(t, idp, nil)
+
+	var claims map[string]interface{}
+	token, err := p.Authenticate(context.Background(), encode(idp.response(t, newTestResponse(), "_assertion")), &claims)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"iss":        testIDPEntityID,
+		"sub":        "user@example.com",
+		"email":      "user@example.com",
+		"name":       "Test User",
+		"given_name": "Test",
+		"groups":     []interface{}{"admins"},
+	}, claims)
+	assert.Equal(t, "_assertion", token.AccessToken)
+	assert.Equal(t, time.Date(2020, 10, 1, 20, 0, 0, 0, time.UTC), token.Expiry)
+
+	claims = nil
+	require.NoError(t, p.UpdateUserInfo(context.Background(), token, &claims))
+	assert.Equal(t, "user@example.com", claims["email"])
+
+	_, err = p.Authenticate(context.Background(), encode(idp.response(t, newTestResponse(), "_assertion")), &claims)
+	assert.Error(t, err, "assertions should only be used once")
+
+	t.Run("signed response", func(t *testing.T) {
+		p := newTestProvider(t, idp, nil)
+		_, err := p.Authenticate(context.Background(), encode(idp.response(t, newTestResponse(), "_response")), &claims)
+		assert.NoError(t, err)
+	})
+	t.Run("signed response and assertion", func(t *testing.T) {
+		p := newTestProvider(t, idp, nil)
+		_, err := p.Authenticate(context.Background(), encode(idp.response(t, newTestResponse(), "_assertion", "_response")), &claims)
+		assert.NoError(t, err)
+	})
+	t.Run("attributes", func(t *testing.T) {
+		p := newTestProvider(t, idp, map[string]string{"name": "department", "groups": "department"})
+		var claims map[string]interface{}
+		_, err := p.Authenticate(context.Background(), encode(idp.response(t, newTestResponse(), "_assertion")), &claims)
+		require.NoError(t, err)
+		assert.Equal(t, "engineering", claims["name"])
+		assert.Equal(t, []interface{}{"engineering"}, claims["groups"])
+	})
+}
+
+func TestProvider_Authenticate_Invalid(t *testing.T) {
+	timeNow = func() time.Time { return testNow }
+	defer func() { timeNow = time.Now }()
+
+	idp := newTestIDP(t)
+	otherIDP := newTestIDP(t)
+
+	tests := []struct {
+		name string
+		doc  func() string
+	}{
+		{"not base64", func() string { return "%%%" }},
+		{"unsigned", func() string { return encode(idp.response(t, newTestResponse())) }},
+		{"signed by another identity provider", func() string {
+			return encode(otherIDP.response(t, newTestResponse(), "_assertion"))
+		}},
+		{"tampered", func() string {
+			doc := idp.response(t, newTestResponse(), "_assertion")
+			return encode(strings.Replace(doc, ">admins<", ">root<", 1))
+		}},
+		{"tampered unsigned assertion", func() string {
+			doc := idp.response(t, newTestResponse(), "_response")
+			return encode(strings.Replace(doc, ">admins<", ">root<", 1))
+		}},
+		{"comment injection", func() string {
+			doc := idp.response(t, newTestResponse(), "_assertion")
+			return encode(strings.Replace(doc, "user@example.com</saml:NameID>", "user@example.com<!---->.evil.com</saml:NameID>", 1))
+		}},
+		{"wrapped assertion", func() string {
+			doc := idp.response(t, newTestResponse(), "_assertion")
+			signed := doc[strings.Index(doc, "<saml:Assertion "):strings.Index(doc, "</samlp:Response>")]
+			evil := strings.Replace(strings.Replace(signed, ">admins<", ">root<", 1), `ID="_assertion"`, `ID="_evil"`, 1)
+			return encode(strings.Replace(doc, signed, evil+"<samlp:Extensions>"+signed+"</samlp:Extensions>", 1))
+		}},
+		{"wrapped assertion with the same id", func() string {
+			doc := idp.response(t, newTestResponse(), "_assertion")
+			signed := doc[strings.Index(doc, "<saml:Assertion "):strings.Index(doc, "</samlp:Response>")]
+			evil := strings.Replace(signed, ">admins<", ">root<", 1)
+			return encode(strings.Replace(doc, signed, evil+"<samlp:Extensions>"+signed+"</samlp:Extensions>", 1))
+		}},
+		{"two assertions", func() string {
+			doc := idp.response(t, newTestResponse(), "_assertion")
+			signed := doc[strings.Index(doc, "<saml:Assertion "):strings.Index(doc, "</samlp:Response>")]
+			return encode(strings.Replace(doc, signed, signed+strings.Replace(signed, `ID="_assertion"`, `ID="_other"`, 1), 1))
+		}},
+		{"failed status", func() string {
+			r := newTestResponse()
+			r.Status = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+		{"wrong destination", func() string {
+			r := newTestResponse()
+			r.Destination = "https://evil.example.com/oauth2/callback"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+		{"wrong issuer", func() string {
+			r := newTestResponse()
+			r.Issuer = "https://evil.example.com"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+		{"wrong recipient", func() string {
+			r := newTestResponse()
+			r.Recipient = "https://evil.example.com/oauth2/callback"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+		{"wrong audience", func() string {
+			r := newTestResponse()
+			r.Audience = "https://evil.example.com"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+		{"expired", func() string {
+			r := newTestResponse()
+			r.NotOnOrAfter = "2020-10-01T11:50:00Z"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+		{"not valid yet", func() string {
+			r := newTestResponse()
+			r.NotBefore = "2020-10-01T12:10:00Z"
+			return encode(idp.response(t, r, "_assertion"))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProvider(t, idp, nil)
+			var claims map[string]interface{}
+			_, err := p.Authenticate(context.Background(), tt.doc(), &claims)
+			assert.Error(t, err)
+			assert.Nil(t, claims)
+		})
+	}
+
+	t.Run("within clock skew", func(t *testing.T) {
+		p := newTestProvider(t, idp, nil)
+		r := newTestResponse()
+		r.NotOnOrAfter = "2020-10-01T11:59:00Z"
+		r.NotBefore = "2020-10-01T12:01:00Z"
+		var claims map[string]interface{}
+		_, err := p.Authenticate(context.Background(), encode(idp.response(t, r, "_assertion")), &claims)
+		assert.NoError(t, err)
+	})
+}
+
+func TestProvider_GetSignInURL(t *testing.T) {
+	timeNow = func() time.Time { return testNow }
+	defer func() { timeNow = time.Now }()
+
+	p := newTestProvider(t, newTestIDP(t), nil)
+	u, err := url.Parse(p.GetSignInURL("STATE"))
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.com", u.Host)
+	assert.Equal(t, "1", u.Query().Get("tenant"))
+	assert.Equal(t, "STATE", u.Query().Get("RelayState"))
+
+	data, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	require.NoError(t, err)
+	data, err = ioutil.ReadAll(flate.NewReader(bytes.NewReader(data)))
+	require.NoError(t, err)
+	var req authnRequest
+	require.NoError(t, xml.Unmarshal(data, &req))
+	assert.Equal(t, testSSOURL, req.Destination)
+	assert.Equal(t, testACSURL, req.AssertionConsumerServiceURL)
+	assert.Equal(t, bindingHTTPPost, req.ProtocolBinding)
+	assert.Equal(t, testSPEntityID, req.Issuer.Value)
+	assert.Equal(t, "2020-10-01T12:00:00Z", req.IssueInstant)
+}
+
+func TestParseIDPMetadata(t *testing.T) {
+	idp := newTestIDP(t)
+	cert := base64.StdEncoding.EncodeToString(idp.cert.Raw)
+	descriptor := func(entityID, use, binding string) string {
+		return `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="` + entityID + `">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="` + use + `"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+` + cert + `
+    </ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/post"/>
+    <md:SingleSignOnService Binding="` + binding + `" Location="` + testSSOURL + `"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+	}
+
+	md, err := parseIDPMetadata([]byte(descriptor(testIDPEntityID, "signing", bindingHTTPRedirect)))
+	require.NoError(t, err)
+	assert.Equal(t, idp.metadata(), md)
+
+	md, err = parseIDPMetadata([]byte(`<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+		`<md:EntityDescriptor entityID="https://sp.example.com"><md:SPSSODescriptor/></md:EntityDescriptor>` +
+		descriptor(testIDPEntityID, "", bindingHTTPRedirect) +
+		`</md:EntitiesDescriptor>`))
+	require.NoError(t, err)
+	assert.Equal(t, idp.metadata(), md)
+
+	for name, data := range map[string]string{
+		"not xml":              "{}",
+		"not metadata":         `<EntityDescriptor entityID="x"/>`,
+		"no entity id":         descriptor("", "signing", bindingHTTPRedirect),
+		"no signing key":       descriptor(testIDPEntityID, "encryption", bindingHTTPRedirect),
+		"no redirect binding":  descriptor(testIDPEntityID, "signing", bindingHTTPPost),
+		"no identity provider": `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="x"/>`,
+		"two identity providers": `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+			descriptor("a", "signing", bindingHTTPRedirect) + descriptor("b", "signing", bindingHTTPRedirect) +
+			`</md:EntitiesDescriptor>`,
+	} {
+		_, err := parseIDPMetadata([]byte(data))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestProvider_Metadata(t *testing.T) {
+	p := newTestProvider(t, newTestIDP(t), nil)
+	data, err := p.Metadata()
+	require.NoError(t, err)
+
+	var md spMetadata
+	require.NoError(t, xml.Unmarshal(data, &md))
+	assert.Equal(t, testSPEntityID, md.EntityID)
+	assert.True(t, md.SPSSODescriptor.WantAssertionsSigned)
+	assert.Equal(t, bindingHTTPPost, md.SPSSODescriptor.AssertionConsumerService.Binding)
+	assert.Equal(t, testACSURL, md.SPSSODescriptor.AssertionConsumerService.Location)
+}
+
+func TestReplayCache(t *testing.T) {
+	c := newReplayCache()
+	assert.True(t, c.add("a", testNow.Add(time.Minute), testNow))
+	assert.False(t, c.add("a", testNow.Add(time.Minute), testNow))
+	assert.True(t, c.add("b", testNow.Add(time.Minute), testNow))
+	assert.True(t, c.add("a", testNow.Add(3*time.Minute), testNow.Add(2*time.Minute)), "expired ids should be forgotten")
+	assert.Len(t, c.ids, 1)
+
+	ok, err := c.Add(context.Background(), "issuer", "c", timeNow().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = c.Add(context.Background(), "other issuer", "c", timeNow().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, ok, "assertion ids should be scoped to their issuer")
+}
+
+type errReplayCache struct{}
+
+func (errReplayCache) Add(ctx context.Context, issuer, id string, expiry time.Time) (bool, error) {
+	return false, errors.New("unavailable")
+}
+
+func TestProvider_SetReplayCache(t *testing.T) {
+	timeNow = func() time.Time { return testNow }
+	defer func() { timeNow = time.Now }()
+
+	idp := newTestIDP(t)
+	p := newTestProvider(t, idp, nil)
+	p.SetReplayCache(errReplayCache{})
+	var claims map[string]interface{}
+	_, err := p.Authenticate(context.Background(), encode(idp.response(t, newTestResponse(), "_assertion")), &claims)
+	assert.Error(t, err, "assertions should be rejected if they can't be recorded")
+	assert.Nil(t, claims)
+}
@@ -0,0 +1,58 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+// errNoSignature is returned when an element isn't signed.
+var errNoSignature = errors.New("element is not signed")
+
+// verifySignature verifies the enveloped signature of an element with one of
+// the certificates, and returns the signed element. Only the returned element
+// may be trusted, since the one passed in may contain content which isn't
+// covered by the signature.
+//
+// The certificates come from the identity provider's metadata, which makes
+// them trusted keys rather than a PKI, so their validity period is ignored.
+func verifySignature(e *etree.Element, certs []*x509.Certificate) (*etree.Element, error) {
+	// keep the namespace declarations of the ancestors, which the element
+	// may use
+	nsCtx, err := etreeutils.NSBuildParentContext(e)
+	if err != nil {
+		return nil, err
+	}
+	e, err = etreeutils.NSDetatch(nsCtx, e)
+	if err != nil {
+		return nil, err
+	}
+
+	err = errors.New("invalid signature")
+	for _, cert := range certs {
+		ctx := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+			Roots: []*x509.Certificate{cert},
+		})
+		ctx.IdAttribute = "ID"
+		ctx.Clock = dsig.NewFakeClockAt(cert.NotBefore)
+
+		var signed *etree.Element
+		signed, err = ctx.Validate(e)
+		if errors.Is(err, dsig.ErrMissingSignature) {
+			return nil, errNoSignature
+		} else if err == nil {
+			return signed, nil
+		}
+	}
+	return nil, err
+}
+
+// decodeBase64 decodes base64 data, which may be split over several lines.
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
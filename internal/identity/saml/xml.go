@@ -0,0 +1,141 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// parseXML parses an XML document and returns its document element. Documents
+// with a DTD, or which use undeclared namespace prefixes, are rejected.
+func parseXML(data []byte) (*etree.Element, error) {
+	// etree doesn't check that end elements match, so check that the document
+	// is well-formed first
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		_, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, err
+	}
+	var root *etree.Element
+	for _, tok := range doc.Child {
+		switch tok := tok.(type) {
+		case *etree.Directive:
+			return nil, errors.New("xml: directives are not supported")
+		case *etree.CharData:
+			if strings.TrimSpace(tok.Data) != "" {
+				return nil, errors.New("xml: text outside the document element")
+			}
+		case *etree.Element:
+			if root != nil {
+				return nil, errors.New("xml: more than one document element")
+			}
+			root = tok
+		}
+	}
+	if root == nil {
+		return nil, errors.New("xml: missing document element")
+	}
+	if err := checkNamespaces(root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// checkNamespaces checks that the prefixes used by an element and its
+// descendants are declared.
+func checkNamespaces(e *etree.Element) error {
+	declared := func(prefix string) bool {
+		return prefix == "" || prefix == "xml" || prefix == "xmlns" || findNamespace(e, prefix)
+	}
+	if !declared(e.Space) {
+		return fmt.Errorf("xml: undeclared namespace prefix %s", e.Space)
+	}
+	for _, a := range e.Attr {
+		if !declared(a.Space) {
+			return fmt.Errorf("xml: undeclared namespace prefix %s", a.Space)
+		}
+	}
+	for _, child := range e.ChildElements() {
+		if err := checkNamespaces(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findNamespace returns true if the prefix is declared by the element or one
+// of its ancestors.
+func findNamespace(e *etree.Element, prefix string) bool {
+	for ; e != nil; e = e.Parent() {
+		for _, a := range e.Attr {
+			if a.Space == "xmlns" && a.Key == prefix {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isElement returns true if the element has the given namespace and local
+// name.
+func isElement(e *etree.Element, ns, local string) bool {
+	return e.Tag == local && e.NamespaceURI() == ns
+}
+
+// attr returns the value of an attribute without a namespace.
+func attr(e *etree.Element, name string) (string, bool) {
+	for _, a := range e.Attr {
+		if a.Space == "" && a.Key == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// elements returns the child elements with the given name.
+func elements(e *etree.Element, ns, local string) []*etree.Element {
+	var elements []*etree.Element
+	for _, child := range e.ChildElements() {
+		if isElement(child, ns, local) {
+			elements = append(elements, child)
+		}
+	}
+	return elements
+}
+
+// element returns the only child element with the given name, or nil if
+// there are none or more than one.
+func element(e *etree.Element, ns, local string) *etree.Element {
+	elements := elements(e, ns, local)
+	if len(elements) != 1 {
+		return nil
+	}
+	return elements[0]
+}
+
+// text returns the text content of the element, without the text of its
+// child elements. Comments are skipped, like they are when the element is
+// canonicalized to verify its signature, so a comment can't truncate a value.
+func text(e *etree.Element) string {
+	var sb strings.Builder
+	for _, child := range e.Child {
+		if data, ok := child.(*etree.CharData); ok {
+			sb.WriteString(data.Data)
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
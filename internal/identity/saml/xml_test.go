@@ -0,0 +1,60 @@
+package saml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr bool
+	}{
+		{"valid", `<?xml version="1.0"?><a:root xmlns:a="urn:a"><a:x xml:lang="en"/></a:root>`, false},
+		{"doctype", `<!DOCTYPE root [<!ENTITY x "y">]><root>&x;</root>`, true},
+		{"undeclared prefix", `<a:root/>`, true},
+		{"undeclared child prefix", `<root xmlns:a="urn:a"><a:x/><b:y/></root>`, true},
+		{"undeclared attribute prefix", `<root a:x="1"/>`, true},
+		{"mismatched end element", `<root><x></y></root>`, true},
+		{"incomplete", `<root><x/>`, true},
+		{"empty", ``, true},
+		{"two document elements", `<root/><root/>`, true},
+		{"text outside the document element", `<root/>text`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseXML([]byte(tt.doc))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestXMLHelpers(t *testing.T) {
+	root, err := parseXML([]byte(`<a:root xmlns:a="urn:a" xmlns="urn:d">
+	<a:x ID="1" a:y="2">one<!-- comment -->two</a:x>
+	<x ID="2"/>
+	<a:y/><a:y/>
+</a:root>`))
+	require.NoError(t, err)
+
+	assert.True(t, isElement(root, "urn:a", "root"))
+	assert.False(t, isElement(root, "urn:d", "root"))
+	x := element(root, "urn:a", "x")
+	require.NotNil(t, x)
+	assert.Equal(t, "onetwo", text(x))
+	id, ok := attr(x, "ID")
+	assert.True(t, ok)
+	assert.Equal(t, "1", id)
+	_, ok = attr(x, "y")
+	assert.False(t, ok, "attr should only return attributes without a namespace")
+	assert.Len(t, elements(root, "urn:d", "x"), 1)
+	assert.Nil(t, element(root, "urn:a", "y"), "element should only return a single element")
+	assert.Len(t, elements(root, "urn:a", "y"), 2)
+}
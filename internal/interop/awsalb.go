@@ -0,0 +1,237 @@
+package interop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// awsALBHeader is the header an AWS application load balancer sends the
+// user claims in.
+// https://docs.aws.amazon.com/elasticloadbalancing/latest/application/listener-authenticate-users.html#user-claims-encoding
+const awsALBHeader = "X-Amzn-Oidc-Data"
+
+// awsALBMaxKeys limits the number of public keys which are cached.
+const awsALBMaxKeys = 64
+
+// awsALBMissingKeyTTL is how long key ids which the endpoint doesn't have a
+// public key for are remembered, so that tokens with made up key ids don't
+// each cause a fetch. awsALBMaxMissingKeys limits how many are remembered.
+const (
+	awsALBMissingKeyTTL  = 5 * time.Minute
+	awsALBMaxMissingKeys = 1024
+)
+
+// awsALBKeyFetchInterval and awsALBKeyFetchBurst limit how often public keys
+// are fetched. Load balancers rotate their keys rarely, so only forged tokens
+// should need more.
+const (
+	awsALBKeyFetchInterval = time.Second
+	awsALBKeyFetchBurst    = 10
+)
+
+var (
+	errInvalidAWSALBToken    = errors.New("interop: invalid aws alb token")
+	errAWSALBUnknownKey      = errors.New("interop: unknown aws alb public key")
+	errAWSALBKeyFetchLimited = errors.New("interop: too many aws alb public key fetches")
+)
+
+type awsALBVerifier struct {
+	arn          string
+	keysURL      string
+	client       *http.Client
+	fetchLimiter *rate.Limiter
+
+	mu   sync.Mutex
+	keys map[string]*ecdsa.PublicKey
+	// missing are the key ids without a public key, and when they can be
+	// fetched again
+	missing map[string]time.Time
+}
+
+// NewAWSALBVerifier returns a verifier of the user claims of the AWS
+// application load balancer with the given ARN. Public keys are fetched from
+// the region's endpoint.
+func NewAWSALBVerifier(region, arn string) Verifier {
+	return newAWSALBVerifier("https://public-keys.auth.elb."+region+".amazonaws.com/", arn)
+}
+
+func newAWSALBVerifier(keysURL, arn string) *awsALBVerifier {
+	return &awsALBVerifier{
+		arn:          arn,
+		keysURL:      keysURL,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		fetchLimiter: rate.NewLimiter(rate.Every(awsALBKeyFetchInterval), awsALBKeyFetchBurst),
+		keys:         make(map[string]*ecdsa.PublicKey),
+		missing:      make(map[string]time.Time),
+	}
+}
+
+// Verify verifies the user claims JWT. The load balancer pads its base64
+// segments, which JWT libraries reject, so it's verified here.
+func (v *awsALBVerifier) Verify(ctx context.Context, headers http.Header) (*Identity, error) {
+	rawJWT := headers.Get(awsALBHeader)
+	if rawJWT == "" {
+		return nil, ErrNoAssertion
+	}
+	parts := strings.Split(rawJWT, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidAWSALBToken
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+		Signer    string `json:"signer"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, errInvalidAWSALBToken
+	}
+	if header.Algorithm != "ES256" || header.Signer != v.arn {
+		return nil, fmt.Errorf("interop: aws alb token isn't signed by %s", v.arn)
+	}
+	// key ids are uuids, anything else isn't worth fetching
+	if _, err := uuid.Parse(header.KeyID); err != nil {
+		return nil, errInvalidAWSALBToken
+	}
+	key, err := v.getKey(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[2], "="))
+	if err != nil || len(sig) != 64 {
+		return nil, errInvalidAWSALBToken
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return nil, errInvalidAWSALBToken
+	}
+
+	var claims struct {
+		Subject   string   `json:"sub"`
+		Email     string   `json:"email"`
+		Groups    []string `json:"groups"`
+		ExpiresAt int64    `json:"exp"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil || claims.Subject == "" {
+		return nil, errInvalidAWSALBToken
+	}
+	expiresAt := time.Unix(claims.ExpiresAt, 0)
+	if time.Now().After(expiresAt) {
+		return nil, errors.New("interop: aws alb token is expired")
+	}
+	return &Identity{
+		Provider: config.InteropProviderAWSALB,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		Groups:   claims.Groups,
+		// the load balancer doesn't say when the user signed in
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// getKey returns the public key with the given id, fetching it if it's not
+// cached. Key ids without a public key aren't fetched again for a while, and
+// fetches are rate limited.
+func (v *awsALBVerifier) getKey(ctx context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	now := time.Now()
+	v.mu.Lock()
+	key, ok := v.keys[keyID]
+	retryAt, missing := v.missing[keyID]
+	v.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	if missing && now.Before(retryAt) {
+		return nil, fmt.Errorf("%w %q", errAWSALBUnknownKey, keyID)
+	}
+	if !v.fetchLimiter.Allow() {
+		return nil, errAWSALBKeyFetchLimited
+	}
+
+	key, err := v.fetchKey(ctx, keyID)
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if errors.Is(err, errAWSALBUnknownKey) {
+		if len(v.missing) >= awsALBMaxMissingKeys {
+			v.missing = make(map[string]time.Time)
+		}
+		v.missing[keyID] = now.Add(awsALBMissingKeyTTL)
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+	if len(v.keys) >= awsALBMaxKeys {
+		v.keys = make(map[string]*ecdsa.PublicKey)
+	}
+	v.keys[keyID] = key
+	delete(v.missing, keyID)
+	return key, nil
+}
+
+// fetchKey fetches the public key with the given id. errAWSALBUnknownKey is
+// returned when the endpoint doesn't have a valid key for it, as opposed to
+// when it couldn't be reached.
+func (v *awsALBVerifier) fetchKey(ctx context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.keysURL+url.PathEscape(keyID), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("interop: error fetching aws alb public key: %w", err)
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusOK:
+	case res.StatusCode >= 400 && res.StatusCode < 500 && res.StatusCode != http.StatusTooManyRequests:
+		return nil, fmt.Errorf("%w %q: %s", errAWSALBUnknownKey, keyID, res.Status)
+	default:
+		return nil, fmt.Errorf("interop: error fetching aws alb public key %q: %s", keyID, res.Status)
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("interop: error fetching aws alb public key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w %q: invalid pem", errAWSALBUnknownKey, keyID)
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", errAWSALBUnknownKey, keyID, err)
+	}
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w %q: not an ecdsa key", errAWSALBUnknownKey, keyID)
+	}
+	return key, nil
+}
+
+// decodeSegment decodes a JWT segment, with or without base64 padding.
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,69 @@
+package interop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	go_oidc "github.com/coreos/go-oidc"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// cloudflareAccessHeader is the header Cloudflare Access sends the
+// application token in.
+// https://developers.cloudflare.com/cloudflare-one/identity/users/validating-json
+const cloudflareAccessHeader = "Cf-Access-Jwt-Assertion"
+
+// errCloudflareAccessNoSubject is returned for the tokens of service tokens,
+// which don't have a subject or email.
+var errCloudflareAccessNoSubject = errors.New("interop: cloudflare access token doesn't have a subject")
+
+type cloudflareAccessVerifier struct {
+	verifier *go_oidc.IDTokenVerifier
+}
+
+// NewCloudflareAccessVerifier returns a verifier of the application tokens
+// of a Cloudflare Access team domain, e.g. "example.cloudflareaccess.com",
+// and application audience tag. Signing keys are fetched from the team
+// domain.
+func NewCloudflareAccessVerifier(teamDomain, audience string) Verifier {
+	keySet := go_oidc.NewRemoteKeySet(context.Background(), "https://"+teamDomain+"/cdn-cgi/access/certs")
+	return newCloudflareAccessVerifier(teamDomain, audience, keySet)
+}
+
+func newCloudflareAccessVerifier(teamDomain, audience string, keySet go_oidc.KeySet) *cloudflareAccessVerifier {
+	return &cloudflareAccessVerifier{
+		verifier: go_oidc.NewVerifier("https://"+teamDomain, keySet, &go_oidc.Config{ClientID: audience}),
+	}
+}
+
+func (v *cloudflareAccessVerifier) Verify(ctx context.Context, headers http.Header) (*Identity, error) {
+	rawJWT := headers.Get(cloudflareAccessHeader)
+	if rawJWT == "" {
+		return nil, ErrNoAssertion
+	}
+	token, err := v.verifier.Verify(ctx, rawJWT)
+	if err != nil {
+		return nil, fmt.Errorf("interop: invalid cloudflare access token: %w", err)
+	}
+	var claims struct {
+		Email  string   `json:"email"`
+		Groups []string `json:"groups"`
+	}
+	if err := token.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("interop: invalid cloudflare access token claims: %w", err)
+	}
+	if token.Subject == "" {
+		return nil, errCloudflareAccessNoSubject
+	}
+	return &Identity{
+		Provider:  config.InteropProviderCloudflareAccess,
+		Subject:   token.Subject,
+		Email:     claims.Email,
+		Groups:    claims.Groups,
+		IssuedAt:  token.IssuedAt,
+		ExpiresAt: token.Expiry,
+	}, nil
+}
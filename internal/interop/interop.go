@@ -0,0 +1,54 @@
+// Package interop verifies the identity assertions of upstream zero-trust
+// providers, such as Cloudflare Access or an AWS application load balancer,
+// so Pomerium can run behind one without users signing in twice.
+package interop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// ErrNoAssertion is returned when a request doesn't have an assertion.
+var ErrNoAssertion = errors.New("interop: no assertion")
+
+// An Identity is the identity of a user asserted by an upstream provider.
+type Identity struct {
+	Provider  string
+	Subject   string
+	Email     string
+	Groups    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// UserID returns the user id of the identity. Users of upstream providers
+// aren't Pomerium users, so their ids are prefixed with the provider.
+func (id *Identity) UserID() string {
+	return "interop/" + id.Provider + "/" + id.Subject
+}
+
+// A Verifier verifies the identity assertions of an upstream provider.
+type Verifier interface {
+	// Verify returns the identity asserted by the request headers, or
+	// ErrNoAssertion if there isn't an assertion.
+	Verify(ctx context.Context, headers http.Header) (*Identity, error)
+}
+
+// New returns the verifier of the configured upstream provider, or nil if
+// there isn't one.
+func New(options *config.Options) (Verifier, error) {
+	switch options.InteropProvider {
+	case "":
+		return nil, nil
+	case config.InteropProviderCloudflareAccess:
+		return NewCloudflareAccessVerifier(options.InteropCloudflareTeamDomain, options.InteropCloudflareAudience), nil
+	case config.InteropProviderAWSALB:
+		return NewAWSALBVerifier(options.InteropAWSALBRegion, options.InteropAWSALBARN), nil
+	}
+	return nil, fmt.Errorf("interop: unknown provider %q", options.InteropProvider)
+}
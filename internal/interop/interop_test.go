@@ -0,0 +1,186 @@
+package interop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+type staticKeySet struct {
+	key *rsa.PublicKey
+}
+
+func (ks staticKeySet) VerifySignature(ctx context.Context, rawJWT string) ([]byte, error) {
+	jws, err := jose.ParseSigned(rawJWT)
+	if err != nil {
+		return nil, err
+	}
+	return jws.Verify(ks.key)
+}
+
+func TestNew(t *testing.T) {
+	v, err := New(&config.Options{})
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = New(&config.Options{
+		InteropProvider:             config.InteropProviderCloudflareAccess,
+		InteropCloudflareTeamDomain: "example.cloudflareaccess.com",
+		InteropCloudflareAudience:   "AUD",
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &cloudflareAccessVerifier{}, v)
+
+	_, err = New(&config.Options{InteropProvider: "zscaler"})
+	assert.Error(t, err)
+}
+
+func TestCloudflareAccessVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	require.NoError(t, err)
+	v := newCloudflareAccessVerifier("example.cloudflareaccess.com", "AUD", staticKeySet{&key.PublicKey})
+
+	now := time.Now()
+	sign := func(modify func(claims map[string]interface{})) http.Header {
+		claims := map[string]interface{}{
+			"iss":   "https://example.cloudflareaccess.com",
+			"aud":   []string{"AUD"},
+			"sub":   "SUBJECT",
+			"email": "user@example.com",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		}
+		modify(claims)
+		raw, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		return http.Header{cloudflareAccessHeader: {raw}}
+	}
+
+	id, err := v.Verify(context.Background(), sign(func(map[string]interface{}) {}))
+	require.NoError(t, err)
+	assert.Equal(t, "SUBJECT", id.Subject)
+	assert.Equal(t, "user@example.com", id.Email)
+	assert.Equal(t, "interop/cloudflare_access/SUBJECT", id.UserID())
+	assert.Equal(t, now.Unix(), id.IssuedAt.Unix())
+
+	for _, tc := range []struct {
+		name   string
+		modify func(claims map[string]interface{})
+	}{
+		{"other audience", func(c map[string]interface{}) { c["aud"] = []string{"OTHER"} }},
+		{"other team", func(c map[string]interface{}) { c["iss"] = "https://other.cloudflareaccess.com" }},
+		{"expired", func(c map[string]interface{}) { c["exp"] = now.Add(-time.Minute).Unix() }},
+		{"service token", func(c map[string]interface{}) { delete(c, "sub"); delete(c, "email") }},
+	} {
+		_, err := v.Verify(context.Background(), sign(tc.modify))
+		assert.Error(t, err, tc.name)
+	}
+
+	_, err = v.Verify(context.Background(), http.Header{})
+	assert.Equal(t, ErrNoAssertion, err)
+}
+
+func TestAWSALBVerifier(t *testing.T) {
+	const (
+		arn   = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188"
+		keyID = "f8a33f3d-5ebc-4a4e-9c8e-2f5f1b0e5c3d"
+	)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	fetches, misses := 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+keyID {
+			misses++
+			http.NotFound(w, r)
+			return
+		}
+		fetches++
+		_ = pem.Encode(w, &pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	}))
+	defer srv.Close()
+	v := newAWSALBVerifier(srv.URL+"/", arn)
+
+	// the load balancer pads its segments
+	encode := func(v interface{}) string {
+		data, _ := json.Marshal(v)
+		return base64.URLEncoding.EncodeToString(data)
+	}
+	sign := func(header, claims map[string]interface{}) http.Header {
+		signed := encode(header) + "." + encode(claims)
+		digest := sha256.Sum256([]byte(signed))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+		return http.Header{awsALBHeader: {signed + "." + base64.URLEncoding.EncodeToString(sig)}}
+	}
+	header := map[string]interface{}{"alg": "ES256", "kid": keyID, "signer": arn}
+	claims := map[string]interface{}{
+		"sub":   "SUBJECT",
+		"email": "user@example.com",
+		"exp":   time.Now().Add(2 * time.Minute).Unix(),
+	}
+
+	for i := 0; i < 2; i++ {
+		id, err := v.Verify(context.Background(), sign(header, claims))
+		require.NoError(t, err)
+		assert.Equal(t, "SUBJECT", id.Subject)
+		assert.Equal(t, "user@example.com", id.Email)
+	}
+	assert.Equal(t, 1, fetches, "keys should be cached")
+
+	otherSigner := map[string]interface{}{"alg": "ES256", "kid": keyID, "signer": "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/other/1"}
+	_, err = v.Verify(context.Background(), sign(otherSigner, claims))
+	assert.Error(t, err, "other load balancers should be rejected")
+
+	otherKey := map[string]interface{}{"alg": "ES256", "kid": "0d1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", "signer": arn}
+	for i := 0; i < 2; i++ {
+		_, err = v.Verify(context.Background(), sign(otherKey, claims))
+		assert.True(t, errors.Is(err, errAWSALBUnknownKey), "unknown keys should be rejected")
+	}
+	assert.Equal(t, 1, misses, "unknown keys should be remembered")
+
+	limited := newAWSALBVerifier(srv.URL+"/", arn)
+	limited.fetchLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+	_, err = limited.Verify(context.Background(), sign(header, claims))
+	assert.NoError(t, err)
+	_, err = limited.Verify(context.Background(), sign(otherKey, claims))
+	assert.Equal(t, errAWSALBKeyFetchLimited, err, "key fetches should be rate limited")
+	_, err = limited.Verify(context.Background(), sign(header, claims))
+	assert.NoError(t, err, "cached keys shouldn't be rate limited")
+
+	expired := map[string]interface{}{"sub": "SUBJECT", "exp": time.Now().Add(-time.Minute).Unix()}
+	_, err = v.Verify(context.Background(), sign(header, expired))
+	assert.Error(t, err)
+
+	tampered := sign(header, claims)
+	tampered.Set(awsALBHeader, encode(header)+"."+encode(map[string]interface{}{"sub": "ADMIN", "exp": claims["exp"]})+"."+
+		tampered.Get(awsALBHeader)[len(encode(header)+"."+encode(claims))+1:])
+	_, err = v.Verify(context.Background(), tampered)
+	assert.Equal(t, errInvalidAWSALBToken, err)
+}
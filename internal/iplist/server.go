@@ -0,0 +1,115 @@
+// Package iplist implements the IP list service, which manages the named IP
+// lists routes reference in allowed_ip_lists.
+package iplist
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
+)
+
+var timeNow = time.Now
+
+// A Server implements the IP list service. The lists are stored in the
+// databroker, which the authorize service syncs them from.
+type Server struct {
+	dataBrokerClient databroker.DataBrokerServiceClient
+	secret           []byte
+	clockSkew        time.Duration
+}
+
+// New creates a new Server. Requests must have a databroker admin token
+// signed with the given secret.
+func New(dataBrokerClient databroker.DataBrokerServiceClient, secret []byte, clockSkew time.Duration) *Server {
+	return &Server{
+		dataBrokerClient: dataBrokerClient,
+		secret:           secret,
+		clockSkew:        clockSkew,
+	}
+}
+
+// Get gets an IP list.
+func (srv *Server) Get(ctx context.Context, req *iplist.GetRequest) (*iplist.IPList, error) {
+	if err := internal_databroker.AuthorizeAdmin(ctx, srv.secret, srv.clockSkew); err != nil {
+		return nil, err
+	}
+	l, err := iplist.Get(ctx, srv.dataBrokerClient, req.GetId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return l, nil
+}
+
+// List lists all the IP lists.
+func (srv *Server) List(ctx context.Context, _ *emptypb.Empty) (*iplist.ListResponse, error) {
+	if err := internal_databroker.AuthorizeAdmin(ctx, srv.secret, srv.clockSkew); err != nil {
+		return nil, err
+	}
+	ls, err := iplist.GetAll(ctx, srv.dataBrokerClient)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &iplist.ListResponse{IpLists: ls}, nil
+}
+
+// Set creates or replaces an IP list.
+func (srv *Server) Set(ctx context.Context, req *iplist.IPList) (*iplist.IPList, error) {
+	if err := internal_databroker.AuthorizeAdmin(ctx, srv.secret, srv.clockSkew); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	l := &iplist.IPList{
+		Id:          req.GetId(),
+		Cidrs:       req.GetCidrs(),
+		Description: req.GetDescription(),
+	}
+	l.ModifiedAt, _ = ptypes.TimestampProto(timeNow())
+	if _, err := iplist.Set(ctx, srv.dataBrokerClient, l); err != nil {
+		return nil, grpcError(err)
+	}
+
+	log.Info().
+		Str("ip_list", l.GetId()).
+		Strs("cidrs", l.GetCidrs()).
+		Msg("iplist: set")
+	return l, nil
+}
+
+// Delete deletes an IP list. Routes referencing a deleted list deny all
+// requests until it's created again.
+func (srv *Server) Delete(ctx context.Context, req *iplist.DeleteRequest) (*emptypb.Empty, error) {
+	if err := internal_databroker.AuthorizeAdmin(ctx, srv.secret, srv.clockSkew); err != nil {
+		return nil, err
+	}
+	if err := iplist.Delete(ctx, srv.dataBrokerClient, req.GetId()); err != nil {
+		return nil, grpcError(err)
+	}
+
+	log.Info().
+		Str("ip_list", req.GetId()).
+		Msg("iplist: delete")
+	return new(emptypb.Empty), nil
+}
+
+// grpcError returns an error with the status code of the databroker error
+// wrapped by err, so clients get NotFound rather than Unknown.
+func grpcError(err error) error {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return status.Error(se.GRPCStatus().Code(), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package iplist
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/iplist"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestServer(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	token, err := internal_databroker.NewAdminToken(secret, time.Minute)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcutil.JWTMetadataKey, token))
+
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer li.Close()
+	grpcServer := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(grpcServer, internal_databroker.New())
+	go func() { _ = grpcServer.Serve(li) }()
+	defer grpcServer.Stop()
+	cc, err := grpc.Dial(li.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer cc.Close()
+	srv := New(databroker.NewDataBrokerServiceClient(cc), secret, time.Minute)
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := srv.List(context.Background(), new(emptypb.Empty))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := srv.Set(ctx, &iplist.IPList{Id: "partner-acme", Cidrs: []string{"not-an-ip"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = srv.Set(ctx, &iplist.IPList{Id: "Partner ACME", Cidrs: []string{"192.0.2.0/24"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("crud", func(t *testing.T) {
+		l, err := srv.Set(ctx, &iplist.IPList{Id: "partner-acme", Cidrs: []string{"192.0.2.0/24"}})
+		require.NoError(t, err)
+		assert.NotNil(t, l.GetModifiedAt())
+
+		l, err = srv.Get(ctx, &iplist.GetRequest{Id: "partner-acme"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"192.0.2.0/24"}, l.GetCidrs())
+
+		res, err := srv.List(ctx, new(emptypb.Empty))
+		require.NoError(t, err)
+		assert.Len(t, res.GetIpLists(), 1)
+
+		_, err = srv.Delete(ctx, &iplist.DeleteRequest{Id: "partner-acme"})
+		require.NoError(t, err)
+		_, err = srv.Get(ctx, &iplist.GetRequest{Id: "partner-acme"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,217 @@
+// Package kafka produces messages to a Kafka topic. It configures a
+// segmentio/kafka-go writer from a Kafka URL, with TLS and SASL PLAIN or SCRAM
+// authentication, and chooses partitions like the Java client, so messages
+// with the same key are produced to the same partition by both.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+const (
+	clientID = "pomerium"
+	// dialTimeout is how long to wait to connect to a broker.
+	dialTimeout = 30 * time.Second
+	// batchTimeout is how long the writer waits for more messages before
+	// producing a batch. Produce already passes whole batches.
+	batchTimeout = 10 * time.Millisecond
+)
+
+// SASL mechanisms supported by the producer.
+const (
+	SASLPlain       = "PLAIN"
+	SASLScramSHA256 = "SCRAM-SHA-256"
+	SASLScramSHA512 = "SCRAM-SHA-512"
+)
+
+// Options are the options of a Producer.
+type Options struct {
+	// Brokers are the addresses of the brokers used to discover the cluster.
+	Brokers []string
+	Topic   string
+	// TLSConfig is used to connect to brokers if set.
+	TLSConfig *tls.Config
+	// SASLMechanism is the SASL mechanism used to authenticate, if set.
+	SASLMechanism string
+	Username      string
+	Password      string
+}
+
+// ParseURL parses a Kafka URL of the form:
+//
+//	kafka[s]://[username:password@]host:port[,host:port...]/topic
+//
+// The kafkas scheme connects with TLS. The sasl_mechanism query parameter
+// sets the SASL mechanism, which defaults to PLAIN if a username is set. The
+// ca_file and tls_skip_verify query parameters customize TLS.
+func ParseURL(rawURL string) (*Options, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.New("kafka: invalid url")
+	}
+
+	options := new(Options)
+	switch u.Scheme {
+	case "kafka":
+	case "kafkas":
+		options.TLSConfig = new(tls.Config)
+	default:
+		return nil, fmt.Errorf("kafka: unsupported scheme %q, must be kafka or kafkas", u.Scheme)
+	}
+	for _, host := range strings.Split(u.Host, ",") {
+		if host == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			return nil, fmt.Errorf("kafka: invalid broker address %q", host)
+		}
+		options.Brokers = append(options.Brokers, host)
+	}
+	if len(options.Brokers) == 0 {
+		return nil, errors.New("kafka: missing broker")
+	}
+	options.Topic = strings.Trim(u.Path, "/")
+	if options.Topic == "" {
+		return nil, errors.New("kafka: missing topic")
+	}
+
+	q := u.Query()
+	if u.User != nil {
+		options.Username = u.User.Username()
+		options.Password, _ = u.User.Password()
+		options.SASLMechanism = SASLPlain
+	}
+	if m := q.Get("sasl_mechanism"); m != "" {
+		options.SASLMechanism = strings.ToUpper(m)
+	}
+	if options.SASLMechanism != "" {
+		if _, err := newSASLMechanism(options.SASLMechanism, options.Username, options.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	if caFile := q.Get("ca_file"); caFile != "" || q.Get("tls_skip_verify") != "" {
+		if options.TLSConfig == nil {
+			return nil, errors.New("kafka: tls options require the kafkas scheme")
+		}
+		if caFile != "" {
+			bs, err := ioutil.ReadFile(caFile)
+			if err != nil {
+				return nil, fmt.Errorf("kafka: error reading ca file: %w", err)
+			}
+			options.TLSConfig.RootCAs = x509.NewCertPool()
+			if !options.TLSConfig.RootCAs.AppendCertsFromPEM(bs) {
+				return nil, errors.New("kafka: no certificates found in ca file")
+			}
+		}
+		if v := q.Get("tls_skip_verify"); v != "" {
+			skip, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, errors.New("kafka: invalid tls_skip_verify")
+			}
+			// nolint: gosec
+			options.TLSConfig.InsecureSkipVerify = skip
+		}
+	}
+	return options, nil
+}
+
+// A Message is a message produced to a topic.
+type Message struct {
+	// Key is used to choose the partition of the message. Messages without a
+	// key are spread over all the partitions.
+	Key       []byte
+	Value     []byte
+	Timestamp time.Time
+}
+
+// A Producer produces messages to a topic. Messages are acknowledged by all
+// in sync replicas before Produce returns.
+type Producer struct {
+	writer *kafkago.Writer
+}
+
+// NewProducer creates a new Producer.
+func NewProducer(options Options) (*Producer, error) {
+	if len(options.Brokers) == 0 {
+		return nil, errors.New("kafka: missing broker")
+	}
+	if options.Topic == "" {
+		return nil, errors.New("kafka: missing topic")
+	}
+	transport := &kafkago.Transport{
+		ClientID:    clientID,
+		DialTimeout: dialTimeout,
+		TLS:         options.TLSConfig,
+	}
+	if options.SASLMechanism != "" {
+		mech, err := newSASLMechanism(options.SASLMechanism, options.Username, options.Password)
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mech
+	}
+	return &Producer{
+		writer: &kafkago.Writer{
+			Addr:         kafkago.TCP(options.Brokers...),
+			Topic:        options.Topic,
+			Balancer:     &kafkago.Murmur2Balancer{},
+			RequiredAcks: kafkago.RequireAll,
+			BatchTimeout: batchTimeout,
+			Transport:    transport,
+		},
+	}, nil
+}
+
+// Close closes the connections to the brokers.
+func (p *Producer) Close() error {
+	return p.writer.Close()
+}
+
+// Produce produces messages. Messages are partitioned by key. If an error
+// is returned, some of the messages may have been produced anyway.
+func (p *Producer) Produce(ctx context.Context, msgs ...Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	kmsgs := make([]kafkago.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		kmsgs = append(kmsgs, kafkago.Message{
+			Key:   msg.Key,
+			Value: msg.Value,
+			Time:  msg.Timestamp,
+		})
+	}
+	if err := p.writer.WriteMessages(ctx, kmsgs...); err != nil {
+		return fmt.Errorf("kafka: error producing to %s: %w", p.writer.Topic, err)
+	}
+	return nil
+}
+
+func newSASLMechanism(name, username, password string) (sasl.Mechanism, error) {
+	switch name {
+	case SASLPlain:
+		return plain.Mechanism{Username: username, Password: password}, nil
+	case SASLScramSHA256:
+		return scram.Mechanism(scram.SHA256, username, password)
+	case SASLScramSHA512:
+		return scram.Mechanism(scram.SHA512, username, password)
+	}
+	return nil, fmt.Errorf("kafka: unsupported sasl mechanism %q", name)
+}
@@ -0,0 +1,25 @@
+// Package kvcache implements short-lived key-value caches, in memory or in
+// redis. Keys are grouped, so related entries, like the entries of a
+// session, can be invalidated together.
+package kvcache
+
+import (
+	"context"
+	"time"
+)
+
+// A Cache is a key-value cache whose entries expire.
+type Cache interface {
+	// Get returns the value of the key in the group, or false if it doesn't
+	// exist or has expired.
+	Get(ctx context.Context, group, key string) ([]byte, bool, error)
+	// Set sets the value of the key in the group, which expires after ttl.
+	Set(ctx context.Context, group, key string, value []byte, ttl time.Duration) error
+	// DeleteGroup deletes every key in the group.
+	DeleteGroup(ctx context.Context, group string) error
+	// Close releases the resources of the cache.
+	Close() error
+}
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
@@ -0,0 +1,55 @@
+package kvcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	c, err := NewMemory(10)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set(ctx, "session-1", "https://a.example/", []byte("A"), time.Minute))
+	require.NoError(t, c.Set(ctx, "session-1", "https://b.example/", []byte("B"), 2*time.Minute))
+	require.NoError(t, c.Set(ctx, "session-2", "https://a.example/", []byte("C"), 2*time.Minute))
+
+	value, ok, err := c.Get(ctx, "session-1", "https://a.example/")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("A"), value)
+
+	now = now.Add(90 * time.Second)
+	_, ok, _ = c.Get(ctx, "session-1", "https://a.example/")
+	assert.False(t, ok, "expired entries should not be returned")
+	_, ok, _ = c.Get(ctx, "session-1", "https://b.example/")
+	assert.True(t, ok)
+
+	require.NoError(t, c.DeleteGroup(ctx, "session-1"))
+	_, ok, _ = c.Get(ctx, "session-1", "https://b.example/")
+	assert.False(t, ok, "entries of deleted groups should not be returned")
+	_, ok, _ = c.Get(ctx, "session-2", "https://a.example/")
+	assert.True(t, ok, "entries of other groups should be kept")
+}
+
+func TestRedisValue(t *testing.T) {
+	expiry := time.Unix(1600000000, 123000000)
+	raw := encodeRedisValue([]byte(`{"a":"b:c"}`), expiry)
+	assert.Equal(t, `1600000000123:{"a":"b:c"}`, string(raw))
+
+	value, gotExpiry, err := decodeRedisValue(raw)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"a":"b:c"}`), value)
+	assert.True(t, expiry.Equal(gotExpiry))
+
+	_, _, err = decodeRedisValue([]byte("no expiry"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package kvcache
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+type memoryKey struct {
+	group, key string
+}
+
+type memoryEntry struct {
+	value  []byte
+	expiry time.Time
+}
+
+// A Memory cache is an in-memory LRU cache, local to the process.
+type Memory struct {
+	lru *lru.Cache
+}
+
+// NewMemory creates a new Memory cache of at most size entries.
+func NewMemory(size int) (*Memory, error) {
+	c, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &Memory{lru: c}, nil
+}
+
+// Get returns the value of the key in the group, or false if it doesn't
+// exist or has expired.
+func (c *Memory) Get(ctx context.Context, group, key string) ([]byte, bool, error) {
+	k := memoryKey{group: group, key: key}
+	v, ok := c.lru.Get(k)
+	if !ok {
+		return nil, false, nil
+	}
+	entry := v.(memoryEntry)
+	if timeNow().After(entry.expiry) {
+		c.lru.Remove(k)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set sets the value of the key in the group, which expires after ttl.
+func (c *Memory) Set(ctx context.Context, group, key string, value []byte, ttl time.Duration) error {
+	c.lru.Add(memoryKey{group: group, key: key}, memoryEntry{
+		value:  value,
+		expiry: timeNow().Add(ttl),
+	})
+	return nil
+}
+
+// DeleteGroup deletes every key in the group.
+func (c *Memory) DeleteGroup(ctx context.Context, group string) error {
+	for _, k := range c.lru.Keys() {
+		if k.(memoryKey).group == group {
+			c.lru.Remove(k)
+		}
+	}
+	return nil
+}
+
+// Close does nothing.
+func (c *Memory) Close() error {
+	return nil
+}
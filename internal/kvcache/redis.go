@@ -0,0 +1,137 @@
+package kvcache
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// maxRedisGroupSize is the most keys stored in a group. A full group is
+	// emptied before a key is added, so groups which are never idle for long
+	// enough to expire don't grow forever.
+	maxRedisGroupSize = 1000
+	redisMaxIdle      = 8
+	redisIdleTimeout  = time.Minute
+)
+
+// setScript sets a key of the group's hash and extends the hash's expiry to
+// the key's, emptying it first if it's full.
+var setScript = redis.NewScript(1, `
+if redis.call("HLEN", KEYS[1]) >= tonumber(ARGV[4]) then
+	redis.call("DEL", KEYS[1])
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+if redis.call("PTTL", KEYS[1]) < tonumber(ARGV[3]) then
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+end
+return 1
+`)
+
+// A Redis cache is stored in redis, and shared by every process using the
+// same redis server and prefix. Each group is a hash, which expires with its
+// last key, and each value is stored with its expiry.
+type Redis struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+// NewRedis creates a new Redis cache using the redis server at rawURL. Its
+// keys are prefixed with prefix.
+func NewRedis(rawURL, prefix string, tlsConfig *tls.Config) *Redis {
+	return &Redis{
+		pool: &redis.Pool{
+			Wait:        true,
+			MaxIdle:     redisMaxIdle,
+			IdleTimeout: redisIdleTimeout,
+			Dial: func() (redis.Conn, error) {
+				c, err := redis.DialURL(rawURL, redis.DialTLSConfig(tlsConfig))
+				if err != nil {
+					return nil, fmt.Errorf(`redis.DialURL(): %w`, err)
+				}
+				return c, nil
+			},
+		},
+		prefix: prefix,
+	}
+}
+
+// Get returns the value of the key in the group, or false if it doesn't
+// exist or has expired.
+func (c *Redis) Get(ctx context.Context, group, key string) ([]byte, bool, error) {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer conn.Close()
+
+	raw, err := redis.Bytes(conn.Do("HGET", c.prefix+group, key))
+	if err == redis.ErrNil {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	value, expiry, err := decodeRedisValue(raw)
+	if err != nil {
+		return nil, false, err
+	}
+	if timeNow().After(expiry) {
+		return nil, false, nil
+	}
+	return value, true, nil
+}
+
+// Set sets the value of the key in the group, which expires after ttl.
+func (c *Redis) Set(ctx context.Context, group, key string, value []byte, ttl time.Duration) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	raw := encodeRedisValue(value, timeNow().Add(ttl))
+	_, err = setScript.Do(conn, c.prefix+group, key, raw, ttl.Milliseconds(), maxRedisGroupSize)
+	return err
+}
+
+// DeleteGroup deletes every key in the group.
+func (c *Redis) DeleteGroup(ctx context.Context, group string) error {
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("DEL", c.prefix+group)
+	return err
+}
+
+// Close closes the connections to the redis server.
+func (c *Redis) Close() error {
+	return c.pool.Close()
+}
+
+// encodeRedisValue encodes a value with its expiry, in milliseconds since
+// the epoch.
+func encodeRedisValue(value []byte, expiry time.Time) []byte {
+	raw := strconv.AppendInt(nil, expiry.UnixNano()/int64(time.Millisecond), 10)
+	raw = append(raw, ':')
+	return append(raw, value...)
+}
+
+func decodeRedisValue(raw []byte) ([]byte, time.Time, error) {
+	i := bytes.IndexByte(raw, ':')
+	if i < 0 {
+		return nil, time.Time{}, fmt.Errorf("kvcache: invalid redis value")
+	}
+	ms, err := strconv.ParseInt(string(raw[:i]), 10, 64)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("kvcache: invalid redis value expiry: %w", err)
+	}
+	return raw[i+1:], time.Unix(0, ms*int64(time.Millisecond)), nil
+}
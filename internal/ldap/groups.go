@@ -0,0 +1,121 @@
+package ldap
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// A Searcher searches for entries.
+type Searcher interface {
+	Search(ctx context.Context, req *SearchRequest) ([]*Entry, error)
+}
+
+// A Group is a group a user is a member of.
+type Group struct {
+	DN   string
+	Name string
+}
+
+// A GroupResolver resolves the nested groups of users. Groups are looked up
+// once, so a resolver is meant to be used for a single sign in, or a single
+// sync of the directory.
+type GroupResolver struct {
+	searcher Searcher
+	// parents are the DNs of the groups each group is a direct member of
+	parents map[string][]string
+	names   map[string]string
+}
+
+// NewGroupResolver creates a new GroupResolver.
+func NewGroupResolver(searcher Searcher) *GroupResolver {
+	return &GroupResolver{
+		searcher: searcher,
+		parents:  make(map[string][]string),
+		names:    make(map[string]string),
+	}
+}
+
+// Resolve returns the groups of a user, given the DNs of the groups they're a
+// direct member of, which are the values of their memberOf attribute. The
+// groups those groups are members of are included, recursively, which works
+// with directories which maintain the memberOf attribute of groups, such as
+// Active Directory and OpenLDAP with the memberof overlay.
+func (r *GroupResolver) Resolve(ctx context.Context, memberOf []string) ([]Group, error) {
+	seen := make(map[string]bool)
+	queue := append([]string(nil), memberOf...)
+	for len(queue) > 0 {
+		dn := queue[0]
+		queue = queue[1:]
+		if seen[dn] {
+			continue
+		}
+		seen[dn] = true
+
+		parents, err := r.lookup(ctx, dn)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, parents...)
+	}
+
+	groups := make([]Group, 0, len(seen))
+	for dn := range seen {
+		name := r.names[dn]
+		if name == "" {
+			name = rdnValue(dn)
+		}
+		groups = append(groups, Group{DN: dn, Name: name})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].DN < groups[j].DN
+	})
+	return groups, nil
+}
+
+func (r *GroupResolver) lookup(ctx context.Context, dn string) ([]string, error) {
+	if parents, ok := r.parents[dn]; ok {
+		return parents, nil
+	}
+
+	entries, err := r.searcher.Search(ctx, &SearchRequest{
+		BaseDN:     dn,
+		Scope:      ScopeBaseObject,
+		Filter:     "(objectClass=*)",
+		Attributes: []string{"cn", "memberOf"},
+	})
+	// groups which were deleted, or which can't be read, are still
+	// returned, but without a name
+	if IsResultCode(err, ResultNoSuchObject) {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var parents []string
+	if len(entries) > 0 {
+		r.names[dn] = entries[0].Value("cn")
+		parents = entries[0].Values("memberOf")
+	}
+	r.parents[dn] = parents
+	return parents, nil
+}
+
+// rdnValue returns the value of the first relative DN of a DN, e.g. admins for
+// cn=admins,ou=groups,dc=example,dc=com.
+func rdnValue(dn string) string {
+	rdn := dn
+	for i := 0; i < len(dn); i++ {
+		if dn[i] == '\\' {
+			i++
+		} else if dn[i] == ',' {
+			rdn = dn[:i]
+			break
+		}
+	}
+	if idx := strings.IndexByte(rdn, '='); idx >= 0 {
+		return rdn[idx+1:]
+	}
+	return rdn
+}
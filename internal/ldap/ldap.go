@@ -0,0 +1,234 @@
+// Package ldap is an LDAPv3 client built on go-ldap, with only the operations
+// needed to authenticate users and look up their groups: simple binds,
+// searches and StartTLS.
+//
+// https://tools.ietf.org/html/rfc4511
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Result codes.
+//
+// https://tools.ietf.org/html/rfc4511#appendix-A
+const (
+	ResultSuccess            = goldap.LDAPResultSuccess
+	ResultSizeLimitExceeded  = goldap.LDAPResultSizeLimitExceeded
+	ResultNoSuchObject       = goldap.LDAPResultNoSuchObject
+	ResultInvalidCredentials = goldap.LDAPResultInvalidCredentials
+)
+
+// defaultTimeout is the timeout of operations when the context has no
+// deadline.
+const defaultTimeout = 30 * time.Second
+
+// ErrInvalidCredentials is returned when a bind fails because the DN or the
+// password is wrong.
+var ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+
+// An Error is a result other than success returned by the server.
+type Error struct {
+	ResultCode int64
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ldap: result code %d", e.ResultCode)
+	}
+	return fmt.Sprintf("ldap: result code %d: %s", e.ResultCode, e.Message)
+}
+
+// IsResultCode returns true if err is an Error with the given result code.
+func IsResultCode(err error, code int64) bool {
+	var e *Error
+	return errors.As(err, &e) && e.ResultCode == code
+}
+
+// An Entry is an entry returned by a search.
+type Entry struct {
+	DN string
+	// Attributes are the values of the entry's attributes, by lower case
+	// attribute name.
+	Attributes map[string][]string
+}
+
+// Values returns the values of an attribute.
+func (e *Entry) Values(attribute string) []string {
+	return e.Attributes[strings.ToLower(attribute)]
+}
+
+// Value returns the first value of an attribute, or an empty string if the
+// entry doesn't have it.
+func (e *Entry) Value(attribute string) string {
+	if vs := e.Values(attribute); len(vs) > 0 {
+		return vs[0]
+	}
+	return ""
+}
+
+// EscapeFilter escapes a value for use in a filter, so that it's matched
+// literally.
+//
+// https://tools.ietf.org/html/rfc4515#section-3
+func EscapeFilter(s string) string {
+	return goldap.EscapeFilter(s)
+}
+
+// A SearchRequest searches for entries.
+type SearchRequest struct {
+	BaseDN     string
+	Scope      int
+	Filter     string
+	Attributes []string
+	// SizeLimit is the most entries returned. If zero, there's no limit.
+	SizeLimit int
+	// PageSize is how many entries are returned at once with the paged
+	// results control. If zero, results aren't paged.
+	PageSize int
+}
+
+// A Conn is a connection to an LDAP server. Operations are sent one at a
+// time, so a Conn must not be used concurrently.
+type Conn struct {
+	conn *goldap.Conn
+}
+
+// Dial connects to the server of an LDAP URL. Connections to ldap URLs are
+// upgraded to TLS with StartTLS, so credentials are never sent in the clear.
+func Dial(ctx context.Context, u *URL, tlsConfig *tls.Config) (*Conn, error) {
+	if tlsConfig == nil {
+		tlsConfig = new(tls.Config)
+	} else {
+		tlsConfig = tlsConfig.Clone()
+	}
+	if tlsConfig.ServerName == "" {
+		host, _, _ := net.SplitHostPort(u.Host)
+		tlsConfig.ServerName = host
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", u.Host)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: error connecting to %s: %w", u.Host, err)
+	}
+
+	if u.Scheme == "ldaps" {
+		tlsConn := tls.Client(conn, tlsConfig)
+		_ = tlsConn.SetDeadline(deadline(ctx))
+		if err := tlsConn.Handshake(); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("ldap: tls handshake failed: %w", err)
+		}
+		_ = tlsConn.SetDeadline(time.Time{})
+		return newConn(tlsConn, true), nil
+	}
+
+	c := newConn(conn, false)
+	c.setTimeout(ctx)
+	if err := c.conn.StartTLS(tlsConfig); err != nil {
+		c.conn.Close()
+		return nil, fmt.Errorf("ldap: starttls failed: %w", convertError(err))
+	}
+	return c, nil
+}
+
+func newConn(conn net.Conn, isTLS bool) *Conn {
+	c := goldap.NewConn(conn, isTLS)
+	c.Start()
+	return &Conn{conn: c}
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	if err := c.conn.Unbind(); err != nil {
+		c.conn.Close()
+	}
+	return nil
+}
+
+// Bind authenticates the connection with a DN and password. ErrInvalidCredentials
+// is returned if they're wrong. Empty passwords are rejected, since servers
+// treat them as an unauthenticated bind, which always succeeds.
+//
+// https://tools.ietf.org/html/rfc4513#section-5.1.2
+func (c *Conn) Bind(ctx context.Context, dn, password string) error {
+	if password == "" {
+		return ErrInvalidCredentials
+	}
+	c.setTimeout(ctx)
+	err := convertError(c.conn.Bind(dn, password))
+	if IsResultCode(err, ResultInvalidCredentials) {
+		return ErrInvalidCredentials
+	}
+	return err
+}
+
+// Search returns the entries matching a search request. Search result
+// references are ignored.
+func (c *Conn) Search(ctx context.Context, req *SearchRequest) ([]*Entry, error) {
+	sr := goldap.NewSearchRequest(req.BaseDN, req.Scope, goldap.NeverDerefAliases,
+		req.SizeLimit, 0, false, req.Filter, req.Attributes, nil)
+
+	c.setTimeout(ctx)
+	var res *goldap.SearchResult
+	var err error
+	if req.PageSize > 0 {
+		res, err = c.conn.SearchWithPaging(sr, uint32(req.PageSize))
+	} else {
+		res, err = c.conn.Search(sr)
+	}
+
+	var entries []*Entry
+	if res != nil {
+		for _, e := range res.Entries {
+			entry := &Entry{DN: e.DN, Attributes: make(map[string][]string)}
+			for _, attr := range e.Attributes {
+				key := strings.ToLower(attr.Name)
+				entry.Attributes[key] = append(entry.Attributes[key], attr.Values...)
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries, convertError(err)
+}
+
+// setTimeout sets the timeout of the next operation to the context's
+// deadline.
+func (c *Conn) setTimeout(ctx context.Context) {
+	c.conn.SetTimeout(time.Until(deadline(ctx)))
+}
+
+func deadline(ctx context.Context) time.Time {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTimeout)
+	}
+	return deadline
+}
+
+// convertError returns an Error for the results returned by the server.
+// Errors of the client, such as network errors, are wrapped as is.
+func convertError(err error) error {
+	var e *goldap.Error
+	if !errors.As(err, &e) {
+		return err
+	}
+	if e.ResultCode >= goldap.ErrorNetwork {
+		return fmt.Errorf("ldap: %w", e.Err)
+	}
+	res := &Error{ResultCode: int64(e.ResultCode)}
+	if e.Err != nil {
+		res.Message = e.Err.Error()
+	}
+	return res
+}
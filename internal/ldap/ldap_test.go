@@ -0,0 +1,254 @@
+package ldap
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serve answers the requests of a client with handler, until the client
+// closes the connection. The controls returned by handler are added to its
+// last response.
+func serve(t *testing.T, handler func(op *ber.Packet, controls []goldap.Control) ([]*ber.Packet, []goldap.Control)) *Conn {
+	client, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		for {
+			msg, err := ber.ReadPacket(server)
+			if err != nil {
+				return
+			}
+			op := msg.Children[1]
+			if op.Tag == goldap.ApplicationUnbindRequest {
+				return
+			}
+			var controls []goldap.Control
+			if len(msg.Children) > 2 {
+				for _, child := range msg.Children[2].Children {
+					control, err := goldap.DecodeControl(child)
+					require.NoError(t, err)
+					controls = append(controls, control)
+				}
+			}
+			res, resControls := handler(op, controls)
+			for i, op := range res {
+				envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+				envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msg.Children[0].Value, ""))
+				envelope.AppendChild(op)
+				if i == len(res)-1 && len(resControls) > 0 {
+					packet := ber.Encode(ber.ClassContext, ber.TypeConstructed, 0, nil, "")
+					for _, control := range resControls {
+						packet.AppendChild(control.Encode())
+					}
+					envelope.AppendChild(packet)
+				}
+				if _, err := server.Write(envelope.Bytes()); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	c := newConn(client, false)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func newString(value string) *ber.Packet {
+	return ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "")
+}
+
+func newResult(tag ber.Tag, code int64, message string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, ""))
+	p.AppendChild(newString(""))
+	p.AppendChild(newString(message))
+	return p
+}
+
+func newEntry(dn string, attrs map[string][]string) *ber.Packet {
+	seq := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	for name, values := range attrs {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		attr.AppendChild(newString(name))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+		for _, v := range values {
+			set.AppendChild(newString(v))
+		}
+		attr.AppendChild(set)
+		seq.AppendChild(attr)
+	}
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "")
+	p.AppendChild(newString(dn))
+	p.AppendChild(seq)
+	return p
+}
+
+func TestEscapeFilter(t *testing.T) {
+	assert.Equal(t, `\2a\28\29\5c\00jdoe`, EscapeFilter("*()\\\x00jdoe"))
+}
+
+func TestParseURL(t *testing.T) {
+	u, err := ParseURL("ldaps://ldap.example.com/ou=people,dc=example,dc=com?sAMAccountName?one?(objectClass=user)")
+	require.NoError(t, err)
+	assert.Equal(t, &URL{
+		Scheme:    "ldaps",
+		Host:      "ldap.example.com:636",
+		BaseDN:    "ou=people,dc=example,dc=com",
+		Attribute: "sAMAccountName",
+		Scope:     ScopeSingleLevel,
+		Filter:    "(objectClass=user)",
+	}, u)
+
+	u, err = ParseURL("ldap://ldap.example.com:1389/dc=example,dc=com")
+	require.NoError(t, err)
+	assert.Equal(t, &URL{
+		Scheme:    "ldap",
+		Host:      "ldap.example.com:1389",
+		BaseDN:    "dc=example,dc=com",
+		Attribute: "uid",
+		Scope:     ScopeWholeSubtree,
+		Filter:    "(objectClass=*)",
+	}, u)
+
+	for _, rawURL := range []string{
+		"https://ldap.example.com",
+		"ldap:///dc=example,dc=com",
+		"ldap://ldap.example.com/dc=example?uid,cn",
+		"ldap://ldap.example.com/dc=example?uid?all",
+		"ldap://ldap.example.com/dc=example?uid?sub?objectClass=*",
+		"ldap://ldap.example.com/dc=example?uid?sub?(objectClass=*)?!e-bindname=cn=admin",
+	} {
+		_, err := ParseURL(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+}
+
+func TestConn_Bind(t *testing.T) {
+	ctx := context.Background()
+	c := serve(t, func(op *ber.Packet, _ []goldap.Control) ([]*ber.Packet, []goldap.Control) {
+		if op.Tag != goldap.ApplicationBindRequest {
+			return []*ber.Packet{newResult(goldap.ApplicationBindResponse, 2, "protocol error")}, nil
+		}
+		if op.Children[1].Value == "cn=admin,dc=example,dc=com" && op.Children[2].Data.String() == "secret" {
+			return []*ber.Packet{newResult(goldap.ApplicationBindResponse, ResultSuccess, "")}, nil
+		}
+		return []*ber.Packet{newResult(goldap.ApplicationBindResponse, ResultInvalidCredentials, "")}, nil
+	})
+
+	assert.NoError(t, c.Bind(ctx, "cn=admin,dc=example,dc=com", "secret"))
+	assert.Equal(t, ErrInvalidCredentials, c.Bind(ctx, "cn=admin,dc=example,dc=com", "wrong"))
+	assert.Equal(t, ErrInvalidCredentials, c.Bind(ctx, "cn=admin,dc=example,dc=com", ""),
+		"empty passwords should never be sent")
+}
+
+func TestConn_Search(t *testing.T) {
+	ctx := context.Background()
+	var pages []string
+	c := serve(t, func(op *ber.Packet, controls []goldap.Control) ([]*ber.Packet, []goldap.Control) {
+		if op.Tag != goldap.ApplicationSearchRequest {
+			return []*ber.Packet{newResult(goldap.ApplicationSearchResultDone, 2, "protocol error")}, nil
+		}
+		if op.Children[0].Value == "ou=missing,dc=example,dc=com" {
+			return []*ber.Packet{newResult(goldap.ApplicationSearchResultDone, ResultNoSuchObject, "no such object")}, nil
+		}
+
+		paging, _ := goldap.FindControl(controls, goldap.ControlTypePaging).(*goldap.ControlPaging)
+		cookie := ""
+		if paging != nil {
+			cookie = string(paging.Cookie)
+		}
+		pages = append(pages, cookie)
+
+		entry := newEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+			"uid":      {"alice"},
+			"memberOf": {"cn=admins,ou=groups,dc=example,dc=com", "cn=devs,ou=groups,dc=example,dc=com"},
+		})
+		next := ""
+		if cookie == "page2" {
+			entry = newEntry("uid=bob,ou=people,dc=example,dc=com", map[string][]string{"uid": {"bob"}})
+		} else if paging != nil {
+			next = "page2"
+		}
+		ref := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultReference, nil, "")
+		ref.AppendChild(newString("ldap://other.example.com/"))
+		res := []*ber.Packet{entry, ref, newResult(goldap.ApplicationSearchResultDone, ResultSuccess, "")}
+		if paging == nil {
+			return res, nil
+		}
+		return res, []goldap.Control{&goldap.ControlPaging{Cookie: []byte(next)}}
+	})
+
+	entries, err := c.Search(ctx, &SearchRequest{
+		BaseDN: "ou=people,dc=example,dc=com",
+		Scope:  ScopeWholeSubtree,
+		Filter: "(uid=alice)",
+	})
+	require.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "uid=alice,ou=people,dc=example,dc=com", entries[0].DN)
+		assert.Equal(t, "alice", entries[0].Value("UID"))
+		assert.Len(t, entries[0].Values("memberof"), 2)
+		assert.Equal(t, "", entries[0].Value("mail"))
+	}
+
+	pages = nil
+	entries, err = c.Search(ctx, &SearchRequest{
+		BaseDN:   "ou=people,dc=example,dc=com",
+		Scope:    ScopeWholeSubtree,
+		Filter:   "(objectClass=person)",
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, []string{"", "page2"}, pages)
+
+	_, err = c.Search(ctx, &SearchRequest{BaseDN: "ou=missing,dc=example,dc=com", Filter: "(objectClass=*)"})
+	assert.True(t, IsResultCode(err, ResultNoSuchObject))
+
+	_, err = c.Search(ctx, &SearchRequest{Filter: "objectClass=*"})
+	assert.Error(t, err)
+}
+
+type fakeSearcher map[string]*Entry
+
+func (s fakeSearcher) Search(ctx context.Context, req *SearchRequest) ([]*Entry, error) {
+	if entry, ok := s[req.BaseDN]; ok {
+		return []*Entry{entry}, nil
+	}
+	return nil, &Error{ResultCode: ResultNoSuchObject}
+}
+
+func TestGroupResolver(t *testing.T) {
+	s := fakeSearcher{
+		"cn=devs,ou=groups,dc=example,dc=com": {
+			DN: "cn=devs,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":       {"devs"},
+				"memberof": {"cn=engineering,ou=groups,dc=example,dc=com"},
+			},
+		},
+		"cn=engineering,ou=groups,dc=example,dc=com": {
+			DN: "cn=engineering,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn": {"Engineering"},
+				// cycles are ignored
+				"memberof": {"cn=devs,ou=groups,dc=example,dc=com"},
+			},
+		},
+	}
+	groups, err := NewGroupResolver(s).Resolve(context.Background(), []string{
+		"cn=devs,ou=groups,dc=example,dc=com",
+		`cn=deleted\,group,ou=groups,dc=example,dc=com`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Group{
+		{DN: `cn=deleted\,group,ou=groups,dc=example,dc=com`, Name: `deleted\,group`},
+		{DN: "cn=devs,ou=groups,dc=example,dc=com", Name: "devs"},
+		{DN: "cn=engineering,ou=groups,dc=example,dc=com", Name: "Engineering"},
+	}, groups)
+}
@@ -0,0 +1,109 @@
+package ldap
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Search scopes.
+const (
+	ScopeBaseObject   = goldap.ScopeBaseObject
+	ScopeSingleLevel  = goldap.ScopeSingleLevel
+	ScopeWholeSubtree = goldap.ScopeWholeSubtree
+)
+
+// A URL is an LDAP URL, which is the address of a server, and the search
+// users are looked up with.
+//
+// https://tools.ietf.org/html/rfc4516
+type URL struct {
+	// Scheme is ldap or ldaps. Connections to ldap URLs are upgraded to
+	// TLS with StartTLS.
+	Scheme string
+	// Host is the host and port of the server.
+	Host string
+	// BaseDN is the DN searches start at.
+	BaseDN string
+	// Attribute is the attribute of users their username is matched
+	// against.
+	Attribute string
+	// Scope is the scope of searches under the base DN.
+	Scope int
+	// Filter limits which entries are users.
+	Filter string
+}
+
+// ParseURL parses an LDAP URL, e.g.
+// ldaps://ldap.example.com/ou=people,dc=example,dc=com?uid?sub?(objectClass=person).
+// The attribute defaults to uid, the scope to sub and the filter to
+// (objectClass=*).
+func ParseURL(rawURL string) (*URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	lu := &URL{
+		Scheme:    u.Scheme,
+		Host:      u.Host,
+		BaseDN:    strings.TrimPrefix(u.Path, "/"),
+		Attribute: "uid",
+		Scope:     ScopeWholeSubtree,
+		Filter:    "(objectClass=*)",
+	}
+	switch lu.Scheme {
+	case "ldap":
+		if u.Port() == "" {
+			lu.Host = net.JoinHostPort(u.Hostname(), "389")
+		}
+	case "ldaps":
+		if u.Port() == "" {
+			lu.Host = net.JoinHostPort(u.Hostname(), "636")
+		}
+	default:
+		return nil, fmt.Errorf("ldap: unsupported url scheme %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("ldap: url is missing a host")
+	}
+
+	// the query is ?attributes?scope?filter?extensions, and isn't
+	// key/value pairs
+	query, err := url.PathUnescape(u.RawQuery)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(query, "?")
+	if len(parts) > 0 && parts[0] != "" {
+		if strings.Contains(parts[0], ",") {
+			return nil, fmt.Errorf("ldap: url must have a single attribute")
+		}
+		lu.Attribute = parts[0]
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		switch parts[1] {
+		case "base":
+			lu.Scope = ScopeBaseObject
+		case "one":
+			lu.Scope = ScopeSingleLevel
+		case "sub":
+			lu.Scope = ScopeWholeSubtree
+		default:
+			return nil, fmt.Errorf("ldap: invalid url scope %q", parts[1])
+		}
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		lu.Filter = parts[2]
+		if _, err := goldap.CompileFilter(lu.Filter); err != nil {
+			return nil, fmt.Errorf("ldap: invalid filter %q: %w", lu.Filter, err)
+		}
+	}
+	if len(parts) > 3 && parts[3] != "" {
+		return nil, fmt.Errorf("ldap: url extensions aren't supported")
+	}
+	return lu, nil
+}
@@ -20,7 +20,7 @@ func init() {
 
 // DisableDebug tells the logger to use stdout and json output.
 func DisableDebug() {
-	l := zerolog.New(os.Stdout).With().Timestamp().Logger()
+	l := zerolog.New(os.Stdout).With().Timestamp().Int(SchemaVersionField, SchemaVersion).Logger()
 	logger.Store(&l)
 }
 
@@ -33,14 +33,14 @@ func ExamplePrint() {
 	setup()
 
 	log.Print("hello world")
-	// Output: {"level":"debug","time":1199811905,"message":"hello world"}
+	// Output: {"level":"debug","schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 func ExampleWith() {
 	setup()
 	sublog := log.With().Str("foo", "bar").Logger()
 	sublog.Debug().Msg("hello world")
-	// Output: {"level":"debug","foo":"bar","time":1199811905,"message":"hello world"}
+	// Output: {"level":"debug","schema_version":2,"foo":"bar","time":1199811905,"message":"hello world"}
 
 }
 
@@ -49,7 +49,7 @@ func ExamplePrintf() {
 	setup()
 
 	log.Printf("hello %s", "world")
-	// Output: {"level":"debug","time":1199811905,"message":"hello world"}
+	// Output: {"level":"debug","schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 // Example of a log with no particular "level"
@@ -57,7 +57,7 @@ func ExampleLog() {
 	setup()
 	log.Log().Msg("hello world")
 
-	// Output: {"time":1199811905,"message":"hello world"}
+	// Output: {"schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 // Example of a log at a particular "level" (in this case, "debug")
@@ -65,7 +65,7 @@ func ExampleDebug() {
 	setup()
 	log.Debug().Msg("hello world")
 
-	// Output: {"level":"debug","time":1199811905,"message":"hello world"}
+	// Output: {"level":"debug","schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 // Example of a log at a particular "level" (in this case, "info")
@@ -73,7 +73,7 @@ func ExampleInfo() {
 	setup()
 	log.Info().Msg("hello world")
 
-	// Output: {"level":"info","time":1199811905,"message":"hello world"}
+	// Output: {"level":"info","schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 // Example of a log at a particular "level" (in this case, "warn")
@@ -81,7 +81,7 @@ func ExampleWarn() {
 	setup()
 	log.Warn().Msg("hello world")
 
-	// Output: {"level":"warn","time":1199811905,"message":"hello world"}
+	// Output: {"level":"warn","schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 // Example of a log at a particular "level" (in this case, "error")
@@ -89,7 +89,7 @@ func ExampleError() {
 	setup()
 	log.Error().Msg("hello world")
 
-	// Output: {"level":"error","time":1199811905,"message":"hello world"}
+	// Output: {"level":"error","schema_version":2,"time":1199811905,"message":"hello world"}
 }
 
 // Example of a log at a particular "level" (in this case, "fatal")
@@ -103,7 +103,7 @@ func ExampleFatal() {
 		Str("service", service).
 		Msg("Cannot start")
 
-	// Outputs: {"level":"fatal","time":1199811905,"error":"a repo man spends his life getting into tense situations","service":"myservice","message":"Cannot start myservice"}
+	// Outputs: {"level":"fatal","schema_version":2,"time":1199811905,"error":"a repo man spends his life getting into tense situations","service":"myservice","message":"Cannot start myservice"}
 }
 
 // This example uses command-line flags to demonstrate various outputs
@@ -129,7 +129,7 @@ func Example() {
 		e.Str("foo", value).Msg("some debug message")
 	}
 
-	// Output: {"level":"info","time":1199811905,"message":"This message appears when log level set to Debug or Info"}
+	// Output: {"level":"info","schema_version":2,"time":1199811905,"message":"This message appears when log level set to Debug or Info"}
 }
 
 func ExampleSetLevel() {
@@ -150,8 +150,8 @@ func ExampleSetLevel() {
 	log.Debug().Msg("Debug")
 
 	// Output:
-	// {"level":"info","time":1199811905,"message":"Debug or Info"}
-	// {"level":"warn","time":1199811905,"message":"Debug or Info or Warn"}
-	// {"level":"error","time":1199811905,"message":"Debug or Info or Warn or Error"}
-	// {"level":"debug","time":1199811905,"message":"Debug"}
+	// {"level":"info","schema_version":2,"time":1199811905,"message":"Debug or Info"}
+	// {"level":"warn","schema_version":2,"time":1199811905,"message":"Debug or Info or Warn"}
+	// {"level":"error","schema_version":2,"time":1199811905,"message":"Debug or Info or Warn or Error"}
+	// {"level":"debug","schema_version":2,"time":1199811905,"message":"Debug"}
 }
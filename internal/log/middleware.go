@@ -26,14 +26,14 @@ func NewHandler(getLogger func() *zerolog.Logger) func(http.Handler) http.Handle
 	}
 }
 
-// URLHandler adds the requested URL as a field to the context's logger
-// using fieldKey as field key.
+// URLHandler adds the requested URL, with sensitive query parameters
+// redacted, as a field to the context's logger using fieldKey as field key.
 func URLHandler(fieldKey string) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			log := zerolog.Ctx(r.Context())
 			log.UpdateContext(func(c zerolog.Context) zerolog.Context {
-				return c.Str(fieldKey, r.URL.String())
+				return c.Str(fieldKey, RedactURL(r.URL.String()))
 			})
 			next.ServeHTTP(w, r)
 		})
@@ -54,14 +54,15 @@ func MethodHandler(fieldKey string) func(next http.Handler) http.Handler {
 	}
 }
 
-// RequestHandler adds the request method and URL as a field to the context's logger
-// using fieldKey as field key.
+// RequestHandler adds the request method and URL, with sensitive query
+// parameters redacted, as a field to the context's logger using fieldKey as
+// field key.
 func RequestHandler(fieldKey string) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			log := zerolog.Ctx(r.Context())
 			log.UpdateContext(func(c zerolog.Context) zerolog.Context {
-				return c.Str(fieldKey, r.Method+" "+r.URL.String())
+				return c.Str(fieldKey, r.Method+" "+RedactURL(r.URL.String()))
 			})
 			next.ServeHTTP(w, r)
 		})
@@ -108,7 +109,7 @@ func RefererHandler(fieldKey string) func(next http.Handler) http.Handler {
 			if ref := r.Header.Get("Referer"); ref != "" {
 				log := zerolog.Ctx(r.Context())
 				log.UpdateContext(func(c zerolog.Context) zerolog.Context {
-					return c.Str(fieldKey, ref)
+					return c.Str(fieldKey, RedactURL(ref))
 				})
 			}
 			next.ServeHTTP(w, r)
@@ -125,7 +126,11 @@ func RequestIDHandler(fieldKey string) func(next http.Handler) http.Handler {
 			if requestID != "" {
 				log := zerolog.Ctx(r.Context())
 				log.UpdateContext(func(c zerolog.Context) zerolog.Context {
-					return c.Str(fieldKey, requestID)
+					c = c.Str(fieldKey, requestID)
+					if name, ok := legacyFieldName(fieldKey); ok {
+						c = c.Str(name, requestID)
+					}
+					return c
 				})
 			}
 			next.ServeHTTP(w, r)
@@ -146,6 +151,7 @@ func AccessHandler(f func(r *http.Request, status, size int, duration time.Durat
 }
 
 // HeadersHandler adds the provided set of header keys to the log context.
+// The values of sensitive headers are redacted.
 //
 // https://tools.ietf.org/html/rfc7239
 // https://en.wikipedia.org/wiki/X-Forwarded-For
@@ -155,6 +161,9 @@ func HeadersHandler(headers []string) func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			for _, key := range headers {
 				if values := r.Header[key]; len(values) != 0 {
+					if IsRedactedHeader(key) {
+						values = []string{Redacted}
+					}
 					log := zerolog.Ctx(r.Context())
 					log.UpdateContext(func(c zerolog.Context) zerolog.Context {
 						return c.Strs(key, values)
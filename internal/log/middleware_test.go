@@ -70,6 +70,23 @@ func TestURLHandler(t *testing.T) {
 	}
 }
 
+func TestURLHandler_Redacted(t *testing.T) {
+	out := &bytes.Buffer{}
+	r := &http.Request{
+		URL: &url.URL{Path: "/path", RawQuery: "foo=bar&access_token=SECRET"},
+	}
+	h := URLHandler("url")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := FromRequest(r)
+		l.Log().Msg("")
+	}))
+	log := zerolog.New(out)
+	h = NewHandler(func() *zerolog.Logger { return &log })(h)
+	h.ServeHTTP(nil, r)
+	if want, got := `{"url":"/path?foo=bar&access_token=REDACTED"}`+"\n", decodeIfBinary(out); want != got {
+		t.Errorf("Invalid log output, got: %s, want: %s", got, want)
+	}
+}
+
 func TestMethodHandler(t *testing.T) {
 	out := &bytes.Buffer{}
 	r := &http.Request{
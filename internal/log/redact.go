@@ -0,0 +1,139 @@
+package log
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces the values of sensitive query parameters and headers.
+const Redacted = "REDACTED"
+
+var (
+	// DefaultRedactedQueryParams are the query parameters which are always
+	// redacted, because they're used to pass credentials.
+	DefaultRedactedQueryParams = []string{
+		"access_token",
+		"client_secret",
+		"code",
+		"device_code",
+		"id_token",
+		"password",
+		"refresh_token",
+		"token",
+		"pomerium_device_user_code",
+		"pomerium_impersonate_grant",
+		"pomerium_jwt",
+		"pomerium_kiosk_user_code",
+		"pomerium_programmatic_token",
+		"pomerium_refresh_token",
+		"pomerium_session",
+		"pomerium_session_encrypted",
+		"pomerium_signature",
+	}
+	// DefaultRedactedHeaders are the headers which are always redacted.
+	DefaultRedactedHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Proxy-Authorization",
+		"Set-Cookie",
+		"X-Pomerium-Authorization",
+		"X-Pomerium-Jwt-Assertion",
+	}
+)
+
+var redaction = struct {
+	sync.RWMutex
+	queryParams []string
+	headers     []string
+}{
+	queryParams: lowerAll(DefaultRedactedQueryParams),
+	headers:     lowerAll(DefaultRedactedHeaders),
+}
+
+// SetRedaction sets the patterns of the query parameters and headers which
+// are redacted in addition to the defaults. Patterns are matched case
+// insensitively, and may contain path.Match wildcards, e.g. *token*.
+func SetRedaction(queryParams, headers []string) {
+	redaction.Lock()
+	defer redaction.Unlock()
+	redaction.queryParams = lowerAll(append(append([]string{}, DefaultRedactedQueryParams...), queryParams...))
+	redaction.headers = lowerAll(append(append([]string{}, DefaultRedactedHeaders...), headers...))
+}
+
+// IsRedactedQueryParam returns true if the values of the query parameter
+// should be redacted.
+func IsRedactedQueryParam(name string) bool {
+	redaction.RLock()
+	defer redaction.RUnlock()
+	return matchAny(redaction.queryParams, name)
+}
+
+// IsRedactedHeader returns true if the values of the header should be
+// redacted.
+func IsRedactedHeader(name string) bool {
+	redaction.RLock()
+	defer redaction.RUnlock()
+	return matchAny(redaction.headers, name)
+}
+
+// RedactURL redacts the values of sensitive query parameters in a URL, or in
+// a path with a query. Everything else is returned as is.
+func RedactURL(rawURL string) string {
+	idx := strings.IndexByte(rawURL, '?')
+	if idx < 0 {
+		return rawURL
+	}
+	prefix, query, fragment := rawURL[:idx+1], rawURL[idx+1:], ""
+	if idx := strings.IndexByte(query, '#'); idx >= 0 {
+		query, fragment = query[:idx], query[idx:]
+	}
+
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		rawName := param
+		if idx := strings.IndexByte(param, '='); idx >= 0 {
+			rawName = param[:idx]
+		}
+		name, err := url.QueryUnescape(rawName)
+		if err != nil {
+			name = rawName
+		}
+		if IsRedactedQueryParam(name) {
+			params[i] = rawName + "=" + Redacted
+		}
+	}
+	return prefix + strings.Join(params, "&") + fragment
+}
+
+// RedactHeaders returns a copy of headers with the values of sensitive
+// headers redacted.
+func RedactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if IsRedactedHeader(k) {
+			v = Redacted
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+func matchAny(patterns []string, name string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func lowerAll(ss []string) []string {
+	lower := make([]string, len(ss))
+	for i, s := range ss {
+		lower[i] = strings.ToLower(s)
+	}
+	return lower
+}
@@ -0,0 +1,55 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRedactURL(t *testing.T) {
+	defer SetRedaction(nil, nil)
+	SetRedaction([]string{"*_key", "Session"}, nil)
+
+	tests := []struct {
+		in, want string
+	}{
+		{"https://example.com/path", "https://example.com/path"},
+		{"/path?foo=bar", "/path?foo=bar"},
+		{"/path?token=SECRET&foo=bar", "/path?token=REDACTED&foo=bar"},
+		{"/path?foo=bar&ACCESS_TOKEN=SECRET#frag", "/path?foo=bar&ACCESS_TOKEN=REDACTED#frag"},
+		{"https://example.com/?api_key=SECRET&session=SECRET", "https://example.com/?api_key=REDACTED&session=REDACTED"},
+		{"/path?access%5Ftoken=SECRET&token", "/path?access%5Ftoken=REDACTED&token=REDACTED"},
+		{"/path?code=a&code=b", "/path?code=REDACTED&code=REDACTED"},
+	}
+	for _, tt := range tests {
+		if got := RedactURL(tt.in); got != tt.want {
+			t.Errorf("RedactURL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	defer SetRedaction(nil, nil)
+	SetRedaction(nil, []string{"x-api-*"})
+
+	got := RedactHeaders(map[string]string{
+		"Accept":        "text/html",
+		"authorization": "Bearer SECRET",
+		"Cookie":        "_pomerium=SECRET",
+		"X-Api-Key":     "SECRET",
+	})
+	want := map[string]string{
+		"Accept":        "text/html",
+		"authorization": "REDACTED",
+		"Cookie":        "REDACTED",
+		"X-Api-Key":     "REDACTED",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RedactHeaders() = %s", diff)
+	}
+
+	SetRedaction(nil, nil)
+	if IsRedactedHeader("X-Api-Key") {
+		t.Error("IsRedactedHeader() should reset to the defaults")
+	}
+}
@@ -0,0 +1,80 @@
+package log
+
+import (
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+// SchemaVersion is the version of the schema of structured logs and audit
+// events, which is included in every record as the schema_version field. It's
+// incremented whenever fields are renamed or removed.
+//
+// Version 2 renamed the hyphenated fields of version 1 to snake case, e.g.
+// request-id to request_id.
+const SchemaVersion = 2
+
+// SchemaVersionField is the field the schema version is logged as.
+const SchemaVersionField = "schema_version"
+
+// legacyFieldNames are the names of renamed fields in the previous schema
+// version, by their current name.
+var legacyFieldNames = map[string]string{
+	"approved_by":           "approved-by",
+	"check_request_id":      "check-request-id",
+	"deny_reason":           "deny-reason",
+	"forwarded_for":         "forwarded-for",
+	"grant_expires_at":      "grant-expires-at",
+	"impersonate_email":     "impersonate-email",
+	"impersonate_groups":    "impersonate-groups",
+	"impersonation_error":   "impersonation-error",
+	"request_id":            "request-id",
+	"response_code":         "response-code",
+	"response_code_details": "response-code-details",
+	"session_id":            "session-id",
+	"upstream_cluster":      "upstream-cluster",
+	"user_agent":            "user-agent",
+	"user_id":               "user-id",
+}
+
+var legacyFields int32
+
+// SetLegacyFieldNames sets whether renamed fields are also logged with their
+// names in the previous schema version, so log pipelines can be migrated
+// after upgrading. It will be removed in the next release.
+func SetLegacyFieldNames(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&legacyFields, v)
+}
+
+func legacyFieldName(key string) (string, bool) {
+	if atomic.LoadInt32(&legacyFields) == 0 {
+		return "", false
+	}
+	name, ok := legacyFieldNames[key]
+	return name, ok
+}
+
+// WithLegacyFields adds the renamed fields of fields with their previous
+// names, if legacy field names are enabled, and returns fields.
+func WithLegacyFields(fields map[string]interface{}) map[string]interface{} {
+	for key, value := range fields {
+		if name, ok := legacyFieldName(key); ok {
+			fields[name] = value
+		}
+	}
+	return fields
+}
+
+// Str adds a string field to an event, and with its previous name, if it was
+// renamed and legacy field names are enabled.
+func Str(evt *zerolog.Event, key, value string) *zerolog.Event {
+	evt = evt.Str(key, value)
+	if name, ok := legacyFieldName(key); ok {
+		evt = evt.Str(name, value)
+	}
+	return evt
+}
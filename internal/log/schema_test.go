@@ -0,0 +1,49 @@
+package log
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/internal/telemetry/requestid"
+)
+
+func TestLegacyFieldNames(t *testing.T) {
+	defer SetLegacyFieldNames(false)
+
+	fields := func() map[string]interface{} {
+		return map[string]interface{}{"request_id": "1234", "path": "/"}
+	}
+	assert.Equal(t, fields(), WithLegacyFields(fields()))
+
+	var out bytes.Buffer
+	l := zerolog.New(&out)
+	Str(l.Log(), "request_id", "1234").Msg("")
+	assert.Equal(t, `{"request_id":"1234"}`+"\n", out.String())
+
+	SetLegacyFieldNames(true)
+	assert.Equal(t, map[string]interface{}{
+		"request_id": "1234",
+		"request-id": "1234",
+		"path":       "/",
+	}, WithLegacyFields(fields()))
+
+	out.Reset()
+	Str(l.Log(), "request_id", "1234").Msg("")
+	assert.Equal(t, `{"request_id":"1234","request-id":"1234"}`+"\n", out.String())
+
+	out.Reset()
+	h := RequestIDHandler("request_id")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		FromRequest(r).Log().Msg("")
+	}))
+	h = NewHandler(func() *zerolog.Logger { return &l })(h)
+	h = requestid.HTTPMiddleware()(h)
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Request-Id", "1234")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, `{"request_id":"1234","request-id":"1234"}`+"\n", out.String())
+}
@@ -0,0 +1,48 @@
+package logarchive
+
+import (
+	"sync"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+var current struct {
+	sync.RWMutex
+	a *Archiver
+}
+
+// SetArchiver sets the archiver used by Archive and returns the previous one.
+// A nil archiver disables archiving.
+func SetArchiver(a *Archiver) *Archiver {
+	current.Lock()
+	defer current.Unlock()
+	prev := current.a
+	current.a = a
+	return prev
+}
+
+// Archive archives a record with the current archiver, if archiving is
+// enabled.
+func Archive(kind, route string, record interface{}) {
+	current.RLock()
+	defer current.RUnlock()
+	if current.a == nil {
+		return
+	}
+	if err := current.a.Archive(kind, route, record); err != nil {
+		log.Error().Err(err).Str("kind", kind).Msg("logarchive: failed to archive record")
+	}
+}
+
+// ArchiveJSON archives a record which is already encoded as JSON with the
+// current archiver, if archiving is enabled.
+func ArchiveJSON(kind, route string, record []byte) {
+	current.RLock()
+	defer current.RUnlock()
+	if current.a == nil {
+		return
+	}
+	if err := current.a.ArchiveJSON(kind, route, record); err != nil {
+		log.Error().Err(err).Str("kind", kind).Msg("logarchive: failed to archive record")
+	}
+}
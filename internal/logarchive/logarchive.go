@@ -0,0 +1,391 @@
+// Package logarchive archives access and audit logs to object storage for
+// long-term retention.
+//
+// Records are appended to segment files in a local spool directory, one per
+// partition, as soon as they're logged. Segments are sealed into gzip
+// compressed, newline delimited JSON files once they're full or old enough,
+// and removed from the spool once uploaded. Segments left in the spool, e.g.
+// after a crash or while object storage is unavailable, are uploaded when the
+// archiver runs again, so every record is delivered at least once.
+package logarchive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+// Kinds of logs which are archived.
+const (
+	KindAccess = "access"
+	KindAudit  = "audit"
+)
+
+const (
+	openDir  = "open"
+	readyDir = "ready"
+	// openExt is the extension of segments still being written to. The
+	// object key of a segment is its path in the spool without the extension.
+	openExt = ".ndjson"
+	// readyExt is the extension of sealed segments, and of the archived
+	// objects.
+	readyExt = ".ndjson.gz"
+)
+
+// DefaultFlushInterval is the default maximum time records are spooled before
+// they're uploaded.
+const DefaultFlushInterval = 5 * time.Minute
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
+// Options are the options of an Archiver.
+type Options struct {
+	// URL is the object storage URL, i.e. s3://bucket/prefix or
+	// gs://bucket/prefix.
+	URL         string
+	Credentials Credentials
+	// SpoolDir is the directory records are written to until they're
+	// uploaded.
+	SpoolDir string
+	// MaxRecords is the maximum number of records in an archived object.
+	MaxRecords int
+	// FlushInterval is the maximum time records are spooled before they're
+	// uploaded.
+	FlushInterval time.Duration
+}
+
+// An Archiver batches, compresses and uploads logs to object storage.
+type Archiver struct {
+	store    objectStore
+	prefix   string
+	spoolDir string
+	hostname string
+
+	maxRecords    int
+	flushInterval time.Duration
+	seal          chan struct{}
+
+	mu       sync.Mutex
+	segments map[partition]*segment
+}
+
+// A partition is a set of records which are archived together.
+type partition struct {
+	kind  string
+	date  string
+	route string
+}
+
+// A segment is a spool file records are appended to.
+type segment struct {
+	f       *os.File
+	key     string
+	records int
+	created time.Time
+}
+
+// New creates a new Archiver.
+func New(options Options) (*Archiver, error) {
+	u, err := url.Parse(options.URL)
+	if err != nil {
+		return nil, errors.New("logarchive: invalid url")
+	}
+	store, prefix, err := newObjectStore(u, options.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("logarchive: %w", err)
+	}
+	return newArchiver(store, prefix, options)
+}
+
+func newArchiver(store objectStore, prefix string, options Options) (*Archiver, error) {
+	if options.SpoolDir == "" {
+		return nil, errors.New("logarchive: missing spool directory")
+	}
+	for _, dir := range []string{openDir, readyDir} {
+		if err := os.MkdirAll(filepath.Join(options.SpoolDir, dir), 0700); err != nil {
+			return nil, fmt.Errorf("logarchive: error creating spool directory: %w", err)
+		}
+	}
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "pomerium"
+	}
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = DefaultFlushInterval
+	}
+
+	a := &Archiver{
+		store:         store,
+		prefix:        prefix,
+		spoolDir:      options.SpoolDir,
+		hostname:      sanitizePathSegment(hostname),
+		maxRecords:    options.MaxRecords,
+		flushInterval: options.FlushInterval,
+		seal:          make(chan struct{}, 1),
+		segments:      make(map[partition]*segment),
+	}
+	return a, nil
+}
+
+// Archive appends a record, which must marshal to JSON, to the spool. Records
+// are partitioned by kind, date and route. The route may be empty.
+func (a *Archiver) Archive(kind, route string, record interface{}) error {
+	bs, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("logarchive: error marshaling record: %w", err)
+	}
+	return a.ArchiveJSON(kind, route, bs)
+}
+
+// ArchiveJSON appends a record which is already encoded as JSON to the spool.
+func (a *Archiver) ArchiveJSON(kind, route string, record []byte) error {
+	now := timeNow().UTC()
+	p := partition{
+		kind:  sanitizePathSegment(kind),
+		date:  now.Format("2006-01-02"),
+		route: sanitizePathSegment(route),
+	}
+	line := append(bytes.TrimSpace(record), '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seg, ok := a.segments[p]
+	if !ok {
+		var err error
+		seg, err = a.openSegment(p, now)
+		if err != nil {
+			return err
+		}
+		a.segments[p] = seg
+	}
+	return a.appendRecord(p, seg, line)
+}
+
+func (a *Archiver) appendRecord(p partition, seg *segment, line []byte) error {
+	if _, err := seg.f.Write(line); err != nil {
+		return fmt.Errorf("logarchive: error writing record: %w", err)
+	}
+	seg.records++
+	if a.maxRecords > 0 && seg.records >= a.maxRecords {
+		delete(a.segments, p)
+		if err := seg.f.Close(); err != nil {
+			log.Warn().Err(err).Msg("logarchive: error closing segment")
+		}
+		select {
+		case a.seal <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// openSegment creates a new segment for a partition. The lock must be held.
+func (a *Archiver) openSegment(p partition, now time.Time) (*segment, error) {
+	var id [4]byte
+	_, _ = rand.Read(id[:])
+	// the timestamp is first so objects sort by time within a partition
+	name := fmt.Sprintf("%s-%s-%s", now.Format("20060102T150405Z"), a.hostname, hex.EncodeToString(id[:]))
+
+	key := path.Join(p.kind, "date="+p.date)
+	if p.route != "" {
+		key = path.Join(key, "route="+p.route)
+	}
+	key = path.Join(key, name)
+
+	fp := filepath.Join(a.spoolDir, openDir, filepath.FromSlash(key)+openExt)
+	if err := os.MkdirAll(filepath.Dir(fp), 0700); err != nil {
+		return nil, fmt.Errorf("logarchive: error creating spool directory: %w", err)
+	}
+	f, err := os.OpenFile(fp, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("logarchive: error creating segment: %w", err)
+	}
+	return &segment{f: f, key: key, created: now}, nil
+}
+
+// Run seals and uploads segments until the context is canceled. Segments
+// left in the spool by a previous run are uploaded first.
+func (a *Archiver) Run(ctx context.Context) error {
+	// check for old segments more often than the flush interval, so they're
+	// not kept for up to twice as long
+	ticker := time.NewTicker(a.flushInterval / 4)
+	defer ticker.Stop()
+
+	for {
+		a.closeSegments(false)
+		a.Flush(ctx)
+
+		select {
+		case <-ctx.Done():
+			a.closeSegments(true)
+			return ctx.Err()
+		case <-ticker.C:
+		case <-a.seal:
+		}
+	}
+}
+
+// closeSegments closes the segments which are older than the flush interval,
+// or all of them if all is true, so they're sealed by the next flush.
+func (a *Archiver) closeSegments(all bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := timeNow().UTC()
+	for p, seg := range a.segments {
+		if !all && now.Sub(seg.created) < a.flushInterval && now.Format("2006-01-02") == p.date {
+			continue
+		}
+		delete(a.segments, p)
+		if err := seg.f.Close(); err != nil {
+			log.Warn().Err(err).Msg("logarchive: error closing segment")
+		}
+	}
+}
+
+// Flush seals the closed segments in the spool and uploads them. Segments
+// which fail to upload are retried by the next flush.
+func (a *Archiver) Flush(ctx context.Context) {
+	for _, key := range a.closedSegments() {
+		if err := a.sealSegment(key); err != nil {
+			log.Error().Err(err).Str("key", key).Msg("logarchive: failed to seal segment")
+		}
+	}
+
+	for _, key := range a.readySegments() {
+		if err := a.upload(ctx, key); err != nil {
+			if ctx.Err() == nil {
+				log.Warn().Err(err).Str("key", key).Msg("logarchive: failed to upload logs")
+			}
+			return
+		}
+	}
+}
+
+// closedSegments returns the keys of the segments in the spool which aren't
+// open for writing.
+func (a *Archiver) closedSegments() []string {
+	// segments are created with the lock held, so any segment found in the
+	// spool which is still open is in the map by the time it's locked
+	spooled := a.spooled(openDir, openExt)
+
+	a.mu.Lock()
+	open := make(map[string]bool, len(a.segments))
+	for _, seg := range a.segments {
+		open[seg.key] = true
+	}
+	a.mu.Unlock()
+
+	var keys []string
+	for _, key := range spooled {
+		if !open[key] {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (a *Archiver) readySegments() []string {
+	return a.spooled(readyDir, readyExt)
+}
+
+// spooled returns the keys of the files in a spool directory with the given
+// extension.
+func (a *Archiver) spooled(dir, ext string) []string {
+	root := filepath.Join(a.spoolDir, dir)
+	var keys []string
+	_ = filepath.Walk(root, func(fp string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(fp, ext) {
+			return nil
+		}
+		rel, err := filepath.Rel(root, fp)
+		if err != nil {
+			return nil
+		}
+		keys = append(keys, strings.TrimSuffix(filepath.ToSlash(rel), ext))
+		return nil
+	})
+	return keys
+}
+
+// sealSegment compresses a closed segment so it's ready to upload.
+func (a *Archiver) sealSegment(key string) error {
+	src := filepath.Join(a.spoolDir, openDir, filepath.FromSlash(key)+openExt)
+	dst := filepath.Join(a.spoolDir, readyDir, filepath.FromSlash(key)+readyExt)
+
+	bs, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	// a crash may have left a partially written record at the end
+	if idx := bytes.LastIndexByte(bs, '\n'); idx+1 < len(bs) {
+		bs = bs[:idx+1]
+	}
+	if len(bs) == 0 {
+		return os.Remove(src)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(bs); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+	tmp := dst + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+// upload uploads a sealed segment and removes it from the spool.
+func (a *Archiver) upload(ctx context.Context, key string) error {
+	fp := filepath.Join(a.spoolDir, readyDir, filepath.FromSlash(key)+readyExt)
+	bs, err := ioutil.ReadFile(fp)
+	if err != nil {
+		return err
+	}
+	if err := a.store.Put(ctx, path.Join(a.prefix, key+readyExt), bs, "application/x-ndjson", "gzip"); err != nil {
+		return err
+	}
+	return os.Remove(fp)
+}
+
+// sanitizePathSegment replaces the characters of s which aren't safe in an
+// object key or file name.
+func sanitizePathSegment(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9',
+			r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, strings.Trim(s, "."))
+}
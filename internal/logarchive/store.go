@@ -0,0 +1,138 @@
+package logarchive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/sigv4"
+	"github.com/pomerium/pomerium/internal/version"
+)
+
+// storeUploadTimeout is the timeout of a single upload.
+const storeUploadTimeout = 5 * time.Minute
+
+// Credentials are the HMAC credentials used to sign object storage requests.
+type Credentials = sigv4.Credentials
+
+// An objectStore stores objects by key.
+type objectStore interface {
+	Put(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error
+}
+
+// An s3Store uploads objects with the S3 API, signing requests with AWS
+// signature version 4. Google Cloud Storage supports the same API with HMAC
+// keys, so it's used for both.
+type s3Store struct {
+	client    *http.Client
+	endpoint  *url.URL
+	bucket    string
+	pathStyle bool
+	region    string
+	creds     Credentials
+}
+
+// newObjectStore creates an objectStore for an archive URL, i.e.
+// s3://bucket/prefix or gs://bucket/prefix. The region and a custom endpoint
+// for S3 compatible storage can be set with the region and endpoint query
+// parameters. It returns the store and the key prefix.
+func newObjectStore(u *url.URL, creds Credentials) (objectStore, string, error) {
+	if u.Host == "" {
+		return nil, "", errors.New("missing bucket")
+	}
+	s := &s3Store{
+		client: &http.Client{Timeout: storeUploadTimeout},
+		bucket: u.Host,
+		region: u.Query().Get("region"),
+		creds:  creds,
+	}
+
+	endpoint := u.Query().Get("endpoint")
+	switch u.Scheme {
+	case "s3":
+		if s.creds.AccessKeyID == "" {
+			s.creds = sigv4.CredentialsFromEnv()
+		}
+		if s.region == "" {
+			s.region = os.Getenv("AWS_REGION")
+		}
+		if s.region == "" {
+			s.region = "us-east-1"
+		}
+		if endpoint == "" {
+			endpoint = "https://s3." + s.region + ".amazonaws.com"
+		} else {
+			// S3 compatible storage, like minio, doesn't support virtual
+			// hosted buckets by default
+			s.pathStyle = true
+		}
+	case "gs":
+		if s.region == "" {
+			s.region = "auto"
+		}
+		if endpoint == "" {
+			endpoint = "https://storage.googleapis.com"
+		}
+		s.pathStyle = true
+	default:
+		return nil, "", fmt.Errorf("unsupported scheme %q, must be s3 or gs", u.Scheme)
+	}
+	if s.creds.AccessKeyID == "" || s.creds.SecretAccessKey == "" {
+		return nil, "", errors.New("missing credentials")
+	}
+
+	var err error
+	s.endpoint, err = url.Parse(endpoint)
+	if err != nil || s.endpoint.Host == "" {
+		return nil, "", errors.New("invalid endpoint")
+	}
+	return s, strings.Trim(u.Path, "/"), nil
+}
+
+// Put uploads an object.
+func (s *s3Store) Put(ctx context.Context, key string, body []byte, contentType, contentEncoding string) error {
+	u := *s.endpoint
+	if s.pathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = "/" + key
+	}
+	// the path is sent exactly as it's signed
+	u.RawPath = sigv4.EscapePath(u.Path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	req.Header.Set("User-Agent", version.UserAgent())
+	s.sign(req, body, time.Now().UTC())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// sign signs a request with AWS signature version 4.
+func (s *s3Store) sign(req *http.Request, body []byte, now time.Time) {
+	sigv4.Sign(req, body, s.creds, s.region, "s3", now)
+}
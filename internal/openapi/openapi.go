@@ -0,0 +1,264 @@
+// Package openapi matches requests to the operations of OpenAPI documents, so
+// routes can be authorized per operation.
+//
+// Both OpenAPI 3 and Swagger 2.0 documents are supported, in JSON or YAML.
+// Only the paths, operation ids, servers and security requirements are read.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// An Operation is an operation of an OpenAPI document.
+type Operation struct {
+	// ID is the operation's operationId.
+	ID     string
+	Method string
+	// Path is the path template of the operation, e.g. /invoices/{id}.
+	Path string
+	// Scopes are the scopes of the operation's security requirements, or of
+	// the document's if the operation doesn't have its own.
+	Scopes []string
+}
+
+// A Spec matches requests to the operations of an OpenAPI document.
+type Spec struct {
+	basePaths []string
+	paths     []*pathTemplate
+}
+
+type pathTemplate struct {
+	template   string
+	re         *regexp.Regexp
+	params     int
+	operations map[string]*Operation
+}
+
+type document struct {
+	OpenAPI  string                `json:"openapi" yaml:"openapi"`
+	Swagger  string                `json:"swagger" yaml:"swagger"`
+	BasePath string                `json:"basePath" yaml:"basePath"`
+	Servers  []server              `json:"servers" yaml:"servers"`
+	Security []map[string][]string `json:"security" yaml:"security"`
+	Paths    map[string]pathItem   `json:"paths" yaml:"paths"`
+}
+
+type server struct {
+	URL       string `json:"url" yaml:"url"`
+	Variables map[string]struct {
+		Default string `json:"default" yaml:"default"`
+	} `json:"variables" yaml:"variables"`
+}
+
+type pathItem struct {
+	Get     *operation `json:"get" yaml:"get"`
+	Put     *operation `json:"put" yaml:"put"`
+	Post    *operation `json:"post" yaml:"post"`
+	Delete  *operation `json:"delete" yaml:"delete"`
+	Options *operation `json:"options" yaml:"options"`
+	Head    *operation `json:"head" yaml:"head"`
+	Patch   *operation `json:"patch" yaml:"patch"`
+	Trace   *operation `json:"trace" yaml:"trace"`
+}
+
+func (item *pathItem) operations() map[string]*operation {
+	return map[string]*operation{
+		"GET":     item.Get,
+		"PUT":     item.Put,
+		"POST":    item.Post,
+		"DELETE":  item.Delete,
+		"OPTIONS": item.Options,
+		"HEAD":    item.Head,
+		"PATCH":   item.Patch,
+		"TRACE":   item.Trace,
+	}
+}
+
+type operation struct {
+	OperationID string `json:"operationId" yaml:"operationId"`
+	// Security is nil if the operation doesn't override the document's
+	// security requirements.
+	Security *[]map[string][]string `json:"security" yaml:"security"`
+}
+
+// ReadFile reads an OpenAPI document from a file.
+func ReadFile(path string) (*Spec, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+	return Parse(bs)
+}
+
+// Parse parses an OpenAPI document.
+func Parse(data []byte) (*Spec, error) {
+	var doc document
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		err = json.Unmarshal(data, &doc)
+	} else {
+		err = yaml.Unmarshal(data, &doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("openapi: invalid document: %w", err)
+	}
+
+	s := new(Spec)
+	switch {
+	case strings.HasPrefix(doc.OpenAPI, "3."):
+		for _, srv := range doc.Servers {
+			basePath, err := getServerBasePath(srv)
+			if err != nil {
+				return nil, err
+			}
+			s.basePaths = append(s.basePaths, basePath)
+		}
+	case doc.Swagger == "2.0":
+		s.basePaths = append(s.basePaths, strings.TrimSuffix(doc.BasePath, "/"))
+	default:
+		return nil, errors.New("openapi: unsupported document, expected openapi 3.x or swagger 2.0")
+	}
+	if len(s.basePaths) == 0 {
+		s.basePaths = []string{""}
+	}
+	// longer base paths are stripped first
+	sort.Slice(s.basePaths, func(i, j int) bool {
+		return len(s.basePaths[i]) > len(s.basePaths[j])
+	})
+
+	for template, item := range doc.Paths {
+		pt, err := newPathTemplate(template)
+		if err != nil {
+			return nil, err
+		}
+		for method, op := range item.operations() {
+			if op == nil {
+				continue
+			}
+			security := doc.Security
+			if op.Security != nil {
+				security = *op.Security
+			}
+			pt.operations[method] = &Operation{
+				ID:     op.OperationID,
+				Method: method,
+				Path:   template,
+				Scopes: getScopes(security),
+			}
+		}
+		s.paths = append(s.paths, pt)
+	}
+	// concrete paths are matched before templated ones
+	sort.Slice(s.paths, func(i, j int) bool {
+		a, b := s.paths[i], s.paths[j]
+		if a.params != b.params {
+			return a.params < b.params
+		}
+		if len(a.template) != len(b.template) {
+			return len(a.template) > len(b.template)
+		}
+		return a.template < b.template
+	})
+	return s, nil
+}
+
+// Match returns the operation of a request's method and path, or nil if there
+// isn't one. The path is the escaped path of the request, including the base
+// path of one of the document's servers.
+func (s *Spec) Match(method, path string) *Operation {
+	if s == nil {
+		return nil
+	}
+	method = strings.ToUpper(method)
+	for _, basePath := range s.basePaths {
+		if !strings.HasPrefix(path, basePath) {
+			continue
+		}
+		rest := path[len(basePath):]
+		if !strings.HasPrefix(rest, "/") {
+			continue
+		}
+		for _, pt := range s.paths {
+			if pt.re.MatchString(rest) {
+				// the first matching path is used, even if it doesn't have
+				// an operation for the method
+				return pt.operations[method]
+			}
+		}
+	}
+	return nil
+}
+
+// paramRE matches the parameters of path templates, e.g. {id}.
+var paramRE = regexp.MustCompile(`\{[^{}/]+\}`)
+
+func newPathTemplate(template string) (*pathTemplate, error) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, fmt.Errorf("openapi: invalid path %q, must start with /", template)
+	}
+	var expr strings.Builder
+	expr.WriteString("^")
+	params := 0
+	last := 0
+	for _, loc := range paramRE.FindAllStringIndex(template, -1) {
+		expr.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		expr.WriteString("[^/]+")
+		last = loc[1]
+		params++
+	}
+	expr.WriteString(regexp.QuoteMeta(template[last:]))
+	expr.WriteString("$")
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, fmt.Errorf("openapi: invalid path %q: %w", template, err)
+	}
+	return &pathTemplate{
+		template:   template,
+		re:         re,
+		params:     params,
+		operations: make(map[string]*Operation),
+	}, nil
+}
+
+// getServerBasePath returns the path of a server URL, with its variables
+// replaced by their default values.
+func getServerBasePath(srv server) (string, error) {
+	rawURL := srv.URL
+	for name, v := range srv.Variables {
+		rawURL = strings.ReplaceAll(rawURL, "{"+name+"}", v.Default)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("openapi: invalid server url %q: %w", srv.URL, err)
+	}
+	return strings.TrimSuffix(u.EscapedPath(), "/"), nil
+}
+
+// getScopes returns the scopes of every security requirement, sorted and
+// without duplicates.
+func getScopes(security []map[string][]string) []string {
+	seen := make(map[string]bool)
+	var scopes []string
+	for _, requirement := range security {
+		for _, schemeScopes := range requirement {
+			for _, scope := range schemeScopes {
+				if !seen[scope] {
+					seen[scope] = true
+					scopes = append(scopes, scope)
+				}
+			}
+		}
+	}
+	sort.Strings(scopes)
+	return scopes
+}
@@ -0,0 +1,94 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDocument = `
+openapi: 3.0.3
+info:
+  title: Invoices
+  version: 1.0.0
+servers:
+  - url: https://api.example.com/{version}
+    variables:
+      version:
+        default: v1
+security:
+  - oauth2: [invoices.read]
+paths:
+  /invoices:
+    get:
+      operationId: listInvoices
+    post:
+      operationId: createInvoice
+      security:
+        - oauth2: [invoices.write]
+        - apiKey: []
+  /invoices/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+    get:
+      operationId: getInvoice
+    delete:
+      operationId: deleteInvoice
+      security: []
+  /invoices/summary:
+    get:
+      operationId: getInvoiceSummary
+  /invoices/{id}.pdf:
+    get:
+      operationId: getInvoicePDF
+`
+
+func TestSpec_Match(t *testing.T) {
+	s, err := Parse([]byte(testDocument))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		method, path string
+		expect       *Operation
+	}{
+		{"GET", "/v1/invoices", &Operation{ID: "listInvoices", Method: "GET", Path: "/invoices", Scopes: []string{"invoices.read"}}},
+		{"post", "/v1/invoices", &Operation{ID: "createInvoice", Method: "POST", Path: "/invoices", Scopes: []string{"invoices.write"}}},
+		{"GET", "/v1/invoices/123", &Operation{ID: "getInvoice", Method: "GET", Path: "/invoices/{id}", Scopes: []string{"invoices.read"}}},
+		{"DELETE", "/v1/invoices/123", &Operation{ID: "deleteInvoice", Method: "DELETE", Path: "/invoices/{id}"}},
+		{"GET", "/v1/invoices/summary", &Operation{ID: "getInvoiceSummary", Method: "GET", Path: "/invoices/summary", Scopes: []string{"invoices.read"}}},
+		{"GET", "/v1/invoices/123.pdf", &Operation{ID: "getInvoicePDF", Method: "GET", Path: "/invoices/{id}.pdf", Scopes: []string{"invoices.read"}}},
+		{"PUT", "/v1/invoices/123", nil},
+		{"GET", "/v1/invoices/123/items", nil},
+		{"GET", "/invoices", nil},
+		{"GET", "/v1invoices", nil},
+	} {
+		assert.Equal(t, tc.expect, s.Match(tc.method, tc.path), "%s %s", tc.method, tc.path)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s, err := Parse([]byte(`{
+	"swagger": "2.0",
+	"basePath": "/api/",
+	"paths": {"/users/{id}": {"get": {"operationId": "getUser"}}}
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, "getUser", s.Match("GET", "/api/users/1").ID)
+
+	s, err = Parse([]byte("openapi: 3.1.0\npaths:\n  /users:\n    get:\n      operationId: listUsers\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "listUsers", s.Match("GET", "/users").ID, "documents without servers should match from the root")
+
+	for _, doc := range []string{
+		"",
+		"swagger: '1.2'",
+		"openapi: 3.0.0\npaths:\n  users:\n    get: {}\n",
+		"{",
+	} {
+		_, err := Parse([]byte(doc))
+		assert.Error(t, err, doc)
+	}
+}
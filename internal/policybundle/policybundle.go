@@ -0,0 +1,209 @@
+// Package policybundle reads and writes signed policy bundles. A bundle is a
+// tar archive of the routes of a Pomerium configuration and a manifest with
+// its version. The archive is signed by the pipeline which builds it, and the
+// signature is stored next to it, so that only bundles which passed the
+// pipeline's checks are loaded.
+package policybundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"golang.org/x/mod/semver"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// The files of a bundle.
+const (
+	manifestFileName = "manifest.json"
+	policyFileName   = "policy.yaml"
+)
+
+// maxFileSize is the maximum size of a file in a bundle.
+const maxFileSize = 16 * 1024 * 1024
+
+// ErrInvalidSignature is returned when a bundle isn't signed by any of the
+// trusted keys.
+var ErrInvalidSignature = errors.New("policybundle: invalid signature")
+
+// ErrNotNewer is returned when a bundle would replace the active bundle with
+// one which isn't newer, such as an older signed bundle being rolled back to.
+var ErrNotNewer = errors.New("policybundle: bundle is not newer than the active bundle")
+
+// A Bundle is a versioned set of routes.
+type Bundle struct {
+	// Version identifies the bundle, e.g. a git commit or tag.
+	Version string
+	// CreatedAt is when the bundle was built.
+	CreatedAt time.Time
+	// Policy is the YAML list of routes, in the same format as the policy
+	// option.
+	Policy []byte
+}
+
+type manifest struct {
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SignatureFile returns the name of the signature file of a bundle file.
+func SignatureFile(bundleFile string) string {
+	return bundleFile + ".sig"
+}
+
+// Write writes a bundle as a tar archive.
+func Write(w io.Writer, b *Bundle) error {
+	if b.Version == "" {
+		return errors.New("policybundle: version is required")
+	}
+	manifestBytes, err := json.Marshal(manifest{Version: b.Version, CreatedAt: b.CreatedAt.UTC()})
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	for _, f := range []struct {
+		name string
+		data []byte
+	}{
+		{manifestFileName, manifestBytes},
+		{policyFileName, b.Policy},
+	} {
+		err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     f.name,
+			Mode:     0o644,
+			Size:     int64(len(f.data)),
+			ModTime:  b.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("policybundle: error writing %s: %w", f.name, err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return fmt.Errorf("policybundle: error writing %s: %w", f.name, err)
+		}
+	}
+	return tw.Close()
+}
+
+// Sign returns the base64-encoded signature of a bundle's archive.
+func Sign(archive []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	sig, err := cryptutil.Sign(archive, key)
+	if err != nil {
+		return nil, fmt.Errorf("policybundle: error signing bundle: %w", err)
+	}
+	return []byte(base64.StdEncoding.EncodeToString(sig) + "\n"), nil
+}
+
+// Verify checks that the base64-encoded signature of a bundle's archive was
+// made by one of the keys.
+func Verify(archive, signature []byte, keys []*ecdsa.PublicKey) error {
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	for _, key := range keys {
+		if len(sig) == 2*(key.Curve.Params().P.BitLen()/8) && cryptutil.Verify(archive, sig, key) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// ReadFile reads a bundle file, after verifying its signature file with the
+// keys.
+func ReadFile(bundleFile string, keys []*ecdsa.PublicKey) (*Bundle, error) {
+	archive, err := ioutil.ReadFile(bundleFile)
+	if err != nil {
+		return nil, fmt.Errorf("policybundle: error reading bundle: %w", err)
+	}
+	signature, err := ioutil.ReadFile(SignatureFile(bundleFile))
+	if err != nil {
+		return nil, fmt.Errorf("policybundle: error reading signature: %w", err)
+	}
+	if err := Verify(archive, signature, keys); err != nil {
+		return nil, err
+	}
+	return Read(archive)
+}
+
+// CheckNewer returns ErrNotNewer unless the bundle is the active bundle, or was
+// created after it with a newer version. Versions which are both semantic
+// versions are compared as such, and other versions, like git commits, must
+// differ.
+func CheckNewer(b, active *Bundle) error {
+	if active == nil || (b.Version == active.Version && b.CreatedAt.Equal(active.CreatedAt)) {
+		return nil
+	}
+	if !b.CreatedAt.After(active.CreatedAt) {
+		return fmt.Errorf("%w: %s was created at %s, before %s at %s", ErrNotNewer,
+			b.Version, b.CreatedAt.Format(time.RFC3339), active.Version, active.CreatedAt.Format(time.RFC3339))
+	}
+	if b.Version == active.Version ||
+		(semver.IsValid(b.Version) && semver.IsValid(active.Version) && semver.Compare(b.Version, active.Version) <= 0) {
+		return fmt.Errorf("%w: version %s isn't newer than %s", ErrNotNewer, b.Version, active.Version)
+	}
+	return nil
+}
+
+// Read reads a bundle's archive. The archive's signature must be verified
+// first.
+func Read(archive []byte) (*Bundle, error) {
+	files := map[string][]byte{}
+	tr := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("policybundle: invalid archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("policybundle: unexpected entry %s", hdr.Name)
+		}
+		if hdr.Name != manifestFileName && hdr.Name != policyFileName {
+			return nil, fmt.Errorf("policybundle: unexpected file %s", hdr.Name)
+		}
+		if _, ok := files[hdr.Name]; ok {
+			return nil, fmt.Errorf("policybundle: duplicate file %s", hdr.Name)
+		}
+		if hdr.Size > maxFileSize {
+			return nil, fmt.Errorf("policybundle: %s is too large", hdr.Name)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("policybundle: error reading %s: %w", hdr.Name, err)
+		}
+		files[hdr.Name] = data
+	}
+
+	manifestBytes, ok := files[manifestFileName]
+	if !ok {
+		return nil, fmt.Errorf("policybundle: missing %s", manifestFileName)
+	}
+	var m manifest
+	if err := json.Unmarshal(manifestBytes, &m); err != nil {
+		return nil, fmt.Errorf("policybundle: invalid manifest: %w", err)
+	}
+	if m.Version == "" {
+		return nil, errors.New("policybundle: manifest is missing the version")
+	}
+	policy, ok := files[policyFileName]
+	if !ok {
+		return nil, fmt.Errorf("policybundle: missing %s", policyFileName)
+	}
+	return &Bundle{
+		Version:   m.Version,
+		CreatedAt: m.CreatedAt,
+		Policy:    policy,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package policybundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func TestReadFile(t *testing.T) {
+	key, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+	otherKey, err := cryptutil.NewSigningKey()
+	require.NoError(t, err)
+
+	createdAt := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, &Bundle{
+		Version:   "v1.2.3",
+		CreatedAt: createdAt,
+		Policy:    []byte("- from: https://from.example.com\n  to: https://to.example.com\n"),
+	}))
+	archive := buf.Bytes()
+	signature, err := Sign(archive, key)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "policybundle")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bundleFile := filepath.Join(dir, "bundle.tar")
+	require.NoError(t, ioutil.WriteFile(bundleFile, archive, 0o600))
+	require.NoError(t, ioutil.WriteFile(SignatureFile(bundleFile), signature, 0o600))
+
+	b, err := ReadFile(bundleFile, []*ecdsa.PublicKey{&otherKey.PublicKey, &key.PublicKey})
+	require.NoError(t, err)
+	assert.Equal(t, &Bundle{
+		Version:   "v1.2.3",
+		CreatedAt: createdAt,
+		Policy:    []byte("- from: https://from.example.com\n  to: https://to.example.com\n"),
+	}, b)
+
+	_, err = ReadFile(bundleFile, []*ecdsa.PublicKey{&otherKey.PublicKey})
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	tampered := append([]byte{}, archive...)
+	tampered[len(tampered)/4] ^= 1
+	require.NoError(t, ioutil.WriteFile(bundleFile, tampered, 0o600))
+	_, err = ReadFile(bundleFile, []*ecdsa.PublicKey{&key.PublicKey})
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	require.NoError(t, os.Remove(SignatureFile(bundleFile)))
+	_, err = ReadFile(bundleFile, []*ecdsa.PublicKey{&key.PublicKey})
+	assert.Error(t, err)
+}
+
+func TestRead(t *testing.T) {
+	archive := func(files ...string) []byte {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for i := 0; i < len(files); i += 2 {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: files[i], Size: int64(len(files[i+1]))}))
+			_, err := tw.Write([]byte(files[i+1]))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name    string
+		archive []byte
+		wantErr bool
+	}{
+		{"good", archive("manifest.json", `{"version":"v1"}`, "policy.yaml", "[]"), false},
+		{"missing manifest", archive("policy.yaml", "[]"), true},
+		{"missing policy", archive("manifest.json", `{"version":"v1"}`), true},
+		{"missing version", archive("manifest.json", `{}`, "policy.yaml", "[]"), true},
+		{"invalid manifest", archive("manifest.json", `{`, "policy.yaml", "[]"), true},
+		{"unexpected file", archive("manifest.json", `{"version":"v1"}`, "policy.yaml", "[]", "../etc/passwd", ""), true},
+		{"duplicate file", archive("manifest.json", `{"version":"v1"}`, "policy.yaml", "[]", "policy.yaml", "[]"), true},
+		{"not an archive", []byte("not an archive"), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(tt.archive)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckNewer(t *testing.T) {
+	createdAt := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	active := &Bundle{Version: "v1.2.3", CreatedAt: createdAt}
+
+	tests := []struct {
+		name   string
+		bundle *Bundle
+		active *Bundle
+		err    error
+	}{
+		{"no active bundle", &Bundle{Version: "v1.0.0", CreatedAt: createdAt}, nil, nil},
+		{"same bundle", &Bundle{Version: "v1.2.3", CreatedAt: createdAt}, active, nil},
+		{"newer", &Bundle{Version: "v1.2.4", CreatedAt: createdAt.Add(time.Minute)}, active, nil},
+		{"older created at", &Bundle{Version: "v1.2.4", CreatedAt: createdAt.Add(-time.Minute)}, active, ErrNotNewer},
+		{"same created at", &Bundle{Version: "v1.2.4", CreatedAt: createdAt}, active, ErrNotNewer},
+		{"older version", &Bundle{Version: "v1.2.2", CreatedAt: createdAt.Add(time.Minute)}, active, ErrNotNewer},
+		{"same version", &Bundle{Version: "v1.2.3", CreatedAt: createdAt.Add(time.Minute)}, active, ErrNotNewer},
+		{"commit", &Bundle{Version: "b7c9d1e", CreatedAt: createdAt.Add(time.Minute)}, &Bundle{Version: "a1f3e2d", CreatedAt: createdAt}, nil},
+		{"same commit", &Bundle{Version: "a1f3e2d", CreatedAt: createdAt.Add(time.Minute)}, &Bundle{Version: "a1f3e2d", CreatedAt: createdAt}, ErrNotNewer},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckNewer(tt.bundle, tt.active)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, tt.err), "expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestWrite_missingVersion(t *testing.T) {
+	assert.Error(t, Write(ioutil.Discard, &Bundle{Policy: []byte("[]")}))
+}
@@ -0,0 +1,331 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+)
+
+// memberFilterRegexp matches the paths of patch operations which remove a
+// single member, e.g. members[value eq "USER_ID"].
+var memberFilterRegexp = regexp.MustCompile(`^(?i:members)\[(.*)\]$`)
+
+// toGroup returns the SCIM group of a directory group and its members.
+func (h *Handler) toGroup(r *http.Request, dg *directory.Group, members []*directory.User) *Group {
+	g := &Group{
+		Schemas:     []string{schemaGroup},
+		ID:          dg.GetId(),
+		ExternalID:  dg.GetId(),
+		DisplayName: dg.GetName(),
+		Meta:        &Meta{ResourceType: "Group", Location: h.location(r, "Groups", dg.GetId())},
+	}
+	for _, du := range members {
+		id, _ := h.userID(du)
+		g.Members = append(g.Members, Member{
+			Value:   id,
+			Display: du.GetEmail(),
+			Ref:     h.location(r, "Users", id),
+		})
+	}
+	return g
+}
+
+// membersByGroupID returns the members of every group.
+func (h *Handler) membersByGroupID(ctx context.Context) (map[string][]*directory.User, error) {
+	users, err := h.loadUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members := map[string][]*directory.User{}
+	for _, du := range users {
+		for _, groupID := range du.GetGroupIds() {
+			members[groupID] = append(members[groupID], du)
+		}
+	}
+	return members, nil
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) error {
+	f, err := parseFilter(r.FormValue("filter"), "id", "externalId", "displayName")
+	if err != nil {
+		return err
+	}
+	groups, err := h.loadGroups(r.Context())
+	if err != nil {
+		return err
+	}
+	members, err := h.membersByGroupID(r.Context())
+	if err != nil {
+		return err
+	}
+
+	var resources []interface{}
+	for _, dg := range groups {
+		if f != nil {
+			switch f.attribute {
+			case "id", "externalId":
+				if dg.GetId() != f.value {
+					continue
+				}
+			case "displayName":
+				if !strings.EqualFold(dg.GetName(), f.value) {
+					continue
+				}
+			}
+		}
+		resources = append(resources, h.toGroup(r, dg, members[dg.GetId()]))
+	}
+	res, err := page(r, resources)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, res)
+	return nil
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) error {
+	dg, err := h.loadGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	return h.writeGroup(w, r, http.StatusOK, dg)
+}
+
+// createGroup creates a directory group. Its id is its external id, or a
+// random id if it has none.
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) error {
+	var g Group
+	if err := readJSON(r, &g); err != nil {
+		return err
+	}
+	if g.DisplayName == "" {
+		return newError(http.StatusBadRequest, errorTypeInvalidValue, "displayName is required")
+	}
+	id := g.ExternalID
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	if ok, err := h.exists(r.Context(), directoryGroupTypeURL, id); err != nil {
+		return err
+	} else if ok {
+		return newError(http.StatusConflict, errorTypeUniqueness, "group %q already exists", id)
+	}
+	dg := &directory.Group{Id: id, Name: g.DisplayName}
+	if err := h.store(r.Context(), id, dg); err != nil {
+		return err
+	}
+	if err := h.setMembers(r.Context(), id, memberIDs(g.Members)); err != nil {
+		return err
+	}
+
+	w.Header().Set("Location", h.location(r, "Groups", id))
+	return h.writeGroup(w, r, http.StatusCreated, dg)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) error {
+	var g Group
+	if err := readJSON(r, &g); err != nil {
+		return err
+	}
+	if g.DisplayName == "" {
+		return newError(http.StatusBadRequest, errorTypeInvalidValue, "displayName is required")
+	}
+	dg, err := h.loadGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	dg.Name = g.DisplayName
+	if err := h.store(r.Context(), dg.GetId(), dg); err != nil {
+		return err
+	}
+	if err := h.setMembers(r.Context(), dg.GetId(), memberIDs(g.Members)); err != nil {
+		return err
+	}
+	return h.writeGroup(w, r, http.StatusOK, dg)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) error {
+	var req PatchRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+	dg, err := h.loadGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	name := dg.GetName()
+	for _, op := range req.Operations {
+		if err := h.patchGroupOperation(r.Context(), dg, op); err != nil {
+			return err
+		}
+	}
+	if dg.GetName() != name {
+		if err := h.store(r.Context(), dg.GetId(), dg); err != nil {
+			return err
+		}
+	}
+	return h.writeGroup(w, r, http.StatusOK, dg)
+}
+
+// patchGroupOperation applies a patch operation to a directory group. Member
+// changes are stored immediately, name changes are stored by the caller.
+// Attributes which aren't stored are ignored.
+func (h *Handler) patchGroupOperation(ctx context.Context, dg *directory.Group, op PatchOperation) error {
+	o, err := parsePatchOp(op)
+	if err != nil {
+		return err
+	}
+
+	if m := memberFilterRegexp.FindStringSubmatch(op.Path); m != nil {
+		if o != "remove" {
+			return newError(http.StatusBadRequest, errorTypeInvalidSyntax, "unsupported patch path %q", op.Path)
+		}
+		f, err := parseFilter(m[1], "value")
+		if err != nil {
+			return err
+		}
+		return h.updateMembers(ctx, dg.GetId(), nil, []string{f.value})
+	}
+
+	if v, ok := patchValue(op, "displayName"); ok && o != "remove" {
+		if dg.Name, err = unmarshalString(v); err != nil {
+			return err
+		}
+	}
+	v, ok := patchValue(op, "members")
+	if !ok {
+		return nil
+	}
+	var members []Member
+	if len(v) > 0 && string(v) != "null" {
+		if err := json.Unmarshal(v, &members); err != nil {
+			return newError(http.StatusBadRequest, errorTypeInvalidValue, "invalid members: %v", err)
+		}
+	}
+	switch o {
+	case "add":
+		return h.updateMembers(ctx, dg.GetId(), memberIDs(members), nil)
+	case "remove":
+		if len(members) == 0 {
+			// removing the attribute removes every member
+			return h.setMembers(ctx, dg.GetId(), nil)
+		}
+		return h.updateMembers(ctx, dg.GetId(), nil, memberIDs(members))
+	default:
+		return h.setMembers(ctx, dg.GetId(), memberIDs(members))
+	}
+}
+
+// deleteGroup deletes a directory group, and removes it from its members.
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) error {
+	dg, err := h.loadGroup(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	if err := h.setMembers(r.Context(), dg.GetId(), nil); err != nil {
+		return err
+	}
+	if err := h.delete(r.Context(), directoryGroupTypeURL, dg.GetId()); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) writeGroup(w http.ResponseWriter, r *http.Request, code int, dg *directory.Group) error {
+	members, err := h.membersByGroupID(r.Context())
+	if err != nil {
+		return err
+	}
+	writeJSON(w, code, h.toGroup(r, dg, members[dg.GetId()]))
+	return nil
+}
+
+// setMembers sets the members of a group to the users with the given ids.
+func (h *Handler) setMembers(ctx context.Context, groupID string, userIDs []string) error {
+	members, err := h.membersByGroupID(ctx)
+	if err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, id := range userIDs {
+		keep[id] = true
+	}
+	var remove []string
+	for _, du := range members[groupID] {
+		if id, _ := h.userID(du); !keep[id] {
+			remove = append(remove, id)
+		}
+	}
+	return h.updateMembers(ctx, groupID, userIDs, remove)
+}
+
+// updateMembers adds users to, and removes users from, a group. Every user
+// added must exist.
+func (h *Handler) updateMembers(ctx context.Context, groupID string, add, remove []string) error {
+	for _, id := range add {
+		du, err := h.loadUser(ctx, id)
+		if status.Code(err) == codes.NotFound {
+			return newError(http.StatusBadRequest, errorTypeInvalidValue, "unknown member %q", id)
+		} else if err != nil {
+			return err
+		}
+		if containsString(du.GetGroupIds(), groupID) {
+			continue
+		}
+		du.GroupIds = append(du.GroupIds, groupID)
+		if err := h.store(ctx, du.GetId(), du); err != nil {
+			return err
+		}
+	}
+	for _, id := range remove {
+		du, err := h.loadUser(ctx, id)
+		if status.Code(err) == codes.NotFound {
+			// removing a user which doesn't exist is a no-op
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !containsString(du.GetGroupIds(), groupID) {
+			continue
+		}
+		groupIDs := du.GroupIds[:0]
+		for _, gid := range du.GroupIds {
+			if gid != groupID {
+				groupIDs = append(groupIDs, gid)
+			}
+		}
+		du.GroupIds = groupIDs
+		if err := h.store(ctx, du.GetId(), du); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func memberIDs(members []Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.Value)
+	}
+	return ids
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SCIM schemas.
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+const (
+	// defaultCount and maxCount are the default and largest number of
+	// resources returned per page.
+	defaultCount = 100
+	maxCount     = 1000
+	// maxBodySize is the largest request body read.
+	maxBodySize = 1 << 20
+)
+
+// A User is a SCIM user.
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Groups      []Member `json:"groups,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// An Email is an email address of a SCIM user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// A Group is a SCIM group.
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// A Member is a reference to a member of a group, or to a group of a user.
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Meta is the metadata of a resource.
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+// A PatchRequest modifies the attributes of a resource.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// A PatchOperation adds, replaces or removes the value of an attribute. If
+// the path is empty, the value is an object of the attributes to modify.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// A ListResponse is a page of the resources matching a query.
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// A filter matches the resources with an attribute equal to a value. It's
+// the only kind of filter identity providers use to look up resources.
+type filter struct {
+	attribute string
+	value     string
+}
+
+var filterRegexp = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// parseFilter parses an `attribute eq "value"` filter. Attribute names are
+// case-insensitive, and only the given attributes are supported.
+func parseFilter(raw string, attributes ...string) (*filter, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	m := filterRegexp.FindStringSubmatch(raw)
+	if m == nil {
+		return nil, newError(http.StatusBadRequest, errorTypeInvalidFilter, "unsupported filter %q", raw)
+	}
+	value, err := strconv.Unquote(`"` + m[2] + `"`)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, errorTypeInvalidFilter, "invalid filter value %q", m[2])
+	}
+	for _, attribute := range attributes {
+		if strings.EqualFold(m[1], attribute) {
+			return &filter{attribute: attribute, value: value}, nil
+		}
+	}
+	return nil, newError(http.StatusBadRequest, errorTypeInvalidFilter, "unsupported filter attribute %q", m[1])
+}
+
+// page returns the page of resources a list request asks for.
+func page(r *http.Request, resources []interface{}) (*ListResponse, error) {
+	startIndex, count := 1, defaultCount
+	if v := r.FormValue("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, errorTypeInvalidValue, "invalid startIndex %q", v)
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	if v := r.FormValue("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, errorTypeInvalidValue, "invalid count %q", v)
+		}
+		count = i
+	}
+	if count < 0 {
+		count = 0
+	} else if count > maxCount {
+		count = maxCount
+	}
+
+	res := &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		Resources:    []interface{}{},
+	}
+	if start := startIndex - 1; start < len(resources) {
+		end := start + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		res.Resources = append(res.Resources, resources[start:end]...)
+	}
+	res.ItemsPerPage = len(res.Resources)
+	return res, nil
+}
+
+// patchValue is the value of an attribute a patch operation modifies, or
+// nil if the attribute isn't modified.
+func patchValue(op PatchOperation, attribute string) (json.RawMessage, bool) {
+	if op.Path != "" {
+		if strings.EqualFold(op.Path, attribute) {
+			return op.Value, true
+		}
+		return nil, false
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(op.Value, &values); err != nil {
+		return nil, false
+	}
+	for k, v := range values {
+		if strings.EqualFold(k, attribute) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// parsePatchOp returns the normalized operation of a patch operation.
+func parsePatchOp(op PatchOperation) (string, error) {
+	switch o := strings.ToLower(op.Op); o {
+	case "add", "replace", "remove":
+		return o, nil
+	default:
+		return "", newError(http.StatusBadRequest, errorTypeInvalidSyntax, "unsupported patch operation %q", op.Op)
+	}
+}
+
+// unmarshalString unmarshals a string value.
+func unmarshalString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", newError(http.StatusBadRequest, errorTypeInvalidValue, "expected a string value: %v", err)
+	}
+	return s, nil
+}
+
+// unmarshalBool unmarshals a boolean value. Some identity providers send
+// booleans as strings, e.g. "False".
+func unmarshalBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if b, err := strconv.ParseBool(strings.ToLower(s)); err == nil {
+			return b, nil
+		}
+	}
+	return false, newError(http.StatusBadRequest, errorTypeInvalidValue, "expected a boolean value, got %s", raw)
+}
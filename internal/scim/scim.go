@@ -0,0 +1,189 @@
+// Package scim implements a SCIM 2.0 server for the Users and Groups
+// resources, so identity providers can push user deactivations and group
+// changes to the databroker as they happen, rather than waiting for the next
+// directory sync.
+//
+// Users are stored as directory users, and their SCIM ids are their subjects
+// at the identity provider, so the groups pushed for a user apply to the
+// sessions of that user. Group memberships are stored in the directory users,
+// as they are by the directory sync.
+//
+// https://tools.ietf.org/html/rfc7643
+// https://tools.ietf.org/html/rfc7644
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// ContentType is the media type of SCIM requests and responses.
+const ContentType = "application/scim+json"
+
+// SCIM error types.
+const (
+	errorTypeInvalidFilter = "invalidFilter"
+	errorTypeInvalidSyntax = "invalidSyntax"
+	errorTypeInvalidValue  = "invalidValue"
+	errorTypeUniqueness    = "uniqueness"
+)
+
+// An Error is a SCIM error response.
+type Error struct {
+	Status   int
+	ScimType string
+	Detail   string
+}
+
+func newError(status int, scimType, format string, args ...interface{}) *Error {
+	return &Error{Status: status, ScimType: scimType, Detail: fmt.Sprintf(format, args...)}
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("scim: %s", e.Detail)
+}
+
+// A Handler serves the SCIM endpoints.
+type Handler struct {
+	router           *mux.Router
+	prefix           string
+	dataBrokerClient databroker.DataBrokerServiceClient
+	providerName     string
+	bearerToken      string
+}
+
+// New creates a new Handler serving the SCIM endpoints under prefix. User ids
+// are the subjects of the users at the identity provider with the given
+// name. Requests must be authenticated with the bearer token.
+func New(prefix string, dataBrokerClient databroker.DataBrokerServiceClient, providerName, bearerToken string) *Handler {
+	h := &Handler{
+		router:           mux.NewRouter(),
+		prefix:           prefix,
+		dataBrokerClient: dataBrokerClient,
+		providerName:     providerName,
+		bearerToken:      bearerToken,
+	}
+
+	sr := h.router.PathPrefix(prefix).Subrouter()
+	sr.Path("/ServiceProviderConfig").Handler(handlerFunc(h.serviceProviderConfig)).Methods(http.MethodGet)
+	sr.Path("/Users").Handler(handlerFunc(h.listUsers)).Methods(http.MethodGet)
+	sr.Path("/Users").Handler(handlerFunc(h.createUser)).Methods(http.MethodPost)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.getUser)).Methods(http.MethodGet)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.replaceUser)).Methods(http.MethodPut)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.patchUser)).Methods(http.MethodPatch)
+	sr.Path("/Users/{id}").Handler(handlerFunc(h.deleteUser)).Methods(http.MethodDelete)
+	sr.Path("/Groups").Handler(handlerFunc(h.listGroups)).Methods(http.MethodGet)
+	sr.Path("/Groups").Handler(handlerFunc(h.createGroup)).Methods(http.MethodPost)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.getGroup)).Methods(http.MethodGet)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.replaceGroup)).Methods(http.MethodPut)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.patchGroup)).Methods(http.MethodPatch)
+	sr.Path("/Groups/{id}").Handler(handlerFunc(h.deleteGroup)).Methods(http.MethodDelete)
+	h.router.NotFoundHandler = handlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return newError(http.StatusNotFound, "", "%s not found", r.URL.Path)
+	})
+	h.router.MethodNotAllowedHandler = handlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return newError(http.StatusMethodNotAllowed, "", "%s not allowed for %s", r.Method, r.URL.Path)
+	})
+	return h
+}
+
+// ServeHTTP serves a SCIM request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(w, r, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+	h.router.ServeHTTP(w, r)
+}
+
+func (h *Handler) authorized(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	if len(auth) < len("Bearer ") || !strings.EqualFold(auth[:len("Bearer ")], "Bearer ") {
+		return false
+	}
+	token := strings.TrimSpace(auth[len("Bearer "):])
+	return h.bearerToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(h.bearerToken)) == 1
+}
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, r *http.Request) error {
+	supported := func(ok bool) map[string]interface{} {
+		return map[string]interface{}{"supported": ok}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with a bearer token",
+			"primary":     true,
+		}},
+	})
+	return nil
+}
+
+// location returns the URL of a resource.
+func (h *Handler) location(r *http.Request, resourceType, id string) string {
+	return fmt.Sprintf("https://%s%s/%s/%s", r.Host, h.prefix, resourceType, id)
+}
+
+// handlerFunc adapts a function returning an error to an http.Handler which
+// writes the error as a SCIM error response.
+type handlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+func (f handlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := f(w, r); err != nil {
+		writeError(w, r, err)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+	case status.Code(err) == codes.NotFound:
+		e = newError(http.StatusNotFound, "", "resource not found")
+	default:
+		log.FromRequest(r).Error().Err(err).Msg("scim: request failed")
+		e = newError(http.StatusInternalServerError, "", "internal error")
+	}
+	writeJSON(w, e.Status, errorResponse{
+		Schemas:  []string{schemaError},
+		Status:   fmt.Sprint(e.Status),
+		ScimType: e.ScimType,
+		Detail:   e.Detail,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxBodySize)).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, errorTypeInvalidSyntax, "invalid request body: %v", err)
+	}
+	return nil
+}
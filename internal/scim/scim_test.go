@@ -0,0 +1,216 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+const testBearerToken = "0123456789abcdef0123456789abcdef"
+
+func newTestDataBrokerClient(t *testing.T) databroker.DataBrokerServiceClient {
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(grpcServer, internal_databroker.New())
+	go func() { _ = grpcServer.Serve(li) }()
+	t.Cleanup(grpcServer.Stop)
+	cc, err := grpc.Dial(li.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cc.Close() })
+	return databroker.NewDataBrokerServiceClient(cc)
+}
+
+func do(t *testing.T, h http.Handler, method, path, body string) (int, map[string]interface{}) {
+	t.Helper()
+	r := httptest.NewRequest(method, "https://authenticate.example.com/scim/v2"+path, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer "+testBearerToken)
+	r.Header.Set("Content-Type", ContentType)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	var res map[string]interface{}
+	if w.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res), w.Body.String())
+	}
+	return w.Code, res
+}
+
+func TestHandler_Authorization(t *testing.T) {
+	h := New("/scim/v2", newTestDataBrokerClient(t), "okta", testBearerToken)
+	for _, auth := range []string{"", "Bearer wrong", "Basic " + testBearerToken} {
+		r := httptest.NewRequest(http.MethodGet, "https://authenticate.example.com/scim/v2/Users", nil)
+		if auth != "" {
+			r.Header.Set("Authorization", auth)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, auth)
+		assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	}
+}
+
+func TestHandler_Users(t *testing.T) {
+	ctx := context.Background()
+	client := newTestDataBrokerClient(t)
+	h := New("/scim/v2", client, "okta", testBearerToken)
+
+	code, res := do(t, h, http.MethodPost, "/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"externalId": "00u1",
+		"userName": "alice@example.com",
+		"displayName": "Alice",
+		"active": true
+	}`)
+	require.Equal(t, http.StatusCreated, code, res)
+	assert.Equal(t, "00u1", res["id"])
+	assert.Equal(t, "https://authenticate.example.com/scim/v2/Users/00u1", res["meta"].(map[string]interface{})["location"])
+
+	code, _ = do(t, h, http.MethodPost, "/Users", `{"externalId": "00u1", "userName": "alice@example.com"}`)
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, res = do(t, h, http.MethodGet, `/Users?filter=userName+eq+%22ALICE@example.com%22`, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1.0, res["totalResults"])
+	code, res = do(t, h, http.MethodGet, `/Users?filter=userName+eq+%22bob@example.com%22`, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 0.0, res["totalResults"])
+	code, _ = do(t, h, http.MethodGet, `/Users?filter=title+co+%22x%22`, "")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	_, err := session.Set(ctx, client, &session.Session{Id: "SESSION_ID", UserId: "okta/00u1"})
+	require.NoError(t, err)
+
+	code, res = do(t, h, http.MethodPatch, "/Users/00u1", `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "displayName", "value": "Alice Smith"},
+			{"op": "Replace", "path": "active", "value": "False"}
+		]
+	}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, false, res["active"])
+
+	du, err := directory.GetUser(ctx, client, "okta/00u1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Smith", du.GetDisplayName())
+	assert.Equal(t, "alice@example.com", du.GetEmail())
+	assert.True(t, du.GetInactive())
+	_, err = session.Get(ctx, client, "SESSION_ID")
+	assert.Error(t, err, "sessions of deactivated users should be deleted")
+
+	code, res = do(t, h, http.MethodPatch, "/Users/00u1", `{
+		"Operations": [{"op": "replace", "value": {"active": true}}]
+	}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, true, res["active"])
+
+	code, _ = do(t, h, http.MethodDelete, "/Users/00u1", "")
+	assert.Equal(t, http.StatusNoContent, code)
+	code, _ = do(t, h, http.MethodGet, "/Users/00u1", "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestHandler_Groups(t *testing.T) {
+	ctx := context.Background()
+	client := newTestDataBrokerClient(t)
+	h := New("/scim/v2", client, "okta", testBearerToken)
+
+	for _, id := range []string{"00u1", "00u2"} {
+		code, _ := do(t, h, http.MethodPost, "/Users", `{"externalId": "`+id+`", "userName": "`+id+`@example.com"}`)
+		require.Equal(t, http.StatusCreated, code)
+	}
+	groupIDs := func(userID string) []string {
+		du, err := directory.GetUser(ctx, client, "okta/"+userID)
+		require.NoError(t, err)
+		return du.GetGroupIds()
+	}
+
+	code, res := do(t, h, http.MethodPost, "/Groups", `{
+		"externalId": "00g1",
+		"displayName": "admins",
+		"members": [{"value": "00u1"}]
+	}`)
+	require.Equal(t, http.StatusCreated, code, res)
+	assert.Len(t, res["members"], 1)
+	assert.Equal(t, []string{"00g1"}, groupIDs("00u1"))
+
+	code, _ = do(t, h, http.MethodPost, "/Groups", `{"displayName": "other", "members": [{"value": "unknown"}]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, res = do(t, h, http.MethodPatch, "/Groups/00g1", `{
+		"Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "00u2"}]},
+			{"op": "remove", "path": "members[value eq \"00u1\"]"},
+			{"op": "replace", "value": {"id": "00g1", "displayName": "operators"}}
+		]
+	}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, "operators", res["displayName"])
+	assert.Empty(t, groupIDs("00u1"))
+	assert.Equal(t, []string{"00g1"}, groupIDs("00u2"))
+
+	code, res = do(t, h, http.MethodGet, `/Groups?filter=displayName+eq+%22operators%22`, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 1.0, res["totalResults"])
+
+	code, res = do(t, h, http.MethodPut, "/Groups/00g1", `{"displayName": "operators", "members": [{"value": "00u1"}]}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, []string{"00g1"}, groupIDs("00u1"))
+	assert.Empty(t, groupIDs("00u2"))
+
+	code, _ = do(t, h, http.MethodDelete, "/Groups/00g1", "")
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Empty(t, groupIDs("00u1"))
+	_, err := directory.GetGroup(ctx, client, "00g1")
+	assert.Error(t, err)
+}
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter(`userName Eq "alice\"@example.com"`, "userName")
+	require.NoError(t, err)
+	assert.Equal(t, &filter{attribute: "userName", value: `alice"@example.com`}, f)
+
+	f, err = parseFilter("", "userName")
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+
+	for _, raw := range []string{`userName sw "a"`, `title eq "a"`, `userName eq a`} {
+		_, err := parseFilter(raw, "userName")
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestPage(t *testing.T) {
+	resources := []interface{}{1, 2, 3, 4, 5}
+	for _, tc := range []struct {
+		query  string
+		expect []interface{}
+	}{
+		{"", []interface{}{1, 2, 3, 4, 5}},
+		{"startIndex=2&count=2", []interface{}{2, 3}},
+		{"startIndex=0&count=1", []interface{}{1}},
+		{"startIndex=5&count=10", []interface{}{5}},
+		{"startIndex=6", []interface{}{}},
+		{"count=0", []interface{}{}},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/Users?"+tc.query, nil)
+		res, err := page(r, resources)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expect, res.Resources, tc.query)
+		assert.Equal(t, 5, res.TotalResults)
+		assert.Equal(t, len(tc.expect), res.ItemsPerPage)
+	}
+}
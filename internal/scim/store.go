@@ -0,0 +1,142 @@
+package scim
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+const (
+	directoryUserTypeURL  = "type.googleapis.com/directory.User"
+	directoryGroupTypeURL = "type.googleapis.com/directory.Group"
+)
+
+// userRecordID returns the databroker record id of the directory user with
+// the given SCIM id.
+func (h *Handler) userRecordID(id string) string {
+	return databroker.GetUserID(h.providerName, id)
+}
+
+// userID returns the SCIM id of a directory user, or false if the user isn't
+// a user of the identity provider.
+func (h *Handler) userID(du *directory.User) (string, bool) {
+	prefix := databroker.GetUserID(h.providerName, "")
+	if !strings.HasPrefix(du.GetId(), prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(du.GetId(), prefix), true
+}
+
+func (h *Handler) loadUser(ctx context.Context, id string) (*directory.User, error) {
+	return directory.GetUser(ctx, h.dataBrokerClient, h.userRecordID(id))
+}
+
+// loadUsers returns the directory users of the identity provider, sorted by
+// id.
+func (h *Handler) loadUsers(ctx context.Context) ([]*directory.User, error) {
+	var users []*directory.User
+	err := h.loadAll(ctx, directoryUserTypeURL, func() proto.Message {
+		du := new(directory.User)
+		users = append(users, du)
+		return du
+	})
+	if err != nil {
+		return nil, err
+	}
+	var matched []*directory.User
+	for _, du := range users {
+		if _, ok := h.userID(du); ok {
+			matched = append(matched, du)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].GetId() < matched[j].GetId() })
+	return matched, nil
+}
+
+func (h *Handler) loadGroup(ctx context.Context, id string) (*directory.Group, error) {
+	return directory.GetGroup(ctx, h.dataBrokerClient, id)
+}
+
+// loadGroups returns the directory groups, sorted by id.
+func (h *Handler) loadGroups(ctx context.Context) ([]*directory.Group, error) {
+	var groups []*directory.Group
+	err := h.loadAll(ctx, directoryGroupTypeURL, func() proto.Message {
+		dg := new(directory.Group)
+		groups = append(groups, dg)
+		return dg
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].GetId() < groups[j].GetId() })
+	return groups, nil
+}
+
+func (h *Handler) loadAll(ctx context.Context, typeURL string, newMessage func() proto.Message) error {
+	res, err := h.dataBrokerClient.GetAll(ctx, &databroker.GetAllRequest{Type: typeURL})
+	if err != nil {
+		return err
+	}
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		if err := ptypes.UnmarshalAny(record.GetData(), newMessage()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Handler) exists(ctx context.Context, typeURL, id string) (bool, error) {
+	_, err := h.dataBrokerClient.Get(ctx, &databroker.GetRequest{Type: typeURL, Id: id})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *Handler) store(ctx context.Context, id string, msg proto.Message) error {
+	any, err := ptypes.MarshalAny(msg)
+	if err != nil {
+		return err
+	}
+	_, err = h.dataBrokerClient.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   id,
+		Data: any,
+	})
+	return err
+}
+
+func (h *Handler) delete(ctx context.Context, typeURL, id string) error {
+	_, err := h.dataBrokerClient.Delete(ctx, &databroker.DeleteRequest{Type: typeURL, Id: id})
+	return err
+}
+
+// deleteSessions deletes the sessions of a directory user, so a deactivated
+// or deleted user has to sign in again.
+func (h *Handler) deleteSessions(ctx context.Context, du *directory.User) error {
+	sessions, err := session.GetAllForUser(ctx, h.dataBrokerClient, du.GetId())
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if err := session.Delete(ctx, h.dataBrokerClient, s.GetId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/pomerium/pomerium/pkg/grpc/directory"
+)
+
+// toUser returns the SCIM user of a directory user. The user's name is its
+// email.
+func (h *Handler) toUser(r *http.Request, id string, du *directory.User, groups map[string]*directory.Group) *User {
+	active := !du.GetInactive()
+	u := &User{
+		Schemas:     []string{schemaUser},
+		ID:          id,
+		ExternalID:  id,
+		UserName:    du.GetEmail(),
+		DisplayName: du.GetDisplayName(),
+		Active:      &active,
+		Meta:        &Meta{ResourceType: "User", Location: h.location(r, "Users", id)},
+	}
+	if du.GetEmail() != "" {
+		u.Emails = []Email{{Value: du.GetEmail(), Type: "work", Primary: true}}
+	}
+	for _, groupID := range du.GetGroupIds() {
+		m := Member{Value: groupID, Ref: h.location(r, "Groups", groupID)}
+		if dg, ok := groups[groupID]; ok {
+			m.Display = dg.GetName()
+		}
+		u.Groups = append(u.Groups, m)
+	}
+	return u
+}
+
+// setUser sets the attributes of a directory user from a SCIM user. The
+// user's email is its primary email, or its name if it has no emails.
+func setUser(du *directory.User, u *User) {
+	du.DisplayName = u.DisplayName
+	du.Email = u.UserName
+	if email := primaryEmail(u.Emails); email != "" {
+		du.Email = email
+	}
+	du.Inactive = u.Active != nil && !*u.Active
+}
+
+// primaryEmail returns the primary email of a list of emails, or the first
+// email if none is primary.
+func primaryEmail(emails []Email) string {
+	for _, email := range emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(emails) > 0 {
+		return emails[0].Value
+	}
+	return ""
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) error {
+	f, err := parseFilter(r.FormValue("filter"), "id", "externalId", "userName")
+	if err != nil {
+		return err
+	}
+	users, err := h.loadUsers(r.Context())
+	if err != nil {
+		return err
+	}
+	groups, err := h.groupsByID(r)
+	if err != nil {
+		return err
+	}
+
+	var resources []interface{}
+	for _, du := range users {
+		id, _ := h.userID(du)
+		if f != nil {
+			switch f.attribute {
+			case "id", "externalId":
+				if id != f.value {
+					continue
+				}
+			case "userName":
+				if !strings.EqualFold(du.GetEmail(), f.value) {
+					continue
+				}
+			}
+		}
+		resources = append(resources, h.toUser(r, id, du, groups))
+	}
+	res, err := page(r, resources)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, res)
+	return nil
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) error {
+	id := mux.Vars(r)["id"]
+	du, err := h.loadUser(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	groups, err := h.groupsByID(r)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, h.toUser(r, id, du, groups))
+	return nil
+}
+
+// createUser creates a directory user. Its id is its external id, which must
+// be the user's subject at the identity provider, or its name if it has no
+// external id.
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) error {
+	var u User
+	if err := readJSON(r, &u); err != nil {
+		return err
+	}
+	if u.UserName == "" {
+		return newError(http.StatusBadRequest, errorTypeInvalidValue, "userName is required")
+	}
+	id := u.ExternalID
+	if id == "" {
+		id = u.UserName
+	}
+
+	du := &directory.User{Id: h.userRecordID(id)}
+	if ok, err := h.exists(r.Context(), directoryUserTypeURL, du.GetId()); err != nil {
+		return err
+	} else if ok {
+		return newError(http.StatusConflict, errorTypeUniqueness, "user %q already exists", id)
+	}
+	setUser(du, &u)
+	if err := h.store(r.Context(), du.GetId(), du); err != nil {
+		return err
+	}
+
+	res := h.toUser(r, id, du, nil)
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, http.StatusCreated, res)
+	return nil
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) error {
+	id := mux.Vars(r)["id"]
+	var u User
+	if err := readJSON(r, &u); err != nil {
+		return err
+	}
+	if u.UserName == "" {
+		return newError(http.StatusBadRequest, errorTypeInvalidValue, "userName is required")
+	}
+	du, err := h.loadUser(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	wasInactive := du.GetInactive()
+	setUser(du, &u)
+	return h.saveUser(w, r, id, du, wasInactive)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) error {
+	id := mux.Vars(r)["id"]
+	var req PatchRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+	du, err := h.loadUser(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	wasInactive := du.GetInactive()
+	for _, op := range req.Operations {
+		if err := patchUser(du, op); err != nil {
+			return err
+		}
+	}
+	return h.saveUser(w, r, id, du, wasInactive)
+}
+
+// patchUser applies a patch operation to a directory user. Attributes which
+// aren't stored are ignored.
+func patchUser(du *directory.User, op PatchOperation) error {
+	o, err := parsePatchOp(op)
+	if err != nil {
+		return err
+	}
+	if o == "remove" {
+		switch path := strings.ToLower(op.Path); {
+		case path == "displayname":
+			du.DisplayName = ""
+		case strings.HasPrefix(path, "emails"):
+			du.Email = ""
+		}
+		return nil
+	}
+
+	if v, ok := patchValue(op, "active"); ok {
+		active, err := unmarshalBool(v)
+		if err != nil {
+			return err
+		}
+		du.Inactive = !active
+	}
+	if v, ok := patchValue(op, "displayName"); ok {
+		if du.DisplayName, err = unmarshalString(v); err != nil {
+			return err
+		}
+	}
+	if v, ok := patchValue(op, "userName"); ok {
+		if du.Email, err = unmarshalString(v); err != nil {
+			return err
+		}
+	}
+	if strings.HasPrefix(strings.ToLower(op.Path), "emails[") {
+		// e.g. emails[type eq "work"].value
+		if du.Email, err = unmarshalString(op.Value); err != nil {
+			return err
+		}
+	} else if v, ok := patchValue(op, "emails"); ok {
+		var emails []Email
+		if err := json.Unmarshal(v, &emails); err != nil {
+			return newError(http.StatusBadRequest, errorTypeInvalidValue, "invalid emails: %v", err)
+		}
+		if email := primaryEmail(emails); email != "" {
+			du.Email = email
+		}
+	}
+	return nil
+}
+
+// saveUser stores a changed directory user, and deletes its sessions if it
+// was deactivated.
+func (h *Handler) saveUser(w http.ResponseWriter, r *http.Request, id string, du *directory.User, wasInactive bool) error {
+	if err := h.store(r.Context(), du.GetId(), du); err != nil {
+		return err
+	}
+	if du.GetInactive() && !wasInactive {
+		if err := h.deleteSessions(r.Context(), du); err != nil {
+			return err
+		}
+	}
+	groups, err := h.groupsByID(r)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, h.toUser(r, id, du, groups))
+	return nil
+}
+
+// deleteUser deletes a directory user and its sessions.
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) error {
+	du, err := h.loadUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	if err := h.delete(r.Context(), directoryUserTypeURL, du.GetId()); err != nil {
+		return err
+	}
+	if err := h.deleteSessions(r.Context(), du); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *Handler) groupsByID(r *http.Request) (map[string]*directory.Group, error) {
+	groups, err := h.loadGroups(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]*directory.Group, len(groups))
+	for _, dg := range groups {
+		m[dg.GetId()] = dg
+	}
+	return m, nil
+}
@@ -46,6 +46,39 @@ func (v *Version) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// AuthMethods represents the "amr" claim, the methods the user
+// authenticated with, e.g. "pwd" and "mfa".
+//
+// The claim is a list, but some providers return a single string.
+type AuthMethods []string
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (m *AuthMethods) UnmarshalJSON(b []byte) error {
+	var tmp interface{}
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+	switch val := tmp.(type) {
+	case string:
+		*m = AuthMethods{val}
+	case []interface{}:
+		methods := make(AuthMethods, 0, len(val))
+		for _, v := range val {
+			method, ok := v.(string)
+			if !ok {
+				return errors.New("invalid type for AuthMethods")
+			}
+			methods = append(methods, method)
+		}
+		*m = methods
+	case nil:
+		*m = nil
+	default:
+		return errors.New("invalid type for AuthMethods")
+	}
+	return nil
+}
+
 // State is our object that keeps track of a user's session state
 type State struct {
 	// Public claim values (as specified in RFC 7519).
@@ -63,6 +96,26 @@ type State struct {
 	// Azure returns OID which should be used instead of subject.
 	OID string `json:"oid,omitempty"`
 
+	// AuthTime is when the user signed in with the identity provider. Unlike
+	// IssuedAt, it isn't updated when new tokens are issued for the session.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+
+	// ACR and AMR are the authentication context class and the methods the
+	// user signed in with, from the ID token, so routes can require a
+	// step-up authentication.
+	ACR string      `json:"acr,omitempty"`
+	AMR AuthMethods `json:"amr,omitempty"`
+
+	// IdentityProviderID is the id of the identity provider the user signed
+	// in with. It's empty for sessions of the default identity provider
+	// created before identity providers had ids.
+	IdentityProviderID string `json:"idp_id,omitempty"`
+
+	// IdentityProviderSessionID is the identity provider's session id, from
+	// the "sid" claim of the ID token. It's used to match back-channel
+	// logout requests to sessions.
+	IdentityProviderSessionID string `json:"sid,omitempty"`
+
 	// Impersonate-able fields
 	ImpersonateEmail  string   `json:"impersonate_email,omitempty"`
 	ImpersonateGroups []string `json:"impersonate_groups,omitempty"`
@@ -88,6 +141,52 @@ func (s *State) IsExpired() bool {
 	return s.Expiry != nil && timeNow().After(s.Expiry.Time())
 }
 
+// Validate returns an error if the session is expired or not valid yet,
+// tolerating the given clock skew.
+func (s *State) Validate(skew time.Duration) error {
+	now := timeNow()
+	if s.Expiry != nil && now.Add(-skew).After(s.Expiry.Time()) {
+		return ErrExpired
+	}
+	if s.NotBefore != nil && now.Add(skew).Before(s.NotBefore.Time()) {
+		return ErrNotValidYet
+	}
+	return nil
+}
+
+// AuthenticatedWithin returns true if the user signed in with the identity
+// provider within the given duration.
+func (s *State) AuthenticatedWithin(d time.Duration) bool {
+	return s.AuthTime != nil && timeNow().Sub(s.AuthTime.Time()) <= d
+}
+
+// SatisfiesStepUp returns true if the user signed in with one of the
+// given authentication context classes, if any, and with one of the given
+// authentication methods, if any.
+func (s *State) SatisfiesStepUp(acrValues, amrValues []string) bool {
+	if len(acrValues) > 0 && !containsString(acrValues, s.ACR) {
+		return false
+	}
+	if len(amrValues) > 0 {
+		for _, method := range s.AMR {
+			if containsString(amrValues, method) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // Impersonating returns if the request is impersonating.
 func (s *State) Impersonating() bool {
 	return s.ImpersonateEmail != "" || len(s.ImpersonateGroups) != 0
@@ -68,6 +68,80 @@ func TestState_IsExpired(t *testing.T) {
 	}
 }
 
+func TestState_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		Expiry    *jwt.NumericDate
+		NotBefore *jwt.NumericDate
+		skew      time.Duration
+		want      error
+	}{
+		{"good", jwt.NewNumericDate(time.Now().Add(time.Hour)), jwt.NewNumericDate(time.Now().Add(-time.Hour)), 0, nil},
+		{"expired", jwt.NewNumericDate(time.Now().Add(-30 * time.Second)), nil, 0, ErrExpired},
+		{"expired within skew", jwt.NewNumericDate(time.Now().Add(-30 * time.Second)), nil, time.Minute, nil},
+		{"not valid yet", nil, jwt.NewNumericDate(time.Now().Add(30 * time.Second)), 0, ErrNotValidYet},
+		{"not valid yet within skew", nil, jwt.NewNumericDate(time.Now().Add(30 * time.Second)), time.Minute, nil},
+		{"not valid yet beyond skew", nil, jwt.NewNumericDate(time.Now().Add(5 * time.Minute)), time.Minute, ErrNotValidYet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &State{Expiry: tt.Expiry, NotBefore: tt.NotBefore}
+			if err := s.Validate(tt.skew); err != tt.want {
+				t.Errorf("State.Validate() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestState_AuthenticatedWithin(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		AuthTime *jwt.NumericDate
+		want     bool
+	}{
+		{"recent", jwt.NewNumericDate(time.Now().Add(-time.Minute)), true},
+		{"old", jwt.NewNumericDate(time.Now().Add(-time.Hour)), false},
+		{"missing", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &State{AuthTime: tt.AuthTime}
+			if got := s.AuthenticatedWithin(5 * time.Minute); got != tt.want {
+				t.Errorf("State.AuthenticatedWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestState_SatisfiesStepUp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		state     State
+		acrValues []string
+		amrValues []string
+		want      bool
+	}{
+		{"no requirements", State{}, nil, nil, true},
+		{"acr", State{ACR: "urn:example:mfa"}, []string{"urn:example:mfa"}, nil, true},
+		{"wrong acr", State{ACR: "urn:example:pwd"}, []string{"urn:example:mfa"}, nil, false},
+		{"amr", State{AMR: AuthMethods{"pwd", "otp"}}, nil, []string{"mfa", "otp"}, true},
+		{"wrong amr", State{AMR: AuthMethods{"pwd"}}, nil, []string{"mfa", "otp"}, false},
+		{"acr but wrong amr", State{ACR: "urn:example:mfa", AMR: AuthMethods{"pwd"}}, []string{"urn:example:mfa"}, []string{"mfa"}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.state.SatisfiesStepUp(tt.acrValues, tt.amrValues); got != tt.want {
+				t.Errorf("State.SatisfiesStepUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestState_UnmarshalJSON(t *testing.T) {
 	fixedTime := time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
 	timeNow = func() time.Time {
@@ -123,6 +197,33 @@ func TestState_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestAuthMethods_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		jsonStr string
+		want    AuthMethods
+		wantErr bool
+	}{
+		{"list", `["pwd","mfa"]`, AuthMethods{"pwd", "mfa"}, false},
+		{"string", `"mfa"`, AuthMethods{"mfa"}, false},
+		{"invalid list", `["pwd",1]`, nil, true},
+		{"invalid", `1`, nil, true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var m AuthMethods
+			if err := m.UnmarshalJSON([]byte(tc.jsonStr)); (err != nil) != tc.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, m); diff != "" {
+				t.Errorf("UnmarshalJSON() = %v", diff)
+			}
+		})
+	}
+}
+
 func TestVersion_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+var (
+	// PolicyEvaluatorViews contains opencensus views for policy evaluator
+	// metrics.
+	PolicyEvaluatorViews = []*view.View{PolicyEvaluatorRebuildDurationView}
+
+	policyEvaluatorRebuildDuration = stats.Int64(
+		"policy_evaluator_rebuild_duration_ms",
+		"Policy evaluator rebuild duration in ms",
+		stats.UnitMilliseconds)
+
+	// PolicyEvaluatorRebuildDurationView is an OpenCensus view which tracks
+	// how long it takes to rebuild the policy evaluator when the config
+	// changes, by result.
+	PolicyEvaluatorRebuildDurationView = &view.View{
+		Name:        policyEvaluatorRebuildDuration.Name(),
+		Description: policyEvaluatorRebuildDuration.Description(),
+		Measure:     policyEvaluatorRebuildDuration,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyPolicyEvaluatorResult},
+		Aggregation: DefaultMillisecondsDistribution,
+	}
+)
+
+// RecordPolicyEvaluatorRebuild records the duration of a policy evaluator
+// rebuild, and whether it failed.
+func RecordPolicyEvaluatorRebuild(ctx context.Context, service string, err error, duration time.Duration) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	err = stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyService, service),
+			tag.Upsert(TagKeyPolicyEvaluatorResult, result),
+		},
+		policyEvaluatorRebuildDuration.M(duration.Milliseconds()),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
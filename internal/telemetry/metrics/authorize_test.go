@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opencensus.io/stats/view"
+)
+
+func Test_RecordPolicyEvaluatorRebuild(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		duration time.Duration
+		want     string
+	}{
+		{"success", nil, time.Millisecond * 5, "{ { {result success}{service authorize} }&{1 5 5 5 0"},
+		{"error", errors.New("failure"), time.Millisecond * 7, "{ { {result error}{service authorize} }&{1 7 7 7 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view.Unregister(PolicyEvaluatorViews...)
+			view.Register(PolicyEvaluatorViews...)
+			RecordPolicyEvaluatorRebuild(context.Background(), "authorize", tt.err, tt.duration)
+
+			testDataRetrieval(PolicyEvaluatorRebuildDurationView, t, tt.want)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+var (
+	// CircuitBreakerViews contains opencensus views for circuit breaker
+	// metrics.
+	CircuitBreakerViews = []*view.View{
+		CircuitBreakerStateView,
+		CircuitBreakerTransitionCountView,
+		CircuitBreakerRejectionCountView,
+	}
+
+	circuitBreakerState = stats.Int64(
+		"circuit_breaker_state",
+		"Current circuit breaker state: 0 closed, 1 half-open, 2 open",
+		stats.UnitDimensionless)
+	circuitBreakerTransitions = stats.Int64(
+		"circuit_breaker_transitions_total",
+		"Total number of circuit breaker state transitions",
+		stats.UnitDimensionless)
+	circuitBreakerRejections = stats.Int64(
+		"circuit_breaker_rejections_total",
+		"Total number of requests rejected by an open circuit breaker",
+		stats.UnitDimensionless)
+
+	// CircuitBreakerStateView contains the current state of a circuit
+	// breaker.
+	CircuitBreakerStateView = &view.View{
+		Name:        circuitBreakerState.Name(),
+		Description: circuitBreakerState.Description(),
+		Measure:     circuitBreakerState,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyCircuitBreaker},
+		Aggregation: view.LastValue(),
+	}
+
+	// CircuitBreakerTransitionCountView is an OpenCensus view which counts
+	// circuit breaker state transitions by the new state.
+	CircuitBreakerTransitionCountView = &view.View{
+		Name:        circuitBreakerTransitions.Name(),
+		Description: circuitBreakerTransitions.Description(),
+		Measure:     circuitBreakerTransitions,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyCircuitBreaker, TagKeyCircuitBreakerState},
+		Aggregation: view.Count(),
+	}
+
+	// CircuitBreakerRejectionCountView is an OpenCensus view which counts
+	// requests rejected by an open circuit breaker.
+	CircuitBreakerRejectionCountView = &view.View{
+		Name:        circuitBreakerRejections.Name(),
+		Description: circuitBreakerRejections.Description(),
+		Measure:     circuitBreakerRejections,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyCircuitBreaker},
+		Aggregation: view.Count(),
+	}
+)
+
+// The states reported by RecordCircuitBreakerTransition.
+const (
+	CircuitBreakerClosed   = "closed"
+	CircuitBreakerHalfOpen = "half-open"
+	CircuitBreakerOpen     = "open"
+)
+
+// RecordCircuitBreakerTransition records a circuit breaker transitioning to
+// the given state.
+func RecordCircuitBreakerTransition(ctx context.Context, service, breaker, state string) {
+	var value int64
+	switch state {
+	case CircuitBreakerHalfOpen:
+		value = 1
+	case CircuitBreakerOpen:
+		value = 2
+	}
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyService, service),
+			tag.Upsert(TagKeyCircuitBreaker, breaker),
+			tag.Upsert(TagKeyCircuitBreakerState, state),
+		},
+		circuitBreakerTransitions.M(1),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+	recordCircuitBreaker(ctx, service, breaker, circuitBreakerState.M(value))
+}
+
+// RecordCircuitBreakerRejection records a request rejected by an open
+// circuit breaker.
+func RecordCircuitBreakerRejection(ctx context.Context, service, breaker string) {
+	recordCircuitBreaker(ctx, service, breaker, circuitBreakerRejections.M(1))
+}
+
+func recordCircuitBreaker(ctx context.Context, service, breaker string, m stats.Measurement) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyService, service),
+			tag.Upsert(TagKeyCircuitBreaker, breaker),
+		},
+		m,
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"go.opencensus.io/stats/view"
+)
+
+func Test_RecordCircuitBreaker(t *testing.T) {
+	view.Unregister(CircuitBreakerViews...)
+	view.Register(CircuitBreakerViews...)
+
+	ctx := context.Background()
+	RecordCircuitBreakerTransition(ctx, "authorize", "databroker", CircuitBreakerOpen)
+	RecordCircuitBreakerRejection(ctx, "authorize", "databroker")
+	RecordCircuitBreakerRejection(ctx, "authorize", "databroker")
+	RecordCircuitBreakerTransition(ctx, "authorize", "databroker", CircuitBreakerHalfOpen)
+
+	testDataRetrieval(CircuitBreakerStateView, t, "{ { {circuit_breaker databroker}{service authorize} }&{1} }")
+	testDataRetrieval(CircuitBreakerRejectionCountView, t, "{ { {circuit_breaker databroker}{service authorize} }&{2} }")
+}
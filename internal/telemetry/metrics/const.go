@@ -15,9 +15,24 @@ var (
 	TagKeyHost        = tag.MustNewKey("host")
 	TagKeyDestination = tag.MustNewKey("destination")
 
-	TagKeyStorageOperation = tag.MustNewKey("operation")
-	TagKeyStorageResult    = tag.MustNewKey("result")
-	TagKeyStorageBackend   = tag.MustNewKey("backend")
+	TagKeyStorageOperation  = tag.MustNewKey("operation")
+	TagKeyStorageResult     = tag.MustNewKey("result")
+	TagKeyStorageBackend    = tag.MustNewKey("backend")
+	TagKeyStorageRecordType = tag.MustNewKey("record_type")
+
+	TagKeyIdentityProvider = tag.MustNewKey("idp")
+
+	TagKeyCircuitBreaker      = tag.MustNewKey("circuit_breaker")
+	TagKeyCircuitBreakerState = tag.MustNewKey("state")
+
+	TagKeyPolicyEvaluatorResult = tag.MustNewKey("result")
+
+	TagKeySessionTokenFormat = tag.MustNewKey("format")
+	TagKeySessionTokenResult = tag.MustNewKey("result")
+
+	TagKeyRoute      = tag.MustNewKey("route")
+	TagKeyTenant     = tag.MustNewKey("tenant")
+	TagKeyHTTPStatus = tag.MustNewKey("status")
 )
 
 // Default distributions used by views in this package.
@@ -44,5 +59,10 @@ var (
 		HTTPServerViews,
 		InfoViews,
 		StorageViews,
+		IdentityViews,
+		CircuitBreakerViews,
+		PolicyEvaluatorViews,
+		RouteViews,
+		SessionTokenViews,
 	}
 )
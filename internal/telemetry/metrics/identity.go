@@ -0,0 +1,135 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+var (
+	// IdentityViews contains opencensus views for identity provider metrics.
+	IdentityViews = []*view.View{
+		IdentityLoginCountView,
+		IdentityLogoutCountView,
+		IdentityTokenRefreshFailureCountView,
+		IdentityActiveSessionsView,
+		IdentityDirectorySyncLastSuccessView,
+	}
+
+	identityLogins = stats.Int64(
+		"identity_logins_total",
+		"Total number of successful logins",
+		stats.UnitDimensionless)
+	identityLogouts = stats.Int64(
+		"identity_logouts_total",
+		"Total number of logouts",
+		stats.UnitDimensionless)
+	identityTokenRefreshFailures = stats.Int64(
+		"identity_token_refresh_failures_total",
+		"Total number of failed oauth2 token refreshes",
+		stats.UnitDimensionless)
+	identityActiveSessions = stats.Int64(
+		"identity_active_sessions",
+		"Number of currently active sessions",
+		stats.UnitDimensionless)
+	identityDirectorySyncLastSuccess = stats.Int64(
+		"identity_directory_sync_last_success_timestamp",
+		"Timestamp of last successful directory sync",
+		"seconds")
+
+	// IdentityLoginCountView is an OpenCensus view which counts successful
+	// logins by identity provider.
+	IdentityLoginCountView = &view.View{
+		Name:        identityLogins.Name(),
+		Description: identityLogins.Description(),
+		Measure:     identityLogins,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyIdentityProvider},
+		Aggregation: view.Count(),
+	}
+
+	// IdentityLogoutCountView is an OpenCensus view which counts logouts by
+	// identity provider.
+	IdentityLogoutCountView = &view.View{
+		Name:        identityLogouts.Name(),
+		Description: identityLogouts.Description(),
+		Measure:     identityLogouts,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyIdentityProvider},
+		Aggregation: view.Count(),
+	}
+
+	// IdentityTokenRefreshFailureCountView is an OpenCensus view which counts
+	// failed oauth2 token refreshes by identity provider.
+	IdentityTokenRefreshFailureCountView = &view.View{
+		Name:        identityTokenRefreshFailures.Name(),
+		Description: identityTokenRefreshFailures.Description(),
+		Measure:     identityTokenRefreshFailures,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyIdentityProvider},
+		Aggregation: view.Count(),
+	}
+
+	// IdentityActiveSessionsView contains the number of currently active
+	// sessions, labeled by identity provider.
+	IdentityActiveSessionsView = &view.View{
+		Name:        identityActiveSessions.Name(),
+		Description: identityActiveSessions.Description(),
+		Measure:     identityActiveSessions,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyIdentityProvider},
+		Aggregation: view.LastValue(),
+	}
+
+	// IdentityDirectorySyncLastSuccessView contains the timestamp of the last
+	// successful directory sync, labeled by identity provider.
+	IdentityDirectorySyncLastSuccessView = &view.View{
+		Name:        identityDirectorySyncLastSuccess.Name(),
+		Description: identityDirectorySyncLastSuccess.Description(),
+		Measure:     identityDirectorySyncLastSuccess,
+		TagKeys:     []tag.Key{TagKeyService, TagKeyIdentityProvider},
+		Aggregation: view.LastValue(),
+	}
+)
+
+// RecordLogin records a successful login for the given identity provider.
+func RecordLogin(ctx context.Context, service, idp string) {
+	recordIdentity(ctx, service, idp, identityLogins.M(1))
+}
+
+// RecordLogout records a logout for the given identity provider.
+func RecordLogout(ctx context.Context, service, idp string) {
+	recordIdentity(ctx, service, idp, identityLogouts.M(1))
+}
+
+// RecordTokenRefreshFailure records a failed oauth2 token refresh for the
+// given identity provider.
+func RecordTokenRefreshFailure(ctx context.Context, service, idp string) {
+	recordIdentity(ctx, service, idp, identityTokenRefreshFailures.M(1))
+}
+
+// SetActiveSessions records the number of currently active sessions for the
+// given identity provider.
+func SetActiveSessions(ctx context.Context, service, idp string, count int) {
+	recordIdentity(ctx, service, idp, identityActiveSessions.M(int64(count)))
+}
+
+// SetDirectorySyncSuccess records the time of a successful directory sync for
+// the given identity provider.
+func SetDirectorySyncSuccess(ctx context.Context, service, idp string, tm time.Time) {
+	recordIdentity(ctx, service, idp, identityDirectorySyncLastSuccess.M(tm.Unix()))
+}
+
+func recordIdentity(ctx context.Context, service, idp string, m stats.Measurement) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyService, service),
+			tag.Upsert(TagKeyIdentityProvider, idp),
+		},
+		m,
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
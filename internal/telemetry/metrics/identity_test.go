@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opencensus.io/stats/view"
+)
+
+func Test_RecordIdentity(t *testing.T) {
+	view.Unregister(IdentityViews...)
+	view.Register(IdentityViews...)
+
+	ctx := context.Background()
+	RecordLogin(ctx, "authenticate", "google")
+	RecordLogin(ctx, "authenticate", "google")
+	RecordLogout(ctx, "authenticate", "google")
+	RecordTokenRefreshFailure(ctx, "cache", "google")
+	SetActiveSessions(ctx, "cache", "google", 5)
+	SetActiveSessions(ctx, "cache", "google", 3)
+	SetDirectorySyncSuccess(ctx, "cache", "google", time.Unix(1600000000, 0))
+
+	testDataRetrieval(IdentityLoginCountView, t, "{ { {idp google}{service authenticate} }&{2} }")
+	testDataRetrieval(IdentityLogoutCountView, t, "{ { {idp google}{service authenticate} }&{1} }")
+	testDataRetrieval(IdentityTokenRefreshFailureCountView, t, "{ { {idp google}{service cache} }&{1} }")
+	testDataRetrieval(IdentityActiveSessionsView, t, "{ { {idp google}{service cache} }&{3} }")
+	testDataRetrieval(IdentityDirectorySyncLastSuccessView, t, "{ { {idp google}{service cache} }&{1.6e+09} }")
+}
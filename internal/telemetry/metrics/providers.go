@@ -5,10 +5,13 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 
 	ocprom "contrib.go.opencensus.io/exporter/prometheus"
 	prom "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"go.opencensus.io/stats/view"
 
 	log "github.com/pomerium/pomerium/internal/log"
@@ -33,6 +36,62 @@ func PrometheusHandler(envoyURL *url.URL) (http.Handler, error) {
 	return mux, nil
 }
 
+// TenantPrometheusHandler returns a handler which exports the metrics of a
+// single tenant to Prometheus, at /metrics/tenants/<tenant>. Only the series
+// with the tenant's label are exported, so a tenant's scraper never sees the
+// metrics of other tenants or of envoy. The bearer token of each request is
+// checked with authorize.
+func TenantPrometheusHandler(authorize func(tenant, token string) bool) (http.Handler, error) {
+	if _, err := getGlobalExporter(); err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant := strings.TrimPrefix(r.URL.Path, "/metrics/tenants/")
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if tenant == "" || strings.Contains(tenant, "/") || !authorize(tenant, token) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		families, err := prom.DefaultGatherer.Gather()
+		if err != nil {
+			log.Error().Err(err).Msg("telemetry/metrics: failed to gather metrics")
+		}
+		w.Header().Set("Content-Type", string(expfmt.FmtText))
+		for _, family := range filterTenantMetrics(families, tenant) {
+			if _, err := expfmt.MetricFamilyToText(w, family); err != nil {
+				log.Warn().Err(err).Msg("telemetry/metrics: failed to write tenant metrics")
+				return
+			}
+		}
+	}), nil
+}
+
+// filterTenantMetrics returns the metrics with the given tenant label.
+func filterTenantMetrics(families []*dto.MetricFamily, tenant string) []*dto.MetricFamily {
+	var filtered []*dto.MetricFamily
+	for _, family := range families {
+		var ms []*dto.Metric
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == TagKeyTenant.Name() && label.GetValue() == tenant {
+					ms = append(ms, m)
+					break
+				}
+			}
+		}
+		if len(ms) > 0 {
+			filtered = append(filtered, &dto.MetricFamily{
+				Name:   family.Name,
+				Help:   family.Help,
+				Type:   family.Type,
+				Metric: ms,
+			})
+		}
+	}
+	return filtered
+}
+
 var (
 	globalExporter     *ocprom.Exporter
 	globalExporterErr  error
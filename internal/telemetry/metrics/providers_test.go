@@ -1,12 +1,16 @@
 package metrics
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"regexp"
 	"testing"
+	"time"
+
+	"go.opencensus.io/stats/view"
 )
 
 func newEnvoyMetricsHandler() http.HandlerFunc {
@@ -71,3 +75,50 @@ func Test_PrometheusHandler(t *testing.T) {
 	})
 
 }
+
+func Test_TenantPrometheusHandler(t *testing.T) {
+	h, err := TenantPrometheusHandler(func(tenant, token string) bool {
+		return token == "secret-"+tenant
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	RecordRouteRequest(ctx, "route-a", "team-a", http.StatusOK, time.Millisecond)
+	RecordRouteRequest(ctx, "route-b", "team-b", http.StatusOK, time.Millisecond)
+	// measurements are recorded asynchronously, retrieving the view data
+	// waits for them
+	if _, err := view.RetrieveData(RouteRequestCountView.Name); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path, token string) (int, []byte) {
+		req := httptest.NewRequest("GET", "http://test.local"+path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		b, _ := ioutil.ReadAll(rec.Result().Body)
+		return rec.Code, b
+	}
+
+	code, b := get("/metrics/tenants/team-a", "secret-team-a")
+	if code != http.StatusOK {
+		t.Fatalf("Tenant metrics endpoint failed to respond: %d %s", code, b)
+	}
+	if m, _ := regexp.Match(`(?m)^pomerium_route_requests_total\{.*tenant="team-a".*\} [0-9]+$`, b); !m {
+		t.Errorf("Tenant metrics did not contain the tenant's requests: %s", b)
+	}
+	if m, _ := regexp.Match(`team-b|route-b|(?m)^go_`, b); m {
+		t.Errorf("Tenant metrics contained metrics of others: %s", b)
+	}
+
+	for _, tc := range []struct{ path, token string }{
+		{"/metrics/tenants/team-a", "secret-team-b"},
+		{"/metrics/tenants/team-a", ""},
+		{"/metrics/tenants/", "secret-"},
+	} {
+		if code, _ := get(tc.path, tc.token); code != http.StatusUnauthorized {
+			t.Errorf("expected %s with token %q to be unauthorized, got %d", tc.path, tc.token, code)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+var (
+	// RouteViews contains opencensus views for the requests to policy routes.
+	RouteViews = []*view.View{RouteRequestCountView, RouteRequestDurationView}
+
+	routeRequestDuration = stats.Int64(
+		"route_request_duration_ms",
+		"Duration of requests to policy routes in ms",
+		stats.UnitMilliseconds)
+
+	// RouteRequestCountView is an OpenCensus view which counts the requests to
+	// policy routes by route, tenant and status.
+	RouteRequestCountView = &view.View{
+		Name:        "route_requests_total",
+		Description: "Total requests to policy routes",
+		Measure:     routeRequestDuration,
+		TagKeys:     []tag.Key{TagKeyRoute, TagKeyTenant, TagKeyHTTPStatus},
+		Aggregation: view.Count(),
+	}
+
+	// RouteRequestDurationView is an OpenCensus view which tracks the duration
+	// of requests to policy routes by route, tenant and status.
+	RouteRequestDurationView = &view.View{
+		Name:        routeRequestDuration.Name(),
+		Description: routeRequestDuration.Description(),
+		Measure:     routeRequestDuration,
+		TagKeys:     []tag.Key{TagKeyRoute, TagKeyTenant, TagKeyHTTPStatus},
+		Aggregation: DefaultHTTPLatencyDistrubtion,
+	}
+)
+
+// RecordRouteRequest records a request to a policy route. The tenant is empty
+// for routes which don't belong to a tenant.
+func RecordRouteRequest(ctx context.Context, route, tenant string, status int, duration time.Duration) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyRoute, route),
+			tag.Upsert(TagKeyTenant, tenant),
+			tag.Upsert(TagKeyHTTPStatus, strconv.Itoa(status)),
+		},
+		routeRequestDuration.M(duration.Milliseconds()),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
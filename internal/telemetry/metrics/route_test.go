@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.opencensus.io/stats/view"
+)
+
+func Test_RecordRouteRequest(t *testing.T) {
+	view.Unregister(RouteViews...)
+	view.Register(RouteViews...)
+
+	ctx := context.Background()
+	RecordRouteRequest(ctx, "route-1", "team-a", http.StatusOK, 10*time.Millisecond)
+	RecordRouteRequest(ctx, "route-1", "team-a", http.StatusOK, 20*time.Millisecond)
+
+	testDataRetrieval(RouteRequestCountView, t, "{ { {route route-1}{status 200}{tenant team-a} }&{2} }")
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+var (
+	// SessionTokenViews contains opencensus views for session token metrics.
+	SessionTokenViews = []*view.View{
+		SessionTokenDecodeCountView,
+	}
+
+	sessionTokenDecodes = stats.Int64(
+		"session_token_decodes_total",
+		"Total number of decoded session tokens",
+		stats.UnitDimensionless)
+
+	// SessionTokenDecodeCountView is an OpenCensus view which counts decoded
+	// session tokens by format and result, so the use of the legacy format
+	// can be tracked while migrating away from it.
+	SessionTokenDecodeCountView = &view.View{
+		Name:        sessionTokenDecodes.Name(),
+		Description: sessionTokenDecodes.Description(),
+		Measure:     sessionTokenDecodes,
+		TagKeys:     []tag.Key{TagKeyService, TagKeySessionTokenFormat, TagKeySessionTokenResult},
+		Aggregation: view.Count(),
+	}
+)
+
+// The results reported by RecordSessionTokenDecode.
+const (
+	SessionTokenValid   = "valid"
+	SessionTokenInvalid = "invalid"
+	// SessionTokenRejected is the result of legacy tokens which are no
+	// longer accepted.
+	SessionTokenRejected = "rejected"
+)
+
+// RecordSessionTokenDecode records a decoded session token of the given
+// format.
+func RecordSessionTokenDecode(ctx context.Context, service, format, result string) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyService, service),
+			tag.Upsert(TagKeySessionTokenFormat, format),
+			tag.Upsert(TagKeySessionTokenResult, result),
+		},
+		sessionTokenDecodes.M(1),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
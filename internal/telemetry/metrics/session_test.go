@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"go.opencensus.io/stats/view"
+)
+
+func Test_RecordSessionTokenDecode(t *testing.T) {
+	view.Unregister(SessionTokenViews...)
+	view.Register(SessionTokenViews...)
+
+	ctx := context.Background()
+	RecordSessionTokenDecode(ctx, "proxy", "jwt", SessionTokenValid)
+	RecordSessionTokenDecode(ctx, "proxy", "jwt", SessionTokenValid)
+
+	testDataRetrieval(SessionTokenDecodeCountView, t, "{ { {format jwt}{result valid}{service proxy} }&{2} }")
+}
@@ -12,7 +12,7 @@ import (
 
 var (
 	// StorageViews contains opencensus views for storage system metrics
-	StorageViews = []*view.View{StorageOperationDurationView}
+	StorageViews = []*view.View{StorageOperationDurationView, StorageRecordsReclaimedView, StorageRecordsEvictedView}
 
 	storageOperationDuration = stats.Int64(
 		"storage_operation_duration_ms",
@@ -28,6 +28,36 @@ var (
 		TagKeys:     []tag.Key{TagKeyStorageOperation, TagKeyStorageResult, TagKeyStorageBackend, TagKeyService},
 		Aggregation: DefaultMillisecondsDistribution,
 	}
+
+	storageRecordsReclaimed = stats.Int64(
+		"storage_records_reclaimed_total",
+		"Total number of records deleted because their TTL expired",
+		stats.UnitDimensionless)
+
+	// StorageRecordsReclaimedView is an OpenCensus view that counts the
+	// records deleted by the databroker's garbage collection by record type
+	StorageRecordsReclaimedView = &view.View{
+		Name:        storageRecordsReclaimed.Name(),
+		Description: storageRecordsReclaimed.Description(),
+		Measure:     storageRecordsReclaimed,
+		TagKeys:     []tag.Key{TagKeyStorageRecordType, TagKeyService},
+		Aggregation: view.Sum(),
+	}
+
+	storageRecordsEvicted = stats.Int64(
+		"storage_records_evicted_total",
+		"Total number of records evicted because the storage was full",
+		stats.UnitDimensionless)
+
+	// StorageRecordsEvictedView is an OpenCensus view that counts the records
+	// evicted from the in-memory storage by record type
+	StorageRecordsEvictedView = &view.View{
+		Name:        storageRecordsEvicted.Name(),
+		Description: storageRecordsEvicted.Description(),
+		Measure:     storageRecordsEvicted,
+		TagKeys:     []tag.Key{TagKeyStorageRecordType, TagKeyService},
+		Aggregation: view.Sum(),
+	}
 )
 
 // StorageOperationTags contains tags to apply when recording a storage operation
@@ -59,3 +89,33 @@ func RecordStorageOperation(ctx context.Context, tags *StorageOperationTags, dur
 		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
 	}
 }
+
+// RecordStorageRecordsReclaimed records the number of records of a type
+// deleted because their TTL expired
+func RecordStorageRecordsReclaimed(ctx context.Context, recordType string, count int64) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyStorageRecordType, recordType),
+			tag.Upsert(TagKeyService, "databroker"),
+		},
+		storageRecordsReclaimed.M(count),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
+
+// RecordStorageRecordsEvicted records the number of records of a type
+// evicted because the storage was full
+func RecordStorageRecordsEvicted(ctx context.Context, recordType string, count int64) {
+	err := stats.RecordWithTags(ctx,
+		[]tag.Mutator{
+			tag.Upsert(TagKeyStorageRecordType, recordType),
+			tag.Upsert(TagKeyService, "databroker"),
+		},
+		storageRecordsEvicted.M(count),
+	)
+	if err != nil {
+		log.Warn().Err(err).Msg("internal/telemetry/metrics: failed to record")
+	}
+}
@@ -31,3 +31,21 @@ func Test_RecordStorageOperation(t *testing.T) {
 		})
 	}
 }
+
+func Test_RecordStorageRecordsReclaimed(t *testing.T) {
+	view.Unregister(StorageViews...)
+	view.Register(StorageViews...)
+	RecordStorageRecordsReclaimed(context.Background(), "type.googleapis.com/session.Session", 3)
+	RecordStorageRecordsReclaimed(context.Background(), "type.googleapis.com/session.Session", 2)
+
+	testDataRetrieval(StorageRecordsReclaimedView, t, "{ { {record_type type.googleapis.com/session.Session}{service databroker} }&{5}")
+}
+
+func Test_RecordStorageRecordsEvicted(t *testing.T) {
+	view.Unregister(StorageViews...)
+	view.Register(StorageViews...)
+	RecordStorageRecordsEvicted(context.Background(), "type.googleapis.com/session.Session", 1)
+	RecordStorageRecordsEvicted(context.Background(), "type.googleapis.com/session.Session", 4)
+
+	testDataRetrieval(StorageRecordsEvictedView, t, "{ { {record_type type.googleapis.com/session.Session}{service databroker} }&{5}")
+}
@@ -67,6 +67,8 @@ func RegisterTracing(opts *TracingOptions) (trace.Exporter, error) {
 	if err != nil {
 		return nil, err
 	}
+	exporter = redactingExporter{exporter}
+	trace.RegisterExporter(exporter)
 	trace.ApplyConfig(trace.Config{DefaultSampler: trace.ProbabilitySampler(opts.SampleRate)})
 
 	log.Debug().Interface("Opts", opts).Msg("telemetry/trace: exporter created")
@@ -86,12 +88,7 @@ func registerJaeger(opts *TracingOptions) (trace.Exporter, error) {
 	if opts.JaegerCollectorEndpoint != nil {
 		jOpts.CollectorEndpoint = opts.JaegerCollectorEndpoint.String()
 	}
-	jex, err := jaeger.NewExporter(jOpts)
-	if err != nil {
-		return nil, err
-	}
-	trace.RegisterExporter(jex)
-	return jex, nil
+	return jaeger.NewExporter(jOpts)
 }
 
 func registerZipkin(opts *TracingOptions) (trace.Exporter, error) {
@@ -102,10 +99,42 @@ func registerZipkin(opts *TracingOptions) (trace.Exporter, error) {
 
 	reporter := zipkinHTTP.NewReporter(opts.ZipkinEndpoint.String())
 
-	exporter := ocZipkin.NewExporter(reporter, localEndpoint)
-	trace.RegisterExporter(exporter)
+	return ocZipkin.NewExporter(reporter, localEndpoint), nil
+}
 
-	return exporter, nil
+// urlAttributes are the span attributes which may contain URLs with
+// sensitive query parameters.
+var urlAttributes = []string{"http.url", "http.referer"}
+
+// A redactingExporter redacts sensitive query parameters from spans before
+// they're exported.
+type redactingExporter struct {
+	trace.Exporter
+}
+
+func (e redactingExporter) ExportSpan(sd *trace.SpanData) {
+	var attrs map[string]interface{}
+	for _, k := range urlAttributes {
+		v, ok := sd.Attributes[k].(string)
+		if !ok || log.RedactURL(v) == v {
+			continue
+		}
+		if attrs == nil {
+			// span data is shared by every exporter, so it's copied before
+			// it's modified
+			attrs = make(map[string]interface{}, len(sd.Attributes))
+			for k, v := range sd.Attributes {
+				attrs[k] = v
+			}
+		}
+		attrs[k] = log.RedactURL(v)
+	}
+	if attrs != nil {
+		redacted := *sd
+		redacted.Attributes = attrs
+		sd = &redacted
+	}
+	e.Exporter.ExportSpan(sd)
 }
 
 // StartSpan starts a new child span of the current span in the context. If
@@ -3,6 +3,8 @@ package trace
 import (
 	"net/url"
 	"testing"
+
+	"go.opencensus.io/trace"
 )
 
 func TestRegisterTracing(t *testing.T) {
@@ -25,3 +27,35 @@ func TestRegisterTracing(t *testing.T) {
 		})
 	}
 }
+
+type spanRecorder struct {
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(sd *trace.SpanData) { r.spans = append(r.spans, sd) }
+
+func TestRedactingExporter(t *testing.T) {
+	r := new(spanRecorder)
+	e := redactingExporter{r}
+
+	sd := &trace.SpanData{Attributes: map[string]interface{}{
+		"http.url":    "https://example.com/callback?code=SECRET&state=1",
+		"http.method": "GET",
+	}}
+	e.ExportSpan(sd)
+	if got, want := r.spans[0].Attributes["http.url"], "https://example.com/callback?code=REDACTED&state=1"; got != want {
+		t.Errorf("http.url = %v, want %v", got, want)
+	}
+	if got := r.spans[0].Attributes["http.method"]; got != "GET" {
+		t.Errorf("http.method = %v, want GET", got)
+	}
+	if got := sd.Attributes["http.url"]; got != "https://example.com/callback?code=SECRET&state=1" {
+		t.Errorf("span data shared with other exporters should not be modified, got %v", got)
+	}
+
+	sd = &trace.SpanData{Attributes: map[string]interface{}{"http.url": "https://example.com/"}}
+	e.ExportSpan(sd)
+	if r.spans[1] != sd {
+		t.Error("spans without sensitive attributes should be exported as is")
+	}
+}
@@ -0,0 +1,142 @@
+// Package upstreamtoken implements the upstream token service, which acquires
+// the OAuth2 client credentials tokens added to the requests to upstreams and
+// caches them in the databroker.
+package upstreamtoken
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+)
+
+const (
+	// RefreshAhead is how long before its expiry a cached token is replaced.
+	RefreshAhead = time.Minute
+	// defaultTokenLifetime is used for tokens returned without an expiry.
+	defaultTokenLifetime = time.Hour
+	// fetchTimeout bounds the requests to the token endpoints, which are
+	// shared by all the callers waiting on the same token.
+	fetchTimeout = 30 * time.Second
+
+	tokenTypeURL = "type.googleapis.com/upstreamtoken.Token"
+)
+
+var timeNow = time.Now
+
+// A Server implements the upstream token service. Tokens are stored in the
+// databroker, so every replica of the service returns the same token until
+// it's about to expire.
+type Server struct {
+	dataBrokerClient databroker.DataBrokerServiceClient
+	singleflight     singleflight.Group
+}
+
+// New creates a new Server.
+func New(dataBrokerClient databroker.DataBrokerServiceClient) *Server {
+	return &Server{dataBrokerClient: dataBrokerClient}
+}
+
+// GetToken returns a token for the given client, acquiring a new one if the
+// cached token expires within RefreshAhead.
+func (srv *Server) GetToken(ctx context.Context, req *upstreamtoken.GetTokenRequest) (*upstreamtoken.Token, error) {
+	if req.GetTokenUrl() == "" || req.GetClientId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token url and client id are required")
+	}
+
+	id := upstreamtoken.TokenID(req)
+	res, err, _ := srv.singleflight.Do(id, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
+
+		if tok := srv.load(ctx, id); tok.ValidFor(timeNow(), RefreshAhead) {
+			return tok, nil
+		}
+
+		tok, err := fetch(ctx, req)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to get upstream token: %v", err)
+		}
+		srv.store(ctx, id, tok)
+		return tok, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*upstreamtoken.Token), nil
+}
+
+func (srv *Server) load(ctx context.Context, id string) *upstreamtoken.Token {
+	res, err := srv.dataBrokerClient.Get(ctx, &databroker.GetRequest{
+		Type: tokenTypeURL,
+		Id:   id,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		log.Warn().Err(err).Msg("upstreamtoken: failed to load token from databroker")
+		return nil
+	}
+
+	var tok upstreamtoken.Token
+	if err := ptypes.UnmarshalAny(res.GetRecord().GetData(), &tok); err != nil {
+		log.Warn().Err(err).Msg("upstreamtoken: invalid token in databroker")
+		return nil
+	}
+	return &tok
+}
+
+func (srv *Server) store(ctx context.Context, id string, tok *upstreamtoken.Token) {
+	any, err := ptypes.MarshalAny(tok)
+	if err != nil {
+		log.Warn().Err(err).Msg("upstreamtoken: failed to marshal token")
+		return
+	}
+	_, err = srv.dataBrokerClient.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   id,
+		Data: any,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("upstreamtoken: failed to store token in databroker")
+	}
+}
+
+func fetch(ctx context.Context, req *upstreamtoken.GetTokenRequest) (*upstreamtoken.Token, error) {
+	cfg := clientcredentials.Config{
+		ClientID:     req.GetClientId(),
+		ClientSecret: req.GetClientSecret(),
+		TokenURL:     req.GetTokenUrl(),
+		Scopes:       req.GetScopes(),
+	}
+	if req.GetAudience() != "" {
+		cfg.EndpointParams = url.Values{"audience": {req.GetAudience()}}
+	}
+	tok, err := cfg.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := tok.Expiry
+	if expiry.IsZero() {
+		expiry = timeNow().Add(defaultTokenLifetime)
+	}
+	expiresAt, err := ptypes.TimestampProto(expiry)
+	if err != nil {
+		return nil, err
+	}
+	return &upstreamtoken.Token{
+		AccessToken: tok.AccessToken,
+		TokenType:   tok.Type(),
+		ExpiresAt:   expiresAt,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package upstreamtoken
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	internal_databroker "github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/upstreamtoken"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	defer func() { timeNow = time.Now }()
+
+	var tokenRequests int
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		assert.Equal(t, "https://api.example.com", r.Form.Get("audience"))
+		clientID, clientSecret, _ := r.BasicAuth()
+		assert.Equal(t, "CLIENT_ID", clientID)
+		assert.Equal(t, "CLIENT_SECRET", clientSecret)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "ACCESS_TOKEN",
+			"token_type":   "Bearer",
+			"expires_in":   300,
+		})
+	}))
+	defer idp.Close()
+
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer li.Close()
+	grpcServer := grpc.NewServer()
+	databroker.RegisterDataBrokerServiceServer(grpcServer, internal_databroker.New())
+	go func() { _ = grpcServer.Serve(li) }()
+	defer grpcServer.Stop()
+	cc, err := grpc.Dial(li.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer cc.Close()
+	dataBrokerClient := databroker.NewDataBrokerServiceClient(cc)
+
+	req := &upstreamtoken.GetTokenRequest{
+		TokenUrl:     idp.URL,
+		ClientId:     "CLIENT_ID",
+		ClientSecret: "CLIENT_SECRET",
+		Audience:     "https://api.example.com",
+	}
+
+	// replicas share the token stored in the databroker
+	a, b := New(dataBrokerClient), New(dataBrokerClient)
+	tok, err := a.GetToken(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "ACCESS_TOKEN", tok.GetAccessToken())
+	_, err = b.GetToken(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenRequests)
+
+	res, err := dataBrokerClient.Get(ctx, &databroker.GetRequest{Type: tokenTypeURL, Id: upstreamtoken.TokenID(req)})
+	require.NoError(t, err)
+	assert.NotNil(t, res.GetRecord().GetData())
+
+	// the token is refreshed ahead of its expiry
+	now = now.Add(5*time.Minute - RefreshAhead + time.Second)
+	_, err = b.GetToken(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tokenRequests)
+
+	_, err = a.GetToken(ctx, &upstreamtoken.GetTokenRequest{ClientId: "CLIENT_ID"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -4,20 +4,34 @@ package urlutil
 // services over HTTP calls and redirects. They are typically used in
 // conjunction with a HMAC to ensure authenticity.
 const (
-	QueryCallbackURI       = "pomerium_callback_uri"
-	QueryImpersonateEmail  = "pomerium_impersonate_email"
-	QueryImpersonateGroups = "pomerium_impersonate_groups"
-	QueryImpersonateAction = "pomerium_impersonate_action"
-	QueryIsProgrammatic    = "pomerium_programmatic"
-	QueryForwardAuth       = "pomerium_forward_auth"
-	QueryPomeriumJWT       = "pomerium_jwt"
-	QuerySession           = "pomerium_session"
-	QuerySessionEncrypted  = "pomerium_session_encrypted"
-	QueryRedirectURI       = "pomerium_redirect_uri"
-	QueryRefreshToken      = "pomerium_refresh_token"
-	QueryAccessTokenID     = "pomerium_session_access_token_id"
-	QueryAudience          = "pomerium_session_audience"
-	QueryProgrammaticToken = "pomerium_programmatic_token"
+	QueryBrokerOrigin       = "pomerium_broker_origin"
+	QueryCallbackURI        = "pomerium_callback_uri"
+	QueryDeviceUserCode     = "pomerium_device_user_code"
+	QueryImpersonateEmail   = "pomerium_impersonate_email"
+	QueryImpersonateGroups  = "pomerium_impersonate_groups"
+	QueryImpersonateAction  = "pomerium_impersonate_action"
+	QueryImpersonateGrant   = "pomerium_impersonate_grant"
+	QueryIdentityProviderID = "pomerium_idp_id"
+	QueryIsProgrammatic     = "pomerium_programmatic"
+	QueryKioskDevice        = "pomerium_kiosk_device"
+	QueryKioskDeviceName    = "pomerium_kiosk_device_name"
+	QueryKioskRoutes        = "pomerium_kiosk_routes"
+	QueryKioskUserCode      = "pomerium_kiosk_user_code"
+	QueryForwardAuth        = "pomerium_forward_auth"
+	QueryPomeriumJWT        = "pomerium_jwt"
+	QuerySession            = "pomerium_session"
+	QuerySessionEncrypted   = "pomerium_session_encrypted"
+	QuerySessionMaxAge      = "pomerium_session_max_age"
+	QueryRedirectURI        = "pomerium_redirect_uri"
+	QueryStepUpACRValues    = "pomerium_acr_values"
+	QueryStepUpAMRValues    = "pomerium_amr_values"
+	QueryRole               = "pomerium_role"
+	QueryRoleDuration       = "pomerium_role_duration"
+	QueryRoleReason         = "pomerium_role_reason"
+	QueryRefreshToken       = "pomerium_refresh_token"
+	QueryAccessTokenID      = "pomerium_session_access_token_id"
+	QueryAudience           = "pomerium_session_audience"
+	QueryProgrammaticToken  = "pomerium_programmatic_token"
 )
 
 // URL signature based query params used for verifying the authenticity of a URL.
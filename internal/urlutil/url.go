@@ -101,3 +101,18 @@ func GetDomainsForURL(u *url.URL) []string {
 	// for everything else we return two routes: 'example.com' and 'example.com:443'
 	return []string{u.Hostname(), net.JoinHostPort(u.Hostname(), defaultPort)}
 }
+
+// IsLoopback returns true if the url is an http(s) url of the loopback
+// interface, which native apps listen on to receive redirects.
+// https://tools.ietf.org/html/rfc8252#section-7.3
+func IsLoopback(u *url.URL) bool {
+	if u == nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	host := u.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
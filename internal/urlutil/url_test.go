@@ -157,3 +157,29 @@ func TestGetDomainsForURL(t *testing.T) {
 		})
 	}
 }
+
+func TestIsLoopback(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		rawURL string
+		want   bool
+	}{
+		{"http://127.0.0.1:8000/", true},
+		{"http://[::1]:8000/callback", true},
+		{"http://localhost", true},
+		{"https://localhost:8443", true},
+		{"http://127.0.0.1.example.com", false},
+		{"http://localhost.example.com", false},
+		{"https://example.com", false},
+		{"ftp://127.0.0.1", false},
+	}
+	for _, tc := range tests {
+		u, err := url.Parse(tc.rawURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := IsLoopback(u); got != tc.want {
+			t.Errorf("IsLoopback(%q) = %v, want %v", tc.rawURL, got, tc.want)
+		}
+	}
+}
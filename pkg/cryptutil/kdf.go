@@ -0,0 +1,21 @@
+package cryptutil
+
+import (
+	"crypto/sha256"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// DeriveKey derives a key of DefaultKeySize bytes from a secret with
+// HKDF-SHA256. Keys derived for different purposes, identified by info, are
+// unrelated, so a single secret can be used for several of them.
+func DeriveKey(secret []byte, info string) []byte {
+	key := make([]byte, DefaultKeySize)
+	r := hkdf.New(sha256.New, secret, nil, []byte(info))
+	if _, err := io.ReadFull(r, key); err != nil {
+		// only possible if more than 255 blocks are read
+		panic(err)
+	}
+	return key
+}
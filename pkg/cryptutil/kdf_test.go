@@ -0,0 +1,28 @@
+package cryptutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeriveKey(t *testing.T) {
+	t.Parallel()
+	secret := NewKey()
+
+	k1 := DeriveKey(secret, "a")
+	if len(k1) != DefaultKeySize {
+		t.Fatalf("DeriveKey() = %d bytes, want %d", len(k1), DefaultKeySize)
+	}
+	if !bytes.Equal(k1, DeriveKey(secret, "a")) {
+		t.Error("DeriveKey() should be deterministic")
+	}
+	if bytes.Equal(k1, DeriveKey(secret, "b")) {
+		t.Error("DeriveKey() should differ by info")
+	}
+	if bytes.Equal(k1, secret) {
+		t.Error("DeriveKey() should not return the secret")
+	}
+	if _, err := NewAEADCipher(k1); err != nil {
+		t.Errorf("NewAEADCipher() error = %v", err)
+	}
+}
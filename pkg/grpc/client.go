@@ -18,10 +18,12 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/balancer/roundrobin"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
 
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry"
 	"github.com/pomerium/pomerium/internal/telemetry/requestid"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 )
 
 const (
@@ -52,6 +54,19 @@ type Options struct {
 
 	// ServiceName specifies the service name for telemetry exposition
 	ServiceName string
+
+	// Compression gzips the messages sent over the connection. Servers
+	// respond to compressed requests and streams with compressed messages.
+	Compression bool
+
+	// Namespace is the databroker namespace sent with every request, which
+	// scopes the records the databroker reads and writes.
+	Namespace string
+
+	// ClientID and ClientSecret are the databroker client credential a token
+	// is sent with every request for, when the databroker has client ACLs.
+	ClientID     string
+	ClientSecret []byte
 }
 
 // NewGRPCClientConn returns a new gRPC pomerium service client connection.
@@ -74,10 +89,21 @@ func NewGRPCClientConn(opts *Options) (*grpc.ClientConn, error) {
 		grpc.WithChainUnaryInterceptor(
 			requestid.UnaryClientInterceptor(),
 			grpcTimeoutInterceptor(opts.RequestTimeout),
+			serviceNameUnaryInterceptor(opts.ServiceName),
+			namespaceUnaryInterceptor(opts.Namespace),
+			clientTokenUnaryInterceptor(opts.ClientID, opts.ClientSecret),
+		),
+		grpc.WithChainStreamInterceptor(
+			requestid.StreamClientInterceptor(),
+			serviceNameStreamInterceptor(opts.ServiceName),
+			namespaceStreamInterceptor(opts.Namespace),
+			clientTokenStreamInterceptor(opts.ClientID, opts.ClientSecret),
 		),
-		grpc.WithStreamInterceptor(requestid.StreamClientInterceptor()),
 		grpc.WithDefaultCallOptions([]grpc.CallOption{grpc.WaitForReady(true)}...),
 	}
+	if opts.Compression {
+		dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
 
 	clientStatsHandler := telemetry.NewGRPCClientStatsHandler(opts.ServiceName)
 	dialOptions = clientStatsHandler.DialOptions(dialOptions...)
@@ -147,6 +173,80 @@ func grpcTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
 	}
 }
 
+// serviceNameUnaryInterceptor sends the name of the calling service, so
+// servers can tell which service made a request.
+func serviceNameUnaryInterceptor(serviceName string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if serviceName != "" {
+			ctx = grpcutil.WithOutgoingServiceName(ctx, serviceName)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// serviceNameStreamInterceptor is serviceNameUnaryInterceptor for streams.
+func serviceNameStreamInterceptor(serviceName string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if serviceName != "" {
+			ctx = grpcutil.WithOutgoingServiceName(ctx, serviceName)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// namespaceUnaryInterceptor sends the databroker namespace.
+func namespaceUnaryInterceptor(namespace string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if namespace != "" {
+			ctx = grpcutil.WithOutgoingNamespace(ctx, namespace)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// namespaceStreamInterceptor is namespaceUnaryInterceptor for streams.
+func namespaceStreamInterceptor(namespace string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if namespace != "" {
+			ctx = grpcutil.WithOutgoingNamespace(ctx, namespace)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// clientTokenTTL is how long the databroker client tokens sent with requests
+// are valid for.
+const clientTokenTTL = 5 * time.Minute
+
+// clientTokenUnaryInterceptor sends a databroker client token for the given
+// client credential.
+func clientTokenUnaryInterceptor(clientID string, secret []byte) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if clientID != "" {
+			rawToken, err := grpcutil.NewClientToken(clientID, secret, clientTokenTTL)
+			if err != nil {
+				return err
+			}
+			ctx = grpcutil.WithOutgoingClientToken(ctx, rawToken)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// clientTokenStreamInterceptor is clientTokenUnaryInterceptor for streams.
+func clientTokenStreamInterceptor(clientID string, secret []byte) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if clientID != "" {
+			rawToken, err := grpcutil.NewClientToken(clientID, secret, clientTokenTTL)
+			if err != nil {
+				return nil, err
+			}
+			ctx = grpcutil.WithOutgoingClientToken(ctx, rawToken)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
 type grpcClientConnRecord struct {
 	conn *grpc.ClientConn
 	opts *Options
@@ -0,0 +1,170 @@
+// Package device contains protobuf types for OAuth 2.0 device authorization
+// grants.
+package device
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// ErrChanged is returned by CompareAndSet and CompareAndDelete when the
+// authorization was changed or deleted since it was read.
+var ErrChanged = errors.New("device authorization changed")
+
+// ErrExists is returned by Create when the authorization already exists.
+var ErrExists = errors.New("device authorization already exists")
+
+// CompareAndDelete deletes an authorization from the databroker only if its
+// record still has the given version, and returns ErrChanged otherwise.
+func CompareAndDelete(ctx context.Context, client databroker.DataBrokerServiceClient, authorizationID, version string) error {
+	any, _ := ptypes.MarshalAny(new(Authorization))
+	_, err := client.Delete(ctx, &databroker.DeleteRequest{
+		Type:            any.GetTypeUrl(),
+		Id:              authorizationID,
+		ExpectedVersion: version,
+	})
+	if status.Code(err) == codes.Aborted {
+		return ErrChanged
+	} else if err != nil {
+		return fmt.Errorf("error deleting device authorization: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes an authorization from the databroker.
+func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, authorizationID string) error {
+	any, _ := ptypes.MarshalAny(new(Authorization))
+	_, err := client.Delete(ctx, &databroker.DeleteRequest{
+		Type: any.GetTypeUrl(),
+		Id:   authorizationID,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting device authorization: %w", err)
+	}
+	return nil
+}
+
+// Get gets an authorization from the databroker.
+func Get(ctx context.Context, client databroker.DataBrokerServiceClient, authorizationID string) (*Authorization, error) {
+	a, _, err := GetWithVersion(ctx, client, authorizationID)
+	return a, err
+}
+
+// GetWithVersion gets an authorization and the version of its record from the
+// databroker, for use with CompareAndSet and CompareAndDelete.
+func GetWithVersion(ctx context.Context, client databroker.DataBrokerServiceClient, authorizationID string) (*Authorization, string, error) {
+	any, _ := ptypes.MarshalAny(new(Authorization))
+
+	res, err := client.Get(ctx, &databroker.GetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   authorizationID,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting device authorization from databroker: %w", err)
+	}
+
+	var a Authorization
+	err = ptypes.UnmarshalAny(res.GetRecord().GetData(), &a)
+	if err != nil {
+		return nil, "", fmt.Errorf("error unmarshaling device authorization from databroker: %w", err)
+	}
+	return &a, res.GetRecord().GetVersion(), nil
+}
+
+// GetByUserCode gets the pending authorization with the given user code from
+// the databroker.
+func GetByUserCode(ctx context.Context, client databroker.DataBrokerServiceClient, userCode string, now time.Time) (*Authorization, error) {
+	any, _ := ptypes.MarshalAny(new(Authorization))
+
+	res, err := client.GetAll(ctx, &databroker.GetAllRequest{
+		Type: any.GetTypeUrl(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting device authorizations from databroker: %w", err)
+	}
+
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		var a Authorization
+		err = ptypes.UnmarshalAny(record.GetData(), &a)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling device authorization from databroker: %w", err)
+		}
+		if a.GetUserCode() == userCode && a.IsPending(now) {
+			return &a, nil
+		}
+	}
+	return nil, fmt.Errorf("no pending device authorization with code %s", userCode)
+}
+
+// Set sets an authorization in the databroker.
+func Set(ctx context.Context, client databroker.DataBrokerServiceClient, a *Authorization) (*databroker.Record, error) {
+	any, _ := anypb.New(a)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   a.Id,
+		Data: any,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting device authorization in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
+
+// Create saves a new authorization in the databroker, and returns ErrExists if
+// it's already there.
+func Create(ctx context.Context, client databroker.DataBrokerServiceClient, a *Authorization) (*databroker.Record, error) {
+	any, _ := anypb.New(a)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type:       any.GetTypeUrl(),
+		Id:         a.Id,
+		Data:       any,
+		CreateOnly: true,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return nil, ErrExists
+	} else if err != nil {
+		return nil, fmt.Errorf("error setting device authorization in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
+
+// CompareAndSet sets an authorization in the databroker only if its record
+// still has the given version, and returns ErrChanged otherwise.
+func CompareAndSet(ctx context.Context, client databroker.DataBrokerServiceClient, a *Authorization, version string) (*databroker.Record, error) {
+	any, _ := anypb.New(a)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type:            any.GetTypeUrl(),
+		Id:              a.Id,
+		Data:            any,
+		ExpectedVersion: version,
+	})
+	if status.Code(err) == codes.Aborted {
+		return nil, ErrChanged
+	} else if err != nil {
+		return nil, fmt.Errorf("error setting device authorization in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
+
+// IsExpired returns true if the authorization's codes have expired.
+func (x *Authorization) IsExpired(now time.Time) bool {
+	return x.GetExpiresAt() == nil || !now.Before(x.GetExpiresAt().AsTime())
+}
+
+// IsPending returns true if the authorization is waiting for a user to approve
+// or deny it and hasn't expired.
+func (x *Authorization) IsPending(now time.Time) bool {
+	return x.GetApprovedAt() == nil && x.GetDeniedAt() == nil && !x.IsExpired(now)
+}
@@ -6,3 +6,7 @@ package grpc
 //go:generate ../../scripts/protoc -I ./directory/ --go_out=plugins=grpc,paths=source_relative:./directory/. ./directory/directory.proto
 //go:generate ../../scripts/protoc -I ./audit/ --go_out=plugins=grpc,paths=source_relative:./audit/. ./audit/audit.proto
 //go:generate ../../scripts/protoc -I ./config/ --go_out=plugins=grpc,paths=source_relative:./config/. ./config/config.proto
+//go:generate ../../scripts/protoc -I ./device/ --go_out=plugins=grpc,paths=source_relative:./device/. ./device/device.proto
+//go:generate ../../scripts/protoc -I ./impersonation/ --go_out=plugins=grpc,paths=source_relative:./impersonation/. ./impersonation/impersonation.proto
+//go:generate ../../scripts/protoc -I ./kiosk/ --go_out=plugins=grpc,paths=source_relative:./kiosk/. ./kiosk/kiosk.proto
+//go:generate ../../scripts/protoc -I ./standby/ --go_out=plugins=grpc,paths=source_relative:./standby/. ./standby/standby.proto
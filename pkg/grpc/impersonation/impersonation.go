@@ -0,0 +1,131 @@
+// Package impersonation contains protobuf types for impersonation grants.
+package impersonation
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// Errors returned by Grant.Validate.
+var (
+	ErrPending  = errors.New("impersonation grant is pending approval")
+	ErrExpired  = errors.New("impersonation grant is expired")
+	ErrMismatch = errors.New("impersonation grant does not match the session")
+)
+
+// Delete deletes a grant from the databroker.
+func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) error {
+	any, _ := ptypes.MarshalAny(new(Grant))
+	_, err := client.Delete(ctx, &databroker.DeleteRequest{
+		Type: any.GetTypeUrl(),
+		Id:   sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting impersonation grant: %w", err)
+	}
+	return nil
+}
+
+// Get gets a grant from the databroker.
+func Get(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) (*Grant, error) {
+	any, _ := ptypes.MarshalAny(new(Grant))
+
+	res, err := client.Get(ctx, &databroker.GetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   sessionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonation grant from databroker: %w", err)
+	}
+
+	var g Grant
+	err = ptypes.UnmarshalAny(res.GetRecord().GetData(), &g)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling impersonation grant from databroker: %w", err)
+	}
+	return &g, nil
+}
+
+// GetAll gets all the grants in the databroker, ordered by the time they were
+// requested.
+func GetAll(ctx context.Context, client databroker.DataBrokerServiceClient) ([]*Grant, error) {
+	any, _ := ptypes.MarshalAny(new(Grant))
+
+	res, err := client.GetAll(ctx, &databroker.GetAllRequest{
+		Type: any.GetTypeUrl(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonation grants from databroker: %w", err)
+	}
+
+	var gs []*Grant
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		var g Grant
+		err = ptypes.UnmarshalAny(record.GetData(), &g)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling impersonation grant from databroker: %w", err)
+		}
+		gs = append(gs, &g)
+	}
+	sort.Slice(gs, func(i, j int) bool {
+		return gs[i].GetRequestedAt().AsTime().Before(gs[j].GetRequestedAt().AsTime())
+	})
+	return gs, nil
+}
+
+// Set sets a grant in the databroker.
+func Set(ctx context.Context, client databroker.DataBrokerServiceClient, g *Grant) (*databroker.Record, error) {
+	any, _ := anypb.New(g)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   g.Id,
+		Data: any,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting impersonation grant in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
+
+// IsApproved returns true if the grant has been approved.
+func (x *Grant) IsApproved() bool {
+	return x.GetApprovedAt() != nil
+}
+
+// Validate returns an error if the grant doesn't allow impersonating the
+// given email and groups at the given time.
+func (x *Grant) Validate(now time.Time, email string, groups []string) error {
+	if x.GetImpersonateEmail() != email || !equalStrings(x.GetImpersonateGroups(), groups) {
+		return ErrMismatch
+	}
+	if !x.IsApproved() {
+		return ErrPending
+	}
+	if x.GetExpiresAt() == nil || !now.Before(x.GetExpiresAt().AsTime()) {
+		return ErrExpired
+	}
+	return nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
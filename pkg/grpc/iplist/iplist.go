@@ -0,0 +1,141 @@
+// Package iplist contains protobuf types for IP lists.
+package iplist
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// IsValidName returns true if the name may be used as the id of an IP list.
+func IsValidName(name string) bool {
+	return nameRegexp.MatchString(name)
+}
+
+// Validate checks that the list has a valid name and that all its entries
+// are IP addresses or CIDRs.
+func (x *IPList) Validate() error {
+	if !IsValidName(x.GetId()) {
+		return fmt.Errorf("invalid ip list name %q, must only contain lowercase letters, digits, dashes and underscores", x.GetId())
+	}
+	for _, cidr := range x.GetCidrs() {
+		if _, err := parseCIDR(cidr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Contains returns true if the IP address is in one of the list's CIDRs.
+// Invalid entries never match.
+func (x *IPList) Contains(ip net.IP) bool {
+	for _, cidr := range x.GetCidrs() {
+		n, err := parseCIDR(cidr)
+		if err == nil && n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCIDR parses a CIDR, or a single IP address.
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid ip address %q", cidr)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cidr %q", cidr)
+	}
+	return n, nil
+}
+
+// Delete deletes an IP list from the databroker.
+func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, id string) error {
+	any, _ := ptypes.MarshalAny(new(IPList))
+	_, err := client.Delete(ctx, &databroker.DeleteRequest{
+		Type: any.GetTypeUrl(),
+		Id:   id,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting ip list: %w", err)
+	}
+	return nil
+}
+
+// Get gets an IP list from the databroker.
+func Get(ctx context.Context, client databroker.DataBrokerServiceClient, id string) (*IPList, error) {
+	any, _ := ptypes.MarshalAny(new(IPList))
+
+	res, err := client.Get(ctx, &databroker.GetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ip list from databroker: %w", err)
+	}
+
+	var l IPList
+	err = ptypes.UnmarshalAny(res.GetRecord().GetData(), &l)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling ip list from databroker: %w", err)
+	}
+	return &l, nil
+}
+
+// GetAll gets all the IP lists in the databroker.
+func GetAll(ctx context.Context, client databroker.DataBrokerServiceClient) ([]*IPList, error) {
+	any, _ := ptypes.MarshalAny(new(IPList))
+
+	res, err := client.GetAll(ctx, &databroker.GetAllRequest{
+		Type: any.GetTypeUrl(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ip lists from databroker: %w", err)
+	}
+
+	var ls []*IPList
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		var l IPList
+		err = ptypes.UnmarshalAny(record.GetData(), &l)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling ip list from databroker: %w", err)
+		}
+		ls = append(ls, &l)
+	}
+	return ls, nil
+}
+
+// Set sets an IP list in the databroker.
+func Set(ctx context.Context, client databroker.DataBrokerServiceClient, l *IPList) (*databroker.Record, error) {
+	any, _ := anypb.New(l)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   l.GetId(),
+		Data: any,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting ip list in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
@@ -0,0 +1,162 @@
+// Package kiosk contains protobuf types for kiosk devices.
+package kiosk
+
+import (
+	context "context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// userIDPrefix is the prefix of the ids of the users kiosk devices act as.
+const userIDPrefix = "kiosk/"
+
+// userCodeAlphabet excludes vowels and easily confused characters, as
+// recommended by RFC 8628 section 6.1.
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+// DeviceID returns the id of the device holding the given device code.
+func DeviceID(deviceCode string) string {
+	h := sha256.Sum256([]byte(deviceCode))
+	return hex.EncodeToString(h[:])
+}
+
+// UserID returns the id of the user a device acts as.
+func UserID(deviceID string) string {
+	return userIDPrefix + deviceID
+}
+
+// IsUserID returns true if the user id belongs to a kiosk device.
+func IsUserID(userID string) bool {
+	return strings.HasPrefix(userID, userIDPrefix)
+}
+
+// NewUserCode returns a new random user code of the form "BCDF-GHJK".
+func NewUserCode() (string, error) {
+	var sb strings.Builder
+	for i := 0; i < 8; i++ {
+		if i == 4 {
+			sb.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(userCodeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// NormalizeUserCode returns the user code in its canonical form, so that
+// codes entered in lower case or without the dash still match.
+func NormalizeUserCode(userCode string) string {
+	userCode = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(userCode))
+	if len(userCode) != 8 {
+		return userCode
+	}
+	return userCode[:4] + "-" + userCode[4:]
+}
+
+// Delete deletes a device from the databroker.
+func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, deviceID string) error {
+	any, _ := ptypes.MarshalAny(new(Device))
+	_, err := client.Delete(ctx, &databroker.DeleteRequest{
+		Type: any.GetTypeUrl(),
+		Id:   deviceID,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting kiosk device: %w", err)
+	}
+	return nil
+}
+
+// Get gets a device from the databroker.
+func Get(ctx context.Context, client databroker.DataBrokerServiceClient, deviceID string) (*Device, error) {
+	any, _ := ptypes.MarshalAny(new(Device))
+
+	res, err := client.Get(ctx, &databroker.GetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   deviceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting kiosk device from databroker: %w", err)
+	}
+
+	var d Device
+	err = ptypes.UnmarshalAny(res.GetRecord().GetData(), &d)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling kiosk device from databroker: %w", err)
+	}
+	return &d, nil
+}
+
+// GetAll gets all the devices in the databroker, ordered by the time they
+// were requested.
+func GetAll(ctx context.Context, client databroker.DataBrokerServiceClient) ([]*Device, error) {
+	any, _ := ptypes.MarshalAny(new(Device))
+
+	res, err := client.GetAll(ctx, &databroker.GetAllRequest{
+		Type: any.GetTypeUrl(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting kiosk devices from databroker: %w", err)
+	}
+
+	var ds []*Device
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		var d Device
+		err = ptypes.UnmarshalAny(record.GetData(), &d)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling kiosk device from databroker: %w", err)
+		}
+		ds = append(ds, &d)
+	}
+	sort.Slice(ds, func(i, j int) bool {
+		return ds[i].GetRequestedAt().AsTime().Before(ds[j].GetRequestedAt().AsTime())
+	})
+	return ds, nil
+}
+
+// Set sets a device in the databroker.
+func Set(ctx context.Context, client databroker.DataBrokerServiceClient, d *Device) (*databroker.Record, error) {
+	any, _ := anypb.New(d)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   d.Id,
+		Data: any,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting kiosk device in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
+
+// IsApproved returns true if the device has been approved.
+func (x *Device) IsApproved() bool {
+	return x.GetApprovedAt() != nil
+}
+
+// IsPending returns true if the device is waiting for approval and its user
+// code hasn't expired.
+func (x *Device) IsPending(now time.Time) bool {
+	return !x.IsApproved() && x.GetCodeExpiresAt() != nil && now.Before(x.GetCodeExpiresAt().AsTime())
+}
+
+// IsActive returns true if the device has been approved and its session
+// hasn't expired.
+func (x *Device) IsActive(now time.Time) bool {
+	return x.IsApproved() && x.GetExpiresAt() != nil && now.Before(x.GetExpiresAt().AsTime())
+}
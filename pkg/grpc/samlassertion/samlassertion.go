@@ -0,0 +1,77 @@
+// Package samlassertion contains protobuf types for used SAML assertions.
+package samlassertion
+
+import (
+	context "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// ID returns the id of the assertion with the given issuer and assertion id.
+// Assertion ids are only unique per issuer.
+func ID(issuer, assertionID string) string {
+	h := sha256.Sum256([]byte(issuer + "\x00" + assertionID))
+	return hex.EncodeToString(h[:])
+}
+
+// Add adds an assertion to the databroker. It returns false if the assertion
+// was already there, which means it has already been used.
+func Add(ctx context.Context, client databroker.DataBrokerServiceClient, a *Assertion) (bool, error) {
+	any, _ := anypb.New(a)
+	_, err := client.Set(ctx, &databroker.SetRequest{
+		Type:       any.GetTypeUrl(),
+		Id:         a.Id,
+		Data:       any,
+		CreateOnly: true,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("error adding saml assertion to databroker: %w", err)
+	}
+	return true, nil
+}
+
+// DeleteExpired deletes the assertions which have expired from the
+// databroker.
+func DeleteExpired(ctx context.Context, client databroker.DataBrokerServiceClient, now time.Time) error {
+	any, _ := ptypes.MarshalAny(new(Assertion))
+
+	res, err := client.GetAll(ctx, &databroker.GetAllRequest{
+		Type: any.GetTypeUrl(),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting saml assertions from databroker: %w", err)
+	}
+
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		var a Assertion
+		err = ptypes.UnmarshalAny(record.GetData(), &a)
+		if err != nil {
+			return fmt.Errorf("error unmarshaling saml assertion from databroker: %w", err)
+		}
+		if now.Before(a.GetExpiresAt().AsTime()) {
+			continue
+		}
+		_, err = client.Delete(ctx, &databroker.DeleteRequest{
+			Type: any.GetTypeUrl(),
+			Id:   record.GetId(),
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting saml assertion: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Package serviceaccount contains protobuf types for service accounts.
+package serviceaccount
+
+import (
+	context "context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// userIDPrefix is the prefix of the ids of the users service accounts act as.
+const userIDPrefix = "serviceaccount/"
+
+// UserID returns the id of the user a service account acts as.
+func UserID(serviceAccountID string) string {
+	return userIDPrefix + serviceAccountID
+}
+
+// IsUserID returns true if the user id belongs to a service account.
+func IsUserID(userID string) bool {
+	return strings.HasPrefix(userID, userIDPrefix)
+}
+
+// Delete deletes a service account from the databroker. Its token is no
+// longer accepted once it's deleted.
+func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, serviceAccountID string) error {
+	any, _ := ptypes.MarshalAny(new(ServiceAccount))
+	_, err := client.Delete(ctx, &databroker.DeleteRequest{
+		Type: any.GetTypeUrl(),
+		Id:   serviceAccountID,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting service account: %w", err)
+	}
+	return nil
+}
+
+// Get gets a service account from the databroker.
+func Get(ctx context.Context, client databroker.DataBrokerServiceClient, serviceAccountID string) (*ServiceAccount, error) {
+	any, _ := ptypes.MarshalAny(new(ServiceAccount))
+
+	res, err := client.Get(ctx, &databroker.GetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   serviceAccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting service account from databroker: %w", err)
+	}
+
+	var sa ServiceAccount
+	err = ptypes.UnmarshalAny(res.GetRecord().GetData(), &sa)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling service account from databroker: %w", err)
+	}
+	return &sa, nil
+}
+
+// GetAll gets all the service accounts in the databroker, ordered by the time
+// they were issued.
+func GetAll(ctx context.Context, client databroker.DataBrokerServiceClient) ([]*ServiceAccount, error) {
+	any, _ := ptypes.MarshalAny(new(ServiceAccount))
+
+	res, err := client.GetAll(ctx, &databroker.GetAllRequest{
+		Type: any.GetTypeUrl(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting service accounts from databroker: %w", err)
+	}
+
+	var sas []*ServiceAccount
+	for _, record := range res.GetRecords() {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		var sa ServiceAccount
+		err = ptypes.UnmarshalAny(record.GetData(), &sa)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling service account from databroker: %w", err)
+		}
+		sas = append(sas, &sa)
+	}
+	sort.Slice(sas, func(i, j int) bool {
+		return sas[i].GetIssuedAt().AsTime().Before(sas[j].GetIssuedAt().AsTime())
+	})
+	return sas, nil
+}
+
+// Set sets a service account in the databroker.
+func Set(ctx context.Context, client databroker.DataBrokerServiceClient, sa *ServiceAccount) (*databroker.Record, error) {
+	any, _ := anypb.New(sa)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type: any.GetTypeUrl(),
+		Id:   sa.Id,
+		Data: any,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting service account in databroker: %w", err)
+	}
+	return res.GetRecord(), nil
+}
+
+// IsActive returns true if the service account's token hasn't expired.
+func (x *ServiceAccount) IsActive(now time.Time) bool {
+	return x.GetExpiresAt() == nil || now.Before(x.GetExpiresAt().AsTime())
+}
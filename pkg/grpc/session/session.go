@@ -3,14 +3,23 @@ package session
 
 import (
 	context "context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
+// ErrChanged is returned by CompareAndSet when the session was changed or
+// deleted since it was read.
+var ErrChanged = errors.New("session changed")
+
 // Delete deletes a session from the databroker.
 func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) error {
 	any, _ := ptypes.MarshalAny(new(Session))
@@ -44,6 +53,42 @@ func Get(ctx context.Context, client databroker.DataBrokerServiceClient, session
 	return &s, nil
 }
 
+// GetAllForUser gets all the sessions of a user from the databroker, using
+// the user id index rather than reading every session.
+func GetAllForUser(ctx context.Context, client databroker.DataBrokerServiceClient, userID string) ([]*Session, error) {
+	return getAllByIndex(ctx, client, "user_id", userID)
+}
+
+// GetAllForIdentityProviderSession gets all the sessions with the given
+// identity provider session id from the databroker.
+func GetAllForIdentityProviderSession(ctx context.Context, client databroker.DataBrokerServiceClient, idpSessionID string) ([]*Session, error) {
+	return getAllByIndex(ctx, client, "idp_session_id", idpSessionID)
+}
+
+func getAllByIndex(ctx context.Context, client databroker.DataBrokerServiceClient, index, value string) ([]*Session, error) {
+	any, _ := ptypes.MarshalAny(new(Session))
+
+	res, err := client.GetAllByIndex(ctx, &databroker.GetAllByIndexRequest{
+		Type:  any.GetTypeUrl(),
+		Index: index,
+		Value: value,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting sessions by %s from databroker: %w", index, err)
+	}
+
+	sessions := make([]*Session, 0, len(res.GetRecords()))
+	for _, record := range res.GetRecords() {
+		var s Session
+		err = ptypes.UnmarshalAny(record.GetData(), &s)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling session from databroker: %w", err)
+		}
+		sessions = append(sessions, &s)
+	}
+	return sessions, nil
+}
+
 // Set sets a session in the databroker.
 func Set(ctx context.Context, client databroker.DataBrokerServiceClient, s *Session) (*databroker.SetResponse, error) {
 	any, _ := anypb.New(s)
@@ -57,3 +102,74 @@ func Set(ctx context.Context, client databroker.DataBrokerServiceClient, s *Sess
 	}
 	return res, nil
 }
+
+// CompareAndSet sets a session in the databroker only if its record still has
+// the given version, and returns ErrChanged otherwise.
+func CompareAndSet(ctx context.Context, client databroker.DataBrokerServiceClient, s *Session, version string) (*databroker.SetResponse, error) {
+	any, _ := anypb.New(s)
+	res, err := client.Set(ctx, &databroker.SetRequest{
+		Type:            any.GetTypeUrl(),
+		Id:              s.Id,
+		Data:            any,
+		ExpectedVersion: version,
+	})
+	if status.Code(err) == codes.Aborted {
+		return nil, ErrChanged
+	} else if err != nil {
+		return nil, fmt.Errorf("error setting session in databroker: %w", err)
+	}
+	return res, nil
+}
+
+// GetActiveRoles returns the names of the roles assumed in the session which
+// haven't expired at the given time.
+func (x *Session) GetActiveRoles(now time.Time) []string {
+	var names []string
+	for _, r := range x.GetAssumedRoles() {
+		if r.IsActive(now) {
+			names = append(names, r.GetName())
+		}
+	}
+	return names
+}
+
+// AssumeRole records a role assumed in the session for the given duration,
+// replacing a previous assumption of it. Expired roles are removed.
+func (x *Session) AssumeRole(name, reason string, now time.Time, duration time.Duration) *AssumedRole {
+	x.DropRole(name, now)
+	r := &AssumedRole{
+		Name:      name,
+		Reason:    reason,
+		AssumedAt: timestamppb.New(now),
+		ExpiresAt: timestamppb.New(now.Add(duration)),
+	}
+	x.AssumedRoles = append(x.AssumedRoles, r)
+	return r
+}
+
+// DropRole removes a role assumed in the session, and the roles which have
+// expired at the given time.
+func (x *Session) DropRole(name string, now time.Time) {
+	roles := x.AssumedRoles[:0]
+	for _, r := range x.AssumedRoles {
+		if r.GetName() != name && r.IsActive(now) {
+			roles = append(roles, r)
+		}
+	}
+	x.AssumedRoles = roles
+}
+
+// IsActive returns true if the role hasn't expired at the given time.
+func (x *AssumedRole) IsActive(now time.Time) bool {
+	return x.GetExpiresAt() != nil && now.Before(x.GetExpiresAt().AsTime())
+}
+
+// IsIdle returns true if the session hasn't been used within the idle
+// timeout at the given time. Sessions are never idle if the timeout is 0, or
+// if they don't have a last activity time yet.
+func (x *Session) IsIdle(now time.Time, timeout time.Duration) bool {
+	if timeout <= 0 || x.GetLastActiveAt() == nil {
+		return false
+	}
+	return now.Sub(x.GetLastActiveAt().AsTime()) > timeout
+}
@@ -0,0 +1,46 @@
+// Package upstreamtoken contains protobuf types for upstream oauth2 tokens.
+package upstreamtoken
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// TokenID returns the id of the token for the given request. Requests with
+// the same client, scopes and audience share the same token. The secret is
+// hashed along with the rest, so a rotated secret gets a new token.
+func TokenID(req *GetTokenRequest) string {
+	scopes := append([]string(nil), req.GetScopes()...)
+	sort.Strings(scopes)
+
+	h := sha256.New()
+	for _, s := range []string{
+		req.GetTokenUrl(),
+		req.GetClientId(),
+		req.GetClientSecret(),
+		strings.Join(scopes, " "),
+		req.GetAudience(),
+	} {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidFor returns true if the token is still valid for at least the given
+// duration after now.
+func (x *Token) ValidFor(now time.Time, d time.Duration) bool {
+	if x.GetAccessToken() == "" {
+		return false
+	}
+	expiresAt, err := ptypes.Timestamp(x.GetExpiresAt())
+	if err != nil {
+		return false
+	}
+	return now.Add(d).Before(expiresAt)
+}
@@ -0,0 +1,69 @@
+package grpcutil
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+)
+
+// clientTokenAudience is the audience of databroker client tokens.
+const clientTokenAudience = "databroker-client"
+
+// NewClientToken returns a token which authenticates a databroker client with
+// the given id, signed with the client's secret. It must be sent with the gRPC
+// request using WithOutgoingClientToken.
+func NewClientToken(clientID string, secret []byte, ttl time.Duration) (string, error) {
+	signer, err := jws.NewHS256Signer(secret, "")
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	raw, err := signer.Marshal(jwt.Claims{
+		Subject:  clientID,
+		Audience: jwt.Audience{clientTokenAudience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(ttl)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// ValidateClientToken validates a token from NewClientToken and returns the
+// id of the client it authenticates. getSecret returns the secret of a client,
+// and false for unknown clients.
+func ValidateClientToken(rawToken string, getSecret func(clientID string) ([]byte, bool), now time.Time, skew time.Duration) (string, error) {
+	tok, err := jwt.ParseSigned(rawToken)
+	if err != nil {
+		return "", err
+	}
+	var unverified jwt.Claims
+	if err := tok.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return "", err
+	}
+	secret, ok := getSecret(unverified.Subject)
+	if !ok {
+		return "", fmt.Errorf("unknown client %q", unverified.Subject)
+	}
+
+	var claims jwt.Claims
+	if err := tok.Claims(secret, &claims); err != nil {
+		return "", err
+	}
+	if claims.Expiry == nil {
+		return "", errors.New("token must expire")
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Audience: jwt.Audience{clientTokenAudience},
+		Time:     now,
+	}, skew)
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,40 @@
+package grpcutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+func TestClientToken(t *testing.T) {
+	secret := cryptutil.NewKey()
+	getSecret := func(clientID string) ([]byte, bool) {
+		return secret, clientID == "proxy"
+	}
+
+	rawToken, err := NewClientToken("proxy", secret, time.Minute)
+	require.NoError(t, err)
+	md, _ := metadata.FromOutgoingContext(WithOutgoingClientToken(context.Background(), rawToken))
+	found, ok := ClientTokenFromGRPCRequest(metadata.NewIncomingContext(context.Background(), md))
+	require.True(t, ok)
+	clientID, err := ValidateClientToken(found, getSecret, time.Now(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "proxy", clientID)
+
+	_, err = ValidateClientToken(rawToken, getSecret, time.Now().Add(time.Hour), time.Second)
+	assert.Error(t, err, "expired tokens should be rejected")
+	_, err = ValidateClientToken(rawToken, func(string) ([]byte, bool) { return cryptutil.NewKey(), true }, time.Now(), 0)
+	assert.Error(t, err, "tokens signed with another secret should be rejected")
+	unknown, err := NewClientToken("authorize", secret, time.Minute)
+	require.NoError(t, err)
+	_, err = ValidateClientToken(unknown, getSecret, time.Now(), 0)
+	assert.Error(t, err, "tokens of unknown clients should be rejected")
+	_, ok = ClientTokenFromGRPCRequest(context.Background())
+	assert.False(t, ok)
+}
@@ -52,3 +52,78 @@ func JWTFromGRPCRequest(ctx context.Context) (rawjwt string, ok bool) {
 
 	return rawjwts[0], true
 }
+
+// ServiceNameMetadataKey is the key in the metadata.
+const ServiceNameMetadataKey = "x-pomerium-service"
+
+// WithOutgoingServiceName appends a metadata header for the name of the
+// calling service to a context.
+func WithOutgoingServiceName(ctx context.Context, serviceName string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ServiceNameMetadataKey, serviceName)
+}
+
+// ServiceNameFromGRPCRequest returns the name of the calling service from the
+// gRPC request.
+func ServiceNameFromGRPCRequest(ctx context.Context) (serviceName string, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	serviceNames := md.Get(ServiceNameMetadataKey)
+	if len(serviceNames) == 0 {
+		return "", false
+	}
+
+	return serviceNames[0], true
+}
+
+// NamespaceMetadataKey is the key in the metadata.
+const NamespaceMetadataKey = "x-pomerium-namespace"
+
+// WithOutgoingNamespace appends a metadata header for the databroker
+// namespace of the calling service to a context.
+func WithOutgoingNamespace(ctx context.Context, namespace string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, NamespaceMetadataKey, namespace)
+}
+
+// NamespaceFromGRPCRequest returns the databroker namespace of the calling
+// service from the gRPC request.
+func NamespaceFromGRPCRequest(ctx context.Context) (namespace string, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	namespaces := md.Get(NamespaceMetadataKey)
+	if len(namespaces) == 0 {
+		return "", false
+	}
+
+	return namespaces[0], true
+}
+
+// ClientTokenMetadataKey is the key in the metadata.
+const ClientTokenMetadataKey = "x-pomerium-client-token"
+
+// WithOutgoingClientToken appends a metadata header for the databroker client
+// token of the calling service to a context.
+func WithOutgoingClientToken(ctx context.Context, rawToken string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ClientTokenMetadataKey, rawToken)
+}
+
+// ClientTokenFromGRPCRequest returns the databroker client token of the
+// calling service from the gRPC request.
+func ClientTokenFromGRPCRequest(ctx context.Context) (rawToken string, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	rawTokens := md.Get(ClientTokenMetadataKey)
+	if len(rawTokens) == 0 {
+		return "", false
+	}
+
+	return rawTokens[0], true
+}
@@ -49,3 +49,25 @@ func TestJWTFromGRPCRequest(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, rawjwt, found)
 }
+
+func TestServiceNameFromGRPCRequest(t *testing.T) {
+	ctx := WithOutgoingServiceName(context.Background(), "authenticate")
+	md, _ := metadata.FromOutgoingContext(ctx)
+	serviceName, ok := ServiceNameFromGRPCRequest(metadata.NewIncomingContext(context.Background(), md))
+	assert.True(t, ok)
+	assert.Equal(t, "authenticate", serviceName)
+
+	_, ok = ServiceNameFromGRPCRequest(context.Background())
+	assert.False(t, ok)
+}
+
+func TestNamespaceFromGRPCRequest(t *testing.T) {
+	ctx := WithOutgoingNamespace(context.Background(), "staging")
+	md, _ := metadata.FromOutgoingContext(ctx)
+	namespace, ok := NamespaceFromGRPCRequest(metadata.NewIncomingContext(context.Background(), md))
+	assert.True(t, ok)
+	assert.Equal(t, "staging", namespace)
+
+	_, ok = NamespaceFromGRPCRequest(context.Background())
+	assert.False(t, ok)
+}
@@ -0,0 +1,172 @@
+// Package jwtassertion verifies the X-Pomerium-Jwt-Assertion header pomerium
+// sends to upstream applications, rejecting assertions meant for other
+// upstreams and assertions which have already been used.
+package jwtassertion
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// HeaderName is the name of the header pomerium sends the assertion in.
+const HeaderName = "X-Pomerium-Jwt-Assertion"
+
+// DefaultClockSkew is the default clock skew tolerated when validating the
+// expiry of assertions.
+const DefaultClockSkew = time.Minute
+
+var (
+	// ErrMissingAssertion is returned by the middleware when a request has no
+	// assertion.
+	ErrMissingAssertion = errors.New("jwtassertion: missing assertion")
+	// ErrNotReplayProtected is returned for assertions without a jti or an
+	// expiry, which are sent for routes without a `jwt_assertion_ttl`.
+	ErrNotReplayProtected = errors.New("jwtassertion: assertion has no jti or expiry")
+	// ErrReplayed is returned for assertions which have already been
+	// verified.
+	ErrReplayed = errors.New("jwtassertion: assertion has already been used")
+)
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
+// Claims are the claims of an assertion.
+type Claims struct {
+	jwt.Claims
+	Email  string   `json:"email,omitempty"`
+	User   string   `json:"user,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// An Option customizes a Verifier.
+type Option func(*Verifier)
+
+// WithIssuer requires assertions to be issued by the given authenticate
+// service host.
+func WithIssuer(issuer string) Option {
+	return func(v *Verifier) {
+		v.issuer = issuer
+	}
+}
+
+// WithClockSkew sets the clock skew tolerated when validating the expiry of
+// assertions.
+func WithClockSkew(clockSkew time.Duration) Option {
+	return func(v *Verifier) {
+		v.clockSkew = clockSkew
+	}
+}
+
+// A Verifier verifies assertions for a single upstream. Each assertion is
+// only accepted once: the ids of verified assertions are remembered until
+// they expire.
+type Verifier struct {
+	key       crypto.PublicKey
+	audience  string
+	issuer    string
+	clockSkew time.Duration
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	nextPurge time.Time
+}
+
+// NewVerifier creates a new Verifier for assertions signed by the given
+// public key. The audience is the host of the route the upstream is
+// served on.
+func NewVerifier(key crypto.PublicKey, audience string, options ...Option) *Verifier {
+	v := &Verifier{
+		key:       key,
+		audience:  audience,
+		clockSkew: DefaultClockSkew,
+		seen:      make(map[string]time.Time),
+	}
+	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// Verify verifies a raw assertion and returns its claims.
+func (v *Verifier) Verify(rawJWT string) (*Claims, error) {
+	tok, err := jwt.ParseSigned(rawJWT)
+	if err != nil {
+		return nil, err
+	}
+	var claims Claims
+	if err := tok.Claims(v.key, &claims); err != nil {
+		return nil, err
+	}
+	if claims.ID == "" || claims.Expiry == nil {
+		return nil, ErrNotReplayProtected
+	}
+	now := timeNow()
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Audience: jwt.Audience{v.audience},
+		Issuer:   v.issuer,
+		Time:     now,
+	}, v.clockSkew)
+	if err != nil {
+		return nil, err
+	}
+	// the assertion could still be accepted by a server whose clock is behind
+	if !v.markSeen(claims.ID, claims.Expiry.Time().Add(v.clockSkew), now) {
+		return nil, ErrReplayed
+	}
+	return &claims, nil
+}
+
+// markSeen records an assertion id until it expires, and returns false if
+// it was already recorded.
+func (v *Verifier) markSeen(id string, expiry, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if now.After(v.nextPurge) {
+		for seenID, seenExpiry := range v.seen {
+			if now.After(seenExpiry) {
+				delete(v.seen, seenID)
+			}
+		}
+		v.nextPurge = now.Add(time.Second)
+	}
+
+	if seenExpiry, ok := v.seen[id]; ok && !now.After(seenExpiry) {
+		return false
+	}
+	v.seen[id] = expiry
+	return true
+}
+
+type claimsContextKey struct{}
+
+// ClaimsFromContext returns the claims of the assertion verified by the
+// middleware.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok
+}
+
+// Middleware rejects requests without a valid assertion with a 401, and
+// adds the claims of valid ones to the request context.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawJWT := r.Header.Get(HeaderName)
+		if rawJWT == "" {
+			http.Error(w, ErrMissingAssertion.Error(), http.StatusUnauthorized)
+			return
+		}
+		claims, err := v.Verify(rawJWT)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), claimsContextKey{}, claims)))
+	})
+}
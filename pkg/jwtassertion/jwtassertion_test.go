@@ -0,0 +1,87 @@
+package jwtassertion
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func TestVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	sign := func(claims map[string]interface{}) string {
+		rawJWT, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		return rawJWT
+	}
+	claims := func(id, audience string, exp time.Time) map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   "authenticate.example.com",
+			"aud":   audience,
+			"jti":   id,
+			"exp":   exp.Unix(),
+			"email": "user@example.com",
+		}
+	}
+
+	v := NewVerifier(&key.PublicKey, "app.example.com", WithIssuer("authenticate.example.com"))
+
+	rawJWT := sign(claims("1", "app.example.com", now.Add(time.Minute)))
+	c, err := v.Verify(rawJWT)
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", c.Email)
+	_, err = v.Verify(rawJWT)
+	assert.Equal(t, ErrReplayed, err)
+
+	_, err = v.Verify(sign(claims("2", "other.example.com", now.Add(time.Minute))))
+	assert.Equal(t, jwt.ErrInvalidAudience, err)
+	_, err = v.Verify(sign(claims("3", "app.example.com", now.Add(-2*time.Minute))))
+	assert.Equal(t, jwt.ErrExpired, err)
+	_, err = v.Verify(sign(claims("", "app.example.com", now.Add(time.Minute))))
+	assert.Equal(t, ErrNotReplayProtected, err)
+
+	t.Run("expired ids are forgotten", func(t *testing.T) {
+		v := NewVerifier(&key.PublicKey, "app.example.com")
+		assert.True(t, v.markSeen("1", now.Add(time.Minute), now))
+		assert.False(t, v.markSeen("1", now.Add(time.Minute), now))
+		assert.True(t, v.markSeen("1", now.Add(3*time.Minute), now.Add(2*time.Minute)))
+		assert.Len(t, v.seen, 1)
+	})
+
+	t.Run("middleware", func(t *testing.T) {
+		h := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c, ok := ClaimsFromContext(r.Context())
+			require.True(t, ok)
+			_, _ = w.Write([]byte(c.Email))
+		}))
+		serve := func(rawJWT string) *httptest.ResponseRecorder {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if rawJWT != "" {
+				r.Header.Set(HeaderName, rawJWT)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			return w
+		}
+
+		rawJWT := sign(claims("4", "app.example.com", now.Add(time.Minute)))
+		w := serve(rawJWT)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "user@example.com", w.Body.String())
+		assert.Equal(t, http.StatusUnauthorized, serve(rawJWT).Code)
+		assert.Equal(t, http.StatusUnauthorized, serve("").Code)
+	})
+}
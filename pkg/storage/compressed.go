@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// compressedTypeURL is the type url of compressed record data. The value is
+// the gzipped protobuf encoding of the original Any.
+const compressedTypeURL = "type.pomerium.io/storage.Compressed"
+
+type compressedBackend struct {
+	Backend
+	threshold int
+}
+
+// NewCompressedBackend creates a new compressed backend. Records whose data
+// is larger than threshold bytes are gzipped before being written to the
+// underlying backend, and are transparently decompressed when read, so
+// records written without compression can still be read.
+func NewCompressedBackend(underlying Backend, threshold int) Backend {
+	return &compressedBackend{Backend: underlying, threshold: threshold}
+}
+
+func (c *compressedBackend) Put(ctx context.Context, id string, data *anypb.Any) error {
+	compressed, err := c.compress(data)
+	if err != nil {
+		return err
+	}
+	return c.Backend.Put(ctx, id, compressed)
+}
+
+func (c *compressedBackend) PutMany(ctx context.Context, records []*databroker.Record) error {
+	compressed := make([]*databroker.Record, len(records))
+	for i, record := range records {
+		data, err := c.compress(record.GetData())
+		if err != nil {
+			return err
+		}
+		compressed[i] = &databroker.Record{Id: record.GetId(), Data: data}
+	}
+	return PutMany(ctx, c.Backend, compressed)
+}
+
+func (c *compressedBackend) DeleteMany(ctx context.Context, ids []string) error {
+	return DeleteMany(ctx, c.Backend, ids)
+}
+
+func (c *compressedBackend) Get(ctx context.Context, id string) (*databroker.Record, error) {
+	record, err := c.Backend.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return decompressRecord(record)
+}
+
+func (c *compressedBackend) GetAll(ctx context.Context) ([]*databroker.Record, error) {
+	records, err := c.Backend.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decompressRecords(records)
+}
+
+func (c *compressedBackend) List(ctx context.Context, sinceVersion string) ([]*databroker.Record, error) {
+	records, err := c.Backend.List(ctx, sinceVersion)
+	if err != nil {
+		return nil, err
+	}
+	return decompressRecords(records)
+}
+
+func (c *compressedBackend) compress(in *anypb.Any) (*anypb.Any, error) {
+	if len(in.GetValue()) <= c.threshold {
+		return in, nil
+	}
+
+	raw, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return &anypb.Any{TypeUrl: compressedTypeURL, Value: buf.Bytes()}, nil
+}
+
+func decompressRecords(records []*databroker.Record) ([]*databroker.Record, error) {
+	var err error
+	for i := range records {
+		records[i], err = decompressRecord(records[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+func decompressRecord(in *databroker.Record) (*databroker.Record, error) {
+	if in.GetData().GetTypeUrl() != compressedTypeURL {
+		return in, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(in.GetData().GetValue()))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data := new(anypb.Any)
+	if err := proto.Unmarshal(raw, data); err != nil {
+		return nil, err
+	}
+	// Create a new record so that we don't re-use any internal state
+	return &databroker.Record{
+		Version:    in.Version,
+		Type:       data.TypeUrl,
+		Id:         in.Id,
+		Data:       data,
+		CreatedAt:  in.CreatedAt,
+		ModifiedAt: in.ModifiedAt,
+		DeletedAt:  in.DeletedAt,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// newMapBackend returns a mockBackend which stores records in a map.
+func newMapBackend() *mockBackend {
+	m := map[string]*anypb.Any{}
+	getAll := func(ctx context.Context) ([]*databroker.Record, error) {
+		var records []*databroker.Record
+		for id, data := range m {
+			records = append(records, &databroker.Record{Id: id, Type: data.GetTypeUrl(), Data: data})
+		}
+		return records, nil
+	}
+	return &mockBackend{
+		put: func(ctx context.Context, id string, data *anypb.Any) error {
+			m[id] = data
+			return nil
+		},
+		get: func(ctx context.Context, id string) (*databroker.Record, error) {
+			data, ok := m[id]
+			if !ok {
+				return nil, errors.New("not found")
+			}
+			return &databroker.Record{Id: id, Type: data.GetTypeUrl(), Data: data}, nil
+		},
+		getAll: getAll,
+		list: func(ctx context.Context, sinceVersion string) ([]*databroker.Record, error) {
+			return getAll(ctx)
+		},
+	}
+}
+
+func TestCompressedBackend(t *testing.T) {
+	ctx := context.Background()
+	underlying := newMapBackend()
+	c := NewCompressedBackend(underlying, 64)
+
+	small, _ := anypb.New(wrapperspb.String("small"))
+	large, _ := anypb.New(wrapperspb.String(strings.Repeat("large", 1000)))
+	require.NoError(t, c.Put(ctx, "small", small))
+	require.NoError(t, c.Put(ctx, "large", large))
+
+	raw, err := underlying.Get(ctx, "small")
+	require.NoError(t, err)
+	assert.Equal(t, small.GetTypeUrl(), raw.GetData().GetTypeUrl(), "small records should not be compressed")
+	raw, err = underlying.Get(ctx, "large")
+	require.NoError(t, err)
+	assert.Equal(t, compressedTypeURL, raw.GetData().GetTypeUrl())
+	assert.Less(t, len(raw.GetData().GetValue()), len(large.GetValue())/10)
+
+	record, err := c.Get(ctx, "large")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(large, record.GetData()))
+	assert.Equal(t, large.GetTypeUrl(), record.GetType())
+
+	records, err := c.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		assert.NotEqual(t, compressedTypeURL, record.GetData().GetTypeUrl())
+	}
+	records, err = c.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		assert.NotEqual(t, compressedTypeURL, record.GetData().GetTypeUrl())
+	}
+
+	t.Run("reencrypt", func(t *testing.T) {
+		oldKey, newKey := cryptutil.NewKey(), cryptutil.NewKey()
+		underlying := newMapBackend()
+		e, err := NewEncryptedBackend(oldKey, underlying)
+		require.NoError(t, err)
+		require.NoError(t, NewCompressedBackend(e, 64).Put(ctx, "large", large))
+
+		e, err = NewEncryptedBackend(newKey, underlying, oldKey)
+		require.NoError(t, err)
+		c := NewCompressedBackend(e, 64)
+		count, err := Reencrypt(ctx, c)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		e, err = NewEncryptedBackend(newKey, underlying)
+		require.NoError(t, err)
+		record, err := NewCompressedBackend(e, 64).Get(ctx, "large")
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(large, record.GetData()))
+	})
+}
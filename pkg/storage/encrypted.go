@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"crypto/cipher"
+	"fmt"
 
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
@@ -13,20 +14,61 @@ import (
 
 type encryptedBackend struct {
 	Backend
-	cipher cipher.AEAD
+	// ciphers are tried in order to decrypt records. The first one is used
+	// to encrypt them.
+	ciphers []cipher.AEAD
 }
 
-// NewEncryptedBackend creates a new encrypted backend.
-func NewEncryptedBackend(secret []byte, underlying Backend) (Backend, error) {
-	c, err := cryptutil.NewAEADCipher(secret)
-	if err != nil {
-		return nil, err
+// NewEncryptedBackend creates a new encrypted backend. Records are encrypted
+// with secret, and records which were encrypted with any of the previous
+// secrets can still be decrypted, so the secret can be rotated.
+func NewEncryptedBackend(secret []byte, underlying Backend, previousSecrets ...[]byte) (Backend, error) {
+	e := &encryptedBackend{Backend: underlying}
+	for _, s := range append([][]byte{secret}, previousSecrets...) {
+		c, err := cryptutil.NewAEADCipher(s)
+		if err != nil {
+			return nil, err
+		}
+		e.ciphers = append(e.ciphers, c)
 	}
+	return e, nil
+}
 
-	return &encryptedBackend{
-		Backend: underlying,
-		cipher:  c,
-	}, nil
+// Reencrypt re-encrypts the records of an encrypted backend which were
+// encrypted with a previous secret, and returns how many were re-encrypted.
+// Other backends are left as is.
+func Reencrypt(ctx context.Context, backend Backend) (int, error) {
+	// compressed records are re-encrypted as is
+	if c, ok := backend.(*compressedBackend); ok {
+		backend = c.Backend
+	}
+	e, ok := backend.(*encryptedBackend)
+	if !ok || len(e.ciphers) < 2 {
+		return 0, nil
+	}
+
+	records, err := e.Backend.GetAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, record := range records {
+		if record.GetDeletedAt() != nil {
+			continue
+		}
+		data, idx, err := e.decryptWithIndex(record.GetData())
+		if err != nil {
+			return count, fmt.Errorf("error decrypting record %s: %w", record.GetId(), err)
+		}
+		if idx == 0 {
+			continue
+		}
+		if err := e.Put(ctx, record.GetId(), data); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
 }
 
 func (e *encryptedBackend) Put(ctx context.Context, id string, data *anypb.Any) error {
@@ -37,6 +79,22 @@ func (e *encryptedBackend) Put(ctx context.Context, id string, data *anypb.Any)
 	return e.Backend.Put(ctx, id, encrypted)
 }
 
+func (e *encryptedBackend) PutMany(ctx context.Context, records []*databroker.Record) error {
+	encrypted := make([]*databroker.Record, len(records))
+	for i, record := range records {
+		data, err := e.encrypt(record.GetData())
+		if err != nil {
+			return err
+		}
+		encrypted[i] = &databroker.Record{Id: record.GetId(), Data: data}
+	}
+	return PutMany(ctx, e.Backend, encrypted)
+}
+
+func (e *encryptedBackend) DeleteMany(ctx context.Context, ids []string) error {
+	return DeleteMany(ctx, e.Backend, ids)
+}
+
 func (e *encryptedBackend) Get(ctx context.Context, id string) (*databroker.Record, error) {
 	record, err := e.Backend.Get(ctx, id)
 	if err != nil {
@@ -95,24 +153,37 @@ func (e *encryptedBackend) decryptRecord(in *databroker.Record) (out *databroker
 }
 
 func (e *encryptedBackend) decrypt(in *anypb.Any) (out *anypb.Any, err error) {
+	out, _, err = e.decryptWithIndex(in)
+	return out, err
+}
+
+// decryptWithIndex decrypts data and returns the index of the cipher which
+// decrypted it.
+func (e *encryptedBackend) decryptWithIndex(in *anypb.Any) (out *anypb.Any, idx int, err error) {
 	var encrypted wrapperspb.BytesValue
 	err = in.UnmarshalTo(&encrypted)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	plaintext, err := cryptutil.Decrypt(e.cipher, encrypted.Value, nil)
+	var plaintext []byte
+	for idx = range e.ciphers {
+		plaintext, err = cryptutil.Decrypt(e.ciphers[idx], encrypted.Value, nil)
+		if err == nil {
+			break
+		}
+	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	out = new(anypb.Any)
 	err = proto.Unmarshal(plaintext, out)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return out, nil
+	return out, idx, nil
 }
 
 func (e *encryptedBackend) encrypt(in *anypb.Any) (out *anypb.Any, err error) {
@@ -121,7 +192,7 @@ func (e *encryptedBackend) encrypt(in *anypb.Any) (out *anypb.Any, err error) {
 		return nil, err
 	}
 
-	encrypted := cryptutil.Encrypt(e.cipher, plaintext, nil)
+	encrypted := cryptutil.Encrypt(e.ciphers[0], plaintext, nil)
 
 	out, err = anypb.New(&wrapperspb.BytesValue{
 		Value: encrypted,
@@ -98,3 +98,76 @@ func TestEncryptedBackend(t *testing.T) {
 		assert.Equal(t, any.TypeUrl, records[0].Type, "record type should be preserved")
 	}
 }
+
+func TestEncryptedBackend_Rotation(t *testing.T) {
+	ctx := context.Background()
+
+	m := map[string]*anypb.Any{}
+	backend := &mockBackend{
+		put: func(ctx context.Context, id string, data *anypb.Any) error {
+			m[id] = data
+			return nil
+		},
+		get: func(ctx context.Context, id string) (*databroker.Record, error) {
+			data, ok := m[id]
+			if !ok {
+				return nil, errors.New("not found")
+			}
+			return &databroker.Record{
+				Id:   id,
+				Data: data,
+			}, nil
+		},
+		getAll: func(ctx context.Context) ([]*databroker.Record, error) {
+			var records []*databroker.Record
+			for id, data := range m {
+				records = append(records, &databroker.Record{
+					Id:   id,
+					Data: data,
+				})
+			}
+			return records, nil
+		},
+	}
+
+	oldKey, newKey := cryptutil.NewKey(), cryptutil.NewKey()
+	any, _ := anypb.New(wrapperspb.String("HELLO WORLD"))
+
+	old, err := NewEncryptedBackend(oldKey, backend)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, old.Put(ctx, "TEST-1", any)) {
+		return
+	}
+
+	rotated, err := NewEncryptedBackend(newKey, backend, oldKey)
+	if !assert.NoError(t, err) {
+		return
+	}
+	record, err := rotated.Get(ctx, "TEST-1")
+	if assert.NoError(t, err, "records encrypted with a previous key should be decrypted") {
+		assert.Equal(t, any.Value, record.Data.Value)
+	}
+
+	n, err := Reencrypt(ctx, rotated)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = Reencrypt(ctx, rotated)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n, "records encrypted with the current key should be left as is")
+
+	current, err := NewEncryptedBackend(newKey, backend)
+	if !assert.NoError(t, err) {
+		return
+	}
+	record, err = current.Get(ctx, "TEST-1")
+	if assert.NoError(t, err, "re-encrypted records should only need the current key") {
+		assert.Equal(t, any.Value, record.Data.Value)
+	}
+	_, err = old.Get(ctx, "TEST-1")
+	assert.Error(t, err)
+
+	_, err = NewEncryptedBackend(newKey, backend, []byte("short"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,441 @@
+// Package etcd is the etcd database, implements storage.Backend interface.
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+	"github.com/pomerium/pomerium/internal/telemetry/trace"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// Name is the storage type name for etcd backend.
+const Name = config.StorageEtcdName
+
+// keyPrefix is the prefix of all the keys written by the databroker.
+const keyPrefix = "/pomerium/databroker/"
+
+const (
+	// dialTimeout is how long to wait to connect and authenticate to etcd.
+	dialTimeout = 5 * time.Second
+	// maxWriteAttempts is how many times a write is retried when the record
+	// is modified concurrently.
+	maxWriteAttempts = 10
+)
+
+var _ storage.Backend = (*DB)(nil)
+
+var (
+	// errNotFound is returned when a record doesn't exist.
+	errNotFound = errors.New("etcd: record not found")
+	// errConflict is returned when a record kept changing while writing it.
+	errConflict = errors.New("etcd: record was modified concurrently")
+)
+
+// DB wraps an etcd client to interact with an etcd cluster.
+//
+// Records are stored as protobuf-encoded values under a key prefix for their
+// type. A record's version is the etcd revision it was last modified at, so
+// versions are ordered across all the databroker replicas sharing the
+// cluster. Writes compare the revision read with the stored one, so
+// concurrent updates from other replicas aren't lost. Deleted records are
+// attached to a shared lease, so etcd removes them permanently once the lease
+// expires.
+type DB struct {
+	client                 *clientv3.Client
+	deletePermanentlyAfter int64
+	recordType             string
+	prefix                 string
+	tlsConfig              *tls.Config
+
+	leaseMu        sync.Mutex
+	lease          clientv3.LeaseID
+	leaseRenewedAt time.Time
+}
+
+// New returns new DB instance.
+func New(rawURL, recordType string, deletePermanentAfter int64, opts ...Option) (*DB, error) {
+	db := &DB{
+		deletePermanentlyAfter: deletePermanentAfter,
+		recordType:             recordType,
+		prefix:                 keyPrefix + url.PathEscape(recordType) + "/",
+	}
+	for _, o := range opts {
+		o(db)
+	}
+
+	cfg, err := newConfig(rawURL, db.tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	db.client, err = clientv3.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("etcd: failed to connect: %w", err)
+	}
+	return db, nil
+}
+
+// newConfig creates the client config from an etcd connection string of the
+// form:
+//
+//	etcd[s]://[username:password@]host:port[,host:port...]
+//
+// The client balances requests across the hosts, failing over to the next
+// one when a member is unavailable.
+func newConfig(rawURL string, tlsConfig *tls.Config) (clientv3.Config, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return clientv3.Config{}, errors.New("etcd: invalid connection string")
+	}
+
+	cfg := clientv3.Config{
+		DialTimeout: dialTimeout,
+		Logger:      zap.NewNop(),
+	}
+
+	scheme := "http"
+	switch u.Scheme {
+	case "etcd":
+	case "etcds":
+		scheme = "https"
+		cfg.TLS = tlsConfig
+		if cfg.TLS == nil {
+			cfg.TLS = new(tls.Config)
+		}
+	default:
+		return clientv3.Config{}, fmt.Errorf("etcd: unsupported scheme %q, must be etcd or etcds", u.Scheme)
+	}
+	for _, host := range strings.Split(u.Host, ",") {
+		if host == "" {
+			continue
+		}
+		cfg.Endpoints = append(cfg.Endpoints, scheme+"://"+host)
+	}
+	if len(cfg.Endpoints) == 0 {
+		return clientv3.Config{}, errors.New("etcd: missing host")
+	}
+	if u.User != nil {
+		cfg.Username = u.User.Username()
+		cfg.Password, _ = u.User.Password()
+	}
+	return cfg, nil
+}
+
+// Put sets new record for given id with input data.
+func (db *DB) Put(ctx context.Context, id string, data *anypb.Any) (err error) {
+	_, span := trace.StartSpan(ctx, "databroker.etcd.Put")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "put", err)
+
+	return db.update(ctx, id, func(record *databroker.Record) (clientv3.LeaseID, error) {
+		if record.CreatedAt == nil {
+			record.CreatedAt = ptypes.TimestampNow()
+		}
+		record.Data = data
+		record.ModifiedAt = ptypes.TimestampNow()
+		record.Type = db.recordType
+		record.Id = id
+		record.DeletedAt = nil
+		return clientv3.NoLease, nil
+	})
+}
+
+// Get retrieves a record from etcd.
+func (db *DB) Get(ctx context.Context, id string) (rec *databroker.Record, err error) {
+	_, span := trace.StartSpan(ctx, "databroker.etcd.Get")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "get", err)
+
+	res, err := db.client.Get(ctx, db.prefix+id)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Kvs) == 0 {
+		return nil, errNotFound
+	}
+	return toPbRecord(res.Kvs[0])
+}
+
+// GetAll retrieves all records from etcd.
+func (db *DB) GetAll(ctx context.Context) (recs []*databroker.Record, err error) {
+	_, span := trace.StartSpan(ctx, "databroker.etcd.GetAll")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "get_all", err)
+	return db.list(ctx, 0)
+}
+
+// List retrieves all records since given version.
+//
+// "version" is in hex format, invalid version will be treated as 0.
+func (db *DB) List(ctx context.Context, sinceVersion string) (recs []*databroker.Record, err error) {
+	_, span := trace.StartSpan(ctx, "databroker.etcd.List")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "list", err)
+
+	v, err := strconv.ParseInt(sinceVersion, 16, 64)
+	if err != nil {
+		v = 0
+	}
+	return db.list(ctx, v+1)
+}
+
+// Delete sets a record DeletedAt field and attaches it to the shared lease,
+// so etcd removes it after deletePermanentlyAfter.
+func (db *DB) Delete(ctx context.Context, id string) (err error) {
+	_, span := trace.StartSpan(ctx, "databroker.etcd.Delete")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "delete", err)
+
+	return db.update(ctx, id, func(record *databroker.Record) (clientv3.LeaseID, error) {
+		if record.Id == "" {
+			return clientv3.NoLease, fmt.Errorf("failed to get record: %w", errNotFound)
+		}
+		record.DeletedAt = ptypes.TimestampNow()
+		return db.getLease(ctx)
+	})
+}
+
+// ClearDeleted clears all the currently deleted records older than the given
+// cutoff. Deleted records are normally removed by etcd when their lease
+// expires, this removes any which weren't attached to a lease.
+func (db *DB) ClearDeleted(ctx context.Context, cutoff time.Time) {
+	_, span := trace.StartSpan(ctx, "databroker.etcd.ClearDeleted")
+	defer span.End()
+	var opErr error
+	defer func(startTime time.Time) {
+		recordOperation(ctx, startTime, "clear_deleted", opErr)
+	}(time.Now())
+
+	res, err := db.client.Get(ctx, db.prefix, clientv3.WithPrefix())
+	if err != nil {
+		opErr = err
+		return
+	}
+	for _, kv := range res.Kvs {
+		record, err := toPbRecord(kv)
+		if err != nil {
+			opErr = err
+			continue
+		}
+		if record.DeletedAt == nil {
+			continue
+		}
+		ts, _ := ptypes.Timestamp(record.DeletedAt)
+		if !ts.Before(cutoff) {
+			continue
+		}
+		// only remove the record if it wasn't put back since it was read
+		key := string(kv.Key)
+		_, err = db.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", kv.ModRevision)).
+			Then(clientv3.OpDelete(key)).
+			Commit()
+		if err != nil {
+			opErr = err
+		}
+	}
+}
+
+// Watch returns a channel to the caller, when there is a change to a record,
+// sending message to the channel to notify the caller.
+func (db *DB) Watch(ctx context.Context) <-chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		defer close(ch)
+
+		bo := backoff.NewExponentialBackOff()
+		bo.MaxElapsedTime = 0
+		for {
+			err := db.watch(ctx, ch, bo)
+			if ctx.Err() != nil {
+				return
+			}
+			log.Warn().Err(err).Str("type", db.recordType).Msg("etcd: watch failed, retrying")
+			recordOperation(ctx, time.Now(), "watch", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(bo.NextBackOff()):
+			}
+		}
+	}()
+	return ch
+}
+
+// watch streams the changes to the record type's keys, sending a message to
+// ch for every change. It returns when the stream ends.
+func (db *DB) watch(ctx context.Context, ch chan<- struct{}, bo backoff.BackOff) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// require a leader so a member cut off from the cluster doesn't leave
+	// the watch silently stalled
+	watchCh := db.client.Watch(clientv3.WithRequireLeader(ctx), db.prefix,
+		clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+	for res := range watchCh {
+		if err := res.Err(); err != nil {
+			return fmt.Errorf("etcd: watch error: %w", err)
+		}
+		if res.Created {
+			// the stream was established, so reset the retry delay and
+			// notify the caller in case changes were missed while
+			// reconnecting
+			bo.Reset()
+		} else if len(res.Events) == 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- struct{}{}:
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.New("etcd: watch closed")
+}
+
+// update reads a record, modifies it with fn and writes it back if it wasn't
+// changed in the meantime, retrying otherwise. A missing record is passed to
+// fn empty. fn returns the lease the record is attached to.
+func (db *DB) update(ctx context.Context, id string, fn func(*databroker.Record) (clientv3.LeaseID, error)) error {
+	key := db.prefix + id
+	for i := 0; i < maxWriteAttempts; i++ {
+		res, err := db.client.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		record := new(databroker.Record)
+		// a new key must not have been created since it was read
+		cmp := clientv3.Compare(clientv3.CreateRevision(key), "=", 0)
+		if len(res.Kvs) > 0 {
+			record, err = toPbRecord(res.Kvs[0])
+			if err != nil {
+				return err
+			}
+			cmp = clientv3.Compare(clientv3.ModRevision(key), "=", res.Kvs[0].ModRevision)
+		}
+
+		lease, err := fn(record)
+		if err != nil {
+			return err
+		}
+		// the version is derived from the revision of the key when it's read
+		record.Version = ""
+		b, err := proto.Marshal(record)
+		if err != nil {
+			return err
+		}
+
+		txn, err := db.client.Txn(ctx).
+			If(cmp).
+			Then(clientv3.OpPut(key, string(b), clientv3.WithLease(lease))).
+			Commit()
+		if errors.Is(err, rpctypes.ErrLeaseNotFound) {
+			// the shared lease was revoked, so grant a new one and retry
+			db.resetLease(lease)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if txn.Succeeded {
+			return nil
+		}
+	}
+	return errConflict
+}
+
+// getLease returns the lease deleted records are attached to. A single lease
+// is shared by all the records deleted within deletePermanentlyAfter of each
+// other, rather than granting one per record. Its TTL is twice
+// deletePermanentlyAfter, so every record attached to it is kept for at least
+// that long.
+func (db *DB) getLease(ctx context.Context) (clientv3.LeaseID, error) {
+	if db.deletePermanentlyAfter <= 0 {
+		return clientv3.NoLease, nil
+	}
+
+	db.leaseMu.Lock()
+	defer db.leaseMu.Unlock()
+
+	ttl := time.Duration(db.deletePermanentlyAfter) * time.Second
+	if db.lease != clientv3.NoLease && time.Since(db.leaseRenewedAt) < ttl {
+		return db.lease, nil
+	}
+
+	renewedAt := time.Now()
+	res, err := db.client.Grant(ctx, 2*db.deletePermanentlyAfter)
+	if err != nil {
+		return clientv3.NoLease, fmt.Errorf("failed to grant lease: %w", err)
+	}
+	db.lease, db.leaseRenewedAt = res.ID, renewedAt
+	return db.lease, nil
+}
+
+// resetLease forgets the shared lease if it's the given one, so the next
+// delete grants a new one.
+func (db *DB) resetLease(lease clientv3.LeaseID) {
+	db.leaseMu.Lock()
+	if db.lease == lease {
+		db.lease = clientv3.NoLease
+	}
+	db.leaseMu.Unlock()
+}
+
+// list returns all the records modified at or after the given revision.
+func (db *DB) list(ctx context.Context, minRevision int64) ([]*databroker.Record, error) {
+	res, err := db.client.Get(ctx, db.prefix, clientv3.WithPrefix(), clientv3.WithMinModRev(minRevision))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*databroker.Record, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		record, err := toPbRecord(kv)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func toPbRecord(kv *mvccpb.KeyValue) (*databroker.Record, error) {
+	record := &databroker.Record{}
+	if err := proto.Unmarshal(kv.Value, record); err != nil {
+		return nil, err
+	}
+	record.Version = fmt.Sprintf("%012X", kv.ModRevision)
+	return record, nil
+}
+
+func recordOperation(ctx context.Context, startTime time.Time, operation string, err error) {
+	metrics.RecordStorageOperation(ctx, &metrics.StorageOperationTags{
+		Operation: operation,
+		Error:     err,
+		Backend:   Name,
+	}, time.Since(startTime))
+}
@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// ErrUnknownIndex is returned when looking up records by an index which
+// doesn't exist.
+var ErrUnknownIndex = errors.New("unknown index")
+
+// An IndexFunc returns the values of an index for the data of a record.
+type IndexFunc func(data *anypb.Any) []string
+
+// An IndexedBackend is a Backend which maintains secondary indexes of its
+// records, so they can be looked up by something other than their id without
+// reading every record.
+//
+// The indexes are kept in memory, and are caught up with the changes listed
+// by the underlying backend before every lookup, so records written by other
+// servers to shared storage are indexed too. Permanently deleted records are
+// never listed, so the indexes are rebuilt after ClearDeleted.
+type IndexedBackend struct {
+	Backend
+	indexes map[string]IndexFunc
+
+	mu          sync.Mutex
+	lastVersion string
+	stale       bool
+	// ids are the ids of the records by index and value.
+	ids map[string]map[string]map[string]struct{}
+	// values are the indexed values of the records by id and index.
+	values map[string]map[string][]string
+}
+
+// NewIndexedBackend creates a new IndexedBackend with the given indexes, by
+// name. The underlying backend must return decrypted records.
+func NewIndexedBackend(underlying Backend, indexes map[string]IndexFunc) *IndexedBackend {
+	b := &IndexedBackend{
+		Backend: underlying,
+		indexes: indexes,
+		ids:     make(map[string]map[string]map[string]struct{}),
+	}
+	b.reset()
+	return b
+}
+
+// HasIndex returns true if the backend has an index with the given name.
+func (b *IndexedBackend) HasIndex(name string) bool {
+	_, ok := b.indexes[name]
+	return ok
+}
+
+// PutMany inserts or updates several records in the underlying backend.
+func (b *IndexedBackend) PutMany(ctx context.Context, records []*databroker.Record) error {
+	return PutMany(ctx, b.Backend, records)
+}
+
+// DeleteMany marks several records as deleted in the underlying backend.
+func (b *IndexedBackend) DeleteMany(ctx context.Context, ids []string) error {
+	return DeleteMany(ctx, b.Backend, ids)
+}
+
+// ClearDeleted clears the deleted records older than the cutoff, and
+// rebuilds the indexes on the next lookup.
+func (b *IndexedBackend) ClearDeleted(ctx context.Context, cutoff time.Time) {
+	b.Backend.ClearDeleted(ctx, cutoff)
+
+	b.mu.Lock()
+	b.stale = true
+	b.mu.Unlock()
+}
+
+// GetAllByIndex returns the records which have the given value for an index,
+// ordered by id. Deleted records are never returned.
+func (b *IndexedBackend) GetAllByIndex(ctx context.Context, name, value string) ([]*databroker.Record, error) {
+	fn, ok := b.indexes[name]
+	if !ok {
+		return nil, ErrUnknownIndex
+	}
+	ids, err := b.lookup(ctx, name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*databroker.Record
+	for _, id := range ids {
+		// the record may have changed since the indexes were caught up
+		record, err := b.Backend.Get(ctx, id)
+		if err != nil || record.GetDeletedAt() != nil || !containsString(fn(record.GetData()), value) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// lookup returns the sorted ids of the records which had the given value for
+// an index, once the indexes are caught up.
+func (b *IndexedBackend) lookup(ctx context.Context, name, value string) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.stale {
+		b.reset()
+	}
+	changed, err := b.Backend.List(ctx, b.lastVersion)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range changed {
+		b.update(record)
+		if record.GetVersion() > b.lastVersion {
+			b.lastVersion = record.GetVersion()
+		}
+	}
+
+	ids := make([]string, 0, len(b.ids[name][value]))
+	for id := range b.ids[name][value] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (b *IndexedBackend) reset() {
+	b.lastVersion = ""
+	b.stale = false
+	for name := range b.indexes {
+		b.ids[name] = make(map[string]map[string]struct{})
+	}
+	b.values = make(map[string]map[string][]string)
+}
+
+// update replaces the indexed values of a record.
+func (b *IndexedBackend) update(record *databroker.Record) {
+	id := record.GetId()
+	for name, values := range b.values[id] {
+		for _, value := range values {
+			delete(b.ids[name][value], id)
+			if len(b.ids[name][value]) == 0 {
+				delete(b.ids[name], value)
+			}
+		}
+	}
+	delete(b.values, id)
+	if record.GetDeletedAt() != nil {
+		return
+	}
+
+	for name, fn := range b.indexes {
+		values := fn(record.GetData())
+		if len(values) == 0 {
+			continue
+		}
+		if b.values[id] == nil {
+			b.values[id] = make(map[string][]string)
+		}
+		b.values[id][name] = values
+		for _, value := range values {
+			if b.ids[name][value] == nil {
+				b.ids[name][value] = make(map[string]struct{})
+			}
+			b.ids[name][value][id] = struct{}{}
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/pkg/storage"
+	"github.com/pomerium/pomerium/pkg/storage/inmemory"
+)
+
+func TestIndexedBackend(t *testing.T) {
+	ctx := context.Background()
+	underlying := inmemory.NewDB("example", 2)
+	db := storage.NewIndexedBackend(underlying, map[string]storage.IndexFunc{
+		"value": func(data *anypb.Any) []string {
+			var v wrapperspb.StringValue
+			if err := data.UnmarshalTo(&v); err != nil {
+				return nil
+			}
+			return []string{v.GetValue()}
+		},
+	})
+	put := func(id, value string) {
+		data, _ := anypb.New(wrapperspb.String(value))
+		require.NoError(t, underlying.Put(ctx, id, data))
+	}
+	lookup := func(value string) []string {
+		records, err := db.GetAllByIndex(ctx, "value", value)
+		require.NoError(t, err)
+		var ids []string
+		for _, record := range records {
+			ids = append(ids, record.GetId())
+		}
+		return ids
+	}
+
+	put("2", "a")
+	put("1", "a")
+	put("3", "b")
+	assert.Equal(t, []string{"1", "2"}, lookup("a"))
+
+	// changes made after the indexes were built are caught up
+	put("2", "b")
+	require.NoError(t, underlying.Delete(ctx, "3"))
+	assert.Equal(t, []string{"1"}, lookup("a"))
+	assert.Equal(t, []string{"2"}, lookup("b"))
+
+	// permanently deleted records are dropped from the indexes
+	require.NoError(t, underlying.Delete(ctx, "1"))
+	db.ClearDeleted(ctx, time.Now().Add(time.Second))
+	assert.Empty(t, lookup("a"))
+
+	_, err := db.GetAllByIndex(ctx, "missing", "a")
+	assert.Equal(t, storage.ErrUnknownIndex, err)
+	assert.True(t, db.HasIndex("value"))
+}
@@ -2,6 +2,7 @@
 package inmemory
 
 import (
+	"container/list"
 	"context"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/signal"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/storage"
 )
@@ -23,7 +25,7 @@ import (
 // Name is the storage type name for inmemory backend.
 const Name = config.StorageInMemoryName
 
-var _ storage.Backend = (*DB)(nil)
+var _ storage.BatchBackend = (*DB)(nil)
 
 type byIDRecord struct {
 	*databroker.Record
@@ -44,6 +46,8 @@ func (k byVersionRecord) Less(than btree.Item) bool {
 // DB is an in-memory database of records using b-trees.
 type DB struct {
 	recordType string
+	maxRecords int
+	maxBytes   int64
 
 	lastVersion uint64
 
@@ -52,17 +56,52 @@ type DB struct {
 	byVersion  *btree.BTree
 	deletedIDs []string
 	onchange   *signal.Signal
+
+	// lru orders the ids of the records which aren't deleted from the most
+	// to the least recently used.
+	lru        *list.List
+	lruByID    map[string]*list.Element
+	sizeByID   map[string]int64
+	totalBytes int64
+}
+
+// An Option customizes the in-memory database.
+type Option func(*DB)
+
+// WithMaxRecords limits the number of records which aren't deleted. When the
+// limit is exceeded, the least recently used records are evicted. If zero,
+// the number of records is unlimited.
+func WithMaxRecords(maxRecords int) Option {
+	return func(db *DB) {
+		db.maxRecords = maxRecords
+	}
+}
+
+// WithMaxBytes limits the total encoded size of the records which aren't
+// deleted. When the limit is exceeded, the least recently used records are
+// evicted. If zero, the size of the records is unlimited.
+func WithMaxBytes(maxBytes int64) Option {
+	return func(db *DB) {
+		db.maxBytes = maxBytes
+	}
 }
 
 // NewDB creates a new in-memory database for the given record type.
-func NewDB(recordType string, btreeDegree int) *DB {
+func NewDB(recordType string, btreeDegree int, options ...Option) *DB {
 	s := signal.New()
-	return &DB{
+	db := &DB{
 		recordType: recordType,
 		byID:       btree.New(btreeDegree),
 		byVersion:  btree.New(btreeDegree),
 		onchange:   s,
+		lru:        list.New(),
+		lruByID:    make(map[string]*list.Element),
+		sizeByID:   make(map[string]int64),
+	}
+	for _, option := range options {
+		option(db)
 	}
+	return db
 }
 
 // ClearDeleted clears all the currently deleted records older than the given cutoff.
@@ -87,6 +126,9 @@ func (db *DB) ClearDeleted(_ context.Context, cutoff time.Time) {
 // Delete marks a record as deleted.
 func (db *DB) Delete(_ context.Context, id string) error {
 	defer db.onchange.Broadcast()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
 	db.replaceOrInsert(id, func(record *databroker.Record) {
 		record.DeletedAt = ptypes.TimestampNow()
 		db.deletedIDs = append(db.deletedIDs, id)
@@ -94,17 +136,42 @@ func (db *DB) Delete(_ context.Context, id string) error {
 	return nil
 }
 
+// DeleteMany marks several records as deleted.
+func (db *DB) DeleteMany(_ context.Context, ids []string) error {
+	defer db.onchange.Broadcast()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	for _, id := range ids {
+		id := id
+		db.replaceOrInsert(id, func(record *databroker.Record) {
+			record.DeletedAt = ptypes.TimestampNow()
+			db.deletedIDs = append(db.deletedIDs, id)
+		})
+	}
+	return nil
+}
+
 // Get gets a record from the db.
 func (db *DB) Get(_ context.Context, id string) (*databroker.Record, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
 	record, ok := db.byID.Get(byIDRecord{Record: &databroker.Record{Id: id}}).(byIDRecord)
 	if !ok {
 		return nil, errors.New("not found")
 	}
+	if e, ok := db.lruByID[id]; ok {
+		db.lru.MoveToFront(e)
+	}
 	return record.Record, nil
 }
 
 // GetAll gets all the records in the db.
 func (db *DB) GetAll(_ context.Context) ([]*databroker.Record, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
 	var records []*databroker.Record
 	db.byID.Ascend(func(item btree.Item) bool {
 		records = append(records, item.(byIDRecord).Record)
@@ -115,6 +182,9 @@ func (db *DB) GetAll(_ context.Context) ([]*databroker.Record, error) {
 
 // List lists all the changes since the given version.
 func (db *DB) List(_ context.Context, sinceVersion string) ([]*databroker.Record, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
 	var records []*databroker.Record
 	db.byVersion.AscendGreaterOrEqual(byVersionRecord{Record: &databroker.Record{Version: sinceVersion}}, func(i btree.Item) bool {
 		record := i.(byVersionRecord)
@@ -126,12 +196,39 @@ func (db *DB) List(_ context.Context, sinceVersion string) ([]*databroker.Record
 	return records, nil
 }
 
-// Put replaces or inserts a record in the db.
-func (db *DB) Put(_ context.Context, id string, data *anypb.Any) error {
+// Put replaces or inserts a record in the db. If the db is over its
+// capacity, the least recently used records are evicted.
+func (db *DB) Put(ctx context.Context, id string, data *anypb.Any) error {
 	defer db.onchange.Broadcast()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
 	db.replaceOrInsert(id, func(record *databroker.Record) {
 		record.Data = data
 	})
+	if evicted := db.evict(); evicted > 0 {
+		metrics.RecordStorageRecordsEvicted(ctx, db.recordType, int64(evicted))
+	}
+	return nil
+}
+
+// PutMany replaces or inserts several records in the db. If the db is over
+// its capacity, the least recently used records are evicted once they've all
+// been written.
+func (db *DB) PutMany(ctx context.Context, records []*databroker.Record) error {
+	defer db.onchange.Broadcast()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	for _, r := range records {
+		data := r.GetData()
+		db.replaceOrInsert(r.GetId(), func(record *databroker.Record) {
+			record.Data = data
+		})
+	}
+	if evicted := db.evict(); evicted > 0 {
+		metrics.RecordStorageRecordsEvicted(ctx, db.recordType, int64(evicted))
+	}
 	return nil
 }
 
@@ -147,10 +244,8 @@ func (db *DB) Watch(ctx context.Context) <-chan struct{} {
 	return ch
 }
 
+// replaceOrInsert must be called with mu held.
 func (db *DB) replaceOrInsert(id string, f func(record *databroker.Record)) {
-	db.mu.Lock()
-	defer db.mu.Unlock()
-
 	record, ok := db.byID.Get(byIDRecord{Record: &databroker.Record{Id: id}}).(byIDRecord)
 	if ok {
 		db.byVersion.Delete(byVersionRecord(record))
@@ -168,4 +263,49 @@ func (db *DB) replaceOrInsert(id string, f func(record *databroker.Record)) {
 	record.Version = fmt.Sprintf("%012X", atomic.AddUint64(&db.lastVersion, 1))
 	db.byID.ReplaceOrInsert(record)
 	db.byVersion.ReplaceOrInsert(byVersionRecord(record))
+	db.updateLRU(record.Record)
+}
+
+// updateLRU marks a record as the most recently used one, or removes it from
+// the lru if it's deleted. It must be called with mu held.
+func (db *DB) updateLRU(record *databroker.Record) {
+	id := record.GetId()
+	db.totalBytes -= db.sizeByID[id]
+	if record.GetDeletedAt() != nil {
+		if e, ok := db.lruByID[id]; ok {
+			db.lru.Remove(e)
+		}
+		delete(db.lruByID, id)
+		delete(db.sizeByID, id)
+		return
+	}
+
+	size := int64(proto.Size(record))
+	db.sizeByID[id] = size
+	db.totalBytes += size
+	if e, ok := db.lruByID[id]; ok {
+		db.lru.MoveToFront(e)
+	} else {
+		db.lruByID[id] = db.lru.PushFront(id)
+	}
+}
+
+// evict evicts the least recently used records until the db is within its
+// capacity, and returns how many were evicted. The most recently used record
+// is never evicted. Evicted records are deleted like any other record, so
+// the deletion is synced, and they're freed by ClearDeleted. It must be
+// called with mu held.
+func (db *DB) evict() int {
+	var evicted int
+	for db.lru.Len() > 1 &&
+		((db.maxRecords > 0 && db.lru.Len() > db.maxRecords) ||
+			(db.maxBytes > 0 && db.totalBytes > db.maxBytes)) {
+		id := db.lru.Back().Value.(string)
+		db.replaceOrInsert(id, func(record *databroker.Record) {
+			record.DeletedAt = ptypes.TimestampNow()
+			db.deletedIDs = append(db.deletedIDs, id)
+		})
+		evicted++
+	}
+	return evicted
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 func TestDB(t *testing.T) {
@@ -75,3 +77,72 @@ func TestDB(t *testing.T) {
 		assert.Len(t, records, 0)
 	})
 }
+
+func TestDB_Batch(t *testing.T) {
+	ctx := context.Background()
+	db := NewDB("example", 2)
+	t.Run("put many", func(t *testing.T) {
+		assert.NoError(t, db.PutMany(ctx, []*databroker.Record{
+			{Id: "a", Data: new(anypb.Any)},
+			{Id: "b", Data: new(anypb.Any)},
+		}))
+		records, err := db.List(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, "000000000001", records[0].Version)
+		assert.Equal(t, "000000000002", records[1].Version)
+	})
+	t.Run("delete many", func(t *testing.T) {
+		assert.NoError(t, db.DeleteMany(ctx, []string{"a", "b"}))
+		for _, id := range []string{"a", "b"} {
+			record, err := db.Get(ctx, id)
+			require.NoError(t, err)
+			assert.NotNil(t, record.DeletedAt)
+		}
+	})
+}
+
+func TestDB_Eviction(t *testing.T) {
+	ctx := context.Background()
+	isDeleted := func(db *DB, id string) bool {
+		record, err := db.Get(ctx, id)
+		require.NoError(t, err)
+		return record.GetDeletedAt() != nil
+	}
+
+	t.Run("max records", func(t *testing.T) {
+		db := NewDB("example", 2, WithMaxRecords(2))
+		require.NoError(t, db.Put(ctx, "a", new(anypb.Any)))
+		require.NoError(t, db.Put(ctx, "b", new(anypb.Any)))
+		assert.False(t, isDeleted(db, "a"), "using a record should keep it from being evicted")
+		require.NoError(t, db.Put(ctx, "c", new(anypb.Any)))
+		assert.True(t, isDeleted(db, "b"))
+		assert.False(t, isDeleted(db, "a"))
+		assert.False(t, isDeleted(db, "c"))
+
+		// evictions are listed like deletions, so they're synced
+		records, err := db.List(ctx, "000000000003")
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "b", records[0].GetId())
+
+		require.NoError(t, db.Delete(ctx, "a"))
+		require.NoError(t, db.Put(ctx, "d", new(anypb.Any)))
+		assert.False(t, isDeleted(db, "c"), "deleted records should not count")
+	})
+	t.Run("max bytes", func(t *testing.T) {
+		data := &anypb.Any{Value: make([]byte, 100)}
+		db := NewDB("example", 2, WithMaxBytes(350))
+		require.NoError(t, db.Put(ctx, "a", data))
+		require.NoError(t, db.Put(ctx, "b", data))
+		require.NoError(t, db.Put(ctx, "c", data))
+		assert.True(t, isDeleted(db, "a"))
+		assert.False(t, isDeleted(db, "b"))
+		assert.False(t, isDeleted(db, "c"))
+
+		require.NoError(t, db.Put(ctx, "d", &anypb.Any{Value: make([]byte, 1000)}))
+		assert.True(t, isDeleted(db, "b"))
+		assert.True(t, isDeleted(db, "c"))
+		assert.False(t, isDeleted(db, "d"), "the last record written should never be evicted")
+	})
+}
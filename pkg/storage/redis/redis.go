@@ -28,7 +28,7 @@ import (
 const Name = config.StorageRedisName
 const watchAction = "zadd"
 
-var _ storage.Backend = (*DB)(nil)
+var _ storage.BatchBackend = (*DB)(nil)
 
 // DB wraps redis conn to interact with redis server.
 type DB struct {
@@ -117,6 +117,58 @@ func (db *DB) Put(ctx context.Context, id string, data *anypb.Any) (err error) {
 	return nil
 }
 
+// PutMany sets several records in a single transaction.
+func (db *DB) PutMany(ctx context.Context, records []*databroker.Record) (err error) {
+	c := db.pool.Get()
+	_, span := trace.StartSpan(ctx, "databroker.redis.PutMany")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "put_many", err)
+	defer c.Close()
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(records))
+	for i, record := range records {
+		ids[i] = record.GetId()
+	}
+	existing, err := db.getMany(c, ids)
+	if err != nil {
+		return err
+	}
+
+	lastVersion, err := redis.Int64(c.Do("INCRBY", db.lastVersionKey, len(records)))
+	if err != nil {
+		return err
+	}
+	cmds := []map[string][]interface{}{
+		{"MULTI": nil},
+	}
+	for i, r := range records {
+		record := existing[i]
+		if record == nil {
+			record = new(databroker.Record)
+			record.CreatedAt = ptypes.TimestampNow()
+		}
+		version := lastVersion - int64(len(records)-1-i)
+		record.Data = r.GetData()
+		record.ModifiedAt = ptypes.TimestampNow()
+		record.Type = db.recordType
+		record.Id = r.GetId()
+		record.Version = fmt.Sprintf("%012X", version)
+		b, err := proto.Marshal(record)
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds,
+			map[string][]interface{}{"HSET": {db.recordType, record.Id, string(b)}},
+			map[string][]interface{}{"ZADD": {db.versionSet, version, record.Id}},
+		)
+	}
+	return db.tx(c, cmds)
+}
+
 // Get retrieves a record from redis.
 func (db *DB) Get(ctx context.Context, id string) (rec *databroker.Record, err error) {
 	c := db.pool.Get()
@@ -212,6 +264,52 @@ func (db *DB) Delete(ctx context.Context, id string) (err error) {
 	return nil
 }
 
+// DeleteMany deletes several records in a single transaction.
+func (db *DB) DeleteMany(ctx context.Context, ids []string) (err error) {
+	c := db.pool.Get()
+	_, span := trace.StartSpan(ctx, "databroker.redis.DeleteMany")
+	defer span.End()
+	defer recordOperation(ctx, time.Now(), "delete_many", err)
+	defer c.Close()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	records, err := db.getMany(c, ids)
+	if err != nil {
+		return err
+	}
+	for i, record := range records {
+		if record == nil {
+			return fmt.Errorf("failed to get record %s: not found", ids[i])
+		}
+	}
+
+	lastVersion, err := redis.Int64(c.Do("INCRBY", db.lastVersionKey, len(ids)))
+	if err != nil {
+		return err
+	}
+	cmds := []map[string][]interface{}{
+		{"MULTI": nil},
+	}
+	for i, r := range records {
+		version := lastVersion - int64(len(records)-1-i)
+		r.DeletedAt = ptypes.TimestampNow()
+		r.Version = fmt.Sprintf("%012X", version)
+		b, err := proto.Marshal(r)
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds,
+			map[string][]interface{}{"HSET": {db.recordType, r.Id, string(b)}},
+			map[string][]interface{}{"SADD": {db.deletedSet, r.Id}},
+			map[string][]interface{}{"ZADD": {db.versionSet, version, r.Id}},
+		)
+	}
+	return db.tx(c, cmds)
+}
+
 // ClearDeleted clears all the currently deleted records older than the given cutoff.
 func (db *DB) ClearDeleted(ctx context.Context, cutoff time.Time) {
 	c := db.pool.Get()
@@ -373,6 +471,31 @@ func (db *DB) getAll(_ context.Context, filter func(record *databroker.Record) b
 	return records, nil
 }
 
+// getMany gets several records with a single command. Records which don't
+// exist are nil.
+func (db *DB) getMany(c redis.Conn, ids []string) ([]*databroker.Record, error) {
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, db.recordType)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	values, err := redis.ByteSlices(c.Do("HMGET", args...))
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*databroker.Record, len(ids))
+	for i, b := range values {
+		if b == nil {
+			continue
+		}
+		records[i], err = db.toPbRecord(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
 func (db *DB) toPbRecord(b []byte) (*databroker.Record, error) {
 	record := &databroker.Record{}
 	if err := proto.Unmarshal(b, record); err != nil {
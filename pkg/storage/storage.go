@@ -35,3 +35,45 @@ type Backend interface {
 	// the channel.
 	Watch(ctx context.Context) <-chan struct{}
 }
+
+// A BatchBackend is a Backend which can write several records in a single
+// transaction.
+type BatchBackend interface {
+	Backend
+
+	// PutMany inserts or updates several records, using their id and data.
+	PutMany(ctx context.Context, records []*databroker.Record) error
+
+	// DeleteMany marks several records as deleted.
+	DeleteMany(ctx context.Context, ids []string) error
+}
+
+// PutMany inserts or updates several records, using their id and data. The
+// records are written in a single transaction if the backend is a
+// BatchBackend, and one at a time otherwise.
+func PutMany(ctx context.Context, backend Backend, records []*databroker.Record) error {
+	if b, ok := backend.(BatchBackend); ok {
+		return b.PutMany(ctx, records)
+	}
+	for _, record := range records {
+		if err := backend.Put(ctx, record.GetId(), record.GetData()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteMany marks several records as deleted. The records are deleted in a
+// single transaction if the backend is a BatchBackend, and one at a time
+// otherwise.
+func DeleteMany(ctx context.Context, backend Backend, ids []string) error {
+	if b, ok := backend.(BatchBackend); ok {
+		return b.DeleteMany(ctx, ids)
+	}
+	for _, id := range ids {
+		if err := backend.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -110,20 +110,32 @@ func (p *Proxy) Verify(verifyOnly bool) http.Handler {
 			return httputil.NewError(http.StatusBadRequest, err)
 		}
 
+		jwt, _ := sessions.FromContext(r.Context())
+		clientIP := getForwardAuthClientIP(r)
+		if headers, ok := state.forwardAuthCache.Get(r.Context(), jwt, clientIP, uri); ok {
+			for k, vs := range headers {
+				w.Header()[k] = append([]string(nil), vs...)
+			}
+			writeForwardAuthAllowed(w, uri)
+			return nil
+		}
+
 		ar, err := p.isAuthorized(w, r)
 		if err != nil {
 			return httputil.NewError(http.StatusBadRequest, err)
 		}
 
 		if ar.authorized {
-			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
-			w.WriteHeader(http.StatusOK)
-			fmt.Fprintf(w, "Access to %s is allowed.", uri.Host)
+			state.forwardAuthCache.Add(r.Context(), jwt, clientIP, uri, w.Header().Clone())
+			writeForwardAuthAllowed(w, uri)
 			return nil
 		}
 
 		unAuthenticated := ar.statusCode == http.StatusUnauthorized
 		if unAuthenticated {
+			// the session is no longer valid, e.g. it was revoked, so drop
+			// any verifications cached for it
+			state.forwardAuthCache.Invalidate(r.Context(), jwt)
 			state.sessionStore.ClearSession(w, r)
 		}
 
@@ -142,6 +154,12 @@ func (p *Proxy) Verify(verifyOnly bool) http.Handler {
 	})
 }
 
+func writeForwardAuthAllowed(w http.ResponseWriter, uri *url.URL) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "Access to %s is allowed.", uri.Host)
+}
+
 // forwardAuthRedirectToSignInWithURI redirects request to authenticate signin url,
 // with all necessary information extracted from given input uri.
 func (p *Proxy) forwardAuthRedirectToSignInWithURI(w http.ResponseWriter, r *http.Request, uri *url.URL) {
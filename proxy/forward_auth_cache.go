@@ -0,0 +1,283 @@
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/kvcache"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+const (
+	// forwardAuthCacheSize is the maximum number of verification results
+	// cached in memory by the forward-auth endpoint.
+	forwardAuthCacheSize = 10000
+	// forwardAuthCacheRedisPrefix prefixes the keys of the verification
+	// results cached in redis.
+	forwardAuthCacheRedisPrefix = "pomerium_forward_auth:"
+	// forwardAuthCacheTimeout bounds the requests to the cache, so an
+	// unavailable cache only slows down verifications by that much.
+	forwardAuthCacheTimeout = 100 * time.Millisecond
+
+	sessionTypeURL = "type.googleapis.com/session.Session"
+)
+
+// A forwardAuthCache caches successful forward-auth verifications with a TTL,
+// in memory or in redis. The verifications of a session are grouped, so they
+// can be invalidated together, including when the session is deleted from the
+// databroker.
+type forwardAuthCache struct {
+	ttl   time.Duration
+	cache kvcache.Cache
+	// generation is the checksum of the options the verifications are made
+	// with, so cached verifications never outlive the policies they were
+	// made with, even when they're shared by proxies in redis.
+	generation string
+	// uncachedPolicies are the routes whose verifications aren't cached,
+	// because their JWT assertions are only valid for a single use.
+	uncachedPolicies []config.Policy
+	// cancel stops syncing session deletions from the databroker.
+	cancel context.CancelFunc
+}
+
+// newForwardAuthCache creates a new forwardAuthCache. If the TTL is not
+// positive, caching is disabled and nil is returned. If there's a databroker
+// client, the verifications of deleted sessions are invalidated until the
+// cache is closed.
+func newForwardAuthCache(options *config.Options, dataBrokerClient databroker.DataBrokerServiceClient) (*forwardAuthCache, error) {
+	if options.ForwardAuthCacheTTL <= 0 {
+		return nil, nil
+	}
+
+	c := &forwardAuthCache{
+		ttl:        options.ForwardAuthCacheTTL,
+		generation: fmt.Sprintf("%x", options.Checksum()),
+		cancel:     func() {},
+	}
+	for _, policy := range options.Policies {
+		if policy.JWTAssertionTTL > 0 {
+			c.uncachedPolicies = append(c.uncachedPolicies, policy)
+		}
+	}
+	switch options.ForwardAuthCacheType {
+	case "", config.StorageInMemoryName:
+		memory, err := kvcache.NewMemory(forwardAuthCacheSize)
+		if err != nil {
+			return nil, err
+		}
+		c.cache = memory
+	case config.StorageRedisName:
+		c.cache = kvcache.NewRedis(options.ForwardAuthCacheConnectionString, forwardAuthCacheRedisPrefix, nil)
+	default:
+		return nil, fmt.Errorf("unknown forward auth cache type %q", options.ForwardAuthCacheType)
+	}
+	if dataBrokerClient != nil {
+		var ctx context.Context
+		ctx, c.cancel = context.WithCancel(context.Background())
+		go c.runSessionSyncer(ctx, dataBrokerClient)
+	}
+	return c, nil
+}
+
+// Get returns the response headers of a cached verification of the session
+// for the given client IP and URL, if it exists and has not expired.
+func (c *forwardAuthCache) Get(ctx context.Context, jwt, clientIP string, uri *url.URL) (http.Header, bool) {
+	sessionID := getSessionID(jwt)
+	if c == nil || sessionID == "" || !c.cacheable(uri) {
+		return nil, false
+	}
+	ctx, cancel := context.WithTimeout(ctx, forwardAuthCacheTimeout)
+	defer cancel()
+
+	raw, ok, err := c.cache.Get(ctx, c.group(sessionID), forwardAuthCacheKey(jwt, clientIP, uri))
+	if err != nil {
+		log.Warn().Err(err).Msg("proxy: failed to get forward auth verification from cache")
+		return nil, false
+	} else if !ok {
+		return nil, false
+	}
+	var headers http.Header
+	if err := json.Unmarshal(raw, &headers); err != nil {
+		log.Warn().Err(err).Msg("proxy: invalid forward auth verification in cache")
+		return nil, false
+	}
+	return headers, true
+}
+
+// Add caches the response headers of a successful verification. Sessions
+// without an id aren't cached, as they can't be invalidated when they're
+// deleted, and neither are routes with a `jwt_assertion_ttl`, since the JWT
+// assertion in the headers is rejected once it's replayed.
+func (c *forwardAuthCache) Add(ctx context.Context, jwt, clientIP string, uri *url.URL, headers http.Header) {
+	sessionID := getSessionID(jwt)
+	if c == nil || sessionID == "" || !c.cacheable(uri) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, forwardAuthCacheTimeout)
+	defer cancel()
+
+	raw, err := json.Marshal(headers)
+	if err != nil {
+		return
+	}
+	if err := c.cache.Set(ctx, c.group(sessionID), forwardAuthCacheKey(jwt, clientIP, uri), raw, c.ttl); err != nil {
+		log.Warn().Err(err).Msg("proxy: failed to add forward auth verification to cache")
+	}
+}
+
+// cacheable returns true if verifications of the URL may be cached.
+func (c *forwardAuthCache) cacheable(uri *url.URL) bool {
+	for i := range c.uncachedPolicies {
+		if c.uncachedPolicies[i].Matches(uri) {
+			return false
+		}
+	}
+	return true
+}
+
+// Invalidate removes every cached verification of the session. It's called
+// when the session is signed out or the authorize service no longer accepts
+// it, for example because it was revoked.
+func (c *forwardAuthCache) Invalidate(ctx context.Context, jwt string) {
+	c.invalidateSession(ctx, getSessionID(jwt))
+}
+
+func (c *forwardAuthCache) invalidateSession(ctx context.Context, sessionID string) {
+	if c == nil || sessionID == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, forwardAuthCacheTimeout)
+	defer cancel()
+
+	if err := c.cache.DeleteGroup(ctx, c.group(sessionID)); err != nil {
+		log.Warn().Err(err).Msg("proxy: failed to invalidate forward auth verifications in cache")
+	}
+}
+
+// runSessionSyncer invalidates the verifications of sessions as they're
+// deleted from the databroker, for example when they're revoked.
+func (c *forwardAuthCache) runSessionSyncer(ctx context.Context, client databroker.DataBrokerServiceClient) {
+	var serverVersion, recordVersion string
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = 0
+	for {
+		err := c.syncSessions(ctx, client, &serverVersion, &recordVersion, bo)
+		if ctx.Err() != nil {
+			return
+		} else if err != nil {
+			log.Warn().Err(err).Msg("proxy: error syncing sessions for the forward auth cache")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bo.NextBackOff()):
+		}
+	}
+}
+
+func (c *forwardAuthCache) syncSessions(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	serverVersion, recordVersion *string,
+	bo interface{ Reset() },
+) error {
+	stream, err := client.Sync(ctx, &databroker.SyncRequest{
+		ServerVersion: *serverVersion,
+		RecordVersion: *recordVersion,
+		Type:          sessionTypeURL,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		bo.Reset()
+
+		if res.GetServerVersion() != *serverVersion {
+			*serverVersion = res.GetServerVersion()
+			*recordVersion = ""
+		}
+		for _, record := range res.GetRecords() {
+			if record.GetVersion() > *recordVersion {
+				*recordVersion = record.GetVersion()
+			}
+			if record.GetDeletedAt() != nil {
+				c.invalidateSession(ctx, record.GetId())
+			}
+		}
+	}
+}
+
+// Close stops syncing session deletions and releases the resources of the
+// cache.
+func (c *forwardAuthCache) Close() {
+	if c == nil {
+		return
+	}
+	c.cancel()
+	_ = c.cache.Close()
+}
+
+// group returns the group of the verifications of a session.
+func (c *forwardAuthCache) group(sessionID string) string {
+	id := sha256.Sum256([]byte(sessionID))
+	return c.generation + ":" + hex.EncodeToString(id[:])
+}
+
+// forwardAuthCacheKey identifies the verification of a URL for a session JWT
+// and client IP, since policies may depend on the IP. Policies only match on
+// the host and path of a URL, so the query is not part of the key and all the
+// requests for a page's subresources share the same entry.
+func forwardAuthCacheKey(jwt, clientIP string, uri *url.URL) string {
+	session := sha256.Sum256([]byte(jwt))
+	return hex.EncodeToString(session[:]) + ":" + clientIP + ":" + uri.Scheme + "://" + uri.Host + uri.EscapedPath()
+}
+
+// getSessionID returns the id of a session JWT. The JWT isn't verified, since
+// the id only groups the verifications, which are keyed by the whole JWT.
+func getSessionID(rawJWT string) string {
+	if rawJWT == "" {
+		return ""
+	}
+	tok, err := jwt.ParseSigned(rawJWT)
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		ID string `json:"jti"`
+	}
+	if err := tok.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return ""
+	}
+	return claims.ID
+}
+
+// getForwardAuthClientIP returns the IP address of the client being verified.
+func getForwardAuthClientIP(r *http.Request) string {
+	if ip := r.Header.Get(httputil.HeaderEnvoyExternalAddress); ip != "" {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
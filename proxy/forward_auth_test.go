@@ -2,17 +2,24 @@ package proxy
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
 
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	backoff "github.com/cenkalti/backoff/v4"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/square/go-jose.v2/jwt"
 
 	"github.com/pomerium/pomerium/config"
@@ -23,14 +30,15 @@ import (
 	"github.com/pomerium/pomerium/internal/sessions"
 	mstore "github.com/pomerium/pomerium/internal/sessions/mock"
 	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 type mockCheckClient struct {
-	response *envoy_service_auth_v2.CheckResponse
+	response *envoy_service_auth_v3.CheckResponse
 	err      error
 }
 
-func (m *mockCheckClient) Check(ctx context.Context, in *envoy_service_auth_v2.CheckRequest, opts ...grpc.CallOption) (*envoy_service_auth_v2.CheckResponse, error) {
+func (m *mockCheckClient) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRequest, opts ...grpc.CallOption) (*envoy_service_auth_v3.CheckResponse, error) {
 	return m.response, m.err
 }
 
@@ -38,9 +46,9 @@ func TestProxy_ForwardAuth(t *testing.T) {
 	t.Parallel()
 
 	allowClient := &mockCheckClient{
-		response: &envoy_service_auth_v2.CheckResponse{
+		response: &envoy_service_auth_v3.CheckResponse{
 			Status:       &status.Status{Code: int32(codes.OK), Message: "OK"},
-			HttpResponse: &envoy_service_auth_v2.CheckResponse_OkResponse{},
+			HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{},
 		},
 	}
 
@@ -59,7 +67,7 @@ func TestProxy_ForwardAuth(t *testing.T) {
 
 		cipher       encoding.MarshalUnmarshaler
 		sessionStore sessions.SessionStore
-		authorizer   envoy_service_auth_v2.AuthorizationClient
+		authorizer   envoy_service_auth_v3.AuthorizationClient
 		wantStatus   int
 		wantBody     string
 	}{
@@ -139,3 +147,166 @@ func TestProxy_ForwardAuth(t *testing.T) {
 		})
 	}
 }
+
+type countingCheckClient struct {
+	mockCheckClient
+	calls int
+}
+
+func (m *countingCheckClient) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRequest, opts ...grpc.CallOption) (*envoy_service_auth_v3.CheckResponse, error) {
+	m.calls++
+	return m.mockCheckClient.Check(ctx, in, opts...)
+}
+
+func TestProxy_ForwardAuthCache(t *testing.T) {
+	allow := &envoy_service_auth_v3.CheckResponse{
+		Status: &status.Status{Code: int32(codes.OK), Message: "OK"},
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+			OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+				Headers: []*envoy_config_core_v3.HeaderValueOption{
+					{Header: &envoy_config_core_v3.HeaderValue{Key: "x-pomerium-jwt-assertion", Value: "JWT"}},
+				},
+			},
+		},
+	}
+	unauthenticated := &envoy_service_auth_v3.CheckResponse{
+		Status: &status.Status{Code: int32(codes.Unauthenticated)},
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_DeniedResponse{
+			DeniedResponse: &envoy_service_auth_v3.DeniedHttpResponse{
+				Status: &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode_Unauthorized},
+			},
+		},
+	}
+
+	now := time.Now()
+
+	opts := testOptions(t)
+	opts.ForwardAuthCacheTTL = time.Second
+	singleUse := config.Policy{From: "https://single-use.example", To: "https://example.example", JWTAssertionTTL: time.Minute}
+	require.NoError(t, singleUse.Validate())
+	opts.Policies = append(opts.Policies, singleUse)
+	p, err := New(&config.Config{Options: opts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &countingCheckClient{mockCheckClient: mockCheckClient{response: allow}}
+	state := p.state.Load()
+	state.authzClient = client
+	state.sessionStore = &mstore.Store{Session: &sessions.State{ID: "SESSION_ID", Expiry: jwt.NewNumericDate(now.Add(10 * time.Minute))}}
+	router := p.registerFwdAuthHandlers()
+
+	clientIP := "192.0.2.1"
+	verify := func(uri string, wantStatus, wantCalls int) {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodGet, "https://some.domain.example/?"+url.Values{"uri": {uri}}.Encode(), nil)
+		r.Header.Set(httputil.HeaderEnvoyExternalAddress, clientIP)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		if w.Code != wantStatus {
+			t.Errorf("%s: status code: got %v want %v", uri, w.Code, wantStatus)
+		}
+		if wantStatus == http.StatusOK && w.Header().Get("x-pomerium-jwt-assertion") != "JWT" {
+			t.Errorf("%s: expected authorize response headers, got %v", uri, w.Header())
+		}
+		if client.calls != wantCalls {
+			t.Errorf("%s: authorize calls: got %v want %v", uri, client.calls, wantCalls)
+		}
+	}
+
+	verify("https://app.example/index.html", http.StatusOK, 1)
+	// the query is not part of the cache key
+	verify("https://app.example/index.html?v=2", http.StatusOK, 1)
+	verify("https://app.example/style.css", http.StatusOK, 2)
+	verify("https://app.example/style.css", http.StatusOK, 2)
+
+	// expired verifications are not used
+	time.Sleep(opts.ForwardAuthCacheTTL + 100*time.Millisecond)
+	verify("https://app.example/style.css", http.StatusOK, 3)
+
+	// once the session is rejected, its cached verifications are dropped
+	client.response = unauthenticated
+	verify("https://app.example/other", http.StatusFound, 4)
+	client.response = allow
+	verify("https://app.example/index.html", http.StatusOK, 5)
+	verify("https://app.example/style.css", http.StatusOK, 6)
+
+	// policies may depend on the client IP, so other clients aren't cached
+	clientIP = "192.0.2.2"
+	verify("https://app.example/index.html", http.StatusOK, 7)
+	verify("https://app.example/index.html", http.StatusOK, 7)
+
+	// deleted sessions are invalidated
+	state.forwardAuthCache.invalidateSession(context.Background(), "SESSION_ID")
+	verify("https://app.example/index.html", http.StatusOK, 8)
+
+	// single use JWT assertions are never replayed from the cache
+	verify("https://single-use.example/", http.StatusOK, 9)
+	verify("https://single-use.example/", http.StatusOK, 10)
+}
+
+type mockSyncClient struct {
+	databroker.DataBrokerService_SyncClient
+	responses []*databroker.SyncResponse
+}
+
+func (m *mockSyncClient) Recv() (*databroker.SyncResponse, error) {
+	if len(m.responses) == 0 {
+		return nil, io.EOF
+	}
+	res := m.responses[0]
+	m.responses = m.responses[1:]
+	return res, nil
+}
+
+type mockSyncDataBrokerClient struct {
+	databroker.DataBrokerServiceClient
+	requests chan *databroker.SyncRequest
+	stream   *mockSyncClient
+}
+
+func (m *mockSyncDataBrokerClient) Sync(ctx context.Context, in *databroker.SyncRequest, opts ...grpc.CallOption) (databroker.DataBrokerService_SyncClient, error) {
+	m.requests <- in
+	return m.stream, nil
+}
+
+func TestForwardAuthCache_syncSessions(t *testing.T) {
+	opts := testOptions(t)
+	opts.ForwardAuthCacheTTL = time.Minute
+	c, err := newForwardAuthCache(opts, nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	signer, err := jws.NewHS256Signer(nil, "mock")
+	require.NoError(t, err)
+	newJWT := func(id string) string {
+		raw, err := signer.Marshal(&sessions.State{ID: id})
+		require.NoError(t, err)
+		return string(raw)
+	}
+	ctx := context.Background()
+	uri := &url.URL{Scheme: "https", Host: "app.example", Path: "/"}
+	deleted, kept := newJWT("DELETED"), newJWT("KEPT")
+	c.Add(ctx, deleted, "192.0.2.1", uri, http.Header{})
+	c.Add(ctx, kept, "192.0.2.1", uri, http.Header{})
+
+	client := &mockSyncDataBrokerClient{
+		requests: make(chan *databroker.SyncRequest, 1),
+		stream: &mockSyncClient{responses: []*databroker.SyncResponse{{
+			ServerVersion: "1",
+			Records: []*databroker.Record{
+				{Version: "2", Type: sessionTypeURL, Id: "KEPT"},
+				{Version: "3", Type: sessionTypeURL, Id: "DELETED", DeletedAt: timestamppb.Now()},
+			},
+		}}},
+	}
+	var serverVersion, recordVersion string
+	require.NoError(t, c.syncSessions(ctx, client, &serverVersion, &recordVersion, backoff.NewExponentialBackOff()))
+	assert.Equal(t, sessionTypeURL, (<-client.requests).GetType())
+	assert.Equal(t, "1", serverVersion)
+	assert.Equal(t, "3", recordVersion)
+
+	_, ok := c.Get(ctx, deleted, "192.0.2.1", uri)
+	assert.False(t, ok, "should invalidate deleted sessions")
+	_, ok = c.Get(ctx, kept, "192.0.2.1", uri)
+	assert.True(t, ok, "should keep other sessions")
+}
@@ -2,9 +2,11 @@ package proxy
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/pomerium/csrf"
@@ -88,6 +90,9 @@ func (p *Proxy) SignOut(w http.ResponseWriter, r *http.Request) {
 	q.Set(urlutil.QueryRedirectURI, redirectURL.String())
 	signoutURL.RawQuery = q.Encode()
 
+	if jwt, err := sessions.FromContext(r.Context()); err == nil {
+		state.forwardAuthCache.Invalidate(r.Context(), jwt)
+	}
 	state.sessionStore.ClearSession(w, r)
 	httputil.Redirect(w, r, urlutil.NewSignedURL(state.sharedKey, &signoutURL).String(), http.StatusFound)
 }
@@ -137,6 +142,10 @@ func (p *Proxy) saveCallbackSession(w http.ResponseWriter, r *http.Request, enct
 	if err != nil {
 		return nil, fmt.Errorf("proxy: callback token decrypt error: %w", err)
 	}
+	// the session changed, so drop the verifications cached for the old one
+	if previous, err := state.sessionStore.LoadSession(r); err == nil && previous != string(rawJWT) {
+		state.forwardAuthCache.Invalidate(r.Context(), previous)
+	}
 	// 3. Save the decrypted JWT to the session store directly as a string, without resigning
 	if err = state.sessionStore.SaveSession(w, r, rawJWT); err != nil {
 		return nil, fmt.Errorf("proxy: callback session save failure: %w", err)
@@ -144,8 +153,32 @@ func (p *Proxy) saveCallbackSession(w http.ResponseWriter, r *http.Request, enct
 	return rawJWT, nil
 }
 
+// errNonLoopbackRedirectURI is returned when a programmatic login would
+// redirect the issued tokens anywhere but to the client's machine, or to one
+// of the allowed programmatic redirect domains.
+var errNonLoopbackRedirectURI = errors.New("programmatic redirect uri must be a loopback url or an allowed domain")
+
+// isAllowedProgrammaticRedirect returns true if the tokens of a programmatic
+// login can be sent to the url.
+func (state *proxyState) isAllowedProgrammaticRedirect(u *url.URL) bool {
+	if urlutil.IsLoopback(u) {
+		return true
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+	for _, domain := range state.programmaticRedirectDomains {
+		if strings.EqualFold(u.Hostname(), domain) {
+			return true
+		}
+	}
+	return false
+}
+
 // ProgrammaticLogin returns a signed url that can be used to login
-// using the authenticate service.
+// using the authenticate service. The redirect uri must be a loopback url, or
+// a url of an allowed programmatic redirect domain, as the tokens are sent to
+// it.
 func (p *Proxy) ProgrammaticLogin(w http.ResponseWriter, r *http.Request) error {
 	state := p.state.Load()
 
@@ -153,6 +186,9 @@ func (p *Proxy) ProgrammaticLogin(w http.ResponseWriter, r *http.Request) error
 	if err != nil {
 		return httputil.NewError(http.StatusBadRequest, err)
 	}
+	if !state.isAllowedProgrammaticRedirect(redirectURI) {
+		return httputil.NewError(http.StatusBadRequest, errNonLoopbackRedirectURI)
+	}
 	signinURL := *state.authenticateSigninURL
 	callbackURI := urlutil.GetAbsoluteURL(r)
 	callbackURI.Path = dashboardPath + "/callback/"
@@ -173,6 +209,8 @@ func (p *Proxy) ProgrammaticLogin(w http.ResponseWriter, r *http.Request) error
 // In addition to returning the individual route session (JWT) it also returns
 // the refresh token.
 func (p *Proxy) ProgrammaticCallback(w http.ResponseWriter, r *http.Request) error {
+	state := p.state.Load()
+
 	redirectURLString := r.FormValue(urlutil.QueryRedirectURI)
 	encryptedSession := r.FormValue(urlutil.QuerySessionEncrypted)
 
@@ -180,6 +218,9 @@ func (p *Proxy) ProgrammaticCallback(w http.ResponseWriter, r *http.Request) err
 	if err != nil {
 		return httputil.NewError(http.StatusBadRequest, err)
 	}
+	if !state.isAllowedProgrammaticRedirect(redirectURL) {
+		return httputil.NewError(http.StatusBadRequest, errNonLoopbackRedirectURI)
+	}
 
 	rawJWT, err := p.saveCallbackSession(w, r, encryptedSession)
 	if err != nil {
@@ -275,6 +275,8 @@ func TestProxy_Callback(t *testing.T) {
 func TestProxy_ProgrammaticLogin(t *testing.T) {
 	t.Parallel()
 	opts := testOptions(t)
+	redirectDomainOpts := testOptions(t)
+	redirectDomainOpts.ProgrammaticRedirectDomains = []string{"app.example.com"}
 	tests := []struct {
 		name    string
 		options *config.Options
@@ -295,6 +297,9 @@ func TestProxy_ProgrammaticLogin(t *testing.T) {
 		{"router miss, bad redirect_uri query", opts, http.MethodGet, "https", "corp.example.example", "/.pomerium/api/v1/login", nil, map[string]string{"bad_redirect_uri": "http://localhost"}, http.StatusNotFound, ""},
 		{"bad redirect_uri missing scheme", opts, http.MethodGet, "https", "corp.example.example", "/.pomerium/api/v1/login", nil, map[string]string{urlutil.QueryRedirectURI: "localhost"}, http.StatusBadRequest, "{\"Status\":400,\"Error\":\"Bad Request: localhost url does contain a valid scheme\"}\n"},
 		{"bad http method", opts, http.MethodPost, "https", "corp.example.example", "/.pomerium/api/v1/login", nil, map[string]string{urlutil.QueryRedirectURI: "http://localhost"}, http.StatusMethodNotAllowed, ""},
+		{"good loopback ip", opts, http.MethodGet, "https", "corp.example.example", "/.pomerium/api/v1/login", nil, map[string]string{urlutil.QueryRedirectURI: "http://127.0.0.1:8000"}, http.StatusOK, ""},
+		{"bad redirect_uri not loopback", opts, http.MethodGet, "https", "corp.example.example", "/.pomerium/api/v1/login", nil, map[string]string{urlutil.QueryRedirectURI: "https://evil.example"}, http.StatusBadRequest, "{\"Status\":400,\"Error\":\"Bad Request: programmatic redirect uri must be a loopback url or an allowed domain\"}\n"},
+		{"good allowed redirect domain", redirectDomainOpts, http.MethodGet, "https", "corp.example.example", "/.pomerium/api/v1/login", nil, map[string]string{urlutil.QueryRedirectURI: "https://app.example.com/callback"}, http.StatusOK, ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -336,6 +341,8 @@ func TestProxy_ProgrammaticLogin(t *testing.T) {
 func TestProxy_ProgrammaticCallback(t *testing.T) {
 	t.Parallel()
 	opts := testOptions(t)
+	redirectDomainOpts := testOptions(t)
+	redirectDomainOpts.ProgrammaticRedirectDomains = []string{"pomerium.io"}
 	tests := []struct {
 		name    string
 		options *config.Options
@@ -356,7 +363,7 @@ func TestProxy_ProgrammaticCallback(t *testing.T) {
 			"good",
 			opts,
 			http.MethodGet,
-			"http://pomerium.io/",
+			"http://127.0.0.1:8000/",
 			nil,
 			map[string]string{urlutil.QueryCallbackURI: "ok", urlutil.QuerySessionEncrypted: goodEncryptionString},
 			&mock.Encoder{MarshalResponse: []byte("x")},
@@ -368,7 +375,7 @@ func TestProxy_ProgrammaticCallback(t *testing.T) {
 			"good programmatic",
 			opts,
 			http.MethodGet,
-			"http://pomerium.io/",
+			"http://localhost:8000/",
 			nil,
 			map[string]string{urlutil.QueryIsProgrammatic: "true",
 				urlutil.QueryCallbackURI:      "ok",
@@ -379,11 +386,35 @@ func TestProxy_ProgrammaticCallback(t *testing.T) {
 			"",
 		},
 		{
-			"bad decrypt",
+			"bad redirect not loopback",
 			opts,
 			http.MethodGet,
 			"http://pomerium.io/",
 			nil,
+			map[string]string{urlutil.QueryCallbackURI: "ok", urlutil.QuerySessionEncrypted: goodEncryptionString},
+			&mock.Encoder{MarshalResponse: []byte("x")},
+			&mstore.Store{Session: &sessions.State{Expiry: jwt.NewNumericDate(time.Now().Add(10 * time.Minute))}},
+			http.StatusBadRequest,
+			"",
+		},
+		{
+			"good allowed redirect domain",
+			redirectDomainOpts,
+			http.MethodGet,
+			"http://pomerium.io/",
+			nil,
+			map[string]string{urlutil.QueryCallbackURI: "ok", urlutil.QuerySessionEncrypted: goodEncryptionString},
+			&mock.Encoder{MarshalResponse: []byte("x")},
+			&mstore.Store{Session: &sessions.State{Expiry: jwt.NewNumericDate(time.Now().Add(10 * time.Minute))}},
+			http.StatusFound,
+			"",
+		},
+		{
+			"bad decrypt",
+			opts,
+			http.MethodGet,
+			"http://127.0.0.1:8000/",
+			nil,
 			map[string]string{urlutil.QuerySessionEncrypted: goodEncryptionString + cryptutil.NewBase64Key()},
 			&mock.Encoder{MarshalResponse: []byte("x")},
 			&mstore.Store{Session: &sessions.State{Expiry: jwt.NewNumericDate(time.Now().Add(10 * time.Minute))}},
@@ -394,7 +425,7 @@ func TestProxy_ProgrammaticCallback(t *testing.T) {
 			"bad save session",
 			opts,
 			http.MethodGet,
-			"http://pomerium.io/",
+			"http://127.0.0.1:8000/",
 			nil,
 			map[string]string{urlutil.QuerySessionEncrypted: goodEncryptionString},
 			&mock.Encoder{MarshalResponse: []byte("x")},
@@ -406,7 +437,7 @@ func TestProxy_ProgrammaticCallback(t *testing.T) {
 			"bad base64",
 			opts,
 			http.MethodGet,
-			"http://pomerium.io/",
+			"http://127.0.0.1:8000/",
 			nil,
 			map[string]string{urlutil.QuerySessionEncrypted: "^"},
 			&mock.Encoder{MarshalResponse: []byte("x")},
@@ -418,7 +449,7 @@ func TestProxy_ProgrammaticCallback(t *testing.T) {
 			"malformed redirect",
 			opts,
 			http.MethodGet,
-			"http://pomerium.io/",
+			"http://127.0.0.1:8000/",
 			nil,
 			nil,
 			&mock.Encoder{},
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog"
@@ -46,7 +46,7 @@ func (p *Proxy) redirectToSignin(w http.ResponseWriter, r *http.Request) error {
 	q := signinURL.Query()
 	q.Set(urlutil.QueryRedirectURI, urlutil.GetAbsoluteURL(r).String())
 	signinURL.RawQuery = q.Encode()
-	log.FromRequest(r).Debug().Str("url", signinURL.String()).Msg("proxy: redirectToSignin")
+	log.FromRequest(r).Debug().Str("url", log.RedactURL(signinURL.String())).Msg("proxy: redirectToSignin")
 	httputil.Redirect(w, r, urlutil.NewSignedURL(state.sharedKey, &signinURL).String(), http.StatusFound)
 	state.sessionStore.ClearSession(w, r)
 	return nil
@@ -60,7 +60,7 @@ func (p *Proxy) isAuthorized(w http.ResponseWriter, r *http.Request) (*authorize
 		return nil, httputil.NewError(http.StatusInternalServerError, fmt.Errorf("error creating protobuf timestamp from current time: %w", err))
 	}
 
-	httpAttrs := &envoy_service_auth_v2.AttributeContext_HttpRequest{
+	httpAttrs := &envoy_service_auth_v3.AttributeContext_HttpRequest{
 		Method:   "GET",
 		Headers:  map[string]string{},
 		Path:     r.URL.Path,
@@ -76,9 +76,9 @@ func (p *Proxy) isAuthorized(w http.ResponseWriter, r *http.Request) (*authorize
 		httpAttrs.Path += "?" + r.URL.RawQuery
 	}
 
-	res, err := state.authzClient.Check(r.Context(), &envoy_service_auth_v2.CheckRequest{
-		Attributes: &envoy_service_auth_v2.AttributeContext{
-			Request: &envoy_service_auth_v2.AttributeContext_Request{
+	res, err := state.authzClient.Check(r.Context(), &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
 				Time: tm,
 				Http: httpAttrs,
 			},
@@ -90,13 +90,18 @@ func (p *Proxy) isAuthorized(w http.ResponseWriter, r *http.Request) (*authorize
 
 	ar := &authorizeResponse{}
 	switch res.HttpResponse.(type) {
-	case *envoy_service_auth_v2.CheckResponse_OkResponse:
+	case *envoy_service_auth_v3.CheckResponse_OkResponse:
 		for _, hdr := range res.GetOkResponse().GetHeaders() {
 			w.Header().Set(hdr.GetHeader().GetKey(), hdr.GetHeader().GetValue())
 		}
 		ar.authorized = true
 		ar.statusCode = res.GetStatus().Code
-	case *envoy_service_auth_v2.CheckResponse_DeniedResponse:
+	case *envoy_service_auth_v3.CheckResponse_DeniedResponse:
+		for _, hdr := range res.GetDeniedResponse().GetHeaders() {
+			if hdr.GetHeader().GetKey() == httputil.HeaderPomeriumDenyReason {
+				w.Header().Set(hdr.GetHeader().GetKey(), hdr.GetHeader().GetValue())
+			}
+		}
 		ar.statusCode = int32(res.GetDeniedResponse().GetStatus().Code)
 	default:
 		ar.statusCode = http.StatusInternalServerError
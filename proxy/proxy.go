@@ -92,7 +92,9 @@ func (p *Proxy) OnConfigChange(cfg *config.Config) {
 	if state, err := newProxyStateFromConfig(cfg); err != nil {
 		log.Error().Err(err).Msg("proxy: failed to update proxy state from configuration settings")
 	} else {
+		previous := p.state.Load()
 		p.state.Store(state)
+		previous.forwardAuthCache.Close()
 	}
 }
 
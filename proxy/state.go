@@ -7,11 +7,11 @@ import (
 	"sync/atomic"
 	"time"
 
-	envoy_service_auth_v2 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/encoding"
-	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/encoding/sessiontoken"
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/internal/sessions/cookie"
@@ -20,6 +20,7 @@ import (
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 type proxyState struct {
@@ -39,7 +40,11 @@ type proxyState struct {
 	sessionStore    sessions.SessionStore
 	sessionLoaders  []sessions.SessionLoader
 	jwtClaimHeaders []string
-	authzClient     envoy_service_auth_v2.AuthorizationClient
+	authzClient     envoy_service_auth_v3.AuthorizationClient
+
+	forwardAuthCache *forwardAuthCache
+
+	programmaticRedirectDomains []string
 }
 
 func newProxyStateFromConfig(cfg *config.Config) (*proxyState, error) {
@@ -54,13 +59,14 @@ func newProxyStateFromConfig(cfg *config.Config) (*proxyState, error) {
 	state.cookieSecret, _ = base64.StdEncoding.DecodeString(cfg.Options.CookieSecret)
 
 	// used to load and verify JWT tokens signed by the authenticate service
-	state.encoder, err = jws.NewHS256Signer([]byte(cfg.Options.SharedKey), cfg.Options.GetAuthenticateURL().Host)
+	state.encoder, err = sessiontoken.New(cfg.Options, "proxy")
 	if err != nil {
 		return nil, err
 	}
 
 	state.refreshCooldown = cfg.Options.RefreshCooldown
 	state.jwtClaimHeaders = cfg.Options.JWTClaimsHeaders
+	state.programmaticRedirectDomains = cfg.Options.ProgrammaticRedirectDomains
 
 	// errors checked in ValidateOptions
 	state.authorizeURL, _ = urlutil.DeepCopy(cfg.Options.AuthorizeURL)
@@ -100,7 +106,32 @@ func newProxyStateFromConfig(cfg *config.Config) (*proxyState, error) {
 	if err != nil {
 		return nil, err
 	}
-	state.authzClient = envoy_service_auth_v2.NewAuthorizationClient(authzConn)
+	state.authzClient = envoy_service_auth_v3.NewAuthorizationClient(authzConn)
+
+	var dataBrokerClient databroker.DataBrokerServiceClient
+	if cfg.Options.ForwardAuthCacheTTL > 0 && cfg.Options.DataBrokerURL != nil {
+		dataBrokerConn, err := grpc.GetGRPCClientConn("databroker", &grpc.Options{
+			Addr:                    cfg.Options.DataBrokerURL,
+			OverrideCertificateName: cfg.Options.OverrideCertificateName,
+			CA:                      cfg.Options.CA,
+			CAFile:                  cfg.Options.CAFile,
+			RequestTimeout:          cfg.Options.GRPCClientTimeout,
+			ClientDNSRoundRobin:     cfg.Options.GRPCClientDNSRoundRobin,
+			WithInsecure:            cfg.Options.GRPCInsecure,
+			ServiceName:             cfg.Options.Services,
+			Namespace:               cfg.Options.DataBrokerNamespace,
+			ClientID:                cfg.Options.DataBrokerClientID,
+			ClientSecret:            cfg.Options.GetDataBrokerClientSecret(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		dataBrokerClient = databroker.NewDataBrokerServiceClient(dataBrokerConn)
+	}
+	state.forwardAuthCache, err = newForwardAuthCache(cfg.Options, dataBrokerClient)
+	if err != nil {
+		return nil, err
+	}
 
 	return state, nil
 }
@@ -3,7 +3,7 @@ set -euo pipefail
 
 BINARY=$1
 
-ENVOY_VERSION=1.15.0
+ENVOY_VERSION=1.20.0
 DIR=$(dirname "${BINARY}")
 TARGET="${TARGET:-"$(go env GOOS)_$(go env GOARCH)"}"
 
@@ -29,10 +29,14 @@ else
   ENVOY_PATH=${DIR}/.getenvoy/builds/standard/${ENVOY_VERSION}/${ENVOY_PLATFORM}/bin
 fi
 ARCHIVE="${ENVOY_PATH}/envoy.zip"
+# the binary is named for its platform so pomerium can select it at runtime
+ENVOY_NAME="envoy-${TARGET/_/-}"
 
 (
   cd "${ENVOY_PATH}"
-  zip envoy.zip envoy
+  cp envoy "${ENVOY_NAME}"
+  rm -f envoy.zip
+  zip envoy.zip "${ENVOY_NAME}"
 )
 
 echo "appending ${ARCHIVE} to ${BINARY}"